@@ -1,6 +1,8 @@
 package command
 
 import (
+	"bytes"
+	"encoding/xml"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -36,6 +38,11 @@ func getReleaseFiles(w *world.World, pattern string) ([]*v1pb.Release_File, erro
 			w.Logger.Warn("version not found. ignore the file", "file", m)
 			continue
 		}
+		format, ok := getFileFormat(base, content)
+		if !ok {
+			w.Logger.Warn("neither a MyBatis mapper nor a TypeORM migration. ignore the file", "file", m)
+			continue
+		}
 
 		files = append(files, &v1pb.Release_File{
 			Path:       m,
@@ -43,12 +50,52 @@ func getReleaseFiles(w *world.World, pattern string) ([]*v1pb.Release_File, erro
 			Version:    version,
 			ChangeType: t,
 			Statement:  content,
+			Format:     format,
 		})
 	}
 
 	return files, nil
 }
 
+// getFileFormat returns the format of the file by its extension and content.
+// MyBatis mapper xml and TypeORM migration files can only be checked.
+// It returns false for the xml and ts files that are neither, e.g. the build configs and the entities.
+func getFileFormat(base string, content []byte) (v1pb.Release_File_Format, bool) {
+	switch strings.ToLower(filepath.Ext(base)) {
+	case ".xml":
+		if !isMyBatisMapper(content) {
+			return v1pb.Release_File_FORMAT_UNSPECIFIED, false
+		}
+		return v1pb.Release_File_MYBATIS_MAPPER, true
+	case ".ts":
+		if !isTypeORMMigration(content) {
+			return v1pb.Release_File_FORMAT_UNSPECIFIED, false
+		}
+		return v1pb.Release_File_TYPEORM_MIGRATION, true
+	default:
+		return v1pb.Release_File_FORMAT_UNSPECIFIED, true
+	}
+}
+
+// isMyBatisMapper returns true if the root element of the xml is <mapper>.
+func isMyBatisMapper(content []byte) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+		if element, ok := token.(xml.StartElement); ok {
+			return element.Name.Local == "mapper"
+		}
+	}
+}
+
+// isTypeORMMigration returns true if the file implements MigrationInterface or runs queries by the QueryRunner.
+func isTypeORMMigration(content []byte) bool {
+	return bytes.Contains(content, []byte("MigrationInterface")) || bytes.Contains(content, []byte("queryRunner"))
+}
+
 var versionReg = regexp.MustCompile(`^[vV]?(\d+(\.\d+)*)`)
 
 // extractVersion extracts version from a string and removes the optional "v" or "V" prefix
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 )
 
 func TestExtractVersion(t *testing.T) {
@@ -96,3 +98,72 @@ func TestExtractVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFileFormat(t *testing.T) {
+	tests := []struct {
+		base    string
+		content string
+		format  v1pb.Release_File_Format
+		ok      bool
+	}{
+		{
+			base:    "v1.0_create_table.sql",
+			content: "CREATE TABLE t (id INT);",
+			format:  v1pb.Release_File_FORMAT_UNSPECIFIED,
+			ok:      true,
+		},
+		{
+			base: "v1.0_UserMapper.xml",
+			content: `<?xml version="1.0" encoding="UTF-8" ?>
+<!DOCTYPE mapper PUBLIC "-//mybatis.org//DTD Mapper 3.0//EN" "http://mybatis.org/dtd/mybatis-3-mapper.dtd">
+<mapper namespace="UserMapper">
+  <select id="get">SELECT * FROM users</select>
+</mapper>`,
+			format: v1pb.Release_File_MYBATIS_MAPPER,
+			ok:     true,
+		},
+		{
+			base: "v1.0_pom.xml",
+			content: `<?xml version="1.0" encoding="UTF-8"?>
+<project><modelVersion>4.0.0</modelVersion></project>`,
+			format: v1pb.Release_File_FORMAT_UNSPECIFIED,
+			ok:     false,
+		},
+		{
+			base:    "v1.0_broken.xml",
+			content: "not xml",
+			format:  v1pb.Release_File_FORMAT_UNSPECIFIED,
+			ok:      false,
+		},
+		{
+			base: "1700000000000-AddUser.ts",
+			content: `import { MigrationInterface, QueryRunner } from "typeorm";
+
+export class AddUser1700000000000 implements MigrationInterface {
+    public async up(queryRunner: QueryRunner): Promise<void> {
+        await queryRunner.query("CREATE TABLE users (id INT)");
+    }
+}`,
+			format: v1pb.Release_File_TYPEORM_MIGRATION,
+			ok:     true,
+		},
+		{
+			base: "1700000000000-User.ts",
+			content: `import { Entity, PrimaryGeneratedColumn } from "typeorm";
+
+@Entity()
+export class User {
+    @PrimaryGeneratedColumn()
+    id: number;
+}`,
+			format: v1pb.Release_File_FORMAT_UNSPECIFIED,
+			ok:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		format, ok := getFileFormat(tt.base, []byte(tt.content))
+		require.Equal(t, tt.format, format, tt.base)
+		require.Equal(t, tt.ok, ok, tt.base)
+	}
+}
@@ -3,6 +3,10 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -90,7 +94,7 @@ func (in *APIAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFun
 		}
 		ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-		principalID, err := in.getPrincipalIDConnect(ctx, accessTokenStr)
+		principalID, accessToken, err := in.getPrincipalIDConnect(ctx, accessTokenStr)
 		if err != nil {
 			if IsAuthenticationAllowed(req.Spec().Procedure, authContext) {
 				return next(ctx, req)
@@ -101,6 +105,12 @@ func (in *APIAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFun
 		if err != nil {
 			return nil, errs.Wrapf(err, "failed to get user for principal ID %d", principalID)
 		}
+		if accessToken != nil {
+			if err := checkAccessTokenScope(req.Spec().Procedure, authContext, accessToken); err != nil {
+				return nil, err
+			}
+			ctx = context.WithValue(ctx, common.AccessTokenContextKey, accessToken)
+		}
 
 		ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
 		ctx = context.WithValue(ctx, common.UserContextKey, user)
@@ -129,7 +139,7 @@ func (in *APIAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandler
 		}
 		ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-		principalID, err := in.getPrincipalIDConnect(ctx, accessTokenStr)
+		principalID, accessToken, err := in.getPrincipalIDConnect(ctx, accessTokenStr)
 		if err != nil {
 			if IsAuthenticationAllowed(conn.Spec().Procedure, authContext) {
 				return next(ctx, conn)
@@ -140,6 +150,12 @@ func (in *APIAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandler
 		if err != nil {
 			return errs.Wrapf(err, "failed to get user for principal ID %d", principalID)
 		}
+		if accessToken != nil {
+			if err := checkAccessTokenScope(conn.Spec().Procedure, authContext, accessToken); err != nil {
+				return err
+			}
+			ctx = context.WithValue(ctx, common.AccessTokenContextKey, accessToken)
+		}
 
 		ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
 		ctx = context.WithValue(ctx, common.UserContextKey, user)
@@ -149,12 +165,16 @@ func (in *APIAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandler
 }
 
 // authenticateConnect is a ConnectRPC-specific version that returns ConnectRPC errors.
-func (in *APIAuthInterceptor) authenticateConnect(ctx context.Context, accessTokenStr string) (int, error) {
+// The access token is returned if the request is authenticated by a personal access token, whose scope must be enforced.
+func (in *APIAuthInterceptor) authenticateConnect(ctx context.Context, accessTokenStr string) (int, *common.AccessToken, error) {
 	if accessTokenStr == "" {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.New("access token not found"))
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.New("access token not found"))
+	}
+	if strings.HasPrefix(accessTokenStr, common.PersonalAccessTokenPrefix) {
+		return in.authenticatePersonalAccessToken(ctx, accessTokenStr)
 	}
 	if _, ok := in.stateCfg.ExpireCache.Get(accessTokenStr); ok {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.New("access token expired"))
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.New("access token expired"))
 	}
 	claims := &claimsMessage{}
 	if _, err := jwt.ParseWithClaims(accessTokenStr, claims, func(t *jwt.Token) (any, error) {
@@ -169,12 +189,12 @@ func (in *APIAuthInterceptor) authenticateConnect(ctx context.Context, accessTok
 		return nil, errs.Errorf("unexpected access token kid=%v", t.Header["kid"])
 	}); err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return 0, connect.NewError(connect.CodeUnauthenticated, errs.New("access token expired"))
+			return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.New("access token expired"))
 		}
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.New("failed to parse claim"))
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.New("failed to parse claim"))
 	}
 	if !audienceContains(claims.Audience, fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode)) {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.Errorf(
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.Errorf(
 			"invalid access token, audience mismatch, got %q, expected %q. you may send request to the wrong environment",
 			claims.Audience,
 			fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode),
@@ -183,32 +203,80 @@ func (in *APIAuthInterceptor) authenticateConnect(ctx context.Context, accessTok
 
 	principalID, err := strconv.Atoi(claims.Subject)
 	if err != nil {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.Errorf("malformed ID %q in the access token", claims.Subject))
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.Errorf("malformed ID %q in the access token", claims.Subject))
+	}
+	if err := in.checkTokenUser(ctx, principalID); err != nil {
+		return 0, nil, err
+	}
+
+	return principalID, nil, nil
+}
+
+// authenticatePersonalAccessToken authenticates the personal access token, which is looked up by its hash
+// so that the revocation takes effect immediately.
+func (in *APIAuthInterceptor) authenticatePersonalAccessToken(ctx context.Context, accessTokenStr string) (int, *common.AccessToken, error) {
+	tokenHash := HashPersonalAccessToken(accessTokenStr)
+	token, err := in.store.GetAccessToken(ctx, &store.FindAccessTokenMessage{TokenHash: &tokenHash})
+	if err != nil {
+		return 0, nil, connect.NewError(connect.CodeInternal, errs.Wrapf(err, "failed to find access token"))
+	}
+	if token == nil {
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.New("invalid access token, it may have been revoked"))
+	}
+	if token.ExpiresAt != nil && token.ExpiresAt.Before(time.Now()) {
+		return 0, nil, connect.NewError(connect.CodeUnauthenticated, errs.New("access token expired"))
 	}
+	if err := in.checkTokenUser(ctx, token.PrincipalID); err != nil {
+		return 0, nil, err
+	}
+	return token.PrincipalID, &common.AccessToken{
+		Name:        common.FormatAccessToken(token.PrincipalID, token.UID),
+		PrincipalID: token.PrincipalID,
+		ProjectIDs:  token.Payload.GetProjects(),
+		Permissions: token.Payload.GetPermissions(),
+	}, nil
+}
+
+func (in *APIAuthInterceptor) checkTokenUser(ctx context.Context, principalID int) error {
 	user, err := in.store.GetUserByID(ctx, principalID)
 	if err != nil {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.Errorf("failed to find user ID %q in the access token", principalID))
+		return connect.NewError(connect.CodeUnauthenticated, errs.Errorf("failed to find user ID %q in the access token", principalID))
 	}
 	if user == nil {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.Errorf("user ID %q not exists in the access token", principalID))
+		return connect.NewError(connect.CodeUnauthenticated, errs.Errorf("user ID %q not exists in the access token", principalID))
 	}
 	if user.MemberDeleted {
-		return 0, connect.NewError(connect.CodeUnauthenticated, errs.Errorf("user ID %q has been deactivated by administrators", user.ID))
+		return connect.NewError(connect.CodeUnauthenticated, errs.Errorf("user ID %q has been deactivated by administrators", user.ID))
 	}
+	return nil
+}
 
-	return principalID, nil
+// checkAccessTokenScope checks the method against the scope of the personal access token.
+// The project scope is enforced by iam.Manager.CheckPermission with the access token in the context.
+// The methods with the custom auth method check the permission scope by themselves, e.g. bb.sql.select for Query.
+func checkAccessTokenScope(fullMethod string, authContext *common.AuthContext, accessToken *common.AccessToken) error {
+	if accessTokenDeniedMethods[fullMethod] {
+		return connect.NewError(connect.CodePermissionDenied, errs.Errorf("method %q cannot be called with a personal access token", fullMethod))
+	}
+	if roleAuthorizedMethods[fullMethod] && len(accessToken.Permissions) > 0 {
+		return connect.NewError(connect.CodePermissionDenied, errs.Errorf("method %q cannot be called with the permission-scoped access token %q", fullMethod, accessToken.Name))
+	}
+	if authContext.AuthMethod == common.AuthMethodIAM && !accessToken.AllowPermission(authContext.Permission) {
+		return connect.NewError(connect.CodePermissionDenied, errs.Errorf("permission %q is not in the scope of the access token %q", authContext.Permission, accessToken.Name))
+	}
+	return nil
 }
 
 // getPrincipalIDConnect is a ConnectRPC-specific version that returns ConnectRPC errors.
-func (in *APIAuthInterceptor) getPrincipalIDConnect(ctx context.Context, accessTokenStr string) (int, error) {
-	principalID, err := in.authenticateConnect(ctx, accessTokenStr)
+func (in *APIAuthInterceptor) getPrincipalIDConnect(ctx context.Context, accessTokenStr string) (int, *common.AccessToken, error) {
+	principalID, accessToken, err := in.authenticateConnect(ctx, accessTokenStr)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	// Only update for authorized request.
 	in.profile.LastActiveTS.Store(time.Now().Unix())
-	return principalID, nil
+	return principalID, accessToken, nil
 }
 
 // GetUserIDFromMFATempToken returns the user ID from the MFA temp token.
@@ -301,6 +369,22 @@ type claimsMessage struct {
 	jwt.RegisteredClaims
 }
 
+// GeneratePersonalAccessToken generates a personal access token and its hash to store.
+func GeneratePersonalAccessToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := common.PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashPersonalAccessToken(token), nil
+}
+
+// HashPersonalAccessToken returns the SHA-256 hex digest of the personal access token.
+func HashPersonalAccessToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
 // GenerateAPIToken generates an API token.
 func GenerateAPIToken(userName string, userID int, mode common.ReleaseMode, secret string) (string, error) {
 	expirationTime := time.Now().Add(apiTokenDuration)
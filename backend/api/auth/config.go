@@ -4,8 +4,32 @@ import (
 	"strings"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
 )
 
+// accessTokenDeniedMethods are the methods managing the credentials of the user.
+// They cannot be called with a personal access token, otherwise a leaked token could be escalated
+// to a password, security key or another token outliving its expiry and scope.
+var accessTokenDeniedMethods = map[string]bool{
+	v1connect.UserServiceUpdateUserProcedure:                      true,
+	v1connect.UserServiceGenerateWebAuthnCreationOptionsProcedure: true,
+	v1connect.UserServiceCreateWebAuthnCredentialProcedure:        true,
+	v1connect.UserServiceUpdateWebAuthnCredentialProcedure:        true,
+	v1connect.UserServiceDeleteWebAuthnCredentialProcedure:        true,
+	v1connect.UserServiceCreateAccessTokenProcedure:               true,
+}
+
+// roleAuthorizedMethods are the methods authorized by the roles of the user instead of the permissions, e.g. the approval flow.
+// They cannot be called with a permission-scoped personal access token, because the roles cannot be limited by the token scope.
+var roleAuthorizedMethods = map[string]bool{
+	v1connect.IssueServiceApproveIssueProcedure:          true,
+	v1connect.IssueServiceRejectIssueProcedure:           true,
+	v1connect.IssueServiceRequestIssueProcedure:          true,
+	v1connect.RolloutServiceBatchRunTasksProcedure:       true,
+	v1connect.RolloutServiceBatchSkipTasksProcedure:      true,
+	v1connect.RolloutServiceBatchCancelTaskRunsProcedure: true,
+}
+
 // IsAuthenticationAllowed returns whether the method is exempted from authentication.
 func IsAuthenticationAllowed(fullMethodName string, authContext *common.AuthContext) bool {
 	// "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo" is used
@@ -0,0 +1,440 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// filterExpr is the parsed SCIM filter.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2
+type filterExpr interface {
+	// match reports whether the resource, which is the JSON object of a SCIM resource, matches the filter.
+	match(resource map[string]any) bool
+}
+
+// logicalExpr is the "and" or "or" expression.
+type logicalExpr struct {
+	and   bool
+	left  filterExpr
+	right filterExpr
+}
+
+func (e *logicalExpr) match(resource map[string]any) bool {
+	if e.and {
+		return e.left.match(resource) && e.right.match(resource)
+	}
+	return e.left.match(resource) || e.right.match(resource)
+}
+
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e *notExpr) match(resource map[string]any) bool {
+	return !e.expr.match(resource)
+}
+
+// attrExpr compares the attribute with the value, e.g. userName eq "alice@example.com" or title pr.
+type attrExpr struct {
+	attr    string
+	subAttr string
+	op      string
+	value   any
+}
+
+func (e *attrExpr) match(resource map[string]any) bool {
+	values := attributeValues(resource, e.attr, e.subAttr)
+	switch e.op {
+	case "pr":
+		return len(values) > 0
+	case "ne":
+		for _, v := range values {
+			if compareValue(v, "eq", e.value) {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, v := range values {
+			if compareValue(v, e.op, e.value) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// valuePathExpr filters the elements of a multi-valued complex attribute, e.g. emails[type eq "work"].
+type valuePathExpr struct {
+	attr   string
+	filter filterExpr
+}
+
+func (e *valuePathExpr) match(resource map[string]any) bool {
+	return len(matchElements(resource, e.attr, e.filter)) > 0
+}
+
+// matchElements returns the indexes of the elements in the multi-valued attribute matching the filter.
+func matchElements(resource map[string]any, attr string, filter filterExpr) []int {
+	var indexes []int
+	for i, element := range asSlice(lookup(resource, attr)) {
+		if m, ok := element.(map[string]any); ok && filter.match(m) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// attributeValues returns the non-null values of the attribute.
+// The values of the complex multi-valued attribute without the sub-attribute are the "value" sub-attributes.
+func attributeValues(resource map[string]any, attr, subAttr string) []any {
+	var values []any
+	for _, v := range asSlice(lookup(resource, attr)) {
+		if m, ok := v.(map[string]any); ok {
+			if subAttr == "" {
+				v = lookup(m, "value")
+			} else {
+				v = lookup(m, subAttr)
+			}
+		} else if subAttr != "" {
+			continue
+		}
+		for _, value := range asSlice(v) {
+			if value == nil || value == "" {
+				continue
+			}
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func compareValue(v any, op string, want any) bool {
+	switch want := want.(type) {
+	case string:
+		got, ok := v.(string)
+		if !ok {
+			return false
+		}
+		// The attributes are case-insensitive by default.
+		got, want = strings.ToLower(got), strings.ToLower(want)
+		switch op {
+		case "eq":
+			return got == want
+		case "co":
+			return strings.Contains(got, want)
+		case "sw":
+			return strings.HasPrefix(got, want)
+		case "ew":
+			return strings.HasSuffix(got, want)
+		case "gt":
+			return got > want
+		case "ge":
+			return got >= want
+		case "lt":
+			return got < want
+		case "le":
+			return got <= want
+		}
+	case float64:
+		got, ok := v.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return got == want
+		case "gt":
+			return got > want
+		case "ge":
+			return got >= want
+		case "lt":
+			return got < want
+		case "le":
+			return got <= want
+		}
+	case bool:
+		got, ok := v.(bool)
+		return ok && op == "eq" && got == want
+	case nil:
+		return false
+	}
+	return false
+}
+
+// lookup returns the attribute value by the case-insensitive name.
+func lookup(m map[string]any, name string) any {
+	if v, ok := m[name]; ok {
+		return v
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// lookupKey returns the existing key of the attribute, or the name itself if the attribute does not exist.
+func lookupKey(m map[string]any, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+func asSlice(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
+
+// parseAttrPath parses the attribute path with the optional schema URN prefix, e.g. "name.givenName" or
+// "urn:ietf:params:scim:schemas:core:2.0:User:userName".
+func parseAttrPath(path string) (string, string) {
+	if i := strings.LastIndex(path, ":"); i >= 0 {
+		path = path[i+1:]
+	}
+	attr, subAttr, _ := strings.Cut(path, ".")
+	return attr, subAttr
+}
+
+var comparisonOperators = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true, "gt": true, "ge": true, "lt": true, "le": true,
+}
+
+// parseFilter parses the SCIM filter.
+func parseFilter(filter string) (filterExpr, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, invalidFilterError("unexpected %q", p.tokens[p.pos].text)
+	}
+	return expr, nil
+}
+
+func invalidFilterError(format string, args ...any) *Error {
+	return newError(http.StatusBadRequest, scimTypeInvalidFilter, "invalid filter: "+format, args...)
+}
+
+type filterToken struct {
+	text string
+	// quoted is true for the string literal, whose text is the decoded string.
+	quoted bool
+}
+
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter); j++ {
+				if filter[j] == '\\' {
+					j++
+					continue
+				}
+				if filter[j] == '"' {
+					break
+				}
+			}
+			if j >= len(filter) {
+				return nil, invalidFilterError("unterminated string at %d", i)
+			}
+			var s string
+			if err := json.Unmarshal([]byte(filter[i:j+1]), &s); err != nil {
+				return nil, invalidFilterError("invalid string %s", filter[i:j+1])
+			}
+			tokens = append(tokens, filterToken{text: s, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter); j++ {
+				if unicode.IsSpace(rune(filter[j])) || strings.ContainsRune("()[]\"", rune(filter[j])) {
+					break
+				}
+			}
+			tokens = append(tokens, filterToken{text: filter[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, keyword)
+}
+
+func (p *filterParser) expect(text string) error {
+	if !p.peekKeyword(text) {
+		if p.pos >= len(p.tokens) {
+			return invalidFilterError("expect %q but got the end", text)
+		}
+		return invalidFilterError("expect %q but got %q", text, p.tokens[p.pos].text)
+	}
+	p.pos++
+	return nil
+}
+
+// parseOr parses the "or" expressions, which have lower precedence than "and".
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, invalidFilterError("unexpected end")
+	}
+	switch {
+	case p.peekKeyword("not"):
+		p.pos++
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	case p.peekKeyword("("):
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	token := p.tokens[p.pos]
+	if token.quoted || strings.ContainsAny(token.text, "()[]") {
+		return nil, invalidFilterError("expect attribute but got %q", token.text)
+	}
+	p.pos++
+	attr, subAttr := parseAttrPath(token.text)
+	if p.peekKeyword("[") {
+		p.pos++
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return &valuePathExpr{attr: attr, filter: filter}, nil
+	}
+
+	if p.pos >= len(p.tokens) {
+		return nil, invalidFilterError("expect operator after %q", token.text)
+	}
+	op := strings.ToLower(p.tokens[p.pos].text)
+	p.pos++
+	if op == "pr" {
+		return &attrExpr{attr: attr, subAttr: subAttr, op: op}, nil
+	}
+	if !comparisonOperators[op] {
+		return nil, invalidFilterError("unsupported operator %q", op)
+	}
+	if p.pos >= len(p.tokens) {
+		return nil, invalidFilterError("expect value after %q", op)
+	}
+	value, err := parseCompValue(p.tokens[p.pos])
+	if err != nil {
+		return nil, err
+	}
+	p.pos++
+	return &attrExpr{attr: attr, subAttr: subAttr, op: op, value: value}, nil
+}
+
+func parseCompValue(token filterToken) (any, error) {
+	if token.quoted {
+		return token.text, nil
+	}
+	switch strings.ToLower(token.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(token.text, 64)
+	if err != nil {
+		return nil, invalidFilterError("invalid value %q", token.text)
+	}
+	return number, nil
+}
+
+// equalityValue returns the value if the filter is exactly `attr eq "value"`, which can be pushed down to the store.
+func equalityValue(filter filterExpr, attr string) (string, bool) {
+	e, ok := filter.(*attrExpr)
+	if !ok || e.op != "eq" || e.subAttr != "" || !strings.EqualFold(e.attr, attr) {
+		return "", false
+	}
+	value, ok := e.value.(string)
+	return value, ok
+}
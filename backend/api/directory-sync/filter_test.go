@@ -0,0 +1,109 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testUserResource = `{
+	"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+	"id": "101",
+	"userName": "Alice@example.com",
+	"name": {"formatted": "Alice Smith", "givenName": "Alice", "familyName": "Smith"},
+	"displayName": "Alice Smith",
+	"active": true,
+	"emails": [
+		{"value": "alice@example.com", "type": "work", "primary": true},
+		{"value": "alice@home.example.org", "type": "home"}
+	],
+	"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+}`
+
+func TestFilter(t *testing.T) {
+	var resource map[string]any
+	require.NoError(t, json.Unmarshal([]byte(testUserResource), &resource))
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		// Examples from https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2.
+		{`userName eq "alice@example.com"`, true},
+		{`userName Eq "ALICE@EXAMPLE.COM"`, true},
+		{`userName eq "bob@example.com"`, false},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "alice"`, true},
+		{`name.familyName co "mit"`, true},
+		{`name.givenName ew "ce"`, true},
+		{`title pr`, false},
+		{`displayName pr`, true},
+		{`meta.created gt "2023-01-01T00:00:00Z"`, true},
+		{`meta.created lt "2023-01-01T00:00:00Z"`, false},
+		{`active eq true`, true},
+		{`active eq false`, false},
+		{`userName ne "bob@example.com"`, true},
+		{`userName sw "alice" and active eq true`, true},
+		{`userName sw "bob" or active eq true`, true},
+		{`userName sw "bob" or active eq false`, false},
+		{`userName sw "bob" or userName sw "carol" and active eq true`, false},
+		{`(userName sw "bob" or userName sw "alice") and active eq true`, true},
+		{`not (userName sw "bob")`, true},
+		{`not (userName sw "alice")`, false},
+		{`emails co "home.example.org"`, true},
+		{`emails.type eq "work"`, true},
+		{`emails[type eq "work" and value co "@example.com"]`, true},
+		{`emails[type eq "home" and value co "@example.com"]`, false},
+		{`emails[not (type eq "work")]`, true},
+		{`displayName eq "Alice \"The\" Smith"`, false},
+	}
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			a := require.New(t)
+			filter, err := parseFilter(test.filter)
+			a.NoError(err)
+			a.Equal(test.want, filter.match(resource))
+		})
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+	for _, filter := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName foo "alice"`,
+		`userName eq "alice`,
+		`userName eq alice`,
+		`(userName eq "alice"`,
+		`userName eq "alice" and`,
+		`emails[type eq "work"`,
+		`not userName eq "alice"`,
+		`userName eq "alice" extra`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			a := require.New(t)
+			_, err := parseFilter(filter)
+			a.Error(err)
+			scimErr, ok := err.(*Error)
+			a.True(ok)
+			a.Equal("400", scimErr.Status)
+			a.Equal(scimTypeInvalidFilter, scimErr.ScimType)
+		})
+	}
+}
+
+func TestEqualityValue(t *testing.T) {
+	a := require.New(t)
+
+	filter, err := parseFilter(`userName eq "alice@example.com"`)
+	a.NoError(err)
+	value, ok := equalityValue(filter, "username")
+	a.True(ok)
+	a.Equal("alice@example.com", value)
+
+	filter, err = parseFilter(`userName eq "alice@example.com" and active eq true`)
+	a.NoError(err)
+	_, ok = equalityValue(filter, "userName")
+	a.False(ok)
+}
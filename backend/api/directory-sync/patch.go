@@ -0,0 +1,268 @@
+package directorysync
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// patchPath is the parsed path of the PATCH operation, e.g. "members[value eq \"101\"]" or "name.givenName".
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+type patchPath struct {
+	attr    string
+	subAttr string
+	// filter selects the elements of the multi-valued attribute.
+	filter filterExpr
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	before, rest, hasFilter := strings.Cut(path, "[")
+	if !hasFilter {
+		attr, subAttr := parseAttrPath(path)
+		if attr == "" {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidPath, "invalid path %q", path)
+		}
+		return &patchPath{attr: attr, subAttr: subAttr}, nil
+	}
+
+	attr, subAttr := parseAttrPath(before)
+	end := strings.LastIndex(rest, "]")
+	if attr == "" || subAttr != "" || end < 0 {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidPath, "invalid path %q", path)
+	}
+	filter, err := parseFilter(rest[:end])
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidPath, "invalid path %q, %v", path, err)
+	}
+	p := &patchPath{attr: attr, filter: filter}
+	if after := rest[end+1:]; after != "" {
+		if !strings.HasPrefix(after, ".") || len(after) == 1 {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidPath, "invalid path %q", path)
+		}
+		p.subAttr = after[1:]
+	}
+	return p, nil
+}
+
+// applyPatch applies the PATCH operations to the JSON object of the resource in order.
+func applyPatch(resource map[string]any, operations []*PatchOperation) error {
+	for _, operation := range operations {
+		if err := applyPatchOperation(resource, operation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPatchOperation(resource map[string]any, operation *PatchOperation) error {
+	// The operation is case-insensitive, e.g. Entra ID sends "Replace" instead of "replace".
+	op := strings.ToLower(operation.OP)
+	if op != "add" && op != "replace" && op != "remove" {
+		return newError(http.StatusBadRequest, scimTypeInvalidSyntax, "unsupported operation %q", operation.OP)
+	}
+
+	if operation.Path == "" {
+		if op == "remove" {
+			return newError(http.StatusBadRequest, scimTypeNoTarget, "path is required for remove operation")
+		}
+		values, ok := operation.Value.(map[string]any)
+		if !ok {
+			return newError(http.StatusBadRequest, scimTypeInvalidValue, "value must be an object if path is not set")
+		}
+		for name, value := range values {
+			if err := applyPatchOperation(resource, &PatchOperation{OP: op, Path: name, Value: value}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	path, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	}
+	key := lookupKey(resource, path.attr)
+
+	if path.filter != nil {
+		return applyFilteredPatch(resource, key, path, op, operation.Value)
+	}
+
+	if path.subAttr != "" {
+		switch current := resource[key].(type) {
+		case map[string]any:
+			if op == "remove" {
+				delete(current, lookupKey(current, path.subAttr))
+			} else {
+				current[lookupKey(current, path.subAttr)] = operation.Value
+			}
+		case []any:
+			// The sub-attribute of all the elements in the multi-valued attribute.
+			for _, element := range current {
+				if m, ok := element.(map[string]any); ok {
+					if op == "remove" {
+						delete(m, lookupKey(m, path.subAttr))
+					} else {
+						m[lookupKey(m, path.subAttr)] = operation.Value
+					}
+				}
+			}
+		case nil:
+			if op != "remove" {
+				resource[key] = map[string]any{path.subAttr: operation.Value}
+			}
+		default:
+			return newError(http.StatusBadRequest, scimTypeInvalidPath, "attribute %q has no sub-attribute", path.attr)
+		}
+		return nil
+	}
+
+	switch op {
+	case "add":
+		current, isMultiValued := resource[key].([]any)
+		_, valueIsMultiValued := operation.Value.([]any)
+		if isMultiValued || (resource[key] == nil && valueIsMultiValued) {
+			// The values are added to the multi-valued attribute if not present.
+			for _, value := range asSlice(operation.Value) {
+				if !containsValue(current, value) {
+					current = append(current, value)
+				}
+			}
+			resource[key] = current
+			return nil
+		}
+		if m, ok := resource[key].(map[string]any); ok {
+			if values, ok := operation.Value.(map[string]any); ok {
+				for name, value := range values {
+					m[lookupKey(m, name)] = value
+				}
+				return nil
+			}
+		}
+		resource[key] = operation.Value
+	case "replace":
+		resource[key] = operation.Value
+	case "remove":
+		current, isMultiValued := resource[key].([]any)
+		if !isMultiValued || operation.Value == nil {
+			delete(resource, key)
+			return nil
+		}
+		// Entra ID removes the members by the values instead of the filter.
+		var remaining []any
+		for _, element := range current {
+			if !containsValue(asSlice(operation.Value), element) {
+				remaining = append(remaining, element)
+			}
+		}
+		resource[key] = remaining
+	}
+	return nil
+}
+
+func applyFilteredPatch(resource map[string]any, key string, path *patchPath, op string, value any) error {
+	current, _ := resource[key].([]any)
+	indexes := matchElements(resource, key, path.filter)
+	if len(indexes) == 0 {
+		if op == "remove" {
+			return nil
+		}
+		// Entra ID adds or replaces emails[type eq "work"].value which does not exist yet,
+		// so the element is created with the attributes in the filter.
+		seed, ok := filterSeed(path.filter)
+		if !ok || path.subAttr == "" {
+			return newError(http.StatusBadRequest, scimTypeNoTarget, "no value matches the filter of %q", path.attr)
+		}
+		seed[path.subAttr] = value
+		resource[key] = append(current, seed)
+		return nil
+	}
+
+	if op == "remove" {
+		var remaining []any
+		for i, element := range current {
+			if !slices.Contains(indexes, i) {
+				remaining = append(remaining, element)
+				continue
+			}
+			if path.subAttr != "" {
+				m, _ := element.(map[string]any)
+				delete(m, lookupKey(m, path.subAttr))
+				remaining = append(remaining, m)
+			}
+		}
+		resource[key] = remaining
+		return nil
+	}
+
+	for _, i := range indexes {
+		m, _ := current[i].(map[string]any)
+		switch {
+		case path.subAttr != "":
+			m[lookupKey(m, path.subAttr)] = value
+		case op == "replace":
+			current[i] = value
+		default:
+			values, ok := value.(map[string]any)
+			if !ok {
+				return newError(http.StatusBadRequest, scimTypeInvalidValue, "value must be an object for %q", path.attr)
+			}
+			for name, v := range values {
+				m[lookupKey(m, name)] = v
+			}
+		}
+	}
+	return nil
+}
+
+// filterSeed returns the attributes of the filter consisting of only "eq" and "and" expressions.
+func filterSeed(filter filterExpr) (map[string]any, bool) {
+	switch e := filter.(type) {
+	case *attrExpr:
+		if e.op != "eq" || e.subAttr != "" {
+			return nil, false
+		}
+		return map[string]any{e.attr: e.value}, true
+	case *logicalExpr:
+		if !e.and {
+			return nil, false
+		}
+		left, ok := filterSeed(e.left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := filterSeed(e.right)
+		if !ok {
+			return nil, false
+		}
+		for k, v := range right {
+			left[k] = v
+		}
+		return left, true
+	default:
+		return nil, false
+	}
+}
+
+// containsValue reports whether the values contain the value.
+// The complex values are compared by the "value" sub-attribute if present.
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if equalValue(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalValue(a, b any) bool {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if aok && bok {
+		av, bv := lookup(am, "value"), lookup(bm, "value")
+		if av != nil && bv != nil {
+			return reflect.DeepEqual(av, bv)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
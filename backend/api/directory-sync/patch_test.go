@@ -0,0 +1,231 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const testGroupResource = `{
+	"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+	"id": "dev@example.com",
+	"displayName": "Dev",
+	"members": [
+		{"value": "101", "display": "Alice"},
+		{"value": "102", "display": "Bob"}
+	]
+}`
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		resource   string
+		operations string
+		want       string
+	}{
+		{
+			name:       "replace attribute",
+			resource:   testGroupResource,
+			operations: `[{"op": "replace", "path": "displayName", "value": "Developers"}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Developers", "members": [{"value": "101", "display": "Alice"}, {"value": "102", "display": "Bob"}]}`,
+		},
+		{
+			name:       "replace without path",
+			resource:   testGroupResource,
+			operations: `[{"op": "Replace", "value": {"displayName": "Developers"}}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Developers", "members": [{"value": "101", "display": "Alice"}, {"value": "102", "display": "Bob"}]}`,
+		},
+		{
+			name:       "add members",
+			resource:   testGroupResource,
+			operations: `[{"op": "add", "path": "members", "value": [{"value": "102"}, {"value": "103"}]}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Dev", "members": [{"value": "101", "display": "Alice"}, {"value": "102", "display": "Bob"}, {"value": "103"}]}`,
+		},
+		{
+			name:       "add members without path",
+			resource:   testGroupResource,
+			operations: `[{"op": "add", "value": {"members": [{"value": "103"}]}}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Dev", "members": [{"value": "101", "display": "Alice"}, {"value": "102", "display": "Bob"}, {"value": "103"}]}`,
+		},
+		{
+			name:       "remove member by filter",
+			resource:   testGroupResource,
+			operations: `[{"op": "remove", "path": "members[value eq \"101\"]"}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Dev", "members": [{"value": "102", "display": "Bob"}]}`,
+		},
+		{
+			name:       "remove member by value",
+			resource:   testGroupResource,
+			operations: `[{"op": "Remove", "path": "members", "value": [{"value": "102"}]}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Dev", "members": [{"value": "101", "display": "Alice"}]}`,
+		},
+		{
+			name:       "remove all members",
+			resource:   testGroupResource,
+			operations: `[{"op": "remove", "path": "members"}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Dev"}`,
+		},
+		{
+			name:       "replace members",
+			resource:   testGroupResource,
+			operations: `[{"op": "replace", "path": "members", "value": [{"value": "103"}]}]`,
+			want:       `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "id": "dev@example.com", "displayName": "Dev", "members": [{"value": "103"}]}`,
+		},
+		{
+			name:       "replace sub-attribute",
+			resource:   testUserResource,
+			operations: `[{"op": "replace", "path": "name.givenName", "value": "Alicia"}, {"op": "replace", "path": "active", "value": false}]`,
+			want: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "id": "101", "userName": "Alice@example.com",
+				"name": {"formatted": "Alice Smith", "givenName": "Alicia", "familyName": "Smith"}, "displayName": "Alice Smith", "active": false,
+				"emails": [{"value": "alice@example.com", "type": "work", "primary": true}, {"value": "alice@home.example.org", "type": "home"}],
+				"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+			}`,
+		},
+		{
+			name:       "replace filtered sub-attribute",
+			resource:   testUserResource,
+			operations: `[{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "alice@corp.example.com"}]`,
+			want: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "id": "101", "userName": "Alice@example.com",
+				"name": {"formatted": "Alice Smith", "givenName": "Alice", "familyName": "Smith"}, "displayName": "Alice Smith", "active": true,
+				"emails": [{"value": "alice@corp.example.com", "type": "work", "primary": true}, {"value": "alice@home.example.org", "type": "home"}],
+				"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+			}`,
+		},
+		{
+			name:       "add missing filtered sub-attribute",
+			resource:   testUserResource,
+			operations: `[{"op": "Add", "path": "phoneNumbers[type eq \"work\"].value", "value": "+1 555 0100"}]`,
+			want: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "id": "101", "userName": "Alice@example.com",
+				"name": {"formatted": "Alice Smith", "givenName": "Alice", "familyName": "Smith"}, "displayName": "Alice Smith", "active": true,
+				"emails": [{"value": "alice@example.com", "type": "work", "primary": true}, {"value": "alice@home.example.org", "type": "home"}],
+				"phoneNumbers": [{"type": "work", "value": "+1 555 0100"}],
+				"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+			}`,
+		},
+		{
+			name:       "remove filtered element",
+			resource:   testUserResource,
+			operations: `[{"op": "remove", "path": "emails[type eq \"home\"]"}, {"op": "remove", "path": "name.familyName"}]`,
+			want: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "id": "101", "userName": "Alice@example.com",
+				"name": {"formatted": "Alice Smith", "givenName": "Alice"}, "displayName": "Alice Smith", "active": true,
+				"emails": [{"value": "alice@example.com", "type": "work", "primary": true}],
+				"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+			}`,
+		},
+		{
+			name:       "case-insensitive attribute",
+			resource:   testUserResource,
+			operations: `[{"op": "replace", "path": "DisplayName", "value": "Alicia"}]`,
+			want: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "id": "101", "userName": "Alice@example.com",
+				"name": {"formatted": "Alice Smith", "givenName": "Alice", "familyName": "Smith"}, "displayName": "Alicia", "active": true,
+				"emails": [{"value": "alice@example.com", "type": "work", "primary": true}, {"value": "alice@home.example.org", "type": "home"}],
+				"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+			}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			var resource map[string]any
+			a.NoError(json.Unmarshal([]byte(test.resource), &resource))
+			var operations []*PatchOperation
+			a.NoError(json.Unmarshal([]byte(test.operations), &operations))
+
+			a.NoError(applyPatch(resource, operations))
+			got, err := json.Marshal(resource)
+			a.NoError(err)
+			a.JSONEq(test.want, string(got))
+		})
+	}
+}
+
+func TestApplyPatchError(t *testing.T) {
+	tests := []struct {
+		operations string
+		scimType   string
+	}{
+		{`[{"op": "move", "path": "displayName", "value": "Dev"}]`, scimTypeInvalidSyntax},
+		{`[{"op": "remove"}]`, scimTypeNoTarget},
+		{`[{"op": "add", "value": "Dev"}]`, scimTypeInvalidValue},
+		{`[{"op": "replace", "path": "members[value eq \"999\"]", "value": {"value": "103"}}]`, scimTypeNoTarget},
+		{`[{"op": "replace", "path": "members[value eq ]", "value": "103"}]`, scimTypeInvalidPath},
+		{`[{"op": "replace", "path": "displayName.value", "value": "Dev"}]`, scimTypeInvalidPath},
+	}
+	for _, test := range tests {
+		t.Run(test.operations, func(t *testing.T) {
+			a := require.New(t)
+			var resource map[string]any
+			a.NoError(json.Unmarshal([]byte(testGroupResource), &resource))
+			var operations []*PatchOperation
+			a.NoError(json.Unmarshal([]byte(test.operations), &operations))
+
+			err := applyPatch(resource, operations)
+			a.Error(err)
+			scimErr, ok := err.(*Error)
+			a.True(ok)
+			a.Equal("400", scimErr.Status)
+			a.Equal(test.scimType, scimErr.ScimType)
+		})
+	}
+}
+
+func TestGroupEmail(t *testing.T) {
+	a := require.New(t)
+
+	email, err := groupEmail(&Group{ExternalID: "Dev@Example.com", DisplayName: "Dev"}, nil)
+	a.NoError(err)
+	a.Equal("dev@example.com", email)
+
+	email, err = groupEmail(&Group{DisplayName: "dba@example.com"}, nil)
+	a.NoError(err)
+	a.Equal("dba@example.com", email)
+
+	email, err = groupEmail(&Group{DisplayName: "Platform Engineering"}, []string{"example.com"})
+	a.NoError(err)
+	a.Equal("platform-engineering@example.com", email)
+
+	_, err = groupEmail(&Group{DisplayName: "Platform Engineering"}, nil)
+	a.Error(err)
+}
+
+func TestCheckEmailDomain(t *testing.T) {
+	a := require.New(t)
+	enforced := &storepb.WorkspaceProfileSetting{EnforceIdentityDomain: true, Domains: []string{"example.com"}}
+
+	a.NoError(checkEmailDomain("alice@example.com", enforced))
+	err := checkEmailDomain("alice@evil.com", enforced)
+	var scimErr *Error
+	a.ErrorAs(err, &scimErr)
+	a.Equal("400", scimErr.Status)
+	a.Equal(scimTypeInvalidValue, scimErr.ScimType)
+	// The subdomain does not belong to the domain, as the user service checks.
+	a.Error(checkEmailDomain("alice@sub.example.com", enforced))
+
+	// The domains are not enforced.
+	a.NoError(checkEmailDomain("alice@evil.com", &storepb.WorkspaceProfileSetting{Domains: []string{"example.com"}}))
+	a.NoError(checkEmailDomain("alice@evil.com", &storepb.WorkspaceProfileSetting{EnforceIdentityDomain: true}))
+}
+
+func TestCheckSCIMGroup(t *testing.T) {
+	a := require.New(t)
+
+	for _, source := range []string{entraIDSource, oktaSource, jumpCloudSource} {
+		a.NoError(checkSCIMGroup(&store.GroupMessage{Email: "dev@example.com", Payload: &storepb.GroupPayload{Source: source}}))
+	}
+	// The groups created in Bytebase or synchronized from LDAP cannot be modified.
+	for _, payload := range []*storepb.GroupPayload{nil, {}, {Source: "LDAP"}} {
+		err := checkSCIMGroup(&store.GroupMessage{Email: "dev@example.com", Payload: payload})
+		var scimErr *Error
+		a.ErrorAs(err, &scimErr)
+		a.Equal("409", scimErr.Status)
+	}
+}
@@ -0,0 +1,337 @@
+package directorysync
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643 and https://datatracker.ietf.org/doc/html/rfc7644
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	scimContentType = "application/scim+json"
+
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeUniqueness    = "uniqueness"
+
+	// maxResults is the maximum number of resources returned in a list response.
+	maxResults = 1000
+)
+
+type MultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type ResourceMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type UserName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// User is the SCIM user resource.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-4.1
+type User struct {
+	ID         string   `json:"id"`
+	Schemas    []string `json:"schemas"`
+	ExternalID string   `json:"externalId,omitempty"`
+	// Map to the email of the Bytebase user, e.g. the userPrincipalName in Entra ID.
+	UserName     string                  `json:"userName"`
+	Name         *UserName               `json:"name,omitempty"`
+	DisplayName  string                  `json:"displayName,omitempty"`
+	Active       bool                    `json:"active"`
+	Emails       []*MultiValuedAttribute `json:"emails,omitempty"`
+	PhoneNumbers []*MultiValuedAttribute `json:"phoneNumbers,omitempty"`
+	Meta         *ResourceMeta           `json:"meta,omitempty"`
+}
+
+// Group is the SCIM group resource.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-4.2
+type Group struct {
+	ID          string   `json:"id"`
+	Schemas     []string `json:"schemas"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	// The member value is the Bytebase user uid.
+	Members []*MultiValuedAttribute `json:"members"`
+	Meta    *ResourceMeta           `json:"meta,omitempty"`
+}
+
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type PatchOperation struct {
+	OP    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+// Error is the SCIM error response, which is also used as the error of the SCIM operations.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.12
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func newError(status int, scimType string, format string, args ...any) *Error {
+	return &Error{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("%s: %s", e.ScimType, e.Detail)
+	}
+	return e.Detail
+}
+
+// SchemaAttribute is the attribute definition in the schema.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-7
+type SchemaAttribute struct {
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	MultiValued   bool               `json:"multiValued"`
+	Description   string             `json:"description"`
+	Required      bool               `json:"required"`
+	CaseExact     bool               `json:"caseExact"`
+	Mutability    string             `json:"mutability"`
+	Returned      string             `json:"returned"`
+	Uniqueness    string             `json:"uniqueness"`
+	SubAttributes []*SchemaAttribute `json:"subAttributes,omitempty"`
+}
+
+type Schema struct {
+	ID          string             `json:"id"`
+	Schemas     []string           `json:"schemas"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Attributes  []*SchemaAttribute `json:"attributes"`
+	Meta        *ResourceMeta      `json:"meta,omitempty"`
+}
+
+type ResourceType struct {
+	ID          string        `json:"id"`
+	Schemas     []string      `json:"schemas"`
+	Name        string        `json:"name"`
+	Endpoint    string        `json:"endpoint"`
+	Description string        `json:"description"`
+	Schema      string        `json:"schema"`
+	Meta        *ResourceMeta `json:"meta,omitempty"`
+}
+
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+type BulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type FilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-5
+type ServiceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationURI      string                  `json:"documentationUri"`
+	Patch                 *Supported              `json:"patch"`
+	Bulk                  *BulkSupported          `json:"bulk"`
+	Filter                *FilterSupported        `json:"filter"`
+	ChangePassword        *Supported              `json:"changePassword"`
+	Sort                  *Supported              `json:"sort"`
+	Etag                  *Supported              `json:"etag"`
+	AuthenticationSchemes []*AuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *ResourceMeta           `json:"meta,omitempty"`
+}
+
+func newServiceProviderConfig(baseURL string) *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas:          []string{schemaServiceProviderConfig},
+		DocumentationURI: "https://docs.bytebase.com/administration/scim/overview",
+		Patch:            &Supported{Supported: true},
+		Bulk:             &BulkSupported{Supported: false},
+		Filter:           &FilterSupported{Supported: true, MaxResults: maxResults},
+		ChangePassword:   &Supported{Supported: false},
+		Sort:             &Supported{Supported: false},
+		Etag:             &Supported{Supported: false},
+		AuthenticationSchemes: []*AuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication with the SCIM token in the Bytebase workspace settings.",
+				Primary:     true,
+			},
+		},
+		Meta: &ResourceMeta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     baseURL + "/ServiceProviderConfig",
+		},
+	}
+}
+
+func newResourceTypes(baseURL string) []*ResourceType {
+	return []*ResourceType{
+		{
+			ID:          "User",
+			Schemas:     []string{schemaResourceType},
+			Name:        "User",
+			Endpoint:    "/Users",
+			Description: "User Account",
+			Schema:      schemaUser,
+			Meta:        &ResourceMeta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/User"},
+		},
+		{
+			ID:          "Group",
+			Schemas:     []string{schemaResourceType},
+			Name:        "Group",
+			Endpoint:    "/Groups",
+			Description: "Group",
+			Schema:      schemaGroup,
+			Meta:        &ResourceMeta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/Group"},
+		},
+	}
+}
+
+func stringAttribute(name, description string, required bool, uniqueness string) *SchemaAttribute {
+	return &SchemaAttribute{
+		Name:        name,
+		Type:        "string",
+		Description: description,
+		Required:    required,
+		Mutability:  "readWrite",
+		Returned:    "default",
+		Uniqueness:  uniqueness,
+	}
+}
+
+func multiValuedAttribute(name, description string, subAttributes ...*SchemaAttribute) *SchemaAttribute {
+	return &SchemaAttribute{
+		Name:          name,
+		Type:          "complex",
+		MultiValued:   true,
+		Description:   description,
+		Mutability:    "readWrite",
+		Returned:      "default",
+		Uniqueness:    "none",
+		SubAttributes: subAttributes,
+	}
+}
+
+func newSchemas(baseURL string) []*Schema {
+	return []*Schema{
+		{
+			ID:          schemaUser,
+			Schemas:     []string{schemaSchema},
+			Name:        "User",
+			Description: "User Account",
+			Attributes: []*SchemaAttribute{
+				stringAttribute("userName", "The email of the user.", true, "server"),
+				{
+					Name:        "name",
+					Type:        "complex",
+					Description: "The components of the user's name.",
+					Mutability:  "readWrite",
+					Returned:    "default",
+					Uniqueness:  "none",
+					SubAttributes: []*SchemaAttribute{
+						stringAttribute("formatted", "The full name.", false, "none"),
+						stringAttribute("familyName", "The family name.", false, "none"),
+						stringAttribute("givenName", "The given name.", false, "none"),
+					},
+				},
+				stringAttribute("displayName", "The name of the user.", false, "none"),
+				{
+					Name:        "active",
+					Type:        "boolean",
+					Description: "The user is deactivated if false.",
+					Mutability:  "readWrite",
+					Returned:    "default",
+					Uniqueness:  "none",
+				},
+				multiValuedAttribute(
+					"emails",
+					"The email addresses of the user, only the primary one is stored.",
+					stringAttribute("value", "The email address.", false, "none"),
+					stringAttribute("type", "The type of the email, e.g. work.", false, "none"),
+					&SchemaAttribute{Name: "primary", Type: "boolean", Description: "Whether the email is the primary one.", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+				),
+				multiValuedAttribute(
+					"phoneNumbers",
+					"The phone numbers of the user, only the primary one is stored.",
+					stringAttribute("value", "The phone number.", false, "none"),
+					stringAttribute("type", "The type of the phone number, e.g. work.", false, "none"),
+					&SchemaAttribute{Name: "primary", Type: "boolean", Description: "Whether the phone number is the primary one.", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+				),
+			},
+			Meta: &ResourceMeta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + schemaUser},
+		},
+		{
+			ID:          schemaGroup,
+			Schemas:     []string{schemaSchema},
+			Name:        "Group",
+			Description: "Group",
+			Attributes: []*SchemaAttribute{
+				stringAttribute("displayName", "The title of the group.", true, "none"),
+				multiValuedAttribute(
+					"members",
+					"The members of the group, only users are supported.",
+					&SchemaAttribute{Name: "value", Type: "string", Description: "The id of the user.", Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+					&SchemaAttribute{Name: "$ref", Type: "reference", Description: "The URI of the user.", Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+					&SchemaAttribute{Name: "display", Type: "string", Description: "The name of the user.", Mutability: "readOnly", Returned: "default", Uniqueness: "none"},
+					&SchemaAttribute{Name: "type", Type: "string", Description: "The type of the member, i.e. User.", Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+				),
+			},
+			Meta: &ResourceMeta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + schemaGroup},
+		},
+	}
+}
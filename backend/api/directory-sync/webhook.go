@@ -5,643 +5,1043 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log/slog"
 	"net/http"
+	"net/mail"
 	"net/url"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/labstack/echo/v4"
 
-	v1api "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
-	"github.com/bytebase/bytebase/backend/common/log"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
-const entraIDSource = "Entra ID"
+const (
+	entraIDSource   = "Entra ID"
+	oktaSource      = "Okta"
+	jumpCloudSource = "JumpCloud"
 
-// https://developer.xurrent.com/v1/scim/service_provider_config/
-// https://scim.cloud/
+	// baseURLContextKey is the echo context key of the SCIM base URL, e.g. "https://bytebase.example.com/hook/scim/workspaces/{workspace}".
+	baseURLContextKey = "scimBaseURL"
+)
+
+// scimHandler handles the SCIM request and returns the status and the response body.
+// The returned error is written as the SCIM error response.
+type scimHandler func(c echo.Context, baseURL string) (int, any, error)
+
+// https://datatracker.ietf.org/doc/html/rfc7644
 // https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups
+// https://developer.okta.com/docs/reference/scim/scim-20/
 func (s *Service) RegisterDirectorySyncRoutes(g *echo.Group) {
-	g.POST("/workspaces/:workspaceID/Users", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+	workspace := g.Group("/workspaces/:workspaceID", s.authenticate)
+
+	workspace.GET("/ServiceProviderConfig", handle(func(_ echo.Context, baseURL string) (int, any, error) {
+		return http.StatusOK, newServiceProviderConfig(baseURL), nil
+	}))
+	workspace.GET("/ResourceTypes", handle(func(_ echo.Context, baseURL string) (int, any, error) {
+		var resources []any
+		for _, resourceType := range newResourceTypes(baseURL) {
+			resources = append(resources, resourceType)
+		}
+		return http.StatusOK, newListResponse(resources, 1), nil
+	}))
+	workspace.GET("/ResourceTypes/:id", handle(func(c echo.Context, baseURL string) (int, any, error) {
+		for _, resourceType := range newResourceTypes(baseURL) {
+			if resourceType.ID == c.Param("id") {
+				return http.StatusOK, resourceType, nil
+			}
 		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+		return 0, nil, newError(http.StatusNotFound, "", "resource type %q not found", c.Param("id"))
+	}))
+	workspace.GET("/Schemas", handle(func(_ echo.Context, baseURL string) (int, any, error) {
+		var resources []any
+		for _, schema := range newSchemas(baseURL) {
+			resources = append(resources, schema)
+		}
+		return http.StatusOK, newListResponse(resources, 1), nil
+	}))
+	workspace.GET("/Schemas/:id", handle(func(c echo.Context, baseURL string) (int, any, error) {
+		for _, schema := range newSchemas(baseURL) {
+			if schema.ID == c.Param("id") {
+				return http.StatusOK, schema, nil
+			}
 		}
+		return 0, nil, newError(http.StatusNotFound, "", "schema %q not found", c.Param("id"))
+	}))
+
+	// The user id is the Bytebase user uid.
+	workspace.POST("/Users", handle(s.createUser))
+	workspace.GET("/Users", handle(s.listUsers))
+	workspace.GET("/Users/:id", handle(s.getUser))
+	workspace.PUT("/Users/:id", handle(s.replaceUser))
+	workspace.PATCH("/Users/:id", handle(s.patchUser))
+	workspace.DELETE("/Users/:id", handle(s.deleteUser))
+
+	// The group id is the Bytebase group email.
+	workspace.POST("/Groups", handle(s.createGroup))
+	workspace.GET("/Groups", handle(s.listGroups))
+	workspace.GET("/Groups/:id", handle(s.getGroup))
+	workspace.PUT("/Groups/:id", handle(s.replaceGroup))
+	workspace.PATCH("/Groups/:id", handle(s.patchGroup))
+	workspace.DELETE("/Groups/:id", handle(s.deleteGroup))
+}
 
-		body, err := io.ReadAll(c.Request().Body)
+func handle(h scimHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		baseURL, _ := c.Get(baseURLContextKey).(string)
+		status, body, err := h(c, baseURL)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
+			return writeError(c, err)
 		}
-
-		var aadUser AADUser
-		if err := json.Unmarshal(body, &aadUser); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
+		if body == nil {
+			return c.NoContent(status)
 		}
+		return writeJSON(c, status, body)
+	}
+}
 
-		user, err := s.store.GetUserByEmail(ctx, aadUser.UserName)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user %s, error %v", aadUser.UserName, err))
-		}
-		if user == nil {
-			password, err := common.RandomString(20)
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to generate random password, error %v", err))
-			}
-			passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to generate password hash, error %v", err))
-			}
-			newUser, err := s.store.CreateUser(ctx, &store.UserMessage{
-				Name:          aadUser.DisplayName,
-				Email:         aadUser.UserName,
-				Type:          storepb.PrincipalType_END_USER,
-				MemberDeleted: !aadUser.Active,
-				PasswordHash:  string(passwordHash),
-				Profile: &storepb.UserProfile{
-					Source: entraIDSource,
-				},
-			})
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to create user "%s", error %v`, aadUser.UserName, err))
-			}
-			user = newUser
-		} else {
-			deleted := !aadUser.Active
-			updatedUser, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
-				Delete: &deleted,
-				Name:   &aadUser.UserName,
-				Profile: &storepb.UserProfile{
-					Source:                 entraIDSource,
-					LastLoginTime:          user.Profile.LastLoginTime,
-					LastChangePasswordTime: user.Profile.LastChangePasswordTime,
-				},
-			})
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to update user "%s", error %v`, user.Email, err))
-			}
-			user = updatedUser
-		}
+func writeJSON(c echo.Context, status int, body any) error {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response, error %v", err))
+	}
+	return c.Blob(status, scimContentType, bytes)
+}
 
-		return c.JSON(http.StatusCreated, convertToAADUser(user))
-	})
+func writeError(c echo.Context, err error) error {
+	var scimErr *Error
+	if !errors.As(err, &scimErr) {
+		scimErr = newError(http.StatusInternalServerError, "", "%v", err)
+	}
+	status, convErr := strconv.Atoi(scimErr.Status)
+	if convErr != nil {
+		status = http.StatusInternalServerError
+	}
+	return writeJSON(c, status, scimErr)
+}
 
-	// Get a single user. The user id is the Bytebase user uid.
-	g.GET("/workspaces/:workspaceID/Users/:userID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+func (s *Service) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		baseURL, err := s.validRequestURL(c.Request().Context(), c)
+		if err != nil {
+			return writeError(c, err)
 		}
 		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+			return writeError(c, newError(http.StatusForbidden, "", "%v", err))
 		}
+		c.Set(baseURLContextKey, baseURL)
+		return next(c)
+	}
+}
 
-		uid, err := strconv.Atoi(c.Param("userID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-		}
+// validRequestURL validates the workspace and the token of the request, and returns the SCIM base URL.
+func (s *Service) validRequestURL(ctx context.Context, c echo.Context) (string, error) {
+	authorization := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+	if authorization == "" {
+		return "", newError(http.StatusUnauthorized, "", "missing authorization token")
+	}
 
-		user, err := s.store.GetUserByID(ctx, uid)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-		}
-		if user == nil || user.MemberDeleted {
-			return c.JSON(http.StatusNotFound, map[string]any{
-				"schemas": []string{
-					"urn:ietf:params:scim:api:messages:2.0:Error",
-				},
-				"status": "404",
-			})
-		}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	if setting.ExternalUrl == "" {
+		return "", errors.Errorf("external URL is empty")
+	}
 
-		return c.JSON(http.StatusOK, convertToAADUser(user))
-	})
+	workspaceID := c.Param("workspaceID")
 
-	// List users. AAD SCIM will send ?filter=userName eq "{user name}" query
-	g.GET("/workspaces/:workspaceID/Users", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
+	myWorkspaceID, err := s.store.GetWorkspaceID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if myWorkspaceID != workspaceID {
+		return "", newError(http.StatusNotFound, "", "invalid workspace id %q, my ID %q", workspaceID, myWorkspaceID)
+	}
 
-		response := &ListUsersResponse{
-			Schemas: []string{
-				"urn:ietf:params:scim:api:messages:2.0:ListResponse",
-			},
-			TotalResults: 0,
-			Resources:    []*AADUser{},
-		}
+	scimSetting, err := s.store.GetSettingV2(ctx, storepb.SettingName_SCIM)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find scim setting")
+	}
+	if scimSetting == nil {
+		return "", errors.Errorf("cannot found scim setting")
+	}
+	payload := new(storepb.SCIMSetting)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(scimSetting.Value), payload); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal scim setting")
+	}
 
-		filter := c.QueryParam("filter")
-		if filter == "" {
-			return c.JSON(http.StatusOK, response)
-		}
+	if payload.Token != authorization {
+		return "", newError(http.StatusUnauthorized, "", "invalid authorization token")
+	}
 
-		filters, err := v1api.ParseFilter(filter)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse filter, error %v", err))
-		}
+	// The route prefix is kept in the base URL, e.g. "/hook/scim/workspaces/{workspace}".
+	workspacePath := "/workspaces/" + workspaceID
+	requestPath := c.Request().URL.Path
+	prefix := requestPath[:strings.Index(requestPath, workspacePath)+len(workspacePath)]
+	return strings.TrimSuffix(setting.ExternalUrl, "/") + prefix, nil
+}
 
-		find := &store.FindUserMessage{}
-		for _, expr := range filters {
-			if expr.Operator != v1api.ComparatorTypeEqual {
-				slog.Warn("unsupport filter operation", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			if expr.Key != "userName" {
-				slog.Warn("unsupport filter key", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			find.Email = &expr.Value
-		}
+// sourceFromUserAgent returns the source of the users and groups provisioned by the SCIM client.
+// The clients other than Okta and JumpCloud are regarded as Entra ID, which was the first supported client.
+func sourceFromUserAgent(userAgent string) string {
+	userAgent = strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(userAgent, "okta"):
+		return oktaSource
+	case strings.Contains(userAgent, "jumpcloud"):
+		return jumpCloudSource
+	default:
+		return entraIDSource
+	}
+}
 
-		users, err := s.store.ListUsers(ctx, find)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to list user, error %v`, err))
-		}
+func (s *Service) createUser(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	resource, err := readResource(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	scimUser, err := decodeUser(resource)
+	if err != nil {
+		return 0, nil, err
+	}
+	email, err := userEmail(scimUser)
+	if err != nil {
+		return 0, nil, err
+	}
 
-		for _, user := range users {
-			if user.MemberDeleted {
-				continue
-			}
-			response.TotalResults++
-			response.Resources = append(response.Resources, convertToAADUser(user))
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to get user %s", email)
+	}
+	if user != nil {
+		if user.Type != storepb.PrincipalType_END_USER {
+			return 0, nil, newError(http.StatusConflict, scimTypeUniqueness, "%q is not an end user", email)
 		}
-
-		return c.JSON(http.StatusOK, response)
-	})
-
-	g.DELETE("/workspaces/:workspaceID/Users/:userID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+		// The existing user is taken over by the SCIM client, and the deleted user is re-activated.
+		user, err = s.updateUser(ctx, user, scimUser, resource, sourceFromUserAgent(c.Request().UserAgent()))
+		if err != nil {
+			return 0, nil, err
 		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+	} else {
+		if err := s.validateUserEmail(ctx, email); err != nil {
+			return 0, nil, err
 		}
-
-		uid, err := strconv.Atoi(c.Param("userID"))
+		password, err := common.RandomString(20)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
+			return 0, nil, errors.Wrapf(err, "failed to generate random password")
 		}
-
-		user, err := s.store.GetUserByID(ctx, uid)
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-		}
-		if user == nil || user.MemberDeleted {
-			return c.String(http.StatusNoContent, "")
+			return 0, nil, errors.Wrapf(err, "failed to generate password hash")
+		}
+		user, err = s.store.CreateUser(ctx, &store.UserMessage{
+			Name:          userDisplayName(scimUser, email),
+			Email:         email,
+			Phone:         primaryValue(scimUser.PhoneNumbers),
+			Type:          storepb.PrincipalType_END_USER,
+			MemberDeleted: isPresent(resource, "active") && !scimUser.Active,
+			PasswordHash:  string(passwordHash),
+			Profile: &storepb.UserProfile{
+				Source: sourceFromUserAgent(c.Request().UserAgent()),
+			},
+		})
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to create user %q", email)
 		}
+	}
 
-		deleteUser := true
-		if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
-			Delete: &deleteUser,
-		}); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to delete user, error %v", err))
-		}
+	response := convertToSCIMUser(baseURL, user)
+	c.Response().Header().Set("Location", response.Meta.Location)
+	return http.StatusCreated, response, nil
+}
 
-		return c.String(http.StatusNoContent, "")
-	})
+func (s *Service) getUser(c echo.Context, baseURL string) (int, any, error) {
+	user, err := s.findUser(c.Request().Context(), c.Param("id"), false /* showDeleted */)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, projectResource(c, convertToSCIMUser(baseURL, user)), nil
+}
 
-	g.PATCH("/workspaces/:workspaceID/Users/:userID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+func (s *Service) listUsers(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	filter, startIndex, count, err := parseListParams(c)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	endUser := storepb.PrincipalType_END_USER
+	find := &store.FindUserMessage{Type: &endUser}
+	if filter != nil {
+		if email, ok := equalityValue(filter, "userName"); ok {
+			email = strings.ToLower(email)
+			find.Email = &email
 		}
+	}
+	users, err := s.store.ListUsers(ctx, find)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to list users")
+	}
 
-		body, err := io.ReadAll(c.Request().Body)
+	var resources []any
+	for _, user := range users {
+		resource, err := toResource(convertToSCIMUser(baseURL, user))
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
+			return 0, nil, err
 		}
-
-		var patch PatchRequest
-		if err := json.Unmarshal(body, &patch); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
+		if filter == nil || filter.match(resource) {
+			resources = append(resources, resource)
 		}
+	}
+	return http.StatusOK, paginate(c, resources, startIndex, count), nil
+}
 
-		uid, err := strconv.Atoi(c.Param("userID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-		}
+func (s *Service) replaceUser(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"), true /* showDeleted */)
+	if err != nil {
+		return 0, nil, err
+	}
+	resource, err := readResource(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	scimUser, err := decodeUser(resource)
+	if err != nil {
+		return 0, nil, err
+	}
+	updatedUser, err := s.updateUser(ctx, user, scimUser, resource, sourceFromUserAgent(c.Request().UserAgent()))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, convertToSCIMUser(baseURL, updatedUser), nil
+}
 
-		user, err := s.store.GetUserByID(ctx, uid)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-		}
-		if user == nil {
-			return c.String(http.StatusNotFound, "cannot found user")
-		}
+func (s *Service) patchUser(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"), true /* showDeleted */)
+	if err != nil {
+		return 0, nil, err
+	}
+	patch, err := readPatchRequest(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	resource, err := toResource(convertToSCIMUser(baseURL, user))
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := applyPatch(resource, patch.Operations); err != nil {
+		return 0, nil, err
+	}
+	scimUser, err := decodeUser(resource)
+	if err != nil {
+		return 0, nil, err
+	}
+	updatedUser, err := s.updateUser(ctx, user, scimUser, resource, sourceFromUserAgent(c.Request().UserAgent()))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, convertToSCIMUser(baseURL, updatedUser), nil
+}
 
-		updateUser := &store.UpdateUserMessage{}
-		for _, op := range patch.Operations {
-			if op.OP != "Replace" {
-				slog.Warn("unsupport operation type", slog.String("operation", op.OP), slog.String("path", op.Path))
-				continue
-			}
-			switch op.Path {
-			case "displayName":
-				displayName, ok := op.Value.(string)
-				if !ok {
-					slog.Warn("unsupport value, expect string", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				updateUser.Name = &displayName
-			case "userName":
-				email, ok := op.Value.(string)
-				if !ok {
-					slog.Warn("unsupport value, expect string", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				updateUser.Email = &email
-			case "active":
-				active, ok := op.Value.(bool)
-				if !ok {
-					slog.Warn("unsupport value, expect bool", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				isDelete := !active
-				updateUser.Delete = &isDelete
-			default:
-				slog.Warn("unsupport patch", slog.String("operation", op.OP), slog.String("path", op.Path))
-			}
-		}
+func (s *Service) deleteUser(c echo.Context, _ string) (int, any, error) {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"), false /* showDeleted */)
+	if err != nil {
+		return 0, nil, err
+	}
+	deleteUser := true
+	if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
+		Delete: &deleteUser,
+	}); err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to delete user")
+	}
+	return http.StatusNoContent, nil, nil
+}
 
-		updatedUser, err := s.store.UpdateUser(ctx, user, updateUser)
+// findUser returns the end user by the uid, or the not found error.
+func (s *Service) findUser(ctx context.Context, id string, showDeleted bool) (*store.UserMessage, error) {
+	uid, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "", "user %q not found", id)
+	}
+	user, err := s.store.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user")
+	}
+	if user == nil || user.Type != storepb.PrincipalType_END_USER || (user.MemberDeleted && !showDeleted) {
+		return nil, newError(http.StatusNotFound, "", "user %q not found", id)
+	}
+	return user, nil
+}
+
+// updateUser updates the user with the SCIM user, whose active attribute is only applied if present in the resource.
+func (s *Service) updateUser(ctx context.Context, user *store.UserMessage, scimUser *User, resource map[string]any, source string) (*store.UserMessage, error) {
+	email, err := userEmail(scimUser)
+	if err != nil {
+		return nil, err
+	}
+	patch := &store.UpdateUserMessage{}
+	if email != user.Email {
+		if err := s.validateUserEmail(ctx, email); err != nil {
+			return nil, err
+		}
+		existing, err := s.store.GetUserByEmail(ctx, email)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to update user, error %v", err))
+			return nil, errors.Wrapf(err, "failed to get user %s", email)
 		}
+		if existing != nil {
+			return nil, newError(http.StatusConflict, scimTypeUniqueness, "user %q already exists", email)
+		}
+		patch.Email = &email
+	}
+	if name := userDisplayName(scimUser, email); name != user.Name {
+		patch.Name = &name
+	}
+	if phone := primaryValue(scimUser.PhoneNumbers); phone != user.Phone {
+		patch.Phone = &phone
+	}
+	if isPresent(resource, "active") && scimUser.Active == user.MemberDeleted {
+		deleted := !scimUser.Active
+		patch.Delete = &deleted
+	}
+	profile := &storepb.UserProfile{}
+	if user.Profile != nil {
+		profile, _ = proto.Clone(user.Profile).(*storepb.UserProfile)
+	}
+	if profile.Source == "" {
+		profile.Source = source
+	}
+	patch.Profile = profile
 
-		return c.JSON(http.StatusOK, convertToAADUser(updatedUser))
-	})
+	updatedUser, err := s.store.UpdateUser(ctx, user, patch)
+	if err != nil {
+		return nil, errors.Wrapf(err, `failed to update user "%s"`, user.Email)
+	}
+	return updatedUser, nil
+}
 
-	g.POST("/workspaces/:workspaceID/Groups", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
+func (s *Service) createGroup(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	resource, err := readResource(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	scimGroup, err := decodeGroup(resource)
+	if err != nil {
+		return 0, nil, err
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	email, err := groupEmail(scimGroup, setting.Domains)
+	if err != nil {
+		return 0, nil, err
+	}
+	existing, err := s.store.GetGroup(ctx, email)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to find group")
+	}
+	if existing != nil {
+		return 0, nil, newError(http.StatusConflict, scimTypeUniqueness, "group %q already exists", email)
+	}
+	members, err := s.convertToGroupMembers(ctx, nil, scimGroup.Members)
+	if err != nil {
+		return 0, nil, err
+	}
 
-		body, err := io.ReadAll(c.Request().Body)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
+	// Entra ID creates the group without members and then patches the group with members,
+	// while Okta and JumpCloud may create the group with members.
+	group, err := s.store.CreateGroup(ctx, &store.GroupMessage{
+		Email: email,
+		Title: scimGroup.DisplayName,
+		Payload: &storepb.GroupPayload{
+			Members: members,
+			Source:  sourceFromUserAgent(c.Request().UserAgent()),
+		},
+	})
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to create group")
+	}
+	if len(members) > 0 {
+		if err := s.iamManager.ReloadCache(ctx); err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to reload iam cache")
 		}
+	}
 
-		var aadGroup AADGroup
-		if err := json.Unmarshal(body, &aadGroup); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
-		}
+	response, err := s.convertToSCIMGroup(ctx, baseURL, group)
+	if err != nil {
+		return 0, nil, err
+	}
+	c.Response().Header().Set("Location", response.Meta.Location)
+	return http.StatusCreated, response, nil
+}
 
-		// Azure SCIM sync group process:
-		// 1. POST users
-		// 2. POST group without members
-		// 3. PATCH group with members
-		group, err := s.store.CreateGroup(ctx, &store.GroupMessage{
-			Email: aadGroup.ExternalID,
-			Title: aadGroup.DisplayName,
-			Payload: &storepb.GroupPayload{
-				Source: entraIDSource,
-			},
-		})
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to create group, error %v", err))
-		}
+func (s *Service) getGroup(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	scimGroup, err := s.convertToSCIMGroup(ctx, baseURL, group)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, projectResource(c, scimGroup), nil
+}
 
-		return c.JSON(http.StatusCreated, convertToAADGroup(group))
-	})
+func (s *Service) listGroups(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	filter, startIndex, count, err := parseListParams(c)
+	if err != nil {
+		return 0, nil, err
+	}
 
-	// Get a single group. The group id is the Bytebase group resource id.
-	g.GET("/workspaces/:workspaceID/Groups/:groupID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+	find := &store.FindGroupMessage{}
+	if filter != nil {
+		for _, attr := range []string{"id", "externalId"} {
+			if email, ok := equalityValue(filter, attr); ok {
+				find.Email = &email
+			}
 		}
+	}
+	groups, err := s.store.ListGroups(ctx, find)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to list groups")
+	}
 
-		email, err := decodeGroupEmail(c.Param("groupID"))
+	var resources []any
+	for _, group := range groups {
+		scimGroup, err := s.convertToSCIMGroup(ctx, baseURL, group)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+			return 0, nil, err
 		}
-
-		group, err := s.store.GetGroup(ctx, email)
+		resource, err := toResource(scimGroup)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to find group, error %v", err))
+			return 0, nil, err
 		}
-		if group == nil {
-			return c.JSON(http.StatusNotFound, map[string]any{
-				"schemas": []string{
-					"urn:ietf:params:scim:api:messages:2.0:Error",
-				},
-				"status": "404",
-			})
+		if filter == nil || filter.match(resource) {
+			resources = append(resources, resource)
 		}
+	}
+	return http.StatusOK, paginate(c, resources, startIndex, count), nil
+}
+
+func (s *Service) replaceGroup(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	group, err := s.findSCIMGroup(ctx, c.Param("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	resource, err := readResource(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	scimGroup, err := decodeGroup(resource)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.updateGroup(c, baseURL, group, scimGroup)
+}
+
+func (s *Service) patchGroup(c echo.Context, baseURL string) (int, any, error) {
+	ctx := c.Request().Context()
+	group, err := s.findSCIMGroup(ctx, c.Param("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	patch, err := readPatchRequest(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	current, err := s.convertToSCIMGroup(ctx, baseURL, group)
+	if err != nil {
+		return 0, nil, err
+	}
+	resource, err := toResource(current)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := applyPatch(resource, patch.Operations); err != nil {
+		return 0, nil, err
+	}
+	scimGroup, err := decodeGroup(resource)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.updateGroup(c, baseURL, group, scimGroup)
+}
 
-		return c.JSON(http.StatusOK, convertToAADGroup(group))
+func (s *Service) updateGroup(c echo.Context, baseURL string, group *store.GroupMessage, scimGroup *Group) (int, any, error) {
+	ctx := c.Request().Context()
+	members, err := s.convertToGroupMembers(ctx, group.Payload.Members, scimGroup.Members)
+	if err != nil {
+		return 0, nil, err
+	}
+	payload, _ := proto.Clone(group.Payload).(*storepb.GroupPayload)
+	payload.Members = members
+
+	updatedGroup, err := s.store.UpdateGroup(ctx, group.Email, &store.UpdateGroupMessage{
+		Title:   &scimGroup.DisplayName,
+		Payload: payload,
 	})
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to update group")
+	}
+	// Reload IAM cache to make sure the group members are updated.
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to reload iam cache")
+	}
 
-	// List groups. AAD SCIM will send ?filter=externalId eq "{group email}" query
-	g.GET("/workspaces/:workspaceID/Groups", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
+	response, err := s.convertToSCIMGroup(ctx, baseURL, updatedGroup)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, response, nil
+}
 
-		response := &ListGroupsResponse{
-			Schemas: []string{
-				"urn:ietf:params:scim:api:messages:2.0:ListResponse",
-			},
-			TotalResults: 0,
-			Resources:    []*AADGroup{},
-		}
+func (s *Service) deleteGroup(c echo.Context, _ string) (int, any, error) {
+	ctx := c.Request().Context()
+	group, err := s.findSCIMGroup(ctx, c.Param("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := s.store.DeleteGroup(ctx, group.Email); err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to delete group")
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to reload iam cache")
+	}
+	return http.StatusNoContent, nil, nil
+}
 
-		filter := c.QueryParam("filter")
-		if filter == "" {
-			return c.JSON(http.StatusOK, response)
-		}
+// findGroup returns the group by the id, which is the URL encoded group email, or the not found error.
+func (s *Service) findGroup(ctx context.Context, id string) (*store.GroupMessage, error) {
+	email, err := url.QueryUnescape(id)
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	group, err := s.store.GetGroup(ctx, email)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find group")
+	}
+	if group == nil {
+		return nil, newError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	return group, nil
+}
 
-		filters, err := v1api.ParseFilter(filter)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse filter, error %v", err))
-		}
+// findSCIMGroup returns the group by the id like findGroup, or the conflict error if the group is not provisioned by SCIM.
+// The groups created in Bytebase or synchronized from LDAP can be read but not modified by the SCIM client.
+func (s *Service) findSCIMGroup(ctx context.Context, id string) (*store.GroupMessage, error) {
+	group, err := s.findGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSCIMGroup(group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
 
-		find := &store.FindGroupMessage{}
-		for _, expr := range filters {
-			if expr.Operator != v1api.ComparatorTypeEqual {
-				slog.Warn("unsupport filter operation", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			if expr.Key != "externalId" {
-				slog.Warn("unsupport filter key", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			find.Email = &expr.Value
-		}
+// checkSCIMGroup returns the conflict error if the group is not provisioned by SCIM.
+func checkSCIMGroup(group *store.GroupMessage) error {
+	switch group.Payload.GetSource() {
+	case entraIDSource, oktaSource, jumpCloudSource:
+		return nil
+	default:
+		return newError(http.StatusConflict, "", "group %q is not provisioned by SCIM and cannot be modified", group.Email)
+	}
+}
 
-		groups, err := s.store.ListGroups(ctx, find)
+// convertToGroupMembers converts the SCIM members to the group members, keeping the roles of the existing members.
+// The members which are not Bytebase users are ignored.
+func (s *Service) convertToGroupMembers(ctx context.Context, existing []*storepb.GroupMember, scimMembers []*MultiValuedAttribute) ([]*storepb.GroupMember, error) {
+	roles := map[string]storepb.GroupMember_Role{}
+	for _, member := range existing {
+		roles[member.Member] = member.Role
+	}
+
+	var members []*storepb.GroupMember
+	seen := map[string]bool{}
+	for _, scimMember := range scimMembers {
+		uid, err := strconv.Atoi(scimMember.Value)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to list group, error %v`, err))
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid member %q", scimMember.Value)
 		}
-
-		for _, group := range groups {
-			response.TotalResults++
-			response.Resources = append(response.Resources, convertToAADGroup(group))
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user")
 		}
-
-		return c.JSON(http.StatusOK, response)
-	})
-
-	g.DELETE("/workspaces/:workspaceID/Groups/:groupID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+		if user == nil {
+			continue
 		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+		member := common.FormatUserUID(user.ID)
+		if seen[member] {
+			continue
+		}
+		seen[member] = true
+		role, ok := roles[member]
+		if !ok {
+			role = storepb.GroupMember_MEMBER
 		}
+		members = append(members, &storepb.GroupMember{
+			Member: member,
+			Role:   role,
+		})
+	}
+	return members, nil
+}
 
-		email, err := decodeGroupEmail(c.Param("groupID"))
+func (s *Service) convertToSCIMGroup(ctx context.Context, baseURL string, group *store.GroupMessage) (*Group, error) {
+	members := []*MultiValuedAttribute{}
+	for _, member := range group.Payload.GetMembers() {
+		uid, err := common.GetUserID(member.Member)
+		if err != nil {
+			continue
+		}
+		user, err := s.store.GetUserByID(ctx, uid)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+			return nil, errors.Wrapf(err, "failed to get user")
 		}
+		if user == nil {
+			continue
+		}
+		members = append(members, &MultiValuedAttribute{
+			Value:   strconv.Itoa(user.ID),
+			Display: user.Name,
+			Type:    "User",
+			Ref:     fmt.Sprintf("%s/Users/%d", baseURL, user.ID),
+		})
+	}
+	return &Group{
+		Schemas:     []string{schemaGroup},
+		ID:          group.Email,
+		ExternalID:  group.Email,
+		DisplayName: group.Title,
+		Members:     members,
+		Meta: &ResourceMeta{
+			ResourceType: "Group",
+			Location:     fmt.Sprintf("%s/Groups/%s", baseURL, url.PathEscape(group.Email)),
+		},
+	}, nil
+}
 
-		if err := s.store.DeleteGroup(ctx, email); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to delete group, error %v", err))
+func convertToSCIMUser(baseURL string, user *store.UserMessage) *User {
+	scimUser := &User{
+		Schemas:     []string{schemaUser},
+		ID:          strconv.Itoa(user.ID),
+		UserName:    user.Email,
+		Name:        &UserName{Formatted: user.Name},
+		DisplayName: user.Name,
+		Active:      !user.MemberDeleted,
+		Emails: []*MultiValuedAttribute{
+			{
+				Type:    "work",
+				Primary: true,
+				Value:   user.Email,
+			},
+		},
+		Meta: &ResourceMeta{
+			ResourceType: "User",
+			Location:     fmt.Sprintf("%s/Users/%d", baseURL, user.ID),
+		},
+	}
+	if !user.CreatedAt.IsZero() {
+		scimUser.Meta.Created = user.CreatedAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if user.Phone != "" {
+		scimUser.PhoneNumbers = []*MultiValuedAttribute{
+			{
+				Type:    "work",
+				Primary: true,
+				Value:   user.Phone,
+			},
 		}
+	}
+	return scimUser
+}
 
-		if err := s.iamManager.ReloadCache(ctx); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to reload iam cache, error %v", err))
+// userEmail returns the email of the SCIM user, which is the userName or the primary email.
+func userEmail(user *User) (string, error) {
+	for _, candidate := range []string{user.UserName, primaryValue(user.Emails)} {
+		if email := strings.ToLower(strings.TrimSpace(candidate)); isEmail(email) {
+			return email, nil
 		}
+	}
+	return "", newError(http.StatusBadRequest, scimTypeInvalidValue, "userName %q is not an email", user.UserName)
+}
 
-		return c.JSON(http.StatusNoContent, "")
-	})
+// validateUserEmail validates the email of the user as the user service does,
+// the email must belong to the workspace domains if the domain restriction is enforced.
+func (s *Service) validateUserEmail(ctx context.Context, email string) error {
+	if s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_USER_EMAIL_DOMAIN_RESTRICTION) != nil {
+		return nil
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace setting")
+	}
+	return checkEmailDomain(email, setting)
+}
 
-	g.PATCH("/workspaces/:workspaceID/Groups/:groupID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+// checkEmailDomain returns the error if the domain restriction is enforced and the email doesn't belong to any workspace domain.
+func checkEmailDomain(email string, setting *storepb.WorkspaceProfileSetting) error {
+	if !setting.GetEnforceIdentityDomain() || len(setting.GetDomains()) == 0 {
+		return nil
+	}
+	for _, domain := range setting.GetDomains() {
+		if strings.HasSuffix(email, fmt.Sprintf("@%s", domain)) {
+			return nil
 		}
+	}
+	return newError(http.StatusBadRequest, scimTypeInvalidValue, "email %q does not belong to domains %v", email, setting.GetDomains())
+}
 
-		body, err := io.ReadAll(c.Request().Body)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
+func userDisplayName(user *User, email string) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	if user.Name != nil {
+		if user.Name.Formatted != "" {
+			return user.Name.Formatted
 		}
-
-		var patch PatchRequest
-		if err := json.Unmarshal(body, &patch); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
+		if name := strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName); name != "" {
+			return name
 		}
+	}
+	return email
+}
 
-		email, err := decodeGroupEmail(c.Param("groupID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
+var nonEmailCharacters = regexp.MustCompile(`[^a-z0-9._-]+`)
 
-		group, err := s.store.GetGroup(ctx, email)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to find group, error %v", err))
-		}
-		if group == nil {
-			return c.String(http.StatusNotFound, "cannot found group")
-		}
-
-		updateGroup := &store.UpdateGroupMessage{}
-		for _, op := range patch.Operations {
-			switch op.Path {
-			case "members":
-				values, ok := op.Value.([]any)
-				if !ok {
-					slog.Warn("unsupport value, expect PatchMember slice", slog.Any("value", op.Value), slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-
-				updateGroup.Payload = group.Payload
-				updateGroup.Payload.Source = entraIDSource
-
-				for _, value := range values {
-					var patchMember PatchMember
-					bytes, err := json.Marshal(value)
-					if err != nil {
-						slog.Warn("failed to marshal patch member", slog.Any("value", value), slog.String("operation", op.OP), slog.String("path", op.Path), log.BBError(err))
-						continue
-					}
-					if err := json.Unmarshal(bytes, &patchMember); err != nil {
-						slog.Warn("failed to unmarshal patch member", slog.Any("value", value), slog.String("operation", op.OP), slog.String("path", op.Path), log.BBError(err))
-						continue
-					}
-
-					// the member identifier in group patch is Bytebase user uid
-					uid, err := strconv.Atoi(patchMember.Value)
-					if err != nil {
-						return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-					}
-					user, err := s.store.GetUserByID(ctx, uid)
-					if err != nil {
-						return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-					}
-					if user == nil {
-						slog.Warn("cannot found user", slog.String("operation", op.OP), slog.String("uid", patchMember.Value))
-						continue
-					}
-
-					member := &storepb.GroupMember{
-						Member: common.FormatUserUID(user.ID),
-						Role:   storepb.GroupMember_MEMBER,
-					}
-					index := slices.IndexFunc(group.Payload.Members, func(m *storepb.GroupMember) bool {
-						return m.Member == member.Member
-					})
-					switch op.OP {
-					case "Add":
-						if index < 0 {
-							updateGroup.Payload.Members = append(updateGroup.Payload.Members, member)
-						}
-					case "Remove":
-						if index >= 0 {
-							updateGroup.Payload.Members = slices.Delete(updateGroup.Payload.Members, index, index+1)
-						}
-					default:
-						slog.Warn("unsupport operation type", slog.String("operation", op.OP), slog.String("path", op.Path))
-						continue
-					}
-				}
-			case "displayName":
-				if op.OP != "Replace" {
-					slog.Warn("unsupport operation type", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				displayName, ok := op.Value.(string)
-				if !ok {
-					slog.Warn("unsupport value, expect string", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				updateGroup.Title = &displayName
-			default:
-				slog.Warn("unsupport patch", slog.String("operation", op.OP), slog.String("path", op.Path))
-			}
+// groupEmail returns the email of the SCIM group, which is the externalId or the displayName if either is an email.
+// Otherwise, the email is generated from the displayName in the first workspace domain, because Okta and JumpCloud
+// only send the displayName.
+func groupEmail(group *Group, domains []string) (string, error) {
+	for _, candidate := range []string{group.ExternalID, group.DisplayName} {
+		if email := strings.ToLower(strings.TrimSpace(candidate)); isEmail(email) {
+			return email, nil
 		}
+	}
+	localPart := strings.Trim(nonEmailCharacters.ReplaceAllString(strings.ToLower(group.DisplayName), "-"), "-.")
+	if localPart == "" || len(domains) == 0 {
+		return "", newError(http.StatusBadRequest, scimTypeInvalidValue, "either externalId or displayName must be an email if the workspace domain is not set")
+	}
+	return fmt.Sprintf("%s@%s", localPart, domains[0]), nil
+}
 
-		updatedGroup, err := s.store.UpdateGroup(ctx, group.Email, updateGroup)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to update group, error %v", err))
-		}
-		// Reload IAM cache to make sure the group members are updated.
-		if err := s.iamManager.ReloadCache(ctx); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to reload iam cache, error %v", err))
+// isEmail reports whether the string is a bare email address without the display name.
+func isEmail(s string) bool {
+	address, err := mail.ParseAddress(s)
+	return err == nil && address.Address == s
+}
+
+// primaryValue returns the value of the primary attribute, or the first one if none is primary.
+func primaryValue(values []*MultiValuedAttribute) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
 		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
 
-		return c.JSON(http.StatusOK, convertToAADGroup(updatedGroup))
-	})
+func readResource(c echo.Context) (map[string]any, error) {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read body")
+	}
+	var resource map[string]any
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidSyntax, "failed to unmarshal body, error %v", err)
+	}
+	return resource, nil
 }
 
-func (s *Service) validRequestURL(ctx context.Context, c echo.Context) error {
-	authorization := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
-	if authorization == "" {
-		return errors.Errorf("missing authorization token")
+func readPatchRequest(c echo.Context) (*PatchRequest, error) {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read body")
+	}
+	var patch PatchRequest
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidSyntax, "failed to unmarshal body, error %v", err)
+	}
+	if len(patch.Schemas) > 0 && !slices.Contains(patch.Schemas, schemaPatchOp) {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidSyntax, "schemas must contain %q", schemaPatchOp)
 	}
+	return &patch, nil
+}
 
-	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+func toResource(v any) (map[string]any, error) {
+	bytes, err := json.Marshal(v)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if setting.ExternalUrl == "" {
-		return errors.Errorf("external URL is empty")
+	var resource map[string]any
+	if err := json.Unmarshal(bytes, &resource); err != nil {
+		return nil, err
 	}
+	return resource, nil
+}
 
-	workspaceID := c.Param("workspaceID")
+func decodeUser(resource map[string]any) (*User, error) {
+	// Entra ID may send the boolean as the string, e.g. "False".
+	if key := lookupKey(resource, "active"); resource[key] != nil {
+		if s, ok := resource[key].(string); ok {
+			active, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid active %q", s)
+			}
+			resource[key] = active
+		}
+	}
+	user := &User{}
+	if err := decodeResource(resource, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
 
-	myWorkspaceID, err := s.store.GetWorkspaceID(ctx)
+func decodeGroup(resource map[string]any) (*Group, error) {
+	group := &Group{}
+	if err := decodeResource(resource, group); err != nil {
+		return nil, err
+	}
+	if group.DisplayName == "" {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "displayName is required")
+	}
+	return group, nil
+}
+
+func decodeResource(resource map[string]any, v any) error {
+	bytes, err := json.Marshal(resource)
 	if err != nil {
 		return err
 	}
-	if myWorkspaceID != workspaceID {
-		return errors.Errorf("invalid workspace id %q, my ID %q", workspaceID, myWorkspaceID)
+	if err := json.Unmarshal(bytes, v); err != nil {
+		return newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid resource, error %v", err)
 	}
+	return nil
+}
 
-	scimSetting, err := s.store.GetSettingV2(ctx, storepb.SettingName_SCIM)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find scim setting")
+func isPresent(resource map[string]any, attr string) bool {
+	return lookup(resource, attr) != nil
+}
+
+// parseListParams parses the filter and the 1-based pagination of the list request.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.4
+func parseListParams(c echo.Context) (filterExpr, int, int, error) {
+	var filter filterExpr
+	if v := c.QueryParam("filter"); v != "" {
+		f, err := parseFilter(v)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		filter = f
 	}
-	if scimSetting == nil {
-		return errors.Errorf("cannot found scim setting")
+	startIndex := 1
+	if v := c.QueryParam("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, 0, 0, newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid startIndex %q", v)
+		}
+		// A value less than 1 is interpreted as 1.
+		startIndex = max(i, 1)
 	}
-	payload := new(storepb.SCIMSetting)
-	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(scimSetting.Value), payload); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal scim setting")
+	count := maxResults
+	if v := c.QueryParam("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, 0, 0, newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid count %q", v)
+		}
+		// A negative value is interpreted as 0.
+		count = min(max(i, 0), maxResults)
 	}
+	return filter, startIndex, count, nil
+}
 
-	if payload.Token != authorization {
-		return errors.Errorf("invalid authorization token")
+func paginate(c echo.Context, resources []any, startIndex, count int) *ListResponse {
+	total := len(resources)
+	start := min(startIndex-1, total)
+	end := min(start+count, total)
+	page := resources[start:end]
+	for i, resource := range page {
+		page[i] = projectResource(c, resource)
 	}
-
-	return nil
+	response := newListResponse(page, startIndex)
+	response.TotalResults = total
+	return response
 }
 
-func decodeGroupEmail(groupID string) (string, error) {
-	email, err := url.QueryUnescape(groupID)
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to decode group id %s", groupID)
+func newListResponse(resources []any, startIndex int) *ListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+	return &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
 	}
-	return email, nil
 }
 
-func convertToAADUser(user *store.UserMessage) *AADUser {
-	return &AADUser{
-		Schemas: []string{
-			"urn:ietf:params:scim:schemas:core:2.0:User",
-		},
-		UserName:    user.Email,
-		Active:      !user.MemberDeleted,
-		DisplayName: user.Name,
-		ID:          fmt.Sprintf("%d", user.ID),
-		Emails: []*AADUserEmail{
-			{
-				Type:    "work",
-				Primary: true,
-				Value:   user.Email,
-			},
-		},
-		Meta: &AADResourceMeta{
-			ResourceType: "User",
-		},
+// projectResource applies the "attributes" and "excludedAttributes" query parameters to the resource.
+// The "id", "schemas" and "meta" attributes are always returned.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.9
+func projectResource(c echo.Context, resource any) any {
+	attributes, excludedAttributes := c.QueryParam("attributes"), c.QueryParam("excludedAttributes")
+	if attributes == "" && excludedAttributes == "" {
+		return resource
+	}
+	m, ok := resource.(map[string]any)
+	if !ok {
+		var err error
+		if m, err = toResource(resource); err != nil {
+			return resource
+		}
 	}
+	return projectAttributes(m, attributes, excludedAttributes)
 }
 
-func convertToAADGroup(group *store.GroupMessage) *AADGroup {
-	return &AADGroup{
-		Schemas: []string{
-			"urn:ietf:params:scim:schemas:core:2.0:Group",
-		},
-		ID:          group.Email,
-		ExternalID:  group.Email,
-		DisplayName: group.Title,
-		Meta: &AADResourceMeta{
-			ResourceType: "Group",
-		},
+func projectAttributes(resource map[string]any, attributes, excludedAttributes string) map[string]any {
+	alwaysReturned := map[string]bool{"id": true, "schemas": true, "meta": true}
+	if attributes != "" {
+		included := map[string]bool{}
+		for _, attribute := range strings.Split(attributes, ",") {
+			attr, _ := parseAttrPath(strings.TrimSpace(attribute))
+			included[strings.ToLower(attr)] = true
+		}
+		for key := range resource {
+			if !alwaysReturned[key] && !included[strings.ToLower(key)] {
+				delete(resource, key)
+			}
+		}
+		return resource
+	}
+	for _, attribute := range strings.Split(excludedAttributes, ",") {
+		attr, _ := parseAttrPath(strings.TrimSpace(attribute))
+		if key := lookupKey(resource, attr); !alwaysReturned[key] {
+			delete(resource, key)
+		}
 	}
+	return resource
 }
@@ -0,0 +1,189 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// handleTextDocumentCodeAction returns the quick fixes of the SQL review advices in the range.
+// The document is reviewed with the fixable SQL review rules of the connected database.
+func (h *Handler) handleTextDocumentCodeAction(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.CodeActionParams) ([]lsp.CodeAction, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/codeAction not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	if len(params.Context.Only) > 0 && !slices.Contains(params.Context.Only, lsp.QuickFix) {
+		return []lsp.CodeAction{}, nil
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	advices, err := h.checkFixableRules(ctx, string(content))
+	if err != nil {
+		slog.Warn("failed to check the fixable SQL review rules", log.BBError(err))
+		return []lsp.CodeAction{}, nil
+	}
+	return convertAdvicesToCodeActions(params.TextDocument.URI, string(content), params.Range, advices), nil
+}
+
+// checkFixableRules reviews the statement with the fixable SQL review rules of the connected database.
+func (h *Handler) checkFixableRules(ctx context.Context, statement string) ([]*storepb.Advice, error) {
+	instanceID := h.getInstanceID()
+	databaseName := h.getDefaultDatabase()
+	if instanceID == "" || databaseName == "" {
+		return nil, nil
+	}
+	instance, err := h.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance")
+	}
+	if instance == nil {
+		return nil, nil
+	}
+	engine := instance.Metadata.GetEngine()
+	if !common.EngineSupportSQLReview(engine) {
+		return nil, nil
+	}
+	database, err := h.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database")
+	}
+	if database == nil {
+		return nil, nil
+	}
+
+	reviewConfig, err := h.store.GetReviewConfigForDatabase(ctx, database)
+	if err != nil {
+		if e, ok := err.(*common.Error); ok && e.Code == common.NotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to get SQL review policy")
+	}
+	rules := advisor.GetFixableRules(reviewConfig.SqlReviewRules)
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	dbSchema, err := h.store.GetDBSchema(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database schema")
+	}
+	if dbSchema == nil {
+		return nil, nil
+	}
+	dbMetadata := dbSchema.GetMetadata()
+	catalog, err := catalog.NewCatalog(ctx, h.store, database.InstanceID, database.DatabaseName, engine, store.IsObjectCaseSensitive(instance), dbMetadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a catalog")
+	}
+
+	return advisor.SQLReviewCheck(ctx, h.sheetManager, statement, rules, advisor.SQLReviewCheckContext{
+		Charset:               dbMetadata.CharacterSet,
+		Collation:             dbMetadata.Collation,
+		ChangeType:            storepb.PlanCheckRunConfig_DDL,
+		DBSchema:              dbMetadata,
+		DBType:                engine,
+		Catalog:               catalog,
+		CurrentDatabase:       database.DatabaseName,
+		InstanceID:            instance.ResourceID,
+		IsObjectCaseSensitive: store.IsObjectCaseSensitive(instance),
+		// The builtin rules come without fixes.
+		NoAppendBuiltin: true,
+	})
+}
+
+func convertAdvicesToCodeActions(uri lsp.DocumentURI, content string, r lsp.Range, advices []*storepb.Advice) []lsp.CodeAction {
+	codeActions := []lsp.CodeAction{}
+	for _, advice := range advices {
+		if len(advice.Fixes) == 0 {
+			continue
+		}
+		diagnostic := convertAdviceToDiagnostic(content, advice)
+		if !isRangeOverlapped(diagnostic.Range, r) {
+			continue
+		}
+		for i, fix := range advice.Fixes {
+			var textEdits []lsp.TextEdit
+			for _, edit := range fix.Edits {
+				textEdits = append(textEdits, lsp.TextEdit{
+					Range: lsp.Range{
+						Start: *common.ConvertPositionToUTF16Position(edit.StartPosition, content),
+						End:   *common.ConvertPositionToUTF16Position(edit.EndPosition, content),
+					},
+					NewText: edit.NewText,
+				})
+			}
+			if len(textEdits) == 0 {
+				continue
+			}
+			codeActions = append(codeActions, lsp.CodeAction{
+				Title:       fix.Title,
+				Kind:        lsp.QuickFix,
+				Diagnostics: []lsp.Diagnostic{diagnostic},
+				// The first fix is the preferred one of the advice.
+				IsPreferred: i == 0,
+				Edit: &lsp.WorkspaceEdit{
+					Changes: map[lsp.DocumentURI][]lsp.TextEdit{
+						uri: textEdits,
+					},
+				},
+			})
+		}
+	}
+	return codeActions
+}
+
+func convertAdviceToDiagnostic(content string, advice *storepb.Advice) lsp.Diagnostic {
+	start := *common.ConvertPositionToUTF16Position(advice.StartPosition, content)
+	end := start
+	if advice.EndPosition != nil {
+		end = *common.ConvertPositionToUTF16Position(advice.EndPosition, content)
+	}
+	severity := lsp.SeverityWarning
+	if advice.Status == storepb.Advice_ERROR {
+		severity = lsp.SeverityError
+	}
+	return lsp.Diagnostic{
+		Range: lsp.Range{
+			Start: start,
+			End:   end,
+		},
+		Severity: severity,
+		Code:     advice.Title,
+		Source:   "SQL review",
+		Message:  advice.Content,
+	}
+}
+
+// isRangeOverlapped returns true if the two ranges overlap or touch each other.
+func isRangeOverlapped(a, b lsp.Range) bool {
+	return !isPositionBefore(a.End, b.Start) && !isPositionBefore(b.End, a.Start)
+}
+
+func isPositionBefore(a, b lsp.Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Character < b.Character
+}
@@ -0,0 +1,137 @@
+package lsp
+
+import (
+	"testing"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestConvertAdvicesToCodeActions(t *testing.T) {
+	uri := lsp.DocumentURI("file:///test.sql")
+	content := "SELECT '世界';\ncreate index on tech_book(id);"
+	advices := []*storepb.Advice{
+		{
+			Status:        storepb.Advice_WARNING,
+			Title:         "statement.where.require",
+			Content:       "without fixes",
+			StartPosition: &storepb.Position{Line: 0, Column: 0},
+		},
+		{
+			Status:        storepb.Advice_WARNING,
+			Title:         "index.create-concurrently",
+			Content:       "Creating indexes will block writes on the table, unless use CONCURRENTLY",
+			StartPosition: &storepb.Position{Line: 1, Column: 0},
+			EndPosition:   &storepb.Position{Line: 1, Column: 30},
+			Fixes: []*storepb.Advice_Fix{
+				{
+					Title: "Use CONCURRENTLY",
+					Edits: []*storepb.Advice_TextEdit{
+						{
+							StartPosition: &storepb.Position{Line: 1, Column: 12},
+							EndPosition:   &storepb.Position{Line: 1, Column: 12},
+							NewText:       " CONCURRENTLY",
+						},
+					},
+				},
+			},
+		},
+		{
+			Status:        storepb.Advice_ERROR,
+			Title:         "unicode",
+			Content:       "unicode",
+			StartPosition: &storepb.Position{Line: 0, Column: 0},
+			EndPosition:   &storepb.Position{Line: 0, Column: 15},
+			Fixes: []*storepb.Advice_Fix{
+				{
+					Title: "Replace",
+					Edits: []*storepb.Advice_TextEdit{
+						{
+							StartPosition: &storepb.Position{Line: 0, Column: 8},
+							EndPosition:   &storepb.Position{Line: 0, Column: 14},
+							NewText:       "hello",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Only the advices in the range come with code actions.
+	codeActions := convertAdvicesToCodeActions(uri, content, lsp.Range{
+		Start: lsp.Position{Line: 1, Character: 5},
+		End:   lsp.Position{Line: 1, Character: 5},
+	}, advices)
+	require.Equal(t, []lsp.CodeAction{
+		{
+			Title: "Use CONCURRENTLY",
+			Kind:  lsp.QuickFix,
+			Diagnostics: []lsp.Diagnostic{
+				{
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 1, Character: 0},
+						End:   lsp.Position{Line: 1, Character: 30},
+					},
+					Severity: lsp.SeverityWarning,
+					Code:     "index.create-concurrently",
+					Source:   "SQL review",
+					Message:  "Creating indexes will block writes on the table, unless use CONCURRENTLY",
+				},
+			},
+			IsPreferred: true,
+			Edit: &lsp.WorkspaceEdit{
+				Changes: map[lsp.DocumentURI][]lsp.TextEdit{
+					uri: {
+						{
+							Range: lsp.Range{
+								Start: lsp.Position{Line: 1, Character: 12},
+								End:   lsp.Position{Line: 1, Character: 12},
+							},
+							NewText: " CONCURRENTLY",
+						},
+					},
+				},
+			},
+		},
+	}, codeActions)
+
+	codeActions = convertAdvicesToCodeActions(uri, content, lsp.Range{
+		Start: lsp.Position{Line: 0, Character: 0},
+		End:   lsp.Position{Line: 0, Character: 3},
+	}, advices)
+	require.Equal(t, []lsp.CodeAction{
+		{
+			Title: "Replace",
+			Kind:  lsp.QuickFix,
+			Diagnostics: []lsp.Diagnostic{
+				{
+					// The column is the byte offset, '世界' takes 6 bytes and 2 UTF-16 code units.
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 0, Character: 0},
+						End:   lsp.Position{Line: 0, Character: 11},
+					},
+					Severity: lsp.SeverityError,
+					Code:     "unicode",
+					Source:   "SQL review",
+					Message:  "unicode",
+				},
+			},
+			IsPreferred: true,
+			Edit: &lsp.WorkspaceEdit{
+				Changes: map[lsp.DocumentURI][]lsp.TextEdit{
+					uri: {
+						{
+							Range: lsp.Range{
+								Start: lsp.Position{Line: 0, Character: 8},
+								End:   lsp.Position{Line: 0, Character: 10},
+							},
+							NewText: "hello",
+						},
+					},
+				},
+			},
+		},
+	}, codeActions)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
@@ -31,6 +32,7 @@ const (
 	LSPMethodSetTrace       Method = "$/setTrace"
 	LSPMethodExecuteCommand Method = "workspace/executeCommand"
 	LSPMethodCompletion     Method = "textDocument/completion"
+	LSPMethodCodeAction     Method = "textDocument/codeAction"
 
 	LSPMethodTextDocumentDidOpen   Method = "textDocument/didOpen"
 	LSPMethodTextDocumentDidChange Method = "textDocument/didChange"
@@ -46,8 +48,8 @@ const (
 )
 
 // NewHandler creates a new Language Server Protocol handler.
-func NewHandler(s *store.Store, profile *config.Profile) jsonrpc2.Handler {
-	return lspHandler{Handler: jsonrpc2.HandlerWithError((&Handler{store: s, profile: profile}).handle)}
+func NewHandler(s *store.Store, sheetManager *sheet.Manager, profile *config.Profile) jsonrpc2.Handler {
+	return lspHandler{Handler: jsonrpc2.HandlerWithError((&Handler{store: s, sheetManager: sheetManager, profile: profile}).handle)}
 }
 
 type lspHandler struct {
@@ -70,6 +72,8 @@ type Handler struct {
 	metadata *SetMetadataCommandArguments
 	store    *store.Store
 
+	sheetManager *sheet.Manager
+
 	shutDown bool
 	profile  *config.Profile
 	cancelF  sync.Map // map[jsonrpc2.ID]context.CancelFunc
@@ -233,6 +237,9 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 				ExecuteCommandProvider: &lsp.ExecuteCommandOptions{
 					Commands: []string{string(CommandNameSetMetadata)},
 				},
+				CodeActionProvider: &lsp.CodeActionOptions{
+					CodeActionKinds: []lsp.CodeActionKind{lsp.QuickFix},
+				},
 			},
 		}, nil
 	case LSPMethodInitialized:
@@ -310,6 +317,15 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 			h.cancelF.Delete(req.ID)
 		}()
 		return h.handleTextDocumentCompletion(childCtx, conn, req, params)
+	case LSPMethodCodeAction:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.CodeActionParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentCodeAction(ctx, conn, req, params)
 	default:
 		if isFileSystemRequest(req.Method) {
 			_, _, err := h.handleFileSystemRequest(ctx, conn, req)
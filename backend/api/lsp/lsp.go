@@ -16,13 +16,14 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/common/stacktrace"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
 var (
 	upgrader   = websocket.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }}
-	newHandler = func(s *store.Store, profile *config.Profile) (jsonrpc2.Handler, io.Closer) {
-		return NewHandler(s, profile), io.NopCloser(strings.NewReader(""))
+	newHandler = func(s *store.Store, sheetManager *sheet.Manager, profile *config.Profile) (jsonrpc2.Handler, io.Closer) {
+		return NewHandler(s, sheetManager, profile), io.NopCloser(strings.NewReader(""))
 	}
 )
 
@@ -42,7 +43,7 @@ func (s *Server) Router(c echo.Context) error {
 	})
 	connectionID := s.connectionCount.Add(1)
 
-	handler, closer := newHandler(s.store, s.profile)
+	handler, closer := newHandler(s.store, s.sheetManager, s.profile)
 	ctx := c.Request().Context()
 	<-jsonrpc2.NewConn(ctx, wsjsonrpc2.NewObjectStream(connection), handler, nil /* connOpt */).DisconnectNotify()
 	err = closer.Close()
@@ -4,6 +4,7 @@ import (
 	"sync/atomic"
 
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -11,17 +12,20 @@ import (
 type Server struct {
 	connectionCount atomic.Uint64
 
-	store   *store.Store
-	profile *config.Profile
+	store        *store.Store
+	sheetManager *sheet.Manager
+	profile      *config.Profile
 }
 
 // NewServer creates a Language Server Protocol service.
 func NewServer(
 	store *store.Store,
+	sheetManager *sheet.Manager,
 	profile *config.Profile,
 ) *Server {
 	return &Server{
-		store:   store,
-		profile: profile,
+		store:        store,
+		sheetManager: sheetManager,
+		profile:      profile,
 	}
 }
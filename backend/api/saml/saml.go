@@ -0,0 +1,133 @@
+// Package saml is the API endpoint of Bytebase as the SAML 2.0 service provider.
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	samlidp "github.com/bytebase/bytebase/backend/plugin/idp/saml"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Service is the API endpoint for handling SAML requests.
+// The SAMLResponse posted to the assertion consumer service is handed over to the frontend, which then logs in
+// with the SAML context so that the response is validated by the auth service. The login is bound to the browser
+// starting it by the request state cookie, which the auth service checks against the response.
+type Service struct {
+	store  *store.Store
+	secret string
+}
+
+// NewService creates a SAML service.
+func NewService(store *store.Store, secret string) *Service {
+	return &Service{
+		store:  store,
+		secret: secret,
+	}
+}
+
+// RegisterRoutes registers the SAML routes.
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	// Redirect the user to the identity provider with the AuthnRequest.
+	g.GET("/sso/:idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		externalURL, idp, err := s.getIdentityProvider(ctx, c.Param("idp"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		now := time.Now()
+		authnRequestURL, requestID, err := idp.AuthnRequestURL(now)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to build AuthnRequest, error %v", err))
+		}
+		state, err := samlidp.EncodeRequestState(&samlidp.RequestState{
+			IdentityProviderID: c.Param("idp"),
+			RequestID:          requestID,
+			ExpireTime:         now.Add(samlidp.RequestStateDuration),
+		}, s.secret)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to encode request state, error %v", err))
+		}
+		c.SetCookie(&http.Cookie{
+			Name:     samlidp.RequestStateCookieName,
+			Value:    state,
+			Path:     "/",
+			MaxAge:   int(samlidp.RequestStateDuration.Seconds()),
+			HttpOnly: true,
+			Secure:   strings.HasPrefix(externalURL, "https"),
+			// The cookie is only read by the login request from our own frontend.
+			SameSite: http.SameSiteLaxMode,
+		})
+		return c.Redirect(http.StatusFound, authnRequestURL)
+	})
+
+	// The service provider metadata to be uploaded to the identity provider.
+	g.GET("/metadata/:idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		_, idp, err := s.getIdentityProvider(ctx, c.Param("idp"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		metadata, err := idp.Metadata()
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to build metadata, error %v", err))
+		}
+		return c.Blob(http.StatusOK, "application/samlmetadata+xml", metadata)
+	})
+
+	// The assertion consumer service using the HTTP-POST binding.
+	// The response is passed to the frontend in the URL fragment, which is never sent to the server.
+	g.POST("/acs/:idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		externalURL, _, err := s.getIdentityProvider(ctx, c.Param("idp"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		samlResponse := c.FormValue("SAMLResponse")
+		if samlResponse == "" {
+			return c.String(http.StatusBadRequest, "missing SAMLResponse")
+		}
+		fragment := url.Values{}
+		fragment.Set("idp", fmt.Sprintf("%s%s", common.IdentityProviderNamePrefix, c.Param("idp")))
+		fragment.Set("saml_response", samlResponse)
+		return c.Redirect(http.StatusSeeOther, fmt.Sprintf("%s/saml/callback#%s", externalURL, fragment.Encode()))
+	})
+}
+
+// getIdentityProvider returns the external URL and the SAML identity provider by the resource ID.
+func (s *Service) getIdentityProvider(ctx context.Context, resourceID string) (string, *samlidp.IdentityProvider, error) {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get workspace setting")
+	}
+	if setting.ExternalUrl == "" {
+		return "", nil, errors.Errorf("external URL is empty")
+	}
+	identityProvider, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{
+		ResourceID: &resourceID,
+	})
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get identity provider")
+	}
+	if identityProvider == nil || identityProvider.Type != storepb.IdentityProviderType_SAML {
+		return "", nil, errors.Errorf("SAML identity provider %q not found", resourceID)
+	}
+	idp, err := samlidp.NewIdentityProvider(
+		identityProvider.Config.GetSamlConfig(),
+		samlidp.EntityID(setting.ExternalUrl, resourceID),
+		samlidp.ACSURL(setting.ExternalUrl, resourceID),
+	)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to create SAML identity provider")
+	}
+	return setting.ExternalUrl, idp, nil
+}
@@ -77,11 +77,11 @@ type aclStreamingConn struct {
 }
 
 func (c *aclStreamingConn) Receive(msg any) error {
-	err := c.interceptor.doACLCheck(c.ctx, msg, c.fullMethod)
-	if err != nil {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
 		return err
 	}
-	return c.StreamingHandlerConn.Receive(msg)
+	// Check the permission on the decoded request, since the resources come from the request.
+	return c.interceptor.doACLCheck(c.ctx, msg, c.fullMethod)
 }
 
 func (in *ACLInterceptor) doACLCheck(ctx context.Context, request any, fullMethod string) error {
@@ -145,6 +145,12 @@ func doIAMPermissionCheck(ctx context.Context, iamManager *iam.Manager, fullMeth
 		return true, nil, nil
 	}
 	if authContext.AuthMethod != common.AuthMethodIAM {
+		// The custom auth methods check the permissions by themselves, but the project scope of the access token is checked here.
+		if accessToken, ok := common.GetAccessTokenFromContext(ctx); ok && accessToken.PrincipalID == user.ID {
+			if projectIDs := authContext.GetProjectResources(); len(projectIDs) > 0 && !accessToken.AllowProjects(projectIDs...) {
+				return false, projectIDs, nil
+			}
+		}
 		return true, nil, nil
 	}
 	// Handle GetProject() error status.
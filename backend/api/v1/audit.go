@@ -22,6 +22,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -87,8 +88,17 @@ func (in *AuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFu
 			interceptor:          in,
 			ctx:                  ctx,
 			method:               conn.Spec().Procedure,
+			// The export is streamed in chunks of the same file, so it is audited once when it ends.
+			auditOnReturn: conn.Spec().Procedure == v1connect.SQLServiceExportProcedure,
 		}
-		return next(ctx, wrappedConn)
+		rerr := next(ctx, wrappedConn)
+		if wrappedConn.auditOnReturn && wrappedConn.curRequest != nil {
+			latency := time.Since(wrappedConn.startTime)
+			if err := createAuditLogConnect(ctx, wrappedConn.curRequest, nil, wrappedConn.method, in.store, nil, rerr, conn.RequestHeader(), latency); err != nil {
+				slog.Warn("audit interceptor: failed to create audit log", log.BBError(err), slog.String("method", wrappedConn.method))
+			}
+		}
+		return rerr
 	}
 }
 
@@ -99,6 +109,8 @@ type auditConnectStreamingConn struct {
 	method      string
 	curRequest  any
 	startTime   time.Time
+	// auditOnReturn audits the call once when the handler returns instead of on each response.
+	auditOnReturn bool
 }
 
 func (c *auditConnectStreamingConn) Receive(msg any) error {
@@ -118,7 +130,7 @@ func (c *auditConnectStreamingConn) Send(resp any) error {
 		return err
 	}
 	// Create audit log for each message pair
-	if c.curRequest != nil {
+	if c.curRequest != nil && !c.auditOnReturn {
 		latency := time.Since(c.startTime)
 		if auditErr := createAuditLogConnect(c.ctx, c.curRequest, resp, c.method, c.interceptor.store, nil, nil, c.RequestHeader(), latency); auditErr != nil {
 			return auditErr
@@ -146,6 +158,11 @@ func createAuditLogConnect(ctx context.Context, request, response any, method st
 		}
 	}
 
+	var accessToken string
+	if t, ok := common.GetAccessTokenFromContext(ctx); ok {
+		accessToken = t.Name
+	}
+
 	authContextAny := ctx.Value(common.AuthContextKey)
 	authContext, ok := authContextAny.(*common.AuthContext)
 	if !ok {
@@ -181,6 +198,7 @@ func createAuditLogConnect(ctx context.Context, request, response any, method st
 			Latency:         durationpb.New(latency),
 			ServiceData:     serviceData,
 			RequestMetadata: requestMetadata,
+			AccessToken:     accessToken,
 		}
 		if err := storage.CreateAuditLog(createAuditLogCtx, p); err != nil {
 			return err
@@ -199,6 +217,8 @@ func getRequestResource(request any) string {
 		return r.Name
 	case *v1pb.AdminExecuteRequest:
 		return r.Name
+	case *v1pb.QueryPagesRequest:
+		return r.Name
 	case *v1pb.ExportRequest:
 		return r.Name
 	case *v1pb.UpdateDatabaseRequest:
@@ -219,6 +239,16 @@ func getRequestResource(request any) string {
 		return r.GetUser().GetName()
 	case *v1pb.LoginRequest:
 		return r.GetEmail()
+	case *v1pb.CreateWebAuthnCredentialRequest:
+		return r.GetParent()
+	case *v1pb.UpdateWebAuthnCredentialRequest:
+		return r.GetWebauthnCredential().GetName()
+	case *v1pb.DeleteWebAuthnCredentialRequest:
+		return r.GetName()
+	case *v1pb.CreateAccessTokenRequest:
+		return r.GetParent()
+	case *v1pb.RevokeAccessTokenRequest:
+		return r.GetName()
 	case *v1pb.CreateRiskRequest:
 		return r.GetRisk().GetName()
 	case *v1pb.DeleteRiskRequest:
@@ -310,6 +340,8 @@ func getResponseString(response any) (string, error) {
 		switch r := response.(type) {
 		case *v1pb.QueryResponse:
 			return redactQueryResponse(r)
+		case *v1pb.QueryPagesResponse:
+			return redactQueryPagesResponse(r)
 		case *v1pb.AdminExecuteResponse:
 			return redactAdminExecuteResponse(r)
 		case *v1pb.ExportResponse:
@@ -322,6 +354,8 @@ func getResponseString(response any) (string, error) {
 			return redactInstance(r)
 		case *v1pb.Secret:
 			return redactSecret(r)
+		case *v1pb.AccessToken:
+			return redactAccessToken(r)
 		default:
 			if p, ok := r.(protoreflect.ProtoMessage); ok {
 				return p
@@ -357,6 +391,9 @@ func redactLoginRequest(r *v1pb.LoginRequest) *v1pb.LoginRequest {
 	if r.MfaTempToken != nil {
 		r.MfaTempToken = &maskedString
 	}
+	if r.WebauthnCredential != nil {
+		r.WebauthnCredential = &maskedString
+	}
 	if r.IdpContext != nil {
 		r.IdpContext = nil
 	}
@@ -497,6 +534,31 @@ func redactQueryResponse(r *v1pb.QueryResponse) *v1pb.QueryResponse {
 	return n
 }
 
+func redactQueryPagesResponse(r *v1pb.QueryPagesResponse) *v1pb.QueryPagesResponse {
+	if r == nil {
+		return nil
+	}
+	n := &v1pb.QueryPagesResponse{
+		NextPageToken: r.NextPageToken,
+		Pagination:    r.Pagination,
+	}
+	if result := r.Result; result != nil {
+		n.Result = &v1pb.QueryResult{
+			ColumnNames:     result.ColumnNames,
+			ColumnTypeNames: result.ColumnTypeNames,
+			Rows:            nil, // Redacted
+			RowsCount:       result.RowsCount,
+			Error:           result.Error,
+			Latency:         result.Latency,
+			Statement:       result.Statement,
+			DetailedError:   result.DetailedError,
+			AllowExport:     result.AllowExport,
+			Masked:          redactMaskingReasons(result.Masked), // Redact icon data
+		}
+	}
+	return n
+}
+
 func redactMaskingReasons(reasons []*v1pb.MaskingReason) []*v1pb.MaskingReason {
 	if reasons == nil {
 		return nil
@@ -539,6 +601,16 @@ func redactSecret(s *v1pb.Secret) *v1pb.Secret {
 	return s
 }
 
+// redactAccessToken returns a copy since the token must still be returned to the caller.
+func redactAccessToken(t *v1pb.AccessToken) *v1pb.AccessToken {
+	redacted, ok := proto.Clone(t).(*v1pb.AccessToken)
+	if !ok {
+		return nil
+	}
+	redacted.Token = maskedString
+	return redacted
+}
+
 func needAudit(ctx context.Context) bool {
 	authCtx, ok := common.GetAuthContextFromContext(ctx)
 	if !ok {
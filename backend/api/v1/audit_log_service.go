@@ -192,6 +192,7 @@ func convertToAuditLog(ctx context.Context, stores *store.Store, l *store.AuditL
 		Status:      l.Payload.Status,
 		Latency:     l.Payload.Latency,
 		ServiceData: l.Payload.ServiceData,
+		AccessToken: l.Payload.AccessToken,
 	}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"slices"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/store"
@@ -75,10 +77,15 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 	if !mfaSecondLogin {
 		var err error
 		if loginViaIDP {
-			loginUser, err = s.getOrCreateUserWithIDP(ctx, request)
+			loginUser, err = s.getOrCreateUserWithIDP(ctx, request, req.Header())
 			if err != nil {
 				return nil, err
 			}
+			if request.IdpContext.GetSamlContext() != nil {
+				// The SAML request state is only used once.
+				cookie := &http.Cookie{Name: saml.RequestStateCookieName, Value: "", Path: "/", MaxAge: -1}
+				resp.Header().Add("Set-Cookie", cookie.String())
+			}
 		} else {
 			loginUser, err = s.getAndVerifyUser(ctx, request)
 			if err != nil {
@@ -100,7 +107,18 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 			return nil, invalidUserOrPasswordError
 		}
 
-		if request.OtpCode != nil {
+		webauthnRequired, err := isWebAuthnRequired(ctx, s.store, s.licenseService, s.iamManager, user)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check WebAuthn requirement"))
+		}
+		if request.WebauthnCredential != nil {
+			if err := s.challengeWebAuthnCredential(ctx, user, *request.WebauthnCredential, request.OtpCode, webauthnRequired); err != nil {
+				return nil, err
+			}
+		} else if webauthnRequired || user.MFAConfig.GetOtpSecret() == "" {
+			// The users without TOTP can only pass MFA with the security key.
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Errorf("WebAuthn is required for MFA"))
+		} else if request.OtpCode != nil {
 			if err := challengeMFACode(user, *request.OtpCode); err != nil {
 				return nil, err
 			}
@@ -142,15 +160,39 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 
 	tokenDuration := auth.GetTokenDuration(ctx, s.store, s.licenseService)
 	userMFAEnabled := loginUser.MFAConfig != nil && loginUser.MFAConfig.OtpSecret != ""
+	webauthnEnabled := len(loginUser.MFAConfig.GetWebauthnCredentials()) > 0
+	webauthnRequired := false
+	if !mfaSecondLogin {
+		webauthnRequired, err = isWebAuthnRequired(ctx, s.store, s.licenseService, s.iamManager, loginUser)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check WebAuthn requirement"))
+		}
+	}
 	// We only allow MFA login (2-step) when the feature is enabled and user has enabled MFA.
-	if s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA) == nil && !mfaSecondLogin && userMFAEnabled {
+	if s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA) == nil && !mfaSecondLogin && (userMFAEnabled || webauthnEnabled || webauthnRequired) {
 		mfaTempToken, err := auth.GenerateMFATempToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret, tokenDuration)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to generate MFA temp token"))
 		}
-		return connect.NewResponse(&v1pb.LoginResponse{
+		mfaResponse := &v1pb.LoginResponse{
 			MfaTempToken: &mfaTempToken,
-		}), nil
+		}
+		switch {
+		case webauthnEnabled:
+			options, err := generateWebAuthnRequestOptions(ctx, s.store, loginUser)
+			if err != nil {
+				return nil, err
+			}
+			mfaResponse.WebauthnRequestOptions = &options
+		case webauthnRequired:
+			// The user registers the first credential to finish the login.
+			options, err := generateWebAuthnCreationOptions(ctx, s.store, loginUser)
+			if err != nil {
+				return nil, err
+			}
+			mfaResponse.WebauthnCreationOptions = &options
+		}
+		return connect.NewResponse(mfaResponse), nil
 	}
 
 	switch loginUser.Type {
@@ -278,7 +320,7 @@ func (s *AuthService) getAndVerifyUser(ctx context.Context, request *v1pb.LoginR
 	return user, nil
 }
 
-func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.LoginRequest) (*store.UserMessage, error) {
+func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.LoginRequest, header http.Header) (*store.UserMessage, error) {
 	idpID, err := common.GetIdentityProviderID(request.IdpName)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to get identity provider ID"))
@@ -362,6 +404,35 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get user info"))
 		}
+	case storepb.IdentityProviderType_SAML:
+		samlContext := request.IdpContext.GetSamlContext()
+		if samlContext == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("missing SAML context"))
+		}
+		// The response must answer the AuthnRequest started by this browser.
+		var requestState *saml.RequestState
+		httpRequest := http.Request{Header: header}
+		if cookie, _ := httpRequest.Cookie(saml.RequestStateCookieName); cookie != nil {
+			requestState, err = saml.DecodeRequestState(cookie.Value, s.secret, time.Now())
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.Wrapf(err, "invalid SAML request state, please sign in again"))
+			}
+		}
+		if requestState == nil || requestState.IdentityProviderID != idp.ResourceID {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Errorf("the SAML login was not started by this browser, please sign in again"))
+		}
+		samlIDP, err := saml.NewIdentityProvider(
+			idp.Config.GetSamlConfig(),
+			saml.EntityID(setting.ExternalUrl, idp.ResourceID),
+			saml.ACSURL(setting.ExternalUrl, idp.ResourceID),
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create new SAML identity provider"))
+		}
+		userInfo, _, err = samlIDP.UserInfo(ctx, samlContext.SamlResponse, requestState.RequestID, s.store.ConsumeSAMLAssertion, time.Now())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Wrapf(err, "failed to validate SAML response"))
+		}
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("identity provider type %s not supported", idp.Type.String()))
 	}
@@ -465,7 +536,35 @@ func (s *AuthService) userCountGuard(ctx context.Context) error {
 	return nil
 }
 
+// challengeWebAuthnCredential verifies the WebAuthn assertion of the user.
+// If WebAuthn is required but the user has no credential yet, the credential is registered instead,
+// and the OTP code is still required if the user has enabled TOTP.
+func (s *AuthService) challengeWebAuthnCredential(ctx context.Context, user *store.UserMessage, credential string, otpCode *string, webauthnRequired bool) error {
+	if len(user.MFAConfig.GetWebauthnCredentials()) > 0 {
+		return challengeWebAuthn(ctx, s.store, user, credential)
+	}
+	if !webauthnRequired {
+		return connect.NewError(connect.CodeUnauthenticated, errors.Errorf("no WebAuthn credential is registered"))
+	}
+	if user.MFAConfig.GetOtpSecret() != "" {
+		if otpCode == nil {
+			return connect.NewError(connect.CodeUnauthenticated, errors.Errorf("OTP code is required to register the WebAuthn credential"))
+		}
+		if err := challengeMFACode(user, *otpCode); err != nil {
+			return err
+		}
+	}
+	if _, err := registerWebAuthnCredential(ctx, s.store, user, defaultWebAuthnCredentialTitle, credential); err != nil {
+		return err
+	}
+	return nil
+}
+
 func challengeMFACode(user *store.UserMessage, mfaCode string) error {
+	// TOTP validates any code generated with the empty secret.
+	if user.MFAConfig.GetOtpSecret() == "" {
+		return connect.NewError(connect.CodeUnauthenticated, errors.Errorf("OTP is not enabled for the user"))
+	}
 	if !validateWithCodeAndSecret(mfaCode, user.MFAConfig.OtpSecret) {
 		return connect.NewError(connect.CodeUnauthenticated, errors.Errorf("invalid MFA code"))
 	}
@@ -473,14 +572,19 @@ func challengeMFACode(user *store.UserMessage, mfaCode string) error {
 }
 
 func (s *AuthService) challengeRecoveryCode(ctx context.Context, user *store.UserMessage, recoveryCode string) error {
+	// The recovery codes are issued along with the TOTP secret.
+	if user.MFAConfig.GetOtpSecret() == "" {
+		return connect.NewError(connect.CodeUnauthenticated, errors.Errorf("OTP is not enabled for the user"))
+	}
 	for i, code := range user.MFAConfig.RecoveryCodes {
 		if code == recoveryCode {
 			// If the recovery code is valid, delete it from the user's recovery code list.
 			user.MFAConfig.RecoveryCodes = slices.Delete(user.MFAConfig.RecoveryCodes, i, i+1)
 			_, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
 				MFAConfig: &storepb.MFAConfig{
-					OtpSecret:     user.MFAConfig.OtpSecret,
-					RecoveryCodes: user.MFAConfig.RecoveryCodes,
+					OtpSecret:           user.MFAConfig.OtpSecret,
+					RecoveryCodes:       user.MFAConfig.RecoveryCodes,
+					WebauthnCredentials: user.MFAConfig.WebauthnCredentials,
 				},
 			})
 			if err != nil {
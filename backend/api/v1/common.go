@@ -450,6 +450,10 @@ func convertExportFormat(format storepb.ExportFormat) v1pb.ExportFormat {
 		return v1pb.ExportFormat_SQL
 	case storepb.ExportFormat_XLSX:
 		return v1pb.ExportFormat_XLSX
+	case storepb.ExportFormat_PARQUET:
+		return v1pb.ExportFormat_PARQUET
+	case storepb.ExportFormat_NDJSON:
+		return v1pb.ExportFormat_NDJSON
 	}
 	return v1pb.ExportFormat_FORMAT_UNSPECIFIED
 }
@@ -464,6 +468,10 @@ func convertToExportFormat(format v1pb.ExportFormat) storepb.ExportFormat {
 		return storepb.ExportFormat_SQL
 	case v1pb.ExportFormat_XLSX:
 		return storepb.ExportFormat_XLSX
+	case v1pb.ExportFormat_PARQUET:
+		return storepb.ExportFormat_PARQUET
+	case v1pb.ExportFormat_NDJSON:
+		return storepb.ExportFormat_NDJSON
 	}
 	return storepb.ExportFormat_FORMAT_UNSPECIFIED
 }
@@ -502,6 +502,7 @@ func (s *DatabaseService) UpdateDatabase(ctx context.Context, req *connect.Reque
 				return nil, errors.Wrapf(err, "failed to create changelog")
 			}
 			patch.Metadata.Drifted = false
+			patch.Metadata.DriftReport = nil
 		}
 	}
 
@@ -746,6 +747,22 @@ func (s *DatabaseService) GetDatabaseMetadata(ctx context.Context, req *connect.
 	return connect.NewResponse(v1pbMetadata), nil
 }
 
+// GetDatabaseDriftReport gets the schema drift report of a database.
+func (s *DatabaseService) GetDatabaseDriftReport(ctx context.Context, req *connect.Request[v1pb.GetDatabaseDriftReportRequest]) (*connect.Response[v1pb.DatabaseDriftReport], error) {
+	name, err := common.TrimSuffix(req.Msg.Name, common.DriftReportSuffix)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%v", err.Error()))
+	}
+	database, err := getDatabaseMessage(ctx, s.store, name)
+	if err != nil {
+		return nil, err
+	}
+	if database.Deleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q was deleted", name))
+	}
+	return connect.NewResponse(convertToV1DriftReport(database)), nil
+}
+
 // GetDatabaseSchema gets the schema of a database.
 func (s *DatabaseService) GetDatabaseSchema(ctx context.Context, req *connect.Request[v1pb.GetDatabaseSchemaRequest]) (*connect.Response[v1pb.DatabaseSchema], error) {
 	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(req.Msg.Name, common.SchemaSuffix)
@@ -1398,6 +1415,35 @@ func (s *DatabaseService) convertToDatabase(ctx context.Context, database *store
 	}, nil
 }
 
+func convertToV1DriftReport(database *store.DatabaseMessage) *v1pb.DatabaseDriftReport {
+	report := &v1pb.DatabaseDriftReport{
+		Name:    fmt.Sprintf("%s%s", common.FormatDatabase(database.InstanceID, database.DatabaseName), common.DriftReportSuffix),
+		Drifted: database.Metadata.GetDrifted(),
+	}
+	driftReport := database.Metadata.GetDriftReport()
+	if driftReport == nil {
+		return report
+	}
+	report.Changelog = common.FormatChangelog(database.InstanceID, database.DatabaseName, driftReport.ChangelogUid)
+	report.DetectTime = driftReport.DetectTime
+	report.Objects = convertToV1DriftObjects(driftReport.Objects)
+	return report
+}
+
+func convertToV1DriftObjects(objects []*storepb.DriftObject) []*v1pb.DriftObject {
+	var v1Objects []*v1pb.DriftObject
+	for _, object := range objects {
+		v1Objects = append(v1Objects, &v1pb.DriftObject{
+			Action:   v1pb.DriftObject_Action(object.Action),
+			Type:     v1pb.DriftObject_Type(object.Type),
+			Schema:   object.Schema,
+			Name:     object.Name,
+			Children: convertToV1DriftObjects(object.Children),
+		})
+	}
+	return v1Objects
+}
+
 type metadataFilter struct {
 	schema *string
 	table  *string
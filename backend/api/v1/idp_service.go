@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -19,6 +20,8 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -27,13 +30,15 @@ type IdentityProviderService struct {
 	v1connect.UnimplementedIdentityProviderServiceHandler
 	store          *store.Store
 	licenseService *enterprise.LicenseService
+	ldapSyncer     *ldapsync.Syncer
 }
 
 // NewIdentityProviderService creates a new IdentityProviderService.
-func NewIdentityProviderService(store *store.Store, licenseService *enterprise.LicenseService) *IdentityProviderService {
+func NewIdentityProviderService(store *store.Store, licenseService *enterprise.LicenseService, ldapSyncer *ldapsync.Syncer) *IdentityProviderService {
 	return &IdentityProviderService{
 		store:          store,
 		licenseService: licenseService,
+		ldapSyncer:     ldapSyncer,
 	}
 }
 
@@ -159,6 +164,10 @@ func (s *IdentityProviderService) UpdateIdentityProvider(ctx context.Context, re
 			if req.Msg.IdentityProvider.Config.GetLdapConfig().BindPassword == "" {
 				patch.Config.GetLdapConfig().BindPassword = identityProviderMessage.Config.GetLdapConfig().BindPassword
 			}
+		case storepb.IdentityProviderType_SAML:
+			if req.Msg.IdentityProvider.Config.GetSamlConfig().SpPrivateKey == "" {
+				patch.Config.GetSamlConfig().SpPrivateKey = identityProviderMessage.Config.GetSamlConfig().SpPrivateKey
+			}
 		}
 	}
 
@@ -183,6 +192,32 @@ func (s *IdentityProviderService) DeleteIdentityProvider(ctx context.Context, re
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// SyncLDAPGroups synchronizes the Bytebase groups with the LDAP groups mapped by the identity provider.
+func (s *IdentityProviderService) SyncLDAPGroups(ctx context.Context, req *connect.Request[v1pb.SyncLDAPGroupsRequest]) (*connect.Response[v1pb.SyncLDAPGroupsResponse], error) {
+	if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_ENTERPRISE_SSO); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	identityProvider, err := s.getIdentityProviderMessage(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if identityProvider.Type != storepb.IdentityProviderType_LDAP {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("identity provider %q is not LDAP", req.Msg.Name))
+	}
+
+	changes, err := s.ldapSyncer.SyncGroups(ctx, identityProvider, req.Msg.ValidateOnly, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to sync LDAP groups"))
+	}
+	return connect.NewResponse(&v1pb.SyncLDAPGroupsResponse{
+		Changes: changes,
+	}), nil
+}
+
 var googleGitHubDomains = map[string]bool{
 	"google.com": true,
 	"github.com": true,
@@ -354,6 +389,38 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, req
 
 		// LDAP cannot return claims without username and password so we return an empty claims map.
 		return connect.NewResponse(&v1pb.TestIdentityProviderResponse{Claims: make(map[string]string)}), nil
+	case v1pb.IdentityProviderType_SAML:
+		identityProviderID, err := common.GetIdentityProviderID(identityProvider.Name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		// Retrieve SP private key from stored identity provider if not provided.
+		// The identity provider may not be created yet.
+		if identityProvider.Config.GetSamlConfig().SpPrivateKey == "" {
+			storedIdentityProvider, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{
+				ResourceID: &identityProviderID,
+			})
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to find identity provider, error: %s", err.Error()))
+			}
+			if storedIdentityProvider != nil {
+				identityProvider.Config.GetSamlConfig().SpPrivateKey = storedIdentityProvider.Config.GetSamlConfig().GetSpPrivateKey()
+			}
+		}
+		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config).GetSamlConfig()
+		samlIdentityProvider, err := saml.NewIdentityProvider(
+			identityProviderConfig,
+			saml.EntityID(setting.ExternalUrl, identityProviderID),
+			saml.ACSURL(setting.ExternalUrl, identityProviderID),
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid SAML identity provider config, error: %s", err.Error()))
+		}
+		// The SAML login is initiated by the browser, so we can only validate the config and build the AuthnRequest here.
+		if _, err := samlIdentityProvider.AuthnRequestURL("", time.Now()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to build AuthnRequest, error: %s", err.Error()))
+		}
+		return connect.NewResponse(&v1pb.TestIdentityProviderResponse{Claims: make(map[string]string)}), nil
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("identity provider type %s not supported", identityProvider.Type.String()))
 	}
@@ -465,6 +532,27 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 					UserFilter:       v.UserFilter,
 					SecurityProtocol: v1pb.LDAPIdentityProviderConfig_SecurityProtocol(v.SecurityProtocol),
 					FieldMapping:     &fieldMapping,
+					GroupSync:        convertLDAPGroupSyncConfigFromStore(v.GroupSync),
+				},
+			},
+		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := v1pb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
+		}
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &v1pb.SAMLIdentityProviderConfig{
+					EntityId:      v.EntityId,
+					SsoUrl:        v.SsoUrl,
+					Certificate:   v.Certificate,
+					SpCertificate: v.SpCertificate,
+					SpPrivateKey:  "", // SECURITY: We do not expose the SP private key
+					NameIdFormat:  v.NameIdFormat,
+					FieldMapping:  &fieldMapping,
 				},
 			},
 		}
@@ -534,6 +622,27 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 					UserFilter:       v.UserFilter,
 					SecurityProtocol: storepb.LDAPIdentityProviderConfig_SecurityProtocol(v.SecurityProtocol),
 					FieldMapping:     &fieldMapping,
+					GroupSync:        convertLDAPGroupSyncConfigToStore(v.GroupSync),
+				},
+			},
+		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := storepb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &storepb.SAMLIdentityProviderConfig{
+					EntityId:      v.EntityId,
+					SsoUrl:        v.SsoUrl,
+					Certificate:   v.Certificate,
+					SpCertificate: v.SpCertificate,
+					SpPrivateKey:  v.SpPrivateKey,
+					NameIdFormat:  v.NameIdFormat,
+					FieldMapping:  &fieldMapping,
 				},
 			},
 		}
@@ -541,6 +650,50 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 	return nil
 }
 
+func convertLDAPGroupSyncConfigFromStore(groupSync *storepb.LDAPGroupSyncConfig) *v1pb.LDAPGroupSyncConfig {
+	if groupSync == nil {
+		return nil
+	}
+	var mappings []*v1pb.LDAPGroupMapping
+	for _, mapping := range groupSync.Mappings {
+		mappings = append(mappings, &v1pb.LDAPGroupMapping{
+			GroupDn: mapping.GroupDn,
+			Filter:  mapping.Filter,
+			Group:   mapping.Group,
+		})
+	}
+	return &v1pb.LDAPGroupSyncConfig{
+		Enabled:         groupSync.Enabled,
+		GroupBaseDn:     groupSync.GroupBaseDn,
+		MemberAttribute: groupSync.MemberAttribute,
+		UseMemberOf:     groupSync.UseMemberOf,
+		Nested:          groupSync.Nested,
+		Mappings:        mappings,
+	}
+}
+
+func convertLDAPGroupSyncConfigToStore(groupSync *v1pb.LDAPGroupSyncConfig) *storepb.LDAPGroupSyncConfig {
+	if groupSync == nil {
+		return nil
+	}
+	var mappings []*storepb.LDAPGroupMapping
+	for _, mapping := range groupSync.Mappings {
+		mappings = append(mappings, &storepb.LDAPGroupMapping{
+			GroupDn: mapping.GroupDn,
+			Filter:  mapping.Filter,
+			Group:   mapping.Group,
+		})
+	}
+	return &storepb.LDAPGroupSyncConfig{
+		Enabled:         groupSync.Enabled,
+		GroupBaseDn:     groupSync.GroupBaseDn,
+		MemberAttribute: groupSync.MemberAttribute,
+		UseMemberOf:     groupSync.UseMemberOf,
+		Nested:          groupSync.Nested,
+		Mappings:        mappings,
+	}
+}
+
 // validIdentityProviderConfig validates the identity provider's config is a valid JSON.
 func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType, identityProviderConfig *v1pb.IdentityProviderConfig) error {
 	switch identityProviderType {
@@ -556,6 +709,21 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 		if identityProviderConfig.GetLdapConfig() == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+		for _, mapping := range identityProviderConfig.GetLdapConfig().GetGroupSync().GetMappings() {
+			if mapping.GroupDn == "" && mapping.Filter == "" {
+				return errors.Errorf("either group DN or filter must be set for the group mapping")
+			}
+			if mapping.Group == "" {
+				return errors.Errorf("group must be set for the group mapping")
+			}
+		}
+	case v1pb.IdentityProviderType_SAML:
+		if identityProviderConfig.GetSamlConfig() == nil {
+			return errors.Errorf("unexpected provider config value")
+		}
+		if identityProviderConfig.GetSamlConfig().GetFieldMapping() == nil {
+			return errors.Errorf("field mapping must be set")
+		}
 	default:
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
@@ -506,6 +506,7 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, req *connect.Reque
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.dbFactory.InvalidateInstance(ctx, ins.ResourceID)
 	result, err := convertInstanceMessage(ins)
 	if err != nil {
 		return nil, err
@@ -558,6 +559,7 @@ func (s *InstanceService) DeleteInstance(ctx context.Context, req *connect.Reque
 	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.dbFactory.InvalidateInstance(ctx, instance.ResourceID)
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
@@ -923,6 +925,7 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, req *connect.Req
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.dbFactory.InvalidateInstance(ctx, instance.ResourceID)
 	result, err := convertInstanceMessage(instance)
 	if err != nil {
 		return nil, err
@@ -971,6 +974,7 @@ func (s *InstanceService) RemoveDataSource(ctx context.Context, req *connect.Req
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.dbFactory.InvalidateInstance(ctx, instance.ResourceID)
 
 	instance, err = s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		ResourceID: &instance.ResourceID,
@@ -274,6 +274,14 @@ func getAlgorithmNameFromSemanticType(semanticType *storepb.SemanticTypeSetting_
 		return "Hash (MD5)"
 	case *storepb.Algorithm_InnerOuterMask_:
 		return "Inner/Outer mask"
+	case *storepb.Algorithm_FormatPreservingMask_:
+		return "Format-preserving encryption"
+	case *storepb.Algorithm_HmacMask_:
+		return "Hash (HMAC)"
+	case *storepb.Algorithm_DateShiftMask_:
+		return "Date shift"
+	case *storepb.Algorithm_NumericBucketMask_:
+		return "Numeric bucket"
 	default:
 		return "Unknown"
 	}
@@ -206,6 +206,32 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			DatabaseName:       database.DatabaseName,
 		},
 	})
+	if instance.Metadata.GetEngine() == storepb.Engine_POSTGRES && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			PlanUID: plan.UID,
+			Status:  store.PlanCheckRunStatusRunning,
+			Type:    store.PlanCheckDatabaseStatementLockImpact,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceId:         instance.ResourceID,
+				DatabaseName:       database.DatabaseName,
+			},
+		})
+	}
+	if instance.Metadata.GetEngine() == storepb.Engine_MYSQL && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			PlanUID: plan.UID,
+			Status:  store.PlanCheckRunStatusRunning,
+			Type:    store.PlanCheckDatabaseStatementOnlineDDL,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceId:         instance.ResourceID,
+				DatabaseName:       database.DatabaseName,
+			},
+		})
+	}
 	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST {
 		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
 			PlanUID: plan.UID,
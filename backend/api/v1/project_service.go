@@ -17,14 +17,17 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -41,6 +44,7 @@ type ProjectService struct {
 	profile        *config.Profile
 	iamManager     *iam.Manager
 	licenseService *enterprise.LicenseService
+	webhookManager *webhook.Manager
 }
 
 // NewProjectService creates a new ProjectService.
@@ -49,12 +53,14 @@ func NewProjectService(
 	profile *config.Profile,
 	iamManager *iam.Manager,
 	licenseService *enterprise.LicenseService,
+	webhookManager *webhook.Manager,
 ) *ProjectService {
 	return &ProjectService{
 		store:          store,
 		profile:        profile,
 		iamManager:     iamManager,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -859,8 +865,17 @@ func (s *ProjectService) AddWebhook(ctx context.Context, req *connect.Request[v1
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	// The payloads of webhooks supporting signing are signed from the start.
+	if isSigningWebhookType(create.Type) {
+		secret, err := webhookplugin.GenerateSigningSecret()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		create.Payload.SigningSecret = secret
+	}
 
-	if _, err := s.store.CreateProjectWebhookV2(ctx, project.ResourceID, create); err != nil {
+	webhook, err := s.store.CreateProjectWebhookV2(ctx, project.ResourceID, create)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -870,7 +885,15 @@ func (s *ProjectService) AddWebhook(ctx context.Context, req *connect.Request[v1
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	return connect.NewResponse(convertToProject(project)), nil
+	response := convertToProject(project)
+	// The signing secret is only returned once on creation.
+	webhookName := fmt.Sprintf("%s/%s%d", common.FormatProject(project.ResourceID), common.WebhookIDPrefix, webhook.ID)
+	for _, v := range response.Webhooks {
+		if v.Name == webhookName {
+			v.SigningSecret = webhook.Payload.GetSigningSecret()
+		}
+	}
+	return connect.NewResponse(response), nil
 }
 
 // UpdateWebhook updates a webhook.
@@ -927,9 +950,12 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, req *connect.Request
 			}
 			update.Events = types
 		case "direct_message":
-			update.Payload = &storepb.ProjectWebhookPayload{
-				DirectMessage: req.Msg.Webhook.DirectMessage,
+			payload, ok := proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to clone webhook payload"))
 			}
+			payload.DirectMessage = req.Msg.Webhook.DirectMessage
+			update.Payload = payload
 		default:
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid field %q", path))
 		}
@@ -1028,8 +1054,36 @@ func (s *ProjectService) TestWebhook(ctx context.Context, req *connect.Request[v
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	// Sign the test payload in the same way as the existing webhook.
+	// The webhook name is empty when testing a webhook before it's created.
+	var signingSecrets []string
+	if req.Msg.Webhook.Name != "" {
+		webhookProjectID, webhookID, err := common.GetProjectIDWebhookID(req.Msg.Webhook.Name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		webhookIDInt, err := strconv.Atoi(webhookID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid webhook id %q", webhookID))
+		}
+		if webhookProjectID != project.ResourceID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("webhook %q does not belong to project %q", req.Msg.Webhook.Name, req.Msg.Project))
+		}
+		existingWebhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+			ProjectID: &project.ResourceID,
+			ID:        &webhookIDInt,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if existingWebhook == nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook %q not found", req.Msg.Webhook.Name))
+		}
+		signingSecrets = webhookplugin.GetSigningSecrets(existingWebhook.Payload, time.Now())
+	}
+
 	resp := &v1pb.TestWebhookResponse{}
-	err = webhookplugin.Post(
+	_, err = webhookplugin.Post(
 		webhook.Type,
 		webhookplugin.Context{
 			URL:         webhook.URL,
@@ -1056,6 +1110,7 @@ func (s *ProjectService) TestWebhook(ctx context.Context, req *connect.Request[v
 				Name:  common.FormatProject(project.ResourceID),
 				Title: project.Title,
 			},
+			SigningSecrets: signingSecrets,
 		},
 	)
 	if err != nil {
@@ -1065,6 +1120,220 @@ func (s *ProjectService) TestWebhook(ctx context.Context, req *connect.Request[v
 	return connect.NewResponse(resp), nil
 }
 
+// defaultWebhookSecretGracePeriod is the default period during which the previous signing secret is still used.
+const defaultWebhookSecretGracePeriod = 24 * time.Hour
+
+// isSigningWebhookType returns whether the payloads of the webhook type can be signed.
+func isSigningWebhookType(webhookType string) bool {
+	return webhookType == "bb.plugin.webhook.custom" || webhookType == "bb.plugin.webhook.eventsink"
+}
+
+// RotateWebhookSecret rotates the signing secret of a custom or event sink webhook.
+func (s *ProjectService) RotateWebhookSecret(ctx context.Context, req *connect.Request[v1pb.RotateWebhookSecretRequest]) (*connect.Response[v1pb.RotateWebhookSecretResponse], error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(req.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid webhook id %q", webhookID))
+	}
+	gracePeriod := defaultWebhookSecretGracePeriod
+	if v := req.Msg.GracePeriod; v != nil {
+		if err := v.CheckValid(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid grace period"))
+		}
+		gracePeriod = v.AsDuration()
+		if gracePeriod < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("grace period must not be negative"))
+		}
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", projectID))
+	}
+	if project.Deleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q has been deleted", projectID))
+	}
+
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.ResourceID,
+		ID:        &webhookIDInt,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if webhook == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook %q not found", req.Msg.Name))
+	}
+	if !isSigningWebhookType(webhook.Type) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("only custom and event sink webhooks support signing secrets"))
+	}
+
+	secret, err := webhookplugin.GenerateSigningSecret()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	payload, ok := proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to clone webhook payload"))
+	}
+	resp := &v1pb.RotateWebhookSecretResponse{
+		SigningSecret: secret,
+	}
+	payload.PreviousSigningSecret = ""
+	payload.PreviousSigningSecretExpireTime = nil
+	if payload.SigningSecret != "" && gracePeriod > 0 {
+		payload.PreviousSigningSecret = payload.SigningSecret
+		payload.PreviousSigningSecretExpireTime = timestamppb.New(time.Now().Add(gracePeriod))
+		resp.PreviousSecretExpireTime = payload.PreviousSigningSecretExpireTime
+	}
+	payload.SigningSecret = secret
+
+	if _, err := s.store.UpdateProjectWebhookV2(ctx, project.ResourceID, webhook.ID, &store.UpdateProjectWebhookMessage{
+		Payload: payload,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListWebhookDeliveriesResponse], error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(req.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid webhook id %q", webhookID))
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", projectID))
+	}
+	if project.Deleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q has been deleted", projectID))
+	}
+
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.ResourceID,
+		ID:        &webhookIDInt,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if webhook == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook %q not found", req.Msg.Parent))
+	}
+
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   req.Msg.PageToken,
+		limit:   int(req.Msg.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		ProjectID: &project.ResourceID,
+		WebhookID: &webhook.ID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset.offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list webhook deliveries"))
+	}
+
+	nextPageToken := ""
+	if len(deliveries) == limitPlusOne {
+		if nextPageToken, err = offset.getNextPageToken(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		deliveries = deliveries[:offset.limit]
+	}
+
+	resp := &v1pb.ListWebhookDeliveriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, convertToWebhookDelivery(delivery))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ResendWebhookDelivery re-sends a webhook delivery.
+func (s *ProjectService) ResendWebhookDelivery(ctx context.Context, req *connect.Request[v1pb.ResendWebhookDeliveryRequest]) (*connect.Response[v1pb.WebhookDelivery], error) {
+	projectID, webhookID, deliveryUID, err := common.GetProjectIDWebhookIDDeliveryUID(req.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		UID:       &deliveryUID,
+		ProjectID: &projectID,
+		WebhookID: &webhookID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if delivery == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook delivery %q not found", req.Msg.Name))
+	}
+	if delivery.Status == store.WebhookDeliveryStatusPending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("webhook delivery %q is already pending", req.Msg.Name))
+	}
+
+	updated, err := s.webhookManager.ResendDelivery(ctx, delivery)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to resend webhook delivery"))
+	}
+	return connect.NewResponse(convertToWebhookDelivery(updated)), nil
+}
+
+func convertToWebhookDelivery(delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	v1Delivery := &v1pb.WebhookDelivery{
+		Name:       fmt.Sprintf("%s/%s%d/%s%d", common.FormatProject(delivery.ProjectID), common.WebhookIDPrefix, delivery.WebhookID, common.WebhookDeliveryPrefix, delivery.UID),
+		Title:      delivery.Payload.GetEvent().GetTitle(),
+		CreateTime: timestamppb.New(delivery.CreatedAt),
+	}
+	if eventTypes := convertNotificationTypeStrings([]string{string(delivery.EventType)}); len(eventTypes) == 1 {
+		v1Delivery.EventType = eventTypes[0]
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryStatusPending:
+		v1Delivery.Status = v1pb.WebhookDelivery_PENDING
+		v1Delivery.NextAttemptTime = timestamppb.New(delivery.NextAttemptAt)
+	case store.WebhookDeliveryStatusDone:
+		v1Delivery.Status = v1pb.WebhookDelivery_DONE
+	case store.WebhookDeliveryStatusDead:
+		v1Delivery.Status = v1pb.WebhookDelivery_DEAD
+	}
+	for _, attempt := range delivery.Payload.GetAttempts() {
+		v1Delivery.Attempts = append(v1Delivery.Attempts, &v1pb.WebhookDelivery_Attempt{
+			CreateTime: attempt.CreateTime,
+			StatusCode: attempt.StatusCode,
+			Latency:    attempt.Latency,
+			Error:      attempt.Error,
+		})
+	}
+	return v1Delivery
+}
+
 func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectWebhookMessage, error) {
 	tp, err := convertToAPIWebhookTypeString(webhook.Type)
 	if err != nil {
@@ -1109,6 +1378,8 @@ func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error)
 			result = append(result, string(common.EventTypeIssueApprovalPass))
 		case v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, string(common.EventTypeIssueRolloutReady))
+		case v1pb.Activity_NOTIFY_SCHEMA_DRIFT:
+			result = append(result, string(common.EventTypeSchemaDrift))
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -1138,6 +1409,8 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 			result = append(result, v1pb.Activity_NOTIFY_ISSUE_APPROVED)
 		case string(common.EventTypeIssueRolloutReady):
 			result = append(result, v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT)
+		case string(common.EventTypeSchemaDrift):
+			result = append(result, v1pb.Activity_NOTIFY_SCHEMA_DRIFT)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -1166,6 +1439,8 @@ func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 		return "bb.plugin.webhook.lark", nil
 	case v1pb.Webhook_CUSTOM:
 		return "bb.plugin.webhook.custom", nil
+	case v1pb.Webhook_EVENT_SINK:
+		return "bb.plugin.webhook.eventsink", nil
 	default:
 		return "", common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -1189,6 +1464,8 @@ func convertWebhookTypeString(tp string) v1pb.Webhook_Type {
 		return v1pb.Webhook_LARK
 	case "bb.plugin.webhook.custom":
 		return v1pb.Webhook_CUSTOM
+	case "bb.plugin.webhook.eventsink":
+		return v1pb.Webhook_EVENT_SINK
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
@@ -1356,6 +1633,7 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 			Url:               webhook.URL,
 			NotificationTypes: convertNotificationTypeStrings(webhook.Events),
 			DirectMessage:     webhook.Payload.GetDirectMessage(),
+			Signed:            webhook.Payload.GetSigningSecret() != "",
 		})
 	}
 
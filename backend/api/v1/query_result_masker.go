@@ -2,6 +2,10 @@ package v1
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -27,26 +31,11 @@ func NewQueryResultMasker(store *store.Store) *QueryResultMasker {
 
 // MaskResults masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*parserbase.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, user *store.UserMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
-	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
-	}
-
-	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
-	}
-
-	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	m, err := s.newMaskingLevelEvaluator(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
+		return err
 	}
 
-	m := newEmptyMaskingLevelEvaluator().
-		withMaskingRulePolicy(maskingRulePolicy).
-		withDataClassificationSetting(classificationSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
 	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
 	loopBoundary := min(len(spans), len(results))
 	for i := 0; i < loopBoundary; i++ {
@@ -67,12 +56,54 @@ func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*parserbase
 		if err != nil {
 			return errors.Wrapf(err, "failed to get maskers for query span")
 		}
-		doMaskResult(maskers, reasons, results[i])
+		doMaskResult(maskers, reasons, getSubjectColumns(spans[i], maskers, instance.Metadata.GetEngine()), results[i])
 	}
 
 	return nil
 }
 
+// GetMaskers returns the maskers and the masking reasons for the result columns of the query span.
+// It's used to mask the streamed query result chunk by chunk with doMaskResult.
+func (s *QueryResultMasker) GetMaskers(ctx context.Context, span *parserbase.QuerySpan, instance *store.InstanceMessage, user *store.UserMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, []*v1pb.MaskingReason, error) {
+	if span.FunctionNotSupportedError != nil {
+		return nil, nil, errors.Errorf("masking error: %v", span.FunctionNotSupportedError)
+	}
+	if span.NotFoundError != nil {
+		return nil, nil, errors.Errorf("masking error: %v", span.NotFoundError)
+	}
+	m, err := s.newMaskingLevelEvaluator(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	maskers, reasons, err := s.getMaskersForQuerySpan(ctx, m, instance, user, span, action)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get maskers for query span")
+	}
+	return maskers, reasons, nil
+}
+
+func (s *QueryResultMasker) newMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
+	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+
+	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
+		withMaskingRulePolicy(maskingRulePolicy).
+		withDataClassificationSetting(classificationSetting).
+		withSemanticTypeSetting(semanticTypesSetting), nil
+}
+
 func getAlgorithmName(m masker.Masker) string {
 	switch m.(type) {
 	case *masker.NoneMasker:
@@ -86,6 +117,14 @@ func getAlgorithmName(m masker.Masker) string {
 	case *masker.InnerOuterMasker:
 		// Check the actual type by examining the mask result
 		return "Inner/Outer mask"
+	case *masker.FormatPreservingMasker:
+		return "Format-preserving encryption"
+	case *masker.HMACMasker:
+		return "Hash (HMAC)"
+	case *masker.DateShiftMasker:
+		return "Date shift"
+	case *masker.NumericBucketMasker:
+		return "Numeric bucket"
 	default:
 		return "Unknown"
 	}
@@ -100,12 +139,16 @@ func buildSemanticTypeToMaskerMap(ctx context.Context, stores *store.Store) (map
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get semantic types setting")
 	}
+	secret, err := stores.GetSecret(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace secret")
+	}
 	for _, semanticType := range semanticTypesSetting.GetTypes() {
 		if semanticType.GetId() == "bb.default" || semanticType.GetId() == "bb.default-partial" {
 			// Skip the built-in default semantic types.
 			continue
 		}
-		m, err := getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm())
+		m, err := getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm(), deriveMaskingKey(secret, semanticType.GetId()))
 		if err != nil {
 			return nil, err
 		}
@@ -362,7 +405,23 @@ func (s *QueryResultMasker) getColumnForColumnResource(ctx context.Context, inst
 	return columnMetadata, columnConfig, nil
 }
 
-func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) (masker.Masker, error) {
+// deriveMaskingKey derives the masking key of the semantic type from the workspace secret.
+// It's used if the key of the keyed masking algorithms is not set.
+func deriveMaskingKey(secret, semanticTypeID string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write([]byte("masking-key/" + semanticTypeID))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func maskingKeyOrDefault(key, defaultKey string) string {
+	if key == "" {
+		return defaultKey
+	}
+	return key
+}
+
+// getMaskerByMaskingAlgorithmAndLevel returns the masker of the algorithm, the default key is used by the keyed algorithms without a key.
+func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm, defaultKey string) (masker.Masker, error) {
 	if algorithm == nil {
 		return masker.NewNoneMasker(), nil
 	}
@@ -376,6 +435,14 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) (masker.M
 		return masker.NewMD5Masker(m.Md5Mask.Salt), nil
 	case *storepb.Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.Algorithm_FormatPreservingMask_:
+		return masker.NewFormatPreservingMasker(maskingKeyOrDefault(m.FormatPreservingMask.Key, defaultKey), m.FormatPreservingMask.Tweak)
+	case *storepb.Algorithm_HmacMask_:
+		return masker.NewHMACMasker(maskingKeyOrDefault(m.HmacMask.Key, defaultKey), m.HmacMask.Length, m.HmacMask.Prefix), nil
+	case *storepb.Algorithm_DateShiftMask_:
+		return masker.NewDateShiftMasker(maskingKeyOrDefault(m.DateShiftMask.Key, defaultKey), m.DateShiftMask.MaxShiftDays, m.DateShiftMask.SubjectColumn), nil
+	case *storepb.Algorithm_NumericBucketMask_:
+		return masker.NewNumericBucketMasker(m.NumericBucketMask.Boundaries, m.NumericBucketMask.Labels)
 	}
 	return masker.NewNoneMasker(), nil
 }
@@ -392,7 +459,58 @@ func convertRangeMaskSlices(slices []*storepb.Algorithm_RangeMask_Slice) []*mask
 	return result
 }
 
-func doMaskResult(maskers []masker.Masker, reasons []*v1pb.MaskingReason, result *v1pb.QueryResult) {
+// getSubjectColumns returns the index of the subject column in the result for each result column, -1 if there is none.
+// The subject maskers mask the value depending on the subject column of the same row,
+// e.g. shift the dates of the same patient by the same days.
+// The subject column is found by the source column in the query span rather than the result column name,
+// so that a constant or an expression aliased as the subject column, e.g. "SELECT 1 AS patient_id, birth_date FROM t",
+// cannot be used as the subject. Without the subject column, the subject maskers mask the value fully.
+func getSubjectColumns(span *parserbase.QuerySpan, maskers []masker.Masker, engine storepb.Engine) []int {
+	subjectColumns := make([]int, len(maskers))
+	for i, m := range maskers {
+		subjectColumns[i] = -1
+		subjectMasker, ok := m.(masker.SubjectMasker)
+		if !ok || subjectMasker.SubjectColumn() == "" {
+			continue
+		}
+		if span == nil || i >= len(span.Results) {
+			continue
+		}
+		for j, spanResult := range span.Results {
+			if isSubjectColumn(span.Results[i], spanResult, subjectMasker.SubjectColumn(), engine) {
+				subjectColumns[i] = j
+				break
+			}
+		}
+	}
+	return subjectColumns
+}
+
+// isSubjectColumn returns true if the candidate result is the plain subject column of the table of the masked result.
+func isSubjectColumn(maskedResult, candidate parserbase.QuerySpanResult, subjectColumn string, engine storepb.Engine) bool {
+	if len(candidate.SourceColumns) != 1 {
+		return false
+	}
+	if !candidate.IsPlainField && common.EngineSupportQuerySpanPlainField(engine) {
+		return false
+	}
+	for candidateColumn := range candidate.SourceColumns {
+		if !strings.EqualFold(candidateColumn.Column, subjectColumn) {
+			return false
+		}
+		for maskedColumn := range maskedResult.SourceColumns {
+			if maskedColumn.Server == candidateColumn.Server &&
+				maskedColumn.Database == candidateColumn.Database &&
+				maskedColumn.Schema == candidateColumn.Schema &&
+				maskedColumn.Table == candidateColumn.Table {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func doMaskResult(maskers []masker.Masker, reasons []*v1pb.MaskingReason, subjectColumns []int, result *v1pb.QueryResult) {
 	sensitive := make([]bool, len(result.ColumnNames))
 	for i := range result.ColumnNames {
 		if i < len(maskers) {
@@ -405,16 +523,28 @@ func doMaskResult(maskers []masker.Masker, reasons []*v1pb.MaskingReason, result
 		}
 	}
 
+	hasSubjectMasker := slices.ContainsFunc(subjectColumns, func(k int) bool { return k >= 0 })
 	for i, row := range result.Rows {
+		// Keep the original values because the subject column may be masked before the columns depending on it.
+		originalValues := row.Values
+		if hasSubjectMasker {
+			originalValues = slices.Clone(row.Values)
+		}
 		for j, value := range row.Values {
 			if value == nil {
 				continue
 			}
 			maskedValue := row.Values[j]
 			if j < len(maskers) && maskers[j] != nil {
-				maskedValue = maskers[j].Mask(&masker.MaskData{
+				maskData := &masker.MaskData{
 					Data: row.Values[j],
-				})
+				}
+				if j < len(subjectColumns) {
+					if k := subjectColumns[j]; k >= 0 && k < len(originalValues) {
+						maskData.Subject = originalValues[k]
+					}
+				}
+				maskedValue = maskers[j].Mask(maskData)
 			}
 			result.Rows[i].Values[j] = maskedValue
 		}
@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/masker"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestDoMaskResultSubjectColumn(t *testing.T) {
+	patientID := base.ColumnResource{Database: "db", Schema: "public", Table: "visit", Column: "patient_id"}
+	visitDate := base.ColumnResource{Database: "db", Schema: "public", Table: "visit", Column: "visit_date"}
+	otherPatientID := base.ColumnResource{Database: "db", Schema: "public", Table: "patient", Column: "patient_id"}
+	dateShiftMasker := masker.NewDateShiftMasker("secret", 30, "patient_id")
+
+	testCases := []struct {
+		description string
+		span        *base.QuerySpan
+		columnNames []string
+		// wantShifted is true if the date is shifted, false if it is masked fully.
+		wantShifted bool
+	}{
+		{
+			description: "subject column selected",
+			span: &base.QuerySpan{
+				Results: []base.QuerySpanResult{
+					{Name: "patient_id", SourceColumns: base.SourceColumnSet{patientID: true}, IsPlainField: true},
+					{Name: "visit_date", SourceColumns: base.SourceColumnSet{visitDate: true}, IsPlainField: true},
+				},
+			},
+			columnNames: []string{"patient_id", "visit_date"},
+			wantShifted: true,
+		},
+		{
+			description: "subject column selected with an alias",
+			span: &base.QuerySpan{
+				Results: []base.QuerySpanResult{
+					{Name: "p", SourceColumns: base.SourceColumnSet{patientID: true}, IsPlainField: true},
+					{Name: "visit_date", SourceColumns: base.SourceColumnSet{visitDate: true}, IsPlainField: true},
+				},
+			},
+			columnNames: []string{"p", "visit_date"},
+			wantShifted: true,
+		},
+		{
+			description: "constant aliased as the subject column",
+			span: &base.QuerySpan{
+				Results: []base.QuerySpanResult{
+					{Name: "patient_id", SourceColumns: base.SourceColumnSet{}},
+					{Name: "visit_date", SourceColumns: base.SourceColumnSet{visitDate: true}, IsPlainField: true},
+				},
+			},
+			columnNames: []string{"patient_id", "visit_date"},
+			wantShifted: false,
+		},
+		{
+			description: "expression of the subject column",
+			span: &base.QuerySpan{
+				Results: []base.QuerySpanResult{
+					{Name: "patient_id", SourceColumns: base.SourceColumnSet{patientID: true}, IsPlainField: false},
+					{Name: "visit_date", SourceColumns: base.SourceColumnSet{visitDate: true}, IsPlainField: true},
+				},
+			},
+			columnNames: []string{"patient_id", "visit_date"},
+			wantShifted: false,
+		},
+		{
+			description: "subject column of another table",
+			span: &base.QuerySpan{
+				Results: []base.QuerySpanResult{
+					{Name: "patient_id", SourceColumns: base.SourceColumnSet{otherPatientID: true}, IsPlainField: true},
+					{Name: "visit_date", SourceColumns: base.SourceColumnSet{visitDate: true}, IsPlainField: true},
+				},
+			},
+			columnNames: []string{"patient_id", "visit_date"},
+			wantShifted: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		maskers := []masker.Masker{masker.NewNoneMasker(), dateShiftMasker}
+		result := &v1pb.QueryResult{
+			ColumnNames: tc.columnNames,
+			Rows: []*v1pb.QueryRow{
+				{
+					Values: []*v1pb.RowValue{
+						{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+						{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-01-15"}},
+					},
+				},
+			},
+		}
+		doMaskResult(maskers, nil, getSubjectColumns(tc.span, maskers, storepb.Engine_MYSQL), result)
+		got := result.Rows[0].Values[1].GetStringValue()
+		if tc.wantShifted {
+			require.NotEqual(t, "******", got, tc.description)
+			require.Regexp(t, `^\d{4}-\d{2}-\d{2}$`, got, tc.description)
+		} else {
+			require.Equal(t, "******", got, tc.description)
+		}
+		// The subject column itself is not masked.
+		require.Equal(t, int64(1), result.Rows[0].Values[0].GetInt64Value(), tc.description)
+	}
+}
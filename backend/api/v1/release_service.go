@@ -68,6 +68,11 @@ func (s *ReleaseService) CreateRelease(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %v not found", projectID))
 	}
 
+	for _, file := range req.Msg.Release.Files {
+		if file.Format != v1pb.Release_File_FORMAT_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("file %q of format %q can only be checked, not released", file.Path, file.Format.String()))
+		}
+	}
 	req.Msg.Release.Files, err = validateAndSanitizeReleaseFiles(ctx, s.store, req.Msg.Release.Files)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid release files, err"))
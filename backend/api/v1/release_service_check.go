@@ -13,6 +13,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/orm"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -189,6 +190,29 @@ func (s *ReleaseService) CheckRelease(ctx context.Context, req *connect.Request[
 				}
 				// statement is guaranteed to be populated by validateAndSanitizeReleaseFiles
 				statement := string(file.Statement)
+				// For ORM files, check the extracted SQL and point the advices at the original file.
+				script, err := extractORMScript(file, engine)
+				if err != nil {
+					// The file is malformed, report it as the advice of the file and continue checking the rest.
+					advice := &v1pb.Advice{
+						Status:  v1pb.Advice_ERROR,
+						Code:    advisor.StatementSyntaxError.Int32(),
+						Title:   "Failed to extract SQL statements",
+						Content: err.Error(),
+					}
+					var extractErr *orm.ExtractError
+					if errors.As(err, &extractErr) {
+						advice.StartPosition = &v1pb.Position{Line: int32(extractErr.Line)}
+					}
+					checkResult.Advices = append(checkResult.Advices, advice)
+					return checkResult, nil
+				}
+				if script != nil {
+					statement = script.Statement
+					defer func() {
+						convertToORMFileAdvicePositions(checkResult.Advices, script)
+					}()
+				}
 				// Check if any syntax error in the statement.
 				if common.EngineSupportSyntaxCheck(engine) {
 					_, syntaxAdvices := s.sheetManager.GetASTsForChecks(engine, statement)
@@ -383,6 +407,35 @@ func (s *ReleaseService) runSQLReviewCheckForFile(
 	return adviceLevel, advices, nil
 }
 
+// extractORMScript extracts the SQL script from an ORM file, returns nil for plain SQL files.
+func extractORMScript(file *v1pb.Release_File, engine storepb.Engine) (*orm.Script, error) {
+	switch file.Format {
+	case v1pb.Release_File_MYBATIS_MAPPER:
+		return orm.ExtractMyBatisMapper(string(file.Statement), engine)
+	case v1pb.Release_File_TYPEORM_MIGRATION:
+		return orm.ExtractTypeORMMigration(string(file.Statement))
+	default:
+		return nil, nil
+	}
+}
+
+// convertToORMFileAdvicePositions converts the advice positions in the extracted script to the lines in the ORM file.
+// The columns are dropped because they are meaningless in the ORM file.
+func convertToORMFileAdvicePositions(advices []*v1pb.Advice, script *orm.Script) {
+	for _, advice := range advices {
+		if advice.StartPosition != nil {
+			advice.StartPosition = &v1pb.Position{
+				Line: int32(script.GetOriginalLine(int(advice.StartPosition.Line))),
+			}
+		}
+		if advice.EndPosition != nil {
+			advice.EndPosition = &v1pb.Position{
+				Line: int32(script.GetOriginalLine(int(advice.EndPosition.Line))) + 1,
+			}
+		}
+	}
+}
+
 func getRiskSourceFromChangeType(changeType storepb.PlanCheckRunConfig_ChangeDatabaseType) store.RiskSource {
 	switch changeType {
 	case storepb.PlanCheckRunConfig_DDL, storepb.PlanCheckRunConfig_DDL_GHOST:
@@ -120,6 +120,9 @@ func (s *ReviewConfigService) UpdateReviewConfig(ctx context.Context, req *conne
 		case "title":
 			patch.Name = &req.Msg.ReviewConfig.Title
 		case "rules":
+			if err := validateSQLReviewRules(req.Msg.ReviewConfig.Rules); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
 			ruleList, err := convertToSQLReviewRules(req.Msg.ReviewConfig.Rules)
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to convert rules"))
@@ -284,6 +287,10 @@ func validateSQLReviewRules(rules []*v1pb.SQLReviewRule) error {
 			if _, err := advisor.UnmarshalNamingCaseRulePayload(rule.Payload); err != nil {
 				return err
 			}
+		case advisor.SchemaRuleCustom:
+			if _, _, err := advisor.UnmarshalCustomRulePayload(rule.Payload); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -97,7 +97,11 @@ func CalculateRiskLevelWithOptionalSummaryReport(
 			args["sql_type"] = statementType
 			for _, tableName := range tableNames {
 				args["table_name"] = tableName
-				out, _, err := prg.Eval(args)
+				vars, err := e.PartialVars(args)
+				if err != nil {
+					return 0, errors.Wrapf(err, "failed to get vars")
+				}
+				out, _, err := prg.Eval(vars)
 				if err != nil {
 					return 0, errors.Wrap(err, "failed to eval expression")
 				}
@@ -110,3 +114,76 @@ func CalculateRiskLevelWithOptionalSummaryReport(
 
 	return 0, nil
 }
+
+// CalculateRiskLevelWithLockImpactReport calculates the risk level with the lock impact report.
+// The expression is evaluated against every statement in the report with its table and lock impact.
+func CalculateRiskLevelWithLockImpactReport(
+	_ context.Context,
+	risks []*store.RiskMessage,
+	commonArgs map[string]any,
+	riskSource store.RiskSource,
+	lockImpactReport *storepb.PlanCheckRunResult_Result_LockImpactReport,
+) (int32, error) {
+	if riskSource == store.RiskSourceUnknown || lockImpactReport == nil {
+		return 0, nil
+	}
+
+	// Sort by level DESC, higher risks go first.
+	slices.SortFunc(risks, func(a, b *store.RiskMessage) int {
+		if a.Level > b.Level {
+			return -1
+		} else if a.Level < b.Level {
+			return 1
+		}
+		return 0
+	})
+
+	for _, risk := range risks {
+		if !risk.Active {
+			continue
+		}
+		if risk.Source != riskSource {
+			continue
+		}
+		if risk.Expression == nil || risk.Expression.Expression == "" {
+			continue
+		}
+		e, err := cel.NewEnv(common.RiskFactors...)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to create cel environment")
+		}
+		ast, issues := e.Parse(risk.Expression.Expression)
+		if issues != nil && issues.Err() != nil {
+			return 0, errors.Errorf("failed to parse expression: %v", issues.Err())
+		}
+		prg, err := e.Program(ast, cel.EvalOptions(cel.OptPartialEval))
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to create program")
+		}
+
+		for _, statement := range lockImpactReport.Statements {
+			args := map[string]any{}
+			maps.Copy(args, commonArgs)
+			args["schema_name"] = statement.Schema
+			args["table_name"] = statement.Table
+			args["table_rows"] = statement.TableRows
+			args["lock_mode"] = statement.LockMode.String()
+			args["table_rewrite"] = statement.TableRewrite
+			args["table_scan"] = statement.TableScan
+			args["blocking_risk"] = statement.BlockingRisk.String()
+			vars, err := e.PartialVars(args)
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to get vars")
+			}
+			out, _, err := prg.Eval(vars)
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to eval expression")
+			}
+			if res, ok := out.Equal(celtypes.True).Value().(bool); ok && res {
+				return risk.Level, nil
+			}
+		}
+	}
+
+	return 0, nil
+}
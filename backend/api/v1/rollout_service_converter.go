@@ -304,6 +304,10 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabaseStatementLockImpact:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_LOCK_IMPACT
+	case store.PlanCheckDatabaseStatementOnlineDDL:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_ONLINE_DDL
 	}
 	return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
 }
@@ -356,10 +360,58 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				EndPosition:   convertToPosition(report.SqlReviewReport.EndPosition),
 			},
 		}
+	case *storepb.PlanCheckRunResult_Result_LockImpactReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_LockImpactReport_{
+			LockImpactReport: convertToLockImpactReport(report.LockImpactReport),
+		}
+	case *storepb.PlanCheckRunResult_Result_OnlineDdlReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_OnlineDdlReport_{
+			OnlineDdlReport: convertToOnlineDDLReport(report.OnlineDdlReport),
+		}
 	}
 	return resultV1
 }
 
+func convertToLockImpactReport(report *storepb.PlanCheckRunResult_Result_LockImpactReport) *v1pb.PlanCheckRun_Result_LockImpactReport {
+	reportV1 := &v1pb.PlanCheckRun_Result_LockImpactReport{}
+	for _, statement := range report.GetStatements() {
+		reportV1.Statements = append(reportV1.Statements, &v1pb.PlanCheckRun_Result_StatementLockImpact{
+			StartPosition: convertToPosition(statement.StartPosition),
+			Statement:     statement.Statement,
+			Schema:        statement.Schema,
+			Table:         statement.Table,
+			LockMode:      v1pb.PlanCheckRun_Result_StatementLockImpact_LockMode(statement.LockMode),
+			TableRewrite:  statement.TableRewrite,
+			TableScan:     statement.TableScan,
+			TableRows:     statement.TableRows,
+			TableSize:     statement.TableSize,
+			BlockingRisk:  v1pb.PlanCheckRun_Result_StatementLockImpact_BlockingRisk(statement.BlockingRisk),
+			Reasons:       statement.Reasons,
+		})
+	}
+	return reportV1
+}
+
+func convertToOnlineDDLReport(report *storepb.PlanCheckRunResult_Result_OnlineDdlReport) *v1pb.PlanCheckRun_Result_OnlineDdlReport {
+	reportV1 := &v1pb.PlanCheckRun_Result_OnlineDdlReport{}
+	for _, statement := range report.GetStatements() {
+		reportV1.Statements = append(reportV1.Statements, &v1pb.PlanCheckRun_Result_StatementOnlineDdl{
+			StartPosition: convertToPosition(statement.StartPosition),
+			Statement:     statement.Statement,
+			Database:      statement.Database,
+			Table:         statement.Table,
+			Algorithm:     v1pb.PlanCheckRun_Result_StatementOnlineDdl_Algorithm(statement.Algorithm),
+			TableRebuild:  statement.TableRebuild,
+			ConcurrentDml: statement.ConcurrentDml,
+			TableRows:     statement.TableRows,
+			TableSize:     statement.TableSize,
+			SuggestGhost:  statement.SuggestGhost,
+			Reasons:       statement.Reasons,
+		})
+	}
+	return reportV1
+}
+
 func convertToPlanCheckRunResultStatus(status storepb.PlanCheckRunResult_Result_Status) v1pb.PlanCheckRun_Result_Status {
 	switch status {
 	case storepb.PlanCheckRunResult_Result_STATUS_UNSPECIFIED:
@@ -18,6 +18,8 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/masker"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -261,6 +263,16 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 					}
 				}
 				oldSetting.DisallowPasswordSignin = payload.DisallowPasswordSignin
+			case "value.workspace_profile_setting_value.webauthn_required_permissions":
+				if len(payload.WebauthnRequiredPermissions) > 0 {
+					if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA); err != nil {
+						return nil, connect.NewError(connect.CodePermissionDenied, err)
+					}
+				}
+				if !iam.PermissionsExist(payload.WebauthnRequiredPermissions...) {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid permissions %v", payload.WebauthnRequiredPermissions))
+				}
+				oldSetting.WebauthnRequiredPermissions = payload.WebauthnRequiredPermissions
 			default:
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid update mask path %v", path))
 			}
@@ -418,6 +430,13 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 		if err := convertProtoToProto(request.Msg.Setting.Value.GetSemanticTypeSettingValue(), storeSemanticTypeSetting); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to unmarshal setting value for %s with error: %v", apiSettingName, err))
 		}
+		oldSemanticTypeSetting, err := s.store.GetSemanticTypesSetting(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get setting %s with error: %v", apiSettingName, err))
+		}
+		// The masking keys are not returned to the client, so the stored key is kept if the key is not set.
+		// The key is derived from the workspace secret if it has never been set.
+		keepMaskingKeys(storeSemanticTypeSetting, oldSemanticTypeSetting)
 		idMap := make(map[string]bool)
 		for _, tp := range storeSemanticTypeSetting.Types {
 			if tp.Title == "" {
@@ -426,11 +445,26 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			if idMap[tp.Id] {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("duplicate semantic type id: %s", tp.Id))
 			}
-			m, ok := tp.GetAlgorithm().GetMask().(*storepb.Algorithm_InnerOuterMask_)
-			if ok && m.InnerOuterMask != nil {
-				if m.InnerOuterMask.Type == storepb.Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED {
+			switch m := tp.GetAlgorithm().GetMask().(type) {
+			case *storepb.Algorithm_InnerOuterMask_:
+				if m.InnerOuterMask != nil && m.InnerOuterMask.Type == storepb.Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED {
 					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("inner outer mask type has to be specified"))
 				}
+			case *storepb.Algorithm_HmacMask_:
+				if m.HmacMask.GetLength() < 0 {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("hmac mask length cannot be negative: %s", tp.Id))
+				}
+			case *storepb.Algorithm_DateShiftMask_:
+				if m.DateShiftMask.GetMaxShiftDays() <= 0 {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("date shift mask max shift days must be positive: %s", tp.Id))
+				}
+			case *storepb.Algorithm_NumericBucketMask_:
+				if len(m.NumericBucketMask.GetBoundaries()) == 0 {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("numeric bucket mask boundaries cannot be empty: %s", tp.Id))
+				}
+				if _, err := masker.NewNumericBucketMasker(m.NumericBucketMask.GetBoundaries(), m.NumericBucketMask.GetLabels()); err != nil {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid numeric bucket mask: %s", tp.Id))
+				}
 			}
 			idMap[tp.Id] = true
 		}
@@ -699,6 +733,7 @@ func convertToSettingMessage(setting *store.SettingMessage, profile *config.Prof
 		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), v1Value); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to unmarshal setting value for %s with error: %v", setting.Name, err))
 		}
+		redactMaskingKeys(v1Value)
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
@@ -1207,15 +1242,16 @@ func convertV1WorkspaceProfileSettingToStore(v1Setting *v1pb.WorkspaceProfileSet
 	}
 
 	storeSetting := &storepb.WorkspaceProfileSetting{
-		ExternalUrl:            v1Setting.ExternalUrl,
-		DisallowSignup:         v1Setting.DisallowSignup,
-		Require_2Fa:            v1Setting.Require_2Fa,
-		TokenDuration:          v1Setting.TokenDuration,
-		MaximumRoleExpiration:  v1Setting.MaximumRoleExpiration,
-		Domains:                v1Setting.Domains,
-		EnforceIdentityDomain:  v1Setting.EnforceIdentityDomain,
-		DatabaseChangeMode:     storepb.DatabaseChangeMode(v1Setting.DatabaseChangeMode),
-		DisallowPasswordSignin: v1Setting.DisallowPasswordSignin,
+		ExternalUrl:                 v1Setting.ExternalUrl,
+		DisallowSignup:              v1Setting.DisallowSignup,
+		Require_2Fa:                 v1Setting.Require_2Fa,
+		TokenDuration:               v1Setting.TokenDuration,
+		MaximumRoleExpiration:       v1Setting.MaximumRoleExpiration,
+		Domains:                     v1Setting.Domains,
+		EnforceIdentityDomain:       v1Setting.EnforceIdentityDomain,
+		DatabaseChangeMode:          storepb.DatabaseChangeMode(v1Setting.DatabaseChangeMode),
+		DisallowPasswordSignin:      v1Setting.DisallowPasswordSignin,
+		WebauthnRequiredPermissions: v1Setting.WebauthnRequiredPermissions,
 	}
 
 	// Convert announcement if present
@@ -1247,15 +1283,16 @@ func convertStoreWorkspaceProfileSettingToV1(storeSetting *storepb.WorkspaceProf
 	}
 
 	v1Setting := &v1pb.WorkspaceProfileSetting{
-		ExternalUrl:            storeSetting.ExternalUrl,
-		DisallowSignup:         storeSetting.DisallowSignup,
-		Require_2Fa:            storeSetting.Require_2Fa,
-		TokenDuration:          storeSetting.TokenDuration,
-		MaximumRoleExpiration:  storeSetting.MaximumRoleExpiration,
-		Domains:                storeSetting.Domains,
-		EnforceIdentityDomain:  storeSetting.EnforceIdentityDomain,
-		DatabaseChangeMode:     v1pb.DatabaseChangeMode(storeSetting.DatabaseChangeMode),
-		DisallowPasswordSignin: storeSetting.DisallowPasswordSignin,
+		ExternalUrl:                 storeSetting.ExternalUrl,
+		DisallowSignup:              storeSetting.DisallowSignup,
+		Require_2Fa:                 storeSetting.Require_2Fa,
+		TokenDuration:               storeSetting.TokenDuration,
+		MaximumRoleExpiration:       storeSetting.MaximumRoleExpiration,
+		Domains:                     storeSetting.Domains,
+		EnforceIdentityDomain:       storeSetting.EnforceIdentityDomain,
+		DatabaseChangeMode:          v1pb.DatabaseChangeMode(storeSetting.DatabaseChangeMode),
+		DisallowPasswordSignin:      storeSetting.DisallowPasswordSignin,
+		WebauthnRequiredPermissions: storeSetting.WebauthnRequiredPermissions,
 	}
 
 	// Convert announcement if present
@@ -1279,3 +1316,42 @@ func convertStoreWorkspaceProfileSettingToV1(storeSetting *storepb.WorkspaceProf
 
 	return v1Setting
 }
+
+// keepMaskingKeys sets the masking keys not set in the semantic types to the stored ones of the same semantic type and algorithm.
+func keepMaskingKeys(setting *storepb.SemanticTypeSetting, oldSetting *storepb.SemanticTypeSetting) {
+	oldAlgorithms := make(map[string]*storepb.Algorithm)
+	for _, tp := range oldSetting.GetTypes() {
+		oldAlgorithms[tp.GetId()] = tp.GetAlgorithm()
+	}
+	for _, tp := range setting.GetTypes() {
+		oldAlgorithm := oldAlgorithms[tp.GetId()]
+		switch m := tp.GetAlgorithm().GetMask().(type) {
+		case *storepb.Algorithm_FormatPreservingMask_:
+			if m.FormatPreservingMask.GetKey() == "" && oldAlgorithm.GetFormatPreservingMask() != nil {
+				m.FormatPreservingMask.Key = oldAlgorithm.GetFormatPreservingMask().GetKey()
+			}
+		case *storepb.Algorithm_HmacMask_:
+			if m.HmacMask.GetKey() == "" && oldAlgorithm.GetHmacMask() != nil {
+				m.HmacMask.Key = oldAlgorithm.GetHmacMask().GetKey()
+			}
+		case *storepb.Algorithm_DateShiftMask_:
+			if m.DateShiftMask.GetKey() == "" && oldAlgorithm.GetDateShiftMask() != nil {
+				m.DateShiftMask.Key = oldAlgorithm.GetDateShiftMask().GetKey()
+			}
+		}
+	}
+}
+
+// redactMaskingKeys clears the masking keys of the semantic types, anyone with the keys can reverse or correlate the masked values.
+func redactMaskingKeys(setting *v1pb.SemanticTypeSetting) {
+	for _, tp := range setting.GetTypes() {
+		switch m := tp.GetAlgorithm().GetMask().(type) {
+		case *v1pb.Algorithm_FormatPreservingMask_:
+			m.FormatPreservingMask.Key = ""
+		case *v1pb.Algorithm_HmacMask_:
+			m.HmacMask.Key = ""
+		case *v1pb.Algorithm_DateShiftMask_:
+			m.DateShiftMask.Key = ""
+		}
+	}
+}
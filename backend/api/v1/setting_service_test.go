@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 )
 
 func TestValidateDomains(t *testing.T) {
@@ -52,3 +55,37 @@ func TestValidateDomains(t *testing.T) {
 		}
 	}
 }
+
+func TestKeepMaskingKeys(t *testing.T) {
+	a := require.New(t)
+
+	oldSetting := &storepb.SemanticTypeSetting{
+		Types: []*storepb.SemanticTypeSetting_SemanticType{
+			{Id: "email", Algorithm: &storepb.Algorithm{Mask: &storepb.Algorithm_HmacMask_{HmacMask: &storepb.Algorithm_HmacMask{Key: "old-hmac"}}}},
+			{Id: "phone", Algorithm: &storepb.Algorithm{Mask: &storepb.Algorithm_HmacMask_{HmacMask: &storepb.Algorithm_HmacMask{Key: "old-phone"}}}},
+		},
+	}
+	setting := &storepb.SemanticTypeSetting{
+		Types: []*storepb.SemanticTypeSetting_SemanticType{
+			// The stored key is kept.
+			{Id: "email", Algorithm: &storepb.Algorithm{Mask: &storepb.Algorithm_HmacMask_{HmacMask: &storepb.Algorithm_HmacMask{Length: 8}}}},
+			// The algorithm is changed, so the key of the old algorithm is not used.
+			{Id: "phone", Algorithm: &storepb.Algorithm{Mask: &storepb.Algorithm_DateShiftMask_{DateShiftMask: &storepb.Algorithm_DateShiftMask{MaxShiftDays: 10}}}},
+			// The new key replaces the stored one.
+			{Id: "ssn", Algorithm: &storepb.Algorithm{Mask: &storepb.Algorithm_FormatPreservingMask_{FormatPreservingMask: &storepb.Algorithm_FormatPreservingMask{Key: "new-fpe"}}}},
+		},
+	}
+	keepMaskingKeys(setting, oldSetting)
+	a.Equal("old-hmac", setting.Types[0].GetAlgorithm().GetHmacMask().GetKey())
+	a.Equal("", setting.Types[1].GetAlgorithm().GetDateShiftMask().GetKey())
+	a.Equal("new-fpe", setting.Types[2].GetAlgorithm().GetFormatPreservingMask().GetKey())
+
+	v1Setting := &v1pb.SemanticTypeSetting{
+		Types: []*v1pb.SemanticTypeSetting_SemanticType{
+			{Id: "email", Algorithm: &v1pb.Algorithm{Mask: &v1pb.Algorithm_HmacMask_{HmacMask: &v1pb.Algorithm_HmacMask{Key: "old-hmac", Length: 8}}}},
+		},
+	}
+	redactMaskingKeys(v1Setting)
+	a.Equal("", v1Setting.Types[0].GetAlgorithm().GetHmacMask().GetKey())
+	a.Equal(int32(8), v1Setting.Types[0].GetAlgorithm().GetHmacMask().GetLength())
+}
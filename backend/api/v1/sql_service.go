@@ -28,6 +28,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/masker"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -56,6 +57,8 @@ type SQLService struct {
 	licenseService *enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	// resultSessions are the query result sessions of QueryPages keyed by the user and the query span.
+	resultSessions *queryResultSessionStore
 }
 
 // NewSQLService creates a SQLService.
@@ -76,6 +79,7 @@ func NewSQLService(
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		resultSessions: newQueryResultSessionStore(),
 	}
 }
 
@@ -263,6 +267,11 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 		checkErr := s.accessCheck(ctx, instance, database, user, spans, int(result.RowsCount), request.Explain, true /* isExport */)
 		result.AllowExport = checkErr == nil
 	}
+	if request.Explain {
+		if err := s.setQueryPlanWarnings(ctx, instance, database, queryContext.Schema, results); err != nil {
+			slog.Warn("failed to set query plan warnings", log.BBError(err))
+		}
+	}
 
 	response := &v1pb.QueryResponse{
 		Results: results,
@@ -319,6 +328,8 @@ func getSchemaMetadata(engine storepb.Engine, dbSchema *model.DatabaseSchema) *m
 		return dbSchema.GetDatabaseMetadata().GetSchema(common.BackupDatabaseNameOfEngine(storepb.Engine_POSTGRES))
 	case storepb.Engine_MSSQL:
 		return dbSchema.GetDatabaseMetadata().GetSchema("dbo")
+	case storepb.Engine_SNOWFLAKE:
+		return dbSchema.GetDatabaseMetadata().GetSchema("PUBLIC")
 	default:
 		return dbSchema.GetDatabaseMetadata().GetSchema("")
 	}
@@ -663,23 +674,11 @@ func getSensitivePredicateColumnErrorMessages(sensitiveColumns []parserbase.Colu
 }
 
 func executeWithTimeout(ctx context.Context, stores *store.Store, licenseService *enterprise.LicenseService, driver db.Driver, conn *sql.Conn, statement string, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
-	queryCtx := ctx
-	var timeout time.Duration
-	// For access control feature, we will use the timeout from request and query data policy.
-	// Otherwise, no timeout will be applied.
-	if licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_QUERY_POLICY) == nil {
-		queryDataPolicy, err := stores.GetQueryDataPolicy(ctx)
-		if err != nil {
-			return nil, time.Duration(0), errors.Wrap(err, "failed to get query data policy")
-		}
-		// Override the timeout if the query data policy has a smaller timeout.
-		if queryDataPolicy.Timeout.GetSeconds() > 0 || queryDataPolicy.Timeout.GetNanos() > 0 {
-			timeout = queryDataPolicy.Timeout.AsDuration()
-			newCtx, cancelCtx := context.WithTimeout(ctx, timeout)
-			defer cancelCtx()
-			queryCtx = newCtx
-		}
+	queryCtx, cancel, timeout, err := withQueryTimeout(ctx, stores, licenseService)
+	if err != nil {
+		return nil, time.Duration(0), err
 	}
+	defer cancel()
 	start := time.Now()
 	result, err := driver.QueryConn(queryCtx, conn, statement, queryContext)
 	select {
@@ -693,31 +692,87 @@ func executeWithTimeout(ctx context.Context, stores *store.Store, licenseService
 	return result, time.Since(start), err
 }
 
+// withQueryTimeout returns the context with the timeout of the query data policy.
+func withQueryTimeout(ctx context.Context, stores *store.Store, licenseService *enterprise.LicenseService) (context.Context, context.CancelFunc, time.Duration, error) {
+	// For access control feature, we will use the timeout from request and query data policy.
+	// Otherwise, no timeout will be applied.
+	if licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_QUERY_POLICY) == nil {
+		queryDataPolicy, err := stores.GetQueryDataPolicy(ctx)
+		if err != nil {
+			return nil, nil, time.Duration(0), errors.Wrap(err, "failed to get query data policy")
+		}
+		// Override the timeout if the query data policy has a smaller timeout.
+		if queryDataPolicy.Timeout.GetSeconds() > 0 || queryDataPolicy.Timeout.GetNanos() > 0 {
+			timeout := queryDataPolicy.Timeout.AsDuration()
+			queryCtx, cancel := context.WithTimeout(ctx, timeout)
+			return queryCtx, cancel, timeout, nil
+		}
+	}
+	return ctx, func() {}, time.Duration(0), nil
+}
+
+// exportChunkSize is the maximum size of the content in each export response.
+const exportChunkSize = 1024 * 1024
+
+// exportStreamWriter sends the written content to the export stream in chunks of at most exportChunkSize.
+type exportStreamWriter struct {
+	stream *connect.ServerStream[v1pb.ExportResponse]
+	buf    []byte
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		size := min(exportChunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:size]...)
+		p = p[size:]
+		if len(w.buf) == exportChunkSize {
+			if err := w.Flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush sends the buffered content.
+func (w *exportStreamWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.stream.Send(&v1pb.ExportResponse{Content: w.buf}); err != nil {
+		return errors.Wrap(err, "failed to send export response")
+	}
+	w.buf = make([]byte, 0, exportChunkSize)
+	return nil
+}
+
 // Export exports the SQL query result.
-func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.ExportRequest]) (*connect.Response[v1pb.ExportResponse], error) {
+// The exported file is streamed in chunks, so that it is never held in memory as a whole.
+func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.ExportRequest], stream *connect.ServerStream[v1pb.ExportResponse]) error {
 	request := req.Msg
+	w := &exportStreamWriter{stream: stream}
 	// Prehandle export from issue.
 	if strings.HasPrefix(request.Name, common.ProjectNamePrefix) {
-		response, err := s.doExportFromIssue(ctx, request.Name)
-		if err != nil {
-			return nil, err
+		if err := s.doExportFromIssue(ctx, request.Name, w); err != nil {
+			return err
 		}
-		return connect.NewResponse(response), nil
+		return w.Flush()
 	}
 
 	// Check if data export is allowed.
 	exportDataPolicy, err := s.store.GetExportDataPolicy(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get data export policy: %v", err))
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to get data export policy: %v", err))
 	}
 	if exportDataPolicy.Disable {
-		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("data export is not allowed"))
+		return connect.NewError(connect.CodePermissionDenied, errors.Errorf("data export is not allowed"))
 	}
 
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	statement := request.Statement
@@ -730,48 +785,49 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 	// New query ACL experience.
 	if instance.Metadata.GetEngine() != storepb.Engine_MYSQL {
 		if err := validateQueryRequest(instance, statement); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, s.licenseService, database, request.DataSourceId)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	// The chunks sent before a failure are discarded by the client since the stream ends with the error.
+	duration, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource, w)
+	if exportErr == nil {
+		exportErr = w.Flush()
 	}
-	bytes, duration, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource)
 
 	if err := s.createQueryHistory(ctx, database, store.QueryHistoryTypeExport, statement, user.ID, duration, exportErr); err != nil {
-		return nil, err
+		return err
 	}
 
 	if exportErr != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
+		return connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
 	}
-
-	return connect.NewResponse(&v1pb.ExportResponse{
-		Content: bytes,
-	}), nil
+	return nil
 }
 
-func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string) (*v1pb.ExportResponse, error) {
+func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string, w io.Writer) error {
 	_, rolloutID, _, err := common.GetProjectIDRolloutIDMaybeStageID(requestName)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to parse rollout ID: %v", err))
+		return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to parse rollout ID: %v", err))
 	}
 	rollout, err := s.store.GetRollout(ctx, rolloutID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get rollout: %v", err))
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to get rollout: %v", err))
 	}
 	if rollout == nil {
-		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("rollout %d not found", rolloutID))
+		return connect.NewError(connect.CodeNotFound, errors.Errorf("rollout %d not found", rolloutID))
 	}
 
 	tasks, err := s.store.ListTasks(ctx, &store.TaskFind{PipelineID: &rollout.ID})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get tasks: %v", err))
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to get tasks: %v", err))
 	}
 	if len(tasks) == 0 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %d has no task", rollout.ID))
+		return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %d has no task", rollout.ID))
 	}
 
 	contents := []*exportData{}
@@ -783,22 +839,22 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 			Status:  &targetTaskRunStatus,
 		})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get task run: %v", err))
+			return connect.NewError(connect.CodeInternal, errors.Errorf("failed to get task run: %v", err))
 		}
 		if len(taskRuns) == 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %v has no task run", requestName))
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %v has no task run", requestName))
 		}
 		taskRun := taskRuns[0]
 		exportArchiveUID := int(taskRun.ResultProto.ExportArchiveUid)
 		if exportArchiveUID == 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("issue %v has no export archive", requestName))
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("issue %v has no export archive", requestName))
 		}
 		exportArchive, err := s.store.GetExportArchive(ctx, &store.FindExportArchiveMessage{UID: &exportArchiveUID})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get export archive: %v", err))
+			return connect.NewError(connect.CodeInternal, errors.Errorf("failed to get export archive: %v", err))
 		}
 		if exportArchive == nil {
-			return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("export not found or expired, please request a new export"))
+			return connect.NewError(connect.CodeNotFound, errors.Errorf("export not found or expired, please request a new export"))
 		}
 		contents = append(contents, &exportData{
 			Content:  exportArchive.Bytes,
@@ -806,20 +862,18 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 		})
 	}
 
-	encryptedBytes, err := doEncrypt(contents, &v1pb.ExportRequest{
+	if err := doEncrypt(w, contents, &v1pb.ExportRequest{
 		Password: tasks[0].Payload.GetPassword(),
 		Format:   v1pb.ExportFormat(tasks[0].Payload.GetFormat()),
-	})
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to encrypt data: %v", err))
+	}); err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to encrypt data: %v", err))
 	}
-
-	return &v1pb.ExportResponse{
-		Content: encryptedBytes,
-	}, nil
+	return nil
 }
 
-// DoExport does the export.
+// DoExport does the export, and writes the exported file to the writer.
+// The file is encrypted in a zip archive if the password is set.
+// The content written to the writer should be discarded if the export fails.
 func DoExport(
 	ctx context.Context,
 	stores *store.Store,
@@ -832,9 +886,10 @@ func DoExport(
 	optionalAccessCheck accessCheckFunc,
 	schemaSyncer *schemasync.Syncer,
 	dataSource *storepb.DataSource,
-) ([]byte, time.Duration, error) {
+	w io.Writer,
+) (time.Duration, error) {
 	if dataSource == nil {
-		return nil, 0, connect.NewError(connect.CodeNotFound, errors.Errorf("cannot found valid data source"))
+		return 0, connect.NewError(connect.CodeNotFound, errors.Errorf("cannot found valid data source"))
 	}
 	driver, err := dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
 		DatabaseName: database.DatabaseName,
@@ -842,7 +897,7 @@ func DoExport(
 		ReadOnly:     true,
 	})
 	if err != nil {
-		return nil, 0, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
+		return 0, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
 	}
 	defer driver.Close(ctx)
 
@@ -851,105 +906,266 @@ func DoExport(
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		defer conn.Close()
 	}
 	queryRestriction := getMaximumSQLResultLimit(ctx, stores, licenseService, request.Limit)
-	queryContext := db.QueryContext{
-		Limit:                int(queryRestriction.MaximumResultRows),
-		OperatorEmail:        user.Email,
-		MaximumSQLResultSize: queryRestriction.MaximumResultSize,
+	exporter := &queryResultExporter{
+		stores:         stores,
+		licenseService: licenseService,
+		request:        request,
+		user:           user,
+		instance:       instance,
+		database:       database,
+		driver:         driver,
+		conn:           conn,
+		queryContext: db.QueryContext{
+			Limit:                int(queryRestriction.MaximumResultRows),
+			OperatorEmail:        user.Email,
+			MaximumSQLResultSize: queryRestriction.MaximumResultSize,
+		},
+		optionalAccessCheck: optionalAccessCheck,
+		schemaSyncer:        schemaSyncer,
 	}
-	results, spans, duration, queryErr := queryRetry(
-		ctx,
-		stores,
-		user,
-		instance,
-		database,
-		driver,
-		conn,
-		request.Statement,
-		queryContext,
-		licenseService,
-		optionalAccessCheck,
-		schemaSyncer,
-		storepb.MaskingExceptionPolicy_MaskingException_EXPORT,
-	)
-	if queryErr != nil {
-		return nil, duration, err
+
+	if request.Password == "" {
+		return exporter.export(ctx, w)
 	}
-	// only return the last result
-	if len(results) > 1 {
-		results = results[len(results)-1:]
+	zipw := zip.NewWriter(w)
+	fw, err := createExportFile(zipw, 0, database.DatabaseName, request)
+	if err != nil {
+		return 0, err
 	}
-	if len(results) == 1 {
-		if optionalAccessCheck != nil {
-			if err := optionalAccessCheck(ctx, instance, database, user, spans, int(results[0].RowsCount), queryContext.Explain, true); err != nil {
-				return nil, duration, err
-			}
-		}
+	duration, err := exporter.export(ctx, fw)
+	if err != nil {
+		return duration, err
 	}
-
-	if results[0].GetError() != "" {
-		return nil, duration, errors.New(results[0].GetError())
+	if err := zipw.Close(); err != nil {
+		return duration, errors.Wrap(err, "failed to close zip writer")
 	}
+	return duration, nil
+}
 
-	if licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
-		masker := NewQueryResultMasker(stores)
-		if err := masker.MaskResults(ctx, spans, results, instance, user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT); err != nil {
-			return nil, duration, err
+// queryResultExporter exports the result of the last statement in the export request.
+type queryResultExporter struct {
+	stores              *store.Store
+	licenseService      *enterprise.LicenseService
+	request             *v1pb.ExportRequest
+	user                *store.UserMessage
+	instance            *store.InstanceMessage
+	database            *store.DatabaseMessage
+	driver              db.Driver
+	conn                *sql.Conn
+	queryContext        db.QueryContext
+	optionalAccessCheck accessCheckFunc
+	schemaSyncer        *schemasync.Syncer
+}
+
+func (e *queryResultExporter) export(ctx context.Context, w io.Writer) (time.Duration, error) {
+	if streamer, ok := db.Unwrap(e.driver).(db.QueryResultStreamer); ok {
+		streamed, duration, err := e.exportStream(ctx, streamer, w)
+		if streamed {
+			return duration, err
 		}
 	}
+	return e.exportBuffered(ctx, w)
+}
 
-	result := results[0]
-	var content []byte
-	switch request.Format {
-	case v1pb.ExportFormat_CSV:
-		content, err = exportCSV(result)
-		if err != nil {
-			return nil, duration, err
+// exportStream streams the rows from the driver to the writer chunk by chunk.
+// It returns false without querying if the export must fall back to the buffered export.
+func (e *queryResultExporter) exportStream(ctx context.Context, streamer db.QueryResultStreamer, w io.Writer) (bool, time.Duration, error) {
+	spans, err := parserbase.GetQuerySpan(
+		ctx,
+		parserbase.GetQuerySpanContext{
+			InstanceID:                    e.instance.ResourceID,
+			GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(e.stores),
+			ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(e.stores),
+			GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(e.stores, e.instance.Metadata.GetEngine()),
+		},
+		e.instance.Metadata.GetEngine(),
+		e.request.Statement,
+		e.database.DatabaseName,
+		e.queryContext.Schema,
+		!store.IsObjectCaseSensitive(e.instance),
+	)
+	if err != nil {
+		return true, 0, err
+	}
+	// The buffered export syncs the database schema and gets the query span again if some resources are not found.
+	if len(spans) == 0 {
+		return false, 0, nil
+	}
+	for _, span := range spans {
+		if span.NotFoundError != nil {
+			return false, 0, nil
 		}
-	case v1pb.ExportFormat_JSON:
-		content, err = exportJSON(result)
-		if err != nil {
-			return nil, duration, err
+	}
+	// After replacing backup table with source, we can apply the original access check and mask sensitive data for backup table.
+	// If err != nil, this function will return the original spans.
+	if err := replaceBackupTableWithSource(ctx, e.stores, e.instance, e.database, spans); err != nil {
+		slog.Debug("failed to replace backup table with source", log.BBError(err))
+	}
+	if e.optionalAccessCheck != nil {
+		if err := e.optionalAccessCheck(ctx, e.instance, e.database, e.user, spans, e.queryContext.Limit, false, false); err != nil {
+			return true, 0, err
 		}
-	case v1pb.ExportFormat_SQL:
-		resourceList, err := getResources(ctx, stores, instance.Metadata.GetEngine(), database.DatabaseName, request.Statement, instance)
+	}
+	if e.licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, e.instance) == nil {
+		sensitivePredicateColumns, err := NewQueryResultMasker(e.stores).ExtractSensitivePredicateColumns(ctx, spans, e.instance, e.user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
 		if err != nil {
-			return nil, 0, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to extract resource list: %v", err))
+			return true, 0, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
 		}
-		statementPrefix, err := getSQLStatementPrefix(instance.Metadata.GetEngine(), resourceList, result.ColumnNames)
-		if err != nil {
-			return nil, 0, err
+		if len(sensitivePredicateColumns) == len(spans) && len(sensitivePredicateColumns[len(spans)-1]) > 0 {
+			return true, 0, errors.New(getSensitivePredicateColumnErrorMessages(sensitivePredicateColumns[len(spans)-1]))
 		}
-		content, err = exportSQL(instance.Metadata.GetEngine(), statementPrefix, result)
-		if err != nil {
-			return nil, duration, err
+	}
+	maskers, reasons, err := e.getMaskers(ctx, spans)
+	if err != nil {
+		return true, 0, err
+	}
+	var subjectColumns []int
+	if maskers != nil {
+		subjectColumns = getSubjectColumns(spans[len(spans)-1], maskers, e.instance.Metadata.GetEngine())
+	}
+
+	queryCtx, cancel, timeout, err := withQueryTimeout(ctx, e.stores, e.licenseService)
+	if err != nil {
+		return true, 0, err
+	}
+	defer cancel()
+
+	var exporter resultExporter
+	var rowsCount int64
+	start := time.Now()
+	streamErr := streamer.QueryConnStream(queryCtx, e.conn, e.request.Statement, e.queryContext, func(chunk *v1pb.QueryResult) error {
+		sanitizeResults([]*v1pb.QueryResult{chunk})
+		if maskers != nil {
+			doMaskResult(maskers, reasons, subjectColumns, chunk)
+		}
+		if exporter == nil {
+			var err error
+			exporter, err = e.newResultExporter(ctx, w, chunk, maskers)
+			if err != nil {
+				return err
+			}
 		}
-	case v1pb.ExportFormat_XLSX:
-		content, err = exportXLSX(result)
-		if err != nil {
-			return nil, duration, err
+		rowsCount += int64(len(chunk.Rows))
+		return exporter.Write(chunk)
+	})
+	duration := time.Since(start)
+	if exporter != nil {
+		if err := exporter.Close(); err != nil && streamErr == nil {
+			streamErr = err
 		}
+	}
+	select {
+	case <-queryCtx.Done():
+		// canceled or timed out
+		return true, duration, errors.Errorf("timeout reached: %v", timeout)
 	default:
-		return nil, duration, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported export format: %s", request.Format.String()))
+		// So the select will not block
+	}
+	if streamErr != nil {
+		return true, duration, streamErr
 	}
 
-	if request.Password == "" {
-		return content, duration, nil
+	if e.optionalAccessCheck != nil {
+		if err := e.optionalAccessCheck(ctx, e.instance, e.database, e.user, spans, int(rowsCount), false, true); err != nil {
+			return true, duration, err
+		}
 	}
-	encryptedBytes, err := doEncrypt([]*exportData{
-		{
-			Database: database.DatabaseName,
-			Content:  content,
-		},
-	}, request)
+	return true, duration, nil
+}
+
+// exportBuffered queries the whole result before writing it to the writer.
+func (e *queryResultExporter) exportBuffered(ctx context.Context, w io.Writer) (time.Duration, error) {
+	results, spans, duration, queryErr := queryRetry(
+		ctx,
+		e.stores,
+		e.user,
+		e.instance,
+		e.database,
+		e.driver,
+		e.conn,
+		e.request.Statement,
+		e.queryContext,
+		e.licenseService,
+		e.optionalAccessCheck,
+		e.schemaSyncer,
+		storepb.MaskingExceptionPolicy_MaskingException_EXPORT,
+	)
+	if queryErr != nil {
+		return duration, queryErr
+	}
+	if len(results) == 0 {
+		return duration, errors.Errorf("no query result")
+	}
+	// only return the last result
+	result := results[len(results)-1]
+	if e.optionalAccessCheck != nil {
+		if err := e.optionalAccessCheck(ctx, e.instance, e.database, e.user, spans, int(result.RowsCount), e.queryContext.Explain, true); err != nil {
+			return duration, err
+		}
+	}
+
+	if result.GetError() != "" {
+		return duration, errors.New(result.GetError())
+	}
+
+	maskers, reasons, err := e.getMaskers(ctx, spans)
 	if err != nil {
-		return nil, 0, errors.Wrap(err, "failed to encrypt data")
+		return duration, err
+	}
+	if maskers != nil {
+		doMaskResult(maskers, reasons, getSubjectColumns(spans[len(spans)-1], maskers, e.instance.Metadata.GetEngine()), result)
+	}
+
+	exporter, err := e.newResultExporter(ctx, w, result, maskers)
+	if err != nil {
+		return duration, err
+	}
+	if err := exporter.Write(result); err != nil {
+		_ = exporter.Close()
+		return duration, err
+	}
+	if err := exporter.Close(); err != nil {
+		return duration, err
 	}
-	return encryptedBytes, duration, nil
+	return duration, nil
+}
+
+// getMaskers returns the maskers for the result of the last statement, or nil if the data masking is not enabled.
+func (e *queryResultExporter) getMaskers(ctx context.Context, spans []*parserbase.QuerySpan) ([]masker.Masker, []*v1pb.MaskingReason, error) {
+	if e.licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, e.instance) != nil {
+		return nil, nil, nil
+	}
+	if len(spans) == 0 {
+		return nil, nil, nil
+	}
+	return NewQueryResultMasker(e.stores).GetMaskers(ctx, spans[len(spans)-1], e.instance, e.user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
+}
+
+func (e *queryResultExporter) newResultExporter(ctx context.Context, w io.Writer, columns *v1pb.QueryResult, maskers []masker.Masker) (resultExporter, error) {
+	engine := e.instance.Metadata.GetEngine()
+	var statementPrefix string
+	if e.request.Format == v1pb.ExportFormat_SQL {
+		resourceList, err := getResources(ctx, e.stores, engine, e.database.DatabaseName, e.request.Statement, e.instance)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to extract resource list: %v", err))
+		}
+		statementPrefix, err = getSQLStatementPrefix(engine, resourceList, columns.ColumnNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	maskedColumns := make([]bool, len(maskers))
+	for i, m := range maskers {
+		if _, ok := m.(*masker.NoneMasker); !ok && m != nil {
+			maskedColumns[i] = true
+		}
+	}
+	return newResultExporter(w, e.request.Format, engine, statementPrefix, columns, maskedColumns)
 }
 
 type exportData struct {
@@ -957,36 +1173,39 @@ type exportData struct {
 	Content  []byte
 }
 
-func doEncrypt(exports []*exportData, request *v1pb.ExportRequest) ([]byte, error) {
-	var b bytes.Buffer
-	fzip := io.Writer(&b)
-
-	zipw := zip.NewWriter(fzip)
-	defer zipw.Close()
-
+// doEncrypt writes the exported files into the zip archive encrypted with the password.
+func doEncrypt(w io.Writer, exports []*exportData, request *v1pb.ExportRequest) error {
+	zipw := zip.NewWriter(w)
 	for i, export := range exports {
-		fh := &zip.FileHeader{
-			Name:   fmt.Sprintf("[%d] %s.%s", i, export.Database, strings.ToLower(request.Format.String())),
-			Method: zip.Deflate,
-		}
-		fh.ModifiedDate, fh.ModifiedTime = timeToMsDosTime(time.Now())
-		if request.Password != "" {
-			fh.SetPassword(request.Password)
-		}
-		writer, err := zipw.CreateHeader(fh)
+		writer, err := createExportFile(zipw, i, export.Database, request)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to create encrypt export file")
+			return err
 		}
-		if _, err := io.Copy(writer, bytes.NewReader(export.Content)); err != nil {
-			return nil, errors.Wrapf(err, "failed to write export file")
+		if _, err := writer.Write(export.Content); err != nil {
+			return errors.Wrapf(err, "failed to write export file")
 		}
 	}
-
 	if err := zipw.Close(); err != nil {
-		return nil, errors.Wrap(err, "failed to close zip writer")
+		return errors.Wrap(err, "failed to close zip writer")
 	}
+	return nil
+}
 
-	return b.Bytes(), nil
+// createExportFile creates the export file in the zip archive, the file is encrypted if the password is set.
+func createExportFile(zipw *zip.Writer, index int, database string, request *v1pb.ExportRequest) (io.Writer, error) {
+	fh := &zip.FileHeader{
+		Name:   fmt.Sprintf("[%d] %s.%s", index, database, strings.ToLower(request.Format.String())),
+		Method: zip.Deflate,
+	}
+	fh.ModifiedDate, fh.ModifiedTime = timeToMsDosTime(time.Now())
+	if request.Password != "" {
+		fh.SetPassword(request.Password)
+	}
+	writer, err := zipw.CreateHeader(fh)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create encrypt export file")
+	}
+	return writer, nil
 }
 
 // timeToMsDosTime converts a time.Time to an MS-DOS date and time.
@@ -1396,7 +1615,7 @@ func (s *SQLService) accessCheck(
 					return connect.NewError(connect.CodeInternal, errors.New(err.Error()))
 				}
 
-				ok, err := s.hasDatabaseAccessRights(ctx, user, []*storepb.IamPolicy{workspacePolicy.Policy, projectPolicy.Policy}, attributes, isExport)
+				ok, err := s.hasDatabaseAccessRights(ctx, user, project.ResourceID, []*storepb.IamPolicy{workspacePolicy.Policy, projectPolicy.Policy}, attributes, isExport)
 				if err != nil {
 					return connect.NewError(connect.CodeInternal, errors.Errorf("failed to check access control for database: %q, error %v", column.Database, err))
 				}
@@ -1493,11 +1712,17 @@ func validateQueryRequest(instance *store.InstanceMessage, statement string) err
 	return nil
 }
 
-func (s *SQLService) hasDatabaseAccessRights(ctx context.Context, user *store.UserMessage, iamPolicies []*storepb.IamPolicy, attributes map[string]any, isExport bool) (bool, error) {
+func (s *SQLService) hasDatabaseAccessRights(ctx context.Context, user *store.UserMessage, projectID string, iamPolicies []*storepb.IamPolicy, attributes map[string]any, isExport bool) (bool, error) {
 	wantPermission := iam.PermissionSQLSelect
 	if isExport {
 		wantPermission = iam.PermissionSQLExport
 	}
+	// The role permissions are read directly, so the scope of the personal access token is checked here.
+	if accessToken, ok := common.GetAccessTokenFromContext(ctx); ok && accessToken.PrincipalID == user.ID {
+		if !accessToken.AllowPermission(wantPermission) || !accessToken.AllowProjects(projectID) {
+			return false, nil
+		}
+	}
 
 	bindings := utils.GetUserIAMPolicyBindings(ctx, s.store, user, iamPolicies...)
 	for _, binding := range bindings {
@@ -1719,7 +1944,26 @@ func convertToV1Advice(advice *storepb.Advice) *v1pb.Advice {
 		Content:       advice.Content,
 		StartPosition: convertToPosition(advice.StartPosition),
 		EndPosition:   convertToPosition(advice.EndPosition),
+		Fixes:         convertToV1AdviceFixes(advice.Fixes),
+	}
+}
+
+func convertToV1AdviceFixes(fixes []*storepb.Advice_Fix) []*v1pb.Advice_Fix {
+	var v1Fixes []*v1pb.Advice_Fix
+	for _, fix := range fixes {
+		v1Fix := &v1pb.Advice_Fix{
+			Title: fix.Title,
+		}
+		for _, edit := range fix.Edits {
+			v1Fix.Edits = append(v1Fix.Edits, &v1pb.Advice_TextEdit{
+				StartPosition: convertToPosition(edit.StartPosition),
+				EndPosition:   convertToPosition(edit.EndPosition),
+				NewText:       edit.NewText,
+			})
+		}
+		v1Fixes = append(v1Fixes, v1Fix)
 	}
+	return v1Fixes
 }
 
 func convertAdviceStatus(status storepb.Advice_Status) v1pb.Advice_Status {
@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// parquetRowGroupLength is the maximum number of rows buffered in a row group before it's flushed.
+const parquetRowGroupLength = 64 * 1024
+
+// parquetExporter writes the query result as an Apache Parquet file.
+type parquetExporter struct {
+	writer  *pqarrow.FileWriter
+	builder *array.RecordBuilder
+}
+
+// newParquetExporter creates the parquet exporter.
+// The column types are derived from the database type names of the result columns,
+// and the masked columns are written as strings because the masked values don't keep the original types.
+func newParquetExporter(w io.Writer, columns *v1pb.QueryResult, maskedColumns []bool) (*parquetExporter, error) {
+	var fields []arrow.Field
+	names := make(map[string]bool)
+	for i, columnName := range columns.ColumnNames {
+		// The field names must be unique in the parquet schema, e.g. SELECT a, a FROM t.
+		name := columnName
+		for suffix := 1; names[name]; suffix++ {
+			name = fmt.Sprintf("%s_%d", columnName, suffix)
+		}
+		names[name] = true
+
+		var dataType arrow.DataType = arrow.BinaryTypes.String
+		if i < len(columns.ColumnTypeNames) && (i >= len(maskedColumns) || !maskedColumns[i]) {
+			dataType = getParquetDataType(columns.ColumnTypeNames[i])
+		}
+		fields = append(fields, arrow.Field{Name: name, Type: dataType, Nullable: true})
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithMaxRowGroupLength(parquetRowGroupLength),
+	)
+	writer, err := pqarrow.NewFileWriter(schema, w, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create parquet writer")
+	}
+	return &parquetExporter{
+		writer:  writer,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, schema),
+	}, nil
+}
+
+func (e *parquetExporter) Write(result *v1pb.QueryResult) error {
+	if len(result.Rows) == 0 {
+		return nil
+	}
+	for _, row := range result.Rows {
+		for i, field := range e.builder.Fields() {
+			var value *v1pb.RowValue
+			if i < len(row.Values) {
+				value = row.Values[i]
+			}
+			if err := appendParquetValue(field, value); err != nil {
+				return errors.Wrapf(err, "failed to convert the value of column %q", e.builder.Schema().Field(i).Name)
+			}
+		}
+	}
+	record := e.builder.NewRecord()
+	defer record.Release()
+	return e.writer.WriteBuffered(record)
+}
+
+func (e *parquetExporter) Close() error {
+	e.builder.Release()
+	return e.writer.Close()
+}
+
+func getParquetDataType(typeName string) arrow.DataType {
+	switch typeName {
+	case "BOOL", "BOOLEAN":
+		return arrow.FixedWidthTypes.Boolean
+	case "INT", "INTEGER", "TINYINT", "SMALLINT", "MEDIUMINT", "BIGINT", "INT2", "INT4", "INT8":
+		return arrow.PrimitiveTypes.Int64
+	case "FLOAT", "DOUBLE", "REAL", "FLOAT4", "FLOAT8":
+		return arrow.PrimitiveTypes.Float64
+	case "DATETIME", "TIMESTAMP":
+		return &arrow.TimestampType{Unit: arrow.Microsecond}
+	case "TIMESTAMPTZ":
+		return arrow.FixedWidthTypes.Timestamp_us
+	case "BIT", "VARBIT", "BYTEA", "BINARY", "VARBINARY":
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// appendParquetValue appends the value to the column builder.
+// The values which cannot be represented in the column type, such as the MySQL zero date, are written as NULL.
+func appendParquetValue(builder array.Builder, value *v1pb.RowValue) error {
+	if value == nil || value.Kind == nil {
+		builder.AppendNull()
+		return nil
+	}
+	if _, ok := value.Kind.(*v1pb.RowValue_NullValue); ok {
+		builder.AppendNull()
+		return nil
+	}
+
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		switch kind := value.Kind.(type) {
+		case *v1pb.RowValue_BoolValue:
+			b.Append(kind.BoolValue)
+		case *v1pb.RowValue_StringValue:
+			v, err := strconv.ParseBool(kind.StringValue)
+			if err != nil {
+				b.AppendNull()
+				return nil
+			}
+			b.Append(v)
+		default:
+			return errors.Errorf("unexpected value type %T for boolean", value.Kind)
+		}
+	case *array.Int64Builder:
+		switch kind := value.Kind.(type) {
+		case *v1pb.RowValue_Int32Value:
+			b.Append(int64(kind.Int32Value))
+		case *v1pb.RowValue_Int64Value:
+			b.Append(kind.Int64Value)
+		case *v1pb.RowValue_Uint32Value:
+			b.Append(int64(kind.Uint32Value))
+		case *v1pb.RowValue_Uint64Value:
+			if kind.Uint64Value > math.MaxInt64 {
+				b.AppendNull()
+				return nil
+			}
+			b.Append(int64(kind.Uint64Value))
+		case *v1pb.RowValue_StringValue:
+			v, err := strconv.ParseInt(kind.StringValue, 10, 64)
+			if err != nil {
+				b.AppendNull()
+				return nil
+			}
+			b.Append(v)
+		default:
+			return errors.Errorf("unexpected value type %T for integer", value.Kind)
+		}
+	case *array.Float64Builder:
+		switch kind := value.Kind.(type) {
+		case *v1pb.RowValue_FloatValue:
+			b.Append(float64(kind.FloatValue))
+		case *v1pb.RowValue_DoubleValue:
+			b.Append(kind.DoubleValue)
+		case *v1pb.RowValue_Int32Value:
+			b.Append(float64(kind.Int32Value))
+		case *v1pb.RowValue_Int64Value:
+			b.Append(float64(kind.Int64Value))
+		case *v1pb.RowValue_StringValue:
+			v, err := strconv.ParseFloat(kind.StringValue, 64)
+			if err != nil {
+				b.AppendNull()
+				return nil
+			}
+			b.Append(v)
+		default:
+			return errors.Errorf("unexpected value type %T for float", value.Kind)
+		}
+	case *array.TimestampBuilder:
+		var t time.Time
+		switch kind := value.Kind.(type) {
+		case *v1pb.RowValue_TimestampValue:
+			t = kind.TimestampValue.GetGoogleTimestamp().AsTime()
+		case *v1pb.RowValue_TimestampTzValue:
+			t = kind.TimestampTzValue.GetGoogleTimestamp().AsTime()
+		case *v1pb.RowValue_StringValue:
+			// E.g. the MySQL zero date 0000-00-00 00:00:00.
+			v, err := time.Parse(time.DateTime, kind.StringValue)
+			if err != nil {
+				b.AppendNull()
+				return nil
+			}
+			t = v
+		default:
+			return errors.Errorf("unexpected value type %T for timestamp", value.Kind)
+		}
+		b.Append(arrow.Timestamp(t.UnixMicro()))
+	case *array.BinaryBuilder:
+		switch kind := value.Kind.(type) {
+		case *v1pb.RowValue_BytesValue:
+			b.Append(kind.BytesValue)
+		case *v1pb.RowValue_StringValue:
+			b.Append([]byte(kind.StringValue))
+		default:
+			return errors.Errorf("unexpected value type %T for binary", value.Kind)
+		}
+	case *array.StringBuilder:
+		b.Append(convertValueToStringInXLSX(value))
+	default:
+		return errors.Errorf("unsupported parquet builder %T", builder)
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package v1
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -24,32 +26,97 @@ import (
 	"github.com/bytebase/bytebase/backend/utils"
 )
 
-func exportCSV(result *v1pb.QueryResult) ([]byte, error) {
+// resultExporter writes the query result into the export file chunk by chunk.
+type resultExporter interface {
+	// Write writes the rows of the query result chunk.
+	Write(result *v1pb.QueryResult) error
+	// Close writes the rest of the export file and releases the resources.
+	// It should be called even if Write fails.
+	Close() error
+}
+
+// newResultExporter creates the exporter for the format, the columns are taken from the first chunk of the query result.
+// The statement prefix is only used by the SQL format, and the masked columns are only used by the Parquet format.
+func newResultExporter(w io.Writer, format v1pb.ExportFormat, engine storepb.Engine, statementPrefix string, columns *v1pb.QueryResult, maskedColumns []bool) (resultExporter, error) {
+	switch format {
+	case v1pb.ExportFormat_CSV:
+		return newCSVExporter(w, columns.ColumnNames)
+	case v1pb.ExportFormat_JSON:
+		return newJSONExporter(w, columns.ColumnNames)
+	case v1pb.ExportFormat_NDJSON:
+		return newNDJSONExporter(w, columns.ColumnNames), nil
+	case v1pb.ExportFormat_SQL:
+		return newSQLExporter(w, engine, statementPrefix), nil
+	case v1pb.ExportFormat_XLSX:
+		return newXLSXExporter(w, columns.ColumnNames)
+	case v1pb.ExportFormat_PARQUET:
+		return newParquetExporter(w, columns, maskedColumns)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported export format: %s", format.String()))
+	}
+}
+
+// exportResult exports the whole query result in the format.
+func exportResult(format v1pb.ExportFormat, engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult) ([]byte, error) {
 	var buf bytes.Buffer
-	if _, err := buf.WriteString(strings.Join(result.ColumnNames, ",")); err != nil {
+	exporter, err := newResultExporter(&buf, format, engine, statementPrefix, result, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := exporter.Write(result); err != nil {
+		_ = exporter.Close()
 		return nil, err
 	}
-	if err := buf.WriteByte('\n'); err != nil {
+	if err := exporter.Close(); err != nil {
 		return nil, err
 	}
-	for i, row := range result.Rows {
+	return buf.Bytes(), nil
+}
+
+func exportCSV(result *v1pb.QueryResult) ([]byte, error) {
+	return exportResult(v1pb.ExportFormat_CSV, storepb.Engine_ENGINE_UNSPECIFIED, "", result)
+}
+
+type csvExporter struct {
+	w        *bufio.Writer
+	rowCount int
+}
+
+func newCSVExporter(w io.Writer, columnNames []string) (*csvExporter, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(strings.Join(columnNames, ",")); err != nil {
+		return nil, err
+	}
+	if err := bw.WriteByte('\n'); err != nil {
+		return nil, err
+	}
+	return &csvExporter{w: bw}, nil
+}
+
+func (e *csvExporter) Write(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		if e.rowCount > 0 {
+			if err := e.w.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
 		for i, value := range row.Values {
 			if i != 0 {
-				if err := buf.WriteByte(','); err != nil {
-					return nil, err
+				if err := e.w.WriteByte(','); err != nil {
+					return err
 				}
 			}
-			if _, err := buf.Write(convertValueToBytesInCSV(value)); err != nil {
-				return nil, err
-			}
-		}
-		if i != len(result.Rows)-1 {
-			if err := buf.WriteByte('\n'); err != nil {
-				return nil, err
+			if _, err := e.w.Write(convertValueToBytesInCSV(value)); err != nil {
+				return err
 			}
 		}
+		e.rowCount++
 	}
-	return buf.Bytes(), nil
+	return nil
+}
+
+func (e *csvExporter) Close() error {
+	return e.w.Flush()
 }
 
 func convertValueToBytesInCSV(value *v1pb.RowValue) []byte {
@@ -145,32 +212,54 @@ func getSQLStatementPrefix(engine storepb.Engine, resourceList []base.SchemaReso
 }
 
 func exportSQL(engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult) ([]byte, error) {
-	var buf bytes.Buffer
-	for i, row := range result.Rows {
-		if _, err := buf.WriteString(statementPrefix); err != nil {
-			return nil, err
+	return exportResult(v1pb.ExportFormat_SQL, engine, statementPrefix, result)
+}
+
+type sqlExporter struct {
+	w               *bufio.Writer
+	engine          storepb.Engine
+	statementPrefix string
+	rowCount        int
+}
+
+func newSQLExporter(w io.Writer, engine storepb.Engine, statementPrefix string) *sqlExporter {
+	return &sqlExporter{
+		w:               bufio.NewWriter(w),
+		engine:          engine,
+		statementPrefix: statementPrefix,
+	}
+}
+
+func (e *sqlExporter) Write(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		if e.rowCount > 0 {
+			if err := e.w.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+		if _, err := e.w.WriteString(e.statementPrefix); err != nil {
+			return err
 		}
 		for i, value := range row.Values {
 			if i != 0 {
-				if err := buf.WriteByte(','); err != nil {
-					return nil, err
+				if err := e.w.WriteByte(','); err != nil {
+					return err
 				}
 			}
-			if _, err := buf.Write(convertValueToBytesInSQL(engine, value)); err != nil {
-				return nil, err
+			if _, err := e.w.Write(convertValueToBytesInSQL(e.engine, value)); err != nil {
+				return err
 			}
 		}
-		if i != len(result.Rows)-1 {
-			if _, err := buf.WriteString(");\n"); err != nil {
-				return nil, err
-			}
-		} else {
-			if _, err := buf.WriteString(");"); err != nil {
-				return nil, err
-			}
+		if _, err := e.w.WriteString(");"); err != nil {
+			return err
 		}
+		e.rowCount++
 	}
-	return buf.Bytes(), nil
+	return nil
+}
+
+func (e *sqlExporter) Close() error {
+	return e.w.Flush()
 }
 
 func convertValueToBytesInSQL(engine storepb.Engine, value *v1pb.RowValue) []byte {
@@ -683,43 +772,89 @@ func getResources(ctx context.Context, storeInstance *store.Store, engine storep
 }
 
 func exportJSON(result *v1pb.QueryResult) ([]byte, error) {
-	var buf bytes.Buffer
-	if _, err := buf.WriteString("["); err != nil {
+	return exportResult(v1pb.ExportFormat_JSON, storepb.Engine_ENGINE_UNSPECIFIED, "", result)
+}
+
+type jsonExporter struct {
+	w           *bufio.Writer
+	columnNames []string
+	rowCount    int
+}
+
+func newJSONExporter(w io.Writer, columnNames []string) (*jsonExporter, error) {
+	bw := bufio.NewWriter(w)
+	if err := bw.WriteByte('['); err != nil {
 		return nil, err
 	}
+	return &jsonExporter{w: bw, columnNames: columnNames}, nil
+}
 
-	for rowIndex, row := range result.Rows {
-		if _, err := buf.WriteString("{"); err != nil {
-			return nil, err
-		}
-		for i, value := range row.Values {
-			if _, err := buf.WriteString(fmt.Sprintf(`"%s":`, result.ColumnNames[i])); err != nil {
-				return nil, err
-			}
-			if _, err := buf.WriteString(convertValueToStringInJSON(value)); err != nil {
-				return nil, err
-			}
-			if i != len(row.Values)-1 {
-				if _, err := buf.WriteString(","); err != nil {
-					return nil, err
-				}
+func (e *jsonExporter) Write(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		if e.rowCount > 0 {
+			if err := e.w.WriteByte(','); err != nil {
+				return err
 			}
 		}
-		if _, err := buf.WriteString("}"); err != nil {
-			return nil, err
+		if err := writeJSONRow(e.w, e.columnNames, row); err != nil {
+			return err
 		}
+		e.rowCount++
+	}
+	return nil
+}
 
-		if rowIndex != len(result.Rows)-1 {
-			if _, err := buf.WriteString(","); err != nil {
-				return nil, err
-			}
+func (e *jsonExporter) Close() error {
+	if err := e.w.WriteByte(']'); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+// ndjsonExporter writes the rows as newline-delimited JSON objects.
+type ndjsonExporter struct {
+	w           *bufio.Writer
+	columnNames []string
+}
+
+func newNDJSONExporter(w io.Writer, columnNames []string) *ndjsonExporter {
+	return &ndjsonExporter{w: bufio.NewWriter(w), columnNames: columnNames}
+}
+
+func (e *ndjsonExporter) Write(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		if err := writeJSONRow(e.w, e.columnNames, row); err != nil {
+			return err
+		}
+		if err := e.w.WriteByte('\n'); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if _, err := buf.WriteString("]"); err != nil {
-		return nil, err
+func (e *ndjsonExporter) Close() error {
+	return e.w.Flush()
+}
+
+func writeJSONRow(w *bufio.Writer, columnNames []string, row *v1pb.QueryRow) error {
+	if err := w.WriteByte('{'); err != nil {
+		return err
 	}
-	return buf.Bytes(), nil
+	for i, value := range row.Values {
+		if _, err := fmt.Fprintf(w, `"%s":`, columnNames[i]); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(convertValueToStringInJSON(value)); err != nil {
+			return err
+		}
+		if i != len(row.Values)-1 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+	}
+	return w.WriteByte('}')
 }
 
 func convertValueToStringInJSON(value *v1pb.RowValue) string {
@@ -792,37 +927,72 @@ const (
 )
 
 func exportXLSX(result *v1pb.QueryResult) ([]byte, error) {
+	return exportResult(v1pb.ExportFormat_XLSX, storepb.Engine_ENGINE_UNSPECIFIED, "", result)
+}
+
+// xlsxExporter writes the rows with the excelize stream writer, which flushes the rows to a temporary file once the buffer is full.
+type xlsxExporter struct {
+	w        io.Writer
+	f        *excelize.File
+	sw       *excelize.StreamWriter
+	index    int
+	rowCount int
+}
+
+func newXLSXExporter(w io.Writer, columnNames []string) (*xlsxExporter, error) {
+	if len(columnNames) > 0 {
+		if _, err := getExcelColumnName(len(columnNames) - 1); err != nil {
+			return nil, err
+		}
+	}
 	f := excelize.NewFile()
-	defer f.Close()
-	index, err := f.NewSheet("Sheet1")
+	index, err := f.NewSheet(sheet1Name)
 	if err != nil {
+		f.Close()
 		return nil, err
 	}
-	var columnPrefixes []string
-	for i, columnName := range result.ColumnNames {
-		columnPrefix, err := getExcelColumnName(i)
-		if err != nil {
-			return nil, err
+	sw, err := f.NewStreamWriter(sheet1Name)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	var header []any
+	for _, columnName := range columnNames {
+		header = append(header, columnName)
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &xlsxExporter{w: w, f: f, sw: sw, index: index}, nil
+}
+
+func (e *xlsxExporter) Write(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		var values []any
+		for _, value := range row.Values {
+			values = append(values, convertValueToStringInXLSX(value))
 		}
-		columnPrefixes = append(columnPrefixes, columnPrefix)
-		if err := f.SetCellValue(sheet1Name, fmt.Sprintf("%s1", columnPrefix), columnName); err != nil {
-			return nil, err
+		// The first row is the header.
+		cell, err := excelize.CoordinatesToCellName(1, e.rowCount+2)
+		if err != nil {
+			return err
 		}
-	}
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
-			columnName := fmt.Sprintf("%s%d", columnPrefixes[j], i+2)
-			if err := f.SetCellValue("Sheet1", columnName, convertValueToStringInXLSX(value)); err != nil {
-				return nil, err
-			}
+		if err := e.sw.SetRow(cell, values); err != nil {
+			return err
 		}
+		e.rowCount++
 	}
-	f.SetActiveSheet(index)
-	excelBytes, err := f.WriteToBuffer()
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+func (e *xlsxExporter) Close() error {
+	defer e.f.Close()
+	if err := e.sw.Flush(); err != nil {
+		return err
 	}
-	return excelBytes.Bytes(), nil
+	e.f.SetActiveSheet(e.index)
+	return e.f.Write(e.w)
 }
 
 func getExcelColumnName(index int) (string, error) {
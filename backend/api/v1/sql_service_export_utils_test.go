@@ -1,9 +1,18 @@
 package v1
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -298,3 +307,128 @@ func TestExportJSON(t *testing.T) {
 		a.Equal(test.want, string(got))
 	}
 }
+
+func TestExportNDJSON(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "Alice"}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+				},
+			},
+		},
+	}
+
+	a := assert.New(t)
+	// The rows are written chunk by chunk.
+	var buf bytes.Buffer
+	exporter, err := newResultExporter(&buf, v1pb.ExportFormat_NDJSON, storepb.Engine_MYSQL, "", result, nil)
+	a.NoError(err)
+	for _, row := range result.Rows {
+		a.NoError(exporter.Write(&v1pb.QueryResult{Rows: []*v1pb.QueryRow{row}}))
+	}
+	a.NoError(exporter.Close())
+	a.Equal("{\"id\":1,\"name\":\"Alice\"}\n{\"id\":2,\"name\":null}\n", buf.String())
+}
+
+func TestExportParquet(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "name", "created_at", "id"},
+		ColumnTypeNames: []string{"BIGINT", "TEXT", "TIMESTAMP", "INT"},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "Alice"}},
+					{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))}}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+		},
+	}
+
+	a := assert.New(t)
+	var buf bytes.Buffer
+	// The last column is masked, so it's written as strings.
+	exporter, err := newResultExporter(&buf, v1pb.ExportFormat_PARQUET, storepb.Engine_MYSQL, "", result, []bool{false, false, false, true})
+	a.NoError(err)
+	a.NoError(exporter.Write(result))
+	a.NoError(exporter.Close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	a.NoError(err)
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	table, err := fileReader.ReadTable(context.Background())
+	a.NoError(err)
+	defer table.Release()
+
+	a.Equal(int64(2), table.NumRows())
+	var fields []string
+	for _, field := range table.Schema().Fields() {
+		fields = append(fields, fmt.Sprintf("%s:%s", field.Name, field.Type))
+	}
+	a.Equal([]string{"id:int64", "name:utf8", "created_at:timestamp[us]", "id_1:utf8"}, fields)
+	a.Equal("[1 2]", table.Column(0).Data().Chunk(0).String())
+	a.Equal(`["Alice" (null)]`, table.Column(1).Data().Chunk(0).String())
+	a.Equal(`["******" "******"]`, table.Column(3).Data().Chunk(0).String())
+}
+
+func TestExportParquetUnparsableValue(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"created_at", "count"},
+		ColumnTypeNames: []string{"DATETIME", "INT"},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-01-02 03:04:05"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "1"}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					// The MySQL zero date cannot be represented as a timestamp.
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "0000-00-00 00:00:00"}},
+					{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: math.MaxUint64}},
+				},
+			},
+		},
+	}
+
+	a := assert.New(t)
+	var buf bytes.Buffer
+	exporter, err := newResultExporter(&buf, v1pb.ExportFormat_PARQUET, storepb.Engine_MYSQL, "", result, nil)
+	a.NoError(err)
+	a.NoError(exporter.Write(result))
+	a.NoError(exporter.Close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	a.NoError(err)
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	table, err := fileReader.ReadTable(context.Background())
+	a.NoError(err)
+	defer table.Release()
+
+	a.Equal(int64(2), table.NumRows())
+	createdAt := table.Column(0).Data().Chunk(0)
+	a.False(createdAt.IsNull(0))
+	a.True(createdAt.IsNull(1))
+	a.Equal("[1 (null)]", table.Column(1).Data().Chunk(0).String())
+}
@@ -0,0 +1,848 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	pgquery "github.com/pganalyze/pg_query_go/v6"
+	tidbast "github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	tidbparser "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	queryPagesDefaultPageSize = 1000
+	queryPagesMaximumPageSize = 10000
+
+	// queryResultSessionTTL is the idle time before a query result session expires.
+	queryResultSessionTTL = 10 * time.Minute
+	// maximumQueryResultSessionsPerUser is the maximum number of the query result sessions kept in memory for a user,
+	// the least recently used session of the user is evicted when it's exceeded.
+	maximumQueryResultSessionsPerUser = 4
+)
+
+// integerColumnTypeRegexp matches the integer column types of MySQL, TiDB and PostgreSQL.
+var integerColumnTypeRegexp = regexp.MustCompile(`(?i)^((tiny|small|medium|big)?int(eger)?|int[248]|(small|big)?serial)\b`)
+
+// queryResultSessionStore keeps the query result sessions in memory.
+// The number of the sessions and the size of the cached results are limited per user,
+// so that the sessions of a user never evict the sessions of other users.
+// A session is only kept by the replica serving its first page, the other replicas rebuild it from the page token.
+type queryResultSessionStore struct {
+	mu sync.Mutex
+	// sessions are the sessions of each user, from the least recently used to the most recently used.
+	sessions map[int][]*queryResultSession
+}
+
+func newQueryResultSessionStore() *queryResultSessionStore {
+	return &queryResultSessionStore{
+		sessions: make(map[int][]*queryResultSession),
+	}
+}
+
+// get returns the session of the user, or nil if it's not found or expired.
+func (s *queryResultSessionStore) get(userID int, key string, now time.Time) *queryResultSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeExpiredLocked(now)
+	for _, session := range s.sessions[userID] {
+		if session.key == key {
+			return session
+		}
+	}
+	return nil
+}
+
+// add adds the session or refreshes its expiry, replacing the session with the same key.
+// The least recently used sessions of the user are evicted if the user has too many sessions.
+func (s *queryResultSessionStore) add(session *queryResultSession, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeExpiredLocked(now)
+	sessions := slices.DeleteFunc(s.sessions[session.userID], func(other *queryResultSession) bool {
+		return other.key == session.key
+	})
+	session.lastUsedTime = now
+	sessions = append(sessions, session)
+	if len(sessions) > maximumQueryResultSessionsPerUser {
+		sessions = slices.Delete(sessions, 0, len(sessions)-maximumQueryResultSessionsPerUser)
+	}
+	s.sessions[session.userID] = sessions
+}
+
+// remove removes the session.
+func (s *queryResultSessionStore) remove(session *queryResultSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setLocked(session.userID, slices.DeleteFunc(s.sessions[session.userID], func(other *queryResultSession) bool {
+		return other == session
+	}))
+}
+
+// reserve records the size of the result cached by the session. The least recently used sessions of the same user
+// are evicted until the cached results of the user fit in the maximum size, which is the maximum size of a query result.
+func (s *queryResultSessionStore) reserve(session *queryResultSession, size, maximumSize int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session.resultSize = size
+	if maximumSize <= 0 {
+		return
+	}
+	sessions := s.sessions[session.userID]
+	total := int64(0)
+	if !slices.Contains(sessions, session) {
+		total += size
+	}
+	for _, other := range sessions {
+		total += other.resultSize
+	}
+	for i := 0; total > maximumSize && i < len(sessions); {
+		if sessions[i] == session {
+			i++
+			continue
+		}
+		total -= sessions[i].resultSize
+		sessions = slices.Delete(sessions, i, i+1)
+	}
+	s.setLocked(session.userID, sessions)
+}
+
+func (s *queryResultSessionStore) removeExpiredLocked(now time.Time) {
+	for userID, sessions := range s.sessions {
+		s.setLocked(userID, slices.DeleteFunc(sessions, func(session *queryResultSession) bool {
+			return now.Sub(session.lastUsedTime) >= queryResultSessionTTL
+		}))
+	}
+}
+
+func (s *queryResultSessionStore) setLocked(userID int, sessions []*queryResultSession) {
+	if len(sessions) == 0 {
+		delete(s.sessions, userID)
+		return
+	}
+	s.sessions[userID] = sessions
+}
+
+// queryResultSession is the server-side cursor of a SQL Editor query, it's keyed by the user and the query span.
+type queryResultSession struct {
+	// mu serializes the page fetches of the session.
+	mu sync.Mutex
+	// lastUsedTime and resultSize are guarded by the session store.
+	lastUsedTime time.Time
+	resultSize   int64
+
+	key          string
+	userID       int
+	dataSourceID string
+	statement    string
+	schema       string
+	span         *parserbase.QuerySpan
+	pagination   v1pb.QueryPagesResponse_Pagination
+	allowExport  bool
+	// recorded indicates whether the query history is created for the session.
+	recorded bool
+
+	// offset is the number of the rows fetched by the keyset pagination.
+	offset int
+	// done indicates there are no more rows for the keyset pagination.
+	done bool
+	// keyColumns are the result column names of the unique key used by the keyset pagination.
+	keyColumns []string
+	// lastKey is the unique key of the last fetched row, it's nil before the first page.
+	lastKey []string
+
+	// result is the result with the unmasked rows used by the cached pagination, it's nil before the first page.
+	result *v1pb.QueryResult
+}
+
+// QueryPages executes the query in a result session and streams the result page by page.
+func (s *SQLService) QueryPages(ctx context.Context, req *connect.Request[v1pb.QueryPagesRequest], stream *connect.ServerStream[v1pb.QueryPagesResponse]) error {
+	request := req.Msg
+	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
+	if err != nil {
+		return err
+	}
+	engine := instance.Metadata.GetEngine()
+	if !common.EngineSupportQueryNewACL(engine) {
+		return connect.NewError(connect.CodeUnimplemented, errors.Errorf("query pages is not supported for engine %v", engine))
+	}
+	if database.Metadata.GetDatashare() {
+		return connect.NewError(connect.CodeUnimplemented, errors.New("query pages is not supported for datashare databases"))
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = queryPagesDefaultPageSize
+	}
+	if pageSize > queryPagesMaximumPageSize {
+		pageSize = queryPagesMaximumPageSize
+	}
+
+	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, s.licenseService, database, request.DataSourceId)
+	if err != nil {
+		return err
+	}
+
+	var driver db.Driver
+	var conn *sql.Conn
+	defer func() {
+		if conn != nil {
+			if err := conn.Close(); err != nil {
+				slog.Warn("failed to close connection", log.BBError(err))
+			}
+		}
+		if driver != nil {
+			driver.Close(ctx)
+		}
+	}()
+	// We only get the driver and connection when the page is fetched from the database.
+	getDriver := func() (db.Driver, *sql.Conn, error) {
+		if driver != nil {
+			return driver, conn, nil
+		}
+		d, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
+			DatabaseName: database.DatabaseName,
+			ReadOnly:     dataSource.GetType() == storepb.DataSourceType_READ_ONLY,
+		})
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
+		}
+		driver = d
+		if sqlDB := driver.GetDB(); sqlDB != nil {
+			conn, err = sqlDB.Conn(ctx)
+			if err != nil {
+				return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database connection: %v", err))
+			}
+		}
+		return driver, conn, nil
+	}
+
+	var session *queryResultSession
+	offset := 0
+	if request.PageToken != "" {
+		session, offset, err = s.getQueryResultSession(ctx, request, user, instance, database)
+	} else {
+		session, err = s.createQueryResultSession(ctx, request, user, instance, database)
+	}
+	if err != nil {
+		return err
+	}
+	s.resultSessions.add(session, time.Now())
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	for pageCount := 0; request.PageCount <= 0 || pageCount < int(request.PageCount); pageCount++ {
+		// Check the access for every page because the permission may be revoked during the session.
+		if err := s.accessCheck(ctx, instance, database, user, []*parserbase.QuerySpan{session.span}, pageSize, false /* isExplain */, false /* isExport */); err != nil {
+			return err
+		}
+
+		start := time.Now()
+		page, err := s.fetchQueryResultPage(ctx, session, user, engine, getDriver, offset, pageSize)
+		if !session.recorded {
+			session.recorded = true
+			if err := s.createQueryHistory(ctx, database, store.QueryHistoryTypeQuery, session.statement, user.ID, time.Since(start), err); err != nil {
+				slog.Error("failed to create query history", log.BBError(err))
+			}
+		}
+		if err != nil {
+			return err
+		}
+		// Mask the page every time because the masking policies may be changed during the session.
+		if s.licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
+			if err := NewQueryResultMasker(s.store).MaskResults(ctx, []*parserbase.QuerySpan{session.span}, []*v1pb.QueryResult{page}, instance, user, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
+				return connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+			}
+		}
+		page.AllowExport = session.allowExport
+		page.Latency = durationpb.New(time.Since(start))
+
+		offset += len(page.Rows)
+		hasMore := false
+		switch session.pagination {
+		case v1pb.QueryPagesResponse_KEYSET:
+			hasMore = !session.done
+		case v1pb.QueryPagesResponse_CACHED:
+			hasMore = offset < len(session.result.Rows)
+		default:
+		}
+		response := &v1pb.QueryPagesResponse{
+			Result:     page,
+			Pagination: session.pagination,
+		}
+		if hasMore {
+			token, err := marshalQueryResultPageToken(&storepb.QueryResultPageToken{
+				Session: session.key,
+				Offset:  int32(offset),
+				LastKey: session.lastKey,
+			})
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+			response.NextPageToken = token
+		}
+		// Refresh the expiry of the session.
+		s.resultSessions.add(session, time.Now())
+
+		if err := stream.Send(response); err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Errorf("failed to send response: %v", err))
+		}
+		if !hasMore {
+			s.resultSessions.remove(session)
+			break
+		}
+	}
+	return nil
+}
+
+// createQueryResultSession checks the query and creates the result session.
+func (s *SQLService) createQueryResultSession(ctx context.Context, request *v1pb.QueryPagesRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage) (*queryResultSession, error) {
+	engine := instance.Metadata.GetEngine()
+	span, err := s.getQueryPagesSpan(ctx, instance, database, request.Statement, request.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+	spans := []*parserbase.QuerySpan{span}
+	if err := s.accessCheck(ctx, instance, database, user, spans, 0, false /* isExplain */, false /* isExport */); err != nil {
+		return nil, err
+	}
+	if s.licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
+		if span.FunctionNotSupportedError != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to mask data: %v", span.FunctionNotSupportedError))
+		}
+		if span.NotFoundError != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to mask data: %v", span.NotFoundError))
+		}
+		sensitivePredicateColumns, err := NewQueryResultMasker(s.store).ExtractSensitivePredicateColumns(ctx, spans, instance, user, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+		}
+		if len(sensitivePredicateColumns) > 0 && len(sensitivePredicateColumns[0]) > 0 {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New(getSensitivePredicateColumnErrorMessages(sensitivePredicateColumns[0])))
+		}
+	}
+
+	session := &queryResultSession{
+		key:          getQueryResultSessionKey(user.ID, request, span),
+		userID:       user.ID,
+		dataSourceID: request.DataSourceId,
+		statement:    request.Statement,
+		schema:       request.GetSchema(),
+		span:         span,
+		pagination:   v1pb.QueryPagesResponse_CACHED,
+		// AllowExport is a validate only check.
+		allowExport: s.accessCheck(ctx, instance, database, user, spans, 0, false /* isExplain */, true /* isExport */) == nil,
+	}
+	keyColumns, err := s.getQuerySpanUniqueKey(ctx, instance, span, request.Statement)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(keyColumns) > 0 {
+		session.pagination = v1pb.QueryPagesResponse_KEYSET
+		session.keyColumns = keyColumns
+	}
+	return session, nil
+}
+
+// getQueryPagesSpan returns the query span of the statement, it syncs the database schema and retries if the source columns are not found.
+func (s *SQLService) getQueryPagesSpan(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, statement, schema string) (*parserbase.QuerySpan, error) {
+	getSpan := func() (*parserbase.QuerySpan, error) {
+		spans, err := parserbase.GetQuerySpan(
+			ctx,
+			parserbase.GetQuerySpanContext{
+				InstanceID:                    instance.ResourceID,
+				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(s.store),
+				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(s.store),
+				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(s.store, instance.Metadata.GetEngine()),
+			},
+			instance.Metadata.GetEngine(),
+			statement,
+			database.DatabaseName,
+			schema,
+			!store.IsObjectCaseSensitive(instance),
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if len(spans) != 1 || spans[0].Type != parserbase.Select {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only a single SELECT statement can be queried by pages"))
+		}
+		// After replacing backup table with source, we can apply the original access check and mask sensitive data for backup table.
+		if err := replaceBackupTableWithSource(ctx, s.store, instance, database, spans); err != nil {
+			slog.Debug("failed to replace backup table with source", log.BBError(err))
+		}
+		return spans[0], nil
+	}
+
+	span, err := getSpan()
+	if err != nil {
+		return nil, err
+	}
+	if span.NotFoundError == nil {
+		return span, nil
+	}
+	syncDatabaseMap := make(map[string]bool)
+	for k := range span.SourceColumns {
+		syncDatabaseMap[k.Database] = true
+	}
+	for accessDatabaseName := range syncDatabaseMap {
+		d, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &accessDatabaseName})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if d == nil {
+			continue
+		}
+		if err := s.schemaSyncer.SyncDatabaseSchema(ctx, d); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to sync database schema for database %q", accessDatabaseName))
+		}
+	}
+	return getSpan()
+}
+
+// getQueryResultSession returns the result session and the offset of the page token.
+// If the session is not kept by this replica, e.g. it's expired or the first page is served by another replica,
+// the session is rebuilt and resumed from the page token.
+func (s *SQLService) getQueryResultSession(ctx context.Context, request *v1pb.QueryPagesRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage) (*queryResultSession, int, error) {
+	token, err := unmarshalQueryResultPageToken(request.PageToken)
+	if err != nil {
+		return nil, 0, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid page token"))
+	}
+	offset := int(token.Offset)
+	if offset < 0 {
+		return nil, 0, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token offset"))
+	}
+	// The sessions are kept per user, so the session of other users is treated as not found.
+	if session := s.resultSessions.get(user.ID, token.Session, time.Now()); session != nil {
+		if session.statement != request.Statement || session.dataSourceID != request.DataSourceId || session.schema != request.GetSchema() {
+			return nil, 0, connect.NewError(connect.CodeInvalidArgument, errors.New("the statement, data source and schema must match the query result session"))
+		}
+		return session, offset, nil
+	}
+
+	session, err := s.createQueryResultSession(ctx, request, user, instance, database)
+	if err != nil {
+		return nil, 0, err
+	}
+	// The session key is derived from the user and the request, so the token must be issued for the same query of the user.
+	if session.key != token.Session {
+		return nil, 0, connect.NewError(connect.CodeNotFound, errors.New("query result session not found or expired, please run the query again"))
+	}
+	if err := session.resume(offset, token.LastKey); err != nil {
+		return nil, 0, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid page token"))
+	}
+	return session, offset, nil
+}
+
+// resume restores the position of the page token on a rebuilt session.
+// The keyset pagination continues after the last key of the token,
+// and the cached pagination runs the statement again and continues from the offset.
+func (session *queryResultSession) resume(offset int, lastKey []string) error {
+	// The query history is created when the session is created for the first page.
+	session.recorded = true
+	if session.pagination != v1pb.QueryPagesResponse_KEYSET {
+		return nil
+	}
+	if offset > 0 && len(lastKey) != len(session.keyColumns) {
+		return errors.New("the page token doesn't have the last key")
+	}
+	for _, value := range lastKey {
+		// The last key is put in the statement, so it must be an integer literal.
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+				return errors.Errorf("invalid last key %q", value)
+			}
+		}
+	}
+	session.offset = offset
+	session.lastKey = lastKey
+	return nil
+}
+
+// fetchQueryResultPage fetches the page with the unmasked rows starting from the offset, the session must be locked.
+func (s *SQLService) fetchQueryResultPage(ctx context.Context, session *queryResultSession, user *store.UserMessage, engine storepb.Engine, getDriver func() (db.Driver, *sql.Conn, error), offset, pageSize int) (*v1pb.QueryResult, error) {
+	queryRestriction := getMaximumSQLResultLimit(ctx, s.store, s.licenseService, 0)
+	query := func(statement string, limit int) (*v1pb.QueryResult, error) {
+		driver, conn, err := getDriver()
+		if err != nil {
+			return nil, err
+		}
+		queryContext := db.QueryContext{
+			Schema:               session.schema,
+			Limit:                limit,
+			OperatorEmail:        user.Email,
+			MaximumSQLResultSize: queryRestriction.GetMaximumResultSize(),
+		}
+		results, _, err := executeWithTimeout(ctx, s.store, s.licenseService, driver, conn, statement, queryContext)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+		}
+		if len(results) != 1 {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("expected one query result, but got %d", len(results)))
+		}
+		if results[0].Error != "" {
+			return nil, connect.NewError(connect.CodeInternal, errors.New(results[0].Error))
+		}
+		return results[0], nil
+	}
+
+	cached := session.result != nil
+	page, err := session.fetchPage(engine, query, offset, pageSize, int(queryRestriction.GetMaximumResultRows()))
+	if err != nil {
+		return nil, err
+	}
+	if !cached && session.result != nil {
+		// The cached results of a user are limited to the maximum size of a query result in total.
+		s.resultSessions.reserve(session, int64(proto.Size(session.result)), queryRestriction.GetMaximumResultSize())
+	}
+	return page, nil
+}
+
+// fetchPage fetches the page with the unmasked rows starting from the offset by the query, the session must be locked.
+// The query runs the statement and returns at most limit rows if the limit is positive.
+// The maximum result rows of the SQL query restriction is applied to the whole session.
+func (session *queryResultSession) fetchPage(engine storepb.Engine, query func(statement string, limit int) (*v1pb.QueryResult, error), offset, pageSize, maximumResultRows int) (*v1pb.QueryResult, error) {
+	switch session.pagination {
+	case v1pb.QueryPagesResponse_KEYSET:
+		// The keyset only moves forward, so the page token must be the latest one of the session.
+		if offset != session.offset {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the page token is stale, please run the query again"))
+		}
+		if maximumResultRows > 0 {
+			pageSize = min(pageSize, maximumResultRows-offset)
+		}
+		if session.done || pageSize <= 0 {
+			session.done = true
+			return &v1pb.QueryResult{Statement: session.statement}, nil
+		}
+		result, err := query(buildKeysetPageStatement(engine, session.statement, session.keyColumns, session.lastKey, pageSize), pageSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Rows) > 0 {
+			lastKey, err := getKeysetKey(result, session.keyColumns, result.Rows[len(result.Rows)-1])
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			session.lastKey = lastKey
+		}
+		session.offset += len(result.Rows)
+		session.done = len(result.Rows) < pageSize || (maximumResultRows > 0 && session.offset >= maximumResultRows)
+		result.Statement = session.statement
+		return result, nil
+	case v1pb.QueryPagesResponse_CACHED:
+		if session.result == nil {
+			result, err := query(session.statement, maximumResultRows)
+			if err != nil {
+				return nil, err
+			}
+			session.result = result
+		}
+		if offset > len(session.result.Rows) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("page token offset %d exceeds %d rows", offset, len(session.result.Rows)))
+		}
+		end := min(offset+pageSize, len(session.result.Rows))
+		// Copy the rows because the masking changes the values in place.
+		rows := make([]*v1pb.QueryRow, 0, end-offset)
+		for _, row := range session.result.Rows[offset:end] {
+			rows = append(rows, proto.CloneOf(row))
+		}
+		return &v1pb.QueryResult{
+			ColumnNames:     session.result.ColumnNames,
+			ColumnTypeNames: session.result.ColumnTypeNames,
+			Rows:            rows,
+			RowsCount:       int64(len(rows)),
+			Statement:       session.statement,
+		}, nil
+	default:
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("unexpected pagination %v", session.pagination))
+	}
+}
+
+// getQuerySpanUniqueKey returns the result column names of the unique key if the query span proves a stable ordering,
+// that's the statement selects from a single base table, all the result columns are plain fields of the table,
+// and the integer primary key of the table is in the result.
+// It returns nil if the keyset pagination is not applicable.
+func (s *SQLService) getQuerySpanUniqueKey(ctx context.Context, instance *store.InstanceMessage, span *parserbase.QuerySpan, statement string) ([]string, error) {
+	engine := instance.Metadata.GetEngine()
+	from := getKeysetTable(engine, statement)
+	if from == nil || len(span.Results) == 0 {
+		return nil, nil
+	}
+
+	var table *parserbase.ColumnResource
+	resultNames := make(map[string]bool)
+	// sourceColumnResults maps the source column names to the result column names.
+	sourceColumnResults := make(map[string]string)
+	for _, result := range span.Results {
+		if !result.IsPlainField || len(result.SourceColumns) != 1 {
+			return nil, nil
+		}
+		// The result names must be unique to be referenced in the keyset condition.
+		name := strings.ToLower(result.Name)
+		if resultNames[name] {
+			return nil, nil
+		}
+		resultNames[name] = true
+		for column := range result.SourceColumns {
+			if column.Server != "" {
+				return nil, nil
+			}
+			if table == nil {
+				table = &parserbase.ColumnResource{Database: column.Database, Schema: column.Schema, Table: column.Table}
+			} else if table.Database != column.Database || table.Schema != column.Schema || table.Table != column.Table {
+				return nil, nil
+			}
+			// Multiple result columns may come from the same source column, the first one is used.
+			if _, ok := sourceColumnResults[column.Column]; !ok {
+				sourceColumnResults[column.Column] = result.Name
+			}
+		}
+	}
+
+	// The table in FROM must be the source table, e.g. it's not a view selecting from the source table.
+	if !from.matches(engine, table) {
+		return nil, nil
+	}
+
+	dbSchema, err := s.store.GetDBSchema(ctx, instance.ResourceID, table.Database)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database schema %q", table.Database)
+	}
+	if dbSchema == nil || dbSchema.GetDatabaseMetadata() == nil {
+		return nil, nil
+	}
+	schemaMetadata := dbSchema.GetDatabaseMetadata().GetSchema(table.Schema)
+	if schemaMetadata == nil {
+		return nil, nil
+	}
+	tableMetadata := schemaMetadata.GetTable(table.Table)
+	if tableMetadata == nil {
+		return nil, nil
+	}
+	primaryKey := tableMetadata.GetPrimaryKey()
+	if primaryKey == nil || len(primaryKey.GetProto().GetExpressions()) == 0 {
+		return nil, nil
+	}
+	var keyColumns []string
+	for _, expression := range primaryKey.GetProto().GetExpressions() {
+		column := tableMetadata.GetColumn(expression)
+		if column == nil || !integerColumnTypeRegexp.MatchString(column.Type) {
+			return nil, nil
+		}
+		name, ok := sourceColumnResults[expression]
+		if !ok {
+			return nil, nil
+		}
+		keyColumns = append(keyColumns, name)
+	}
+	return keyColumns, nil
+}
+
+// keysetTable is the table in the FROM clause of a statement eligible for the keyset pagination.
+type keysetTable struct {
+	// schema is the database for MySQL and TiDB, or the schema for PostgreSQL. It's empty if the table is not qualified.
+	schema string
+	table  string
+}
+
+// getKeysetTable returns the table if the statement is a SELECT from a single base table without joins, DISTINCT,
+// GROUP BY, HAVING, window clauses, ORDER BY, LIMIT, locking clauses or set operations.
+// Wrapping such a statement in the keyset condition and ordering doesn't change the rows it returns.
+// It returns nil if the statement is not eligible.
+func getKeysetTable(engine storepb.Engine, statement string) *keysetTable {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB:
+		return getMySQLKeysetTable(statement)
+	case storepb.Engine_POSTGRES:
+		return getPostgresKeysetTable(statement)
+	default:
+		return nil
+	}
+}
+
+func getMySQLKeysetTable(statement string) *keysetTable {
+	nodes, err := tidbparser.ParseTiDB(statement, "", "")
+	if err != nil || len(nodes) != 1 {
+		return nil
+	}
+	// The set operations are parsed as *ast.SetOprStmt.
+	sel, ok := nodes[0].(*tidbast.SelectStmt)
+	if !ok || sel.Kind != tidbast.SelectStmtKindSelect {
+		return nil
+	}
+	if sel.Distinct || (sel.SelectStmtOpts != nil && sel.SelectStmtOpts.Distinct) {
+		return nil
+	}
+	if sel.With != nil || sel.GroupBy != nil || sel.Having != nil || len(sel.WindowSpecs) > 0 || sel.OrderBy != nil || sel.Limit != nil || sel.LockInfo != nil || sel.SelectIntoOpt != nil {
+		return nil
+	}
+	if sel.From == nil || sel.From.TableRefs == nil || sel.From.TableRefs.Right != nil {
+		return nil
+	}
+	source, ok := sel.From.TableRefs.Left.(*tidbast.TableSource)
+	if !ok {
+		return nil
+	}
+	name, ok := source.Source.(*tidbast.TableName)
+	if !ok || name.AsOf != nil || name.TableSample != nil {
+		return nil
+	}
+	return &keysetTable{schema: name.Schema.O, table: name.Name.O}
+}
+
+func getPostgresKeysetTable(statement string) *keysetTable {
+	tree, err := pgquery.Parse(statement)
+	if err != nil || len(tree.GetStmts()) != 1 {
+		return nil
+	}
+	sel := tree.GetStmts()[0].GetStmt().GetSelectStmt()
+	if sel == nil || sel.GetOp() != pgquery.SetOperation_SETOP_NONE || len(sel.GetValuesLists()) > 0 {
+		return nil
+	}
+	if sel.GetWithClause() != nil || len(sel.GetDistinctClause()) > 0 || len(sel.GetGroupClause()) > 0 || sel.GetHavingClause() != nil || len(sel.GetWindowClause()) > 0 {
+		return nil
+	}
+	if len(sel.GetSortClause()) > 0 || sel.GetLimitCount() != nil || sel.GetLimitOffset() != nil || len(sel.GetLockingClause()) > 0 || sel.GetIntoClause() != nil {
+		return nil
+	}
+	if len(sel.GetFromClause()) != 1 {
+		return nil
+	}
+	// The joins, subqueries, functions and table samples in FROM are not range vars.
+	rangeVar := sel.GetFromClause()[0].GetRangeVar()
+	if rangeVar == nil {
+		return nil
+	}
+	return &keysetTable{schema: rangeVar.GetSchemaname(), table: rangeVar.GetRelname()}
+}
+
+// matches returns whether the table is the source table of the query span.
+func (t *keysetTable) matches(engine storepb.Engine, table *parserbase.ColumnResource) bool {
+	if engine == storepb.Engine_POSTGRES {
+		return (t.schema == "" || t.schema == table.Schema) && t.table == table.Table
+	}
+	return (t.schema == "" || strings.EqualFold(t.schema, table.Database)) && strings.EqualFold(t.table, table.Table)
+}
+
+// buildKeysetPageStatement builds the statement fetching the page after the last key ordered by the key columns,
+// e.g. SELECT * FROM (SELECT * FROM t) AS bb_page WHERE (`id`) > (100) ORDER BY `id` LIMIT 1000.
+func buildKeysetPageStatement(engine storepb.Engine, statement string, keyColumns []string, lastKey []string, limit int) string {
+	quote := func(identifier string) string {
+		if engine == storepb.Engine_POSTGRES {
+			return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+		}
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+	}
+	var quotedColumns []string
+	for _, column := range keyColumns {
+		quotedColumns = append(quotedColumns, quote(column))
+	}
+	keys := strings.Join(quotedColumns, ", ")
+
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "SELECT * FROM (%s) AS bb_page", strings.TrimRight(strings.TrimSpace(statement), "; \t\n"))
+	if len(lastKey) > 0 {
+		_, _ = fmt.Fprintf(&buf, " WHERE (%s) > (%s)", keys, strings.Join(lastKey, ", "))
+	}
+	_, _ = fmt.Fprintf(&buf, " ORDER BY %s LIMIT %d", keys, limit)
+	return buf.String()
+}
+
+// getKeysetKey returns the integer literals of the key columns of the row.
+// The key columns are integers, so the literals are safe to be put in the statement.
+func getKeysetKey(result *v1pb.QueryResult, keyColumns []string, row *v1pb.QueryRow) ([]string, error) {
+	var key []string
+	for _, column := range keyColumns {
+		index := slices.IndexFunc(result.ColumnNames, func(name string) bool {
+			return strings.EqualFold(name, column)
+		})
+		if index < 0 || index >= len(row.Values) {
+			return nil, errors.Errorf("key column %q not found in the result", column)
+		}
+		switch value := row.Values[index].GetKind().(type) {
+		case *v1pb.RowValue_Int32Value:
+			key = append(key, strconv.FormatInt(int64(value.Int32Value), 10))
+		case *v1pb.RowValue_Int64Value:
+			key = append(key, strconv.FormatInt(value.Int64Value, 10))
+		case *v1pb.RowValue_Uint32Value:
+			key = append(key, strconv.FormatUint(uint64(value.Uint32Value), 10))
+		case *v1pb.RowValue_Uint64Value:
+			key = append(key, strconv.FormatUint(value.Uint64Value, 10))
+		case *v1pb.RowValue_StringValue:
+			v, err := strconv.ParseInt(value.StringValue, 10, 64)
+			if err != nil {
+				u, uerr := strconv.ParseUint(value.StringValue, 10, 64)
+				if uerr != nil {
+					return nil, errors.Errorf("key column %q has non-integer value %q", column, value.StringValue)
+				}
+				key = append(key, strconv.FormatUint(u, 10))
+				continue
+			}
+			key = append(key, strconv.FormatInt(v, 10))
+		default:
+			return nil, errors.Errorf("key column %q has unexpected value type %T", column, value)
+		}
+	}
+	return key, nil
+}
+
+// getQueryResultSessionKey returns the key of the query result session, which is derived from the user and the query span.
+func getQueryResultSessionKey(userID int, request *v1pb.QueryPagesRequest, span *parserbase.QuerySpan) string {
+	var sourceColumns []string
+	for _, result := range span.Results {
+		var columns []string
+		for column := range result.SourceColumns {
+			columns = append(columns, column.String())
+		}
+		slices.Sort(columns)
+		sourceColumns = append(sourceColumns, fmt.Sprintf("%s(%s)", result.Name, strings.Join(columns, ",")))
+	}
+	h := sha256.New()
+	for _, part := range []string{strconv.Itoa(userID), request.Name, request.DataSourceId, request.GetSchema(), request.Statement, strings.Join(sourceColumns, ";")} {
+		_, _ = h.Write([]byte(part))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func marshalQueryResultPageToken(token *storepb.QueryResultPageToken) (string, error) {
+	b, err := proto.Marshal(token)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal page token")
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func unmarshalQueryResultPageToken(s string) (*storepb.QueryResultPageToken, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode page token")
+	}
+	token := &storepb.QueryResultPageToken{}
+	if err := proto.Unmarshal(b, token); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal page token")
+	}
+	return token, nil
+}
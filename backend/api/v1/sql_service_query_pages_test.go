@@ -0,0 +1,275 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestBuildKeysetPageStatement(t *testing.T) {
+	a := require.New(t)
+	testCases := []struct {
+		engine     storepb.Engine
+		statement  string
+		keyColumns []string
+		lastKey    []string
+		want       string
+	}{
+		{
+			engine:     storepb.Engine_MYSQL,
+			statement:  "SELECT * FROM t;",
+			keyColumns: []string{"id"},
+			want:       "SELECT * FROM (SELECT * FROM t) AS bb_page ORDER BY `id` LIMIT 100",
+		},
+		{
+			engine:     storepb.Engine_MYSQL,
+			statement:  "SELECT * FROM t WHERE a = 1",
+			keyColumns: []string{"tenant_id", "id"},
+			lastKey:    []string{"3", "42"},
+			want:       "SELECT * FROM (SELECT * FROM t WHERE a = 1) AS bb_page WHERE (`tenant_id`, `id`) > (3, 42) ORDER BY `tenant_id`, `id` LIMIT 100",
+		},
+		{
+			engine:     storepb.Engine_POSTGRES,
+			statement:  "SELECT id AS \"ID\", name FROM t",
+			keyColumns: []string{"ID"},
+			lastKey:    []string{"-1"},
+			want:       "SELECT * FROM (SELECT id AS \"ID\", name FROM t) AS bb_page WHERE (\"ID\") > (-1) ORDER BY \"ID\" LIMIT 100",
+		},
+	}
+	for _, tc := range testCases {
+		a.Equal(tc.want, buildKeysetPageStatement(tc.engine, tc.statement, tc.keyColumns, tc.lastKey, 100))
+	}
+}
+
+func TestGetKeysetKey(t *testing.T) {
+	a := require.New(t)
+	result := &v1pb.QueryResult{ColumnNames: []string{"name", "ID", "tenant_id"}}
+	row := &v1pb.QueryRow{Values: []*v1pb.RowValue{
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "alice"}},
+		{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 18446744073709551615}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "7"}},
+	}}
+	key, err := getKeysetKey(result, []string{"tenant_id", "id"}, row)
+	a.NoError(err)
+	a.Equal([]string{"7", "18446744073709551615"}, key)
+
+	// The non-integer values must not be put in the statement.
+	_, err = getKeysetKey(result, []string{"name"}, row)
+	a.Error(err)
+	_, err = getKeysetKey(result, []string{"missing"}, row)
+	a.Error(err)
+}
+
+func TestGetKeysetTable(t *testing.T) {
+	a := require.New(t)
+	testCases := []struct {
+		engine    storepb.Engine
+		statement string
+		want      *keysetTable
+	}{
+		{storepb.Engine_MYSQL, "SELECT * FROM orders WHERE status = 'order by';", &keysetTable{table: "orders"}},
+		{storepb.Engine_MYSQL, "SELECT id, name FROM shop.Orders o", &keysetTable{schema: "shop", table: "Orders"}},
+		{storepb.Engine_TIDB, "SELECT * FROM orders WHERE id IN (SELECT order_id FROM items)", &keysetTable{table: "orders"}},
+		{storepb.Engine_MYSQL, "SELECT * FROM orders ORDER  BY created_at", nil},
+		{storepb.Engine_MYSQL, "select * from orders limit 10", nil},
+		{storepb.Engine_MYSQL, "SELECT id FROM a UNION SELECT id FROM b", nil},
+		{storepb.Engine_MYSQL, "SELECT DISTINCT customer_id FROM orders", nil},
+		{storepb.Engine_MYSQL, "SELECT customer_id FROM orders GROUP BY customer_id", nil},
+		{storepb.Engine_MYSQL, "SELECT * FROM orders o JOIN items i ON o.id = i.order_id", nil},
+		{storepb.Engine_MYSQL, "SELECT * FROM orders, items", nil},
+		{storepb.Engine_MYSQL, "SELECT * FROM (SELECT * FROM orders) t", nil},
+		{storepb.Engine_MYSQL, "WITH t AS (SELECT * FROM orders) SELECT * FROM t", nil},
+		{storepb.Engine_MYSQL, "SELECT 1", nil},
+		{storepb.Engine_MYSQL, "SELECT * FROM a; SELECT * FROM b", nil},
+		{storepb.Engine_POSTGRES, "SELECT * FROM public.orders WHERE note = 'limit'", &keysetTable{schema: "public", table: "orders"}},
+		{storepb.Engine_POSTGRES, `SELECT * FROM "Orders"`, &keysetTable{table: "Orders"}},
+		{storepb.Engine_POSTGRES, "SELECT * FROM orders FETCH FIRST 3 ROWS ONLY", nil},
+		{storepb.Engine_POSTGRES, "SELECT * FROM orders OFFSET 3", nil},
+		{storepb.Engine_POSTGRES, "SELECT DISTINCT ON (customer_id) * FROM orders", nil},
+		{storepb.Engine_POSTGRES, "SELECT id FROM a EXCEPT SELECT id FROM b", nil},
+		{storepb.Engine_POSTGRES, "SELECT * FROM orders NATURAL JOIN items", nil},
+		{storepb.Engine_POSTGRES, "SELECT * FROM generate_series(1, 10)", nil},
+		{storepb.Engine_POSTGRES, "SELECT * FROM orders FOR UPDATE", nil},
+		{storepb.Engine_SNOWFLAKE, "SELECT * FROM orders", nil},
+	}
+	for _, tc := range testCases {
+		a.Equal(tc.want, getKeysetTable(tc.engine, tc.statement), tc.statement)
+	}
+
+	table := &parserbase.ColumnResource{Database: "shop", Schema: "", Table: "orders"}
+	a.True((&keysetTable{table: "ORDERS"}).matches(storepb.Engine_MYSQL, table))
+	a.True((&keysetTable{schema: "Shop", table: "orders"}).matches(storepb.Engine_MYSQL, table))
+	a.False((&keysetTable{schema: "other", table: "orders"}).matches(storepb.Engine_MYSQL, table))
+	// The view selecting from the table isn't the table.
+	a.False((&keysetTable{table: "orders_view"}).matches(storepb.Engine_MYSQL, table))
+	pgTable := &parserbase.ColumnResource{Database: "shop", Schema: "public", Table: "Orders"}
+	a.True((&keysetTable{table: "Orders"}).matches(storepb.Engine_POSTGRES, pgTable))
+	a.False((&keysetTable{table: "orders"}).matches(storepb.Engine_POSTGRES, pgTable))
+	a.False((&keysetTable{schema: "audit", table: "Orders"}).matches(storepb.Engine_POSTGRES, pgTable))
+}
+
+func TestIntegerColumnType(t *testing.T) {
+	a := require.New(t)
+	a.True(integerColumnTypeRegexp.MatchString("bigint unsigned"))
+	a.True(integerColumnTypeRegexp.MatchString("int(11)"))
+	a.True(integerColumnTypeRegexp.MatchString("integer"))
+	a.False(integerColumnTypeRegexp.MatchString("varchar(255)"))
+	a.False(integerColumnTypeRegexp.MatchString("interval"))
+}
+
+func TestQueryResultPageToken(t *testing.T) {
+	a := require.New(t)
+	token, err := marshalQueryResultPageToken(&storepb.QueryResultPageToken{Session: "abc", Offset: 1000, LastKey: []string{"7", "42"}})
+	a.NoError(err)
+	got, err := unmarshalQueryResultPageToken(token)
+	a.NoError(err)
+	a.Equal("abc", got.Session)
+	a.Equal(int32(1000), got.Offset)
+	a.Equal([]string{"7", "42"}, got.LastKey)
+
+	_, err = unmarshalQueryResultPageToken("not a token")
+	a.Error(err)
+}
+
+func TestQueryResultSessionStore(t *testing.T) {
+	a := require.New(t)
+	store := newQueryResultSessionStore()
+	now := time.Now()
+
+	// The sessions of a user are limited without evicting the sessions of other users.
+	other := &queryResultSession{key: "other", userID: 2}
+	store.add(other, now)
+	var sessions []*queryResultSession
+	for i := 0; i <= maximumQueryResultSessionsPerUser; i++ {
+		session := &queryResultSession{key: strconv.Itoa(i), userID: 1}
+		sessions = append(sessions, session)
+		store.add(session, now)
+	}
+	a.Nil(store.get(1, "0", now))
+	a.Same(sessions[1], store.get(1, "1", now))
+	a.Same(other, store.get(2, "other", now))
+	// The session of other users is not found.
+	a.Nil(store.get(1, "other", now))
+
+	// The least recently used sessions of the same user are evicted for the cached result.
+	store.add(sessions[1], now)
+	store.reserve(sessions[2], 60, 100)
+	store.reserve(sessions[3], 30, 100)
+	store.reserve(other, 100, 100)
+	store.reserve(sessions[4], 30, 100)
+	a.Nil(store.get(1, "2", now))
+	a.Same(sessions[3], store.get(1, "3", now))
+	a.Same(sessions[4], store.get(1, "4", now))
+	a.Same(sessions[1], store.get(1, "1", now))
+	a.Same(other, store.get(2, "other", now))
+
+	store.remove(sessions[1])
+	a.Nil(store.get(1, "1", now))
+
+	// The idle sessions expire.
+	a.Nil(store.get(2, "other", now.Add(queryResultSessionTTL)))
+	a.Empty(store.sessions)
+}
+
+// fakeKeysetTable is a table with the integer primary key id from 1 to rows, it answers the keyset page statements.
+func fakeKeysetTable(rows int, statements *[]string) func(string, int) (*v1pb.QueryResult, error) {
+	return func(statement string, limit int) (*v1pb.QueryResult, error) {
+		*statements = append(*statements, statement)
+		start := 1
+		if _, after, ok := strings.Cut(statement, "WHERE (`id`) > ("); ok {
+			last, err := strconv.Atoi(strings.SplitN(after, ")", 2)[0])
+			if err != nil {
+				return nil, err
+			}
+			start = last + 1
+		}
+		result := &v1pb.QueryResult{ColumnNames: []string{"id"}}
+		for id := start; id <= rows && (limit <= 0 || len(result.Rows) < limit); id++ {
+			result.Rows = append(result.Rows, &v1pb.QueryRow{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(id)}}}})
+		}
+		return result, nil
+	}
+}
+
+func TestFetchKeysetPages(t *testing.T) {
+	a := require.New(t)
+	var statements []string
+	query := fakeKeysetTable(5, &statements)
+	session := &queryResultSession{statement: "SELECT * FROM t", pagination: v1pb.QueryPagesResponse_KEYSET, keyColumns: []string{"id"}}
+
+	var ids []int64
+	offset := 0
+	for !session.done {
+		page, err := session.fetchPage(storepb.Engine_MYSQL, query, offset, 2, 0)
+		a.NoError(err)
+		for _, row := range page.Rows {
+			ids = append(ids, row.Values[0].GetInt64Value())
+		}
+		offset += len(page.Rows)
+	}
+	a.Equal([]int64{1, 2, 3, 4, 5}, ids)
+	a.Equal([]string{
+		"SELECT * FROM (SELECT * FROM t) AS bb_page ORDER BY `id` LIMIT 2",
+		"SELECT * FROM (SELECT * FROM t) AS bb_page WHERE (`id`) > (2) ORDER BY `id` LIMIT 2",
+		"SELECT * FROM (SELECT * FROM t) AS bb_page WHERE (`id`) > (4) ORDER BY `id` LIMIT 2",
+	}, statements)
+
+	// The stale page token is rejected.
+	_, err := session.fetchPage(storepb.Engine_MYSQL, query, 2, 2, 0)
+	a.Error(err)
+
+	// The maximum result rows are applied to the whole session.
+	session = &queryResultSession{statement: "SELECT * FROM t", pagination: v1pb.QueryPagesResponse_KEYSET, keyColumns: []string{"id"}}
+	page, err := session.fetchPage(storepb.Engine_MYSQL, query, 0, 2, 3)
+	a.NoError(err)
+	a.Len(page.Rows, 2)
+	page, err = session.fetchPage(storepb.Engine_MYSQL, query, 2, 2, 3)
+	a.NoError(err)
+	a.Len(page.Rows, 1)
+	a.True(session.done)
+
+	// A session rebuilt from the page token continues after the last key.
+	statements = nil
+	session = &queryResultSession{statement: "SELECT * FROM t", pagination: v1pb.QueryPagesResponse_KEYSET, keyColumns: []string{"id"}}
+	a.NoError(session.resume(2, []string{"2"}))
+	a.True(session.recorded)
+	page, err = session.fetchPage(storepb.Engine_MYSQL, query, 2, 2, 0)
+	a.NoError(err)
+	a.Equal(int64(3), page.Rows[0].Values[0].GetInt64Value())
+	a.Equal([]string{"SELECT * FROM (SELECT * FROM t) AS bb_page WHERE (`id`) > (2) ORDER BY `id` LIMIT 2"}, statements)
+
+	// The last key of the page token must be integers.
+	session = &queryResultSession{pagination: v1pb.QueryPagesResponse_KEYSET, keyColumns: []string{"id"}}
+	a.Error(session.resume(2, []string{"1) OR (1 = 1"}))
+	a.Error(session.resume(2, nil))
+}
+
+func TestFetchCachedPages(t *testing.T) {
+	a := require.New(t)
+	var statements []string
+	query := fakeKeysetTable(5, &statements)
+	session := &queryResultSession{statement: "SELECT * FROM t", pagination: v1pb.QueryPagesResponse_CACHED}
+
+	page, err := session.fetchPage(storepb.Engine_MYSQL, query, 0, 2, 4)
+	a.NoError(err)
+	a.Len(page.Rows, 2)
+	// The masking changes the page in place, which must not change the cached result.
+	page.Rows[0].Values[0] = &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}}
+	page, err = session.fetchPage(storepb.Engine_MYSQL, query, 2, 3, 4)
+	a.NoError(err)
+	a.Len(page.Rows, 2)
+	a.Equal(int64(3), page.Rows[0].Values[0].GetInt64Value())
+	a.Equal(int64(1), session.result.Rows[0].Values[0].GetInt64Value())
+	// The statement runs once with the maximum result rows.
+	a.Equal([]string{"SELECT * FROM t"}, statements)
+
+	_, err = session.fetchPage(storepb.Engine_MYSQL, query, 5, 2, 4)
+	a.Error(err)
+}
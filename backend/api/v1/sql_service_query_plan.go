@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// queryPlanLargeTableRowCount is the row count of the synced table metadata from which the full table scan is warned.
+const queryPlanLargeTableRowCount = 100000
+
+// setQueryPlanWarnings sets the warnings of the query plans in the results, e.g. the full table scans on large tables.
+func (s *SQLService) setQueryPlanWarnings(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, schema string, results []*v1pb.QueryResult) error {
+	var plans []*v1pb.QueryPlan
+	for _, result := range results {
+		if result.GetPlan().GetRoot() != nil {
+			plans = append(plans, result.Plan)
+		}
+	}
+	if len(plans) == 0 {
+		return nil
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database schema %q", database.DatabaseName)
+	}
+	if dbSchema == nil || dbSchema.GetDatabaseMetadata() == nil {
+		return nil
+	}
+	getRowCount := func(schemaName, tableName string) (int64, bool) {
+		schemaMetadata := dbSchema.GetDatabaseMetadata().GetSchema(schemaName)
+		if schemaMetadata == nil {
+			return 0, false
+		}
+		tableMetadata := schemaMetadata.GetTable(tableName)
+		if tableMetadata == nil {
+			return 0, false
+		}
+		return tableMetadata.GetRowCount(), true
+	}
+	if schema == "" {
+		schema = getQueryPlanDefaultSchema(instance.Metadata.GetEngine())
+	}
+	for _, plan := range plans {
+		plan.Warnings = getQueryPlanWarnings(plan.Root, schema, getRowCount)
+	}
+	return nil
+}
+
+// getQueryPlanWarnings returns the full table scan warnings of the plan, the tables without the schema are in the default schema.
+func getQueryPlanWarnings(root *v1pb.QueryPlanNode, defaultSchema string, getRowCount func(schema, table string) (int64, bool)) []*v1pb.QueryPlanWarning {
+	var warnings []*v1pb.QueryPlanWarning
+	warned := make(map[string]bool)
+	var walk func(node *v1pb.QueryPlanNode)
+	walk = func(node *v1pb.QueryPlanNode) {
+		for _, child := range node.Children {
+			walk(child)
+		}
+		if !node.FullScan || node.Table == "" {
+			return
+		}
+		schema := node.Schema
+		if schema == "" {
+			schema = defaultSchema
+		}
+		key := fmt.Sprintf("%s.%s", schema, node.Table)
+		if warned[key] {
+			return
+		}
+		rowCount, ok := getRowCount(schema, node.Table)
+		if !ok || rowCount < queryPlanLargeTableRowCount {
+			return
+		}
+		warned[key] = true
+		tableName := node.Table
+		if schema != "" {
+			tableName = key
+		}
+		warnings = append(warnings, &v1pb.QueryPlanWarning{
+			Type:      v1pb.QueryPlanWarning_FULL_TABLE_SCAN,
+			Message:   fmt.Sprintf("Full table scan on table %q with about %d rows", tableName, rowCount),
+			Schema:    schema,
+			Table:     node.Table,
+			TableRows: rowCount,
+		})
+	}
+	walk(root)
+	return warnings
+}
+
+func getQueryPlanDefaultSchema(engine storepb.Engine) string {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return "public"
+	case storepb.Engine_MSSQL:
+		return "dbo"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestGetQueryPlanWarnings(t *testing.T) {
+	a := require.New(t)
+	rowCounts := map[string]int64{
+		"public.orders": 2000000,
+		"public.users":  10,
+		"sales.orders":  500000,
+	}
+	getRowCount := func(schema, table string) (int64, bool) {
+		rowCount, ok := rowCounts[schema+"."+table]
+		return rowCount, ok
+	}
+	root := &v1pb.QueryPlanNode{
+		NodeType: "Hash Join",
+		Children: []*v1pb.QueryPlanNode{
+			{NodeType: "Seq Scan", Table: "orders", FullScan: true},
+			{NodeType: "Seq Scan", Schema: "public", Table: "orders", FullScan: true},
+			{NodeType: "Seq Scan", Schema: "public", Table: "users", FullScan: true},
+			{NodeType: "Index Scan", Schema: "sales", Table: "orders", Index: "orders_pkey"},
+			{NodeType: "Seq Scan", Schema: "public", Table: "unknown", FullScan: true},
+		},
+	}
+	warnings := getQueryPlanWarnings(root, "public", getRowCount)
+	a.Len(warnings, 1)
+	a.Equal(v1pb.QueryPlanWarning_FULL_TABLE_SCAN, warnings[0].Type)
+	a.Equal("public", warnings[0].Schema)
+	a.Equal("orders", warnings[0].Table)
+	a.Equal(int64(2000000), warnings[0].TableRows)
+	a.Equal(`Full table scan on table "public.orders" with about 2000000 rows`, warnings[0].Message)
+}
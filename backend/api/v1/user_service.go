@@ -541,8 +541,9 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("MFA is not setup yet"))
 				}
 				patch.MFAConfig = &storepb.MFAConfig{
-					OtpSecret:     user.MFAConfig.TempOtpSecret,
-					RecoveryCodes: user.MFAConfig.TempRecoveryCodes,
+					OtpSecret:           user.MFAConfig.TempOtpSecret,
+					RecoveryCodes:       user.MFAConfig.TempRecoveryCodes,
+					WebauthnCredentials: user.MFAConfig.WebauthnCredentials,
 				}
 			} else {
 				setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
@@ -559,7 +560,10 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 						return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("2FA is required and cannot be disabled"))
 					}
 				}
-				patch.MFAConfig = &storepb.MFAConfig{}
+				// The WebAuthn credentials are managed separately.
+				patch.MFAConfig = &storepb.MFAConfig{
+					WebauthnCredentials: user.MFAConfig.WebauthnCredentials,
+				}
 			}
 		case "phone":
 			if request.Msg.User.Phone != "" {
@@ -609,6 +613,7 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 		if user.MFAConfig != nil {
 			patch.MFAConfig.OtpSecret = user.MFAConfig.OtpSecret
 			patch.MFAConfig.RecoveryCodes = user.MFAConfig.RecoveryCodes
+			patch.MFAConfig.WebauthnCredentials = user.MFAConfig.WebauthnCredentials
 		}
 	}
 	// This flag will update user's recovery codes with temp recovery codes.
@@ -621,8 +626,9 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("No recovery codes to update"))
 		}
 		patch.MFAConfig = &storepb.MFAConfig{
-			OtpSecret:     user.MFAConfig.OtpSecret,
-			RecoveryCodes: user.MFAConfig.TempRecoveryCodes,
+			OtpSecret:           user.MFAConfig.OtpSecret,
+			RecoveryCodes:       user.MFAConfig.TempRecoveryCodes,
+			WebauthnCredentials: user.MFAConfig.WebauthnCredentials,
 		}
 	}
 
@@ -803,6 +809,7 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 		convertedUser.MfaEnabled = user.MFAConfig.OtpSecret != ""
 		convertedUser.MfaSecret = user.MFAConfig.TempOtpSecret
 		convertedUser.RecoveryCodes = user.MFAConfig.TempRecoveryCodes
+		convertedUser.WebauthnEnabled = len(user.MFAConfig.WebauthnCredentials) > 0
 	}
 	return convertedUser
 }
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// CreateAccessToken creates a personal access token.
+func (s *UserService) CreateAccessToken(ctx context.Context, request *connect.Request[v1pb.CreateAccessTokenRequest]) (*connect.Response[v1pb.AccessToken], error) {
+	accessToken := request.Msg.AccessToken
+	if accessToken == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("access_token must be set"))
+	}
+	if accessToken.Title == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("title must be set"))
+	}
+	user, err := s.getAccessTokenUser(ctx, request.Msg.Parent, true /* allowAdmin */)
+	if err != nil {
+		return nil, err
+	}
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	// The admin can create tokens for service accounts, but never for other end users.
+	if callerUser.ID != user.ID && user.Type != storepb.PrincipalType_SERVICE_ACCOUNT {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("only the user itself can create access tokens"))
+	}
+
+	if !iam.PermissionsExist(accessToken.Permissions...) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid permissions %v", accessToken.Permissions))
+	}
+	var projectIDs []string
+	for _, name := range accessToken.Projects {
+		projectID, err := common.GetProjectID(name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project %q", projectID))
+		}
+		if project == nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", projectID))
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	var expiresAt *time.Time
+	if accessToken.ExpireTime != nil {
+		t := accessToken.ExpireTime.AsTime()
+		if !t.After(time.Now()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("expire_time must be in the future"))
+		}
+		expiresAt = &t
+	}
+
+	token, tokenHash, err := auth.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to generate access token"))
+	}
+	created, err := s.store.CreateAccessToken(ctx, &store.AccessTokenMessage{
+		PrincipalID: user.ID,
+		TokenHash:   tokenHash,
+		ExpiresAt:   expiresAt,
+		Payload: &storepb.AccessTokenPayload{
+			Title:       accessToken.Title,
+			Projects:    projectIDs,
+			Permissions: accessToken.Permissions,
+		},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	v1AccessToken := convertToV1AccessToken(created)
+	// The token is only returned once.
+	v1AccessToken.Token = token
+	return connect.NewResponse(v1AccessToken), nil
+}
+
+// ListAccessTokens lists the personal access tokens of the user.
+func (s *UserService) ListAccessTokens(ctx context.Context, request *connect.Request[v1pb.ListAccessTokensRequest]) (*connect.Response[v1pb.ListAccessTokensResponse], error) {
+	user, err := s.getAccessTokenUser(ctx, request.Msg.Parent, true /* allowAdmin */)
+	if err != nil {
+		return nil, err
+	}
+	accessTokens, err := s.store.ListAccessTokens(ctx, &store.FindAccessTokenMessage{
+		PrincipalID: &user.ID,
+		ShowRevoked: request.Msg.ShowRevoked,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list access tokens"))
+	}
+	response := &v1pb.ListAccessTokensResponse{}
+	for _, accessToken := range accessTokens {
+		response.AccessTokens = append(response.AccessTokens, convertToV1AccessToken(accessToken))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// RevokeAccessToken revokes the personal access token.
+func (s *UserService) RevokeAccessToken(ctx context.Context, request *connect.Request[v1pb.RevokeAccessTokenRequest]) (*connect.Response[v1pb.AccessToken], error) {
+	userID, tokenID, err := common.GetUserIDAccessTokenID(request.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	user, err := s.getAccessTokenUser(ctx, common.FormatUserUID(userID), true /* allowAdmin */)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := s.store.GetAccessToken(ctx, &store.FindAccessTokenMessage{
+		UID:         &tokenID,
+		PrincipalID: &user.ID,
+		ShowRevoked: true,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get access token"))
+	}
+	if accessToken == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("access token %q not found", request.Msg.Name))
+	}
+	if !accessToken.Revoked {
+		accessToken, err = s.store.RevokeAccessToken(ctx, accessToken.UID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to revoke access token"))
+		}
+	}
+	return connect.NewResponse(convertToV1AccessToken(accessToken)), nil
+}
+
+// getAccessTokenUser returns the user owning the personal access tokens.
+// The caller must be the user itself, or have bb.users.update permission if allowAdmin is true.
+func (s *UserService) getAccessTokenUser(ctx context.Context, name string, allowAdmin bool) (*store.UserMessage, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	userID, err := common.GetUserID(name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if callerUser.ID != userID {
+		if !allowAdmin {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("only the user itself can manage access tokens"))
+		}
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+		}
+		if !ok {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", iam.PermissionUsersUpdate))
+		}
+	}
+
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get user, error: %v", err))
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("user %d not found", userID))
+	}
+	if user.Type != storepb.PrincipalType_END_USER && user.Type != storepb.PrincipalType_SERVICE_ACCOUNT {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("access tokens are only available for end users and service accounts"))
+	}
+	return user, nil
+}
+
+func convertToV1AccessToken(accessToken *store.AccessTokenMessage) *v1pb.AccessToken {
+	v1AccessToken := &v1pb.AccessToken{
+		Name:        common.FormatAccessToken(accessToken.PrincipalID, accessToken.UID),
+		Title:       accessToken.Payload.GetTitle(),
+		Permissions: accessToken.Payload.GetPermissions(),
+		CreateTime:  timestamppb.New(accessToken.CreatedAt),
+		Revoked:     accessToken.Revoked,
+	}
+	for _, projectID := range accessToken.Payload.GetProjects() {
+		v1AccessToken.Projects = append(v1AccessToken.Projects, common.FormatProject(projectID))
+	}
+	if accessToken.ExpiresAt != nil {
+		v1AccessToken.ExpireTime = timestamppb.New(*accessToken.ExpiresAt)
+	}
+	return v1AccessToken
+}
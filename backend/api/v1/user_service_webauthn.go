@@ -0,0 +1,421 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/webauthn"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// defaultWebAuthnCredentialTitle is the title of the credential if the user does not give one.
+const defaultWebAuthnCredentialTitle = "Security key"
+
+// GenerateWebAuthnCreationOptions generates the options to register a WebAuthn credential.
+func (s *UserService) GenerateWebAuthnCreationOptions(ctx context.Context, request *connect.Request[v1pb.GenerateWebAuthnCreationOptionsRequest]) (*connect.Response[v1pb.GenerateWebAuthnCreationOptionsResponse], error) {
+	if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	user, err := s.getWebAuthnUser(ctx, request.Msg.Parent, false /* allowAdmin */)
+	if err != nil {
+		return nil, err
+	}
+	options, err := generateWebAuthnCreationOptions(ctx, s.store, user)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.GenerateWebAuthnCreationOptionsResponse{
+		CreationOptions: options,
+	}), nil
+}
+
+// CreateWebAuthnCredential registers a WebAuthn credential.
+func (s *UserService) CreateWebAuthnCredential(ctx context.Context, request *connect.Request[v1pb.CreateWebAuthnCredentialRequest]) (*connect.Response[v1pb.WebAuthnCredential], error) {
+	if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	user, err := s.getWebAuthnUser(ctx, request.Msg.Parent, false /* allowAdmin */)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := registerWebAuthnCredential(ctx, s.store, user, request.Msg.GetWebauthnCredential().GetTitle(), request.Msg.RegistrationResponse)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(convertToV1WebAuthnCredential(user.ID, credential)), nil
+}
+
+// ListWebAuthnCredentials lists the WebAuthn credentials of the user.
+func (s *UserService) ListWebAuthnCredentials(ctx context.Context, request *connect.Request[v1pb.ListWebAuthnCredentialsRequest]) (*connect.Response[v1pb.ListWebAuthnCredentialsResponse], error) {
+	user, err := s.getWebAuthnUser(ctx, request.Msg.Parent, true /* allowAdmin */)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.ListWebAuthnCredentialsResponse{}
+	for _, credential := range user.MFAConfig.GetWebauthnCredentials() {
+		response.WebauthnCredentials = append(response.WebauthnCredentials, convertToV1WebAuthnCredential(user.ID, credential))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// UpdateWebAuthnCredential updates the title of the WebAuthn credential.
+func (s *UserService) UpdateWebAuthnCredential(ctx context.Context, request *connect.Request[v1pb.UpdateWebAuthnCredentialRequest]) (*connect.Response[v1pb.WebAuthnCredential], error) {
+	if request.Msg.WebauthnCredential == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("webauthn_credential must be set"))
+	}
+	if request.Msg.UpdateMask == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("update_mask must be set"))
+	}
+	user, credentialID, err := s.getWebAuthnUserAndCredentialID(ctx, request.Msg.WebauthnCredential.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaConfig, ok := proto.Clone(user.MFAConfig).(*storepb.MFAConfig)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to clone MFA config"))
+	}
+	credential := findWebAuthnCredential(mfaConfig.WebauthnCredentials, credentialID)
+	if credential == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("WebAuthn credential %q not found", request.Msg.WebauthnCredential.Name))
+	}
+	for _, path := range request.Msg.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.Msg.WebauthnCredential.Title == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("title must be set"))
+			}
+			credential.Title = request.Msg.WebauthnCredential.Title
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid update mask path %q", path))
+		}
+	}
+	if err := updateWebAuthnMFAConfig(ctx, s.store, user, mfaConfig); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(convertToV1WebAuthnCredential(user.ID, credential)), nil
+}
+
+// DeleteWebAuthnCredential deletes the WebAuthn credential.
+func (s *UserService) DeleteWebAuthnCredential(ctx context.Context, request *connect.Request[v1pb.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error) {
+	user, credentialID, err := s.getWebAuthnUserAndCredentialID(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaConfig, ok := proto.Clone(user.MFAConfig).(*storepb.MFAConfig)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to clone MFA config"))
+	}
+	var credentials []*storepb.WebAuthnCredential
+	for _, credential := range mfaConfig.WebauthnCredentials {
+		if !bytes.Equal(credential.Id, credentialID) {
+			credentials = append(credentials, credential)
+		}
+	}
+	if len(credentials) == len(mfaConfig.WebauthnCredentials) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("WebAuthn credential %q not found", request.Msg.Name))
+	}
+	if len(credentials) == 0 {
+		webauthnRequired, err := isWebAuthnRequired(ctx, s.store, s.licenseService, s.iamManager, user)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check WebAuthn requirement"))
+		}
+		if webauthnRequired {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("cannot delete the last WebAuthn credential because WebAuthn is required for the user"))
+		}
+	}
+	mfaConfig.WebauthnCredentials = credentials
+	if err := updateWebAuthnMFAConfig(ctx, s.store, user, mfaConfig); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// getWebAuthnUser returns the end user owning the WebAuthn credentials.
+// The caller must be the user itself, or have bb.users.update permission if allowAdmin is true.
+func (s *UserService) getWebAuthnUser(ctx context.Context, name string, allowAdmin bool) (*store.UserMessage, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	userID, err := common.GetUserID(name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if callerUser.ID != userID {
+		if !allowAdmin {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("only the user itself can register WebAuthn credentials"))
+		}
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+		}
+		if !ok {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", iam.PermissionUsersUpdate))
+		}
+	}
+
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get user, error: %v", err))
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("user %d not found", userID))
+	}
+	if user.Type != storepb.PrincipalType_END_USER {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("WebAuthn credentials are only available for end users"))
+	}
+	return user, nil
+}
+
+func (s *UserService) getWebAuthnUserAndCredentialID(ctx context.Context, name string) (*store.UserMessage, []byte, error) {
+	userID, id, err := common.GetUserIDWebAuthnCredentialID(name)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	credentialID, err := webauthn.DecodeID(id)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid WebAuthn credential id %q", id))
+	}
+	user, err := s.getWebAuthnUser(ctx, common.FormatUserUID(userID), true /* allowAdmin */)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, credentialID, nil
+}
+
+// isWebAuthnRequired reports whether the user must use WebAuthn as the second factor,
+// i.e. the user has any of the workspace permissions requiring WebAuthn.
+func isWebAuthnRequired(ctx context.Context, stores *store.Store, licenseService *enterprise.LicenseService, iamManager *iam.Manager, user *store.UserMessage) (bool, error) {
+	if user.Type != storepb.PrincipalType_END_USER {
+		return false, nil
+	}
+	if licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA) != nil {
+		return false, nil
+	}
+	setting, err := stores.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to find workspace setting")
+	}
+	for _, permission := range setting.WebauthnRequiredPermissions {
+		ok, err := iamManager.CheckPermission(ctx, iam.Permission(permission), user)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to check permission %q", permission)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getWebAuthnRelyingParty returns the relying party of the workspace, whose ID is the host of the external URL.
+func getWebAuthnRelyingParty(ctx context.Context, stores *store.Store) (*webauthn.RelyingParty, error) {
+	setting, err := stores.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to find workspace setting"))
+	}
+	rp, err := webauthn.NewRelyingParty(setting.ExternalUrl, "Bytebase")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return rp, nil
+}
+
+// generateWebAuthnCreationOptions starts the registration ceremony and returns the PublicKeyCredentialCreationOptionsJSON.
+func generateWebAuthnCreationOptions(ctx context.Context, stores *store.Store, user *store.UserMessage) (string, error) {
+	rp, err := getWebAuthnRelyingParty(ctx, stores)
+	if err != nil {
+		return "", err
+	}
+	challenge, err := issueWebAuthnChallenge(ctx, stores, user)
+	if err != nil {
+		return "", err
+	}
+	options := rp.CreationOptions(&webauthn.User{
+		ID:          []byte(strconv.Itoa(user.ID)),
+		Name:        user.Email,
+		DisplayName: user.Name,
+	}, challenge, convertToWebAuthnCredentials(user.MFAConfig.GetWebauthnCredentials()))
+	data, err := json.Marshal(options)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to marshal creation options"))
+	}
+	return string(data), nil
+}
+
+// generateWebAuthnRequestOptions starts the authentication ceremony and returns the PublicKeyCredentialRequestOptionsJSON.
+func generateWebAuthnRequestOptions(ctx context.Context, stores *store.Store, user *store.UserMessage) (string, error) {
+	rp, err := getWebAuthnRelyingParty(ctx, stores)
+	if err != nil {
+		return "", err
+	}
+	challenge, err := issueWebAuthnChallenge(ctx, stores, user)
+	if err != nil {
+		return "", err
+	}
+	options := rp.RequestOptions(challenge, convertToWebAuthnCredentials(user.MFAConfig.GetWebauthnCredentials()))
+	data, err := json.Marshal(options)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to marshal request options"))
+	}
+	return string(data), nil
+}
+
+// registerWebAuthnCredential finishes the registration ceremony and saves the new credential.
+func registerWebAuthnCredential(ctx context.Context, stores *store.Store, user *store.UserMessage, title, response string) (*storepb.WebAuthnCredential, error) {
+	rp, err := getWebAuthnRelyingParty(ctx, stores)
+	if err != nil {
+		return nil, err
+	}
+	mfaConfig, challenge, err := takeWebAuthnChallenge(user)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := rp.VerifyRegistration(challenge, []byte(response))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to verify WebAuthn registration"))
+	}
+	if findWebAuthnCredential(mfaConfig.WebauthnCredentials, credential.ID) != nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.Errorf("WebAuthn credential is already registered"))
+	}
+	if title == "" {
+		title = defaultWebAuthnCredentialTitle
+	}
+	storeCredential := &storepb.WebAuthnCredential{
+		Id:         credential.ID,
+		PublicKey:  credential.PublicKey,
+		Title:      title,
+		SignCount:  credential.SignCount,
+		Transports: credential.Transports,
+		Aaguid:     credential.AAGUID,
+		CreateTime: timestamppb.Now(),
+	}
+	mfaConfig.WebauthnCredentials = append(mfaConfig.WebauthnCredentials, storeCredential)
+	if err := updateWebAuthnMFAConfig(ctx, stores, user, mfaConfig); err != nil {
+		return nil, err
+	}
+	return storeCredential, nil
+}
+
+// challengeWebAuthn finishes the authentication ceremony, and updates the signature counter of the used credential.
+func challengeWebAuthn(ctx context.Context, stores *store.Store, user *store.UserMessage, response string) error {
+	rp, err := getWebAuthnRelyingParty(ctx, stores)
+	if err != nil {
+		return err
+	}
+	mfaConfig, challenge, err := takeWebAuthnChallenge(user)
+	if err != nil {
+		return err
+	}
+	used, err := rp.VerifyAssertion(challenge, []byte(response), convertToWebAuthnCredentials(mfaConfig.WebauthnCredentials))
+	if err == nil {
+		credential := findWebAuthnCredential(mfaConfig.WebauthnCredentials, used.ID)
+		credential.SignCount = used.SignCount
+		credential.LastUseTime = timestamppb.Now()
+	}
+	// The challenge is consumed no matter whether the verification succeeds or not.
+	// The conditional update also guarantees the challenge is consumed only once.
+	if updateErr := updateWebAuthnMFAConfig(ctx, stores, user, mfaConfig); updateErr != nil {
+		return updateErr
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, errors.Wrapf(err, "failed to verify WebAuthn assertion"))
+	}
+	return nil
+}
+
+// issueWebAuthnChallenge saves a new challenge for the ceremony of the user.
+func issueWebAuthnChallenge(ctx context.Context, stores *store.Store, user *store.UserMessage) ([]byte, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	mfaConfig, ok := proto.Clone(user.MFAConfig).(*storepb.MFAConfig)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to clone MFA config"))
+	}
+	mfaConfig.TempWebauthnChallenge = challenge
+	mfaConfig.TempWebauthnChallengeExpireTime = timestamppb.New(time.Now().Add(webauthn.Timeout))
+	if err := updateWebAuthnMFAConfig(ctx, stores, user, mfaConfig); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// takeWebAuthnChallenge returns the unexpired challenge of the user, and a copy of the MFA config with the challenge cleared.
+func takeWebAuthnChallenge(user *store.UserMessage) (*storepb.MFAConfig, []byte, error) {
+	mfaConfig, ok := proto.Clone(user.MFAConfig).(*storepb.MFAConfig)
+	if !ok {
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to clone MFA config"))
+	}
+	challenge := mfaConfig.TempWebauthnChallenge
+	expireTime := mfaConfig.TempWebauthnChallengeExpireTime
+	if len(challenge) == 0 || expireTime == nil || time.Now().After(expireTime.AsTime()) {
+		return nil, nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("WebAuthn challenge is missing or expired"))
+	}
+	mfaConfig.TempWebauthnChallenge = nil
+	mfaConfig.TempWebauthnChallengeExpireTime = nil
+	return mfaConfig, challenge, nil
+}
+
+// updateWebAuthnMFAConfig saves the MFA config changed by the WebAuthn ceremony of the user.
+// It fails if the MFA config has been changed since the user was read, e.g. by another ceremony.
+func updateWebAuthnMFAConfig(ctx context.Context, stores *store.Store, user *store.UserMessage, mfaConfig *storepb.MFAConfig) error {
+	ok, err := stores.UpdateUserMFAConfigIfUnchanged(ctx, user, mfaConfig)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to update user"))
+	}
+	if !ok {
+		return connect.NewError(connect.CodeAborted, errors.Errorf("MFA config has been changed concurrently, please retry"))
+	}
+	return nil
+}
+
+func findWebAuthnCredential(credentials []*storepb.WebAuthnCredential, id []byte) *storepb.WebAuthnCredential {
+	for _, credential := range credentials {
+		if bytes.Equal(credential.Id, id) {
+			return credential
+		}
+	}
+	return nil
+}
+
+func convertToWebAuthnCredentials(credentials []*storepb.WebAuthnCredential) []*webauthn.Credential {
+	var result []*webauthn.Credential
+	for _, credential := range credentials {
+		result = append(result, &webauthn.Credential{
+			ID:         credential.Id,
+			PublicKey:  credential.PublicKey,
+			SignCount:  credential.SignCount,
+			Transports: credential.Transports,
+			AAGUID:     credential.Aaguid,
+		})
+	}
+	return result
+}
+
+func convertToV1WebAuthnCredential(userID int, credential *storepb.WebAuthnCredential) *v1pb.WebAuthnCredential {
+	return &v1pb.WebAuthnCredential{
+		Name:        common.FormatWebAuthnCredential(userID, webauthn.EncodeID(credential.Id)),
+		Title:       credential.Title,
+		Transports:  credential.Transports,
+		CreateTime:  credential.CreateTime,
+		LastUseTime: credential.LastUseTime,
+	}
+}
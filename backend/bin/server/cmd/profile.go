@@ -22,6 +22,7 @@ func getBaseProfile(dataDir string) *config.Profile {
 		DatastorePort:      flags.port + 2, // Using flags.port + 2 as our datastore port.
 		SampleDatabasePort: sampleDatabasePort,
 		HA:                 flags.ha,
+		HAMode:             config.HAMode(flags.haMode),
 		SaaS:               flags.saas,
 		EnableJSONLogging:  flags.enableJSONLogging,
 		IsDocker:           isDocker(),
@@ -28,6 +28,12 @@ var RiskFactors = []cel.EnvOption{
 	cel.Variable("sql_type", cel.StringType),
 	cel.Variable("sql_statement", cel.StringType),
 
+	// lock_mode, table_rewrite, table_scan and blocking_risk come from the lock impact report of PostgreSQL DDL.
+	cel.Variable("lock_mode", cel.StringType),
+	cel.Variable("table_rewrite", cel.BoolType),
+	cel.Variable("table_scan", cel.BoolType),
+	cel.Variable("blocking_risk", cel.StringType),
+
 	cel.Variable("expiration_days", cel.IntType),
 	cel.Variable("export_rows", cel.IntType),
 	cel.Variable("role", cel.StringType),
@@ -75,6 +81,12 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// SQLReviewCustomRuleCELAttributes are the variables when evaluating the custom SQL review rule.
+var SQLReviewCustomRuleCELAttributes = []cel.EnvOption{
+	cel.Variable("statement", cel.MapType(cel.StringType, cel.DynType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // ConvertUnparsedRisk converts unparsed risk to parsed format.
 func ConvertUnparsedRisk(expression *expr.Expr) (*exprproto.ParsedExpr, error) {
 	if expression == nil || expression.Expression == "" {
@@ -18,6 +18,9 @@ const (
 
 	// ServiceAccountAccessKeyPrefix is the prefix for service account access key.
 	ServiceAccountAccessKeyPrefix = "bbs_"
+
+	// PersonalAccessTokenPrefix is the prefix for personal access token.
+	PersonalAccessTokenPrefix = "bbp_"
 )
 
 // DefaultInstanceMaximumConnections is the maximum number of connections outstanding per instance by default.
@@ -54,4 +57,6 @@ const (
 
 	EventTypeStageStatusUpdate   = "bb.webhook.event.stage.status.update"
 	EventTypeTaskRunStatusUpdate = "bb.webhook.event.taskRun.status.update"
+
+	EventTypeSchemaDrift = "bb.webhook.event.database.schema.drift"
 )
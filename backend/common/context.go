@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"slices"
 
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -16,6 +17,8 @@ const (
 	UserContextKey
 	AuthContextKey
 	ServiceDataKey
+	// AccessTokenContextKey is the key name used to store the personal access token authenticating the request.
+	AccessTokenContextKey
 )
 
 func WithSetServiceData(ctx context.Context, setServiceData func(a *anypb.Any)) context.Context {
@@ -77,3 +80,41 @@ func (c *AuthContext) GetProjectResources() []string {
 	}
 	return projectIDs
 }
+
+// AccessToken is the scope of the personal access token authenticating the request.
+type AccessToken struct {
+	// Name is the resource name of the token, users/{userUID}/accessTokens/{access_token}.
+	Name        string
+	PrincipalID int
+	// ProjectIDs limits the projects the token can access. Empty means all projects.
+	ProjectIDs []string
+	// Permissions limits the permissions the token can use. Empty means all permissions.
+	Permissions []string
+}
+
+func GetAccessTokenFromContext(ctx context.Context) (*AccessToken, bool) {
+	accessToken, ok := ctx.Value(AccessTokenContextKey).(*AccessToken)
+	return accessToken, ok
+}
+
+// AllowPermission reports whether the token can use the permission.
+func (t *AccessToken) AllowPermission(permission string) bool {
+	return len(t.Permissions) == 0 || slices.Contains(t.Permissions, permission)
+}
+
+// AllowProjects reports whether the token can access all the projects.
+// A project-scoped token cannot access the workspace, i.e. no projects.
+func (t *AccessToken) AllowProjects(projectIDs ...string) bool {
+	if len(t.ProjectIDs) == 0 {
+		return true
+	}
+	if len(projectIDs) == 0 {
+		return false
+	}
+	for _, projectID := range projectIDs {
+		if !slices.Contains(t.ProjectIDs, projectID) {
+			return false
+		}
+	}
+	return true
+}
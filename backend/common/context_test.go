@@ -0,0 +1,28 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenScope(t *testing.T) {
+	a := require.New(t)
+
+	unscoped := &AccessToken{}
+	a.True(unscoped.AllowPermission("bb.projects.update"))
+	a.True(unscoped.AllowProjects())
+	a.True(unscoped.AllowProjects("p1", "p2"))
+
+	scoped := &AccessToken{
+		ProjectIDs:  []string{"p1", "p2"},
+		Permissions: []string{"bb.projects.get"},
+	}
+	a.True(scoped.AllowPermission("bb.projects.get"))
+	a.False(scoped.AllowPermission("bb.projects.update"))
+	a.True(scoped.AllowProjects("p1"))
+	a.True(scoped.AllowProjects("p1", "p2"))
+	a.False(scoped.AllowProjects("p1", "p3"))
+	// The workspace is out of the scope of a project-scoped token.
+	a.False(scoped.AllowProjects())
+}
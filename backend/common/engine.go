@@ -17,7 +17,10 @@ func EngineSupportSQLReview(engine storepb.Engine) bool {
 		storepb.Engine_OCEANBASE,
 		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_DM,
-		storepb.Engine_MSSQL:
+		storepb.Engine_MSSQL,
+		storepb.Engine_CLICKHOUSE,
+		storepb.Engine_BIGQUERY,
+		storepb.Engine_TRINO:
 		return true
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
@@ -25,9 +28,7 @@ func EngineSupportSQLReview(engine storepb.Engine) bool {
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
 		storepb.Engine_REDIS,
-		storepb.Engine_CLICKHOUSE,
 		storepb.Engine_SPANNER,
-		storepb.Engine_BIGQUERY,
 		storepb.Engine_REDSHIFT,
 		storepb.Engine_STARROCKS,
 		storepb.Engine_RISINGWAVE,
@@ -37,8 +38,7 @@ func EngineSupportSQLReview(engine storepb.Engine) bool {
 		storepb.Engine_DYNAMODB,
 		storepb.Engine_ELASTICSEARCH,
 		storepb.Engine_DATABRICKS,
-		storepb.Engine_COSMOSDB,
-		storepb.Engine_TRINO:
+		storepb.Engine_COSMOSDB:
 		return false
 	default:
 		return false
@@ -209,6 +209,49 @@ func EngineSupportStatementAdvise(e storepb.Engine) bool {
 	}
 }
 
+// EngineSupportStatementAdviseWithRules returns true if the statement advise runs for the engine
+// only when the SQL review rules of the engine are configured.
+func EngineSupportStatementAdviseWithRules(e storepb.Engine) bool {
+	//exhaustive:enforce
+	switch e {
+	case
+		storepb.Engine_CLICKHOUSE,
+		storepb.Engine_BIGQUERY,
+		storepb.Engine_TRINO:
+		return true
+	case
+		storepb.Engine_ENGINE_UNSPECIFIED,
+		storepb.Engine_MYSQL,
+		storepb.Engine_TIDB,
+		storepb.Engine_POSTGRES,
+		storepb.Engine_ORACLE,
+		storepb.Engine_OCEANBASE_ORACLE,
+		storepb.Engine_OCEANBASE,
+		storepb.Engine_SNOWFLAKE,
+		storepb.Engine_MSSQL,
+		storepb.Engine_DYNAMODB,
+		storepb.Engine_COCKROACHDB,
+		storepb.Engine_REDSHIFT,
+		storepb.Engine_STARROCKS,
+		storepb.Engine_DORIS,
+		storepb.Engine_DM,
+		storepb.Engine_CASSANDRA,
+		storepb.Engine_SQLITE,
+		storepb.Engine_MONGODB,
+		storepb.Engine_REDIS,
+		storepb.Engine_SPANNER,
+		storepb.Engine_MARIADB,
+		storepb.Engine_RISINGWAVE,
+		storepb.Engine_HIVE,
+		storepb.Engine_ELASTICSEARCH,
+		storepb.Engine_DATABRICKS,
+		storepb.Engine_COSMOSDB:
+		return false
+	default:
+		return false
+	}
+}
+
 func EngineSupportStatementReport(e storepb.Engine) bool {
 	//exhaustive:enforce
 	switch e {
@@ -258,11 +301,13 @@ func EngineSupportPriorBackup(e storepb.Engine) bool {
 		storepb.Engine_TIDB,
 		storepb.Engine_MSSQL,
 		storepb.Engine_ORACLE,
-		storepb.Engine_POSTGRES:
+		storepb.Engine_POSTGRES,
+		storepb.Engine_SNOWFLAKE,
+		storepb.Engine_STARROCKS,
+		storepb.Engine_DORIS:
 		return true
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
-		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_CASSANDRA,
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
@@ -275,11 +320,9 @@ func EngineSupportPriorBackup(e storepb.Engine) bool {
 		storepb.Engine_OCEANBASE,
 		storepb.Engine_OCEANBASE_ORACLE,
 		storepb.Engine_DM,
-		storepb.Engine_STARROCKS,
 		storepb.Engine_RISINGWAVE,
 		storepb.Engine_HIVE,
 		storepb.Engine_COCKROACHDB,
-		storepb.Engine_DORIS,
 		storepb.Engine_DYNAMODB,
 		storepb.Engine_ELASTICSEARCH,
 		storepb.Engine_DATABRICKS,
@@ -421,14 +464,16 @@ func BackupDatabaseNameOfEngine(e storepb.Engine) string {
 		storepb.Engine_MYSQL,
 		storepb.Engine_TIDB,
 		storepb.Engine_MSSQL,
-		storepb.Engine_POSTGRES:
+		storepb.Engine_POSTGRES,
+		storepb.Engine_STARROCKS,
+		storepb.Engine_DORIS:
 		return "bbdataarchive"
 	case
-		storepb.Engine_ORACLE:
+		storepb.Engine_ORACLE,
+		storepb.Engine_SNOWFLAKE:
 		return "BBDATAARCHIVE"
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
-		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_CASSANDRA,
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
@@ -441,11 +486,9 @@ func BackupDatabaseNameOfEngine(e storepb.Engine) string {
 		storepb.Engine_OCEANBASE,
 		storepb.Engine_OCEANBASE_ORACLE,
 		storepb.Engine_DM,
-		storepb.Engine_STARROCKS,
 		storepb.Engine_RISINGWAVE,
 		storepb.Engine_HIVE,
 		storepb.Engine_COCKROACHDB,
-		storepb.Engine_DORIS,
 		storepb.Engine_DYNAMODB,
 		storepb.Engine_ELASTICSEARCH,
 		storepb.Engine_DATABRICKS,
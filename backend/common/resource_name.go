@@ -30,6 +30,7 @@ const (
 	RolePrefix                 = "roles/"
 	SecretNamePrefix           = "secrets/"
 	WebhookIDPrefix            = "webhooks/"
+	WebhookDeliveryPrefix      = "deliveries/"
 	SheetIDPrefix              = "sheets/"
 	WorksheetIDPrefix          = "worksheets/"
 	DatabaseGroupNamePrefix    = "databaseGroups/"
@@ -49,11 +50,15 @@ const (
 	ReleaseNamePrefix          = "releases/"
 	FileNamePrefix             = "files/"
 	RevisionNamePrefix         = "revisions/"
+	WebAuthnCredentialPrefix   = "webAuthnCredentials/"
+	AccessTokenPrefix          = "accessTokens/"
 
 	SchemaSuffix   = "/schema"
 	MetadataSuffix = "/metadata"
 	CatalogSuffix  = "/catalog"
 
+	DriftReportSuffix = "/driftReport"
+
 	UserBindingPrefix  = "user:"
 	GroupBindingPrefix = "group:"
 )
@@ -97,6 +102,23 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryUID returns the project ID, webhook ID and delivery UID from a resource name.
+func GetProjectIDWebhookIDDeliveryUID(name string) (string, int, int64, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryPrefix)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	webhookID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid webhook ID %q", tokens[1])
+	}
+	deliveryUID, err := strconv.ParseInt(tokens[2], 10, 64)
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid delivery ID %q", tokens[2])
+	}
+	return tokens[0], webhookID, deliveryUID, nil
+}
+
 func GetProjectIDChangelistID(name string) (string, string, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, ChangelistsPrefix)
 	if err != nil {
@@ -198,6 +220,39 @@ func GetUserID(name string) (int, error) {
 	return GetUIDFromName(name, UserNamePrefix)
 }
 
+// GetUserIDWebAuthnCredentialID returns the user ID and the WebAuthn credential ID from a resource name.
+func GetUserIDWebAuthnCredentialID(name string) (int, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, WebAuthnCredentialPrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	uid, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	if tokens[1] == "" {
+		return 0, "", errors.Errorf("invalid WebAuthn credential name %q", name)
+	}
+	return uid, tokens[1], nil
+}
+
+// GetUserIDAccessTokenID returns the user ID and the access token ID from a resource name.
+func GetUserIDAccessTokenID(name string) (int, int64, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AccessTokenPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	uid, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	tokenID, err := strconv.ParseInt(tokens[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid access token ID %q", tokens[1])
+	}
+	return uid, tokenID, nil
+}
+
 // GetUserEmail returns the user email from a resource name.
 func GetUserEmail(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -553,6 +608,14 @@ func FormatUserUID(uid int) string {
 	return fmt.Sprintf("%s%d", UserNamePrefix, uid)
 }
 
+func FormatWebAuthnCredential(uid int, credentialID string) string {
+	return fmt.Sprintf("%s/%s%s", FormatUserUID(uid), WebAuthnCredentialPrefix, credentialID)
+}
+
+func FormatAccessToken(uid int, tokenID int64) string {
+	return fmt.Sprintf("%s/%s%d", FormatUserUID(uid), AccessTokenPrefix, tokenID)
+}
+
 func FormatGroupEmail(email string) string {
 	return fmt.Sprintf("%s%s", GroupPrefix, email)
 }
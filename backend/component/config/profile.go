@@ -8,6 +8,16 @@ import (
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 )
 
+// HAMode is how the runners are coordinated across the replicas in HA mode.
+type HAMode string
+
+const (
+	// HAModeActiveStandby runs the runners on the leader replica only.
+	HAModeActiveStandby HAMode = "active-standby"
+	// HAModeActiveActive runs the runners on all replicas, and each replica claims the work with advisory locks.
+	HAModeActiveActive HAMode = "active-active"
+)
+
 // Profile is the configuration to start main server.
 // Profile must not be copied, its fields must not be modified unless mentioned otherwise.
 type Profile struct {
@@ -33,6 +43,8 @@ type Profile struct {
 	Demo bool
 	// HA replica mode.
 	HA bool
+	// HAMode is how the runners are coordinated across the replicas in HA mode.
+	HAMode HAMode
 
 	// Version is the bytebase's server version
 	Version string
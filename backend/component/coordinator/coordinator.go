@@ -0,0 +1,273 @@
+// Package coordinator coordinates the background runners across the Bytebase replicas in HA mode
+// with the session-level advisory locks on the metadata PostgreSQL.
+package coordinator
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+)
+
+const (
+	electionInterval = 5 * time.Second
+	// lockNamespace is added to the advisory lock class IDs to avoid colliding with the other advisory lock users of the metadata database.
+	lockNamespace int32 = 0x62620000
+)
+
+// LockKind is the kind of the work claimed by an advisory lock.
+type LockKind int32
+
+const (
+	lockKindLeader LockKind = iota
+	// LockKindTaskRun claims a running task run.
+	LockKindTaskRun
+	// LockKindPlanCheckRun claims a running plan check run.
+	LockKindPlanCheckRun
+	// LockKindInstanceSync claims the periodic sync of an instance.
+	LockKindInstanceSync
+	// LockKindIssueApproval claims the approval template finding of an issue.
+	LockKindIssueApproval
+)
+
+type lockKey struct {
+	kind LockKind
+	id   int32
+}
+
+// claim is the work claimed by this replica.
+type claim struct {
+	// cancel cancels the work if the claim is lost, nil if the work is not cancelable.
+	cancel context.CancelFunc
+}
+
+// Coordinator elects the leader replica and claims the work for the runners.
+//
+// All advisory locks are held by a dedicated session. If the session is lost, the locks are
+// released by PostgreSQL, and the coordinator tries to re-acquire them on a new session.
+// Without HA, the coordinator is a no-op: the replica is always the leader and every lock is granted.
+type Coordinator struct {
+	db   *sql.DB
+	ha   bool
+	mode config.HAMode
+
+	mu   sync.Mutex
+	conn *sql.Conn
+	// locks is the set of work locks held by this replica, excluding the leader lock.
+	locks  map[lockKey]*claim
+	leader atomic.Bool
+}
+
+// New creates a coordinator.
+func New(db *sql.DB, profile *config.Profile) *Coordinator {
+	return &Coordinator{
+		db:    db,
+		ha:    profile.HA,
+		mode:  profile.HAMode,
+		locks: make(map[lockKey]*claim),
+	}
+}
+
+// Run runs the leader election until the context is done.
+func (c *Coordinator) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if !c.ha {
+		return
+	}
+	ticker := time.NewTicker(electionInterval)
+	defer ticker.Stop()
+	slog.Debug("Coordinator started", slog.String("mode", string(c.mode)))
+	c.elect(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			c.elect(ctx)
+		case <-ctx.Done():
+			c.close()
+			return
+		}
+	}
+}
+
+// Enabled returns whether the runners are coordinated across the replicas.
+func (c *Coordinator) Enabled() bool {
+	return c.ha
+}
+
+// IsLeader returns whether this replica is the leader.
+func (c *Coordinator) IsLeader() bool {
+	return !c.ha || c.leader.Load()
+}
+
+// IsActive returns whether the runners should run on this replica.
+func (c *Coordinator) IsActive() bool {
+	return !c.ha || c.mode == config.HAModeActiveActive || c.leader.Load()
+}
+
+// TryLock tries to claim the work of the kind and id for this replica.
+// It returns false if the work is claimed by this or another replica.
+func (c *Coordinator) TryLock(ctx context.Context, kind LockKind, id int) (bool, error) {
+	if !c.ha {
+		return true, nil
+	}
+	key := lockKey{kind: kind, id: int32(id)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.locks[key]; ok {
+		return false, nil
+	}
+	if err := c.ensureConnLocked(ctx); err != nil {
+		return false, err
+	}
+	acquired, err := c.tryLockLocked(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if acquired {
+		c.locks[key] = &claim{}
+	}
+	return acquired, nil
+}
+
+// SetCancel sets the function to cancel the work claimed by TryLock if the claim is lost,
+// i.e. the session is lost and the lock is taken by another replica before it is re-acquired.
+// The work is canceled immediately if the claim has already been lost.
+func (c *Coordinator) SetCancel(kind LockKind, id int, cancel context.CancelFunc) {
+	if !c.ha {
+		return
+	}
+	key := lockKey{kind: kind, id: int32(id)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	claim, ok := c.locks[key]
+	if !ok {
+		cancel()
+		return
+	}
+	claim.cancel = cancel
+}
+
+// TryLockKey is like TryLock but for the work identified by a string key.
+func (c *Coordinator) TryLockKey(ctx context.Context, kind LockKind, key string) (bool, error) {
+	return c.TryLock(ctx, kind, hashKey(key))
+}
+
+// Unlock releases the work of the kind and id claimed by TryLock.
+func (c *Coordinator) Unlock(ctx context.Context, kind LockKind, id int) {
+	if !c.ha {
+		return
+	}
+	key := lockKey{kind: kind, id: int32(id)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.locks[key]; !ok {
+		return
+	}
+	delete(c.locks, key)
+	if c.conn == nil {
+		return
+	}
+	if _, err := c.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1, $2)", lockNamespace+int32(key.kind), key.id); err != nil {
+		slog.Warn("failed to release advisory lock", slog.Int("kind", int(kind)), slog.Int("id", id), log.BBError(err))
+	}
+}
+
+// UnlockKey releases the work identified by a string key claimed by TryLockKey.
+func (c *Coordinator) UnlockKey(ctx context.Context, kind LockKind, key string) {
+	c.Unlock(ctx, kind, hashKey(key))
+}
+
+func (c *Coordinator) elect(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.ensureConnLocked(ctx); err != nil {
+		slog.Error("failed to connect to the metadata database for leader election", log.BBError(err))
+		return
+	}
+	if c.leader.Load() {
+		// The session is alive, so the leader lock is still held.
+		return
+	}
+	acquired, err := c.tryLockLocked(ctx, lockKey{kind: lockKindLeader})
+	if err != nil {
+		slog.Error("failed to acquire the leader lock", log.BBError(err))
+		return
+	}
+	if acquired {
+		slog.Info("This replica becomes the leader", slog.String("mode", string(c.mode)))
+		c.leader.Store(true)
+	}
+}
+
+// ensureConnLocked makes sure the session holding the advisory locks is alive.
+func (c *Coordinator) ensureConnLocked(ctx context.Context) error {
+	if c.conn != nil {
+		if err := c.conn.PingContext(ctx); err == nil {
+			return nil
+		}
+		// The session is lost, and so are the advisory locks held by it.
+		slog.Warn("lost the coordinator session to the metadata database")
+		_ = c.conn.Close()
+		c.conn = nil
+		if c.leader.Swap(false) {
+			slog.Warn("This replica is no longer the leader")
+		}
+	}
+
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get connection")
+	}
+	c.conn = conn
+	for key, claim := range c.locks {
+		acquired, err := c.tryLockLocked(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !acquired {
+			// Another replica may execute the work now, so stop executing it here.
+			slog.Error("the advisory lock is claimed by another replica after reconnecting, canceling the work", slog.Int("kind", int(key.kind)), slog.Int("id", int(key.id)))
+			if claim.cancel != nil {
+				claim.cancel()
+			}
+			delete(c.locks, key)
+		}
+	}
+	return nil
+}
+
+func (c *Coordinator) tryLockLocked(ctx context.Context, key lockKey) (bool, error) {
+	var acquired bool
+	if err := c.conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1, $2)", lockNamespace+int32(key.kind), key.id).Scan(&acquired); err != nil {
+		return false, errors.Wrapf(err, "failed to acquire advisory lock")
+	}
+	return acquired, nil
+}
+
+func (c *Coordinator) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		// Closing the session releases all advisory locks.
+		_ = c.conn.Close()
+		c.conn = nil
+	}
+	c.leader.Store(false)
+}
+
+func hashKey(key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(int32(h.Sum32()))
+}
@@ -0,0 +1,71 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/config"
+)
+
+func TestCoordinatorWithoutHA(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	c := New(nil, &config.Profile{})
+
+	a.False(c.Enabled())
+	a.True(c.IsLeader())
+	a.True(c.IsActive())
+	// Every lock is granted without HA, even if it is claimed twice.
+	for i := 0; i < 2; i++ {
+		claimed, err := c.TryLock(ctx, LockKindTaskRun, 1)
+		a.NoError(err)
+		a.True(claimed)
+	}
+	claimed, err := c.TryLockKey(ctx, LockKindInstanceSync, "prod")
+	a.NoError(err)
+	a.True(claimed)
+	c.Unlock(ctx, LockKindTaskRun, 1)
+	c.UnlockKey(ctx, LockKindInstanceSync, "prod")
+	a.Empty(c.locks)
+}
+
+func TestCoordinatorActive(t *testing.T) {
+	a := require.New(t)
+	standby := New(nil, &config.Profile{HA: true, HAMode: config.HAModeActiveStandby})
+	a.True(standby.Enabled())
+	a.False(standby.IsLeader())
+	a.False(standby.IsActive())
+	standby.leader.Store(true)
+	a.True(standby.IsLeader())
+	a.True(standby.IsActive())
+
+	active := New(nil, &config.Profile{HA: true, HAMode: config.HAModeActiveActive})
+	a.False(active.IsLeader())
+	a.True(active.IsActive())
+}
+
+func TestHashKey(t *testing.T) {
+	a := require.New(t)
+	a.Equal(hashKey("prod"), hashKey("prod"))
+	a.NotEqual(hashKey("prod"), hashKey("test"))
+}
+
+func TestCoordinatorLostClaim(t *testing.T) {
+	a := require.New(t)
+	c := New(nil, &config.Profile{HA: true, HAMode: config.HAModeActiveActive})
+	key := lockKey{kind: LockKindTaskRun, id: 1}
+	c.locks[key] = &claim{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.SetCancel(LockKindTaskRun, 1, cancel)
+	a.NoError(ctx.Err())
+	c.locks[key].cancel()
+	a.ErrorIs(ctx.Err(), context.Canceled)
+
+	// The work is canceled at once if the claim has been lost.
+	ctx, cancel = context.WithCancel(context.Background())
+	c.SetCancel(LockKindTaskRun, 2, cancel)
+	a.ErrorIs(ctx.Err(), context.Canceled)
+}
@@ -3,6 +3,9 @@ package dbfactory
 
 import (
 	"context"
+	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/bytebase/bytebase/backend/common"
 	secretlib "github.com/bytebase/bytebase/backend/component/secret"
@@ -15,9 +18,11 @@ import (
 )
 
 // DBFactory is the factory for building database driver.
+// The drivers are pooled and reused to save the connection cost, e.g. the TLS and SSH handshakes.
 type DBFactory struct {
 	store          *store.Store
 	licenseService *enterprise.LicenseService
+	pool           *driverPool
 }
 
 // New creates a new database driver factory.
@@ -25,9 +30,34 @@ func New(store *store.Store, licenseService *enterprise.LicenseService) *DBFacto
 	return &DBFactory{
 		store:          store,
 		licenseService: licenseService,
+		pool:           newDriverPool(maxOpenDrivers),
 	}
 }
 
+// Run evicts the idle drivers periodically, and closes them when the context is done.
+func (d *DBFactory) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(evictionInterval)
+	defer ticker.Stop()
+	slog.Debug("Database driver pool started")
+	for {
+		select {
+		case <-ticker.C:
+			d.pool.evictIdle(ctx)
+		case <-ctx.Done():
+			// Use a fresh context because the drivers should be closed even if the context is canceled.
+			d.pool.close(context.Background())
+			return
+		}
+	}
+}
+
+// InvalidateInstance closes the pooled drivers of the instance.
+// It should be called when the instance or its data sources are changed or deleted.
+func (d *DBFactory) InvalidateInstance(ctx context.Context, instanceID string) {
+	d.pool.invalidate(ctx, instanceID)
+}
+
 // GetAdminDatabaseDriver gets the admin database driver using the instance's admin data source.
 // Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
 func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connectionContext db.ConnectionContext) (db.Driver, error) {
@@ -42,6 +72,7 @@ func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.
 }
 
 // GetDataSourceDriver returns the database driver for a data source.
+// Upon successful return, caller must call driver.Close() to put the driver back to the pool.
 func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *storepb.DataSource, connectionContext db.ConnectionContext) (db.Driver, error) {
 	password := dataSource.GetPassword()
 	if err := d.licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_EXTERNAL_SECRET_MANAGER, instance); err == nil {
@@ -54,18 +85,19 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 	connectionContext.InstanceID = instance.ResourceID
 	connectionContext.EngineVersion = instance.Metadata.GetVersion()
 
-	driver, err := db.Open(
-		ctx,
-		instance.Metadata.GetEngine(),
-		db.ConnectionConfig{
-			DataSource:        dataSource,
-			ConnectionContext: connectionContext,
-			Password:          password,
-		},
-	)
+	key, err := newPoolKey(instance.ResourceID, dataSource, password, connectionContext)
 	if err != nil {
 		return nil, err
 	}
-
-	return driver, nil
+	return d.pool.get(ctx, key, func() (db.Driver, error) {
+		return db.Open(
+			ctx,
+			instance.Metadata.GetEngine(),
+			db.ConnectionConfig{
+				DataSource:        dataSource,
+				ConnectionContext: connectionContext,
+				Password:          password,
+			},
+		)
+	})
 }
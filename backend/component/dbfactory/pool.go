@@ -0,0 +1,386 @@
+package dbfactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+const (
+	// maxIdleDriversPerKey is the maximum number of idle drivers kept for the same key.
+	maxIdleDriversPerKey = 4
+	// maxIdleDrivers is the maximum number of idle drivers kept in the pool.
+	maxIdleDrivers = 128
+	// maxOpenDrivers is the maximum number of open drivers, both in use and idle.
+	maxOpenDrivers = 512
+	// driverIdleTimeout is the duration after which an idle driver is closed.
+	driverIdleTimeout = 5 * time.Minute
+	// driverPingTimeout is the timeout for checking the health of an idle driver before reusing it.
+	driverPingTimeout = 5 * time.Second
+	evictionInterval  = 1 * time.Minute
+)
+
+var (
+	driverPoolRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bytebase",
+		Subsystem: "driver_pool",
+		Name:      "requests_total",
+		Help:      "The number of driver requests to the driver pool, partitioned by whether an idle driver is reused.",
+	}, []string{"result"})
+	driverPoolOpenDrivers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "bytebase",
+		Subsystem: "driver_pool",
+		Name:      "open_drivers",
+		Help:      "The number of open drivers, both in use and idle.",
+	})
+	driverPoolIdleDrivers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "bytebase",
+		Subsystem: "driver_pool",
+		Name:      "idle_drivers",
+		Help:      "The number of idle drivers in the driver pool.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(driverPoolRequests, driverPoolOpenDrivers, driverPoolIdleDrivers)
+}
+
+// dataSourceKey identifies a data source of an instance.
+type dataSourceKey struct {
+	instanceID   string
+	dataSourceID string
+}
+
+// poolKey identifies the drivers that can be used interchangeably.
+type poolKey struct {
+	dataSourceKey
+	// fingerprint is the hash of the data source and its resolved password.
+	// The drivers are invalidated if the data source or its external secret changes.
+	fingerprint string
+
+	environmentID    string
+	engineVersion    string
+	databaseName     string
+	useDatabaseOwner bool
+	dataShare        bool
+	readOnly         bool
+}
+
+func newPoolKey(instanceID string, dataSource *storepb.DataSource, password string, connectionContext db.ConnectionContext) (poolKey, error) {
+	bytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(dataSource)
+	if err != nil {
+		return poolKey{}, errors.Wrapf(err, "failed to marshal data source")
+	}
+	h := sha256.New()
+	_, _ = h.Write(bytes)
+	_, _ = h.Write([]byte(password))
+	return poolKey{
+		dataSourceKey: dataSourceKey{
+			instanceID:   instanceID,
+			dataSourceID: dataSource.GetId(),
+		},
+		fingerprint:      hex.EncodeToString(h.Sum(nil)),
+		environmentID:    connectionContext.EnvironmentID,
+		engineVersion:    connectionContext.EngineVersion,
+		databaseName:     connectionContext.DatabaseName,
+		useDatabaseOwner: connectionContext.UseDatabaseOwner,
+		dataShare:        connectionContext.DataShare,
+		readOnly:         connectionContext.ReadOnly,
+	}, nil
+}
+
+type idleDriver struct {
+	driver db.Driver
+	since  time.Time
+}
+
+// driverPool is a keyed, bounded pool of the database drivers.
+// A driver is used by one caller at a time; closing the driver returned by get puts it back to the pool.
+// Only the drivers implementing db.SessionResetter are pooled, and their session state is reset before being reused.
+type driverPool struct {
+	mu     sync.Mutex
+	idle   map[poolKey][]*idleDriver
+	closed bool
+	// idleCount is the number of idle drivers.
+	idleCount int
+	// openCount is the number of drivers opened by the pool and not closed yet.
+	openCount int
+	// openSlots limits the number of open drivers. A slot is taken when a driver is opened and released when it is closed.
+	openSlots chan struct{}
+	// fingerprints is the latest fingerprint of each data source.
+	fingerprints map[dataSourceKey]string
+}
+
+func newDriverPool(maxOpen int) *driverPool {
+	return &driverPool{
+		idle:         make(map[poolKey][]*idleDriver),
+		openSlots:    make(chan struct{}, maxOpen),
+		fingerprints: make(map[dataSourceKey]string),
+	}
+}
+
+// get returns an idle driver for the key if there is a healthy one, or opens a new driver.
+func (p *driverPool) get(ctx context.Context, key poolKey, open func() (db.Driver, error)) (db.Driver, error) {
+	for {
+		driver, stale := p.takeIdle(key)
+		p.closeDrivers(ctx, stale)
+		if driver == nil {
+			break
+		}
+		if err := resetDriver(ctx, driver); err != nil {
+			slog.Debug("close unhealthy idle driver", slog.String("instance", key.instanceID), log.BBError(err))
+			p.closeDrivers(ctx, []db.Driver{driver})
+			continue
+		}
+		driverPoolRequests.WithLabelValues("hit").Inc()
+		return &pooledDriver{Driver: driver, pool: p, key: key}, nil
+	}
+
+	driverPoolRequests.WithLabelValues("miss").Inc()
+	if err := p.acquireSlot(ctx); err != nil {
+		return nil, err
+	}
+	driver, err := open()
+	if err != nil {
+		<-p.openSlots
+		return nil, err
+	}
+	p.mu.Lock()
+	p.openCount++
+	p.updateGaugesLocked()
+	p.mu.Unlock()
+	return &pooledDriver{Driver: driver, pool: p, key: key}, nil
+}
+
+// resetDriver checks the health of an idle driver and clears the session state left by the previous caller.
+func resetDriver(ctx context.Context, driver db.Driver) error {
+	ctx, cancel := context.WithTimeout(ctx, driverPingTimeout)
+	defer cancel()
+	if err := driver.Ping(ctx); err != nil {
+		return err
+	}
+	resetter, ok := driver.(db.SessionResetter)
+	if !ok {
+		return errors.New("driver does not support resetting the session")
+	}
+	return resetter.ResetSession(ctx)
+}
+
+// acquireSlot takes an open slot for a new driver. If all slots are taken, the least recently used idle driver is closed
+// to free a slot, or it waits for a driver in use to be closed.
+func (p *driverPool) acquireSlot(ctx context.Context) error {
+	for {
+		select {
+		case p.openSlots <- struct{}{}:
+			return nil
+		default:
+		}
+		driver := p.takeOldestIdle()
+		if driver == nil {
+			break
+		}
+		p.closeDrivers(ctx, []db.Driver{driver})
+	}
+	select {
+	case p.openSlots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "too many open database drivers")
+	}
+}
+
+// takeOldestIdle takes the least recently used idle driver of any key.
+func (p *driverPool) takeOldestIdle() db.Driver {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var oldestKey poolKey
+	var oldest *idleDriver
+	for key, drivers := range p.idle {
+		// The drivers of a key are ordered by the time they are put back.
+		if oldest == nil || drivers[0].since.Before(oldest.since) {
+			oldestKey, oldest = key, drivers[0]
+		}
+	}
+	if oldest == nil {
+		return nil
+	}
+	p.setIdleLocked(oldestKey, p.idle[oldestKey][1:])
+	p.idleCount--
+	p.updateGaugesLocked()
+	return oldest.driver
+}
+
+// takeIdle takes the most recently used idle driver for the key.
+// It also returns the stale drivers of the data source that should be closed.
+func (p *driverPool) takeIdle(key poolKey) (db.Driver, []db.Driver) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var stale []db.Driver
+	if fingerprint, ok := p.fingerprints[key.dataSourceKey]; ok && fingerprint != key.fingerprint {
+		// The data source or its external secret has changed.
+		stale = p.removeIdleLocked(func(k poolKey) bool {
+			return k.dataSourceKey == key.dataSourceKey && k.fingerprint != key.fingerprint
+		})
+	}
+	p.fingerprints[key.dataSourceKey] = key.fingerprint
+
+	drivers := p.idle[key]
+	for len(drivers) > 0 {
+		last := drivers[len(drivers)-1]
+		drivers = drivers[:len(drivers)-1]
+		p.idleCount--
+		if time.Since(last.since) > driverIdleTimeout {
+			stale = append(stale, last.driver)
+			continue
+		}
+		p.setIdleLocked(key, drivers)
+		p.updateGaugesLocked()
+		return last.driver, stale
+	}
+	p.setIdleLocked(key, drivers)
+	p.updateGaugesLocked()
+	return nil, stale
+}
+
+// put puts the driver back to the pool, or closes it if the pool is full, the driver is stale or the driver cannot be reset.
+func (p *driverPool) put(ctx context.Context, key poolKey, driver db.Driver) error {
+	if _, ok := driver.(db.SessionResetter); !ok {
+		return p.closeDriver(ctx, driver)
+	}
+	p.mu.Lock()
+	if p.closed || p.fingerprints[key.dataSourceKey] != key.fingerprint || len(p.idle[key]) >= maxIdleDriversPerKey || p.idleCount >= maxIdleDrivers {
+		p.mu.Unlock()
+		return p.closeDriver(ctx, driver)
+	}
+	p.idle[key] = append(p.idle[key], &idleDriver{driver: driver, since: time.Now()})
+	p.idleCount++
+	p.updateGaugesLocked()
+	p.mu.Unlock()
+	return nil
+}
+
+// invalidate closes the idle drivers of the instance, and the drivers in use are closed instead of being put back.
+func (p *driverPool) invalidate(ctx context.Context, instanceID string) {
+	p.mu.Lock()
+	stale := p.removeIdleLocked(func(k poolKey) bool {
+		return k.instanceID == instanceID
+	})
+	for k := range p.fingerprints {
+		if k.instanceID == instanceID {
+			delete(p.fingerprints, k)
+		}
+	}
+	p.mu.Unlock()
+	p.closeDrivers(ctx, stale)
+}
+
+// evictIdle closes the drivers that have been idle for longer than the idle timeout.
+func (p *driverPool) evictIdle(ctx context.Context) {
+	p.mu.Lock()
+	var stale []db.Driver
+	for key, drivers := range p.idle {
+		var kept []*idleDriver
+		for _, d := range drivers {
+			if time.Since(d.since) > driverIdleTimeout {
+				stale = append(stale, d.driver)
+				p.idleCount--
+				continue
+			}
+			kept = append(kept, d)
+		}
+		p.setIdleLocked(key, kept)
+	}
+	p.updateGaugesLocked()
+	p.mu.Unlock()
+	p.closeDrivers(ctx, stale)
+}
+
+// close closes all idle drivers. The drivers in use are closed when they are released.
+func (p *driverPool) close(ctx context.Context) {
+	p.mu.Lock()
+	p.closed = true
+	stale := p.removeIdleLocked(func(poolKey) bool { return true })
+	p.mu.Unlock()
+	p.closeDrivers(ctx, stale)
+}
+
+func (p *driverPool) removeIdleLocked(match func(poolKey) bool) []db.Driver {
+	var removed []db.Driver
+	for key, drivers := range p.idle {
+		if !match(key) {
+			continue
+		}
+		for _, d := range drivers {
+			removed = append(removed, d.driver)
+		}
+		p.idleCount -= len(drivers)
+		delete(p.idle, key)
+	}
+	p.updateGaugesLocked()
+	return removed
+}
+
+func (p *driverPool) setIdleLocked(key poolKey, drivers []*idleDriver) {
+	if len(drivers) == 0 {
+		delete(p.idle, key)
+		return
+	}
+	p.idle[key] = drivers
+}
+
+func (p *driverPool) closeDrivers(ctx context.Context, drivers []db.Driver) {
+	for _, driver := range drivers {
+		if err := p.closeDriver(ctx, driver); err != nil {
+			slog.Debug("failed to close driver", log.BBError(err))
+		}
+	}
+}
+
+func (p *driverPool) closeDriver(ctx context.Context, driver db.Driver) error {
+	p.mu.Lock()
+	p.openCount--
+	p.updateGaugesLocked()
+	p.mu.Unlock()
+	<-p.openSlots
+	return driver.Close(ctx)
+}
+
+func (p *driverPool) updateGaugesLocked() {
+	driverPoolOpenDrivers.Set(float64(p.openCount))
+	driverPoolIdleDrivers.Set(float64(p.idleCount))
+}
+
+// pooledDriver is a driver borrowed from the pool. Closing it puts the driver back to the pool.
+type pooledDriver struct {
+	db.Driver
+	pool     *driverPool
+	key      poolKey
+	released atomic.Bool
+}
+
+// Close puts the driver back to the pool.
+func (d *pooledDriver) Close(ctx context.Context) error {
+	if d.released.Swap(true) {
+		return nil
+	}
+	return d.pool.put(ctx, d.key, d.Driver)
+}
+
+// Unwrap returns the underlying driver.
+func (d *pooledDriver) Unwrap() db.Driver {
+	return d.Driver
+}
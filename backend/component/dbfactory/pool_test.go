@@ -0,0 +1,253 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+type fakeDriver struct {
+	db.Driver
+	pingErr error
+	closed  bool
+	resets  int
+}
+
+func (d *fakeDriver) Ping(context.Context) error {
+	return d.pingErr
+}
+
+func (d *fakeDriver) ResetSession(context.Context) error {
+	d.resets++
+	return nil
+}
+
+func (d *fakeDriver) Close(context.Context) error {
+	d.closed = true
+	return nil
+}
+
+// nonResettableDriver is a driver whose session state cannot be reset.
+type nonResettableDriver struct {
+	db.Driver
+	closed bool
+}
+
+func (d *nonResettableDriver) Close(context.Context) error {
+	d.closed = true
+	return nil
+}
+
+func newTestPoolKey(t *testing.T, password string, databaseName string) poolKey {
+	key, err := newPoolKey("prod", &storepb.DataSource{Id: "admin", Host: "localhost"}, password, db.ConnectionContext{DatabaseName: databaseName})
+	require.NoError(t, err)
+	return key
+}
+
+func TestDriverPoolReuse(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool(maxOpenDrivers)
+	opened := 0
+	open := func() (db.Driver, error) {
+		opened++
+		return &fakeDriver{}, nil
+	}
+
+	key := newTestPoolKey(t, "pwd", "db1")
+	driver, err := p.get(ctx, key, open)
+	a.NoError(err)
+	underlying := db.Unwrap(driver)
+	a.NoError(driver.Close(ctx))
+	// Closing twice doesn't put the driver back twice.
+	a.NoError(driver.Close(ctx))
+	a.Equal(1, p.idleCount)
+
+	driver, err = p.get(ctx, key, open)
+	a.NoError(err)
+	a.Same(underlying, db.Unwrap(driver))
+	a.Equal(1, underlying.(*fakeDriver).resets)
+	a.Equal(1, opened)
+	a.Equal(0, p.idleCount)
+
+	// The driver in use is not shared.
+	other, err := p.get(ctx, key, open)
+	a.NoError(err)
+	a.NotSame(underlying, db.Unwrap(other))
+	a.Equal(2, opened)
+
+	// A different database uses a different key.
+	_, err = p.get(ctx, newTestPoolKey(t, "pwd", "db2"), open)
+	a.NoError(err)
+	a.Equal(3, opened)
+	a.Equal(3, p.openCount)
+}
+
+func TestDriverPoolUnhealthy(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool(maxOpenDrivers)
+	key := newTestPoolKey(t, "pwd", "db1")
+
+	unhealthy := &fakeDriver{}
+	driver, err := p.get(ctx, key, func() (db.Driver, error) { return unhealthy, nil })
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	unhealthy.pingErr = errors.New("connection reset")
+
+	healthy := &fakeDriver{}
+	driver, err = p.get(ctx, key, func() (db.Driver, error) { return healthy, nil })
+	a.NoError(err)
+	a.Same(healthy, db.Unwrap(driver))
+	a.True(unhealthy.closed)
+	a.Equal(1, p.openCount)
+}
+
+func TestDriverPoolInvalidation(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool(maxOpenDrivers)
+	open := func() (db.Driver, error) { return &fakeDriver{}, nil }
+
+	// The password of the data source changes while a driver is in use.
+	oldKey := newTestPoolKey(t, "old", "db1")
+	idle, err := p.get(ctx, oldKey, open)
+	a.NoError(err)
+	inUse, err := p.get(ctx, oldKey, open)
+	a.NoError(err)
+	a.NoError(idle.Close(ctx))
+
+	newKey := newTestPoolKey(t, "new", "db1")
+	a.NotEqual(oldKey.fingerprint, newKey.fingerprint)
+	driver, err := p.get(ctx, newKey, open)
+	a.NoError(err)
+	a.True(db.Unwrap(idle).(*fakeDriver).closed)
+	// The stale driver is closed instead of being put back.
+	a.NoError(inUse.Close(ctx))
+	a.True(db.Unwrap(inUse).(*fakeDriver).closed)
+
+	a.NoError(driver.Close(ctx))
+	a.Equal(1, p.idleCount)
+	p.invalidate(ctx, "prod")
+	a.True(db.Unwrap(driver).(*fakeDriver).closed)
+	a.Equal(0, p.idleCount)
+	a.Equal(0, p.openCount)
+}
+
+func TestDriverPoolEviction(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool(maxOpenDrivers)
+	key := newTestPoolKey(t, "pwd", "db1")
+
+	var drivers []db.Driver
+	for i := 0; i < maxIdleDriversPerKey+1; i++ {
+		driver, err := p.get(ctx, key, func() (db.Driver, error) { return &fakeDriver{}, nil })
+		a.NoError(err)
+		drivers = append(drivers, driver)
+	}
+	for _, driver := range drivers {
+		a.NoError(driver.Close(ctx))
+	}
+	// The driver exceeding the limit per key is closed.
+	a.Equal(maxIdleDriversPerKey, p.idleCount)
+	a.True(db.Unwrap(drivers[maxIdleDriversPerKey]).(*fakeDriver).closed)
+
+	for _, d := range p.idle[key] {
+		d.since = time.Now().Add(-driverIdleTimeout - time.Second)
+	}
+	p.evictIdle(ctx)
+	a.Equal(0, p.idleCount)
+	a.Equal(0, p.openCount)
+	for _, driver := range drivers {
+		a.True(db.Unwrap(driver).(*fakeDriver).closed)
+	}
+}
+
+func TestDriverPoolNonResettable(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool(maxOpenDrivers)
+	key := newTestPoolKey(t, "pwd", "db1")
+
+	driver, err := p.get(ctx, key, func() (db.Driver, error) { return &nonResettableDriver{}, nil })
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	// The driver is closed instead of being put back.
+	a.True(db.Unwrap(driver).(*nonResettableDriver).closed)
+	a.Equal(0, p.idleCount)
+	a.Equal(0, p.openCount)
+}
+
+func TestDriverPoolMaxOpen(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool(2)
+	open := func() (db.Driver, error) { return &fakeDriver{}, nil }
+
+	idle, err := p.get(ctx, newTestPoolKey(t, "pwd", "db1"), open)
+	a.NoError(err)
+	inUse, err := p.get(ctx, newTestPoolKey(t, "pwd", "db2"), open)
+	a.NoError(err)
+	a.NoError(idle.Close(ctx))
+
+	// The idle driver of another key is closed to open a new driver.
+	driver, err := p.get(ctx, newTestPoolKey(t, "pwd", "db3"), open)
+	a.NoError(err)
+	a.True(db.Unwrap(idle).(*fakeDriver).closed)
+	a.Equal(2, p.openCount)
+
+	// It waits for a driver in use to be closed if there is no idle driver.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = p.get(timeoutCtx, newTestPoolKey(t, "pwd", "db4"), open)
+	a.ErrorIs(err, context.DeadlineExceeded)
+
+	a.NoError(inUse.Close(ctx))
+	a.NoError(driver.Close(ctx))
+	_, err = p.get(ctx, newTestPoolKey(t, "pwd", "db4"), open)
+	a.NoError(err)
+	a.Equal(2, p.openCount)
+}
+
+// streamingDriver is a driver implementing the optional QueryResultStreamer interface.
+type streamingDriver struct {
+	fakeDriver
+}
+
+func (d *streamingDriver) Open(context.Context, storepb.Engine, db.ConnectionConfig) (db.Driver, error) {
+	return d, nil
+}
+
+func (*streamingDriver) QueryConnStream(context.Context, *sql.Conn, string, db.QueryContext, db.QueryResultHandler) error {
+	return nil
+}
+
+func TestDriverPoolUnwrap(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	streaming := &streamingDriver{}
+	db.Register(storepb.Engine_ENGINE_UNSPECIFIED, func() db.Driver { return streaming })
+
+	// Open the driver the way GetDataSourceDriver does.
+	p := newDriverPool(maxOpenDrivers)
+	driver, err := p.get(ctx, newTestPoolKey(t, "pwd", "db1"), func() (db.Driver, error) {
+		return db.Open(ctx, storepb.Engine_ENGINE_UNSPECIFIED, db.ConnectionConfig{})
+	})
+	a.NoError(err)
+	defer driver.Close(ctx)
+
+	// The pooled driver hides the optional interfaces of the underlying driver.
+	_, ok := driver.(db.QueryResultStreamer)
+	a.False(ok)
+	streamer, ok := db.Unwrap(driver).(db.QueryResultStreamer)
+	a.True(ok)
+	a.Same(streaming, streamer)
+}
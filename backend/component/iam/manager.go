@@ -51,7 +51,13 @@ func NewManager(store *store.Store, licenseService *enterprise.LicenseService) (
 // Check if the user has permission on the resource hierarchy.
 // CEL on the binding is not considered.
 // When multiple projects are specified, the user should have permission on every projects.
+// If the request is authenticated by a personal access token of the user, the permission and projects must be in the token scope.
 func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
+	if accessToken, ok := common.GetAccessTokenFromContext(ctx); ok && accessToken.PrincipalID == user.ID {
+		if !accessToken.AllowPermission(p) || !accessToken.AllowProjects(projectIDs...) {
+			return false, nil
+		}
+	}
 	policyMessage, err := m.store.GetWorkspaceIamPolicy(ctx)
 	if err != nil {
 		return false, err
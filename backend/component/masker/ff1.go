@@ -0,0 +1,141 @@
+package masker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// ff1 is the FF1 format-preserving encryption defined in NIST SP 800-38G,
+// it encrypts a string of numerals in the radix to another string of numerals with the same length.
+type ff1 struct {
+	block cipher.Block
+	radix int
+}
+
+func newFF1(key []byte, radix int) (*ff1, error) {
+	if radix < 2 || radix > 1<<16 {
+		return nil, errors.Errorf("invalid radix %d", radix)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AES cipher")
+	}
+	return &ff1{block: block, radix: radix}, nil
+}
+
+// minDomainSize is the minimum domain size radix^minlen of FF1 required by NIST SP 800-38G Revision 1.
+const minDomainSize = 1000000
+
+// minLength returns the minimum length of the numeral strings in the radix, so that the domain is large enough.
+func (f *ff1) minLength() int {
+	n := 1
+	for size := f.radix; size < minDomainSize; size *= f.radix {
+		n++
+	}
+	return n
+}
+
+// encrypt encrypts the numeral string x with the tweak, every numeral in x must be less than the radix.
+// It returns an error if the domain of x is too small to be encrypted securely.
+func (f *ff1) encrypt(x []int, tweak []byte) ([]int, error) {
+	n := len(x)
+	if minLen := f.minLength(); n < minLen {
+		return nil, errors.Errorf("the length %d is less than the minimum length %d of radix %d", n, minLen, f.radix)
+	}
+	t := len(tweak)
+	u := n / 2
+	v := n - u
+	a, b := x[:u], x[u:]
+
+	radix := big.NewInt(int64(f.radix))
+	radixU := new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	radixV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+	// b is the byte length of the numeral string in radix v, d is the byte length of the round output.
+	byteLen := (new(big.Int).Sub(radixV, big.NewInt(1)).BitLen() + 7) / 8
+	d := 4*((byteLen+3)/4) + 4
+
+	p := make([]byte, 16)
+	p[0], p[1], p[2] = 1, 2, 1
+	p[3], p[4], p[5] = byte(f.radix>>16), byte(f.radix>>8), byte(f.radix)
+	p[6] = 10
+	p[7] = byte(u)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(t))
+
+	padding := ((-t-byteLen-1)%16 + 16) % 16
+	numA := f.num(a)
+	numB := f.num(b)
+	for i := 0; i < 10; i++ {
+		q := make([]byte, 0, t+padding+1+byteLen)
+		q = append(q, tweak...)
+		q = append(q, make([]byte, padding)...)
+		q = append(q, byte(i))
+		q = append(q, numB.FillBytes(make([]byte, byteLen))...)
+
+		r := f.prf(append(append([]byte{}, p...), q...))
+		s := append([]byte{}, r...)
+		for j := 1; len(s) < d; j++ {
+			block := make([]byte, 16)
+			binary.BigEndian.PutUint64(block[8:], uint64(j))
+			for k := range block {
+				block[k] ^= r[k]
+			}
+			f.block.Encrypt(block, block)
+			s = append(s, block...)
+		}
+		y := new(big.Int).SetBytes(s[:d])
+
+		modulus := radixU
+		if i%2 == 1 {
+			modulus = radixV
+		}
+		c := new(big.Int).Add(numA, y)
+		c.Mod(c, modulus)
+		numA, numB = numB, c
+	}
+
+	return append(f.str(numA, u), f.str(numB, v)...), nil
+}
+
+// prf is the CBC-MAC of the input with the zero IV, the input is padded with zeros to a multiple of the block size.
+func (f *ff1) prf(input []byte) []byte {
+	if rem := len(input) % aes.BlockSize; rem != 0 {
+		input = append(input, make([]byte, aes.BlockSize-rem)...)
+	}
+	y := make([]byte, aes.BlockSize)
+	for i := 0; i < len(input); i += aes.BlockSize {
+		for k := 0; k < aes.BlockSize; k++ {
+			y[k] ^= input[i+k]
+		}
+		f.block.Encrypt(y, y)
+	}
+	return y
+}
+
+// num returns the number represented by the numeral string in the radix, the most significant numeral first.
+func (f *ff1) num(x []int) *big.Int {
+	radix := big.NewInt(int64(f.radix))
+	result := new(big.Int)
+	for _, numeral := range x {
+		result.Mul(result, radix)
+		result.Add(result, big.NewInt(int64(numeral)))
+	}
+	return result
+}
+
+// str returns the numeral string of length m representing the number in the radix.
+func (f *ff1) str(x *big.Int, m int) []int {
+	radix := big.NewInt(int64(f.radix))
+	result := make([]int, m)
+	x = new(big.Int).Set(x)
+	mod := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		x.DivMod(x, radix, mod)
+		result[i] = int(mod.Int64())
+	}
+	return result
+}
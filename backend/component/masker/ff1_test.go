@@ -0,0 +1,107 @@
+package masker
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFF1Encrypt(t *testing.T) {
+	// The samples of FF1 from NIST, https://csrc.nist.gov/CSRC/media/Projects/Cryptographic-Standards-and-Guidelines/documents/examples/FF1samples.pdf.
+	testCases := []struct {
+		key        string
+		radix      int
+		tweak      string
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			radix:      10,
+			tweak:      "",
+			plaintext:  "0123456789",
+			ciphertext: "2433477484",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			radix:      10,
+			tweak:      "39383736353433323130",
+			plaintext:  "0123456789",
+			ciphertext: "6124200773",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			radix:      36,
+			tweak:      "3737373770717273373737",
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "a9tv40mll9kdu509eum",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F",
+			radix:      36,
+			tweak:      "3737373770717273373737",
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "xbj3kv35jrawxv32ysr",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94",
+			radix:      10,
+			tweak:      "",
+			plaintext:  "0123456789",
+			ciphertext: "6657667009",
+		},
+	}
+
+	const alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+	toNumerals := func(s string) []int {
+		var result []int
+		for _, c := range s {
+			for i, a := range alphabet {
+				if a == c {
+					result = append(result, i)
+				}
+			}
+		}
+		return result
+	}
+
+	a := require.New(t)
+	for _, tc := range testCases {
+		key, err := hex.DecodeString(tc.key)
+		a.NoError(err)
+		tweak, err := hex.DecodeString(tc.tweak)
+		a.NoError(err)
+		f, err := newFF1(key, tc.radix)
+		a.NoError(err)
+		got, err := f.encrypt(toNumerals(tc.plaintext), tweak)
+		a.NoError(err)
+		a.Equal(toNumerals(tc.ciphertext), got)
+	}
+}
+
+func TestFF1MinDomainSize(t *testing.T) {
+	testCases := []struct {
+		radix     int
+		minLength int
+	}{
+		{radix: 10, minLength: 6},
+		{radix: 26, minLength: 5},
+		{radix: 36, minLength: 4},
+		{radix: 1 << 16, minLength: 2},
+	}
+
+	a := require.New(t)
+	key := make([]byte, 32)
+	for _, tc := range testCases {
+		f, err := newFF1(key, tc.radix)
+		a.NoError(err)
+		a.Equal(tc.minLength, f.minLength(), "radix %d", tc.radix)
+
+		_, err = f.encrypt(make([]int, tc.minLength-1), nil)
+		a.Error(err, "radix %d", tc.radix)
+		got, err := f.encrypt(make([]int, tc.minLength), nil)
+		a.NoError(err, "radix %d", tc.radix)
+		a.Len(got, tc.minLength)
+	}
+}
@@ -1,16 +1,24 @@
 package masker
 
 import (
+	"crypto/hmac"
 	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"math/big"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pkg/errors"
 
@@ -27,6 +35,10 @@ type MaskData struct {
 
 	// Data is the data to be masked.
 	Data *v1pb.RowValue
+
+	// Subject is the value identifying the subject the data belongs to, e.g. the patient ID of the row.
+	// It's only used by the SubjectMasker.
+	Subject *v1pb.RowValue
 }
 
 // Masker is the interface that masks the data.
@@ -35,6 +47,13 @@ type Masker interface {
 	Equal(other Masker) bool
 }
 
+// SubjectMasker is the masker that masks the data depending on the subject of the row.
+type SubjectMasker interface {
+	Masker
+	// SubjectColumn returns the name of the result column identifying the subject.
+	SubjectColumn() string
+}
+
 // NoneMasker is the masker that does not mask the data.
 type NoneMasker struct{}
 
@@ -809,3 +828,495 @@ func (m *InnerOuterMasker) maskOuter(data []rune) string {
 }
 
 var _ Masker = (*InnerOuterMasker)(nil)
+
+// FormatPreservingMasker is the masker that encrypts the data with the keyed format-preserving encryption (FF1).
+// The ASCII digits, lowercase and uppercase letters are encrypted to the characters in the same class,
+// and the other characters are kept except that the non-ASCII letters and digits are replaced with "*",
+// so the same data is always masked to the same value with the original shape, e.g. emails and phone numbers.
+// The class with too few characters to be encrypted securely, e.g. less than 6 digits, is replaced with "*" as well.
+type FormatPreservingMasker struct {
+	key   string
+	tweak string
+
+	digits  *ff1
+	letters *ff1
+}
+
+// NewFormatPreservingMasker returns a new FormatPreservingMasker.
+func NewFormatPreservingMasker(key, tweak string) (*FormatPreservingMasker, error) {
+	// Derive the AES-256 key from the key of any length.
+	aesKey := sha256.Sum256([]byte(key))
+	digits, err := newFF1(aesKey[:], 10)
+	if err != nil {
+		return nil, err
+	}
+	letters, err := newFF1(aesKey[:], 26)
+	if err != nil {
+		return nil, err
+	}
+	return &FormatPreservingMasker{
+		key:     key,
+		tweak:   tweak,
+		digits:  digits,
+		letters: letters,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *FormatPreservingMasker) Mask(data *MaskData) *v1pb.RowValue {
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_BoolValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: "******",
+			},
+		}
+	case *v1pb.RowValue_BytesValue:
+		// Keep the non-ASCII bytes, the bytes may not be valid UTF-8.
+		s := make([]rune, len(kind.BytesValue))
+		for i, b := range kind.BytesValue {
+			s[i] = rune(b)
+		}
+		s = m.encrypt(s)
+		bytesValue := make([]byte, len(s))
+		for i, r := range s {
+			bytesValue[i] = byte(r)
+		}
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_BytesValue{
+				BytesValue: bytesValue,
+			},
+		}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	s, ok := rowValueToString(data.Data)
+	if !ok {
+		return nil
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: string(m.encrypt([]rune(s))),
+		},
+	}
+}
+
+// encrypt encrypts the digits, lowercase and uppercase letters separately, and puts them back to the original positions.
+func (m *FormatPreservingMasker) encrypt(s []rune) []rune {
+	classes := []struct {
+		id     byte
+		cipher *ff1
+		base   rune
+		match  func(rune) bool
+	}{
+		{id: 'd', cipher: m.digits, base: '0', match: func(r rune) bool { return r >= '0' && r <= '9' }},
+		{id: 'l', cipher: m.letters, base: 'a', match: func(r rune) bool { return r >= 'a' && r <= 'z' }},
+		{id: 'u', cipher: m.letters, base: 'A', match: func(r rune) bool { return r >= 'A' && r <= 'Z' }},
+	}
+
+	result := make([]rune, len(s))
+	copy(result, s)
+	for i, r := range result {
+		if r > unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			result[i] = '*'
+		}
+	}
+	for _, class := range classes {
+		var positions []int
+		var numerals []int
+		for i, r := range s {
+			if class.match(r) {
+				positions = append(positions, i)
+				numerals = append(numerals, int(r-class.base))
+			}
+		}
+		if len(numerals) == 0 {
+			continue
+		}
+		// Mix the class into the tweak so that the same sequence of lowercase and uppercase letters are encrypted differently.
+		encrypted, err := class.cipher.encrypt(numerals, append([]byte(m.tweak), class.id))
+		if err != nil {
+			// The domain is too small, the encrypted value can be recovered by enumerating all the values.
+			for _, position := range positions {
+				result[position] = '*'
+			}
+			continue
+		}
+		for i, position := range positions {
+			result[position] = class.base + rune(encrypted[i])
+		}
+	}
+	return result
+}
+
+// Equal implements Masker.Equal.
+func (m *FormatPreservingMasker) Equal(other Masker) bool {
+	if otherFormatPreservingMasker, ok := other.(*FormatPreservingMasker); ok {
+		return m.key == otherFormatPreservingMasker.key && m.tweak == otherFormatPreservingMasker.tweak
+	}
+	return false
+}
+
+// HMACMasker is the masker that replaces the data with the token generated by the keyed HMAC-SHA256,
+// so the same data is always masked to the same token, and the masked columns are still joinable.
+type HMACMasker struct {
+	key    string
+	length int32
+	prefix string
+}
+
+// NewHMACMasker returns a new HMACMasker, the whole hex-encoded digest is kept if the length is not positive.
+func NewHMACMasker(key string, length int32, prefix string) *HMACMasker {
+	return &HMACMasker{
+		key:    key,
+		length: length,
+		prefix: prefix,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *HMACMasker) Mask(data *MaskData) *v1pb.RowValue {
+	f := func(b []byte) string {
+		h := hmac.New(sha256.New, []byte(m.key))
+		_, _ = h.Write(b)
+		token := hex.EncodeToString(h.Sum(nil))
+		if m.length > 0 && int(m.length) < len(token) {
+			token = token[:m.length]
+		}
+		return m.prefix + token
+	}
+
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_BoolValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: "******",
+			},
+		}
+	case *v1pb.RowValue_BytesValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: f(kind.BytesValue),
+			},
+		}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	s, ok := rowValueToString(data.Data)
+	if !ok {
+		return nil
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: f([]byte(s)),
+		},
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *HMACMasker) Equal(other Masker) bool {
+	if otherHMACMasker, ok := other.(*HMACMasker); ok {
+		return m.key == otherHMACMasker.key && m.length == otherHMACMasker.length && m.prefix == otherHMACMasker.prefix
+	}
+	return false
+}
+
+// rowValueToString returns the string representation of the scalar row value.
+func rowValueToString(value *v1pb.RowValue) (string, bool) {
+	switch kind := value.Kind.(type) {
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 64), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	case *v1pb.RowValue_TimestampValue:
+		return kind.TimestampValue.GoogleTimestamp.AsTime().Format("2006-01-02 15:04:05.000000"), true
+	case *v1pb.RowValue_TimestampTzValue:
+		t := kind.TimestampTzValue.GoogleTimestamp.AsTime()
+		z := time.FixedZone(kind.TimestampTzValue.GetZone(), int(kind.TimestampTzValue.GetOffset()))
+		return t.In(z).Format(time.RFC3339Nano), true
+	}
+	return "", false
+}
+
+// DateShiftMasker is the masker that shifts the date and timestamp values by a random but consistent number of days per subject.
+// The values of the same subject are shifted by the same number of days, so the intervals between them are preserved.
+type DateShiftMasker struct {
+	key           string
+	maxShiftDays  int32
+	subjectColumn string
+}
+
+// NewDateShiftMasker returns a new DateShiftMasker.
+func NewDateShiftMasker(key string, maxShiftDays int32, subjectColumn string) *DateShiftMasker {
+	return &DateShiftMasker{
+		key:           key,
+		maxShiftDays:  maxShiftDays,
+		subjectColumn: subjectColumn,
+	}
+}
+
+// dateStringRegexp matches the date strings such as "2006-01-02", "2006-01-02 15:04:05" and "2006-01-02T15:04:05Z".
+var dateStringRegexp = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})([ T]|$)`)
+
+// Mask implements Masker.Mask.
+// If the subject column is configured but the subject is missing, e.g. the column is not selected,
+// the values are masked fully, because shifting the dates of all subjects by the same days
+// would let the original dates be recovered from any known one.
+func (m *DateShiftMasker) Mask(data *MaskData) *v1pb.RowValue {
+	if _, ok := data.Data.Kind.(*v1pb.RowValue_NullValue); ok {
+		return data.Data
+	}
+	if m.subjectColumn != "" && data.Subject == nil {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: "******",
+			},
+		}
+	}
+	days := m.shiftDays(data.Subject)
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_TimestampValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_TimestampValue{
+				TimestampValue: &v1pb.RowValue_Timestamp{
+					GoogleTimestamp: timestamppb.New(kind.TimestampValue.GetGoogleTimestamp().AsTime().AddDate(0, 0, days)),
+					Accuracy:        kind.TimestampValue.GetAccuracy(),
+				},
+			},
+		}
+	case *v1pb.RowValue_TimestampTzValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_TimestampTzValue{
+				TimestampTzValue: &v1pb.RowValue_TimestampTZ{
+					GoogleTimestamp: timestamppb.New(kind.TimestampTzValue.GetGoogleTimestamp().AsTime().AddDate(0, 0, days)),
+					Zone:            kind.TimestampTzValue.GetZone(),
+					Offset:          kind.TimestampTzValue.GetOffset(),
+					Accuracy:        kind.TimestampTzValue.GetAccuracy(),
+				},
+			},
+		}
+	case *v1pb.RowValue_StringValue:
+		// The DATE and DATETIME values are returned as strings by some drivers.
+		// Only the date part is shifted, so the time part and the format are kept as is.
+		matches := dateStringRegexp.FindStringSubmatch(kind.StringValue)
+		if matches != nil {
+			year, _ := strconv.Atoi(matches[1])
+			month, _ := strconv.Atoi(matches[2])
+			day, _ := strconv.Atoi(matches[3])
+			date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+			// Reject the invalid dates such as "2006-02-30" which are normalized by time.Date.
+			if date.Year() == year && int(date.Month()) == month && date.Day() == day {
+				shifted := date.AddDate(0, 0, days).Format("2006-01-02")
+				return &v1pb.RowValue{
+					Kind: &v1pb.RowValue_StringValue{
+						StringValue: shifted + kind.StringValue[len("2006-01-02"):],
+					},
+				}
+			}
+		}
+	case *v1pb.RowValue_ValueValue:
+		// The nested values are shifted by the same days as the subject of the row.
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(&subjectDateShiftMasker{DateShiftMasker: m, subject: data.Subject}, kind.ValueValue),
+			},
+		}
+	}
+	// The values that are not dates are masked fully.
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: "******",
+		},
+	}
+}
+
+// shiftDays returns the number of days in [-maxShiftDays, maxShiftDays] derived from the key and the subject.
+func (m *DateShiftMasker) shiftDays(subject *v1pb.RowValue) int {
+	if m.maxShiftDays <= 0 {
+		return 0
+	}
+	h := hmac.New(sha256.New, []byte(m.key))
+	if subject != nil {
+		if bytesValue, ok := subject.Kind.(*v1pb.RowValue_BytesValue); ok {
+			_, _ = h.Write(bytesValue.BytesValue)
+		} else if s, ok := rowValueToString(subject); ok {
+			_, _ = h.Write([]byte(s))
+		}
+	}
+	sum := h.Sum(nil)
+	span := uint64(2*int64(m.maxShiftDays) + 1)
+	return int(binary.BigEndian.Uint64(sum[:8])%span) - int(m.maxShiftDays)
+}
+
+// SubjectColumn implements SubjectMasker.SubjectColumn.
+func (m *DateShiftMasker) SubjectColumn() string {
+	return m.subjectColumn
+}
+
+// subjectDateShiftMasker is the DateShiftMasker bound to the subject of a row.
+type subjectDateShiftMasker struct {
+	*DateShiftMasker
+	subject *v1pb.RowValue
+}
+
+// Mask implements Masker.Mask.
+func (m *subjectDateShiftMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return m.DateShiftMasker.Mask(&MaskData{
+		WantBytes: data.WantBytes,
+		Data:      data.Data,
+		Subject:   m.subject,
+	})
+}
+
+// Equal implements Masker.Equal.
+func (m *DateShiftMasker) Equal(other Masker) bool {
+	if otherDateShiftMasker, ok := other.(*DateShiftMasker); ok {
+		return m.key == otherDateShiftMasker.key && m.maxShiftDays == otherDateShiftMasker.maxShiftDays && m.subjectColumn == otherDateShiftMasker.subjectColumn
+	}
+	return false
+}
+
+// NumericBucketMasker is the masker that generalizes the numeric values into the buckets, e.g. the salary ranges or the age bands.
+type NumericBucketMasker struct {
+	boundaries []float64
+	labels     []string
+
+	boundaryRats []*big.Rat
+}
+
+// NewNumericBucketMasker returns a new NumericBucketMasker.
+// The boundaries must be in ascending order, and there must be one more label than the boundaries if the labels are set.
+func NewNumericBucketMasker(boundaries []float64, labels []string) (*NumericBucketMasker, error) {
+	boundaryRats := make([]*big.Rat, 0, len(boundaries))
+	for i, boundary := range boundaries {
+		r := new(big.Rat)
+		if r.SetFloat64(boundary) == nil {
+			return nil, errors.Errorf("invalid bucket boundary %v", boundary)
+		}
+		if i > 0 && r.Cmp(boundaryRats[i-1]) <= 0 {
+			return nil, errors.Errorf("bucket boundaries must be in ascending order, but got %v after %v", boundary, boundaries[i-1])
+		}
+		boundaryRats = append(boundaryRats, r)
+	}
+	if len(labels) > 0 && len(labels) != len(boundaries)+1 {
+		return nil, errors.Errorf("expected %d bucket labels for %d boundaries, but got %d", len(boundaries)+1, len(boundaries), len(labels))
+	}
+	return &NumericBucketMasker{
+		boundaries:   boundaries,
+		labels:       labels,
+		boundaryRats: boundaryRats,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *NumericBucketMasker) Mask(data *MaskData) *v1pb.RowValue {
+	value := new(big.Rat)
+	ok := false
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_Int32Value:
+		value.SetInt64(int64(kind.Int32Value))
+		ok = true
+	case *v1pb.RowValue_Int64Value:
+		value.SetInt64(kind.Int64Value)
+		ok = true
+	case *v1pb.RowValue_Uint32Value:
+		value.SetUint64(uint64(kind.Uint32Value))
+		ok = true
+	case *v1pb.RowValue_Uint64Value:
+		value.SetUint64(kind.Uint64Value)
+		ok = true
+	case *v1pb.RowValue_FloatValue:
+		ok = value.SetFloat64(float64(kind.FloatValue)) != nil
+	case *v1pb.RowValue_DoubleValue:
+		ok = value.SetFloat64(kind.DoubleValue) != nil
+	case *v1pb.RowValue_StringValue:
+		// The DECIMAL and NUMERIC values are returned as strings to keep the precision.
+		_, ok = value.SetString(strings.TrimSpace(kind.StringValue))
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	if !ok {
+		// The values that are not numbers are masked fully.
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: "******",
+			},
+		}
+	}
+
+	// Find the first boundary greater than the value, the buckets are left-closed.
+	bucket, _ := slices.BinarySearchFunc(m.boundaryRats, value, func(boundary, target *big.Rat) int {
+		if boundary.Cmp(target) <= 0 {
+			return -1
+		}
+		return 1
+	})
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: m.bucketLabel(bucket),
+		},
+	}
+}
+
+// bucketLabel returns the label of the bucket, which is "< b0", "[b0, b1)", ..., ">= bn" by default.
+func (m *NumericBucketMasker) bucketLabel(bucket int) string {
+	if len(m.labels) > 0 {
+		return m.labels[bucket]
+	}
+	format := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	switch {
+	case len(m.boundaries) == 0:
+		return "******"
+	case bucket == 0:
+		return fmt.Sprintf("< %s", format(m.boundaries[0]))
+	case bucket == len(m.boundaries):
+		return fmt.Sprintf(">= %s", format(m.boundaries[bucket-1]))
+	default:
+		return fmt.Sprintf("[%s, %s)", format(m.boundaries[bucket-1]), format(m.boundaries[bucket]))
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *NumericBucketMasker) Equal(other Masker) bool {
+	if otherNumericBucketMasker, ok := other.(*NumericBucketMasker); ok {
+		return slices.Equal(m.boundaries, otherNumericBucketMasker.boundaries) && slices.Equal(m.labels, otherNumericBucketMasker.labels)
+	}
+	return false
+}
+
+var (
+	_ Masker        = (*FormatPreservingMasker)(nil)
+	_ Masker        = (*HMACMasker)(nil)
+	_ SubjectMasker = (*DateShiftMasker)(nil)
+	_ Masker        = (*NumericBucketMasker)(nil)
+)
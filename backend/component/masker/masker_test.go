@@ -2,9 +2,11 @@ package masker
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 )
@@ -401,3 +403,218 @@ func TestInnerOuterMask(t *testing.T) {
 		a.Equal(tc.want, got)
 	}
 }
+
+func TestFormatPreservingMask(t *testing.T) {
+	a := require.New(t)
+	masker, err := NewFormatPreservingMasker("secret", "email")
+	a.NoError(err)
+
+	mask := func(m Masker, s string) string {
+		return m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}}).GetStringValue()
+	}
+	isClass := func(r rune, c rune) bool {
+		switch {
+		case c >= '0' && c <= '9':
+			return r >= '0' && r <= '9'
+		case c >= 'a' && c <= 'z':
+			return r >= 'a' && r <= 'z'
+		case c >= 'A' && c <= 'Z':
+			return r >= 'A' && r <= 'Z'
+		default:
+			return r == c
+		}
+	}
+
+	for _, input := range []string{"john.doe@example.com", "+1 (415) 555-0132", ""} {
+		got := mask(masker, input)
+		// Deterministic.
+		a.Equal(got, mask(masker, input))
+		// Format preserving.
+		a.Len([]rune(got), len([]rune(input)))
+		for i, r := range got {
+			a.True(isClass(r, rune(input[i])), "input %q, got %q", input, got)
+		}
+	}
+	a.NotEqual("+1 (415) 555-0132", mask(masker, "+1 (415) 555-0132"))
+	a.Regexp(`^\*\*-\d{6}$`, mask(masker, "中文-123456"))
+
+	// The classes below the minimum domain size of FF1 are replaced with "*".
+	a.Equal("*", mask(masker, "7"))
+	a.Equal("*****", mask(masker, "12345"))
+	a.Regexp(`^\*[a-z]{3}\.\*[a-z]{2}@[a-z]{7}\.[a-z]{3}$`, mask(masker, "John.Doe@example.com"))
+
+	// Different keys or tweaks produce different values.
+	otherKey, err := NewFormatPreservingMasker("another", "email")
+	a.NoError(err)
+	otherTweak, err := NewFormatPreservingMasker("secret", "phone")
+	a.NoError(err)
+	a.NotEqual(mask(masker, "4155550132"), mask(otherKey, "4155550132"))
+	a.NotEqual(mask(masker, "4155550132"), mask(otherTweak, "4155550132"))
+
+	// Numbers are masked to strings of the same shape.
+	got := masker.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 12345.678}}})
+	a.Regexp(`^\d{5}\.\d{3}$`, got.GetStringValue())
+	// NULL is kept.
+	null := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+	a.Equal(null, masker.Mask(&MaskData{Data: null}))
+
+	a.False(masker.Equal(otherKey))
+	same, err := NewFormatPreservingMasker("secret", "email")
+	a.NoError(err)
+	a.True(masker.Equal(same))
+}
+
+func TestHMACMask(t *testing.T) {
+	a := require.New(t)
+	testCases := []struct {
+		masker *HMACMasker
+		input  *v1pb.RowValue
+		want   string
+	}{
+		{
+			// HMAC-SHA256 test case 2 of RFC 4231.
+			masker: NewHMACMasker("Jefe", 0, ""),
+			input:  &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "what do ya want for nothing?"}},
+			want:   "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		},
+		{
+			masker: NewHMACMasker("Jefe", 8, "tok_"),
+			input:  &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "what do ya want for nothing?"}},
+			want:   "tok_5bdcc146",
+		},
+		{
+			masker: NewHMACMasker("Jefe", 128, ""),
+			input:  &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte("what do ya want for nothing?")}},
+			want:   "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		},
+	}
+	for _, tc := range testCases {
+		got := tc.masker.Mask(&MaskData{Data: tc.input})
+		a.Equal(tc.want, got.GetStringValue())
+	}
+
+	// The same numbers in different types are masked to the same token.
+	m := NewHMACMasker("key", 16, "")
+	int32Token := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 42}}}).GetStringValue()
+	int64Token := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}}).GetStringValue()
+	a.Equal(int32Token, int64Token)
+	a.Len(int32Token, 16)
+
+	a.True(m.Equal(NewHMACMasker("key", 16, "")))
+	a.False(m.Equal(NewHMACMasker("key", 16, "x")))
+	a.False(m.Equal(NewMD5Masker("key")))
+}
+
+func TestDateShiftMask(t *testing.T) {
+	a := require.New(t)
+	m := NewDateShiftMasker("secret", 30, "patient_id")
+	a.Equal("patient_id", m.SubjectColumn())
+
+	subject := &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1001}}
+	admitted := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	discharged := time.Date(2024, 3, 11, 17, 0, 0, 0, time.UTC)
+	mask := func(subject *v1pb.RowValue, t time.Time) time.Time {
+		return m.Mask(&MaskData{
+			Data: &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{
+				GoogleTimestamp: timestamppb.New(t),
+			}}},
+			Subject: subject,
+		}).GetTimestampValue().GetGoogleTimestamp().AsTime()
+	}
+
+	// The intervals of the same subject are preserved.
+	maskedAdmitted := mask(subject, admitted)
+	maskedDischarged := mask(subject, discharged)
+	a.Equal(discharged.Sub(admitted), maskedDischarged.Sub(maskedAdmitted))
+	a.Equal(admitted.Hour(), maskedAdmitted.Hour())
+	shift := maskedAdmitted.Sub(admitted)
+	a.Zero(shift % (24 * time.Hour))
+	a.LessOrEqual(shift.Abs(), 30*24*time.Hour)
+
+	// The subjects are shifted independently.
+	shifts := make(map[time.Duration]bool)
+	for i := int64(0); i < 20; i++ {
+		shifts[mask(&v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: i}}, admitted).Sub(admitted)] = true
+	}
+	a.Greater(len(shifts), 1)
+
+	// The date strings are shifted by the same days with the format kept.
+	for _, tc := range []struct {
+		input string
+		want  string
+	}{
+		{input: "2024-03-01", want: admitted.Add(shift).Format("2006-01-02")},
+		{input: "2024-03-01 08:30:00.123", want: admitted.Add(shift).Format("2006-01-02") + " 08:30:00.123"},
+		{input: "2024-03-01T08:30:00+08:00", want: admitted.Add(shift).Format("2006-01-02") + "T08:30:00+08:00"},
+		{input: "2024-02-30", want: "******"},
+		{input: "not a date", want: "******"},
+	} {
+		got := m.Mask(&MaskData{
+			Data:    &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: tc.input}},
+			Subject: subject,
+		})
+		a.Equal(tc.want, got.GetStringValue(), tc.input)
+	}
+
+	// The nested values are shifted by the same days as the subject.
+	nested := m.Mask(&MaskData{
+		Data:    &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: structpb.NewStringValue("2024-03-01")}},
+		Subject: subject,
+	})
+	a.Equal(admitted.Add(shift).Format("2006-01-02"), nested.GetValueValue().GetStringValue())
+
+	// The values are masked fully if the subject column is configured but missing.
+	for _, data := range []*v1pb.RowValue{
+		{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(admitted)}}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-01"}},
+		{Kind: &v1pb.RowValue_ValueValue{ValueValue: structpb.NewStringValue("2024-03-01")}},
+	} {
+		a.Equal("******", m.Mask(&MaskData{Data: data}).GetStringValue())
+	}
+	// Without the subject column, the values are shifted without a subject.
+	noSubject := NewDateShiftMasker("secret", 30, "")
+	a.NotEqual("******", noSubject.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-01"}}}).GetStringValue())
+
+	null := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+	a.Equal(null, m.Mask(&MaskData{Data: null}))
+	a.True(m.Equal(NewDateShiftMasker("secret", 30, "patient_id")))
+	a.False(m.Equal(NewDateShiftMasker("secret", 30, "")))
+}
+
+func TestNumericBucketMask(t *testing.T) {
+	a := require.New(t)
+	ageBands, err := NewNumericBucketMasker([]float64{18, 30, 50}, nil)
+	a.NoError(err)
+	salaryRanges, err := NewNumericBucketMasker([]float64{50000, 100000.5}, []string{"low", "medium", "high"})
+	a.NoError(err)
+
+	testCases := []struct {
+		masker *NumericBucketMasker
+		input  *v1pb.RowValue
+		want   string
+	}{
+		{masker: ageBands, input: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 17}}, want: "< 18"},
+		{masker: ageBands, input: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 18}}, want: "[18, 30)"},
+		{masker: ageBands, input: &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 49}}, want: "[30, 50)"},
+		{masker: ageBands, input: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 50}}, want: ">= 50"},
+		{masker: ageBands, input: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "abc"}}, want: "******"},
+		{masker: ageBands, input: &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}}, want: "******"},
+		{masker: salaryRanges, input: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "100000.49999999999999999"}}, want: "medium"},
+		{masker: salaryRanges, input: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "100000.50"}}, want: "high"},
+		{masker: salaryRanges, input: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "-1"}}, want: "low"},
+	}
+	for _, tc := range testCases {
+		got := tc.masker.Mask(&MaskData{Data: tc.input})
+		a.Equal(tc.want, got.GetStringValue(), tc.input.String())
+	}
+
+	_, err = NewNumericBucketMasker([]float64{30, 18}, nil)
+	a.Error(err)
+	_, err = NewNumericBucketMasker([]float64{18}, []string{"minor"})
+	a.Error(err)
+
+	a.False(ageBands.Equal(salaryRanges))
+	same, err := NewNumericBucketMasker([]float64{18, 30, 50}, nil)
+	a.NoError(err)
+	a.True(ageBands.Equal(same))
+}
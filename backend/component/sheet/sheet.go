@@ -21,7 +21,10 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	bigqueryparser "github.com/bytebase/bytebase/backend/plugin/parser/bigquery"
+	chparser "github.com/bytebase/bytebase/backend/plugin/parser/clickhouse"
 	crparser "github.com/bytebase/bytebase/backend/plugin/parser/cockroachdb"
+	dorisparser "github.com/bytebase/bytebase/backend/plugin/parser/doris"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	partiqlparser "github.com/bytebase/bytebase/backend/plugin/parser/partiql"
 	plsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
@@ -29,6 +32,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
 	tidbbbparser "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	trinoparser "github.com/bytebase/bytebase/backend/plugin/parser/trino"
 	tsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 	tsqlbatch "github.com/bytebase/bytebase/backend/plugin/parser/tsql/batch"
 	"github.com/bytebase/bytebase/backend/store"
@@ -227,6 +231,8 @@ func syntaxCheck(dbType storepb.Engine, statement string) (any, []*storepb.Advic
 		return tidbSyntaxCheck(statement)
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
 		return mysqlSyntaxCheck(statement)
+	case storepb.Engine_STARROCKS, storepb.Engine_DORIS:
+		return dorisSyntaxCheck(statement)
 	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT:
 		return postgresSyntaxCheck(statement)
 	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
@@ -239,6 +245,12 @@ func syntaxCheck(dbType storepb.Engine, statement string) (any, []*storepb.Advic
 		return partiqlSyntaxCheck(statement)
 	case storepb.Engine_COCKROACHDB:
 		return cockroachdbSyntaxCheck(statement)
+	case storepb.Engine_CLICKHOUSE:
+		return clickhouseSyntaxCheck(statement)
+	case storepb.Engine_BIGQUERY:
+		return bigquerySyntaxCheck(statement)
+	case storepb.Engine_TRINO:
+		return trinoSyntaxCheck(statement)
 	}
 	return nil, []*storepb.Advice{
 		{
@@ -251,6 +263,53 @@ func syntaxCheck(dbType storepb.Engine, statement string) (any, []*storepb.Advic
 	}
 }
 
+func clickhouseSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	result, err := chparser.ParseClickHouse(statement)
+	if err != nil {
+		return nil, convertSyntaxErrorToAdvice(err)
+	}
+	return result, nil
+}
+
+func bigquerySyntaxCheck(statement string) (any, []*storepb.Advice) {
+	result, err := bigqueryparser.ParseBigQuerySQL(statement)
+	if err != nil {
+		return nil, convertSyntaxErrorToAdvice(err)
+	}
+	return result.Tree, nil
+}
+
+func trinoSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	result, err := trinoparser.ParseTrinoStatements(statement)
+	if err != nil {
+		return nil, convertSyntaxErrorToAdvice(err)
+	}
+	return result.Tree, nil
+}
+
+func convertSyntaxErrorToAdvice(err error) []*storepb.Advice {
+	if syntaxErr, ok := err.(*base.SyntaxError); ok {
+		return []*storepb.Advice{
+			{
+				Status:        storepb.Advice_WARNING,
+				Code:          StatementSyntaxErrorCode,
+				Title:         SyntaxErrorTitle,
+				Content:       syntaxErr.Message,
+				StartPosition: syntaxErr.Position,
+			},
+		}
+	}
+	return []*storepb.Advice{
+		{
+			Status:        storepb.Advice_WARNING,
+			Code:          InternalErrorCode,
+			Title:         "Parse error",
+			Content:       err.Error(),
+			StartPosition: common.FirstLinePosition,
+		},
+	}
+}
+
 func cockroachdbSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	result, err := crparser.ParseCockroachDBSQL(statement)
 	if err != nil {
@@ -363,6 +422,14 @@ func snowflakeSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	return result.Tree, nil
 }
 
+func dorisSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	result, err := dorisparser.ParseDorisSQL(statement)
+	if err != nil {
+		return nil, convertSyntaxErrorToAdvice(err)
+	}
+	return result.Tree, nil
+}
+
 func oracleSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	tree, _, err := plsqlparser.ParsePLSQL(statement + ";")
 	if err != nil {
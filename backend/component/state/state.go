@@ -50,6 +50,8 @@ type State struct {
 	PlanCheckTickleChan chan int
 	// TaskRunTickleChan is the tickler for task run scheduler.
 	TaskRunTickleChan chan int
+	// WebhookDeliveryTickleChan is the tickler for webhook delivery runner.
+	WebhookDeliveryTickleChan chan int
 
 	ExpireCache *lru.Cache[string, bool]
 }
@@ -66,6 +68,7 @@ func New() (*State, error) {
 		TaskSkippedOrDoneChan:                make(chan int, 1000),
 		PlanCheckTickleChan:                  make(chan int, 1000),
 		TaskRunTickleChan:                    make(chan int, 1000),
+		WebhookDeliveryTickleChan:            make(chan int, 1),
 		ExpireCache:                          expireCache,
 	}, nil
 }
@@ -0,0 +1,308 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// deliveryMaxRetries is the number of failed attempts after which a delivery is dead-lettered.
+	deliveryMaxRetries = 8
+	// deliveryInitialBackoff is the delay before the first retry, doubled on each following retry.
+	deliveryInitialBackoff = 10 * time.Second
+	// deliveryMaxBackoff caps the delay between two retries.
+	deliveryMaxBackoff = 1 * time.Hour
+)
+
+// enqueueDeliveries persists one pending delivery per webhook and wakes up the delivery runner.
+func (m *Manager) enqueueDeliveries(ctx context.Context, projectID string, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) error {
+	event := convertToWebhookEvent(webhookCtx)
+	var creates []*store.WebhookDeliveryMessage
+	for _, hook := range webhookList {
+		creates = append(creates, &store.WebhookDeliveryMessage{
+			ProjectID: projectID,
+			WebhookID: hook.ID,
+			EventType: common.EventType(webhookCtx.EventType),
+			Payload: &storepb.WebhookDeliveryPayload{
+				Event: event,
+			},
+		})
+	}
+	if err := m.store.CreateWebhookDeliveries(ctx, creates...); err != nil {
+		return err
+	}
+	m.tickle()
+	return nil
+}
+
+// ResendDelivery resets a delivery to pending so that it's sent again as soon as possible.
+// The attempt history is kept.
+func (m *Manager) ResendDelivery(ctx context.Context, delivery *store.WebhookDeliveryMessage) (*store.WebhookDeliveryMessage, error) {
+	status := store.WebhookDeliveryStatusPending
+	retryCount := 0
+	now := time.Now()
+	updated, err := m.store.UpdateWebhookDelivery(ctx, delivery.UID, &store.UpdateWebhookDeliveryMessage{
+		Status:        &status,
+		RetryCount:    &retryCount,
+		NextAttemptAt: &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.tickle()
+	return updated, nil
+}
+
+func (m *Manager) tickle() {
+	select {
+	case m.stateCfg.WebhookDeliveryTickleChan <- 0:
+	default:
+	}
+}
+
+// Deliver makes one attempt to send the delivery, and records the attempt with the next state of the delivery.
+func (m *Manager) Deliver(ctx context.Context, delivery *store.WebhookDeliveryMessage) error {
+	hook, err := m.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ID: &delivery.WebhookID,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project webhook %d", delivery.WebhookID)
+	}
+	if hook == nil {
+		// The webhook has been removed after the delivery was created.
+		return m.recordAttempt(ctx, delivery, 0, 0, errors.Errorf("webhook %d not found", delivery.WebhookID), false /* retryable */)
+	}
+
+	webhookCtx, err := m.convertToWebhookContext(ctx, delivery.Payload.GetEvent())
+	if err != nil {
+		// The persisted event cannot be sent, dead-letter it instead of leaving it pending.
+		return m.recordAttempt(ctx, delivery, 0, 0, errors.Wrapf(err, "failed to convert webhook context"), false /* retryable */)
+	}
+	webhookCtx.URL = hook.URL
+	webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
+	webhookCtx.SigningSecrets = webhook.GetSigningSecrets(hook.Payload, time.Now())
+	webhookCtx.EventID = strconv.FormatInt(delivery.UID, 10)
+	setting, err := m.store.GetAppIMSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get app im setting", log.BBError(err))
+	} else {
+		webhookCtx.IMSetting = setting
+	}
+
+	start := time.Now()
+	statusCode, postErr := webhook.Post(hook.Type, *webhookCtx)
+	if postErr != nil {
+		// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
+		slog.Warn("Failed to post webhook event",
+			slog.String("webhook type", hook.Type),
+			slog.String("webhook name", hook.Title),
+			slog.String("event type", webhookCtx.EventType),
+			slog.String("title", webhookCtx.Title),
+			slog.Int("retry count", delivery.RetryCount),
+			log.BBError(postErr))
+	}
+	return m.recordAttempt(ctx, delivery, statusCode, time.Since(start), postErr, true /* retryable */)
+}
+
+func (m *Manager) recordAttempt(ctx context.Context, delivery *store.WebhookDeliveryMessage, statusCode int, latency time.Duration, postErr error, retryable bool) error {
+	update := getAttemptUpdate(delivery, statusCode, latency, postErr, retryable, time.Now())
+	if _, err := m.store.UpdateWebhookDelivery(ctx, delivery.UID, update); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery %d", delivery.UID)
+	}
+	return nil
+}
+
+// getAttemptUpdate returns the update that appends the attempt to the delivery history and moves the delivery to its next state.
+// A successful attempt marks the delivery done. A failed attempt schedules a retry with backoff,
+// or marks the delivery dead if the failure is not retryable or the retries are exhausted.
+func getAttemptUpdate(delivery *store.WebhookDeliveryMessage, statusCode int, latency time.Duration, postErr error, retryable bool, now time.Time) *store.UpdateWebhookDeliveryMessage {
+	if statusCode == 0 {
+		statusCode = webhook.GetStatusCode(postErr)
+	}
+	attempt := &storepb.WebhookDeliveryAttempt{
+		CreateTime: timestamppb.New(now),
+		StatusCode: int32(statusCode),
+		Latency:    durationpb.New(latency),
+	}
+	update := &store.UpdateWebhookDeliveryMessage{}
+	if postErr == nil {
+		status := store.WebhookDeliveryStatusDone
+		update.Status = &status
+	} else {
+		attempt.Error = postErr.Error()
+		retryCount := delivery.RetryCount + 1
+		update.RetryCount = &retryCount
+		if !retryable || retryCount >= deliveryMaxRetries {
+			status := store.WebhookDeliveryStatusDead
+			update.Status = &status
+		} else {
+			nextAttemptAt := now.Add(getRetryBackoff(retryCount))
+			update.NextAttemptAt = &nextAttemptAt
+		}
+	}
+
+	payload := delivery.Payload
+	payload.Attempts = append(payload.Attempts, attempt)
+	update.Payload = payload
+	return update
+}
+
+// getRetryBackoff returns the delay before the next attempt after retryCount failed attempts.
+func getRetryBackoff(retryCount int) time.Duration {
+	backoff := deliveryInitialBackoff
+	for i := 1; i < retryCount; i++ {
+		backoff *= 2
+		if backoff >= deliveryMaxBackoff {
+			return deliveryMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func convertToWebhookEvent(webhookCtx *webhook.Context) *storepb.WebhookEvent {
+	event := &storepb.WebhookEvent{
+		Level:               string(webhookCtx.Level),
+		EventType:           webhookCtx.EventType,
+		Title:               webhookCtx.Title,
+		TitleZh:             webhookCtx.TitleZh,
+		Description:         webhookCtx.Description,
+		Link:                webhookCtx.Link,
+		ActorId:             int32(webhookCtx.ActorID),
+		ActorName:           webhookCtx.ActorName,
+		ActorEmail:          webhookCtx.ActorEmail,
+		CreateTime:          timestamppb.Now(),
+		MentionUsersByPhone: webhookCtx.MentionUsersByPhone,
+	}
+	if v := webhookCtx.Issue; v != nil {
+		event.Issue = &storepb.WebhookEvent_Issue{
+			Id:          int32(v.ID),
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+		}
+		if v.Creator != nil {
+			event.Issue.CreatorId = int32(v.Creator.ID)
+		}
+	}
+	if v := webhookCtx.Rollout; v != nil {
+		event.Rollout = &storepb.WebhookEvent_Rollout{
+			Uid:   int32(v.UID),
+			Title: v.Title,
+		}
+	}
+	if v := webhookCtx.Stage; v != nil {
+		event.StageName = v.Name
+	}
+	if v := webhookCtx.Project; v != nil {
+		event.Project = &storepb.WebhookEvent_Project{
+			Name:  v.Name,
+			Title: v.Title,
+		}
+	}
+	if v := webhookCtx.TaskResult; v != nil {
+		event.TaskResult = &storepb.WebhookEvent_TaskResult{
+			Name:          v.Name,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			SkippedReason: v.SkippedReason,
+		}
+	}
+	if v := webhookCtx.SchemaDrift; v != nil {
+		event.SchemaDrift = &storepb.WebhookEvent_SchemaDrift{
+			Database: v.Database,
+			Objects:  v.Objects,
+		}
+	}
+	for _, user := range webhookCtx.MentionEndUsers {
+		event.MentionEndUserIds = append(event.MentionEndUserIds, int32(user.ID))
+	}
+	return event
+}
+
+func (m *Manager) convertToWebhookContext(ctx context.Context, event *storepb.WebhookEvent) (*webhook.Context, error) {
+	webhookCtx := &webhook.Context{
+		Level:               webhook.Level(event.GetLevel()),
+		EventType:           event.GetEventType(),
+		Title:               event.GetTitle(),
+		TitleZh:             event.GetTitleZh(),
+		Description:         event.GetDescription(),
+		Link:                event.GetLink(),
+		ActorID:             int(event.GetActorId()),
+		ActorName:           event.GetActorName(),
+		ActorEmail:          event.GetActorEmail(),
+		CreatedTS:           event.GetCreateTime().GetSeconds(),
+		MentionUsersByPhone: event.GetMentionUsersByPhone(),
+	}
+	if v := event.GetIssue(); v != nil {
+		creator, err := m.store.GetUserByID(ctx, int(v.CreatorId))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get issue creator %d", v.CreatorId)
+		}
+		if creator == nil {
+			creator = m.store.GetSystemBotUser(ctx)
+		}
+		webhookCtx.Issue = &webhook.Issue{
+			ID:          int(v.Id),
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+			Creator:     creator,
+		}
+	}
+	if v := event.GetRollout(); v != nil {
+		webhookCtx.Rollout = &webhook.Rollout{
+			UID:   int(v.Uid),
+			Title: v.Title,
+		}
+	}
+	if v := event.GetStageName(); v != "" {
+		webhookCtx.Stage = &webhook.Stage{
+			Name: v,
+		}
+	}
+	if v := event.GetProject(); v != nil {
+		webhookCtx.Project = &webhook.Project{
+			Name:  v.Name,
+			Title: v.Title,
+		}
+	}
+	if v := event.GetTaskResult(); v != nil {
+		webhookCtx.TaskResult = &webhook.TaskResult{
+			Name:          v.Name,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			SkippedReason: v.SkippedReason,
+		}
+	}
+	if v := event.GetSchemaDrift(); v != nil {
+		webhookCtx.SchemaDrift = &webhook.SchemaDrift{
+			Database: v.Database,
+			Objects:  v.Objects,
+		}
+	}
+	for _, id := range event.GetMentionEndUserIds() {
+		user, err := m.store.GetUserByID(ctx, int(id))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", id)
+		}
+		if user == nil {
+			continue
+		}
+		webhookCtx.MentionEndUsers = append(webhookCtx.MentionEndUsers, user)
+	}
+	return webhookCtx, nil
+}
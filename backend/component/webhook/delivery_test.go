@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetRetryBackoff(t *testing.T) {
+	a := require.New(t)
+	a.Equal(10*time.Second, getRetryBackoff(1))
+	a.Equal(20*time.Second, getRetryBackoff(2))
+	a.Equal(40*time.Second, getRetryBackoff(3))
+	a.Equal(160*time.Second, getRetryBackoff(5))
+	a.Equal(time.Hour, getRetryBackoff(20))
+}
+
+func TestGetAttemptUpdate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	newDelivery := func(retryCount int) *store.WebhookDeliveryMessage {
+		return &store.WebhookDeliveryMessage{
+			Status:     store.WebhookDeliveryStatusPending,
+			RetryCount: retryCount,
+			Payload:    &storepb.WebhookDeliveryPayload{},
+		}
+	}
+
+	t.Run("done", func(t *testing.T) {
+		a := require.New(t)
+		update := getAttemptUpdate(newDelivery(2), http.StatusNoContent, time.Second, nil, true, now)
+		a.Equal(store.WebhookDeliveryStatusDone, *update.Status)
+		a.Nil(update.RetryCount)
+		a.Nil(update.NextAttemptAt)
+		a.Len(update.Payload.Attempts, 1)
+		a.Equal(int32(http.StatusNoContent), update.Payload.Attempts[0].StatusCode)
+		a.Empty(update.Payload.Attempts[0].Error)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		a := require.New(t)
+		postErr := &webhook.ResponseError{StatusCode: http.StatusServiceUnavailable, Err: errors.New("unavailable")}
+		update := getAttemptUpdate(newDelivery(2), 0, time.Second, postErr, true, now)
+		a.Nil(update.Status)
+		a.Equal(3, *update.RetryCount)
+		a.Equal(now.Add(40*time.Second), *update.NextAttemptAt)
+		a.Len(update.Payload.Attempts, 1)
+		a.Equal(int32(http.StatusServiceUnavailable), update.Payload.Attempts[0].StatusCode)
+		a.Equal("unavailable", update.Payload.Attempts[0].Error)
+	})
+
+	t.Run("retry with status code of a successful response", func(t *testing.T) {
+		a := require.New(t)
+		update := getAttemptUpdate(newDelivery(0), http.StatusOK, time.Second, errors.New("invalid_token"), true, now)
+		a.Nil(update.Status)
+		a.Equal(1, *update.RetryCount)
+		a.Equal(int32(http.StatusOK), update.Payload.Attempts[0].StatusCode)
+	})
+
+	t.Run("dead after exhausting retries", func(t *testing.T) {
+		a := require.New(t)
+		update := getAttemptUpdate(newDelivery(deliveryMaxRetries-1), 0, time.Second, errors.New("timeout"), true, now)
+		a.Equal(store.WebhookDeliveryStatusDead, *update.Status)
+		a.Equal(deliveryMaxRetries, *update.RetryCount)
+		a.Nil(update.NextAttemptAt)
+	})
+
+	t.Run("dead on non-retryable failure", func(t *testing.T) {
+		a := require.New(t)
+		update := getAttemptUpdate(newDelivery(0), 0, 0, errors.New("webhook 101 not found"), false, now)
+		a.Equal(store.WebhookDeliveryStatusDead, *update.Status)
+		a.Equal(1, *update.RetryCount)
+		a.Nil(update.NextAttemptAt)
+		a.Equal("webhook 101 not found", update.Payload.Attempts[0].Error)
+	})
+
+	t.Run("keep attempt history", func(t *testing.T) {
+		a := require.New(t)
+		delivery := newDelivery(1)
+		delivery.Payload.Attempts = []*storepb.WebhookDeliveryAttempt{{Error: "timeout"}}
+		update := getAttemptUpdate(delivery, http.StatusOK, time.Second, nil, true, now)
+		a.Len(update.Payload.Attempts, 2)
+		a.Equal("timeout", update.Payload.Attempts[0].Error)
+		a.Equal(int32(http.StatusOK), update.Payload.Attempts[1].StatusCode)
+	})
+}
+
+func TestConvertToWebhookEvent(t *testing.T) {
+	a := require.New(t)
+	webhookCtx := &webhook.Context{
+		Level:       webhook.WebhookError,
+		EventType:   "bb.issue.status.update",
+		Title:       "Issue status changed",
+		Description: "description",
+		Link:        "http://localhost/projects/p1/issues/1",
+		ActorID:     101,
+		ActorName:   "alice",
+		Issue: &webhook.Issue{
+			ID:   1,
+			Name: "issue",
+		},
+		Project: &webhook.Project{
+			Name:  "projects/p1",
+			Title: "P1",
+		},
+		Stage: &webhook.Stage{
+			Name: "prod",
+		},
+		MentionUsersByPhone: []string{"123"},
+	}
+	event := convertToWebhookEvent(webhookCtx)
+	a.Equal(string(webhook.WebhookError), event.Level)
+	a.Equal("bb.issue.status.update", event.EventType)
+	a.Equal("Issue status changed", event.Title)
+	a.Equal(int32(101), event.ActorId)
+	a.Equal(int32(1), event.Issue.Id)
+	a.Equal(int32(0), event.Issue.CreatorId)
+	a.Equal("projects/p1", event.Project.Name)
+	a.Equal("prod", event.StageName)
+	a.Nil(event.Rollout)
+	a.Nil(event.TaskResult)
+	a.Nil(event.SchemaDrift)
+	a.Equal([]string{"123"}, event.MentionUsersByPhone)
+
+	webhookCtx = &webhook.Context{
+		EventType: "bb.webhook.event.database.schema.drift",
+		SchemaDrift: &webhook.SchemaDrift{
+			Database: "instances/prod/databases/db",
+			Objects:  []string{"ALTER TABLE public.users"},
+		},
+	}
+	event = convertToWebhookEvent(webhookCtx)
+	a.Nil(event.Issue)
+	a.Equal("instances/prod/databases/db", event.SchemaDrift.Database)
+	a.Equal([]string{"ALTER TABLE public.users"}, event.SchemaDrift.Objects)
+}
@@ -20,6 +20,7 @@ type Event struct {
 	IssueRolloutReady   *EventIssueRolloutReady
 	StageStatusUpdate   *EventStageStatusUpdate
 	TaskRunStatusUpdate *EventTaskRunStatusUpdate
+	SchemaDrift         *EventSchemaDrift
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -95,3 +96,10 @@ type EventTaskRunStatusUpdate struct {
 	Detail        string
 	SkippedReason string
 }
+
+type EventSchemaDrift struct {
+	InstanceID   string
+	DatabaseName string
+	// Objects are the drifted objects, e.g. "ALTER TABLE public.users".
+	Objects []string
+}
@@ -6,7 +6,6 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/gosimple/slug"
 	"github.com/nyaruka/phonenumbers"
@@ -14,6 +13,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/state"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
@@ -26,6 +26,7 @@ import (
 type Manager struct {
 	store      *store.Store
 	iamManager *iam.Manager
+	stateCfg   *state.State
 }
 
 // Metadata is the activity metadata.
@@ -34,10 +35,11 @@ type Metadata struct {
 }
 
 // NewManager creates an activity manager.
-func NewManager(store *store.Store, iamManager *iam.Manager) *Manager {
+func NewManager(store *store.Store, iamManager *iam.Manager, stateCfg *state.State) *Manager {
 	return &Manager{
 		store:      store,
 		iamManager: iamManager,
+		stateCfg:   stateCfg,
 	}
 }
 
@@ -47,7 +49,7 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		EventType: &e.Type,
 	})
 	if err != nil {
-		slog.Warn("failed to find project webhook", "issue_name", e.Issue.Title, log.BBError(err))
+		slog.Warn("failed to find project webhook", slog.String("project", e.Project.ResourceID), log.BBError(err))
 		return
 	}
 
@@ -58,12 +60,16 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 	webhookCtx, err := m.getWebhookContextFromEvent(ctx, e, e.Type)
 	if err != nil {
 		slog.Warn("failed to get webhook context",
-			slog.String("issue_name", e.Issue.Title),
+			slog.String("project", e.Project.ResourceID),
 			log.BBError(err))
 		return
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go m.postWebhookList(ctx, webhookCtx, webhookList)
+	// Persist the deliveries to the outbox, the delivery runner calls the external webhook endpoints.
+	if err := m.enqueueDeliveries(ctx, e.Project.ResourceID, webhookCtx, webhookList); err != nil {
+		slog.Warn("failed to enqueue webhook deliveries",
+			slog.String("project", e.Project.ResourceID),
+			log.BBError(err))
+	}
 }
 
 func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, eventType common.EventType) (*webhook.Context, error) {
@@ -84,6 +90,8 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 		link = fmt.Sprintf("%s/projects/%s/issues/%s-%d", setting.ExternalUrl, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID)
 	} else if e.Rollout != nil {
 		link = fmt.Sprintf("%s/projects/%s/rollouts/%d", setting.ExternalUrl, e.Project.ResourceID, e.Rollout.UID)
+	} else if e.SchemaDrift != nil {
+		link = fmt.Sprintf("%s/projects/%s/instances/%s/databases/%s", setting.ExternalUrl, e.Project.ResourceID, e.SchemaDrift.InstanceID, e.SchemaDrift.DatabaseName)
 	}
 	switch e.Type {
 	case common.EventTypeIssueCreate:
@@ -222,6 +230,11 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 			}
 		}
 
+	case common.EventTypeSchemaDrift:
+		level = webhook.WebhookWarn
+		title = "Schema drift detected"
+		titleZh = "检测到 Schema 漂移"
+
 	case common.EventTypeIssueApprovalCreate:
 		pendingStep := e.IssueApprovalCreate.ApprovalStep
 
@@ -319,39 +332,14 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 			Name: u.StageTitle,
 		}
 	}
-
-	return &webhookCtx, nil
-}
-
-func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
-	ctx = context.WithoutCancel(ctx)
-	setting, err := m.store.GetAppIMSetting(ctx)
-	if err != nil {
-		slog.Error("failed to get app im setting", log.BBError(err))
-	} else {
-		webhookCtx.IMSetting = setting
+	if u := e.SchemaDrift; u != nil {
+		webhookCtx.SchemaDrift = &webhook.SchemaDrift{
+			Database: common.FormatDatabase(u.InstanceID, u.DatabaseName),
+			Objects:  u.Objects,
+		}
 	}
 
-	for _, hook := range webhookList {
-		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTS = time.Now().Unix()
-		webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Type, *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				slog.Warn("Failed to post webhook event on activity",
-					slog.String("webhook type", hook.Type),
-					slog.String("webhook name", hook.Title),
-					slog.String("activity type", webhookCtx.EventType),
-					slog.String("title", webhookCtx.Title),
-					log.BBError(err))
-				return
-			}
-		}(&webhookCtx, hook)
-	}
+	return &webhookCtx, nil
 }
 
 func getUsersFromRole(s *store.Store, role string, projectID string) func(context.Context) ([]*store.UserMessage, error) {
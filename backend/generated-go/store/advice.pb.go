@@ -88,6 +88,8 @@ type Advice struct {
 	// TODO: use range instead.
 	StartPosition *Position `protobuf:"bytes,6,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	EndPosition   *Position `protobuf:"bytes,7,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	// The machine-applicable fixes for the advice.
+	Fixes         []*Advice_Fix `protobuf:"bytes,8,rep,name=fixes,proto3" json:"fixes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -164,18 +166,152 @@ func (x *Advice) GetEndPosition() *Position {
 	return nil
 }
 
+func (x *Advice) GetFixes() []*Advice_Fix {
+	if x != nil {
+		return x.Fixes
+	}
+	return nil
+}
+
+// Fix is a set of text edits resolving the advice.
+type Advice_Fix struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The fix title shown to the user.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The text edits to apply, positioned in the original statement.
+	// The edits do not overlap.
+	Edits         []*Advice_TextEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advice_Fix) Reset() {
+	*x = Advice_Fix{}
+	mi := &file_store_advice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advice_Fix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advice_Fix) ProtoMessage() {}
+
+func (x *Advice_Fix) ProtoReflect() protoreflect.Message {
+	mi := &file_store_advice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advice_Fix.ProtoReflect.Descriptor instead.
+func (*Advice_Fix) Descriptor() ([]byte, []int) {
+	return file_store_advice_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Advice_Fix) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Advice_Fix) GetEdits() []*Advice_TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+// TextEdit replaces the text between start_position and end_position with new_text.
+// The start_position is inclusive and the end_position is exclusive.
+// An insertion has the same start_position and end_position.
+type Advice_TextEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartPosition *Position              `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	EndPosition   *Position              `protobuf:"bytes,2,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	NewText       string                 `protobuf:"bytes,3,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advice_TextEdit) Reset() {
+	*x = Advice_TextEdit{}
+	mi := &file_store_advice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advice_TextEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advice_TextEdit) ProtoMessage() {}
+
+func (x *Advice_TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_store_advice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advice_TextEdit.ProtoReflect.Descriptor instead.
+func (*Advice_TextEdit) Descriptor() ([]byte, []int) {
+	return file_store_advice_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Advice_TextEdit) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *Advice_TextEdit) GetEndPosition() *Position {
+	if x != nil {
+		return x.EndPosition
+	}
+	return nil
+}
+
+func (x *Advice_TextEdit) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
 var File_store_advice_proto protoreflect.FileDescriptor
 
 const file_store_advice_proto_rawDesc = "" +
 	"\n" +
-	"\x12store/advice.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xce\x02\n" +
+	"\x12store/advice.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xfa\x04\n" +
 	"\x06Advice\x125\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1d.bytebase.store.Advice.StatusR\x06status\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12?\n" +
 	"\x0estart_position\x18\x06 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
-	"\fend_position\x18\a \x01(\v2\x18.bytebase.store.PositionR\vendPosition\"E\n" +
+	"\fend_position\x18\a \x01(\v2\x18.bytebase.store.PositionR\vendPosition\x120\n" +
+	"\x05fixes\x18\b \x03(\v2\x1a.bytebase.store.Advice.FixR\x05fixes\x1aR\n" +
+	"\x03Fix\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x125\n" +
+	"\x05edits\x18\x02 \x03(\v2\x1f.bytebase.store.Advice.TextEditR\x05edits\x1a\xa3\x01\n" +
+	"\bTextEdit\x12?\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
+	"\fend_position\x18\x02 \x01(\v2\x18.bytebase.store.PositionR\vendPosition\x12\x19\n" +
+	"\bnew_text\x18\x03 \x01(\tR\anewText\"E\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aSUCCESS\x10\x01\x12\v\n" +
@@ -195,21 +331,27 @@ func file_store_advice_proto_rawDescGZIP() []byte {
 }
 
 var file_store_advice_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_advice_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_advice_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_advice_proto_goTypes = []any{
-	(Advice_Status)(0), // 0: bytebase.store.Advice.Status
-	(*Advice)(nil),     // 1: bytebase.store.Advice
-	(*Position)(nil),   // 2: bytebase.store.Position
+	(Advice_Status)(0),      // 0: bytebase.store.Advice.Status
+	(*Advice)(nil),          // 1: bytebase.store.Advice
+	(*Advice_Fix)(nil),      // 2: bytebase.store.Advice.Fix
+	(*Advice_TextEdit)(nil), // 3: bytebase.store.Advice.TextEdit
+	(*Position)(nil),        // 4: bytebase.store.Position
 }
 var file_store_advice_proto_depIdxs = []int32{
 	0, // 0: bytebase.store.Advice.status:type_name -> bytebase.store.Advice.Status
-	2, // 1: bytebase.store.Advice.start_position:type_name -> bytebase.store.Position
-	2, // 2: bytebase.store.Advice.end_position:type_name -> bytebase.store.Position
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 1: bytebase.store.Advice.start_position:type_name -> bytebase.store.Position
+	4, // 2: bytebase.store.Advice.end_position:type_name -> bytebase.store.Position
+	2, // 3: bytebase.store.Advice.fixes:type_name -> bytebase.store.Advice.Fix
+	3, // 4: bytebase.store.Advice.Fix.edits:type_name -> bytebase.store.Advice.TextEdit
+	4, // 5: bytebase.store.Advice.TextEdit.start_position:type_name -> bytebase.store.Position
+	4, // 6: bytebase.store.Advice.TextEdit.end_position:type_name -> bytebase.store.Position
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_store_advice_proto_init() }
@@ -224,7 +366,7 @@ func file_store_advice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_advice_proto_rawDesc), len(file_store_advice_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -263,6 +263,8 @@ const (
 	ExportFormat_JSON               ExportFormat = 2
 	ExportFormat_SQL                ExportFormat = 3
 	ExportFormat_XLSX               ExportFormat = 4
+	ExportFormat_PARQUET            ExportFormat = 5
+	ExportFormat_NDJSON             ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -273,6 +275,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "NDJSON",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -280,6 +284,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"NDJSON":             6,
 	}
 )
 
@@ -363,6 +369,71 @@ func (x *PageToken) GetOffset() int32 {
 	return 0
 }
 
+// QueryResultPageToken is the page token of the SQL Editor query result session.
+type QueryResultPageToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// session is the key of the query result session.
+	Session string `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	// offset is the number of the rows fetched before the page.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// last_key is the unique key of the last row fetched by the keyset pagination.
+	// It lets any replica resume the keyset pagination without the session.
+	LastKey       []string `protobuf:"bytes,3,rep,name=last_key,json=lastKey,proto3" json:"last_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResultPageToken) Reset() {
+	*x = QueryResultPageToken{}
+	mi := &file_store_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResultPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResultPageToken) ProtoMessage() {}
+
+func (x *QueryResultPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_store_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResultPageToken.ProtoReflect.Descriptor instead.
+func (*QueryResultPageToken) Descriptor() ([]byte, []int) {
+	return file_store_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryResultPageToken) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *QueryResultPageToken) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QueryResultPageToken) GetLastKey() []string {
+	if x != nil {
+		return x.LastKey
+	}
+	return nil
+}
+
 // Position in a text expressed as zero-based line and zero-based column byte
 // offset.
 type Position struct {
@@ -377,7 +448,7 @@ type Position struct {
 
 func (x *Position) Reset() {
 	*x = Position{}
-	mi := &file_store_common_proto_msgTypes[1]
+	mi := &file_store_common_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +460,7 @@ func (x *Position) String() string {
 func (*Position) ProtoMessage() {}
 
 func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_store_common_proto_msgTypes[1]
+	mi := &file_store_common_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +473,7 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Position.ProtoReflect.Descriptor instead.
 func (*Position) Descriptor() ([]byte, []int) {
-	return file_store_common_proto_rawDescGZIP(), []int{1}
+	return file_store_common_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Position) GetLine() int32 {
@@ -429,7 +500,7 @@ type Range struct {
 
 func (x *Range) Reset() {
 	*x = Range{}
-	mi := &file_store_common_proto_msgTypes[2]
+	mi := &file_store_common_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +512,7 @@ func (x *Range) String() string {
 func (*Range) ProtoMessage() {}
 
 func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_store_common_proto_msgTypes[2]
+	mi := &file_store_common_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +525,7 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Range.ProtoReflect.Descriptor instead.
 func (*Range) Descriptor() ([]byte, []int) {
-	return file_store_common_proto_rawDescGZIP(), []int{2}
+	return file_store_common_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Range) GetStart() int32 {
@@ -478,7 +549,11 @@ const file_store_common_proto_rawDesc = "" +
 	"\x12store/common.proto\x12\x0ebytebase.store\"9\n" +
 	"\tPageToken\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"6\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"c\n" +
+	"\x14QueryResultPageToken\x12\x18\n" +
+	"\asession\x18\x01 \x01(\tR\asession\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x19\n" +
+	"\blast_key\x18\x03 \x03(\tR\alastKey\"6\n" +
 	"\bPosition\x12\x12\n" +
 	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
 	"\x06column\x18\x02 \x01(\x05R\x06column\"/\n" +
@@ -533,13 +608,16 @@ const file_store_common_proto_rawDesc = "" +
 	"\x19MASKING_LEVEL_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04NONE\x10\x01\x12\v\n" +
 	"\aPARTIAL\x10\x02\x12\b\n" +
-	"\x04FULL\x10\x03*L\n" +
+	"\x04FULL\x10\x03*e\n" +
 	"\fExportFormat\x12\x16\n" +
 	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03CSV\x10\x01\x12\b\n" +
 	"\x04JSON\x10\x02\x12\a\n" +
 	"\x03SQL\x10\x03\x12\b\n" +
-	"\x04XLSX\x10\x04B\x14Z\x12generated-go/storeb\x06proto3"
+	"\x04XLSX\x10\x04\x12\v\n" +
+	"\aPARQUET\x10\x05\x12\n" +
+	"\n" +
+	"\x06NDJSON\x10\x06B\x14Z\x12generated-go/storeb\x06proto3"
 
 var (
 	file_store_common_proto_rawDescOnce sync.Once
@@ -554,15 +632,16 @@ func file_store_common_proto_rawDescGZIP() []byte {
 }
 
 var file_store_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_store_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_store_common_proto_goTypes = []any{
-	(Engine)(0),       // 0: bytebase.store.Engine
-	(VCSType)(0),      // 1: bytebase.store.VCSType
-	(MaskingLevel)(0), // 2: bytebase.store.MaskingLevel
-	(ExportFormat)(0), // 3: bytebase.store.ExportFormat
-	(*PageToken)(nil), // 4: bytebase.store.PageToken
-	(*Position)(nil),  // 5: bytebase.store.Position
-	(*Range)(nil),     // 6: bytebase.store.Range
+	(Engine)(0),                  // 0: bytebase.store.Engine
+	(VCSType)(0),                 // 1: bytebase.store.VCSType
+	(MaskingLevel)(0),            // 2: bytebase.store.MaskingLevel
+	(ExportFormat)(0),            // 3: bytebase.store.ExportFormat
+	(*PageToken)(nil),            // 4: bytebase.store.PageToken
+	(*QueryResultPageToken)(nil), // 5: bytebase.store.QueryResultPageToken
+	(*Position)(nil),             // 6: bytebase.store.Position
+	(*Range)(nil),                // 7: bytebase.store.Range
 }
 var file_store_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -583,7 +662,7 @@ func file_store_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_common_proto_rawDesc), len(file_store_common_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,146 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DriftObject_Action int32
+
+const (
+	DriftObject_ACTION_UNSPECIFIED DriftObject_Action = 0
+	DriftObject_ADD                DriftObject_Action = 1
+	DriftObject_DROP               DriftObject_Action = 2
+	DriftObject_ALTER              DriftObject_Action = 3
+)
+
+// Enum value maps for DriftObject_Action.
+var (
+	DriftObject_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ADD",
+		2: "DROP",
+		3: "ALTER",
+	}
+	DriftObject_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ADD":                1,
+		"DROP":               2,
+		"ALTER":              3,
+	}
+)
+
+func (x DriftObject_Action) Enum() *DriftObject_Action {
+	p := new(DriftObject_Action)
+	*p = x
+	return p
+}
+
+func (x DriftObject_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftObject_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_database_proto_enumTypes[0].Descriptor()
+}
+
+func (DriftObject_Action) Type() protoreflect.EnumType {
+	return &file_store_database_proto_enumTypes[0]
+}
+
+func (x DriftObject_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftObject_Action.Descriptor instead.
+func (DriftObject_Action) EnumDescriptor() ([]byte, []int) {
+	return file_store_database_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type DriftObject_Type int32
+
+const (
+	DriftObject_TYPE_UNSPECIFIED  DriftObject_Type = 0
+	DriftObject_SCHEMA            DriftObject_Type = 1
+	DriftObject_TABLE             DriftObject_Type = 2
+	DriftObject_VIEW              DriftObject_Type = 3
+	DriftObject_MATERIALIZED_VIEW DriftObject_Type = 4
+	DriftObject_FUNCTION          DriftObject_Type = 5
+	DriftObject_PROCEDURE         DriftObject_Type = 6
+	DriftObject_SEQUENCE          DriftObject_Type = 7
+	DriftObject_ENUM_TYPE         DriftObject_Type = 8
+	DriftObject_EVENT             DriftObject_Type = 9
+	DriftObject_COLUMN            DriftObject_Type = 10
+	DriftObject_INDEX             DriftObject_Type = 11
+	DriftObject_FOREIGN_KEY       DriftObject_Type = 12
+	DriftObject_CHECK_CONSTRAINT  DriftObject_Type = 13
+	DriftObject_PARTITION         DriftObject_Type = 14
+	DriftObject_TRIGGER           DriftObject_Type = 15
+)
+
+// Enum value maps for DriftObject_Type.
+var (
+	DriftObject_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "SCHEMA",
+		2:  "TABLE",
+		3:  "VIEW",
+		4:  "MATERIALIZED_VIEW",
+		5:  "FUNCTION",
+		6:  "PROCEDURE",
+		7:  "SEQUENCE",
+		8:  "ENUM_TYPE",
+		9:  "EVENT",
+		10: "COLUMN",
+		11: "INDEX",
+		12: "FOREIGN_KEY",
+		13: "CHECK_CONSTRAINT",
+		14: "PARTITION",
+		15: "TRIGGER",
+	}
+	DriftObject_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"SCHEMA":            1,
+		"TABLE":             2,
+		"VIEW":              3,
+		"MATERIALIZED_VIEW": 4,
+		"FUNCTION":          5,
+		"PROCEDURE":         6,
+		"SEQUENCE":          7,
+		"ENUM_TYPE":         8,
+		"EVENT":             9,
+		"COLUMN":            10,
+		"INDEX":             11,
+		"FOREIGN_KEY":       12,
+		"CHECK_CONSTRAINT":  13,
+		"PARTITION":         14,
+		"TRIGGER":           15,
+	}
+)
+
+func (x DriftObject_Type) Enum() *DriftObject_Type {
+	p := new(DriftObject_Type)
+	*p = x
+	return p
+}
+
+func (x DriftObject_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftObject_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_database_proto_enumTypes[1].Descriptor()
+}
+
+func (DriftObject_Type) Type() protoreflect.EnumType {
+	return &file_store_database_proto_enumTypes[1]
+}
+
+func (x DriftObject_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftObject_Type.Descriptor instead.
+func (DriftObject_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_database_proto_rawDescGZIP(), []int{2, 1}
+}
+
 type TaskMetadata_State int32
 
 const (
@@ -55,11 +195,11 @@ func (x TaskMetadata_State) String() string {
 }
 
 func (TaskMetadata_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[0].Descriptor()
+	return file_store_database_proto_enumTypes[2].Descriptor()
 }
 
 func (TaskMetadata_State) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[0]
+	return &file_store_database_proto_enumTypes[2]
 }
 
 func (x TaskMetadata_State) Number() protoreflect.EnumNumber {
@@ -68,7 +208,7 @@ func (x TaskMetadata_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskMetadata_State.Descriptor instead.
 func (TaskMetadata_State) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{8, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type StreamMetadata_Type int32
@@ -101,11 +241,11 @@ func (x StreamMetadata_Type) String() string {
 }
 
 func (StreamMetadata_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[1].Descriptor()
+	return file_store_database_proto_enumTypes[3].Descriptor()
 }
 
 func (StreamMetadata_Type) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[1]
+	return &file_store_database_proto_enumTypes[3]
 }
 
 func (x StreamMetadata_Type) Number() protoreflect.EnumNumber {
@@ -114,7 +254,7 @@ func (x StreamMetadata_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StreamMetadata_Type.Descriptor instead.
 func (StreamMetadata_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{9, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type StreamMetadata_Mode int32
@@ -153,11 +293,11 @@ func (x StreamMetadata_Mode) String() string {
 }
 
 func (StreamMetadata_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[2].Descriptor()
+	return file_store_database_proto_enumTypes[4].Descriptor()
 }
 
 func (StreamMetadata_Mode) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[2]
+	return &file_store_database_proto_enumTypes[4]
 }
 
 func (x StreamMetadata_Mode) Number() protoreflect.EnumNumber {
@@ -166,7 +306,7 @@ func (x StreamMetadata_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StreamMetadata_Mode.Descriptor instead.
 func (StreamMetadata_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{9, 1}
+	return file_store_database_proto_rawDescGZIP(), []int{11, 1}
 }
 
 // Type is the type of a table partition, some database engines may not
@@ -227,11 +367,11 @@ func (x TablePartitionMetadata_Type) String() string {
 }
 
 func (TablePartitionMetadata_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[3].Descriptor()
+	return file_store_database_proto_enumTypes[5].Descriptor()
 }
 
 func (TablePartitionMetadata_Type) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[3]
+	return &file_store_database_proto_enumTypes[5]
 }
 
 func (x TablePartitionMetadata_Type) Number() protoreflect.EnumNumber {
@@ -240,7 +380,7 @@ func (x TablePartitionMetadata_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TablePartitionMetadata_Type.Descriptor instead.
 func (TablePartitionMetadata_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{13, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type ColumnMetadata_IdentityGeneration int32
@@ -276,11 +416,11 @@ func (x ColumnMetadata_IdentityGeneration) String() string {
 }
 
 func (ColumnMetadata_IdentityGeneration) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[4].Descriptor()
+	return file_store_database_proto_enumTypes[6].Descriptor()
 }
 
 func (ColumnMetadata_IdentityGeneration) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[4]
+	return &file_store_database_proto_enumTypes[6]
 }
 
 func (x ColumnMetadata_IdentityGeneration) Number() protoreflect.EnumNumber {
@@ -289,7 +429,7 @@ func (x ColumnMetadata_IdentityGeneration) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ColumnMetadata_IdentityGeneration.Descriptor instead.
 func (ColumnMetadata_IdentityGeneration) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{14, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type GenerationMetadata_Type int32
@@ -325,11 +465,11 @@ func (x GenerationMetadata_Type) String() string {
 }
 
 func (GenerationMetadata_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[5].Descriptor()
+	return file_store_database_proto_enumTypes[7].Descriptor()
 }
 
 func (GenerationMetadata_Type) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[5]
+	return &file_store_database_proto_enumTypes[7]
 }
 
 func (x GenerationMetadata_Type) Number() protoreflect.EnumNumber {
@@ -338,7 +478,7 @@ func (x GenerationMetadata_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GenerationMetadata_Type.Descriptor instead.
 func (GenerationMetadata_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{15, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type ObjectSchema_Type int32
@@ -383,11 +523,11 @@ func (x ObjectSchema_Type) String() string {
 }
 
 func (ObjectSchema_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[6].Descriptor()
+	return file_store_database_proto_enumTypes[8].Descriptor()
 }
 
 func (ObjectSchema_Type) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[6]
+	return &file_store_database_proto_enumTypes[8]
 }
 
 func (x ObjectSchema_Type) Number() protoreflect.EnumNumber {
@@ -396,7 +536,7 @@ func (x ObjectSchema_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ObjectSchema_Type.Descriptor instead.
 func (ObjectSchema_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{38, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{40, 0}
 }
 
 // DatabaseMetadata is the metadata for databases.
@@ -410,7 +550,10 @@ type DatabaseMetadata struct {
 	// The schema is drifted from the source of truth.
 	Drifted bool `protobuf:"varint,6,opt,name=drifted,proto3" json:"drifted,omitempty"`
 	// The version of database schema.
-	Version       string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// The object-level drift between the latest changelog schema and the synced schema.
+	// It's only set if the schema is drifted.
+	DriftReport   *DriftReport `protobuf:"bytes,8,opt,name=drift_report,json=driftReport,proto3" json:"drift_report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -494,6 +637,159 @@ func (x *DatabaseMetadata) GetVersion() string {
 	return ""
 }
 
+func (x *DatabaseMetadata) GetDriftReport() *DriftReport {
+	if x != nil {
+		return x.DriftReport
+	}
+	return nil
+}
+
+// DriftReport is the object-level difference between the schema recorded by
+// the latest changelog and the synced schema.
+type DriftReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UID of the changelog that the synced schema is compared against.
+	ChangelogUid int64 `protobuf:"varint,1,opt,name=changelog_uid,json=changelogUid,proto3" json:"changelog_uid,omitempty"`
+	// The time when the drift is detected.
+	DetectTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=detect_time,json=detectTime,proto3" json:"detect_time,omitempty"`
+	// The added, dropped and altered objects.
+	Objects       []*DriftObject `protobuf:"bytes,3,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftReport) Reset() {
+	*x = DriftReport{}
+	mi := &file_store_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftReport) ProtoMessage() {}
+
+func (x *DriftReport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftReport.ProtoReflect.Descriptor instead.
+func (*DriftReport) Descriptor() ([]byte, []int) {
+	return file_store_database_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DriftReport) GetChangelogUid() int64 {
+	if x != nil {
+		return x.ChangelogUid
+	}
+	return 0
+}
+
+func (x *DriftReport) GetDetectTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectTime
+	}
+	return nil
+}
+
+func (x *DriftReport) GetObjects() []*DriftObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+// DriftObject is an added, dropped or altered database object.
+type DriftObject struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Action DriftObject_Action     `protobuf:"varint,1,opt,name=action,proto3,enum=bytebase.store.DriftObject_Action" json:"action,omitempty"`
+	Type   DriftObject_Type       `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.DriftObject_Type" json:"type,omitempty"`
+	// The schema name of the object.
+	// It is an empty string for databases without such concept such as MySQL.
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The object name.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The changed sub-objects of an altered table, such as columns and indexes.
+	Children      []*DriftObject `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftObject) Reset() {
+	*x = DriftObject{}
+	mi := &file_store_database_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftObject) ProtoMessage() {}
+
+func (x *DriftObject) ProtoReflect() protoreflect.Message {
+	mi := &file_store_database_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftObject.ProtoReflect.Descriptor instead.
+func (*DriftObject) Descriptor() ([]byte, []int) {
+	return file_store_database_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DriftObject) GetAction() DriftObject_Action {
+	if x != nil {
+		return x.Action
+	}
+	return DriftObject_ACTION_UNSPECIFIED
+}
+
+func (x *DriftObject) GetType() DriftObject_Type {
+	if x != nil {
+		return x.Type
+	}
+	return DriftObject_TYPE_UNSPECIFIED
+}
+
+func (x *DriftObject) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *DriftObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DriftObject) GetChildren() []*DriftObject {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 // DatabaseSchemaMetadata is the schema metadata for databases.
 type DatabaseSchemaMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -520,7 +816,7 @@ type DatabaseSchemaMetadata struct {
 
 func (x *DatabaseSchemaMetadata) Reset() {
 	*x = DatabaseSchemaMetadata{}
-	mi := &file_store_database_proto_msgTypes[1]
+	mi := &file_store_database_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +828,7 @@ func (x *DatabaseSchemaMetadata) String() string {
 func (*DatabaseSchemaMetadata) ProtoMessage() {}
 
 func (x *DatabaseSchemaMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[1]
+	mi := &file_store_database_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +841,7 @@ func (x *DatabaseSchemaMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseSchemaMetadata.ProtoReflect.Descriptor instead.
 func (*DatabaseSchemaMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{1}
+	return file_store_database_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DatabaseSchemaMetadata) GetName() string {
@@ -629,7 +925,7 @@ type LinkedDatabaseMetadata struct {
 
 func (x *LinkedDatabaseMetadata) Reset() {
 	*x = LinkedDatabaseMetadata{}
-	mi := &file_store_database_proto_msgTypes[2]
+	mi := &file_store_database_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +937,7 @@ func (x *LinkedDatabaseMetadata) String() string {
 func (*LinkedDatabaseMetadata) ProtoMessage() {}
 
 func (x *LinkedDatabaseMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[2]
+	mi := &file_store_database_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +950,7 @@ func (x *LinkedDatabaseMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkedDatabaseMetadata.ProtoReflect.Descriptor instead.
 func (*LinkedDatabaseMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{2}
+	return file_store_database_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LinkedDatabaseMetadata) GetName() string {
@@ -718,7 +1014,7 @@ type SchemaMetadata struct {
 
 func (x *SchemaMetadata) Reset() {
 	*x = SchemaMetadata{}
-	mi := &file_store_database_proto_msgTypes[3]
+	mi := &file_store_database_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +1026,7 @@ func (x *SchemaMetadata) String() string {
 func (*SchemaMetadata) ProtoMessage() {}
 
 func (x *SchemaMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[3]
+	mi := &file_store_database_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1039,7 @@ func (x *SchemaMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaMetadata.ProtoReflect.Descriptor instead.
 func (*SchemaMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{3}
+	return file_store_database_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SchemaMetadata) GetName() string {
@@ -872,7 +1168,7 @@ type EnumTypeMetadata struct {
 
 func (x *EnumTypeMetadata) Reset() {
 	*x = EnumTypeMetadata{}
-	mi := &file_store_database_proto_msgTypes[4]
+	mi := &file_store_database_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +1180,7 @@ func (x *EnumTypeMetadata) String() string {
 func (*EnumTypeMetadata) ProtoMessage() {}
 
 func (x *EnumTypeMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[4]
+	mi := &file_store_database_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1193,7 @@ func (x *EnumTypeMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumTypeMetadata.ProtoReflect.Descriptor instead.
 func (*EnumTypeMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{4}
+	return file_store_database_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnumTypeMetadata) GetName() string {
@@ -946,7 +1242,7 @@ type EventMetadata struct {
 
 func (x *EventMetadata) Reset() {
 	*x = EventMetadata{}
-	mi := &file_store_database_proto_msgTypes[5]
+	mi := &file_store_database_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +1254,7 @@ func (x *EventMetadata) String() string {
 func (*EventMetadata) ProtoMessage() {}
 
 func (x *EventMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[5]
+	mi := &file_store_database_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1267,7 @@ func (x *EventMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventMetadata.ProtoReflect.Descriptor instead.
 func (*EventMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{5}
+	return file_store_database_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventMetadata) GetName() string {
@@ -1055,7 +1351,7 @@ type SequenceMetadata struct {
 
 func (x *SequenceMetadata) Reset() {
 	*x = SequenceMetadata{}
-	mi := &file_store_database_proto_msgTypes[6]
+	mi := &file_store_database_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1067,7 +1363,7 @@ func (x *SequenceMetadata) String() string {
 func (*SequenceMetadata) ProtoMessage() {}
 
 func (x *SequenceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[6]
+	mi := &file_store_database_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1376,7 @@ func (x *SequenceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SequenceMetadata.ProtoReflect.Descriptor instead.
 func (*SequenceMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{6}
+	return file_store_database_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SequenceMetadata) GetName() string {
@@ -1196,7 +1492,7 @@ type TriggerMetadata struct {
 
 func (x *TriggerMetadata) Reset() {
 	*x = TriggerMetadata{}
-	mi := &file_store_database_proto_msgTypes[7]
+	mi := &file_store_database_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1208,7 +1504,7 @@ func (x *TriggerMetadata) String() string {
 func (*TriggerMetadata) ProtoMessage() {}
 
 func (x *TriggerMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[7]
+	mi := &file_store_database_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1517,7 @@ func (x *TriggerMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerMetadata.ProtoReflect.Descriptor instead.
 func (*TriggerMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{7}
+	return file_store_database_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TriggerMetadata) GetName() string {
@@ -1316,7 +1612,7 @@ type TaskMetadata struct {
 
 func (x *TaskMetadata) Reset() {
 	*x = TaskMetadata{}
-	mi := &file_store_database_proto_msgTypes[8]
+	mi := &file_store_database_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +1624,7 @@ func (x *TaskMetadata) String() string {
 func (*TaskMetadata) ProtoMessage() {}
 
 func (x *TaskMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[8]
+	mi := &file_store_database_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +1637,7 @@ func (x *TaskMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskMetadata.ProtoReflect.Descriptor instead.
 func (*TaskMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{8}
+	return file_store_database_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TaskMetadata) GetName() string {
@@ -1438,7 +1734,7 @@ type StreamMetadata struct {
 
 func (x *StreamMetadata) Reset() {
 	*x = StreamMetadata{}
-	mi := &file_store_database_proto_msgTypes[9]
+	mi := &file_store_database_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +1746,7 @@ func (x *StreamMetadata) String() string {
 func (*StreamMetadata) ProtoMessage() {}
 
 func (x *StreamMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[9]
+	mi := &file_store_database_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +1759,7 @@ func (x *StreamMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamMetadata.ProtoReflect.Descriptor instead.
 func (*StreamMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{9}
+	return file_store_database_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StreamMetadata) GetName() string {
@@ -1575,7 +1871,7 @@ type TableMetadata struct {
 
 func (x *TableMetadata) Reset() {
 	*x = TableMetadata{}
-	mi := &file_store_database_proto_msgTypes[10]
+	mi := &file_store_database_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1587,7 +1883,7 @@ func (x *TableMetadata) String() string {
 func (*TableMetadata) ProtoMessage() {}
 
 func (x *TableMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[10]
+	mi := &file_store_database_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,7 +1896,7 @@ func (x *TableMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableMetadata.ProtoReflect.Descriptor instead.
 func (*TableMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{10}
+	return file_store_database_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TableMetadata) GetName() string {
@@ -1769,7 +2065,7 @@ type CheckConstraintMetadata struct {
 
 func (x *CheckConstraintMetadata) Reset() {
 	*x = CheckConstraintMetadata{}
-	mi := &file_store_database_proto_msgTypes[11]
+	mi := &file_store_database_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1781,7 +2077,7 @@ func (x *CheckConstraintMetadata) String() string {
 func (*CheckConstraintMetadata) ProtoMessage() {}
 
 func (x *CheckConstraintMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[11]
+	mi := &file_store_database_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,7 +2090,7 @@ func (x *CheckConstraintMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckConstraintMetadata.ProtoReflect.Descriptor instead.
 func (*CheckConstraintMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{11}
+	return file_store_database_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CheckConstraintMetadata) GetName() string {
@@ -1827,7 +2123,7 @@ type ExternalTableMetadata struct {
 
 func (x *ExternalTableMetadata) Reset() {
 	*x = ExternalTableMetadata{}
-	mi := &file_store_database_proto_msgTypes[12]
+	mi := &file_store_database_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1839,7 +2135,7 @@ func (x *ExternalTableMetadata) String() string {
 func (*ExternalTableMetadata) ProtoMessage() {}
 
 func (x *ExternalTableMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[12]
+	mi := &file_store_database_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,7 +2148,7 @@ func (x *ExternalTableMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalTableMetadata.ProtoReflect.Descriptor instead.
 func (*ExternalTableMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{12}
+	return file_store_database_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ExternalTableMetadata) GetName() string {
@@ -1896,11 +2192,10 @@ type TablePartitionMetadata struct {
 	// https://www.postgresql.org/docs/current/sql-createtable.html. For MySQL,
 	// the expression is the `expr` or `column_list` of the following syntax.
 	// PARTITION BY
-	//
-	//	{ [LINEAR] HASH(expr)
-	//	| [LINEAR] KEY [ALGORITHM={1 | 2}] (column_list)
-	//	| RANGE{(expr) | COLUMNS(column_list)}
-	//	| LIST{(expr) | COLUMNS(column_list)} }.
+	//    { [LINEAR] HASH(expr)
+	//    | [LINEAR] KEY [ALGORITHM={1 | 2}] (column_list)
+	//    | RANGE{(expr) | COLUMNS(column_list)}
+	//    | LIST{(expr) | COLUMNS(column_list)} }.
 	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
 	// The value is the value of a table partition.
 	// For MySQL, the value is for RANGE and LIST partition types,
@@ -1926,7 +2221,7 @@ type TablePartitionMetadata struct {
 
 func (x *TablePartitionMetadata) Reset() {
 	*x = TablePartitionMetadata{}
-	mi := &file_store_database_proto_msgTypes[13]
+	mi := &file_store_database_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1938,7 +2233,7 @@ func (x *TablePartitionMetadata) String() string {
 func (*TablePartitionMetadata) ProtoMessage() {}
 
 func (x *TablePartitionMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[13]
+	mi := &file_store_database_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,7 +2246,7 @@ func (x *TablePartitionMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TablePartitionMetadata.ProtoReflect.Descriptor instead.
 func (*TablePartitionMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{13}
+	return file_store_database_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TablePartitionMetadata) GetName() string {
@@ -2058,20 +2353,17 @@ type ColumnMetadata struct {
 	// This field stores the actual constraint name from the database.
 	//
 	// Example: A column definition like:
-	//
-	//	CREATE TABLE employees (
-	//	  status NVARCHAR(20) DEFAULT 'active'
-	//	)
+	//   CREATE TABLE employees (
+	//     status NVARCHAR(20) DEFAULT 'active'
+	//   )
 	//
 	// Will create a constraint with an auto-generated name like 'DF__employees__statu__3B75D760'
 	// or a user-defined name if specified:
-	//
-	//	ALTER TABLE employees ADD CONSTRAINT DF_employees_status DEFAULT 'active' FOR status
+	//   ALTER TABLE employees ADD CONSTRAINT DF_employees_status DEFAULT 'active' FOR status
 	//
 	// To modify the default, you must first drop the existing constraint by name:
-	//
-	//	ALTER TABLE employees DROP CONSTRAINT DF__employees__statu__3B75D760
-	//	ALTER TABLE employees ADD CONSTRAINT DF_employees_status DEFAULT 'inactive' FOR status
+	//   ALTER TABLE employees DROP CONSTRAINT DF__employees__statu__3B75D760
+	//   ALTER TABLE employees ADD CONSTRAINT DF_employees_status DEFAULT 'inactive' FOR status
 	//
 	// This field is populated when syncing from the database. When empty (e.g., when parsing
 	// from SQL files), the system cannot automatically drop the constraint.
@@ -2082,7 +2374,7 @@ type ColumnMetadata struct {
 
 func (x *ColumnMetadata) Reset() {
 	*x = ColumnMetadata{}
-	mi := &file_store_database_proto_msgTypes[14]
+	mi := &file_store_database_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2094,7 +2386,7 @@ func (x *ColumnMetadata) String() string {
 func (*ColumnMetadata) ProtoMessage() {}
 
 func (x *ColumnMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[14]
+	mi := &file_store_database_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,7 +2399,7 @@ func (x *ColumnMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnMetadata.ProtoReflect.Descriptor instead.
 func (*ColumnMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{14}
+	return file_store_database_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ColumnMetadata) GetName() string {
@@ -2253,7 +2545,7 @@ type GenerationMetadata struct {
 
 func (x *GenerationMetadata) Reset() {
 	*x = GenerationMetadata{}
-	mi := &file_store_database_proto_msgTypes[15]
+	mi := &file_store_database_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2265,7 +2557,7 @@ func (x *GenerationMetadata) String() string {
 func (*GenerationMetadata) ProtoMessage() {}
 
 func (x *GenerationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[15]
+	mi := &file_store_database_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +2570,7 @@ func (x *GenerationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerationMetadata.ProtoReflect.Descriptor instead.
 func (*GenerationMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{15}
+	return file_store_database_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GenerationMetadata) GetType() GenerationMetadata_Type {
@@ -2317,7 +2609,7 @@ type ViewMetadata struct {
 
 func (x *ViewMetadata) Reset() {
 	*x = ViewMetadata{}
-	mi := &file_store_database_proto_msgTypes[16]
+	mi := &file_store_database_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2329,7 +2621,7 @@ func (x *ViewMetadata) String() string {
 func (*ViewMetadata) ProtoMessage() {}
 
 func (x *ViewMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[16]
+	mi := &file_store_database_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +2634,7 @@ func (x *ViewMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ViewMetadata.ProtoReflect.Descriptor instead.
 func (*ViewMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{16}
+	return file_store_database_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ViewMetadata) GetName() string {
@@ -2409,7 +2701,7 @@ type DependencyColumn struct {
 
 func (x *DependencyColumn) Reset() {
 	*x = DependencyColumn{}
-	mi := &file_store_database_proto_msgTypes[17]
+	mi := &file_store_database_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2421,7 +2713,7 @@ func (x *DependencyColumn) String() string {
 func (*DependencyColumn) ProtoMessage() {}
 
 func (x *DependencyColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[17]
+	mi := &file_store_database_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2434,7 +2726,7 @@ func (x *DependencyColumn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DependencyColumn.ProtoReflect.Descriptor instead.
 func (*DependencyColumn) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{17}
+	return file_store_database_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DependencyColumn) GetSchema() string {
@@ -2480,7 +2772,7 @@ type MaterializedViewMetadata struct {
 
 func (x *MaterializedViewMetadata) Reset() {
 	*x = MaterializedViewMetadata{}
-	mi := &file_store_database_proto_msgTypes[18]
+	mi := &file_store_database_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2492,7 +2784,7 @@ func (x *MaterializedViewMetadata) String() string {
 func (*MaterializedViewMetadata) ProtoMessage() {}
 
 func (x *MaterializedViewMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[18]
+	mi := &file_store_database_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,7 +2797,7 @@ func (x *MaterializedViewMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaterializedViewMetadata.ProtoReflect.Descriptor instead.
 func (*MaterializedViewMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{18}
+	return file_store_database_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MaterializedViewMetadata) GetName() string {
@@ -2569,7 +2861,7 @@ type DependencyTable struct {
 
 func (x *DependencyTable) Reset() {
 	*x = DependencyTable{}
-	mi := &file_store_database_proto_msgTypes[19]
+	mi := &file_store_database_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2581,7 +2873,7 @@ func (x *DependencyTable) String() string {
 func (*DependencyTable) ProtoMessage() {}
 
 func (x *DependencyTable) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[19]
+	mi := &file_store_database_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,7 +2886,7 @@ func (x *DependencyTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DependencyTable.ProtoReflect.Descriptor instead.
 func (*DependencyTable) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{19}
+	return file_store_database_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DependencyTable) GetSchema() string {
@@ -2637,7 +2929,7 @@ type FunctionMetadata struct {
 
 func (x *FunctionMetadata) Reset() {
 	*x = FunctionMetadata{}
-	mi := &file_store_database_proto_msgTypes[20]
+	mi := &file_store_database_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2649,7 +2941,7 @@ func (x *FunctionMetadata) String() string {
 func (*FunctionMetadata) ProtoMessage() {}
 
 func (x *FunctionMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[20]
+	mi := &file_store_database_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2662,7 +2954,7 @@ func (x *FunctionMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FunctionMetadata.ProtoReflect.Descriptor instead.
 func (*FunctionMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{20}
+	return file_store_database_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FunctionMetadata) GetName() string {
@@ -2758,7 +3050,7 @@ type ProcedureMetadata struct {
 
 func (x *ProcedureMetadata) Reset() {
 	*x = ProcedureMetadata{}
-	mi := &file_store_database_proto_msgTypes[21]
+	mi := &file_store_database_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2770,7 +3062,7 @@ func (x *ProcedureMetadata) String() string {
 func (*ProcedureMetadata) ProtoMessage() {}
 
 func (x *ProcedureMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[21]
+	mi := &file_store_database_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2783,7 +3075,7 @@ func (x *ProcedureMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcedureMetadata.ProtoReflect.Descriptor instead.
 func (*ProcedureMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{21}
+	return file_store_database_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProcedureMetadata) GetName() string {
@@ -2862,7 +3154,7 @@ type PackageMetadata struct {
 
 func (x *PackageMetadata) Reset() {
 	*x = PackageMetadata{}
-	mi := &file_store_database_proto_msgTypes[22]
+	mi := &file_store_database_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2874,7 +3166,7 @@ func (x *PackageMetadata) String() string {
 func (*PackageMetadata) ProtoMessage() {}
 
 func (x *PackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[22]
+	mi := &file_store_database_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,7 +3179,7 @@ func (x *PackageMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageMetadata.ProtoReflect.Descriptor instead.
 func (*PackageMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{22}
+	return file_store_database_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PackageMetadata) GetName() string {
@@ -2946,7 +3238,7 @@ type IndexMetadata struct {
 
 func (x *IndexMetadata) Reset() {
 	*x = IndexMetadata{}
-	mi := &file_store_database_proto_msgTypes[23]
+	mi := &file_store_database_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2958,7 +3250,7 @@ func (x *IndexMetadata) String() string {
 func (*IndexMetadata) ProtoMessage() {}
 
 func (x *IndexMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[23]
+	mi := &file_store_database_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2971,7 +3263,7 @@ func (x *IndexMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexMetadata.ProtoReflect.Descriptor instead.
 func (*IndexMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{23}
+	return file_store_database_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *IndexMetadata) GetName() string {
@@ -3099,7 +3391,7 @@ type SpatialIndexConfig struct {
 
 func (x *SpatialIndexConfig) Reset() {
 	*x = SpatialIndexConfig{}
-	mi := &file_store_database_proto_msgTypes[24]
+	mi := &file_store_database_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3111,7 +3403,7 @@ func (x *SpatialIndexConfig) String() string {
 func (*SpatialIndexConfig) ProtoMessage() {}
 
 func (x *SpatialIndexConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[24]
+	mi := &file_store_database_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3124,7 +3416,7 @@ func (x *SpatialIndexConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpatialIndexConfig.ProtoReflect.Descriptor instead.
 func (*SpatialIndexConfig) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{24}
+	return file_store_database_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SpatialIndexConfig) GetMethod() string {
@@ -3180,7 +3472,7 @@ type TessellationConfig struct {
 
 func (x *TessellationConfig) Reset() {
 	*x = TessellationConfig{}
-	mi := &file_store_database_proto_msgTypes[25]
+	mi := &file_store_database_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3192,7 +3484,7 @@ func (x *TessellationConfig) String() string {
 func (*TessellationConfig) ProtoMessage() {}
 
 func (x *TessellationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[25]
+	mi := &file_store_database_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3205,7 +3497,7 @@ func (x *TessellationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TessellationConfig.ProtoReflect.Descriptor instead.
 func (*TessellationConfig) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{25}
+	return file_store_database_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TessellationConfig) GetScheme() string {
@@ -3249,7 +3541,7 @@ type BoundingBox struct {
 
 func (x *BoundingBox) Reset() {
 	*x = BoundingBox{}
-	mi := &file_store_database_proto_msgTypes[26]
+	mi := &file_store_database_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3261,7 +3553,7 @@ func (x *BoundingBox) String() string {
 func (*BoundingBox) ProtoMessage() {}
 
 func (x *BoundingBox) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[26]
+	mi := &file_store_database_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3274,7 +3566,7 @@ func (x *BoundingBox) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
 func (*BoundingBox) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{26}
+	return file_store_database_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BoundingBox) GetXmin() float64 {
@@ -3316,7 +3608,7 @@ type GridLevel struct {
 
 func (x *GridLevel) Reset() {
 	*x = GridLevel{}
-	mi := &file_store_database_proto_msgTypes[27]
+	mi := &file_store_database_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3328,7 +3620,7 @@ func (x *GridLevel) String() string {
 func (*GridLevel) ProtoMessage() {}
 
 func (x *GridLevel) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[27]
+	mi := &file_store_database_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3341,7 +3633,7 @@ func (x *GridLevel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GridLevel.ProtoReflect.Descriptor instead.
 func (*GridLevel) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{27}
+	return file_store_database_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GridLevel) GetLevel() int32 {
@@ -3384,7 +3676,7 @@ type StorageConfig struct {
 
 func (x *StorageConfig) Reset() {
 	*x = StorageConfig{}
-	mi := &file_store_database_proto_msgTypes[28]
+	mi := &file_store_database_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3396,7 +3688,7 @@ func (x *StorageConfig) String() string {
 func (*StorageConfig) ProtoMessage() {}
 
 func (x *StorageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[28]
+	mi := &file_store_database_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3409,7 +3701,7 @@ func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
 func (*StorageConfig) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{28}
+	return file_store_database_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *StorageConfig) GetFillfactor() int32 {
@@ -3532,7 +3824,7 @@ type DimensionalConfig struct {
 
 func (x *DimensionalConfig) Reset() {
 	*x = DimensionalConfig{}
-	mi := &file_store_database_proto_msgTypes[29]
+	mi := &file_store_database_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3544,7 +3836,7 @@ func (x *DimensionalConfig) String() string {
 func (*DimensionalConfig) ProtoMessage() {}
 
 func (x *DimensionalConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[29]
+	mi := &file_store_database_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,7 +3849,7 @@ func (x *DimensionalConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DimensionalConfig.ProtoReflect.Descriptor instead.
 func (*DimensionalConfig) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{29}
+	return file_store_database_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DimensionalConfig) GetDimensions() int32 {
@@ -3613,7 +3905,7 @@ type ExtensionMetadata struct {
 
 func (x *ExtensionMetadata) Reset() {
 	*x = ExtensionMetadata{}
-	mi := &file_store_database_proto_msgTypes[30]
+	mi := &file_store_database_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3625,7 +3917,7 @@ func (x *ExtensionMetadata) String() string {
 func (*ExtensionMetadata) ProtoMessage() {}
 
 func (x *ExtensionMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[30]
+	mi := &file_store_database_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3638,7 +3930,7 @@ func (x *ExtensionMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionMetadata.ProtoReflect.Descriptor instead.
 func (*ExtensionMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{30}
+	return file_store_database_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ExtensionMetadata) GetName() string {
@@ -3697,7 +3989,7 @@ type ForeignKeyMetadata struct {
 
 func (x *ForeignKeyMetadata) Reset() {
 	*x = ForeignKeyMetadata{}
-	mi := &file_store_database_proto_msgTypes[31]
+	mi := &file_store_database_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3709,7 +4001,7 @@ func (x *ForeignKeyMetadata) String() string {
 func (*ForeignKeyMetadata) ProtoMessage() {}
 
 func (x *ForeignKeyMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[31]
+	mi := &file_store_database_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3722,7 +4014,7 @@ func (x *ForeignKeyMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForeignKeyMetadata.ProtoReflect.Descriptor instead.
 func (*ForeignKeyMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{31}
+	return file_store_database_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ForeignKeyMetadata) GetName() string {
@@ -3795,7 +4087,7 @@ type InstanceRoleMetadata struct {
 
 func (x *InstanceRoleMetadata) Reset() {
 	*x = InstanceRoleMetadata{}
-	mi := &file_store_database_proto_msgTypes[32]
+	mi := &file_store_database_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3807,7 +4099,7 @@ func (x *InstanceRoleMetadata) String() string {
 func (*InstanceRoleMetadata) ProtoMessage() {}
 
 func (x *InstanceRoleMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[32]
+	mi := &file_store_database_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3820,7 +4112,7 @@ func (x *InstanceRoleMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceRoleMetadata.ProtoReflect.Descriptor instead.
 func (*InstanceRoleMetadata) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{32}
+	return file_store_database_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *InstanceRoleMetadata) GetName() string {
@@ -3851,7 +4143,7 @@ type Secret struct {
 
 func (x *Secret) Reset() {
 	*x = Secret{}
-	mi := &file_store_database_proto_msgTypes[33]
+	mi := &file_store_database_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3863,7 +4155,7 @@ func (x *Secret) String() string {
 func (*Secret) ProtoMessage() {}
 
 func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[33]
+	mi := &file_store_database_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4168,7 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret.ProtoReflect.Descriptor instead.
 func (*Secret) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{33}
+	return file_store_database_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Secret) GetName() string {
@@ -3911,7 +4203,7 @@ type DatabaseConfig struct {
 
 func (x *DatabaseConfig) Reset() {
 	*x = DatabaseConfig{}
-	mi := &file_store_database_proto_msgTypes[34]
+	mi := &file_store_database_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3923,7 +4215,7 @@ func (x *DatabaseConfig) String() string {
 func (*DatabaseConfig) ProtoMessage() {}
 
 func (x *DatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[34]
+	mi := &file_store_database_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,7 +4228,7 @@ func (x *DatabaseConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseConfig.ProtoReflect.Descriptor instead.
 func (*DatabaseConfig) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{34}
+	return file_store_database_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DatabaseConfig) GetName() string {
@@ -3966,7 +4258,7 @@ type SchemaCatalog struct {
 
 func (x *SchemaCatalog) Reset() {
 	*x = SchemaCatalog{}
-	mi := &file_store_database_proto_msgTypes[35]
+	mi := &file_store_database_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3978,7 +4270,7 @@ func (x *SchemaCatalog) String() string {
 func (*SchemaCatalog) ProtoMessage() {}
 
 func (x *SchemaCatalog) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[35]
+	mi := &file_store_database_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3991,7 +4283,7 @@ func (x *SchemaCatalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaCatalog.ProtoReflect.Descriptor instead.
 func (*SchemaCatalog) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{35}
+	return file_store_database_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SchemaCatalog) GetName() string {
@@ -4022,7 +4314,7 @@ type TableCatalog struct {
 
 func (x *TableCatalog) Reset() {
 	*x = TableCatalog{}
-	mi := &file_store_database_proto_msgTypes[36]
+	mi := &file_store_database_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4034,7 +4326,7 @@ func (x *TableCatalog) String() string {
 func (*TableCatalog) ProtoMessage() {}
 
 func (x *TableCatalog) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[36]
+	mi := &file_store_database_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4047,7 +4339,7 @@ func (x *TableCatalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableCatalog.ProtoReflect.Descriptor instead.
 func (*TableCatalog) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{36}
+	return file_store_database_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *TableCatalog) GetName() string {
@@ -4099,7 +4391,7 @@ type ColumnCatalog struct {
 
 func (x *ColumnCatalog) Reset() {
 	*x = ColumnCatalog{}
-	mi := &file_store_database_proto_msgTypes[37]
+	mi := &file_store_database_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4111,7 +4403,7 @@ func (x *ColumnCatalog) String() string {
 func (*ColumnCatalog) ProtoMessage() {}
 
 func (x *ColumnCatalog) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[37]
+	mi := &file_store_database_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4124,7 +4416,7 @@ func (x *ColumnCatalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnCatalog.ProtoReflect.Descriptor instead.
 func (*ColumnCatalog) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{37}
+	return file_store_database_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ColumnCatalog) GetName() string {
@@ -4198,7 +4490,7 @@ type ObjectSchema struct {
 
 func (x *ObjectSchema) Reset() {
 	*x = ObjectSchema{}
-	mi := &file_store_database_proto_msgTypes[38]
+	mi := &file_store_database_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4210,7 +4502,7 @@ func (x *ObjectSchema) String() string {
 func (*ObjectSchema) ProtoMessage() {}
 
 func (x *ObjectSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[38]
+	mi := &file_store_database_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4223,7 +4515,7 @@ func (x *ObjectSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectSchema.ProtoReflect.Descriptor instead.
 func (*ObjectSchema) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{38}
+	return file_store_database_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ObjectSchema) GetType() ObjectSchema_Type {
@@ -4290,7 +4582,7 @@ type ObjectSchema_StructKind struct {
 
 func (x *ObjectSchema_StructKind) Reset() {
 	*x = ObjectSchema_StructKind{}
-	mi := &file_store_database_proto_msgTypes[42]
+	mi := &file_store_database_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4302,7 +4594,7 @@ func (x *ObjectSchema_StructKind) String() string {
 func (*ObjectSchema_StructKind) ProtoMessage() {}
 
 func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[42]
+	mi := &file_store_database_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4315,7 +4607,7 @@ func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectSchema_StructKind.ProtoReflect.Descriptor instead.
 func (*ObjectSchema_StructKind) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{38, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{40, 0}
 }
 
 func (x *ObjectSchema_StructKind) GetProperties() map[string]*ObjectSchema {
@@ -4334,7 +4626,7 @@ type ObjectSchema_ArrayKind struct {
 
 func (x *ObjectSchema_ArrayKind) Reset() {
 	*x = ObjectSchema_ArrayKind{}
-	mi := &file_store_database_proto_msgTypes[43]
+	mi := &file_store_database_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4346,7 +4638,7 @@ func (x *ObjectSchema_ArrayKind) String() string {
 func (*ObjectSchema_ArrayKind) ProtoMessage() {}
 
 func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[43]
+	mi := &file_store_database_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4359,7 +4651,7 @@ func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectSchema_ArrayKind.ProtoReflect.Descriptor instead.
 func (*ObjectSchema_ArrayKind) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{38, 1}
+	return file_store_database_proto_rawDescGZIP(), []int{40, 1}
 }
 
 func (x *ObjectSchema_ArrayKind) GetKind() *ObjectSchema {
@@ -4373,7 +4665,7 @@ var File_store_database_proto protoreflect.FileDescriptor
 
 const file_store_database_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/database.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12store/common.proto\"\x84\x03\n" +
+	"\x14store/database.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12store/common.proto\"\xc4\x03\n" +
 	"\x10DatabaseMetadata\x12D\n" +
 	"\x06labels\x18\x01 \x03(\v2,.bytebase.store.DatabaseMetadata.LabelsEntryR\x06labels\x12@\n" +
 	"\x0elast_sync_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncTime\x12)\n" +
@@ -4381,10 +4673,47 @@ const file_store_database_proto_rawDesc = "" +
 	"\tdatashare\x18\x04 \x01(\bR\tdatashare\x120\n" +
 	"\asecrets\x18\x05 \x03(\v2\x16.bytebase.store.SecretR\asecrets\x12\x18\n" +
 	"\adrifted\x18\x06 \x01(\bR\adrifted\x12\x18\n" +
-	"\aversion\x18\a \x01(\tR\aversion\x1a9\n" +
+	"\aversion\x18\a \x01(\tR\aversion\x12>\n" +
+	"\fdrift_report\x18\b \x01(\v2\x1b.bytebase.store.DriftReportR\vdriftReport\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\x01\n" +
+	"\vDriftReport\x12#\n" +
+	"\rchangelog_uid\x18\x01 \x01(\x03R\fchangelogUid\x12;\n" +
+	"\vdetect_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectTime\x125\n" +
+	"\aobjects\x18\x03 \x03(\v2\x1b.bytebase.store.DriftObjectR\aobjects\"\x9a\x04\n" +
+	"\vDriftObject\x12:\n" +
+	"\x06action\x18\x01 \x01(\x0e2\".bytebase.store.DriftObject.ActionR\x06action\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .bytebase.store.DriftObject.TypeR\x04type\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x127\n" +
+	"\bchildren\x18\x05 \x03(\v2\x1b.bytebase.store.DriftObjectR\bchildren\">\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03ADD\x10\x01\x12\b\n" +
+	"\x04DROP\x10\x02\x12\t\n" +
+	"\x05ALTER\x10\x03\"\xf3\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06SCHEMA\x10\x01\x12\t\n" +
+	"\x05TABLE\x10\x02\x12\b\n" +
+	"\x04VIEW\x10\x03\x12\x15\n" +
+	"\x11MATERIALIZED_VIEW\x10\x04\x12\f\n" +
+	"\bFUNCTION\x10\x05\x12\r\n" +
+	"\tPROCEDURE\x10\x06\x12\f\n" +
+	"\bSEQUENCE\x10\a\x12\r\n" +
+	"\tENUM_TYPE\x10\b\x12\t\n" +
+	"\x05EVENT\x10\t\x12\n" +
+	"\n" +
+	"\x06COLUMN\x10\n" +
+	"\x12\t\n" +
+	"\x05INDEX\x10\v\x12\x0f\n" +
+	"\vFOREIGN_KEY\x10\f\x12\x14\n" +
+	"\x10CHECK_CONSTRAINT\x10\r\x12\r\n" +
+	"\tPARTITION\x10\x0e\x12\v\n" +
+	"\aTRIGGER\x10\x0f\"\xb7\x03\n" +
 	"\x16DatabaseSchemaMetadata\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x128\n" +
 	"\aschemas\x18\x02 \x03(\v2\x1e.bytebase.store.SchemaMetadataR\aschemas\x12#\n" +
@@ -4827,132 +5156,142 @@ func file_store_database_proto_rawDescGZIP() []byte {
 	return file_store_database_proto_rawDescData
 }
 
-var file_store_database_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_store_database_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_store_database_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_store_database_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_store_database_proto_goTypes = []any{
-	(TaskMetadata_State)(0),                // 0: bytebase.store.TaskMetadata.State
-	(StreamMetadata_Type)(0),               // 1: bytebase.store.StreamMetadata.Type
-	(StreamMetadata_Mode)(0),               // 2: bytebase.store.StreamMetadata.Mode
-	(TablePartitionMetadata_Type)(0),       // 3: bytebase.store.TablePartitionMetadata.Type
-	(ColumnMetadata_IdentityGeneration)(0), // 4: bytebase.store.ColumnMetadata.IdentityGeneration
-	(GenerationMetadata_Type)(0),           // 5: bytebase.store.GenerationMetadata.Type
-	(ObjectSchema_Type)(0),                 // 6: bytebase.store.ObjectSchema.Type
-	(*DatabaseMetadata)(nil),               // 7: bytebase.store.DatabaseMetadata
-	(*DatabaseSchemaMetadata)(nil),         // 8: bytebase.store.DatabaseSchemaMetadata
-	(*LinkedDatabaseMetadata)(nil),         // 9: bytebase.store.LinkedDatabaseMetadata
-	(*SchemaMetadata)(nil),                 // 10: bytebase.store.SchemaMetadata
-	(*EnumTypeMetadata)(nil),               // 11: bytebase.store.EnumTypeMetadata
-	(*EventMetadata)(nil),                  // 12: bytebase.store.EventMetadata
-	(*SequenceMetadata)(nil),               // 13: bytebase.store.SequenceMetadata
-	(*TriggerMetadata)(nil),                // 14: bytebase.store.TriggerMetadata
-	(*TaskMetadata)(nil),                   // 15: bytebase.store.TaskMetadata
-	(*StreamMetadata)(nil),                 // 16: bytebase.store.StreamMetadata
-	(*TableMetadata)(nil),                  // 17: bytebase.store.TableMetadata
-	(*CheckConstraintMetadata)(nil),        // 18: bytebase.store.CheckConstraintMetadata
-	(*ExternalTableMetadata)(nil),          // 19: bytebase.store.ExternalTableMetadata
-	(*TablePartitionMetadata)(nil),         // 20: bytebase.store.TablePartitionMetadata
-	(*ColumnMetadata)(nil),                 // 21: bytebase.store.ColumnMetadata
-	(*GenerationMetadata)(nil),             // 22: bytebase.store.GenerationMetadata
-	(*ViewMetadata)(nil),                   // 23: bytebase.store.ViewMetadata
-	(*DependencyColumn)(nil),               // 24: bytebase.store.DependencyColumn
-	(*MaterializedViewMetadata)(nil),       // 25: bytebase.store.MaterializedViewMetadata
-	(*DependencyTable)(nil),                // 26: bytebase.store.DependencyTable
-	(*FunctionMetadata)(nil),               // 27: bytebase.store.FunctionMetadata
-	(*ProcedureMetadata)(nil),              // 28: bytebase.store.ProcedureMetadata
-	(*PackageMetadata)(nil),                // 29: bytebase.store.PackageMetadata
-	(*IndexMetadata)(nil),                  // 30: bytebase.store.IndexMetadata
-	(*SpatialIndexConfig)(nil),             // 31: bytebase.store.SpatialIndexConfig
-	(*TessellationConfig)(nil),             // 32: bytebase.store.TessellationConfig
-	(*BoundingBox)(nil),                    // 33: bytebase.store.BoundingBox
-	(*GridLevel)(nil),                      // 34: bytebase.store.GridLevel
-	(*StorageConfig)(nil),                  // 35: bytebase.store.StorageConfig
-	(*DimensionalConfig)(nil),              // 36: bytebase.store.DimensionalConfig
-	(*ExtensionMetadata)(nil),              // 37: bytebase.store.ExtensionMetadata
-	(*ForeignKeyMetadata)(nil),             // 38: bytebase.store.ForeignKeyMetadata
-	(*InstanceRoleMetadata)(nil),           // 39: bytebase.store.InstanceRoleMetadata
-	(*Secret)(nil),                         // 40: bytebase.store.Secret
-	(*DatabaseConfig)(nil),                 // 41: bytebase.store.DatabaseConfig
-	(*SchemaCatalog)(nil),                  // 42: bytebase.store.SchemaCatalog
-	(*TableCatalog)(nil),                   // 43: bytebase.store.TableCatalog
-	(*ColumnCatalog)(nil),                  // 44: bytebase.store.ColumnCatalog
-	(*ObjectSchema)(nil),                   // 45: bytebase.store.ObjectSchema
-	nil,                                    // 46: bytebase.store.DatabaseMetadata.LabelsEntry
-	nil,                                    // 47: bytebase.store.SpatialIndexConfig.EngineSpecificEntry
-	nil,                                    // 48: bytebase.store.ColumnCatalog.LabelsEntry
-	(*ObjectSchema_StructKind)(nil),        // 49: bytebase.store.ObjectSchema.StructKind
-	(*ObjectSchema_ArrayKind)(nil),         // 50: bytebase.store.ObjectSchema.ArrayKind
-	nil,                                    // 51: bytebase.store.ObjectSchema.StructKind.PropertiesEntry
-	(*timestamppb.Timestamp)(nil),          // 52: google.protobuf.Timestamp
-	(MaskingLevel)(0),                      // 53: bytebase.store.MaskingLevel
+	(DriftObject_Action)(0),                // 0: bytebase.store.DriftObject.Action
+	(DriftObject_Type)(0),                  // 1: bytebase.store.DriftObject.Type
+	(TaskMetadata_State)(0),                // 2: bytebase.store.TaskMetadata.State
+	(StreamMetadata_Type)(0),               // 3: bytebase.store.StreamMetadata.Type
+	(StreamMetadata_Mode)(0),               // 4: bytebase.store.StreamMetadata.Mode
+	(TablePartitionMetadata_Type)(0),       // 5: bytebase.store.TablePartitionMetadata.Type
+	(ColumnMetadata_IdentityGeneration)(0), // 6: bytebase.store.ColumnMetadata.IdentityGeneration
+	(GenerationMetadata_Type)(0),           // 7: bytebase.store.GenerationMetadata.Type
+	(ObjectSchema_Type)(0),                 // 8: bytebase.store.ObjectSchema.Type
+	(*DatabaseMetadata)(nil),               // 9: bytebase.store.DatabaseMetadata
+	(*DriftReport)(nil),                    // 10: bytebase.store.DriftReport
+	(*DriftObject)(nil),                    // 11: bytebase.store.DriftObject
+	(*DatabaseSchemaMetadata)(nil),         // 12: bytebase.store.DatabaseSchemaMetadata
+	(*LinkedDatabaseMetadata)(nil),         // 13: bytebase.store.LinkedDatabaseMetadata
+	(*SchemaMetadata)(nil),                 // 14: bytebase.store.SchemaMetadata
+	(*EnumTypeMetadata)(nil),               // 15: bytebase.store.EnumTypeMetadata
+	(*EventMetadata)(nil),                  // 16: bytebase.store.EventMetadata
+	(*SequenceMetadata)(nil),               // 17: bytebase.store.SequenceMetadata
+	(*TriggerMetadata)(nil),                // 18: bytebase.store.TriggerMetadata
+	(*TaskMetadata)(nil),                   // 19: bytebase.store.TaskMetadata
+	(*StreamMetadata)(nil),                 // 20: bytebase.store.StreamMetadata
+	(*TableMetadata)(nil),                  // 21: bytebase.store.TableMetadata
+	(*CheckConstraintMetadata)(nil),        // 22: bytebase.store.CheckConstraintMetadata
+	(*ExternalTableMetadata)(nil),          // 23: bytebase.store.ExternalTableMetadata
+	(*TablePartitionMetadata)(nil),         // 24: bytebase.store.TablePartitionMetadata
+	(*ColumnMetadata)(nil),                 // 25: bytebase.store.ColumnMetadata
+	(*GenerationMetadata)(nil),             // 26: bytebase.store.GenerationMetadata
+	(*ViewMetadata)(nil),                   // 27: bytebase.store.ViewMetadata
+	(*DependencyColumn)(nil),               // 28: bytebase.store.DependencyColumn
+	(*MaterializedViewMetadata)(nil),       // 29: bytebase.store.MaterializedViewMetadata
+	(*DependencyTable)(nil),                // 30: bytebase.store.DependencyTable
+	(*FunctionMetadata)(nil),               // 31: bytebase.store.FunctionMetadata
+	(*ProcedureMetadata)(nil),              // 32: bytebase.store.ProcedureMetadata
+	(*PackageMetadata)(nil),                // 33: bytebase.store.PackageMetadata
+	(*IndexMetadata)(nil),                  // 34: bytebase.store.IndexMetadata
+	(*SpatialIndexConfig)(nil),             // 35: bytebase.store.SpatialIndexConfig
+	(*TessellationConfig)(nil),             // 36: bytebase.store.TessellationConfig
+	(*BoundingBox)(nil),                    // 37: bytebase.store.BoundingBox
+	(*GridLevel)(nil),                      // 38: bytebase.store.GridLevel
+	(*StorageConfig)(nil),                  // 39: bytebase.store.StorageConfig
+	(*DimensionalConfig)(nil),              // 40: bytebase.store.DimensionalConfig
+	(*ExtensionMetadata)(nil),              // 41: bytebase.store.ExtensionMetadata
+	(*ForeignKeyMetadata)(nil),             // 42: bytebase.store.ForeignKeyMetadata
+	(*InstanceRoleMetadata)(nil),           // 43: bytebase.store.InstanceRoleMetadata
+	(*Secret)(nil),                         // 44: bytebase.store.Secret
+	(*DatabaseConfig)(nil),                 // 45: bytebase.store.DatabaseConfig
+	(*SchemaCatalog)(nil),                  // 46: bytebase.store.SchemaCatalog
+	(*TableCatalog)(nil),                   // 47: bytebase.store.TableCatalog
+	(*ColumnCatalog)(nil),                  // 48: bytebase.store.ColumnCatalog
+	(*ObjectSchema)(nil),                   // 49: bytebase.store.ObjectSchema
+	nil,                                    // 50: bytebase.store.DatabaseMetadata.LabelsEntry
+	nil,                                    // 51: bytebase.store.SpatialIndexConfig.EngineSpecificEntry
+	nil,                                    // 52: bytebase.store.ColumnCatalog.LabelsEntry
+	(*ObjectSchema_StructKind)(nil),        // 53: bytebase.store.ObjectSchema.StructKind
+	(*ObjectSchema_ArrayKind)(nil),         // 54: bytebase.store.ObjectSchema.ArrayKind
+	nil,                                    // 55: bytebase.store.ObjectSchema.StructKind.PropertiesEntry
+	(*timestamppb.Timestamp)(nil),          // 56: google.protobuf.Timestamp
+	(MaskingLevel)(0),                      // 57: bytebase.store.MaskingLevel
 }
 var file_store_database_proto_depIdxs = []int32{
-	46, // 0: bytebase.store.DatabaseMetadata.labels:type_name -> bytebase.store.DatabaseMetadata.LabelsEntry
-	52, // 1: bytebase.store.DatabaseMetadata.last_sync_time:type_name -> google.protobuf.Timestamp
-	40, // 2: bytebase.store.DatabaseMetadata.secrets:type_name -> bytebase.store.Secret
-	10, // 3: bytebase.store.DatabaseSchemaMetadata.schemas:type_name -> bytebase.store.SchemaMetadata
-	37, // 4: bytebase.store.DatabaseSchemaMetadata.extensions:type_name -> bytebase.store.ExtensionMetadata
-	9,  // 5: bytebase.store.DatabaseSchemaMetadata.linked_databases:type_name -> bytebase.store.LinkedDatabaseMetadata
-	17, // 6: bytebase.store.SchemaMetadata.tables:type_name -> bytebase.store.TableMetadata
-	19, // 7: bytebase.store.SchemaMetadata.external_tables:type_name -> bytebase.store.ExternalTableMetadata
-	23, // 8: bytebase.store.SchemaMetadata.views:type_name -> bytebase.store.ViewMetadata
-	27, // 9: bytebase.store.SchemaMetadata.functions:type_name -> bytebase.store.FunctionMetadata
-	28, // 10: bytebase.store.SchemaMetadata.procedures:type_name -> bytebase.store.ProcedureMetadata
-	16, // 11: bytebase.store.SchemaMetadata.streams:type_name -> bytebase.store.StreamMetadata
-	15, // 12: bytebase.store.SchemaMetadata.tasks:type_name -> bytebase.store.TaskMetadata
-	25, // 13: bytebase.store.SchemaMetadata.materialized_views:type_name -> bytebase.store.MaterializedViewMetadata
-	13, // 14: bytebase.store.SchemaMetadata.sequences:type_name -> bytebase.store.SequenceMetadata
-	29, // 15: bytebase.store.SchemaMetadata.packages:type_name -> bytebase.store.PackageMetadata
-	12, // 16: bytebase.store.SchemaMetadata.events:type_name -> bytebase.store.EventMetadata
-	11, // 17: bytebase.store.SchemaMetadata.enum_types:type_name -> bytebase.store.EnumTypeMetadata
-	0,  // 18: bytebase.store.TaskMetadata.state:type_name -> bytebase.store.TaskMetadata.State
-	1,  // 19: bytebase.store.StreamMetadata.type:type_name -> bytebase.store.StreamMetadata.Type
-	2,  // 20: bytebase.store.StreamMetadata.mode:type_name -> bytebase.store.StreamMetadata.Mode
-	21, // 21: bytebase.store.TableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
-	30, // 22: bytebase.store.TableMetadata.indexes:type_name -> bytebase.store.IndexMetadata
-	38, // 23: bytebase.store.TableMetadata.foreign_keys:type_name -> bytebase.store.ForeignKeyMetadata
-	20, // 24: bytebase.store.TableMetadata.partitions:type_name -> bytebase.store.TablePartitionMetadata
-	18, // 25: bytebase.store.TableMetadata.check_constraints:type_name -> bytebase.store.CheckConstraintMetadata
-	14, // 26: bytebase.store.TableMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
-	21, // 27: bytebase.store.ExternalTableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
-	3,  // 28: bytebase.store.TablePartitionMetadata.type:type_name -> bytebase.store.TablePartitionMetadata.Type
-	20, // 29: bytebase.store.TablePartitionMetadata.subpartitions:type_name -> bytebase.store.TablePartitionMetadata
-	30, // 30: bytebase.store.TablePartitionMetadata.indexes:type_name -> bytebase.store.IndexMetadata
-	18, // 31: bytebase.store.TablePartitionMetadata.check_constraints:type_name -> bytebase.store.CheckConstraintMetadata
-	22, // 32: bytebase.store.ColumnMetadata.generation:type_name -> bytebase.store.GenerationMetadata
-	4,  // 33: bytebase.store.ColumnMetadata.identity_generation:type_name -> bytebase.store.ColumnMetadata.IdentityGeneration
-	5,  // 34: bytebase.store.GenerationMetadata.type:type_name -> bytebase.store.GenerationMetadata.Type
-	24, // 35: bytebase.store.ViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
-	21, // 36: bytebase.store.ViewMetadata.columns:type_name -> bytebase.store.ColumnMetadata
-	14, // 37: bytebase.store.ViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
-	24, // 38: bytebase.store.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
-	14, // 39: bytebase.store.MaterializedViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
-	30, // 40: bytebase.store.MaterializedViewMetadata.indexes:type_name -> bytebase.store.IndexMetadata
-	26, // 41: bytebase.store.FunctionMetadata.dependency_tables:type_name -> bytebase.store.DependencyTable
-	31, // 42: bytebase.store.IndexMetadata.spatial_config:type_name -> bytebase.store.SpatialIndexConfig
-	32, // 43: bytebase.store.SpatialIndexConfig.tessellation:type_name -> bytebase.store.TessellationConfig
-	35, // 44: bytebase.store.SpatialIndexConfig.storage:type_name -> bytebase.store.StorageConfig
-	36, // 45: bytebase.store.SpatialIndexConfig.dimensional:type_name -> bytebase.store.DimensionalConfig
-	47, // 46: bytebase.store.SpatialIndexConfig.engine_specific:type_name -> bytebase.store.SpatialIndexConfig.EngineSpecificEntry
-	33, // 47: bytebase.store.TessellationConfig.bounding_box:type_name -> bytebase.store.BoundingBox
-	34, // 48: bytebase.store.TessellationConfig.grid_levels:type_name -> bytebase.store.GridLevel
-	42, // 49: bytebase.store.DatabaseConfig.schemas:type_name -> bytebase.store.SchemaCatalog
-	43, // 50: bytebase.store.SchemaCatalog.tables:type_name -> bytebase.store.TableCatalog
-	44, // 51: bytebase.store.TableCatalog.columns:type_name -> bytebase.store.ColumnCatalog
-	45, // 52: bytebase.store.TableCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
-	48, // 53: bytebase.store.ColumnCatalog.labels:type_name -> bytebase.store.ColumnCatalog.LabelsEntry
-	45, // 54: bytebase.store.ColumnCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
-	53, // 55: bytebase.store.ColumnCatalog.masking_level:type_name -> bytebase.store.MaskingLevel
-	6,  // 56: bytebase.store.ObjectSchema.type:type_name -> bytebase.store.ObjectSchema.Type
-	49, // 57: bytebase.store.ObjectSchema.struct_kind:type_name -> bytebase.store.ObjectSchema.StructKind
-	50, // 58: bytebase.store.ObjectSchema.array_kind:type_name -> bytebase.store.ObjectSchema.ArrayKind
-	51, // 59: bytebase.store.ObjectSchema.StructKind.properties:type_name -> bytebase.store.ObjectSchema.StructKind.PropertiesEntry
-	45, // 60: bytebase.store.ObjectSchema.ArrayKind.kind:type_name -> bytebase.store.ObjectSchema
-	45, // 61: bytebase.store.ObjectSchema.StructKind.PropertiesEntry.value:type_name -> bytebase.store.ObjectSchema
-	62, // [62:62] is the sub-list for method output_type
-	62, // [62:62] is the sub-list for method input_type
-	62, // [62:62] is the sub-list for extension type_name
-	62, // [62:62] is the sub-list for extension extendee
-	0,  // [0:62] is the sub-list for field type_name
+	50, // 0: bytebase.store.DatabaseMetadata.labels:type_name -> bytebase.store.DatabaseMetadata.LabelsEntry
+	56, // 1: bytebase.store.DatabaseMetadata.last_sync_time:type_name -> google.protobuf.Timestamp
+	44, // 2: bytebase.store.DatabaseMetadata.secrets:type_name -> bytebase.store.Secret
+	10, // 3: bytebase.store.DatabaseMetadata.drift_report:type_name -> bytebase.store.DriftReport
+	56, // 4: bytebase.store.DriftReport.detect_time:type_name -> google.protobuf.Timestamp
+	11, // 5: bytebase.store.DriftReport.objects:type_name -> bytebase.store.DriftObject
+	0,  // 6: bytebase.store.DriftObject.action:type_name -> bytebase.store.DriftObject.Action
+	1,  // 7: bytebase.store.DriftObject.type:type_name -> bytebase.store.DriftObject.Type
+	11, // 8: bytebase.store.DriftObject.children:type_name -> bytebase.store.DriftObject
+	14, // 9: bytebase.store.DatabaseSchemaMetadata.schemas:type_name -> bytebase.store.SchemaMetadata
+	41, // 10: bytebase.store.DatabaseSchemaMetadata.extensions:type_name -> bytebase.store.ExtensionMetadata
+	13, // 11: bytebase.store.DatabaseSchemaMetadata.linked_databases:type_name -> bytebase.store.LinkedDatabaseMetadata
+	21, // 12: bytebase.store.SchemaMetadata.tables:type_name -> bytebase.store.TableMetadata
+	23, // 13: bytebase.store.SchemaMetadata.external_tables:type_name -> bytebase.store.ExternalTableMetadata
+	27, // 14: bytebase.store.SchemaMetadata.views:type_name -> bytebase.store.ViewMetadata
+	31, // 15: bytebase.store.SchemaMetadata.functions:type_name -> bytebase.store.FunctionMetadata
+	32, // 16: bytebase.store.SchemaMetadata.procedures:type_name -> bytebase.store.ProcedureMetadata
+	20, // 17: bytebase.store.SchemaMetadata.streams:type_name -> bytebase.store.StreamMetadata
+	19, // 18: bytebase.store.SchemaMetadata.tasks:type_name -> bytebase.store.TaskMetadata
+	29, // 19: bytebase.store.SchemaMetadata.materialized_views:type_name -> bytebase.store.MaterializedViewMetadata
+	17, // 20: bytebase.store.SchemaMetadata.sequences:type_name -> bytebase.store.SequenceMetadata
+	33, // 21: bytebase.store.SchemaMetadata.packages:type_name -> bytebase.store.PackageMetadata
+	16, // 22: bytebase.store.SchemaMetadata.events:type_name -> bytebase.store.EventMetadata
+	15, // 23: bytebase.store.SchemaMetadata.enum_types:type_name -> bytebase.store.EnumTypeMetadata
+	2,  // 24: bytebase.store.TaskMetadata.state:type_name -> bytebase.store.TaskMetadata.State
+	3,  // 25: bytebase.store.StreamMetadata.type:type_name -> bytebase.store.StreamMetadata.Type
+	4,  // 26: bytebase.store.StreamMetadata.mode:type_name -> bytebase.store.StreamMetadata.Mode
+	25, // 27: bytebase.store.TableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
+	34, // 28: bytebase.store.TableMetadata.indexes:type_name -> bytebase.store.IndexMetadata
+	42, // 29: bytebase.store.TableMetadata.foreign_keys:type_name -> bytebase.store.ForeignKeyMetadata
+	24, // 30: bytebase.store.TableMetadata.partitions:type_name -> bytebase.store.TablePartitionMetadata
+	22, // 31: bytebase.store.TableMetadata.check_constraints:type_name -> bytebase.store.CheckConstraintMetadata
+	18, // 32: bytebase.store.TableMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
+	25, // 33: bytebase.store.ExternalTableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
+	5,  // 34: bytebase.store.TablePartitionMetadata.type:type_name -> bytebase.store.TablePartitionMetadata.Type
+	24, // 35: bytebase.store.TablePartitionMetadata.subpartitions:type_name -> bytebase.store.TablePartitionMetadata
+	34, // 36: bytebase.store.TablePartitionMetadata.indexes:type_name -> bytebase.store.IndexMetadata
+	22, // 37: bytebase.store.TablePartitionMetadata.check_constraints:type_name -> bytebase.store.CheckConstraintMetadata
+	26, // 38: bytebase.store.ColumnMetadata.generation:type_name -> bytebase.store.GenerationMetadata
+	6,  // 39: bytebase.store.ColumnMetadata.identity_generation:type_name -> bytebase.store.ColumnMetadata.IdentityGeneration
+	7,  // 40: bytebase.store.GenerationMetadata.type:type_name -> bytebase.store.GenerationMetadata.Type
+	28, // 41: bytebase.store.ViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
+	25, // 42: bytebase.store.ViewMetadata.columns:type_name -> bytebase.store.ColumnMetadata
+	18, // 43: bytebase.store.ViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
+	28, // 44: bytebase.store.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
+	18, // 45: bytebase.store.MaterializedViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
+	34, // 46: bytebase.store.MaterializedViewMetadata.indexes:type_name -> bytebase.store.IndexMetadata
+	30, // 47: bytebase.store.FunctionMetadata.dependency_tables:type_name -> bytebase.store.DependencyTable
+	35, // 48: bytebase.store.IndexMetadata.spatial_config:type_name -> bytebase.store.SpatialIndexConfig
+	36, // 49: bytebase.store.SpatialIndexConfig.tessellation:type_name -> bytebase.store.TessellationConfig
+	39, // 50: bytebase.store.SpatialIndexConfig.storage:type_name -> bytebase.store.StorageConfig
+	40, // 51: bytebase.store.SpatialIndexConfig.dimensional:type_name -> bytebase.store.DimensionalConfig
+	51, // 52: bytebase.store.SpatialIndexConfig.engine_specific:type_name -> bytebase.store.SpatialIndexConfig.EngineSpecificEntry
+	37, // 53: bytebase.store.TessellationConfig.bounding_box:type_name -> bytebase.store.BoundingBox
+	38, // 54: bytebase.store.TessellationConfig.grid_levels:type_name -> bytebase.store.GridLevel
+	46, // 55: bytebase.store.DatabaseConfig.schemas:type_name -> bytebase.store.SchemaCatalog
+	47, // 56: bytebase.store.SchemaCatalog.tables:type_name -> bytebase.store.TableCatalog
+	48, // 57: bytebase.store.TableCatalog.columns:type_name -> bytebase.store.ColumnCatalog
+	49, // 58: bytebase.store.TableCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
+	52, // 59: bytebase.store.ColumnCatalog.labels:type_name -> bytebase.store.ColumnCatalog.LabelsEntry
+	49, // 60: bytebase.store.ColumnCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
+	57, // 61: bytebase.store.ColumnCatalog.masking_level:type_name -> bytebase.store.MaskingLevel
+	8,  // 62: bytebase.store.ObjectSchema.type:type_name -> bytebase.store.ObjectSchema.Type
+	53, // 63: bytebase.store.ObjectSchema.struct_kind:type_name -> bytebase.store.ObjectSchema.StructKind
+	54, // 64: bytebase.store.ObjectSchema.array_kind:type_name -> bytebase.store.ObjectSchema.ArrayKind
+	55, // 65: bytebase.store.ObjectSchema.StructKind.properties:type_name -> bytebase.store.ObjectSchema.StructKind.PropertiesEntry
+	49, // 66: bytebase.store.ObjectSchema.ArrayKind.kind:type_name -> bytebase.store.ObjectSchema
+	49, // 67: bytebase.store.ObjectSchema.StructKind.PropertiesEntry.value:type_name -> bytebase.store.ObjectSchema
+	68, // [68:68] is the sub-list for method output_type
+	68, // [68:68] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_store_database_proto_init() }
@@ -4961,9 +5300,9 @@ func file_store_database_proto_init() {
 		return
 	}
 	file_store_common_proto_init()
-	file_store_database_proto_msgTypes[36].OneofWrappers = []any{}
-	file_store_database_proto_msgTypes[37].OneofWrappers = []any{}
-	file_store_database_proto_msgTypes[38].OneofWrappers = []any{
+	file_store_database_proto_msgTypes[38].OneofWrappers = []any{}
+	file_store_database_proto_msgTypes[39].OneofWrappers = []any{}
+	file_store_database_proto_msgTypes[40].OneofWrappers = []any{
 		(*ObjectSchema_StructKind_)(nil),
 		(*ObjectSchema_ArrayKind_)(nil),
 	}
@@ -4972,8 +5311,8 @@ func file_store_database_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_database_proto_rawDesc), len(file_store_database_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   45,
+			NumEnums:      9,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
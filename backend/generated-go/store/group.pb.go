@@ -128,7 +128,7 @@ func (x *GroupMember) GetRole() GroupMember_Role {
 type GroupPayload struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Members []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	// source means where the group comes from. For now we support Entra ID SCIM sync, so the source could be Entra ID.
+	// source means where the group comes from. For now we support Entra ID SCIM sync and LDAP group sync, so the source could be Entra ID or LDAP.
 	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
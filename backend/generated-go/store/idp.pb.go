@@ -28,6 +28,7 @@ const (
 	IdentityProviderType_OAUTH2                             IdentityProviderType = 1
 	IdentityProviderType_OIDC                               IdentityProviderType = 2
 	IdentityProviderType_LDAP                               IdentityProviderType = 3
+	IdentityProviderType_SAML                               IdentityProviderType = 4
 )
 
 // Enum value maps for IdentityProviderType.
@@ -37,12 +38,14 @@ var (
 		1: "OAUTH2",
 		2: "OIDC",
 		3: "LDAP",
+		4: "SAML",
 	}
 	IdentityProviderType_value = map[string]int32{
 		"IDENTITY_PROVIDER_TYPE_UNSPECIFIED": 0,
 		"OAUTH2":                             1,
 		"OIDC":                               2,
 		"LDAP":                               3,
+		"SAML":                               4,
 	}
 )
 
@@ -182,6 +185,7 @@ type IdentityProviderConfig struct {
 	//	*IdentityProviderConfig_Oauth2Config
 	//	*IdentityProviderConfig_OidcConfig
 	//	*IdentityProviderConfig_LdapConfig
+	//	*IdentityProviderConfig_SamlConfig
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -251,6 +255,15 @@ func (x *IdentityProviderConfig) GetLdapConfig() *LDAPIdentityProviderConfig {
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetSamlConfig() *SAMLIdentityProviderConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_SamlConfig); ok {
+			return x.SamlConfig
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -267,12 +280,18 @@ type IdentityProviderConfig_LdapConfig struct {
 	LdapConfig *LDAPIdentityProviderConfig `protobuf:"bytes,3,opt,name=ldap_config,json=ldapConfig,proto3,oneof"`
 }
 
+type IdentityProviderConfig_SamlConfig struct {
+	SamlConfig *SAMLIdentityProviderConfig `protobuf:"bytes,4,opt,name=saml_config,json=samlConfig,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2Config) isIdentityProviderConfig_Config() {}
 
 func (*IdentityProviderConfig_OidcConfig) isIdentityProviderConfig_Config() {}
 
 func (*IdentityProviderConfig_LdapConfig) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_SamlConfig) isIdentityProviderConfig_Config() {}
+
 // OAuth2IdentityProviderConfig is the structure for OAuth2 identity provider config.
 type OAuth2IdentityProviderConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -501,7 +520,10 @@ type LDAPIdentityProviderConfig struct {
 	SecurityProtocol LDAPIdentityProviderConfig_SecurityProtocol `protobuf:"varint,8,opt,name=security_protocol,json=securityProtocol,proto3,enum=bytebase.store.LDAPIdentityProviderConfig_SecurityProtocol" json:"security_protocol,omitempty"`
 	// FieldMapping is the mapping of the user attributes returned by the LDAP
 	// server.
-	FieldMapping  *FieldMapping `protobuf:"bytes,9,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	FieldMapping *FieldMapping `protobuf:"bytes,9,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// GroupSync is the configuration to synchronize the LDAP groups into
+	// Bytebase groups.
+	GroupSync     *LDAPGroupSyncConfig `protobuf:"bytes,10,opt,name=group_sync,json=groupSync,proto3" json:"group_sync,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -599,6 +621,280 @@ func (x *LDAPIdentityProviderConfig) GetFieldMapping() *FieldMapping {
 	return nil
 }
 
+func (x *LDAPIdentityProviderConfig) GetGroupSync() *LDAPGroupSyncConfig {
+	if x != nil {
+		return x.GroupSync
+	}
+	return nil
+}
+
+// LDAPGroupSyncConfig is the configuration to synchronize the LDAP groups into
+// Bytebase groups.
+type LDAPGroupSyncConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enabled controls whether the groups are synchronized periodically.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// GroupBaseDN is the base DN to search for nested groups, e.g.
+	// "ou=groups,dc=example,dc=com". When not set, the BaseDN will be used.
+	GroupBaseDn string `protobuf:"bytes,2,opt,name=group_base_dn,json=groupBaseDn,proto3" json:"group_base_dn,omitempty"`
+	// MemberAttribute is the attribute of the group entries listing the DNs of
+	// the members. When not set, "member" will be used.
+	MemberAttribute string `protobuf:"bytes,3,opt,name=member_attribute,json=memberAttribute,proto3" json:"member_attribute,omitempty"`
+	// UseMemberOf controls whether to find the members by the "memberOf"
+	// attribute of the user entries instead of the member attribute of the
+	// group entries.
+	UseMemberOf bool `protobuf:"varint,4,opt,name=use_member_of,json=useMemberOf,proto3" json:"use_member_of,omitempty"`
+	// Nested controls whether the members of the nested groups are included.
+	Nested bool `protobuf:"varint,5,opt,name=nested,proto3" json:"nested,omitempty"`
+	// Mappings are the mappings from the LDAP groups to Bytebase groups.
+	Mappings      []*LDAPGroupMapping `protobuf:"bytes,6,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAPGroupSyncConfig) Reset() {
+	*x = LDAPGroupSyncConfig{}
+	mi := &file_store_idp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPGroupSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPGroupSyncConfig) ProtoMessage() {}
+
+func (x *LDAPGroupSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPGroupSyncConfig.ProtoReflect.Descriptor instead.
+func (*LDAPGroupSyncConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LDAPGroupSyncConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LDAPGroupSyncConfig) GetGroupBaseDn() string {
+	if x != nil {
+		return x.GroupBaseDn
+	}
+	return ""
+}
+
+func (x *LDAPGroupSyncConfig) GetMemberAttribute() string {
+	if x != nil {
+		return x.MemberAttribute
+	}
+	return ""
+}
+
+func (x *LDAPGroupSyncConfig) GetUseMemberOf() bool {
+	if x != nil {
+		return x.UseMemberOf
+	}
+	return false
+}
+
+func (x *LDAPGroupSyncConfig) GetNested() bool {
+	if x != nil {
+		return x.Nested
+	}
+	return false
+}
+
+func (x *LDAPGroupSyncConfig) GetMappings() []*LDAPGroupMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// LDAPGroupMapping maps an LDAP group or the users matching a filter to a
+// Bytebase group.
+type LDAPGroupMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupDN is the DN of the LDAP group, e.g.
+	// "cn=dba,ou=groups,dc=example,dc=com".
+	GroupDn string `protobuf:"bytes,1,opt,name=group_dn,json=groupDn,proto3" json:"group_dn,omitempty"`
+	// Filter is the filter to search for the member users under the BaseDN,
+	// e.g. "(department=DBA)". It takes precedence over the GroupDN.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Group is the email of the Bytebase group, e.g. "dba@example.com".
+	Group         string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAPGroupMapping) Reset() {
+	*x = LDAPGroupMapping{}
+	mi := &file_store_idp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPGroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPGroupMapping) ProtoMessage() {}
+
+func (x *LDAPGroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPGroupMapping.ProtoReflect.Descriptor instead.
+func (*LDAPGroupMapping) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LDAPGroupMapping) GetGroupDn() string {
+	if x != nil {
+		return x.GroupDn
+	}
+	return ""
+}
+
+func (x *LDAPGroupMapping) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *LDAPGroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// SAMLIdentityProviderConfig is the structure for SAML 2.0 identity provider config.
+// Bytebase acts as the service provider, whose entity ID is "{external_url}/saml/metadata/{idp}"
+// and assertion consumer service URL is "{external_url}/saml/acs/{idp}".
+type SAMLIdentityProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// EntityId is the entity ID of the identity provider, i.e. the issuer of the assertions.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// SsoUrl is the single sign-on URL of the identity provider for the HTTP-Redirect binding.
+	SsoUrl string `protobuf:"bytes,2,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	// Certificate is the PEM encoded X.509 certificate used by the identity provider to sign the responses and assertions.
+	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// SpCertificate is the PEM encoded X.509 certificate of the service provider published in the metadata. Optional.
+	SpCertificate string `protobuf:"bytes,4,opt,name=sp_certificate,json=spCertificate,proto3" json:"sp_certificate,omitempty"`
+	// SpPrivateKey is the PEM encoded private key of the service provider to sign the AuthnRequest. Optional.
+	// The AuthnRequest is not signed if it's empty.
+	SpPrivateKey string `protobuf:"bytes,5,opt,name=sp_private_key,json=spPrivateKey,proto3" json:"sp_private_key,omitempty"`
+	// NameIdFormat is the requested name identifier format, e.g. "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress". Optional.
+	NameIdFormat string `protobuf:"bytes,6,opt,name=name_id_format,json=nameIdFormat,proto3" json:"name_id_format,omitempty"`
+	// FieldMapping is the mapping of the assertion attributes.
+	// The "NameID" refers to the name identifier of the subject.
+	FieldMapping  *FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLIdentityProviderConfig) Reset() {
+	*x = SAMLIdentityProviderConfig{}
+	mi := &file_store_idp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLIdentityProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLIdentityProviderConfig) ProtoMessage() {}
+
+func (x *SAMLIdentityProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLIdentityProviderConfig.ProtoReflect.Descriptor instead.
+func (*SAMLIdentityProviderConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SAMLIdentityProviderConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetSpCertificate() string {
+	if x != nil {
+		return x.SpCertificate
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetSpPrivateKey() string {
+	if x != nil {
+		return x.SpPrivateKey
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetNameIdFormat() string {
+	if x != nil {
+		return x.NameIdFormat
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetFieldMapping() *FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
 // FieldMapping saves the field names from user info API of identity provider.
 // As we save all raw json string of user info response data into `principal.idp_user_info`,
 // we can extract the relevant data based with `FieldMapping`.
@@ -619,7 +915,7 @@ type FieldMapping struct {
 
 func (x *FieldMapping) Reset() {
 	*x = FieldMapping{}
-	mi := &file_store_idp_proto_msgTypes[4]
+	mi := &file_store_idp_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +927,7 @@ func (x *FieldMapping) String() string {
 func (*FieldMapping) ProtoMessage() {}
 
 func (x *FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_store_idp_proto_msgTypes[4]
+	mi := &file_store_idp_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +940,7 @@ func (x *FieldMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldMapping.ProtoReflect.Descriptor instead.
 func (*FieldMapping) Descriptor() ([]byte, []int) {
-	return file_store_idp_proto_rawDescGZIP(), []int{4}
+	return file_store_idp_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FieldMapping) GetIdentifier() string {
@@ -693,7 +989,7 @@ type IdentityProviderUserInfo struct {
 
 func (x *IdentityProviderUserInfo) Reset() {
 	*x = IdentityProviderUserInfo{}
-	mi := &file_store_idp_proto_msgTypes[5]
+	mi := &file_store_idp_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +1001,7 @@ func (x *IdentityProviderUserInfo) String() string {
 func (*IdentityProviderUserInfo) ProtoMessage() {}
 
 func (x *IdentityProviderUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_idp_proto_msgTypes[5]
+	mi := &file_store_idp_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1014,7 @@ func (x *IdentityProviderUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProviderUserInfo.ProtoReflect.Descriptor instead.
 func (*IdentityProviderUserInfo) Descriptor() ([]byte, []int) {
-	return file_store_idp_proto_rawDescGZIP(), []int{5}
+	return file_store_idp_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IdentityProviderUserInfo) GetIdentifier() string {
@@ -760,13 +1056,15 @@ var File_store_idp_proto protoreflect.FileDescriptor
 
 const file_store_idp_proto_rawDesc = "" +
 	"\n" +
-	"\x0fstore/idp.proto\x12\x0ebytebase.store\"\x95\x02\n" +
+	"\x0fstore/idp.proto\x12\x0ebytebase.store\"\xe4\x02\n" +
 	"\x16IdentityProviderConfig\x12S\n" +
 	"\roauth2_config\x18\x01 \x01(\v2,.bytebase.store.OAuth2IdentityProviderConfigH\x00R\foauth2Config\x12M\n" +
 	"\voidc_config\x18\x02 \x01(\v2*.bytebase.store.OIDCIdentityProviderConfigH\x00R\n" +
 	"oidcConfig\x12M\n" +
 	"\vldap_config\x18\x03 \x01(\v2*.bytebase.store.LDAPIdentityProviderConfigH\x00R\n" +
-	"ldapConfigB\b\n" +
+	"ldapConfig\x12M\n" +
+	"\vsaml_config\x18\x04 \x01(\v2*.bytebase.store.SAMLIdentityProviderConfigH\x00R\n" +
+	"samlConfigB\b\n" +
 	"\x06config\"\xff\x02\n" +
 	"\x1cOAuth2IdentityProviderConfig\x12\x19\n" +
 	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x12\x1b\n" +
@@ -787,7 +1085,7 @@ const file_store_idp_proto_rawDesc = "" +
 	"\x0fskip_tls_verify\x18\x05 \x01(\bR\rskipTlsVerify\x12>\n" +
 	"\n" +
 	"auth_style\x18\x06 \x01(\x0e2\x1f.bytebase.store.OAuth2AuthStyleR\tauthStyle\x12\x16\n" +
-	"\x06scopes\x18\a \x03(\tR\x06scopes\"\xe2\x03\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\"\xa6\x04\n" +
 	"\x1aLDAPIdentityProviderConfig\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12&\n" +
@@ -798,11 +1096,33 @@ const file_store_idp_proto_rawDesc = "" +
 	"\vuser_filter\x18\a \x01(\tR\n" +
 	"userFilter\x12h\n" +
 	"\x11security_protocol\x18\b \x01(\x0e2;.bytebase.store.LDAPIdentityProviderConfig.SecurityProtocolR\x10securityProtocol\x12A\n" +
-	"\rfield_mapping\x18\t \x01(\v2\x1c.bytebase.store.FieldMappingR\ffieldMapping\"O\n" +
+	"\rfield_mapping\x18\t \x01(\v2\x1c.bytebase.store.FieldMappingR\ffieldMapping\x12B\n" +
+	"\n" +
+	"group_sync\x18\n" +
+	" \x01(\v2#.bytebase.store.LDAPGroupSyncConfigR\tgroupSync\"O\n" +
 	"\x10SecurityProtocol\x12!\n" +
 	"\x1dSECURITY_PROTOCOL_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tSTART_TLS\x10\x01\x12\t\n" +
-	"\x05LDAPS\x10\x02\"\x85\x01\n" +
+	"\x05LDAPS\x10\x02\"\xf8\x01\n" +
+	"\x13LDAPGroupSyncConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\"\n" +
+	"\rgroup_base_dn\x18\x02 \x01(\tR\vgroupBaseDn\x12)\n" +
+	"\x10member_attribute\x18\x03 \x01(\tR\x0fmemberAttribute\x12\"\n" +
+	"\ruse_member_of\x18\x04 \x01(\bR\vuseMemberOf\x12\x16\n" +
+	"\x06nested\x18\x05 \x01(\bR\x06nested\x12<\n" +
+	"\bmappings\x18\x06 \x03(\v2 .bytebase.store.LDAPGroupMappingR\bmappings\"[\n" +
+	"\x10LDAPGroupMapping\x12\x19\n" +
+	"\bgroup_dn\x18\x01 \x01(\tR\agroupDn\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\"\xaa\x02\n" +
+	"\x1aSAMLIdentityProviderConfig\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x17\n" +
+	"\asso_url\x18\x02 \x01(\tR\x06ssoUrl\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12%\n" +
+	"\x0esp_certificate\x18\x04 \x01(\tR\rspCertificate\x12$\n" +
+	"\x0esp_private_key\x18\x05 \x01(\tR\fspPrivateKey\x12$\n" +
+	"\x0ename_id_format\x18\x06 \x01(\tR\fnameIdFormat\x12A\n" +
+	"\rfield_mapping\x18\a \x01(\v2\x1c.bytebase.store.FieldMappingR\ffieldMapping\"\x85\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
@@ -818,13 +1138,14 @@ const file_store_idp_proto_rawDesc = "" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x16\n" +
 	"\x06groups\x18\x05 \x03(\tR\x06groups\x12\x1d\n" +
 	"\n" +
-	"has_groups\x18\x06 \x01(\bR\thasGroupsJ\x04\b\x03\x10\x04*^\n" +
+	"has_groups\x18\x06 \x01(\bR\thasGroupsJ\x04\b\x03\x10\x04*h\n" +
 	"\x14IdentityProviderType\x12&\n" +
 	"\"IDENTITY_PROVIDER_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06OAUTH2\x10\x01\x12\b\n" +
 	"\x04OIDC\x10\x02\x12\b\n" +
-	"\x04LDAP\x10\x03*R\n" +
+	"\x04LDAP\x10\x03\x12\b\n" +
+	"\x04SAML\x10\x04*R\n" +
 	"\x0fOAuth2AuthStyle\x12!\n" +
 	"\x1dOAUTH2_AUTH_STYLE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tIN_PARAMS\x10\x01\x12\r\n" +
@@ -843,7 +1164,7 @@ func file_store_idp_proto_rawDescGZIP() []byte {
 }
 
 var file_store_idp_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_store_idp_proto_goTypes = []any{
 	(IdentityProviderType)(0),                        // 0: bytebase.store.IdentityProviderType
 	(OAuth2AuthStyle)(0),                             // 1: bytebase.store.OAuth2AuthStyle
@@ -852,24 +1173,31 @@ var file_store_idp_proto_goTypes = []any{
 	(*OAuth2IdentityProviderConfig)(nil),             // 4: bytebase.store.OAuth2IdentityProviderConfig
 	(*OIDCIdentityProviderConfig)(nil),               // 5: bytebase.store.OIDCIdentityProviderConfig
 	(*LDAPIdentityProviderConfig)(nil),               // 6: bytebase.store.LDAPIdentityProviderConfig
-	(*FieldMapping)(nil),                             // 7: bytebase.store.FieldMapping
-	(*IdentityProviderUserInfo)(nil),                 // 8: bytebase.store.IdentityProviderUserInfo
+	(*LDAPGroupSyncConfig)(nil),                      // 7: bytebase.store.LDAPGroupSyncConfig
+	(*LDAPGroupMapping)(nil),                         // 8: bytebase.store.LDAPGroupMapping
+	(*SAMLIdentityProviderConfig)(nil),               // 9: bytebase.store.SAMLIdentityProviderConfig
+	(*FieldMapping)(nil),                             // 10: bytebase.store.FieldMapping
+	(*IdentityProviderUserInfo)(nil),                 // 11: bytebase.store.IdentityProviderUserInfo
 }
 var file_store_idp_proto_depIdxs = []int32{
-	4, // 0: bytebase.store.IdentityProviderConfig.oauth2_config:type_name -> bytebase.store.OAuth2IdentityProviderConfig
-	5, // 1: bytebase.store.IdentityProviderConfig.oidc_config:type_name -> bytebase.store.OIDCIdentityProviderConfig
-	6, // 2: bytebase.store.IdentityProviderConfig.ldap_config:type_name -> bytebase.store.LDAPIdentityProviderConfig
-	7, // 3: bytebase.store.OAuth2IdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
-	1, // 4: bytebase.store.OAuth2IdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
-	7, // 5: bytebase.store.OIDCIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
-	1, // 6: bytebase.store.OIDCIdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
-	2, // 7: bytebase.store.LDAPIdentityProviderConfig.security_protocol:type_name -> bytebase.store.LDAPIdentityProviderConfig.SecurityProtocol
-	7, // 8: bytebase.store.LDAPIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	4,  // 0: bytebase.store.IdentityProviderConfig.oauth2_config:type_name -> bytebase.store.OAuth2IdentityProviderConfig
+	5,  // 1: bytebase.store.IdentityProviderConfig.oidc_config:type_name -> bytebase.store.OIDCIdentityProviderConfig
+	6,  // 2: bytebase.store.IdentityProviderConfig.ldap_config:type_name -> bytebase.store.LDAPIdentityProviderConfig
+	9,  // 3: bytebase.store.IdentityProviderConfig.saml_config:type_name -> bytebase.store.SAMLIdentityProviderConfig
+	10, // 4: bytebase.store.OAuth2IdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	1,  // 5: bytebase.store.OAuth2IdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
+	10, // 6: bytebase.store.OIDCIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	1,  // 7: bytebase.store.OIDCIdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
+	2,  // 8: bytebase.store.LDAPIdentityProviderConfig.security_protocol:type_name -> bytebase.store.LDAPIdentityProviderConfig.SecurityProtocol
+	10, // 9: bytebase.store.LDAPIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	7,  // 10: bytebase.store.LDAPIdentityProviderConfig.group_sync:type_name -> bytebase.store.LDAPGroupSyncConfig
+	8,  // 11: bytebase.store.LDAPGroupSyncConfig.mappings:type_name -> bytebase.store.LDAPGroupMapping
+	10, // 12: bytebase.store.SAMLIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_store_idp_proto_init() }
@@ -881,6 +1209,7 @@ func file_store_idp_proto_init() {
 		(*IdentityProviderConfig_Oauth2Config)(nil),
 		(*IdentityProviderConfig_OidcConfig)(nil),
 		(*IdentityProviderConfig_LdapConfig)(nil),
+		(*IdentityProviderConfig_SamlConfig)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -888,7 +1217,7 @@ func file_store_idp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_idp_proto_rawDesc), len(file_store_idp_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
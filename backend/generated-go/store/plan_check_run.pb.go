@@ -131,6 +131,177 @@ func (PlanCheckRunResult_Result_Status) EnumDescriptor() ([]byte, []int) {
 	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 0}
 }
 
+type PlanCheckRunResult_Result_StatementLockImpact_LockMode int32
+
+const (
+	PlanCheckRunResult_Result_StatementLockImpact_LOCK_MODE_UNSPECIFIED  PlanCheckRunResult_Result_StatementLockImpact_LockMode = 0
+	PlanCheckRunResult_Result_StatementLockImpact_ACCESS_SHARE           PlanCheckRunResult_Result_StatementLockImpact_LockMode = 1
+	PlanCheckRunResult_Result_StatementLockImpact_ROW_SHARE              PlanCheckRunResult_Result_StatementLockImpact_LockMode = 2
+	PlanCheckRunResult_Result_StatementLockImpact_ROW_EXCLUSIVE          PlanCheckRunResult_Result_StatementLockImpact_LockMode = 3
+	PlanCheckRunResult_Result_StatementLockImpact_SHARE_UPDATE_EXCLUSIVE PlanCheckRunResult_Result_StatementLockImpact_LockMode = 4
+	PlanCheckRunResult_Result_StatementLockImpact_SHARE                  PlanCheckRunResult_Result_StatementLockImpact_LockMode = 5
+	PlanCheckRunResult_Result_StatementLockImpact_SHARE_ROW_EXCLUSIVE    PlanCheckRunResult_Result_StatementLockImpact_LockMode = 6
+	PlanCheckRunResult_Result_StatementLockImpact_EXCLUSIVE              PlanCheckRunResult_Result_StatementLockImpact_LockMode = 7
+	PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE       PlanCheckRunResult_Result_StatementLockImpact_LockMode = 8
+)
+
+// Enum value maps for PlanCheckRunResult_Result_StatementLockImpact_LockMode.
+var (
+	PlanCheckRunResult_Result_StatementLockImpact_LockMode_name = map[int32]string{
+		0: "LOCK_MODE_UNSPECIFIED",
+		1: "ACCESS_SHARE",
+		2: "ROW_SHARE",
+		3: "ROW_EXCLUSIVE",
+		4: "SHARE_UPDATE_EXCLUSIVE",
+		5: "SHARE",
+		6: "SHARE_ROW_EXCLUSIVE",
+		7: "EXCLUSIVE",
+		8: "ACCESS_EXCLUSIVE",
+	}
+	PlanCheckRunResult_Result_StatementLockImpact_LockMode_value = map[string]int32{
+		"LOCK_MODE_UNSPECIFIED":  0,
+		"ACCESS_SHARE":           1,
+		"ROW_SHARE":              2,
+		"ROW_EXCLUSIVE":          3,
+		"SHARE_UPDATE_EXCLUSIVE": 4,
+		"SHARE":                  5,
+		"SHARE_ROW_EXCLUSIVE":    6,
+		"EXCLUSIVE":              7,
+		"ACCESS_EXCLUSIVE":       8,
+	}
+)
+
+func (x PlanCheckRunResult_Result_StatementLockImpact_LockMode) Enum() *PlanCheckRunResult_Result_StatementLockImpact_LockMode {
+	p := new(PlanCheckRunResult_Result_StatementLockImpact_LockMode)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRunResult_Result_StatementLockImpact_LockMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRunResult_Result_StatementLockImpact_LockMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_plan_check_run_proto_enumTypes[2].Descriptor()
+}
+
+func (PlanCheckRunResult_Result_StatementLockImpact_LockMode) Type() protoreflect.EnumType {
+	return &file_store_plan_check_run_proto_enumTypes[2]
+}
+
+func (x PlanCheckRunResult_Result_StatementLockImpact_LockMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_StatementLockImpact_LockMode.Descriptor instead.
+func (PlanCheckRunResult_Result_StatementLockImpact_LockMode) EnumDescriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 3, 0}
+}
+
+type PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk int32
+
+const (
+	PlanCheckRunResult_Result_StatementLockImpact_BLOCKING_RISK_UNSPECIFIED PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk = 0
+	PlanCheckRunResult_Result_StatementLockImpact_LOW                       PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk = 1
+	PlanCheckRunResult_Result_StatementLockImpact_MEDIUM                    PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk = 2
+	PlanCheckRunResult_Result_StatementLockImpact_HIGH                      PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk = 3
+)
+
+// Enum value maps for PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk.
+var (
+	PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk_name = map[int32]string{
+		0: "BLOCKING_RISK_UNSPECIFIED",
+		1: "LOW",
+		2: "MEDIUM",
+		3: "HIGH",
+	}
+	PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk_value = map[string]int32{
+		"BLOCKING_RISK_UNSPECIFIED": 0,
+		"LOW":                       1,
+		"MEDIUM":                    2,
+		"HIGH":                      3,
+	}
+)
+
+func (x PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk) Enum() *PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk {
+	p := new(PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_plan_check_run_proto_enumTypes[3].Descriptor()
+}
+
+func (PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk) Type() protoreflect.EnumType {
+	return &file_store_plan_check_run_proto_enumTypes[3]
+}
+
+func (x PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk.Descriptor instead.
+func (PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk) EnumDescriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 3, 1}
+}
+
+type PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm int32
+
+const (
+	PlanCheckRunResult_Result_StatementOnlineDdl_ALGORITHM_UNSPECIFIED PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm = 0
+	PlanCheckRunResult_Result_StatementOnlineDdl_INSTANT               PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm = 1
+	PlanCheckRunResult_Result_StatementOnlineDdl_INPLACE               PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm = 2
+	PlanCheckRunResult_Result_StatementOnlineDdl_COPY                  PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm = 3
+)
+
+// Enum value maps for PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm.
+var (
+	PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm_name = map[int32]string{
+		0: "ALGORITHM_UNSPECIFIED",
+		1: "INSTANT",
+		2: "INPLACE",
+		3: "COPY",
+	}
+	PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm_value = map[string]int32{
+		"ALGORITHM_UNSPECIFIED": 0,
+		"INSTANT":               1,
+		"INPLACE":               2,
+		"COPY":                  3,
+	}
+)
+
+func (x PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm) Enum() *PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm {
+	p := new(PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_plan_check_run_proto_enumTypes[4].Descriptor()
+}
+
+func (PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm) Type() protoreflect.EnumType {
+	return &file_store_plan_check_run_proto_enumTypes[4]
+}
+
+func (x PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm.Descriptor instead.
+func (PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 5, 0}
+}
+
 type PlanCheckRunConfig struct {
 	state              protoimpl.MessageState                `protogen:"open.v1"`
 	SheetUid           int32                                 `protobuf:"varint,1,opt,name=sheet_uid,json=sheetUid,proto3" json:"sheet_uid,omitempty"`
@@ -288,6 +459,8 @@ type PlanCheckRunResult_Result struct {
 	//
 	//	*PlanCheckRunResult_Result_SqlSummaryReport_
 	//	*PlanCheckRunResult_Result_SqlReviewReport_
+	//	*PlanCheckRunResult_Result_LockImpactReport_
+	//	*PlanCheckRunResult_Result_OnlineDdlReport_
 	Report        isPlanCheckRunResult_Result_Report `protobuf_oneof:"report"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -376,6 +549,24 @@ func (x *PlanCheckRunResult_Result) GetSqlReviewReport() *PlanCheckRunResult_Res
 	return nil
 }
 
+func (x *PlanCheckRunResult_Result) GetLockImpactReport() *PlanCheckRunResult_Result_LockImpactReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRunResult_Result_LockImpactReport_); ok {
+			return x.LockImpactReport
+		}
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result) GetOnlineDdlReport() *PlanCheckRunResult_Result_OnlineDdlReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRunResult_Result_OnlineDdlReport_); ok {
+			return x.OnlineDdlReport
+		}
+	}
+	return nil
+}
+
 type isPlanCheckRunResult_Result_Report interface {
 	isPlanCheckRunResult_Result_Report()
 }
@@ -388,10 +579,22 @@ type PlanCheckRunResult_Result_SqlReviewReport_ struct {
 	SqlReviewReport *PlanCheckRunResult_Result_SqlReviewReport `protobuf:"bytes,6,opt,name=sql_review_report,json=sqlReviewReport,proto3,oneof"`
 }
 
+type PlanCheckRunResult_Result_LockImpactReport_ struct {
+	LockImpactReport *PlanCheckRunResult_Result_LockImpactReport `protobuf:"bytes,7,opt,name=lock_impact_report,json=lockImpactReport,proto3,oneof"`
+}
+
+type PlanCheckRunResult_Result_OnlineDdlReport_ struct {
+	OnlineDdlReport *PlanCheckRunResult_Result_OnlineDdlReport `protobuf:"bytes,8,opt,name=online_ddl_report,json=onlineDdlReport,proto3,oneof"`
+}
+
 func (*PlanCheckRunResult_Result_SqlSummaryReport_) isPlanCheckRunResult_Result_Report() {}
 
 func (*PlanCheckRunResult_Result_SqlReviewReport_) isPlanCheckRunResult_Result_Report() {}
 
+func (*PlanCheckRunResult_Result_LockImpactReport_) isPlanCheckRunResult_Result_Report() {}
+
+func (*PlanCheckRunResult_Result_OnlineDdlReport_) isPlanCheckRunResult_Result_Report() {}
+
 type PlanCheckRunResult_Result_SqlSummaryReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// statement_types are the types of statements that are found in the sql.
@@ -523,6 +726,361 @@ func (x *PlanCheckRunResult_Result_SqlReviewReport) GetEndPosition() *Position {
 	return nil
 }
 
+// LockImpactReport is the lock impact analysis of the DDL statements.
+type PlanCheckRunResult_Result_LockImpactReport struct {
+	state         protoimpl.MessageState                           `protogen:"open.v1"`
+	Statements    []*PlanCheckRunResult_Result_StatementLockImpact `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) Reset() {
+	*x = PlanCheckRunResult_Result_LockImpactReport{}
+	mi := &file_store_plan_check_run_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_LockImpactReport) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_LockImpactReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_LockImpactReport) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 2}
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetStatements() []*PlanCheckRunResult_Result_StatementLockImpact {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// StatementLockImpact is the lock impact of a statement on a table.
+type PlanCheckRunResult_Result_StatementLockImpact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of the statement.
+	StartPosition *Position `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	Statement     string    `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Schema        string    `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table         string    `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The strongest table lock mode acquired by the statement.
+	LockMode PlanCheckRunResult_Result_StatementLockImpact_LockMode `protobuf:"varint,5,opt,name=lock_mode,json=lockMode,proto3,enum=bytebase.store.PlanCheckRunResult_Result_StatementLockImpact_LockMode" json:"lock_mode,omitempty"`
+	// The statement rewrites the whole table while holding the lock.
+	TableRewrite bool `protobuf:"varint,6,opt,name=table_rewrite,json=tableRewrite,proto3" json:"table_rewrite,omitempty"`
+	// The statement scans the whole table while holding the lock.
+	TableScan bool `protobuf:"varint,7,opt,name=table_scan,json=tableScan,proto3" json:"table_scan,omitempty"`
+	// The row count of the table from the synced metadata.
+	TableRows int64 `protobuf:"varint,8,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	// The data size and index size in bytes of the table from the synced metadata.
+	TableSize    int64                                                      `protobuf:"varint,9,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
+	BlockingRisk PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk `protobuf:"varint,10,opt,name=blocking_risk,json=blockingRisk,proto3,enum=bytebase.store.PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk" json:"blocking_risk,omitempty"`
+	// The reasons of the lock mode, rewrite and scan behavior.
+	Reasons       []string `protobuf:"bytes,11,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) Reset() {
+	*x = PlanCheckRunResult_Result_StatementLockImpact{}
+	mi := &file_store_plan_check_run_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_StatementLockImpact) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_StatementLockImpact.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_StatementLockImpact) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 3}
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetLockMode() PlanCheckRunResult_Result_StatementLockImpact_LockMode {
+	if x != nil {
+		return x.LockMode
+	}
+	return PlanCheckRunResult_Result_StatementLockImpact_LOCK_MODE_UNSPECIFIED
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetTableRewrite() bool {
+	if x != nil {
+		return x.TableRewrite
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetTableScan() bool {
+	if x != nil {
+		return x.TableScan
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetTableSize() int64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetBlockingRisk() PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk {
+	if x != nil {
+		return x.BlockingRisk
+	}
+	return PlanCheckRunResult_Result_StatementLockImpact_BLOCKING_RISK_UNSPECIFIED
+}
+
+func (x *PlanCheckRunResult_Result_StatementLockImpact) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// OnlineDdlReport is the online DDL algorithm prediction of the ALTER TABLE statements.
+type PlanCheckRunResult_Result_OnlineDdlReport struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Statements    []*PlanCheckRunResult_Result_StatementOnlineDdl `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_OnlineDdlReport) Reset() {
+	*x = PlanCheckRunResult_Result_OnlineDdlReport{}
+	mi := &file_store_plan_check_run_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_OnlineDdlReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_OnlineDdlReport) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_OnlineDdlReport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_OnlineDdlReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_OnlineDdlReport) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 4}
+}
+
+func (x *PlanCheckRunResult_Result_OnlineDdlReport) GetStatements() []*PlanCheckRunResult_Result_StatementOnlineDdl {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// StatementOnlineDdl is the predicted online DDL behavior of an ALTER TABLE statement.
+type PlanCheckRunResult_Result_StatementOnlineDdl struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of the statement.
+	StartPosition *Position `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	Statement     string    `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Database      string    `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table         string    `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The algorithm MySQL is expected to choose for the statement.
+	Algorithm PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm `protobuf:"varint,5,opt,name=algorithm,proto3,enum=bytebase.store.PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm" json:"algorithm,omitempty"`
+	// The statement rebuilds the table.
+	TableRebuild bool `protobuf:"varint,6,opt,name=table_rebuild,json=tableRebuild,proto3" json:"table_rebuild,omitempty"`
+	// The statement permits concurrent DML while it is running.
+	ConcurrentDml bool `protobuf:"varint,7,opt,name=concurrent_dml,json=concurrentDml,proto3" json:"concurrent_dml,omitempty"`
+	// The row count of the table from the synced metadata.
+	TableRows int64 `protobuf:"varint,8,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	// The data size and index size in bytes of the table from the synced metadata.
+	TableSize int64 `protobuf:"varint,9,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
+	// Switching to the gh-ost migration is suggested because the table is copied while blocking writes.
+	SuggestGhost bool `protobuf:"varint,10,opt,name=suggest_ghost,json=suggestGhost,proto3" json:"suggest_ghost,omitempty"`
+	// The reasons of the predicted algorithm.
+	Reasons       []string `protobuf:"bytes,11,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) Reset() {
+	*x = PlanCheckRunResult_Result_StatementOnlineDdl{}
+	mi := &file_store_plan_check_run_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_StatementOnlineDdl) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_StatementOnlineDdl.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_StatementOnlineDdl) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 5}
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetAlgorithm() PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return PlanCheckRunResult_Result_StatementOnlineDdl_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetTableRebuild() bool {
+	if x != nil {
+		return x.TableRebuild
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetConcurrentDml() bool {
+	if x != nil {
+		return x.ConcurrentDml
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetTableSize() int64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetSuggestGhost() bool {
+	if x != nil {
+		return x.SuggestGhost
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_StatementOnlineDdl) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
 var File_store_plan_check_run_proto protoreflect.FileDescriptor
 
 const file_store_plan_check_run_proto_rawDesc = "" +
@@ -549,17 +1107,19 @@ const file_store_plan_check_run_proto_rawDesc = "" +
 	"\tDDL_GHOST\x10\x04\x12\x0e\n" +
 	"\n" +
 	"SQL_EDITOR\x10\x05B\x15\n" +
-	"\x13_database_group_uid\"\xb0\a\n" +
+	"\x13_database_group_uid\"\xb2\x15\n" +
 	"\x12PlanCheckRunResult\x12C\n" +
 	"\aresults\x18\x01 \x03(\v2).bytebase.store.PlanCheckRunResult.ResultR\aresults\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\x1a\xbe\x06\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x1a\xc0\x14\n" +
 	"\x06Result\x12H\n" +
 	"\x06status\x18\x01 \x01(\x0e20.bytebase.store.PlanCheckRunResult.Result.StatusR\x06status\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
 	"\x04code\x18\x04 \x01(\x05R\x04code\x12j\n" +
 	"\x12sql_summary_report\x18\x05 \x01(\v2:.bytebase.store.PlanCheckRunResult.Result.SqlSummaryReportH\x00R\x10sqlSummaryReport\x12g\n" +
-	"\x11sql_review_report\x18\x06 \x01(\v29.bytebase.store.PlanCheckRunResult.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x1a\xb5\x01\n" +
+	"\x11sql_review_report\x18\x06 \x01(\v29.bytebase.store.PlanCheckRunResult.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x12j\n" +
+	"\x12lock_impact_report\x18\a \x01(\v2:.bytebase.store.PlanCheckRunResult.Result.LockImpactReportH\x00R\x10lockImpactReport\x12g\n" +
+	"\x11online_ddl_report\x18\b \x01(\v29.bytebase.store.PlanCheckRunResult.Result.OnlineDdlReportH\x00R\x0fonlineDdlReport\x1a\xb5\x01\n" +
 	"\x10SqlSummaryReport\x12'\n" +
 	"\x0fstatement_types\x18\x02 \x03(\tR\x0estatementTypes\x12#\n" +
 	"\raffected_rows\x18\x03 \x01(\x05R\faffectedRows\x12M\n" +
@@ -568,7 +1128,67 @@ const file_store_plan_check_run_proto_rawDesc = "" +
 	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
 	"\x06column\x18\x02 \x01(\x05R\x06column\x12?\n" +
 	"\x0estart_position\x18\b \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
-	"\fend_position\x18\t \x01(\v2\x18.bytebase.store.PositionR\vendPositionJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"E\n" +
+	"\fend_position\x18\t \x01(\v2\x18.bytebase.store.PositionR\vendPositionJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\x1aq\n" +
+	"\x10LockImpactReport\x12]\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v2=.bytebase.store.PlanCheckRunResult.Result.StatementLockImpactR\n" +
+	"statements\x1a\xa3\x06\n" +
+	"\x13StatementLockImpact\x12?\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12\x1c\n" +
+	"\tstatement\x18\x02 \x01(\tR\tstatement\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x04 \x01(\tR\x05table\x12c\n" +
+	"\tlock_mode\x18\x05 \x01(\x0e2F.bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.LockModeR\blockMode\x12#\n" +
+	"\rtable_rewrite\x18\x06 \x01(\bR\ftableRewrite\x12\x1d\n" +
+	"\n" +
+	"table_scan\x18\a \x01(\bR\ttableScan\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\b \x01(\x03R\ttableRows\x12\x1d\n" +
+	"\n" +
+	"table_size\x18\t \x01(\x03R\ttableSize\x12o\n" +
+	"\rblocking_risk\x18\n" +
+	" \x01(\x0e2J.bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.BlockingRiskR\fblockingRisk\x12\x18\n" +
+	"\areasons\x18\v \x03(\tR\areasons\"\xbe\x01\n" +
+	"\bLockMode\x12\x19\n" +
+	"\x15LOCK_MODE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fACCESS_SHARE\x10\x01\x12\r\n" +
+	"\tROW_SHARE\x10\x02\x12\x11\n" +
+	"\rROW_EXCLUSIVE\x10\x03\x12\x1a\n" +
+	"\x16SHARE_UPDATE_EXCLUSIVE\x10\x04\x12\t\n" +
+	"\x05SHARE\x10\x05\x12\x17\n" +
+	"\x13SHARE_ROW_EXCLUSIVE\x10\x06\x12\r\n" +
+	"\tEXCLUSIVE\x10\a\x12\x14\n" +
+	"\x10ACCESS_EXCLUSIVE\x10\b\"L\n" +
+	"\fBlockingRisk\x12\x1d\n" +
+	"\x19BLOCKING_RISK_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03LOW\x10\x01\x12\n" +
+	"\n" +
+	"\x06MEDIUM\x10\x02\x12\b\n" +
+	"\x04HIGH\x10\x03\x1ao\n" +
+	"\x0fOnlineDdlReport\x12\\\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v2<.bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdlR\n" +
+	"statements\x1a\xa0\x04\n" +
+	"\x12StatementOnlineDdl\x12?\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12\x1c\n" +
+	"\tstatement\x18\x02 \x01(\tR\tstatement\x12\x1a\n" +
+	"\bdatabase\x18\x03 \x01(\tR\bdatabase\x12\x14\n" +
+	"\x05table\x18\x04 \x01(\tR\x05table\x12d\n" +
+	"\talgorithm\x18\x05 \x01(\x0e2F.bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl.AlgorithmR\talgorithm\x12#\n" +
+	"\rtable_rebuild\x18\x06 \x01(\bR\ftableRebuild\x12%\n" +
+	"\x0econcurrent_dml\x18\a \x01(\bR\rconcurrentDml\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\b \x01(\x03R\ttableRows\x12\x1d\n" +
+	"\n" +
+	"table_size\x18\t \x01(\x03R\ttableSize\x12#\n" +
+	"\rsuggest_ghost\x18\n" +
+	" \x01(\bR\fsuggestGhost\x12\x18\n" +
+	"\areasons\x18\v \x03(\tR\areasons\"J\n" +
+	"\tAlgorithm\x12\x19\n" +
+	"\x15ALGORITHM_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aINSTANT\x10\x01\x12\v\n" +
+	"\aINPLACE\x10\x02\x12\b\n" +
+	"\x04COPY\x10\x03\"E\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ERROR\x10\x01\x12\v\n" +
@@ -588,35 +1208,51 @@ func file_store_plan_check_run_proto_rawDescGZIP() []byte {
 	return file_store_plan_check_run_proto_rawDescData
 }
 
-var file_store_plan_check_run_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_plan_check_run_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_store_plan_check_run_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_store_plan_check_run_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_plan_check_run_proto_goTypes = []any{
-	(PlanCheckRunConfig_ChangeDatabaseType)(0),         // 0: bytebase.store.PlanCheckRunConfig.ChangeDatabaseType
-	(PlanCheckRunResult_Result_Status)(0),              // 1: bytebase.store.PlanCheckRunResult.Result.Status
-	(*PlanCheckRunConfig)(nil),                         // 2: bytebase.store.PlanCheckRunConfig
-	(*PlanCheckRunResult)(nil),                         // 3: bytebase.store.PlanCheckRunResult
-	nil,                                                // 4: bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
-	(*PlanCheckRunResult_Result)(nil),                  // 5: bytebase.store.PlanCheckRunResult.Result
-	(*PlanCheckRunResult_Result_SqlSummaryReport)(nil), // 6: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
-	(*PlanCheckRunResult_Result_SqlReviewReport)(nil),  // 7: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
-	(*ChangedResources)(nil),                           // 8: bytebase.store.ChangedResources
-	(*Position)(nil),                                   // 9: bytebase.store.Position
+	(PlanCheckRunConfig_ChangeDatabaseType)(0),                      // 0: bytebase.store.PlanCheckRunConfig.ChangeDatabaseType
+	(PlanCheckRunResult_Result_Status)(0),                           // 1: bytebase.store.PlanCheckRunResult.Result.Status
+	(PlanCheckRunResult_Result_StatementLockImpact_LockMode)(0),     // 2: bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.LockMode
+	(PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk)(0), // 3: bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.BlockingRisk
+	(PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm)(0),     // 4: bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl.Algorithm
+	(*PlanCheckRunConfig)(nil),                                      // 5: bytebase.store.PlanCheckRunConfig
+	(*PlanCheckRunResult)(nil),                                      // 6: bytebase.store.PlanCheckRunResult
+	nil,                                                             // 7: bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
+	(*PlanCheckRunResult_Result)(nil),                               // 8: bytebase.store.PlanCheckRunResult.Result
+	(*PlanCheckRunResult_Result_SqlSummaryReport)(nil),              // 9: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
+	(*PlanCheckRunResult_Result_SqlReviewReport)(nil),               // 10: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
+	(*PlanCheckRunResult_Result_LockImpactReport)(nil),              // 11: bytebase.store.PlanCheckRunResult.Result.LockImpactReport
+	(*PlanCheckRunResult_Result_StatementLockImpact)(nil),           // 12: bytebase.store.PlanCheckRunResult.Result.StatementLockImpact
+	(*PlanCheckRunResult_Result_OnlineDdlReport)(nil),               // 13: bytebase.store.PlanCheckRunResult.Result.OnlineDdlReport
+	(*PlanCheckRunResult_Result_StatementOnlineDdl)(nil),            // 14: bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl
+	(*ChangedResources)(nil),                                        // 15: bytebase.store.ChangedResources
+	(*Position)(nil),                                                // 16: bytebase.store.Position
 }
 var file_store_plan_check_run_proto_depIdxs = []int32{
-	0, // 0: bytebase.store.PlanCheckRunConfig.change_database_type:type_name -> bytebase.store.PlanCheckRunConfig.ChangeDatabaseType
-	4, // 1: bytebase.store.PlanCheckRunConfig.ghost_flags:type_name -> bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
-	5, // 2: bytebase.store.PlanCheckRunResult.results:type_name -> bytebase.store.PlanCheckRunResult.Result
-	1, // 3: bytebase.store.PlanCheckRunResult.Result.status:type_name -> bytebase.store.PlanCheckRunResult.Result.Status
-	6, // 4: bytebase.store.PlanCheckRunResult.Result.sql_summary_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
-	7, // 5: bytebase.store.PlanCheckRunResult.Result.sql_review_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
-	8, // 6: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.store.ChangedResources
-	9, // 7: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.start_position:type_name -> bytebase.store.Position
-	9, // 8: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.end_position:type_name -> bytebase.store.Position
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: bytebase.store.PlanCheckRunConfig.change_database_type:type_name -> bytebase.store.PlanCheckRunConfig.ChangeDatabaseType
+	7,  // 1: bytebase.store.PlanCheckRunConfig.ghost_flags:type_name -> bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
+	8,  // 2: bytebase.store.PlanCheckRunResult.results:type_name -> bytebase.store.PlanCheckRunResult.Result
+	1,  // 3: bytebase.store.PlanCheckRunResult.Result.status:type_name -> bytebase.store.PlanCheckRunResult.Result.Status
+	9,  // 4: bytebase.store.PlanCheckRunResult.Result.sql_summary_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
+	10, // 5: bytebase.store.PlanCheckRunResult.Result.sql_review_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
+	11, // 6: bytebase.store.PlanCheckRunResult.Result.lock_impact_report:type_name -> bytebase.store.PlanCheckRunResult.Result.LockImpactReport
+	13, // 7: bytebase.store.PlanCheckRunResult.Result.online_ddl_report:type_name -> bytebase.store.PlanCheckRunResult.Result.OnlineDdlReport
+	15, // 8: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.store.ChangedResources
+	16, // 9: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.start_position:type_name -> bytebase.store.Position
+	16, // 10: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.end_position:type_name -> bytebase.store.Position
+	12, // 11: bytebase.store.PlanCheckRunResult.Result.LockImpactReport.statements:type_name -> bytebase.store.PlanCheckRunResult.Result.StatementLockImpact
+	16, // 12: bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.start_position:type_name -> bytebase.store.Position
+	2,  // 13: bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.lock_mode:type_name -> bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.LockMode
+	3,  // 14: bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.blocking_risk:type_name -> bytebase.store.PlanCheckRunResult.Result.StatementLockImpact.BlockingRisk
+	14, // 15: bytebase.store.PlanCheckRunResult.Result.OnlineDdlReport.statements:type_name -> bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl
+	16, // 16: bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl.start_position:type_name -> bytebase.store.Position
+	4,  // 17: bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl.algorithm:type_name -> bytebase.store.PlanCheckRunResult.Result.StatementOnlineDdl.Algorithm
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_store_plan_check_run_proto_init() }
@@ -630,14 +1266,16 @@ func file_store_plan_check_run_proto_init() {
 	file_store_plan_check_run_proto_msgTypes[3].OneofWrappers = []any{
 		(*PlanCheckRunResult_Result_SqlSummaryReport_)(nil),
 		(*PlanCheckRunResult_Result_SqlReviewReport_)(nil),
+		(*PlanCheckRunResult_Result_LockImpactReport_)(nil),
+		(*PlanCheckRunResult_Result_OnlineDdlReport_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_plan_check_run_proto_rawDesc), len(file_store_plan_check_run_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   6,
+			NumEnums:      5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,8 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,8 +29,20 @@ type ProjectWebhookPayload struct {
 	// to the persons and url will be ignored.
 	// IM integration setting should be set for this function to work.
 	DirectMessage bool `protobuf:"varint,1,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// signing_secret is the secret used to sign the payloads of custom webhooks.
+	// The payloads are not signed if it's empty.
+	// It's stored as obfuscated_signing_secret and never persisted in plain text.
+	SigningSecret string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// previous_signing_secret is the signing secret before the latest rotation.
+	// Payloads are also signed with it until previous_signing_secret_expire_time,
+	// so that receivers have a grace period to switch to the new secret.
+	// It's stored as obfuscated_previous_signing_secret and never persisted in plain text.
+	PreviousSigningSecret           string                 `protobuf:"bytes,3,opt,name=previous_signing_secret,json=previousSigningSecret,proto3" json:"previous_signing_secret,omitempty"`
+	PreviousSigningSecretExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_signing_secret_expire_time,json=previousSigningSecretExpireTime,proto3" json:"previous_signing_secret_expire_time,omitempty"`
+	ObfuscatedSigningSecret         string                 `protobuf:"bytes,5,opt,name=obfuscated_signing_secret,json=obfuscatedSigningSecret,proto3" json:"obfuscated_signing_secret,omitempty"`
+	ObfuscatedPreviousSigningSecret string                 `protobuf:"bytes,6,opt,name=obfuscated_previous_signing_secret,json=obfuscatedPreviousSigningSecret,proto3" json:"obfuscated_previous_signing_secret,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
 func (x *ProjectWebhookPayload) Reset() {
@@ -68,13 +82,726 @@ func (x *ProjectWebhookPayload) GetDirectMessage() bool {
 	return false
 }
 
+func (x *ProjectWebhookPayload) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *ProjectWebhookPayload) GetPreviousSigningSecret() string {
+	if x != nil {
+		return x.PreviousSigningSecret
+	}
+	return ""
+}
+
+func (x *ProjectWebhookPayload) GetPreviousSigningSecretExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousSigningSecretExpireTime
+	}
+	return nil
+}
+
+func (x *ProjectWebhookPayload) GetObfuscatedSigningSecret() string {
+	if x != nil {
+		return x.ObfuscatedSigningSecret
+	}
+	return ""
+}
+
+func (x *ProjectWebhookPayload) GetObfuscatedPreviousSigningSecret() string {
+	if x != nil {
+		return x.ObfuscatedPreviousSigningSecret
+	}
+	return ""
+}
+
+type WebhookDeliveryPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The snapshot of the webhook event when the delivery is created.
+	Event *WebhookEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The delivery attempts in chronological order.
+	Attempts      []*WebhookDeliveryAttempt `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryPayload) Reset() {
+	*x = WebhookDeliveryPayload{}
+	mi := &file_store_project_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryPayload) ProtoMessage() {}
+
+func (x *WebhookDeliveryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryPayload.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryPayload) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookDeliveryPayload) GetEvent() *WebhookEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryPayload) GetAttempts() []*WebhookDeliveryAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type WebhookEvent struct {
+	state       protoimpl.MessageState   `protogen:"open.v1"`
+	Level       string                   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	EventType   string                   `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Title       string                   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	TitleZh     string                   `protobuf:"bytes,4,opt,name=title_zh,json=titleZh,proto3" json:"title_zh,omitempty"`
+	Description string                   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Link        string                   `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	ActorId     int32                    `protobuf:"varint,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName   string                   `protobuf:"bytes,8,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	ActorEmail  string                   `protobuf:"bytes,9,opt,name=actor_email,json=actorEmail,proto3" json:"actor_email,omitempty"`
+	CreateTime  *timestamppb.Timestamp   `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Issue       *WebhookEvent_Issue      `protobuf:"bytes,11,opt,name=issue,proto3" json:"issue,omitempty"`
+	Rollout     *WebhookEvent_Rollout    `protobuf:"bytes,12,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	StageName   string                   `protobuf:"bytes,13,opt,name=stage_name,json=stageName,proto3" json:"stage_name,omitempty"`
+	Project     *WebhookEvent_Project    `protobuf:"bytes,14,opt,name=project,proto3" json:"project,omitempty"`
+	TaskResult  *WebhookEvent_TaskResult `protobuf:"bytes,15,opt,name=task_result,json=taskResult,proto3" json:"task_result,omitempty"`
+	// The principal ids of the end users that should be mentioned.
+	MentionEndUserIds   []int32                   `protobuf:"varint,16,rep,packed,name=mention_end_user_ids,json=mentionEndUserIds,proto3" json:"mention_end_user_ids,omitempty"`
+	MentionUsersByPhone []string                  `protobuf:"bytes,17,rep,name=mention_users_by_phone,json=mentionUsersByPhone,proto3" json:"mention_users_by_phone,omitempty"`
+	SchemaDrift         *WebhookEvent_SchemaDrift `protobuf:"bytes,18,opt,name=schema_drift,json=schemaDrift,proto3" json:"schema_drift,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
+	mi := &file_store_project_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent) ProtoMessage() {}
+
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookEvent) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetTitleZh() string {
+	if x != nil {
+		return x.TitleZh
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetActorEmail() string {
+	if x != nil {
+		return x.ActorEmail
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetIssue() *WebhookEvent_Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetRollout() *WebhookEvent_Rollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetStageName() string {
+	if x != nil {
+		return x.StageName
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetProject() *WebhookEvent_Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetTaskResult() *WebhookEvent_TaskResult {
+	if x != nil {
+		return x.TaskResult
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetMentionEndUserIds() []int32 {
+	if x != nil {
+		return x.MentionEndUserIds
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetMentionUsersByPhone() []string {
+	if x != nil {
+		return x.MentionUsersByPhone
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetSchemaDrift() *WebhookEvent_SchemaDrift {
+	if x != nil {
+		return x.SchemaDrift
+	}
+	return nil
+}
+
+type WebhookDeliveryAttempt struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The HTTP status code returned by the receiver, 0 if unknown.
+	StatusCode int32                `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Latency    *durationpb.Duration `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The error message, empty if the attempt succeeded.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryAttempt) Reset() {
+	*x = WebhookDeliveryAttempt{}
+	mi := &file_store_project_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryAttempt) ProtoMessage() {}
+
+func (x *WebhookDeliveryAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryAttempt) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookDeliveryAttempt) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryAttempt) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WebhookEvent_Issue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatorId     int32                  `protobuf:"varint,6,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEvent_Issue) Reset() {
+	*x = WebhookEvent_Issue{}
+	mi := &file_store_project_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent_Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent_Issue) ProtoMessage() {}
+
+func (x *WebhookEvent_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent_Issue.ProtoReflect.Descriptor instead.
+func (*WebhookEvent_Issue) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *WebhookEvent_Issue) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookEvent_Issue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookEvent_Issue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookEvent_Issue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WebhookEvent_Issue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookEvent_Issue) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+type WebhookEvent_Rollout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int32                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEvent_Rollout) Reset() {
+	*x = WebhookEvent_Rollout{}
+	mi := &file_store_project_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent_Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent_Rollout) ProtoMessage() {}
+
+func (x *WebhookEvent_Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent_Rollout.ProtoReflect.Descriptor instead.
+func (*WebhookEvent_Rollout) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *WebhookEvent_Rollout) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *WebhookEvent_Rollout) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type WebhookEvent_Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEvent_Project) Reset() {
+	*x = WebhookEvent_Project{}
+	mi := &file_store_project_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent_Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent_Project) ProtoMessage() {}
+
+func (x *WebhookEvent_Project) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent_Project.ProtoReflect.Descriptor instead.
+func (*WebhookEvent_Project) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *WebhookEvent_Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookEvent_Project) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type WebhookEvent_TaskResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	SkippedReason string                 `protobuf:"bytes,4,opt,name=skipped_reason,json=skippedReason,proto3" json:"skipped_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEvent_TaskResult) Reset() {
+	*x = WebhookEvent_TaskResult{}
+	mi := &file_store_project_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent_TaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent_TaskResult) ProtoMessage() {}
+
+func (x *WebhookEvent_TaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent_TaskResult.ProtoReflect.Descriptor instead.
+func (*WebhookEvent_TaskResult) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *WebhookEvent_TaskResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookEvent_TaskResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookEvent_TaskResult) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *WebhookEvent_TaskResult) GetSkippedReason() string {
+	if x != nil {
+		return x.SkippedReason
+	}
+	return ""
+}
+
+type WebhookEvent_SchemaDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: instances/{instance}/databases/{database}
+	Database      string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Objects       []string `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEvent_SchemaDrift) Reset() {
+	*x = WebhookEvent_SchemaDrift{}
+	mi := &file_store_project_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent_SchemaDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent_SchemaDrift) ProtoMessage() {}
+
+func (x *WebhookEvent_SchemaDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent_SchemaDrift.ProtoReflect.Descriptor instead.
+func (*WebhookEvent_SchemaDrift) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *WebhookEvent_SchemaDrift) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *WebhookEvent_SchemaDrift) GetObjects() []string {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
 var File_store_project_webhook_proto protoreflect.FileDescriptor
 
 const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
-	"\x1bstore/project_webhook.proto\x12\x0ebytebase.store\">\n" +
+	"\x1bstore/project_webhook.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x03\n" +
 	"\x15ProjectWebhookPayload\x12%\n" +
-	"\x0edirect_message\x18\x01 \x01(\bR\rdirectMessageB\x14Z\x12generated-go/storeb\x06proto3"
+	"\x0edirect_message\x18\x01 \x01(\bR\rdirectMessage\x12%\n" +
+	"\x0esigning_secret\x18\x02 \x01(\tR\rsigningSecret\x126\n" +
+	"\x17previous_signing_secret\x18\x03 \x01(\tR\x15previousSigningSecret\x12h\n" +
+	"#previous_signing_secret_expire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x1fpreviousSigningSecretExpireTime\x12:\n" +
+	"\x19obfuscated_signing_secret\x18\x05 \x01(\tR\x17obfuscatedSigningSecret\x12K\n" +
+	"\"obfuscated_previous_signing_secret\x18\x06 \x01(\tR\x1fobfuscatedPreviousSigningSecret\"\x90\x01\n" +
+	"\x16WebhookDeliveryPayload\x122\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.bytebase.store.WebhookEventR\x05event\x12B\n" +
+	"\battempts\x18\x02 \x03(\v2&.bytebase.store.WebhookDeliveryAttemptR\battempts\"\xd9\t\n" +
+	"\fWebhookEvent\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x19\n" +
+	"\btitle_zh\x18\x04 \x01(\tR\atitleZh\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04link\x18\x06 \x01(\tR\x04link\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\x05R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\b \x01(\tR\tactorName\x12\x1f\n" +
+	"\vactor_email\x18\t \x01(\tR\n" +
+	"actorEmail\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x128\n" +
+	"\x05issue\x18\v \x01(\v2\".bytebase.store.WebhookEvent.IssueR\x05issue\x12>\n" +
+	"\arollout\x18\f \x01(\v2$.bytebase.store.WebhookEvent.RolloutR\arollout\x12\x1d\n" +
+	"\n" +
+	"stage_name\x18\r \x01(\tR\tstageName\x12>\n" +
+	"\aproject\x18\x0e \x01(\v2$.bytebase.store.WebhookEvent.ProjectR\aproject\x12H\n" +
+	"\vtask_result\x18\x0f \x01(\v2'.bytebase.store.WebhookEvent.TaskResultR\n" +
+	"taskResult\x12/\n" +
+	"\x14mention_end_user_ids\x18\x10 \x03(\x05R\x11mentionEndUserIds\x123\n" +
+	"\x16mention_users_by_phone\x18\x11 \x03(\tR\x13mentionUsersByPhone\x12K\n" +
+	"\fschema_drift\x18\x12 \x01(\v2(.bytebase.store.WebhookEvent.SchemaDriftR\vschemaDrift\x1a\x98\x01\n" +
+	"\x05Issue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x06 \x01(\x05R\tcreatorId\x1a1\n" +
+	"\aRollout\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x05R\x03uid\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x1a3\n" +
+	"\aProject\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x1aw\n" +
+	"\n" +
+	"TaskResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12%\n" +
+	"\x0eskipped_reason\x18\x04 \x01(\tR\rskippedReason\x1aC\n" +
+	"\vSchemaDrift\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x18\n" +
+	"\aobjects\x18\x02 \x03(\tR\aobjects\"\xc1\x01\n" +
+	"\x16WebhookDeliveryAttempt\x12;\n" +
+	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x123\n" +
+	"\alatency\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05errorB\x14Z\x12generated-go/storeb\x06proto3"
 
 var (
 	file_store_project_webhook_proto_rawDescOnce sync.Once
@@ -88,16 +815,37 @@ func file_store_project_webhook_proto_rawDescGZIP() []byte {
 	return file_store_project_webhook_proto_rawDescData
 }
 
-var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_store_project_webhook_proto_goTypes = []any{
-	(*ProjectWebhookPayload)(nil), // 0: bytebase.store.ProjectWebhookPayload
+	(*ProjectWebhookPayload)(nil),    // 0: bytebase.store.ProjectWebhookPayload
+	(*WebhookDeliveryPayload)(nil),   // 1: bytebase.store.WebhookDeliveryPayload
+	(*WebhookEvent)(nil),             // 2: bytebase.store.WebhookEvent
+	(*WebhookDeliveryAttempt)(nil),   // 3: bytebase.store.WebhookDeliveryAttempt
+	(*WebhookEvent_Issue)(nil),       // 4: bytebase.store.WebhookEvent.Issue
+	(*WebhookEvent_Rollout)(nil),     // 5: bytebase.store.WebhookEvent.Rollout
+	(*WebhookEvent_Project)(nil),     // 6: bytebase.store.WebhookEvent.Project
+	(*WebhookEvent_TaskResult)(nil),  // 7: bytebase.store.WebhookEvent.TaskResult
+	(*WebhookEvent_SchemaDrift)(nil), // 8: bytebase.store.WebhookEvent.SchemaDrift
+	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 10: google.protobuf.Duration
 }
 var file_store_project_webhook_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9,  // 0: bytebase.store.ProjectWebhookPayload.previous_signing_secret_expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 1: bytebase.store.WebhookDeliveryPayload.event:type_name -> bytebase.store.WebhookEvent
+	3,  // 2: bytebase.store.WebhookDeliveryPayload.attempts:type_name -> bytebase.store.WebhookDeliveryAttempt
+	9,  // 3: bytebase.store.WebhookEvent.create_time:type_name -> google.protobuf.Timestamp
+	4,  // 4: bytebase.store.WebhookEvent.issue:type_name -> bytebase.store.WebhookEvent.Issue
+	5,  // 5: bytebase.store.WebhookEvent.rollout:type_name -> bytebase.store.WebhookEvent.Rollout
+	6,  // 6: bytebase.store.WebhookEvent.project:type_name -> bytebase.store.WebhookEvent.Project
+	7,  // 7: bytebase.store.WebhookEvent.task_result:type_name -> bytebase.store.WebhookEvent.TaskResult
+	8,  // 8: bytebase.store.WebhookEvent.schema_drift:type_name -> bytebase.store.WebhookEvent.SchemaDrift
+	9,  // 9: bytebase.store.WebhookDeliveryAttempt.create_time:type_name -> google.protobuf.Timestamp
+	10, // 10: bytebase.store.WebhookDeliveryAttempt.latency:type_name -> google.protobuf.Duration
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_store_project_webhook_proto_init() }
@@ -111,7 +859,7 @@ func file_store_project_webhook_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_project_webhook_proto_rawDesc), len(file_store_project_webhook_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
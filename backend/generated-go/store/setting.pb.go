@@ -349,8 +349,11 @@ type WorkspaceProfileSetting struct {
 	DatabaseChangeMode DatabaseChangeMode `protobuf:"varint,11,opt,name=database_change_mode,json=databaseChangeMode,proto3,enum=bytebase.store.DatabaseChangeMode" json:"database_change_mode,omitempty"`
 	// Whether to disallow password signin. (Except workspace admins)
 	DisallowPasswordSignin bool `protobuf:"varint,12,opt,name=disallow_password_signin,json=disallowPasswordSignin,proto3" json:"disallow_password_signin,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Require WebAuthn as the second factor for users having any of the workspace permissions, e.g. bb.databases.update.
+	// TOTP and recovery codes are not accepted for these users.
+	WebauthnRequiredPermissions []string `protobuf:"bytes,13,rep,name=webauthn_required_permissions,json=webauthnRequiredPermissions,proto3" json:"webauthn_required_permissions,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *WorkspaceProfileSetting) Reset() {
@@ -453,6 +456,13 @@ func (x *WorkspaceProfileSetting) GetDisallowPasswordSignin() bool {
 	return false
 }
 
+func (x *WorkspaceProfileSetting) GetWebauthnRequiredPermissions() []string {
+	if x != nil {
+		return x.WebauthnRequiredPermissions
+	}
+	return nil
+}
+
 type Announcement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The alert level of announcemnt
@@ -716,6 +726,10 @@ type Algorithm struct {
 	//	*Algorithm_RangeMask_
 	//	*Algorithm_Md5Mask
 	//	*Algorithm_InnerOuterMask_
+	//	*Algorithm_FormatPreservingMask_
+	//	*Algorithm_HmacMask_
+	//	*Algorithm_DateShiftMask_
+	//	*Algorithm_NumericBucketMask_
 	Mask          isAlgorithm_Mask `protobuf_oneof:"mask"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -794,6 +808,42 @@ func (x *Algorithm) GetInnerOuterMask() *Algorithm_InnerOuterMask {
 	return nil
 }
 
+func (x *Algorithm) GetFormatPreservingMask() *Algorithm_FormatPreservingMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_FormatPreservingMask_); ok {
+			return x.FormatPreservingMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetHmacMask() *Algorithm_HmacMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_HmacMask_); ok {
+			return x.HmacMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDateShiftMask() *Algorithm_DateShiftMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_DateShiftMask_); ok {
+			return x.DateShiftMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericBucketMask() *Algorithm_NumericBucketMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericBucketMask_); ok {
+			return x.NumericBucketMask
+		}
+	}
+	return nil
+}
+
 type isAlgorithm_Mask interface {
 	isAlgorithm_Mask()
 }
@@ -814,6 +864,22 @@ type Algorithm_InnerOuterMask_ struct {
 	InnerOuterMask *Algorithm_InnerOuterMask `protobuf:"bytes,8,opt,name=inner_outer_mask,json=innerOuterMask,proto3,oneof"`
 }
 
+type Algorithm_FormatPreservingMask_ struct {
+	FormatPreservingMask *Algorithm_FormatPreservingMask `protobuf:"bytes,9,opt,name=format_preserving_mask,json=formatPreservingMask,proto3,oneof"`
+}
+
+type Algorithm_HmacMask_ struct {
+	HmacMask *Algorithm_HmacMask `protobuf:"bytes,10,opt,name=hmac_mask,json=hmacMask,proto3,oneof"`
+}
+
+type Algorithm_DateShiftMask_ struct {
+	DateShiftMask *Algorithm_DateShiftMask `protobuf:"bytes,11,opt,name=date_shift_mask,json=dateShiftMask,proto3,oneof"`
+}
+
+type Algorithm_NumericBucketMask_ struct {
+	NumericBucketMask *Algorithm_NumericBucketMask `protobuf:"bytes,12,opt,name=numeric_bucket_mask,json=numericBucketMask,proto3,oneof"`
+}
+
 func (*Algorithm_FullMask_) isAlgorithm_Mask() {}
 
 func (*Algorithm_RangeMask_) isAlgorithm_Mask() {}
@@ -822,6 +888,14 @@ func (*Algorithm_Md5Mask) isAlgorithm_Mask() {}
 
 func (*Algorithm_InnerOuterMask_) isAlgorithm_Mask() {}
 
+func (*Algorithm_FormatPreservingMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_HmacMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_DateShiftMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericBucketMask_) isAlgorithm_Mask() {}
+
 type AppIMSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slack         *AppIMSetting_Slack    `protobuf:"bytes,1,opt,name=slack,proto3" json:"slack,omitempty"`
@@ -1996,6 +2070,249 @@ func (x *Algorithm_InnerOuterMask) GetType() Algorithm_InnerOuterMask_MaskType {
 	return Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED
 }
 
+// FormatPreservingMask replaces the digits and letters with the keyed format-preserving encryption (FF1),
+// the other characters are kept, so the same value is always masked to the same value in the same format.
+type Algorithm_FormatPreservingMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the secret key of the encryption.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// tweak is mixed into the encryption, the same value is masked differently with different tweaks.
+	Tweak         string `protobuf:"bytes,2,opt,name=tweak,proto3" json:"tweak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_FormatPreservingMask) Reset() {
+	*x = Algorithm_FormatPreservingMask{}
+	mi := &file_store_setting_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_FormatPreservingMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_FormatPreservingMask) ProtoMessage() {}
+
+func (x *Algorithm_FormatPreservingMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_FormatPreservingMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_FormatPreservingMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 4}
+}
+
+func (x *Algorithm_FormatPreservingMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_FormatPreservingMask) GetTweak() string {
+	if x != nil {
+		return x.Tweak
+	}
+	return ""
+}
+
+// HmacMask replaces the value with the token generated by the keyed HMAC-SHA256,
+// so the same value is always masked to the same token.
+type Algorithm_HmacMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the secret key of the HMAC.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// length is the number of the hex characters kept in the token, the whole digest is kept if it's 0.
+	Length int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	// prefix is prepended to the token, e.g. "tok_".
+	Prefix        string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_HmacMask) Reset() {
+	*x = Algorithm_HmacMask{}
+	mi := &file_store_setting_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_HmacMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_HmacMask) ProtoMessage() {}
+
+func (x *Algorithm_HmacMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_HmacMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_HmacMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 5}
+}
+
+func (x *Algorithm_HmacMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_HmacMask) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Algorithm_HmacMask) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// DateShiftMask shifts the date and timestamp values by a random but consistent number of days per subject,
+// so the intervals between the values of the same subject are preserved.
+type Algorithm_DateShiftMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the secret key deriving the shift of each subject.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// max_shift_days is the maximum number of days shifted in either direction.
+	MaxShiftDays int32 `protobuf:"varint,2,opt,name=max_shift_days,json=maxShiftDays,proto3" json:"max_shift_days,omitempty"`
+	// subject_column is the name of the result column identifying the subject, e.g. "patient_id".
+	// All values are shifted by the same number of days if it's empty or not in the result.
+	SubjectColumn string `protobuf:"bytes,3,opt,name=subject_column,json=subjectColumn,proto3" json:"subject_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_DateShiftMask) Reset() {
+	*x = Algorithm_DateShiftMask{}
+	mi := &file_store_setting_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_DateShiftMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_DateShiftMask) ProtoMessage() {}
+
+func (x *Algorithm_DateShiftMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_DateShiftMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_DateShiftMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 6}
+}
+
+func (x *Algorithm_DateShiftMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_DateShiftMask) GetMaxShiftDays() int32 {
+	if x != nil {
+		return x.MaxShiftDays
+	}
+	return 0
+}
+
+func (x *Algorithm_DateShiftMask) GetSubjectColumn() string {
+	if x != nil {
+		return x.SubjectColumn
+	}
+	return ""
+}
+
+// NumericBucketMask generalizes the numeric values into the buckets, e.g. the salary ranges or the age bands.
+type Algorithm_NumericBucketMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// boundaries are the bucket boundaries in ascending order,
+	// e.g. [18, 30, 50] generalizes the values into "< 18", "[18, 30)", "[30, 50)" and ">= 50".
+	Boundaries []float64 `protobuf:"fixed64,1,rep,packed,name=boundaries,proto3" json:"boundaries,omitempty"`
+	// labels are the optional bucket labels, there must be one more label than the boundaries if it's set.
+	Labels        []string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_NumericBucketMask) Reset() {
+	*x = Algorithm_NumericBucketMask{}
+	mi := &file_store_setting_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericBucketMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericBucketMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericBucketMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_NumericBucketMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericBucketMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 7}
+}
+
+func (x *Algorithm_NumericBucketMask) GetBoundaries() []float64 {
+	if x != nil {
+		return x.Boundaries
+	}
+	return nil
+}
+
+func (x *Algorithm_NumericBucketMask) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type Algorithm_RangeMask_Slice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// start is the start index of the original value, start from 0 and should be less than stop.
@@ -2010,7 +2327,7 @@ type Algorithm_RangeMask_Slice struct {
 
 func (x *Algorithm_RangeMask_Slice) Reset() {
 	*x = Algorithm_RangeMask_Slice{}
-	mi := &file_store_setting_proto_msgTypes[26]
+	mi := &file_store_setting_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2022,7 +2339,7 @@ func (x *Algorithm_RangeMask_Slice) String() string {
 func (*Algorithm_RangeMask_Slice) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[26]
+	mi := &file_store_setting_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2069,7 +2386,7 @@ type AppIMSetting_Slack struct {
 
 func (x *AppIMSetting_Slack) Reset() {
 	*x = AppIMSetting_Slack{}
-	mi := &file_store_setting_proto_msgTypes[27]
+	mi := &file_store_setting_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2081,7 +2398,7 @@ func (x *AppIMSetting_Slack) String() string {
 func (*AppIMSetting_Slack) ProtoMessage() {}
 
 func (x *AppIMSetting_Slack) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[27]
+	mi := &file_store_setting_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,7 +2439,7 @@ type AppIMSetting_Feishu struct {
 
 func (x *AppIMSetting_Feishu) Reset() {
 	*x = AppIMSetting_Feishu{}
-	mi := &file_store_setting_proto_msgTypes[28]
+	mi := &file_store_setting_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2134,7 +2451,7 @@ func (x *AppIMSetting_Feishu) String() string {
 func (*AppIMSetting_Feishu) ProtoMessage() {}
 
 func (x *AppIMSetting_Feishu) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[28]
+	mi := &file_store_setting_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,7 +2500,7 @@ type AppIMSetting_Wecom struct {
 
 func (x *AppIMSetting_Wecom) Reset() {
 	*x = AppIMSetting_Wecom{}
-	mi := &file_store_setting_proto_msgTypes[29]
+	mi := &file_store_setting_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2195,7 +2512,7 @@ func (x *AppIMSetting_Wecom) String() string {
 func (*AppIMSetting_Wecom) ProtoMessage() {}
 
 func (x *AppIMSetting_Wecom) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[29]
+	mi := &file_store_setting_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,7 +2567,7 @@ type AppIMSetting_Lark struct {
 
 func (x *AppIMSetting_Lark) Reset() {
 	*x = AppIMSetting_Lark{}
-	mi := &file_store_setting_proto_msgTypes[30]
+	mi := &file_store_setting_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2262,7 +2579,7 @@ func (x *AppIMSetting_Lark) String() string {
 func (*AppIMSetting_Lark) ProtoMessage() {}
 
 func (x *AppIMSetting_Lark) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[30]
+	mi := &file_store_setting_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,7 +2628,7 @@ type AppIMSetting_DingTalk struct {
 
 func (x *AppIMSetting_DingTalk) Reset() {
 	*x = AppIMSetting_DingTalk{}
-	mi := &file_store_setting_proto_msgTypes[31]
+	mi := &file_store_setting_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2323,7 +2640,7 @@ func (x *AppIMSetting_DingTalk) String() string {
 func (*AppIMSetting_DingTalk) ProtoMessage() {}
 
 func (x *AppIMSetting_DingTalk) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[31]
+	mi := &file_store_setting_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2383,7 +2700,7 @@ type EnvironmentSetting_Environment struct {
 
 func (x *EnvironmentSetting_Environment) Reset() {
 	*x = EnvironmentSetting_Environment{}
-	mi := &file_store_setting_proto_msgTypes[32]
+	mi := &file_store_setting_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2395,7 +2712,7 @@ func (x *EnvironmentSetting_Environment) String() string {
 func (*EnvironmentSetting_Environment) ProtoMessage() {}
 
 func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[32]
+	mi := &file_store_setting_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2443,7 +2760,7 @@ var File_store_setting_proto protoreflect.FileDescriptor
 
 const file_store_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x13store/setting.proto\x12\x0ebytebase.store\x1a%google/api/expr/v1alpha1/syntax.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x14store/approval.proto\x1a\x12store/common.proto\x1a\x14store/database.proto\"\xbf\x04\n" +
+	"\x13store/setting.proto\x12\x0ebytebase.store\x1a%google/api/expr/v1alpha1/syntax.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x14store/approval.proto\x1a\x12store/common.proto\x1a\x14store/database.proto\"\x83\x05\n" +
 	"\x17WorkspaceProfileSetting\x12!\n" +
 	"\fexternal_url\x18\x01 \x01(\tR\vexternalUrl\x12'\n" +
 	"\x0fdisallow_signup\x18\x02 \x01(\bR\x0edisallowSignup\x12\x1f\n" +
@@ -2456,7 +2773,8 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x17enforce_identity_domain\x18\n" +
 	" \x01(\bR\x15enforceIdentityDomain\x12T\n" +
 	"\x14database_change_mode\x18\v \x01(\x0e2\".bytebase.store.DatabaseChangeModeR\x12databaseChangeMode\x128\n" +
-	"\x18disallow_password_signin\x18\f \x01(\bR\x16disallowPasswordSignin\"\xe9\x01\n" +
+	"\x18disallow_password_signin\x18\f \x01(\bR\x16disallowPasswordSignin\x12B\n" +
+	"\x1dwebauthn_required_permissions\x18\r \x03(\tR\x1bwebauthnRequiredPermissions\"\xe9\x01\n" +
 	"\fAnnouncement\x12=\n" +
 	"\x05level\x18\x01 \x01(\x0e2'.bytebase.store.Announcement.AlertLevelR\x05level\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
@@ -2524,13 +2842,18 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
 	"\talgorithm\x18\x06 \x01(\v2\x19.bytebase.store.AlgorithmR\talgorithm\x12\x12\n" +
-	"\x04icon\x18\a \x01(\tR\x04icon\"\xa0\x06\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\"\xc8\v\n" +
 	"\tAlgorithm\x12A\n" +
 	"\tfull_mask\x18\x05 \x01(\v2\".bytebase.store.Algorithm.FullMaskH\x00R\bfullMask\x12D\n" +
 	"\n" +
 	"range_mask\x18\x06 \x01(\v2#.bytebase.store.Algorithm.RangeMaskH\x00R\trangeMask\x12>\n" +
 	"\bmd5_mask\x18\a \x01(\v2!.bytebase.store.Algorithm.MD5MaskH\x00R\amd5Mask\x12T\n" +
-	"\x10inner_outer_mask\x18\b \x01(\v2(.bytebase.store.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x1a.\n" +
+	"\x10inner_outer_mask\x18\b \x01(\v2(.bytebase.store.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x12f\n" +
+	"\x16format_preserving_mask\x18\t \x01(\v2..bytebase.store.Algorithm.FormatPreservingMaskH\x00R\x14formatPreservingMask\x12A\n" +
+	"\thmac_mask\x18\n" +
+	" \x01(\v2\".bytebase.store.Algorithm.HmacMaskH\x00R\bhmacMask\x12Q\n" +
+	"\x0fdate_shift_mask\x18\v \x01(\v2'.bytebase.store.Algorithm.DateShiftMaskH\x00R\rdateShiftMask\x12]\n" +
+	"\x13numeric_bucket_mask\x18\f \x01(\v2+.bytebase.store.Algorithm.NumericBucketMaskH\x00R\x11numericBucketMask\x1a.\n" +
 	"\bFullMask\x12\"\n" +
 	"\fsubstitution\x18\x01 \x01(\tR\fsubstitution\x1a\xa3\x01\n" +
 	"\tRangeMask\x12A\n" +
@@ -2551,7 +2874,23 @@ const file_store_setting_proto_rawDesc = "" +
 	"\bMaskType\x12\x19\n" +
 	"\x15MASK_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05INNER\x10\x01\x12\t\n" +
-	"\x05OUTER\x10\x02B\x06\n" +
+	"\x05OUTER\x10\x02\x1a>\n" +
+	"\x14FormatPreservingMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05tweak\x18\x02 \x01(\tR\x05tweak\x1aL\n" +
+	"\bHmacMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x1an\n" +
+	"\rDateShiftMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x0emax_shift_days\x18\x02 \x01(\x05R\fmaxShiftDays\x12%\n" +
+	"\x0esubject_column\x18\x03 \x01(\tR\rsubjectColumn\x1aK\n" +
+	"\x11NumericBucketMask\x12\x1e\n" +
+	"\n" +
+	"boundaries\x18\x01 \x03(\x01R\n" +
+	"boundaries\x12\x16\n" +
+	"\x06labels\x18\x02 \x03(\tR\x06labelsB\x06\n" +
 	"\x04mask\"\x9b\x06\n" +
 	"\fAppIMSetting\x128\n" +
 	"\x05slack\x18\x01 \x01(\v2\".bytebase.store.AppIMSetting.SlackR\x05slack\x12;\n" +
@@ -2662,7 +3001,7 @@ func file_store_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_store_setting_proto_goTypes = []any{
 	(SettingName)(0),                                                 // 0: bytebase.store.SettingName
 	(DatabaseChangeMode)(0),                                          // 1: bytebase.store.DatabaseChangeMode
@@ -2695,28 +3034,32 @@ var file_store_setting_proto_goTypes = []any{
 	(*Algorithm_RangeMask)(nil),              // 28: bytebase.store.Algorithm.RangeMask
 	(*Algorithm_MD5Mask)(nil),                // 29: bytebase.store.Algorithm.MD5Mask
 	(*Algorithm_InnerOuterMask)(nil),         // 30: bytebase.store.Algorithm.InnerOuterMask
-	(*Algorithm_RangeMask_Slice)(nil),        // 31: bytebase.store.Algorithm.RangeMask.Slice
-	(*AppIMSetting_Slack)(nil),               // 32: bytebase.store.AppIMSetting.Slack
-	(*AppIMSetting_Feishu)(nil),              // 33: bytebase.store.AppIMSetting.Feishu
-	(*AppIMSetting_Wecom)(nil),               // 34: bytebase.store.AppIMSetting.Wecom
-	(*AppIMSetting_Lark)(nil),                // 35: bytebase.store.AppIMSetting.Lark
-	(*AppIMSetting_DingTalk)(nil),            // 36: bytebase.store.AppIMSetting.DingTalk
-	(*EnvironmentSetting_Environment)(nil),   // 37: bytebase.store.EnvironmentSetting.Environment
-	nil,                                      // 38: bytebase.store.EnvironmentSetting.Environment.TagsEntry
-	(*durationpb.Duration)(nil),              // 39: google.protobuf.Duration
-	(*v1alpha1.Expr)(nil),                    // 40: google.api.expr.v1alpha1.Expr
-	(*ApprovalTemplate)(nil),                 // 41: bytebase.store.ApprovalTemplate
-	(*expr.Expr)(nil),                        // 42: google.type.Expr
-	(Engine)(0),                              // 43: bytebase.store.Engine
-	(*ColumnMetadata)(nil),                   // 44: bytebase.store.ColumnMetadata
-	(*ColumnCatalog)(nil),                    // 45: bytebase.store.ColumnCatalog
-	(*TableMetadata)(nil),                    // 46: bytebase.store.TableMetadata
-	(*TableCatalog)(nil),                     // 47: bytebase.store.TableCatalog
+	(*Algorithm_FormatPreservingMask)(nil),   // 31: bytebase.store.Algorithm.FormatPreservingMask
+	(*Algorithm_HmacMask)(nil),               // 32: bytebase.store.Algorithm.HmacMask
+	(*Algorithm_DateShiftMask)(nil),          // 33: bytebase.store.Algorithm.DateShiftMask
+	(*Algorithm_NumericBucketMask)(nil),      // 34: bytebase.store.Algorithm.NumericBucketMask
+	(*Algorithm_RangeMask_Slice)(nil),        // 35: bytebase.store.Algorithm.RangeMask.Slice
+	(*AppIMSetting_Slack)(nil),               // 36: bytebase.store.AppIMSetting.Slack
+	(*AppIMSetting_Feishu)(nil),              // 37: bytebase.store.AppIMSetting.Feishu
+	(*AppIMSetting_Wecom)(nil),               // 38: bytebase.store.AppIMSetting.Wecom
+	(*AppIMSetting_Lark)(nil),                // 39: bytebase.store.AppIMSetting.Lark
+	(*AppIMSetting_DingTalk)(nil),            // 40: bytebase.store.AppIMSetting.DingTalk
+	(*EnvironmentSetting_Environment)(nil),   // 41: bytebase.store.EnvironmentSetting.Environment
+	nil,                                      // 42: bytebase.store.EnvironmentSetting.Environment.TagsEntry
+	(*durationpb.Duration)(nil),              // 43: google.protobuf.Duration
+	(*v1alpha1.Expr)(nil),                    // 44: google.api.expr.v1alpha1.Expr
+	(*ApprovalTemplate)(nil),                 // 45: bytebase.store.ApprovalTemplate
+	(*expr.Expr)(nil),                        // 46: google.type.Expr
+	(Engine)(0),                              // 47: bytebase.store.Engine
+	(*ColumnMetadata)(nil),                   // 48: bytebase.store.ColumnMetadata
+	(*ColumnCatalog)(nil),                    // 49: bytebase.store.ColumnCatalog
+	(*TableMetadata)(nil),                    // 50: bytebase.store.TableMetadata
+	(*TableCatalog)(nil),                     // 51: bytebase.store.TableCatalog
 }
 var file_store_setting_proto_depIdxs = []int32{
-	39, // 0: bytebase.store.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
+	43, // 0: bytebase.store.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
 	6,  // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.Announcement
-	39, // 2: bytebase.store.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
+	43, // 2: bytebase.store.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
 	1,  // 3: bytebase.store.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.store.DatabaseChangeMode
 	2,  // 4: bytebase.store.Announcement.level:type_name -> bytebase.store.Announcement.AlertLevel
 	18, // 5: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
@@ -2729,36 +3072,40 @@ var file_store_setting_proto_depIdxs = []int32{
 	28, // 12: bytebase.store.Algorithm.range_mask:type_name -> bytebase.store.Algorithm.RangeMask
 	29, // 13: bytebase.store.Algorithm.md5_mask:type_name -> bytebase.store.Algorithm.MD5Mask
 	30, // 14: bytebase.store.Algorithm.inner_outer_mask:type_name -> bytebase.store.Algorithm.InnerOuterMask
-	32, // 15: bytebase.store.AppIMSetting.slack:type_name -> bytebase.store.AppIMSetting.Slack
-	33, // 16: bytebase.store.AppIMSetting.feishu:type_name -> bytebase.store.AppIMSetting.Feishu
-	34, // 17: bytebase.store.AppIMSetting.wecom:type_name -> bytebase.store.AppIMSetting.Wecom
-	35, // 18: bytebase.store.AppIMSetting.lark:type_name -> bytebase.store.AppIMSetting.Lark
-	36, // 19: bytebase.store.AppIMSetting.dingtalk:type_name -> bytebase.store.AppIMSetting.DingTalk
-	39, // 20: bytebase.store.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
-	4,  // 21: bytebase.store.AISetting.provider:type_name -> bytebase.store.AISetting.Provider
-	37, // 22: bytebase.store.EnvironmentSetting.environments:type_name -> bytebase.store.EnvironmentSetting.Environment
-	40, // 23: bytebase.store.WorkspaceApprovalSetting.Rule.expression:type_name -> google.api.expr.v1alpha1.Expr
-	41, // 24: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
-	42, // 25: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	43, // 26: bytebase.store.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.store.Engine
-	44, // 27: bytebase.store.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.store.ColumnMetadata
-	45, // 28: bytebase.store.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.store.ColumnCatalog
-	43, // 29: bytebase.store.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.store.Engine
-	43, // 30: bytebase.store.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.store.Engine
-	46, // 31: bytebase.store.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.store.TableMetadata
-	47, // 32: bytebase.store.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.store.TableCatalog
-	23, // 33: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	25, // 34: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	24, // 35: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	11, // 36: bytebase.store.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.store.Algorithm
-	31, // 37: bytebase.store.Algorithm.RangeMask.slices:type_name -> bytebase.store.Algorithm.RangeMask.Slice
-	3,  // 38: bytebase.store.Algorithm.InnerOuterMask.type:type_name -> bytebase.store.Algorithm.InnerOuterMask.MaskType
-	38, // 39: bytebase.store.EnvironmentSetting.Environment.tags:type_name -> bytebase.store.EnvironmentSetting.Environment.TagsEntry
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	31, // 15: bytebase.store.Algorithm.format_preserving_mask:type_name -> bytebase.store.Algorithm.FormatPreservingMask
+	32, // 16: bytebase.store.Algorithm.hmac_mask:type_name -> bytebase.store.Algorithm.HmacMask
+	33, // 17: bytebase.store.Algorithm.date_shift_mask:type_name -> bytebase.store.Algorithm.DateShiftMask
+	34, // 18: bytebase.store.Algorithm.numeric_bucket_mask:type_name -> bytebase.store.Algorithm.NumericBucketMask
+	36, // 19: bytebase.store.AppIMSetting.slack:type_name -> bytebase.store.AppIMSetting.Slack
+	37, // 20: bytebase.store.AppIMSetting.feishu:type_name -> bytebase.store.AppIMSetting.Feishu
+	38, // 21: bytebase.store.AppIMSetting.wecom:type_name -> bytebase.store.AppIMSetting.Wecom
+	39, // 22: bytebase.store.AppIMSetting.lark:type_name -> bytebase.store.AppIMSetting.Lark
+	40, // 23: bytebase.store.AppIMSetting.dingtalk:type_name -> bytebase.store.AppIMSetting.DingTalk
+	43, // 24: bytebase.store.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
+	4,  // 25: bytebase.store.AISetting.provider:type_name -> bytebase.store.AISetting.Provider
+	41, // 26: bytebase.store.EnvironmentSetting.environments:type_name -> bytebase.store.EnvironmentSetting.Environment
+	44, // 27: bytebase.store.WorkspaceApprovalSetting.Rule.expression:type_name -> google.api.expr.v1alpha1.Expr
+	45, // 28: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
+	46, // 29: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	47, // 30: bytebase.store.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.store.Engine
+	48, // 31: bytebase.store.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.store.ColumnMetadata
+	49, // 32: bytebase.store.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.store.ColumnCatalog
+	47, // 33: bytebase.store.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.store.Engine
+	47, // 34: bytebase.store.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.store.Engine
+	50, // 35: bytebase.store.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.store.TableMetadata
+	51, // 36: bytebase.store.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.store.TableCatalog
+	23, // 37: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	25, // 38: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	24, // 39: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	11, // 40: bytebase.store.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.store.Algorithm
+	35, // 41: bytebase.store.Algorithm.RangeMask.slices:type_name -> bytebase.store.Algorithm.RangeMask.Slice
+	3,  // 42: bytebase.store.Algorithm.InnerOuterMask.type:type_name -> bytebase.store.Algorithm.InnerOuterMask.MaskType
+	42, // 43: bytebase.store.EnvironmentSetting.Environment.tags:type_name -> bytebase.store.EnvironmentSetting.Environment.TagsEntry
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_store_setting_proto_init() }
@@ -2774,6 +3121,10 @@ func file_store_setting_proto_init() {
 		(*Algorithm_RangeMask_)(nil),
 		(*Algorithm_Md5Mask)(nil),
 		(*Algorithm_InnerOuterMask_)(nil),
+		(*Algorithm_FormatPreservingMask_)(nil),
+		(*Algorithm_HmacMask_)(nil),
+		(*Algorithm_DateShiftMask_)(nil),
+		(*Algorithm_NumericBucketMask_)(nil),
 	}
 	file_store_setting_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
@@ -2782,7 +3133,7 @@ func file_store_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_setting_proto_rawDesc), len(file_store_setting_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   34,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
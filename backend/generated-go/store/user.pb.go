@@ -85,12 +85,18 @@ type MFAConfig struct {
 	OtpSecret string `protobuf:"bytes,1,opt,name=otp_secret,json=otpSecret,proto3" json:"otp_secret,omitempty"`
 	// The temp_otp_secret is the temporary secret key used to validate the OTP code and will replace the otp_secret in two phase commits.
 	TempOtpSecret string `protobuf:"bytes,2,opt,name=temp_otp_secret,json=tempOtpSecret,proto3" json:"temp_otp_secret,omitempty"`
-	// The recovery_codes are the codes that can be used to recover the account.
+	//  The recovery_codes are the codes that can be used to recover the account.
 	RecoveryCodes []string `protobuf:"bytes,3,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
-	// The temp_recovery_codes are the temporary codes that will replace the recovery_codes in two phase commits.
+	//  The temp_recovery_codes are the temporary codes that will replace the recovery_codes in two phase commits.
 	TempRecoveryCodes []string `protobuf:"bytes,4,rep,name=temp_recovery_codes,json=tempRecoveryCodes,proto3" json:"temp_recovery_codes,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The webauthn_credentials are the registered passkeys and security keys used as the second factor.
+	WebauthnCredentials []*WebAuthnCredential `protobuf:"bytes,5,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	// The temp_webauthn_challenge is the challenge of the ongoing WebAuthn registration or authentication ceremony.
+	TempWebauthnChallenge []byte `protobuf:"bytes,6,opt,name=temp_webauthn_challenge,json=tempWebauthnChallenge,proto3" json:"temp_webauthn_challenge,omitempty"`
+	// The expire time of the temp_webauthn_challenge.
+	TempWebauthnChallengeExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=temp_webauthn_challenge_expire_time,json=tempWebauthnChallengeExpireTime,proto3" json:"temp_webauthn_challenge_expire_time,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
 func (x *MFAConfig) Reset() {
@@ -151,6 +157,133 @@ func (x *MFAConfig) GetTempRecoveryCodes() []string {
 	return nil
 }
 
+func (x *MFAConfig) GetWebauthnCredentials() []*WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredentials
+	}
+	return nil
+}
+
+func (x *MFAConfig) GetTempWebauthnChallenge() []byte {
+	if x != nil {
+		return x.TempWebauthnChallenge
+	}
+	return nil
+}
+
+func (x *MFAConfig) GetTempWebauthnChallengeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TempWebauthnChallengeExpireTime
+	}
+	return nil
+}
+
+type WebAuthnCredential struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential id generated by the authenticator.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The COSE_Key encoded credential public key.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The title given by the user, e.g. YubiKey.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// The signature counter of the authenticator, which is used to detect cloned authenticators.
+	SignCount uint32 `protobuf:"varint,4,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	// The transports of the authenticator, e.g. usb, nfc and internal.
+	Transports []string `protobuf:"bytes,5,rep,name=transports,proto3" json:"transports,omitempty"`
+	// The AAGUID identifying the model of the authenticator.
+	Aaguid        []byte                 `protobuf:"bytes,6,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	LastUseTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_use_time,json=lastUseTime,proto3" json:"last_use_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
+	mi := &file_store_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthnCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnCredential) ProtoMessage() {}
+
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_store_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebAuthnCredential) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetSignCount() uint32 {
+	if x != nil {
+		return x.SignCount
+	}
+	return 0
+}
+
+func (x *WebAuthnCredential) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetAaguid() []byte {
+	if x != nil {
+		return x.Aaguid
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetLastUseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUseTime
+	}
+	return nil
+}
+
 type UserProfile struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	LastLoginTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`
@@ -163,7 +296,7 @@ type UserProfile struct {
 
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
-	mi := &file_store_user_proto_msgTypes[1]
+	mi := &file_store_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +308,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_proto_msgTypes[1]
+	mi := &file_store_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +321,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_store_user_proto_rawDescGZIP(), []int{1}
+	return file_store_user_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UserProfile) GetLastLoginTime() *timestamppb.Timestamp {
@@ -216,13 +349,30 @@ var File_store_user_proto protoreflect.FileDescriptor
 
 const file_store_user_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/user.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x01\n" +
+	"\x10store/user.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x03\n" +
 	"\tMFAConfig\x12\x1d\n" +
 	"\n" +
 	"otp_secret\x18\x01 \x01(\tR\totpSecret\x12&\n" +
 	"\x0ftemp_otp_secret\x18\x02 \x01(\tR\rtempOtpSecret\x12%\n" +
 	"\x0erecovery_codes\x18\x03 \x03(\tR\rrecoveryCodes\x12.\n" +
-	"\x13temp_recovery_codes\x18\x04 \x03(\tR\x11tempRecoveryCodes\"\xc0\x01\n" +
+	"\x13temp_recovery_codes\x18\x04 \x03(\tR\x11tempRecoveryCodes\x12U\n" +
+	"\x14webauthn_credentials\x18\x05 \x03(\v2\".bytebase.store.WebAuthnCredentialR\x13webauthnCredentials\x126\n" +
+	"\x17temp_webauthn_challenge\x18\x06 \x01(\fR\x15tempWebauthnChallenge\x12h\n" +
+	"#temp_webauthn_challenge_expire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1ftempWebauthnChallengeExpireTime\"\xad\x02\n" +
+	"\x12WebAuthnCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"sign_count\x18\x04 \x01(\rR\tsignCount\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x05 \x03(\tR\n" +
+	"transports\x12\x16\n" +
+	"\x06aaguid\x18\x06 \x01(\fR\x06aaguid\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12>\n" +
+	"\rlast_use_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastUseTime\"\xc0\x01\n" +
 	"\vUserProfile\x12B\n" +
 	"\x0flast_login_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastLoginTime\x12U\n" +
 	"\x19last_change_password_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x16lastChangePasswordTime\x12\x16\n" +
@@ -247,21 +397,26 @@ func file_store_user_proto_rawDescGZIP() []byte {
 }
 
 var file_store_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_user_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_user_proto_goTypes = []any{
 	(PrincipalType)(0),            // 0: bytebase.store.PrincipalType
 	(*MFAConfig)(nil),             // 1: bytebase.store.MFAConfig
-	(*UserProfile)(nil),           // 2: bytebase.store.UserProfile
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*WebAuthnCredential)(nil),    // 2: bytebase.store.WebAuthnCredential
+	(*UserProfile)(nil),           // 3: bytebase.store.UserProfile
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_store_user_proto_depIdxs = []int32{
-	3, // 0: bytebase.store.UserProfile.last_login_time:type_name -> google.protobuf.Timestamp
-	3, // 1: bytebase.store.UserProfile.last_change_password_time:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: bytebase.store.MFAConfig.webauthn_credentials:type_name -> bytebase.store.WebAuthnCredential
+	4, // 1: bytebase.store.MFAConfig.temp_webauthn_challenge_expire_time:type_name -> google.protobuf.Timestamp
+	4, // 2: bytebase.store.WebAuthnCredential.create_time:type_name -> google.protobuf.Timestamp
+	4, // 3: bytebase.store.WebAuthnCredential.last_use_time:type_name -> google.protobuf.Timestamp
+	4, // 4: bytebase.store.UserProfile.last_login_time:type_name -> google.protobuf.Timestamp
+	4, // 5: bytebase.store.UserProfile.last_change_password_time:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_user_proto_init() }
@@ -275,7 +430,7 @@ func file_store_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_proto_rawDesc), len(file_store_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
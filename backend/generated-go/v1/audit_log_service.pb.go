@@ -106,16 +106,16 @@ type SearchAuditLogsRequest struct {
 	// - create_time: support ">=" and "<=" operator.
 	//
 	// For example:
-	//   - filter = "method == '/bytebase.v1.SQLService/Query'"
-	//   - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR'"
-	//   - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR' && user == 'users/bb@bytebase.com'"
-	//   - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR' && create_time <= '2021-01-01T00:00:00Z' && create_time >= '2020-01-01T00:00:00Z'"
+	//  - filter = "method == '/bytebase.v1.SQLService/Query'"
+	//  - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR'"
+	//  - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR' && user == 'users/bb@bytebase.com'"
+	//  - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR' && create_time <= '2021-01-01T00:00:00Z' && create_time >= '2020-01-01T00:00:00Z'"
 	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	// The order by of the log.
 	// Only support order by create_time.
 	// For example:
-	//   - order_by = "create_time asc"
-	//   - order_by = "create_time desc"
+	//  - order_by = "create_time asc"
+	//  - order_by = "create_time desc"
 	OrderBy string `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	// The maximum number of logs to return.
 	// The service may return fewer than this value.
@@ -258,8 +258,8 @@ type ExportAuditLogsRequest struct {
 	// The order by of the log.
 	// Only support order by create_time.
 	// For example:
-	//   - order_by = "create_time asc"
-	//   - order_by = "create_time desc"
+	//  - order_by = "create_time asc"
+	//  - order_by = "create_time desc"
 	OrderBy string `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	// The export format.
 	Format ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=bytebase.v1.ExportFormat" json:"format,omitempty"`
@@ -429,8 +429,11 @@ type AuditLog struct {
 	ServiceData *anypb.Any `protobuf:"bytes,11,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
 	// Metadata about the operation.
 	RequestMetadata *RequestMetadata `protobuf:"bytes,12,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The personal access token used to authenticate the request.
+	// Format: users/{user}/accessTokens/{access_token}
+	AccessToken   string `protobuf:"bytes,13,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuditLog) Reset() {
@@ -547,6 +550,13 @@ func (x *AuditLog) GetRequestMetadata() *RequestMetadata {
 	return nil
 }
 
+func (x *AuditLog) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type AuditData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PolicyDelta   *PolicyDelta           `protobuf:"bytes,1,opt,name=policy_delta,json=policyDelta,proto3" json:"policy_delta,omitempty"`
@@ -673,7 +683,7 @@ const file_v1_audit_log_service_proto_rawDesc = "" +
 	"page_token\x18\x06 \x01(\tR\tpageToken\"[\n" +
 	"\x17ExportAuditLogsResponse\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\fR\acontent\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfc\x04\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9f\x05\n" +
 	"\bAuditLog\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12@\n" +
 	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
@@ -688,7 +698,8 @@ const file_v1_audit_log_service_proto_rawDesc = "" +
 	"\alatency\x18\n" +
 	" \x01(\v2\x19.google.protobuf.DurationR\alatency\x127\n" +
 	"\fservice_data\x18\v \x01(\v2\x14.google.protobuf.AnyR\vserviceData\x12G\n" +
-	"\x10request_metadata\x18\f \x01(\v2\x1c.bytebase.v1.RequestMetadataR\x0frequestMetadata\"x\n" +
+	"\x10request_metadata\x18\f \x01(\v2\x1c.bytebase.v1.RequestMetadataR\x0frequestMetadata\x12!\n" +
+	"\faccess_token\x18\r \x01(\tR\vaccessToken\"x\n" +
 	"\bSeverity\x12\v\n" +
 	"\aDEFAULT\x10\x00\x12\t\n" +
 	"\x05DEBUG\x10\x01\x12\b\n" +
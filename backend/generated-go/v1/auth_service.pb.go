@@ -39,9 +39,12 @@ type LoginRequest struct {
 	// The recovery_code is used to recovery the user's identity with MFA.
 	RecoveryCode *string `protobuf:"bytes,7,opt,name=recovery_code,json=recoveryCode,proto3,oneof" json:"recovery_code,omitempty"`
 	// The mfa_temp_token is used to verify the user's identity by MFA.
-	MfaTempToken  *string `protobuf:"bytes,8,opt,name=mfa_temp_token,json=mfaTempToken,proto3,oneof" json:"mfa_temp_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MfaTempToken *string `protobuf:"bytes,8,opt,name=mfa_temp_token,json=mfaTempToken,proto3,oneof" json:"mfa_temp_token,omitempty"`
+	// The webauthn_credential is the PublicKeyCredential JSON returned by navigator.credentials.get()
+	// or navigator.credentials.create() to verify the user's identity by WebAuthn.
+	WebauthnCredential *string `protobuf:"bytes,9,opt,name=webauthn_credential,json=webauthnCredential,proto3,oneof" json:"webauthn_credential,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *LoginRequest) Reset() {
@@ -130,12 +133,20 @@ func (x *LoginRequest) GetMfaTempToken() string {
 	return ""
 }
 
+func (x *LoginRequest) GetWebauthnCredential() string {
+	if x != nil && x.WebauthnCredential != nil {
+		return *x.WebauthnCredential
+	}
+	return ""
+}
+
 type IdentityProviderContext struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Context:
 	//
 	//	*IdentityProviderContext_Oauth2Context
 	//	*IdentityProviderContext_OidcContext
+	//	*IdentityProviderContext_SamlContext
 	Context       isIdentityProviderContext_Context `protobuf_oneof:"context"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -196,6 +207,15 @@ func (x *IdentityProviderContext) GetOidcContext() *OIDCIdentityProviderContext
 	return nil
 }
 
+func (x *IdentityProviderContext) GetSamlContext() *SAMLIdentityProviderContext {
+	if x != nil {
+		if x, ok := x.Context.(*IdentityProviderContext_SamlContext); ok {
+			return x.SamlContext
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderContext_Context interface {
 	isIdentityProviderContext_Context()
 }
@@ -208,10 +228,16 @@ type IdentityProviderContext_OidcContext struct {
 	OidcContext *OIDCIdentityProviderContext `protobuf:"bytes,2,opt,name=oidc_context,json=oidcContext,proto3,oneof"`
 }
 
+type IdentityProviderContext_SamlContext struct {
+	SamlContext *SAMLIdentityProviderContext `protobuf:"bytes,3,opt,name=saml_context,json=samlContext,proto3,oneof"`
+}
+
 func (*IdentityProviderContext_Oauth2Context) isIdentityProviderContext_Context() {}
 
 func (*IdentityProviderContext_OidcContext) isIdentityProviderContext_Context() {}
 
+func (*IdentityProviderContext_SamlContext) isIdentityProviderContext_Context() {}
+
 type OAuth2IdentityProviderContext struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -292,20 +318,71 @@ func (*OIDCIdentityProviderContext) Descriptor() ([]byte, []int) {
 	return file_v1_auth_service_proto_rawDescGZIP(), []int{3}
 }
 
+type SAMLIdentityProviderContext struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base64 encoded SAMLResponse posted by the identity provider to the assertion consumer service.
+	SamlResponse  string `protobuf:"bytes,1,opt,name=saml_response,json=samlResponse,proto3" json:"saml_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLIdentityProviderContext) Reset() {
+	*x = SAMLIdentityProviderContext{}
+	mi := &file_v1_auth_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLIdentityProviderContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLIdentityProviderContext) ProtoMessage() {}
+
+func (x *SAMLIdentityProviderContext) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLIdentityProviderContext.ProtoReflect.Descriptor instead.
+func (*SAMLIdentityProviderContext) Descriptor() ([]byte, []int) {
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SAMLIdentityProviderContext) GetSamlResponse() string {
+	if x != nil {
+		return x.SamlResponse
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	MfaTempToken         *string                `protobuf:"bytes,2,opt,name=mfa_temp_token,json=mfaTempToken,proto3,oneof" json:"mfa_temp_token,omitempty"`
 	RequireResetPassword bool                   `protobuf:"varint,3,opt,name=require_reset_password,json=requireResetPassword,proto3" json:"require_reset_password,omitempty"`
 	// The user of successful login.
-	User          *User `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	User *User `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	// The PublicKeyCredentialRequestOptionsJSON for navigator.credentials.get().
+	// It is set with the mfa_temp_token if the user has registered WebAuthn credentials.
+	WebauthnRequestOptions *string `protobuf:"bytes,5,opt,name=webauthn_request_options,json=webauthnRequestOptions,proto3,oneof" json:"webauthn_request_options,omitempty"`
+	// The PublicKeyCredentialCreationOptionsJSON for navigator.credentials.create().
+	// It is set with the mfa_temp_token if WebAuthn is required but the user has not registered any credential yet.
+	WebauthnCreationOptions *string `protobuf:"bytes,6,opt,name=webauthn_creation_options,json=webauthnCreationOptions,proto3,oneof" json:"webauthn_creation_options,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_v1_auth_service_proto_msgTypes[4]
+	mi := &file_v1_auth_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +394,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_service_proto_msgTypes[4]
+	mi := &file_v1_auth_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +407,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_v1_auth_service_proto_rawDescGZIP(), []int{4}
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoginResponse) GetToken() string {
@@ -361,6 +438,20 @@ func (x *LoginResponse) GetUser() *User {
 	return nil
 }
 
+func (x *LoginResponse) GetWebauthnRequestOptions() string {
+	if x != nil && x.WebauthnRequestOptions != nil {
+		return *x.WebauthnRequestOptions
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetWebauthnCreationOptions() string {
+	if x != nil && x.WebauthnCreationOptions != nil {
+		return *x.WebauthnCreationOptions
+	}
+	return ""
+}
+
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -369,7 +460,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_v1_auth_service_proto_msgTypes[5]
+	mi := &file_v1_auth_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -381,7 +472,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_service_proto_msgTypes[5]
+	mi := &file_v1_auth_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,14 +485,14 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_v1_auth_service_proto_rawDescGZIP(), []int{5}
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{6}
 }
 
 var File_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15v1/auth_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x13v1/annotation.proto\x1a\x15v1/user_service.proto\"\xdb\x02\n" +
+	"\x15v1/auth_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x13v1/annotation.proto\x1a\x15v1/user_service.proto\"\xa9\x03\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x10\n" +
@@ -411,23 +502,32 @@ const file_v1_auth_service_proto_rawDesc = "" +
 	"idpContext\x12\x1e\n" +
 	"\botp_code\x18\x06 \x01(\tH\x00R\aotpCode\x88\x01\x01\x12(\n" +
 	"\rrecovery_code\x18\a \x01(\tH\x01R\frecoveryCode\x88\x01\x01\x12)\n" +
-	"\x0emfa_temp_token\x18\b \x01(\tH\x02R\fmfaTempToken\x88\x01\x01B\v\n" +
+	"\x0emfa_temp_token\x18\b \x01(\tH\x02R\fmfaTempToken\x88\x01\x01\x124\n" +
+	"\x13webauthn_credential\x18\t \x01(\tH\x03R\x12webauthnCredential\x88\x01\x01B\v\n" +
 	"\t_otp_codeB\x10\n" +
 	"\x0e_recovery_codeB\x11\n" +
-	"\x0f_mfa_temp_token\"\xc8\x01\n" +
+	"\x0f_mfa_temp_tokenB\x16\n" +
+	"\x14_webauthn_credential\"\x97\x02\n" +
 	"\x17IdentityProviderContext\x12S\n" +
 	"\x0eoauth2_context\x18\x01 \x01(\v2*.bytebase.v1.OAuth2IdentityProviderContextH\x00R\roauth2Context\x12M\n" +
-	"\foidc_context\x18\x02 \x01(\v2(.bytebase.v1.OIDCIdentityProviderContextH\x00R\voidcContextB\t\n" +
+	"\foidc_context\x18\x02 \x01(\v2(.bytebase.v1.OIDCIdentityProviderContextH\x00R\voidcContext\x12M\n" +
+	"\fsaml_context\x18\x03 \x01(\v2(.bytebase.v1.SAMLIdentityProviderContextH\x00R\vsamlContextB\t\n" +
 	"\acontext\"3\n" +
 	"\x1dOAuth2IdentityProviderContext\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\"\x1d\n" +
-	"\x1bOIDCIdentityProviderContext\"\xc0\x01\n" +
+	"\x1bOIDCIdentityProviderContext\"B\n" +
+	"\x1bSAMLIdentityProviderContext\x12#\n" +
+	"\rsaml_response\x18\x01 \x01(\tR\fsamlResponse\"\xfb\x02\n" +
 	"\rLoginResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12)\n" +
 	"\x0emfa_temp_token\x18\x02 \x01(\tH\x00R\fmfaTempToken\x88\x01\x01\x124\n" +
 	"\x16require_reset_password\x18\x03 \x01(\bR\x14requireResetPassword\x12%\n" +
-	"\x04user\x18\x04 \x01(\v2\x11.bytebase.v1.UserR\x04userB\x11\n" +
-	"\x0f_mfa_temp_token\"\x0f\n" +
+	"\x04user\x18\x04 \x01(\v2\x11.bytebase.v1.UserR\x04user\x12=\n" +
+	"\x18webauthn_request_options\x18\x05 \x01(\tH\x01R\x16webauthnRequestOptions\x88\x01\x01\x12?\n" +
+	"\x19webauthn_creation_options\x18\x06 \x01(\tH\x02R\x17webauthnCreationOptions\x88\x01\x01B\x11\n" +
+	"\x0f_mfa_temp_tokenB\x1b\n" +
+	"\x19_webauthn_request_optionsB\x1c\n" +
+	"\x1a_webauthn_creation_options\"\x0f\n" +
 	"\rLogoutRequest2\xd2\x01\n" +
 	"\vAuthService\x12a\n" +
 	"\x05Login\x12\x19.bytebase.v1.LoginRequest\x1a\x1a.bytebase.v1.LoginResponse\"!\x80\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12`\n" +
@@ -445,31 +545,33 @@ func file_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_v1_auth_service_proto_rawDescData
 }
 
-var file_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_v1_auth_service_proto_goTypes = []any{
 	(*LoginRequest)(nil),                  // 0: bytebase.v1.LoginRequest
 	(*IdentityProviderContext)(nil),       // 1: bytebase.v1.IdentityProviderContext
 	(*OAuth2IdentityProviderContext)(nil), // 2: bytebase.v1.OAuth2IdentityProviderContext
 	(*OIDCIdentityProviderContext)(nil),   // 3: bytebase.v1.OIDCIdentityProviderContext
-	(*LoginResponse)(nil),                 // 4: bytebase.v1.LoginResponse
-	(*LogoutRequest)(nil),                 // 5: bytebase.v1.LogoutRequest
-	(*User)(nil),                          // 6: bytebase.v1.User
-	(*emptypb.Empty)(nil),                 // 7: google.protobuf.Empty
+	(*SAMLIdentityProviderContext)(nil),   // 4: bytebase.v1.SAMLIdentityProviderContext
+	(*LoginResponse)(nil),                 // 5: bytebase.v1.LoginResponse
+	(*LogoutRequest)(nil),                 // 6: bytebase.v1.LogoutRequest
+	(*User)(nil),                          // 7: bytebase.v1.User
+	(*emptypb.Empty)(nil),                 // 8: google.protobuf.Empty
 }
 var file_v1_auth_service_proto_depIdxs = []int32{
 	1, // 0: bytebase.v1.LoginRequest.idp_context:type_name -> bytebase.v1.IdentityProviderContext
 	2, // 1: bytebase.v1.IdentityProviderContext.oauth2_context:type_name -> bytebase.v1.OAuth2IdentityProviderContext
 	3, // 2: bytebase.v1.IdentityProviderContext.oidc_context:type_name -> bytebase.v1.OIDCIdentityProviderContext
-	6, // 3: bytebase.v1.LoginResponse.user:type_name -> bytebase.v1.User
-	0, // 4: bytebase.v1.AuthService.Login:input_type -> bytebase.v1.LoginRequest
-	5, // 5: bytebase.v1.AuthService.Logout:input_type -> bytebase.v1.LogoutRequest
-	4, // 6: bytebase.v1.AuthService.Login:output_type -> bytebase.v1.LoginResponse
-	7, // 7: bytebase.v1.AuthService.Logout:output_type -> google.protobuf.Empty
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 3: bytebase.v1.IdentityProviderContext.saml_context:type_name -> bytebase.v1.SAMLIdentityProviderContext
+	7, // 4: bytebase.v1.LoginResponse.user:type_name -> bytebase.v1.User
+	0, // 5: bytebase.v1.AuthService.Login:input_type -> bytebase.v1.LoginRequest
+	6, // 6: bytebase.v1.AuthService.Logout:input_type -> bytebase.v1.LogoutRequest
+	5, // 7: bytebase.v1.AuthService.Login:output_type -> bytebase.v1.LoginResponse
+	8, // 8: bytebase.v1.AuthService.Logout:output_type -> google.protobuf.Empty
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_v1_auth_service_proto_init() }
@@ -483,15 +585,16 @@ func file_v1_auth_service_proto_init() {
 	file_v1_auth_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*IdentityProviderContext_Oauth2Context)(nil),
 		(*IdentityProviderContext_OidcContext)(nil),
+		(*IdentityProviderContext_SamlContext)(nil),
 	}
-	file_v1_auth_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_v1_auth_service_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_auth_service_proto_rawDesc), len(file_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
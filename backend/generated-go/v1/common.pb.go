@@ -260,6 +260,8 @@ const (
 	ExportFormat_JSON               ExportFormat = 2
 	ExportFormat_SQL                ExportFormat = 3
 	ExportFormat_XLSX               ExportFormat = 4
+	ExportFormat_PARQUET            ExportFormat = 5
+	ExportFormat_NDJSON             ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -270,6 +272,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "NDJSON",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -277,6 +281,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"NDJSON":             6,
 	}
 )
 
@@ -474,13 +480,16 @@ const file_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"\x06GITLAB\x10\x02\x12\r\n" +
 	"\tBITBUCKET\x10\x03\x12\x10\n" +
-	"\fAZURE_DEVOPS\x10\x04*L\n" +
+	"\fAZURE_DEVOPS\x10\x04*e\n" +
 	"\fExportFormat\x12\x16\n" +
 	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03CSV\x10\x01\x12\b\n" +
 	"\x04JSON\x10\x02\x12\a\n" +
 	"\x03SQL\x10\x03\x12\b\n" +
-	"\x04XLSX\x10\x04B6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
+	"\x04XLSX\x10\x04\x12\v\n" +
+	"\aPARQUET\x10\x05\x12\n" +
+	"\n" +
+	"\x06NDJSON\x10\x06B6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
 
 var (
 	file_v1_common_proto_rawDescOnce sync.Once
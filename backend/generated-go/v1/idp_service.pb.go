@@ -31,6 +31,7 @@ const (
 	IdentityProviderType_OAUTH2                             IdentityProviderType = 1
 	IdentityProviderType_OIDC                               IdentityProviderType = 2
 	IdentityProviderType_LDAP                               IdentityProviderType = 3
+	IdentityProviderType_SAML                               IdentityProviderType = 4
 )
 
 // Enum value maps for IdentityProviderType.
@@ -40,12 +41,14 @@ var (
 		1: "OAUTH2",
 		2: "OIDC",
 		3: "LDAP",
+		4: "SAML",
 	}
 	IdentityProviderType_value = map[string]int32{
 		"IDENTITY_PROVIDER_TYPE_UNSPECIFIED": 0,
 		"OAUTH2":                             1,
 		"OIDC":                               2,
 		"LDAP":                               3,
+		"SAML":                               4,
 	}
 )
 
@@ -175,7 +178,174 @@ func (x LDAPIdentityProviderConfig_SecurityProtocol) Number() protoreflect.EnumN
 
 // Deprecated: Use LDAPIdentityProviderConfig_SecurityProtocol.Descriptor instead.
 func (LDAPIdentityProviderConfig_SecurityProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{13, 0}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type SyncLDAPGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the LDAP identity provider.
+	// Format: idps/{idp}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If set, the changes are computed and returned without being applied.
+	ValidateOnly  bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncLDAPGroupsRequest) Reset() {
+	*x = SyncLDAPGroupsRequest{}
+	mi := &file_v1_idp_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncLDAPGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncLDAPGroupsRequest) ProtoMessage() {}
+
+func (x *SyncLDAPGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_idp_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncLDAPGroupsRequest.ProtoReflect.Descriptor instead.
+func (*SyncLDAPGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyncLDAPGroupsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SyncLDAPGroupsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type SyncLDAPGroupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The membership changes of the Bytebase groups.
+	Changes       []*LDAPGroupChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncLDAPGroupsResponse) Reset() {
+	*x = SyncLDAPGroupsResponse{}
+	mi := &file_v1_idp_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncLDAPGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncLDAPGroupsResponse) ProtoMessage() {}
+
+func (x *SyncLDAPGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_idp_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncLDAPGroupsResponse.ProtoReflect.Descriptor instead.
+func (*SyncLDAPGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncLDAPGroupsResponse) GetChanges() []*LDAPGroupChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// LDAPGroupChange is the membership change of a Bytebase group.
+type LDAPGroupChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the Bytebase group.
+	// Format: groups/{email}
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The members added to the group.
+	// Format: users/{email}
+	AddedMembers []string `protobuf:"bytes,2,rep,name=added_members,json=addedMembers,proto3" json:"added_members,omitempty"`
+	// The members removed from the group.
+	// Format: users/{email}
+	RemovedMembers []string `protobuf:"bytes,3,rep,name=removed_members,json=removedMembers,proto3" json:"removed_members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LDAPGroupChange) Reset() {
+	*x = LDAPGroupChange{}
+	mi := &file_v1_idp_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPGroupChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPGroupChange) ProtoMessage() {}
+
+func (x *LDAPGroupChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_idp_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPGroupChange.ProtoReflect.Descriptor instead.
+func (*LDAPGroupChange) Descriptor() ([]byte, []int) {
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LDAPGroupChange) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *LDAPGroupChange) GetAddedMembers() []string {
+	if x != nil {
+		return x.AddedMembers
+	}
+	return nil
+}
+
+func (x *LDAPGroupChange) GetRemovedMembers() []string {
+	if x != nil {
+		return x.RemovedMembers
+	}
+	return nil
 }
 
 type GetIdentityProviderRequest struct {
@@ -187,7 +357,7 @@ type GetIdentityProviderRequest struct {
 
 func (x *GetIdentityProviderRequest) Reset() {
 	*x = GetIdentityProviderRequest{}
-	mi := &file_v1_idp_service_proto_msgTypes[0]
+	mi := &file_v1_idp_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +369,7 @@ func (x *GetIdentityProviderRequest) String() string {
 func (*GetIdentityProviderRequest) ProtoMessage() {}
 
 func (x *GetIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[0]
+	mi := &file_v1_idp_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +382,7 @@ func (x *GetIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*GetIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{0}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetIdentityProviderRequest) GetName() string {
@@ -243,7 +413,7 @@ type ListIdentityProvidersRequest struct {
 
 func (x *ListIdentityProvidersRequest) Reset() {
 	*x = ListIdentityProvidersRequest{}
-	mi := &file_v1_idp_service_proto_msgTypes[1]
+	mi := &file_v1_idp_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +425,7 @@ func (x *ListIdentityProvidersRequest) String() string {
 func (*ListIdentityProvidersRequest) ProtoMessage() {}
 
 func (x *ListIdentityProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[1]
+	mi := &file_v1_idp_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +438,7 @@ func (x *ListIdentityProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIdentityProvidersRequest.ProtoReflect.Descriptor instead.
 func (*ListIdentityProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{1}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListIdentityProvidersRequest) GetPageSize() int32 {
@@ -298,7 +468,7 @@ type ListIdentityProvidersResponse struct {
 
 func (x *ListIdentityProvidersResponse) Reset() {
 	*x = ListIdentityProvidersResponse{}
-	mi := &file_v1_idp_service_proto_msgTypes[2]
+	mi := &file_v1_idp_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -310,7 +480,7 @@ func (x *ListIdentityProvidersResponse) String() string {
 func (*ListIdentityProvidersResponse) ProtoMessage() {}
 
 func (x *ListIdentityProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[2]
+	mi := &file_v1_idp_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,7 +493,7 @@ func (x *ListIdentityProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIdentityProvidersResponse.ProtoReflect.Descriptor instead.
 func (*ListIdentityProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{2}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListIdentityProvidersResponse) GetIdentityProviders() []*IdentityProvider {
@@ -358,7 +528,7 @@ type CreateIdentityProviderRequest struct {
 
 func (x *CreateIdentityProviderRequest) Reset() {
 	*x = CreateIdentityProviderRequest{}
-	mi := &file_v1_idp_service_proto_msgTypes[3]
+	mi := &file_v1_idp_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +540,7 @@ func (x *CreateIdentityProviderRequest) String() string {
 func (*CreateIdentityProviderRequest) ProtoMessage() {}
 
 func (x *CreateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[3]
+	mi := &file_v1_idp_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +553,7 @@ func (x *CreateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*CreateIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{3}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateIdentityProviderRequest) GetIdentityProvider() *IdentityProvider {
@@ -422,7 +592,7 @@ type UpdateIdentityProviderRequest struct {
 
 func (x *UpdateIdentityProviderRequest) Reset() {
 	*x = UpdateIdentityProviderRequest{}
-	mi := &file_v1_idp_service_proto_msgTypes[4]
+	mi := &file_v1_idp_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +604,7 @@ func (x *UpdateIdentityProviderRequest) String() string {
 func (*UpdateIdentityProviderRequest) ProtoMessage() {}
 
 func (x *UpdateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[4]
+	mi := &file_v1_idp_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +617,7 @@ func (x *UpdateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{4}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateIdentityProviderRequest) GetIdentityProvider() *IdentityProvider {
@@ -475,7 +645,7 @@ type DeleteIdentityProviderRequest struct {
 
 func (x *DeleteIdentityProviderRequest) Reset() {
 	*x = DeleteIdentityProviderRequest{}
-	mi := &file_v1_idp_service_proto_msgTypes[5]
+	mi := &file_v1_idp_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +657,7 @@ func (x *DeleteIdentityProviderRequest) String() string {
 func (*DeleteIdentityProviderRequest) ProtoMessage() {}
 
 func (x *DeleteIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[5]
+	mi := &file_v1_idp_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +670,7 @@ func (x *DeleteIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{5}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteIdentityProviderRequest) GetName() string {
@@ -524,7 +694,7 @@ type TestIdentityProviderRequest struct {
 
 func (x *TestIdentityProviderRequest) Reset() {
 	*x = TestIdentityProviderRequest{}
-	mi := &file_v1_idp_service_proto_msgTypes[6]
+	mi := &file_v1_idp_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +706,7 @@ func (x *TestIdentityProviderRequest) String() string {
 func (*TestIdentityProviderRequest) ProtoMessage() {}
 
 func (x *TestIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[6]
+	mi := &file_v1_idp_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +719,7 @@ func (x *TestIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*TestIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{6}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TestIdentityProviderRequest) GetIdentityProvider() *IdentityProvider {
@@ -595,7 +765,7 @@ type OAuth2IdentityProviderTestRequestContext struct {
 
 func (x *OAuth2IdentityProviderTestRequestContext) Reset() {
 	*x = OAuth2IdentityProviderTestRequestContext{}
-	mi := &file_v1_idp_service_proto_msgTypes[7]
+	mi := &file_v1_idp_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +777,7 @@ func (x *OAuth2IdentityProviderTestRequestContext) String() string {
 func (*OAuth2IdentityProviderTestRequestContext) ProtoMessage() {}
 
 func (x *OAuth2IdentityProviderTestRequestContext) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[7]
+	mi := &file_v1_idp_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +790,7 @@ func (x *OAuth2IdentityProviderTestRequestContext) ProtoReflect() protoreflect.M
 
 // Deprecated: Use OAuth2IdentityProviderTestRequestContext.ProtoReflect.Descriptor instead.
 func (*OAuth2IdentityProviderTestRequestContext) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{7}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OAuth2IdentityProviderTestRequestContext) GetCode() string {
@@ -642,7 +812,7 @@ type TestIdentityProviderResponse struct {
 
 func (x *TestIdentityProviderResponse) Reset() {
 	*x = TestIdentityProviderResponse{}
-	mi := &file_v1_idp_service_proto_msgTypes[8]
+	mi := &file_v1_idp_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +824,7 @@ func (x *TestIdentityProviderResponse) String() string {
 func (*TestIdentityProviderResponse) ProtoMessage() {}
 
 func (x *TestIdentityProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[8]
+	mi := &file_v1_idp_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +837,7 @@ func (x *TestIdentityProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestIdentityProviderResponse.ProtoReflect.Descriptor instead.
 func (*TestIdentityProviderResponse) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{8}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TestIdentityProviderResponse) GetClaims() map[string]string {
@@ -699,7 +869,7 @@ type IdentityProvider struct {
 
 func (x *IdentityProvider) Reset() {
 	*x = IdentityProvider{}
-	mi := &file_v1_idp_service_proto_msgTypes[9]
+	mi := &file_v1_idp_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -711,7 +881,7 @@ func (x *IdentityProvider) String() string {
 func (*IdentityProvider) ProtoMessage() {}
 
 func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[9]
+	mi := &file_v1_idp_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,7 +894,7 @@ func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProvider.ProtoReflect.Descriptor instead.
 func (*IdentityProvider) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{9}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IdentityProvider) GetName() string {
@@ -769,6 +939,7 @@ type IdentityProviderConfig struct {
 	//	*IdentityProviderConfig_Oauth2Config
 	//	*IdentityProviderConfig_OidcConfig
 	//	*IdentityProviderConfig_LdapConfig
+	//	*IdentityProviderConfig_SamlConfig
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -776,7 +947,7 @@ type IdentityProviderConfig struct {
 
 func (x *IdentityProviderConfig) Reset() {
 	*x = IdentityProviderConfig{}
-	mi := &file_v1_idp_service_proto_msgTypes[10]
+	mi := &file_v1_idp_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +959,7 @@ func (x *IdentityProviderConfig) String() string {
 func (*IdentityProviderConfig) ProtoMessage() {}
 
 func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[10]
+	mi := &file_v1_idp_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +972,7 @@ func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProviderConfig.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{10}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *IdentityProviderConfig) GetConfig() isIdentityProviderConfig_Config {
@@ -838,6 +1009,15 @@ func (x *IdentityProviderConfig) GetLdapConfig() *LDAPIdentityProviderConfig {
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetSamlConfig() *SAMLIdentityProviderConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_SamlConfig); ok {
+			return x.SamlConfig
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -854,12 +1034,18 @@ type IdentityProviderConfig_LdapConfig struct {
 	LdapConfig *LDAPIdentityProviderConfig `protobuf:"bytes,3,opt,name=ldap_config,json=ldapConfig,proto3,oneof"`
 }
 
+type IdentityProviderConfig_SamlConfig struct {
+	SamlConfig *SAMLIdentityProviderConfig `protobuf:"bytes,4,opt,name=saml_config,json=samlConfig,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2Config) isIdentityProviderConfig_Config() {}
 
 func (*IdentityProviderConfig_OidcConfig) isIdentityProviderConfig_Config() {}
 
 func (*IdentityProviderConfig_LdapConfig) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_SamlConfig) isIdentityProviderConfig_Config() {}
+
 // OAuth2IdentityProviderConfig is the structure for OAuth2 identity provider config.
 type OAuth2IdentityProviderConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -878,7 +1064,7 @@ type OAuth2IdentityProviderConfig struct {
 
 func (x *OAuth2IdentityProviderConfig) Reset() {
 	*x = OAuth2IdentityProviderConfig{}
-	mi := &file_v1_idp_service_proto_msgTypes[11]
+	mi := &file_v1_idp_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1076,7 @@ func (x *OAuth2IdentityProviderConfig) String() string {
 func (*OAuth2IdentityProviderConfig) ProtoMessage() {}
 
 func (x *OAuth2IdentityProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[11]
+	mi := &file_v1_idp_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1089,7 @@ func (x *OAuth2IdentityProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuth2IdentityProviderConfig.ProtoReflect.Descriptor instead.
 func (*OAuth2IdentityProviderConfig) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *OAuth2IdentityProviderConfig) GetAuthUrl() string {
@@ -990,7 +1176,7 @@ type OIDCIdentityProviderConfig struct {
 
 func (x *OIDCIdentityProviderConfig) Reset() {
 	*x = OIDCIdentityProviderConfig{}
-	mi := &file_v1_idp_service_proto_msgTypes[12]
+	mi := &file_v1_idp_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1002,7 +1188,7 @@ func (x *OIDCIdentityProviderConfig) String() string {
 func (*OIDCIdentityProviderConfig) ProtoMessage() {}
 
 func (x *OIDCIdentityProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[12]
+	mi := &file_v1_idp_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1201,7 @@ func (x *OIDCIdentityProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OIDCIdentityProviderConfig.ProtoReflect.Descriptor instead.
 func (*OIDCIdentityProviderConfig) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{12}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OIDCIdentityProviderConfig) GetIssuer() string {
@@ -1100,14 +1286,17 @@ type LDAPIdentityProviderConfig struct {
 	SecurityProtocol LDAPIdentityProviderConfig_SecurityProtocol `protobuf:"varint,8,opt,name=security_protocol,json=securityProtocol,proto3,enum=bytebase.v1.LDAPIdentityProviderConfig_SecurityProtocol" json:"security_protocol,omitempty"`
 	// FieldMapping is the mapping of the user attributes returned by the LDAP
 	// server.
-	FieldMapping  *FieldMapping `protobuf:"bytes,9,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	FieldMapping *FieldMapping `protobuf:"bytes,9,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// GroupSync is the configuration to synchronize the LDAP groups into
+	// Bytebase groups.
+	GroupSync     *LDAPGroupSyncConfig `protobuf:"bytes,10,opt,name=group_sync,json=groupSync,proto3" json:"group_sync,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LDAPIdentityProviderConfig) Reset() {
 	*x = LDAPIdentityProviderConfig{}
-	mi := &file_v1_idp_service_proto_msgTypes[13]
+	mi := &file_v1_idp_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1308,7 @@ func (x *LDAPIdentityProviderConfig) String() string {
 func (*LDAPIdentityProviderConfig) ProtoMessage() {}
 
 func (x *LDAPIdentityProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[13]
+	mi := &file_v1_idp_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1321,7 @@ func (x *LDAPIdentityProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LDAPIdentityProviderConfig.ProtoReflect.Descriptor instead.
 func (*LDAPIdentityProviderConfig) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{13}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *LDAPIdentityProviderConfig) GetHost() string {
@@ -1198,6 +1387,277 @@ func (x *LDAPIdentityProviderConfig) GetFieldMapping() *FieldMapping {
 	return nil
 }
 
+func (x *LDAPIdentityProviderConfig) GetGroupSync() *LDAPGroupSyncConfig {
+	if x != nil {
+		return x.GroupSync
+	}
+	return nil
+}
+
+// LDAPGroupSyncConfig is the configuration to synchronize the LDAP groups into
+// Bytebase groups.
+type LDAPGroupSyncConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the groups are synchronized periodically.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The base DN to search for nested groups, e.g. "ou=groups,dc=example,dc=com".
+	// When not set, the base DN of the users will be used.
+	GroupBaseDn string `protobuf:"bytes,2,opt,name=group_base_dn,json=groupBaseDn,proto3" json:"group_base_dn,omitempty"`
+	// The attribute of the group entries listing the DNs of the members.
+	// When not set, "member" will be used.
+	MemberAttribute string `protobuf:"bytes,3,opt,name=member_attribute,json=memberAttribute,proto3" json:"member_attribute,omitempty"`
+	// Whether to find the members by the "memberOf" attribute of the user entries
+	// instead of the member attribute of the group entries.
+	UseMemberOf bool `protobuf:"varint,4,opt,name=use_member_of,json=useMemberOf,proto3" json:"use_member_of,omitempty"`
+	// Whether the members of the nested groups are included.
+	Nested bool `protobuf:"varint,5,opt,name=nested,proto3" json:"nested,omitempty"`
+	// The mappings from the LDAP groups to Bytebase groups.
+	Mappings      []*LDAPGroupMapping `protobuf:"bytes,6,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAPGroupSyncConfig) Reset() {
+	*x = LDAPGroupSyncConfig{}
+	mi := &file_v1_idp_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPGroupSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPGroupSyncConfig) ProtoMessage() {}
+
+func (x *LDAPGroupSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_idp_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPGroupSyncConfig.ProtoReflect.Descriptor instead.
+func (*LDAPGroupSyncConfig) Descriptor() ([]byte, []int) {
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LDAPGroupSyncConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LDAPGroupSyncConfig) GetGroupBaseDn() string {
+	if x != nil {
+		return x.GroupBaseDn
+	}
+	return ""
+}
+
+func (x *LDAPGroupSyncConfig) GetMemberAttribute() string {
+	if x != nil {
+		return x.MemberAttribute
+	}
+	return ""
+}
+
+func (x *LDAPGroupSyncConfig) GetUseMemberOf() bool {
+	if x != nil {
+		return x.UseMemberOf
+	}
+	return false
+}
+
+func (x *LDAPGroupSyncConfig) GetNested() bool {
+	if x != nil {
+		return x.Nested
+	}
+	return false
+}
+
+func (x *LDAPGroupSyncConfig) GetMappings() []*LDAPGroupMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// LDAPGroupMapping maps an LDAP group or the users matching a filter to a
+// Bytebase group.
+type LDAPGroupMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The DN of the LDAP group, e.g. "cn=dba,ou=groups,dc=example,dc=com".
+	GroupDn string `protobuf:"bytes,1,opt,name=group_dn,json=groupDn,proto3" json:"group_dn,omitempty"`
+	// The filter to search for the member users under the base DN, e.g.
+	// "(department=DBA)". It takes precedence over the group DN.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The email of the Bytebase group, e.g. "dba@example.com".
+	Group         string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAPGroupMapping) Reset() {
+	*x = LDAPGroupMapping{}
+	mi := &file_v1_idp_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPGroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPGroupMapping) ProtoMessage() {}
+
+func (x *LDAPGroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_idp_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPGroupMapping.ProtoReflect.Descriptor instead.
+func (*LDAPGroupMapping) Descriptor() ([]byte, []int) {
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LDAPGroupMapping) GetGroupDn() string {
+	if x != nil {
+		return x.GroupDn
+	}
+	return ""
+}
+
+func (x *LDAPGroupMapping) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *LDAPGroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// SAMLIdentityProviderConfig is the structure for SAML 2.0 identity provider config.
+// Bytebase acts as the service provider, whose metadata is served at "{external_url}/saml/metadata/{idp}"
+// and assertion consumer service URL is "{external_url}/saml/acs/{idp}".
+type SAMLIdentityProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entity ID of the identity provider, i.e. the issuer of the assertions.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The single sign-on URL of the identity provider for the HTTP-Redirect binding.
+	SsoUrl string `protobuf:"bytes,2,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	// The PEM encoded X.509 certificate used by the identity provider to sign the responses and assertions.
+	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// The PEM encoded X.509 certificate of the service provider published in the metadata.
+	SpCertificate string `protobuf:"bytes,4,opt,name=sp_certificate,json=spCertificate,proto3" json:"sp_certificate,omitempty"`
+	// The PEM encoded private key of the service provider to sign the AuthnRequest.
+	// The AuthnRequest is not signed if it's empty.
+	SpPrivateKey string `protobuf:"bytes,5,opt,name=sp_private_key,json=spPrivateKey,proto3" json:"sp_private_key,omitempty"`
+	// The requested name identifier format, e.g. "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress".
+	NameIdFormat string `protobuf:"bytes,6,opt,name=name_id_format,json=nameIdFormat,proto3" json:"name_id_format,omitempty"`
+	// The mapping of the assertion attributes. The "NameID" refers to the name identifier of the subject.
+	FieldMapping  *FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLIdentityProviderConfig) Reset() {
+	*x = SAMLIdentityProviderConfig{}
+	mi := &file_v1_idp_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLIdentityProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLIdentityProviderConfig) ProtoMessage() {}
+
+func (x *SAMLIdentityProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_idp_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLIdentityProviderConfig.ProtoReflect.Descriptor instead.
+func (*SAMLIdentityProviderConfig) Descriptor() ([]byte, []int) {
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SAMLIdentityProviderConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetSpCertificate() string {
+	if x != nil {
+		return x.SpCertificate
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetSpPrivateKey() string {
+	if x != nil {
+		return x.SpPrivateKey
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetNameIdFormat() string {
+	if x != nil {
+		return x.NameIdFormat
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetFieldMapping() *FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
 // FieldMapping saves the field names from user info API of identity provider.
 // As we save all raw json string of user info response data into `principal.idp_user_info`,
 // we can extract the relevant data based with `FieldMapping`.
@@ -1218,7 +1678,7 @@ type FieldMapping struct {
 
 func (x *FieldMapping) Reset() {
 	*x = FieldMapping{}
-	mi := &file_v1_idp_service_proto_msgTypes[14]
+	mi := &file_v1_idp_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1230,7 +1690,7 @@ func (x *FieldMapping) String() string {
 func (*FieldMapping) ProtoMessage() {}
 
 func (x *FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_idp_service_proto_msgTypes[14]
+	mi := &file_v1_idp_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1703,7 @@ func (x *FieldMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldMapping.ProtoReflect.Descriptor instead.
 func (*FieldMapping) Descriptor() ([]byte, []int) {
-	return file_v1_idp_service_proto_rawDescGZIP(), []int{14}
+	return file_v1_idp_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *FieldMapping) GetIdentifier() string {
@@ -1278,7 +1738,17 @@ var File_v1_idp_service_proto protoreflect.FileDescriptor
 
 const file_v1_idp_service_proto_rawDesc = "" +
 	"\n" +
-	"\x14v1/idp_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\"J\n" +
+	"\x14v1/idp_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\"j\n" +
+	"\x15SyncLDAPGroupsRequest\x12,\n" +
+	"\x04name\x18\x01 \x01(\tB\x18\xe0A\x02\xfaA\x12\n" +
+	"\x10bytebase.com/IdPR\x04name\x12#\n" +
+	"\rvalidate_only\x18\x02 \x01(\bR\fvalidateOnly\"P\n" +
+	"\x16SyncLDAPGroupsResponse\x126\n" +
+	"\achanges\x18\x01 \x03(\v2\x1c.bytebase.v1.LDAPGroupChangeR\achanges\"u\n" +
+	"\x0fLDAPGroupChange\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12#\n" +
+	"\radded_members\x18\x02 \x03(\tR\faddedMembers\x12'\n" +
+	"\x0fremoved_members\x18\x03 \x03(\tR\x0eremovedMembers\"J\n" +
 	"\x1aGetIdentityProviderRequest\x12,\n" +
 	"\x04name\x18\x01 \x01(\tB\x18\xe0A\x02\xfaA\x12\n" +
 	"\x10bytebase.com/IdPR\x04name\"Z\n" +
@@ -1322,13 +1792,15 @@ const file_v1_idp_service_proto_rawDesc = "" +
 	"\x04type\x18\x06 \x01(\x0e2!.bytebase.v1.IdentityProviderTypeR\x04type\x12;\n" +
 	"\x06config\x18\a \x01(\v2#.bytebase.v1.IdentityProviderConfigR\x06config:!\xeaA\x1e\n" +
 	"\x10bytebase.com/IdP\x12\n" +
-	"idps/{idp}J\x04\b\x02\x10\x03\"\x8c\x02\n" +
+	"idps/{idp}J\x04\b\x02\x10\x03\"\xd8\x02\n" +
 	"\x16IdentityProviderConfig\x12P\n" +
 	"\roauth2_config\x18\x01 \x01(\v2).bytebase.v1.OAuth2IdentityProviderConfigH\x00R\foauth2Config\x12J\n" +
 	"\voidc_config\x18\x02 \x01(\v2'.bytebase.v1.OIDCIdentityProviderConfigH\x00R\n" +
 	"oidcConfig\x12J\n" +
 	"\vldap_config\x18\x03 \x01(\v2'.bytebase.v1.LDAPIdentityProviderConfigH\x00R\n" +
-	"ldapConfigB\b\n" +
+	"ldapConfig\x12J\n" +
+	"\vsaml_config\x18\x04 \x01(\v2'.bytebase.v1.SAMLIdentityProviderConfigH\x00R\n" +
+	"samlConfigB\b\n" +
 	"\x06config\"\xf9\x02\n" +
 	"\x1cOAuth2IdentityProviderConfig\x12\x19\n" +
 	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x12\x1b\n" +
@@ -1350,7 +1822,7 @@ const file_v1_idp_service_proto_rawDesc = "" +
 	"\x0fskip_tls_verify\x18\x06 \x01(\bR\rskipTlsVerify\x12;\n" +
 	"\n" +
 	"auth_style\x18\a \x01(\x0e2\x1c.bytebase.v1.OAuth2AuthStyleR\tauthStyle\x12(\n" +
-	"\rauth_endpoint\x18\b \x01(\tB\x03\xe0A\x03R\fauthEndpoint\"\xdc\x03\n" +
+	"\rauth_endpoint\x18\b \x01(\tB\x03\xe0A\x03R\fauthEndpoint\"\x9d\x04\n" +
 	"\x1aLDAPIdentityProviderConfig\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12&\n" +
@@ -1361,28 +1833,51 @@ const file_v1_idp_service_proto_rawDesc = "" +
 	"\vuser_filter\x18\a \x01(\tR\n" +
 	"userFilter\x12e\n" +
 	"\x11security_protocol\x18\b \x01(\x0e28.bytebase.v1.LDAPIdentityProviderConfig.SecurityProtocolR\x10securityProtocol\x12>\n" +
-	"\rfield_mapping\x18\t \x01(\v2\x19.bytebase.v1.FieldMappingR\ffieldMapping\"O\n" +
+	"\rfield_mapping\x18\t \x01(\v2\x19.bytebase.v1.FieldMappingR\ffieldMapping\x12?\n" +
+	"\n" +
+	"group_sync\x18\n" +
+	" \x01(\v2 .bytebase.v1.LDAPGroupSyncConfigR\tgroupSync\"O\n" +
 	"\x10SecurityProtocol\x12!\n" +
 	"\x1dSECURITY_PROTOCOL_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tSTART_TLS\x10\x01\x12\t\n" +
-	"\x05LDAPS\x10\x02\"\x85\x01\n" +
+	"\x05LDAPS\x10\x02\"\xf5\x01\n" +
+	"\x13LDAPGroupSyncConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\"\n" +
+	"\rgroup_base_dn\x18\x02 \x01(\tR\vgroupBaseDn\x12)\n" +
+	"\x10member_attribute\x18\x03 \x01(\tR\x0fmemberAttribute\x12\"\n" +
+	"\ruse_member_of\x18\x04 \x01(\bR\vuseMemberOf\x12\x16\n" +
+	"\x06nested\x18\x05 \x01(\bR\x06nested\x129\n" +
+	"\bmappings\x18\x06 \x03(\v2\x1d.bytebase.v1.LDAPGroupMappingR\bmappings\"[\n" +
+	"\x10LDAPGroupMapping\x12\x19\n" +
+	"\bgroup_dn\x18\x01 \x01(\tR\agroupDn\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\"\xac\x02\n" +
+	"\x1aSAMLIdentityProviderConfig\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x17\n" +
+	"\asso_url\x18\x02 \x01(\tR\x06ssoUrl\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12%\n" +
+	"\x0esp_certificate\x18\x04 \x01(\tR\rspCertificate\x12)\n" +
+	"\x0esp_private_key\x18\x05 \x01(\tB\x03\xe0A\x04R\fspPrivateKey\x12$\n" +
+	"\x0ename_id_format\x18\x06 \x01(\tR\fnameIdFormat\x12>\n" +
+	"\rfield_mapping\x18\a \x01(\v2\x19.bytebase.v1.FieldMappingR\ffieldMapping\"\x85\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
 	"identifier\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x16\n" +
-	"\x06groups\x18\x05 \x01(\tR\x06groupsJ\x04\b\x03\x10\x04*^\n" +
+	"\x06groups\x18\x05 \x01(\tR\x06groupsJ\x04\b\x03\x10\x04*h\n" +
 	"\x14IdentityProviderType\x12&\n" +
 	"\"IDENTITY_PROVIDER_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06OAUTH2\x10\x01\x12\b\n" +
 	"\x04OIDC\x10\x02\x12\b\n" +
-	"\x04LDAP\x10\x03*R\n" +
+	"\x04LDAP\x10\x03\x12\b\n" +
+	"\x04SAML\x10\x04*R\n" +
 	"\x0fOAuth2AuthStyle\x12!\n" +
 	"\x1dOAUTH2_AUTH_STYLE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tIN_PARAMS\x10\x01\x12\r\n" +
-	"\tIN_HEADER\x10\x022\xbd\b\n" +
+	"\tIN_HEADER\x10\x022\xf4\t\n" +
 	"\x17IdentityProviderService\x12\x9f\x01\n" +
 	"\x13GetIdentityProvider\x12'.bytebase.v1.GetIdentityProviderRequest\x1a\x1d.bytebase.v1.IdentityProvider\"@\xdaA\x04name\x8a\xea0\x18bb.identityProviders.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/{name=idps/*}\x12\x87\x01\n" +
 	"\x15ListIdentityProviders\x12).bytebase.v1.ListIdentityProvidersRequest\x1a*.bytebase.v1.ListIdentityProvidersResponse\"\x17\xdaA\x00\x80\xea0\x01\x82\xd3\xe4\x93\x02\n" +
@@ -1390,7 +1885,8 @@ const file_v1_idp_service_proto_rawDesc = "" +
 	"\x16CreateIdentityProvider\x12*.bytebase.v1.CreateIdentityProviderRequest\x1a\x1d.bytebase.v1.IdentityProvider\"M\xdaA\x00\x8a\xea0\x1bbb.identityProviders.create\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x1d:\x11identity_provider\"\b/v1/idps\x12\xeb\x01\n" +
 	"\x16UpdateIdentityProvider\x12*.bytebase.v1.UpdateIdentityProviderRequest\x1a\x1d.bytebase.v1.IdentityProvider\"\x85\x01\xdaA\x1didentity_provider,update_mask\x8a\xea0\x1bbb.identityProviders.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x028:\x11identity_provider2#/v1/{identity_provider.name=idps/*}\x12\xa5\x01\n" +
 	"\x16DeleteIdentityProvider\x12*.bytebase.v1.DeleteIdentityProviderRequest\x1a\x16.google.protobuf.Empty\"G\xdaA\x04name\x8a\xea0\x1bbb.identityProviders.delete\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x13*\x11/v1/{name=idps/*}\x12\xaa\x01\n" +
-	"\x14TestIdentityProvider\x12(.bytebase.v1.TestIdentityProviderRequest\x1a).bytebase.v1.TestIdentityProviderResponse\"=\x8a\xea0\x1bbb.identityProviders.update\x90\xea0\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/idps/*:testB6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
+	"\x14TestIdentityProvider\x12(.bytebase.v1.TestIdentityProviderRequest\x1a).bytebase.v1.TestIdentityProviderResponse\"=\x8a\xea0\x1bbb.identityProviders.update\x90\xea0\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/idps/*:test\x12\xb4\x01\n" +
+	"\x0eSyncLDAPGroups\x12\".bytebase.v1.SyncLDAPGroupsRequest\x1a#.bytebase.v1.SyncLDAPGroupsResponse\"Y\xdaA\x04name\x8a\xea0\x1bbb.identityProviders.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02%:\x01*\" /v1/{name=idps/*}:syncLDAPGroupsB6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
 
 var (
 	file_v1_idp_service_proto_rawDescOnce sync.Once
@@ -1405,68 +1901,81 @@ func file_v1_idp_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_idp_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_v1_idp_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_v1_idp_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_v1_idp_service_proto_goTypes = []any{
 	(IdentityProviderType)(0),                        // 0: bytebase.v1.IdentityProviderType
 	(OAuth2AuthStyle)(0),                             // 1: bytebase.v1.OAuth2AuthStyle
 	(LDAPIdentityProviderConfig_SecurityProtocol)(0), // 2: bytebase.v1.LDAPIdentityProviderConfig.SecurityProtocol
-	(*GetIdentityProviderRequest)(nil),               // 3: bytebase.v1.GetIdentityProviderRequest
-	(*ListIdentityProvidersRequest)(nil),             // 4: bytebase.v1.ListIdentityProvidersRequest
-	(*ListIdentityProvidersResponse)(nil),            // 5: bytebase.v1.ListIdentityProvidersResponse
-	(*CreateIdentityProviderRequest)(nil),            // 6: bytebase.v1.CreateIdentityProviderRequest
-	(*UpdateIdentityProviderRequest)(nil),            // 7: bytebase.v1.UpdateIdentityProviderRequest
-	(*DeleteIdentityProviderRequest)(nil),            // 8: bytebase.v1.DeleteIdentityProviderRequest
-	(*TestIdentityProviderRequest)(nil),              // 9: bytebase.v1.TestIdentityProviderRequest
-	(*OAuth2IdentityProviderTestRequestContext)(nil), // 10: bytebase.v1.OAuth2IdentityProviderTestRequestContext
-	(*TestIdentityProviderResponse)(nil),             // 11: bytebase.v1.TestIdentityProviderResponse
-	(*IdentityProvider)(nil),                         // 12: bytebase.v1.IdentityProvider
-	(*IdentityProviderConfig)(nil),                   // 13: bytebase.v1.IdentityProviderConfig
-	(*OAuth2IdentityProviderConfig)(nil),             // 14: bytebase.v1.OAuth2IdentityProviderConfig
-	(*OIDCIdentityProviderConfig)(nil),               // 15: bytebase.v1.OIDCIdentityProviderConfig
-	(*LDAPIdentityProviderConfig)(nil),               // 16: bytebase.v1.LDAPIdentityProviderConfig
-	(*FieldMapping)(nil),                             // 17: bytebase.v1.FieldMapping
-	nil,                                              // 18: bytebase.v1.TestIdentityProviderResponse.ClaimsEntry
-	nil,                                              // 19: bytebase.v1.TestIdentityProviderResponse.UserInfoEntry
-	(*fieldmaskpb.FieldMask)(nil),                    // 20: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                            // 21: google.protobuf.Empty
+	(*SyncLDAPGroupsRequest)(nil),                    // 3: bytebase.v1.SyncLDAPGroupsRequest
+	(*SyncLDAPGroupsResponse)(nil),                   // 4: bytebase.v1.SyncLDAPGroupsResponse
+	(*LDAPGroupChange)(nil),                          // 5: bytebase.v1.LDAPGroupChange
+	(*GetIdentityProviderRequest)(nil),               // 6: bytebase.v1.GetIdentityProviderRequest
+	(*ListIdentityProvidersRequest)(nil),             // 7: bytebase.v1.ListIdentityProvidersRequest
+	(*ListIdentityProvidersResponse)(nil),            // 8: bytebase.v1.ListIdentityProvidersResponse
+	(*CreateIdentityProviderRequest)(nil),            // 9: bytebase.v1.CreateIdentityProviderRequest
+	(*UpdateIdentityProviderRequest)(nil),            // 10: bytebase.v1.UpdateIdentityProviderRequest
+	(*DeleteIdentityProviderRequest)(nil),            // 11: bytebase.v1.DeleteIdentityProviderRequest
+	(*TestIdentityProviderRequest)(nil),              // 12: bytebase.v1.TestIdentityProviderRequest
+	(*OAuth2IdentityProviderTestRequestContext)(nil), // 13: bytebase.v1.OAuth2IdentityProviderTestRequestContext
+	(*TestIdentityProviderResponse)(nil),             // 14: bytebase.v1.TestIdentityProviderResponse
+	(*IdentityProvider)(nil),                         // 15: bytebase.v1.IdentityProvider
+	(*IdentityProviderConfig)(nil),                   // 16: bytebase.v1.IdentityProviderConfig
+	(*OAuth2IdentityProviderConfig)(nil),             // 17: bytebase.v1.OAuth2IdentityProviderConfig
+	(*OIDCIdentityProviderConfig)(nil),               // 18: bytebase.v1.OIDCIdentityProviderConfig
+	(*LDAPIdentityProviderConfig)(nil),               // 19: bytebase.v1.LDAPIdentityProviderConfig
+	(*LDAPGroupSyncConfig)(nil),                      // 20: bytebase.v1.LDAPGroupSyncConfig
+	(*LDAPGroupMapping)(nil),                         // 21: bytebase.v1.LDAPGroupMapping
+	(*SAMLIdentityProviderConfig)(nil),               // 22: bytebase.v1.SAMLIdentityProviderConfig
+	(*FieldMapping)(nil),                             // 23: bytebase.v1.FieldMapping
+	nil,                                              // 24: bytebase.v1.TestIdentityProviderResponse.ClaimsEntry
+	nil,                                              // 25: bytebase.v1.TestIdentityProviderResponse.UserInfoEntry
+	(*fieldmaskpb.FieldMask)(nil),                    // 26: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                            // 27: google.protobuf.Empty
 }
 var file_v1_idp_service_proto_depIdxs = []int32{
-	12, // 0: bytebase.v1.ListIdentityProvidersResponse.identity_providers:type_name -> bytebase.v1.IdentityProvider
-	12, // 1: bytebase.v1.CreateIdentityProviderRequest.identity_provider:type_name -> bytebase.v1.IdentityProvider
-	12, // 2: bytebase.v1.UpdateIdentityProviderRequest.identity_provider:type_name -> bytebase.v1.IdentityProvider
-	20, // 3: bytebase.v1.UpdateIdentityProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 4: bytebase.v1.TestIdentityProviderRequest.identity_provider:type_name -> bytebase.v1.IdentityProvider
-	10, // 5: bytebase.v1.TestIdentityProviderRequest.oauth2_context:type_name -> bytebase.v1.OAuth2IdentityProviderTestRequestContext
-	18, // 6: bytebase.v1.TestIdentityProviderResponse.claims:type_name -> bytebase.v1.TestIdentityProviderResponse.ClaimsEntry
-	19, // 7: bytebase.v1.TestIdentityProviderResponse.user_info:type_name -> bytebase.v1.TestIdentityProviderResponse.UserInfoEntry
-	0,  // 8: bytebase.v1.IdentityProvider.type:type_name -> bytebase.v1.IdentityProviderType
-	13, // 9: bytebase.v1.IdentityProvider.config:type_name -> bytebase.v1.IdentityProviderConfig
-	14, // 10: bytebase.v1.IdentityProviderConfig.oauth2_config:type_name -> bytebase.v1.OAuth2IdentityProviderConfig
-	15, // 11: bytebase.v1.IdentityProviderConfig.oidc_config:type_name -> bytebase.v1.OIDCIdentityProviderConfig
-	16, // 12: bytebase.v1.IdentityProviderConfig.ldap_config:type_name -> bytebase.v1.LDAPIdentityProviderConfig
-	17, // 13: bytebase.v1.OAuth2IdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
-	1,  // 14: bytebase.v1.OAuth2IdentityProviderConfig.auth_style:type_name -> bytebase.v1.OAuth2AuthStyle
-	17, // 15: bytebase.v1.OIDCIdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
-	1,  // 16: bytebase.v1.OIDCIdentityProviderConfig.auth_style:type_name -> bytebase.v1.OAuth2AuthStyle
-	2,  // 17: bytebase.v1.LDAPIdentityProviderConfig.security_protocol:type_name -> bytebase.v1.LDAPIdentityProviderConfig.SecurityProtocol
-	17, // 18: bytebase.v1.LDAPIdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
-	3,  // 19: bytebase.v1.IdentityProviderService.GetIdentityProvider:input_type -> bytebase.v1.GetIdentityProviderRequest
-	4,  // 20: bytebase.v1.IdentityProviderService.ListIdentityProviders:input_type -> bytebase.v1.ListIdentityProvidersRequest
-	6,  // 21: bytebase.v1.IdentityProviderService.CreateIdentityProvider:input_type -> bytebase.v1.CreateIdentityProviderRequest
-	7,  // 22: bytebase.v1.IdentityProviderService.UpdateIdentityProvider:input_type -> bytebase.v1.UpdateIdentityProviderRequest
-	8,  // 23: bytebase.v1.IdentityProviderService.DeleteIdentityProvider:input_type -> bytebase.v1.DeleteIdentityProviderRequest
-	9,  // 24: bytebase.v1.IdentityProviderService.TestIdentityProvider:input_type -> bytebase.v1.TestIdentityProviderRequest
-	12, // 25: bytebase.v1.IdentityProviderService.GetIdentityProvider:output_type -> bytebase.v1.IdentityProvider
-	5,  // 26: bytebase.v1.IdentityProviderService.ListIdentityProviders:output_type -> bytebase.v1.ListIdentityProvidersResponse
-	12, // 27: bytebase.v1.IdentityProviderService.CreateIdentityProvider:output_type -> bytebase.v1.IdentityProvider
-	12, // 28: bytebase.v1.IdentityProviderService.UpdateIdentityProvider:output_type -> bytebase.v1.IdentityProvider
-	21, // 29: bytebase.v1.IdentityProviderService.DeleteIdentityProvider:output_type -> google.protobuf.Empty
-	11, // 30: bytebase.v1.IdentityProviderService.TestIdentityProvider:output_type -> bytebase.v1.TestIdentityProviderResponse
-	25, // [25:31] is the sub-list for method output_type
-	19, // [19:25] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	5,  // 0: bytebase.v1.SyncLDAPGroupsResponse.changes:type_name -> bytebase.v1.LDAPGroupChange
+	15, // 1: bytebase.v1.ListIdentityProvidersResponse.identity_providers:type_name -> bytebase.v1.IdentityProvider
+	15, // 2: bytebase.v1.CreateIdentityProviderRequest.identity_provider:type_name -> bytebase.v1.IdentityProvider
+	15, // 3: bytebase.v1.UpdateIdentityProviderRequest.identity_provider:type_name -> bytebase.v1.IdentityProvider
+	26, // 4: bytebase.v1.UpdateIdentityProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	15, // 5: bytebase.v1.TestIdentityProviderRequest.identity_provider:type_name -> bytebase.v1.IdentityProvider
+	13, // 6: bytebase.v1.TestIdentityProviderRequest.oauth2_context:type_name -> bytebase.v1.OAuth2IdentityProviderTestRequestContext
+	24, // 7: bytebase.v1.TestIdentityProviderResponse.claims:type_name -> bytebase.v1.TestIdentityProviderResponse.ClaimsEntry
+	25, // 8: bytebase.v1.TestIdentityProviderResponse.user_info:type_name -> bytebase.v1.TestIdentityProviderResponse.UserInfoEntry
+	0,  // 9: bytebase.v1.IdentityProvider.type:type_name -> bytebase.v1.IdentityProviderType
+	16, // 10: bytebase.v1.IdentityProvider.config:type_name -> bytebase.v1.IdentityProviderConfig
+	17, // 11: bytebase.v1.IdentityProviderConfig.oauth2_config:type_name -> bytebase.v1.OAuth2IdentityProviderConfig
+	18, // 12: bytebase.v1.IdentityProviderConfig.oidc_config:type_name -> bytebase.v1.OIDCIdentityProviderConfig
+	19, // 13: bytebase.v1.IdentityProviderConfig.ldap_config:type_name -> bytebase.v1.LDAPIdentityProviderConfig
+	22, // 14: bytebase.v1.IdentityProviderConfig.saml_config:type_name -> bytebase.v1.SAMLIdentityProviderConfig
+	23, // 15: bytebase.v1.OAuth2IdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
+	1,  // 16: bytebase.v1.OAuth2IdentityProviderConfig.auth_style:type_name -> bytebase.v1.OAuth2AuthStyle
+	23, // 17: bytebase.v1.OIDCIdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
+	1,  // 18: bytebase.v1.OIDCIdentityProviderConfig.auth_style:type_name -> bytebase.v1.OAuth2AuthStyle
+	2,  // 19: bytebase.v1.LDAPIdentityProviderConfig.security_protocol:type_name -> bytebase.v1.LDAPIdentityProviderConfig.SecurityProtocol
+	23, // 20: bytebase.v1.LDAPIdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
+	20, // 21: bytebase.v1.LDAPIdentityProviderConfig.group_sync:type_name -> bytebase.v1.LDAPGroupSyncConfig
+	21, // 22: bytebase.v1.LDAPGroupSyncConfig.mappings:type_name -> bytebase.v1.LDAPGroupMapping
+	23, // 23: bytebase.v1.SAMLIdentityProviderConfig.field_mapping:type_name -> bytebase.v1.FieldMapping
+	6,  // 24: bytebase.v1.IdentityProviderService.GetIdentityProvider:input_type -> bytebase.v1.GetIdentityProviderRequest
+	7,  // 25: bytebase.v1.IdentityProviderService.ListIdentityProviders:input_type -> bytebase.v1.ListIdentityProvidersRequest
+	9,  // 26: bytebase.v1.IdentityProviderService.CreateIdentityProvider:input_type -> bytebase.v1.CreateIdentityProviderRequest
+	10, // 27: bytebase.v1.IdentityProviderService.UpdateIdentityProvider:input_type -> bytebase.v1.UpdateIdentityProviderRequest
+	11, // 28: bytebase.v1.IdentityProviderService.DeleteIdentityProvider:input_type -> bytebase.v1.DeleteIdentityProviderRequest
+	12, // 29: bytebase.v1.IdentityProviderService.TestIdentityProvider:input_type -> bytebase.v1.TestIdentityProviderRequest
+	3,  // 30: bytebase.v1.IdentityProviderService.SyncLDAPGroups:input_type -> bytebase.v1.SyncLDAPGroupsRequest
+	15, // 31: bytebase.v1.IdentityProviderService.GetIdentityProvider:output_type -> bytebase.v1.IdentityProvider
+	8,  // 32: bytebase.v1.IdentityProviderService.ListIdentityProviders:output_type -> bytebase.v1.ListIdentityProvidersResponse
+	15, // 33: bytebase.v1.IdentityProviderService.CreateIdentityProvider:output_type -> bytebase.v1.IdentityProvider
+	15, // 34: bytebase.v1.IdentityProviderService.UpdateIdentityProvider:output_type -> bytebase.v1.IdentityProvider
+	27, // 35: bytebase.v1.IdentityProviderService.DeleteIdentityProvider:output_type -> google.protobuf.Empty
+	14, // 36: bytebase.v1.IdentityProviderService.TestIdentityProvider:output_type -> bytebase.v1.TestIdentityProviderResponse
+	4,  // 37: bytebase.v1.IdentityProviderService.SyncLDAPGroups:output_type -> bytebase.v1.SyncLDAPGroupsResponse
+	31, // [31:38] is the sub-list for method output_type
+	24, // [24:31] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_v1_idp_service_proto_init() }
@@ -1475,13 +1984,14 @@ func file_v1_idp_service_proto_init() {
 		return
 	}
 	file_v1_annotation_proto_init()
-	file_v1_idp_service_proto_msgTypes[6].OneofWrappers = []any{
+	file_v1_idp_service_proto_msgTypes[9].OneofWrappers = []any{
 		(*TestIdentityProviderRequest_Oauth2Context)(nil),
 	}
-	file_v1_idp_service_proto_msgTypes[10].OneofWrappers = []any{
+	file_v1_idp_service_proto_msgTypes[13].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2Config)(nil),
 		(*IdentityProviderConfig_OidcConfig)(nil),
 		(*IdentityProviderConfig_LdapConfig)(nil),
+		(*IdentityProviderConfig_SamlConfig)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1489,7 +1999,7 @@ func file_v1_idp_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_idp_service_proto_rawDesc), len(file_v1_idp_service_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   17,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ const (
 	IdentityProviderService_UpdateIdentityProvider_FullMethodName = "/bytebase.v1.IdentityProviderService/UpdateIdentityProvider"
 	IdentityProviderService_DeleteIdentityProvider_FullMethodName = "/bytebase.v1.IdentityProviderService/DeleteIdentityProvider"
 	IdentityProviderService_TestIdentityProvider_FullMethodName   = "/bytebase.v1.IdentityProviderService/TestIdentityProvider"
+	IdentityProviderService_SyncLDAPGroups_FullMethodName         = "/bytebase.v1.IdentityProviderService/SyncLDAPGroups"
 )
 
 // IdentityProviderServiceClient is the client API for IdentityProviderService service.
@@ -44,6 +45,9 @@ type IdentityProviderServiceClient interface {
 	DeleteIdentityProvider(ctx context.Context, in *DeleteIdentityProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Permissions required: bb.identityProviders.update
 	TestIdentityProvider(ctx context.Context, in *TestIdentityProviderRequest, opts ...grpc.CallOption) (*TestIdentityProviderResponse, error)
+	// Synchronizes the LDAP groups into Bytebase groups.
+	// Permissions required: bb.identityProviders.update
+	SyncLDAPGroups(ctx context.Context, in *SyncLDAPGroupsRequest, opts ...grpc.CallOption) (*SyncLDAPGroupsResponse, error)
 }
 
 type identityProviderServiceClient struct {
@@ -114,6 +118,16 @@ func (c *identityProviderServiceClient) TestIdentityProvider(ctx context.Context
 	return out, nil
 }
 
+func (c *identityProviderServiceClient) SyncLDAPGroups(ctx context.Context, in *SyncLDAPGroupsRequest, opts ...grpc.CallOption) (*SyncLDAPGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncLDAPGroupsResponse)
+	err := c.cc.Invoke(ctx, IdentityProviderService_SyncLDAPGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IdentityProviderServiceServer is the server API for IdentityProviderService service.
 // All implementations must embed UnimplementedIdentityProviderServiceServer
 // for forward compatibility.
@@ -130,6 +144,9 @@ type IdentityProviderServiceServer interface {
 	DeleteIdentityProvider(context.Context, *DeleteIdentityProviderRequest) (*emptypb.Empty, error)
 	// Permissions required: bb.identityProviders.update
 	TestIdentityProvider(context.Context, *TestIdentityProviderRequest) (*TestIdentityProviderResponse, error)
+	// Synchronizes the LDAP groups into Bytebase groups.
+	// Permissions required: bb.identityProviders.update
+	SyncLDAPGroups(context.Context, *SyncLDAPGroupsRequest) (*SyncLDAPGroupsResponse, error)
 	mustEmbedUnimplementedIdentityProviderServiceServer()
 }
 
@@ -158,6 +175,9 @@ func (UnimplementedIdentityProviderServiceServer) DeleteIdentityProvider(context
 func (UnimplementedIdentityProviderServiceServer) TestIdentityProvider(context.Context, *TestIdentityProviderRequest) (*TestIdentityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestIdentityProvider not implemented")
 }
+func (UnimplementedIdentityProviderServiceServer) SyncLDAPGroups(context.Context, *SyncLDAPGroupsRequest) (*SyncLDAPGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncLDAPGroups not implemented")
+}
 func (UnimplementedIdentityProviderServiceServer) mustEmbedUnimplementedIdentityProviderServiceServer() {
 }
 func (UnimplementedIdentityProviderServiceServer) testEmbeddedByValue() {}
@@ -288,6 +308,24 @@ func _IdentityProviderService_TestIdentityProvider_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityProviderService_SyncLDAPGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncLDAPGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityProviderServiceServer).SyncLDAPGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityProviderService_SyncLDAPGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityProviderServiceServer).SyncLDAPGroups(ctx, req.(*SyncLDAPGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IdentityProviderService_ServiceDesc is the grpc.ServiceDesc for IdentityProviderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -319,6 +357,10 @@ var IdentityProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TestIdentityProvider",
 			Handler:    _IdentityProviderService_TestIdentityProvider_Handler,
 		},
+		{
+			MethodName: "SyncLDAPGroups",
+			Handler:    _IdentityProviderService_SyncLDAPGroups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/idp_service.proto",
@@ -93,6 +93,8 @@ const (
 	PlanCheckRun_DATABASE_STATEMENT_SUMMARY_REPORT PlanCheckRun_Type = 5
 	PlanCheckRun_DATABASE_CONNECT                  PlanCheckRun_Type = 6
 	PlanCheckRun_DATABASE_GHOST_SYNC               PlanCheckRun_Type = 7
+	PlanCheckRun_DATABASE_STATEMENT_LOCK_IMPACT    PlanCheckRun_Type = 8
+	PlanCheckRun_DATABASE_STATEMENT_ONLINE_DDL     PlanCheckRun_Type = 9
 )
 
 // Enum value maps for PlanCheckRun_Type.
@@ -104,6 +106,8 @@ var (
 		5: "DATABASE_STATEMENT_SUMMARY_REPORT",
 		6: "DATABASE_CONNECT",
 		7: "DATABASE_GHOST_SYNC",
+		8: "DATABASE_STATEMENT_LOCK_IMPACT",
+		9: "DATABASE_STATEMENT_ONLINE_DDL",
 	}
 	PlanCheckRun_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                  0,
@@ -112,6 +116,8 @@ var (
 		"DATABASE_STATEMENT_SUMMARY_REPORT": 5,
 		"DATABASE_CONNECT":                  6,
 		"DATABASE_GHOST_SYNC":               7,
+		"DATABASE_STATEMENT_LOCK_IMPACT":    8,
+		"DATABASE_STATEMENT_ONLINE_DDL":     9,
 	}
 )
 
@@ -249,6 +255,177 @@ func (PlanCheckRun_Result_Status) EnumDescriptor() ([]byte, []int) {
 	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 0}
 }
 
+type PlanCheckRun_Result_StatementLockImpact_LockMode int32
+
+const (
+	PlanCheckRun_Result_StatementLockImpact_LOCK_MODE_UNSPECIFIED  PlanCheckRun_Result_StatementLockImpact_LockMode = 0
+	PlanCheckRun_Result_StatementLockImpact_ACCESS_SHARE           PlanCheckRun_Result_StatementLockImpact_LockMode = 1
+	PlanCheckRun_Result_StatementLockImpact_ROW_SHARE              PlanCheckRun_Result_StatementLockImpact_LockMode = 2
+	PlanCheckRun_Result_StatementLockImpact_ROW_EXCLUSIVE          PlanCheckRun_Result_StatementLockImpact_LockMode = 3
+	PlanCheckRun_Result_StatementLockImpact_SHARE_UPDATE_EXCLUSIVE PlanCheckRun_Result_StatementLockImpact_LockMode = 4
+	PlanCheckRun_Result_StatementLockImpact_SHARE                  PlanCheckRun_Result_StatementLockImpact_LockMode = 5
+	PlanCheckRun_Result_StatementLockImpact_SHARE_ROW_EXCLUSIVE    PlanCheckRun_Result_StatementLockImpact_LockMode = 6
+	PlanCheckRun_Result_StatementLockImpact_EXCLUSIVE              PlanCheckRun_Result_StatementLockImpact_LockMode = 7
+	PlanCheckRun_Result_StatementLockImpact_ACCESS_EXCLUSIVE       PlanCheckRun_Result_StatementLockImpact_LockMode = 8
+)
+
+// Enum value maps for PlanCheckRun_Result_StatementLockImpact_LockMode.
+var (
+	PlanCheckRun_Result_StatementLockImpact_LockMode_name = map[int32]string{
+		0: "LOCK_MODE_UNSPECIFIED",
+		1: "ACCESS_SHARE",
+		2: "ROW_SHARE",
+		3: "ROW_EXCLUSIVE",
+		4: "SHARE_UPDATE_EXCLUSIVE",
+		5: "SHARE",
+		6: "SHARE_ROW_EXCLUSIVE",
+		7: "EXCLUSIVE",
+		8: "ACCESS_EXCLUSIVE",
+	}
+	PlanCheckRun_Result_StatementLockImpact_LockMode_value = map[string]int32{
+		"LOCK_MODE_UNSPECIFIED":  0,
+		"ACCESS_SHARE":           1,
+		"ROW_SHARE":              2,
+		"ROW_EXCLUSIVE":          3,
+		"SHARE_UPDATE_EXCLUSIVE": 4,
+		"SHARE":                  5,
+		"SHARE_ROW_EXCLUSIVE":    6,
+		"EXCLUSIVE":              7,
+		"ACCESS_EXCLUSIVE":       8,
+	}
+)
+
+func (x PlanCheckRun_Result_StatementLockImpact_LockMode) Enum() *PlanCheckRun_Result_StatementLockImpact_LockMode {
+	p := new(PlanCheckRun_Result_StatementLockImpact_LockMode)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRun_Result_StatementLockImpact_LockMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRun_Result_StatementLockImpact_LockMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_plan_service_proto_enumTypes[4].Descriptor()
+}
+
+func (PlanCheckRun_Result_StatementLockImpact_LockMode) Type() protoreflect.EnumType {
+	return &file_v1_plan_service_proto_enumTypes[4]
+}
+
+func (x PlanCheckRun_Result_StatementLockImpact_LockMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_StatementLockImpact_LockMode.Descriptor instead.
+func (PlanCheckRun_Result_StatementLockImpact_LockMode) EnumDescriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 3, 0}
+}
+
+type PlanCheckRun_Result_StatementLockImpact_BlockingRisk int32
+
+const (
+	PlanCheckRun_Result_StatementLockImpact_BLOCKING_RISK_UNSPECIFIED PlanCheckRun_Result_StatementLockImpact_BlockingRisk = 0
+	PlanCheckRun_Result_StatementLockImpact_LOW                       PlanCheckRun_Result_StatementLockImpact_BlockingRisk = 1
+	PlanCheckRun_Result_StatementLockImpact_MEDIUM                    PlanCheckRun_Result_StatementLockImpact_BlockingRisk = 2
+	PlanCheckRun_Result_StatementLockImpact_HIGH                      PlanCheckRun_Result_StatementLockImpact_BlockingRisk = 3
+)
+
+// Enum value maps for PlanCheckRun_Result_StatementLockImpact_BlockingRisk.
+var (
+	PlanCheckRun_Result_StatementLockImpact_BlockingRisk_name = map[int32]string{
+		0: "BLOCKING_RISK_UNSPECIFIED",
+		1: "LOW",
+		2: "MEDIUM",
+		3: "HIGH",
+	}
+	PlanCheckRun_Result_StatementLockImpact_BlockingRisk_value = map[string]int32{
+		"BLOCKING_RISK_UNSPECIFIED": 0,
+		"LOW":                       1,
+		"MEDIUM":                    2,
+		"HIGH":                      3,
+	}
+)
+
+func (x PlanCheckRun_Result_StatementLockImpact_BlockingRisk) Enum() *PlanCheckRun_Result_StatementLockImpact_BlockingRisk {
+	p := new(PlanCheckRun_Result_StatementLockImpact_BlockingRisk)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRun_Result_StatementLockImpact_BlockingRisk) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRun_Result_StatementLockImpact_BlockingRisk) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_plan_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PlanCheckRun_Result_StatementLockImpact_BlockingRisk) Type() protoreflect.EnumType {
+	return &file_v1_plan_service_proto_enumTypes[5]
+}
+
+func (x PlanCheckRun_Result_StatementLockImpact_BlockingRisk) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_StatementLockImpact_BlockingRisk.Descriptor instead.
+func (PlanCheckRun_Result_StatementLockImpact_BlockingRisk) EnumDescriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 3, 1}
+}
+
+type PlanCheckRun_Result_StatementOnlineDdl_Algorithm int32
+
+const (
+	PlanCheckRun_Result_StatementOnlineDdl_ALGORITHM_UNSPECIFIED PlanCheckRun_Result_StatementOnlineDdl_Algorithm = 0
+	PlanCheckRun_Result_StatementOnlineDdl_INSTANT               PlanCheckRun_Result_StatementOnlineDdl_Algorithm = 1
+	PlanCheckRun_Result_StatementOnlineDdl_INPLACE               PlanCheckRun_Result_StatementOnlineDdl_Algorithm = 2
+	PlanCheckRun_Result_StatementOnlineDdl_COPY                  PlanCheckRun_Result_StatementOnlineDdl_Algorithm = 3
+)
+
+// Enum value maps for PlanCheckRun_Result_StatementOnlineDdl_Algorithm.
+var (
+	PlanCheckRun_Result_StatementOnlineDdl_Algorithm_name = map[int32]string{
+		0: "ALGORITHM_UNSPECIFIED",
+		1: "INSTANT",
+		2: "INPLACE",
+		3: "COPY",
+	}
+	PlanCheckRun_Result_StatementOnlineDdl_Algorithm_value = map[string]int32{
+		"ALGORITHM_UNSPECIFIED": 0,
+		"INSTANT":               1,
+		"INPLACE":               2,
+		"COPY":                  3,
+	}
+)
+
+func (x PlanCheckRun_Result_StatementOnlineDdl_Algorithm) Enum() *PlanCheckRun_Result_StatementOnlineDdl_Algorithm {
+	p := new(PlanCheckRun_Result_StatementOnlineDdl_Algorithm)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRun_Result_StatementOnlineDdl_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRun_Result_StatementOnlineDdl_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_plan_service_proto_enumTypes[6].Descriptor()
+}
+
+func (PlanCheckRun_Result_StatementOnlineDdl_Algorithm) Type() protoreflect.EnumType {
+	return &file_v1_plan_service_proto_enumTypes[6]
+}
+
+func (x PlanCheckRun_Result_StatementOnlineDdl_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_StatementOnlineDdl_Algorithm.Descriptor instead.
+func (PlanCheckRun_Result_StatementOnlineDdl_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 5, 0}
+}
+
 type GetPlanRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the plan to retrieve.
@@ -1776,6 +1953,8 @@ type PlanCheckRun_Result struct {
 	//
 	//	*PlanCheckRun_Result_SqlSummaryReport_
 	//	*PlanCheckRun_Result_SqlReviewReport_
+	//	*PlanCheckRun_Result_LockImpactReport_
+	//	*PlanCheckRun_Result_OnlineDdlReport_
 	Report        isPlanCheckRun_Result_Report `protobuf_oneof:"report"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1864,6 +2043,24 @@ func (x *PlanCheckRun_Result) GetSqlReviewReport() *PlanCheckRun_Result_SqlRevie
 	return nil
 }
 
+func (x *PlanCheckRun_Result) GetLockImpactReport() *PlanCheckRun_Result_LockImpactReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRun_Result_LockImpactReport_); ok {
+			return x.LockImpactReport
+		}
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result) GetOnlineDdlReport() *PlanCheckRun_Result_OnlineDdlReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRun_Result_OnlineDdlReport_); ok {
+			return x.OnlineDdlReport
+		}
+	}
+	return nil
+}
+
 type isPlanCheckRun_Result_Report interface {
 	isPlanCheckRun_Result_Report()
 }
@@ -1876,10 +2073,22 @@ type PlanCheckRun_Result_SqlReviewReport_ struct {
 	SqlReviewReport *PlanCheckRun_Result_SqlReviewReport `protobuf:"bytes,6,opt,name=sql_review_report,json=sqlReviewReport,proto3,oneof"`
 }
 
+type PlanCheckRun_Result_LockImpactReport_ struct {
+	LockImpactReport *PlanCheckRun_Result_LockImpactReport `protobuf:"bytes,7,opt,name=lock_impact_report,json=lockImpactReport,proto3,oneof"`
+}
+
+type PlanCheckRun_Result_OnlineDdlReport_ struct {
+	OnlineDdlReport *PlanCheckRun_Result_OnlineDdlReport `protobuf:"bytes,8,opt,name=online_ddl_report,json=onlineDdlReport,proto3,oneof"`
+}
+
 func (*PlanCheckRun_Result_SqlSummaryReport_) isPlanCheckRun_Result_Report() {}
 
 func (*PlanCheckRun_Result_SqlReviewReport_) isPlanCheckRun_Result_Report() {}
 
+func (*PlanCheckRun_Result_LockImpactReport_) isPlanCheckRun_Result_Report() {}
+
+func (*PlanCheckRun_Result_OnlineDdlReport_) isPlanCheckRun_Result_Report() {}
+
 type PlanCheckRun_Result_SqlSummaryReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// statement_types are the types of statements that are found in the sql.
@@ -2011,6 +2220,361 @@ func (x *PlanCheckRun_Result_SqlReviewReport) GetEndPosition() *Position {
 	return nil
 }
 
+// LockImpactReport is the lock impact analysis of the DDL statements.
+type PlanCheckRun_Result_LockImpactReport struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Statements    []*PlanCheckRun_Result_StatementLockImpact `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) Reset() {
+	*x = PlanCheckRun_Result_LockImpactReport{}
+	mi := &file_v1_plan_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_LockImpactReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_LockImpactReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_LockImpactReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_LockImpactReport) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 2}
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetStatements() []*PlanCheckRun_Result_StatementLockImpact {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// StatementLockImpact is the lock impact of a statement on a table.
+type PlanCheckRun_Result_StatementLockImpact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of the statement.
+	StartPosition *Position `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	Statement     string    `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Schema        string    `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table         string    `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The strongest table lock mode acquired by the statement.
+	LockMode PlanCheckRun_Result_StatementLockImpact_LockMode `protobuf:"varint,5,opt,name=lock_mode,json=lockMode,proto3,enum=bytebase.v1.PlanCheckRun_Result_StatementLockImpact_LockMode" json:"lock_mode,omitempty"`
+	// The statement rewrites the whole table while holding the lock.
+	TableRewrite bool `protobuf:"varint,6,opt,name=table_rewrite,json=tableRewrite,proto3" json:"table_rewrite,omitempty"`
+	// The statement scans the whole table while holding the lock.
+	TableScan bool `protobuf:"varint,7,opt,name=table_scan,json=tableScan,proto3" json:"table_scan,omitempty"`
+	// The row count of the table from the synced metadata.
+	TableRows int64 `protobuf:"varint,8,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	// The data size and index size in bytes of the table from the synced metadata.
+	TableSize    int64                                                `protobuf:"varint,9,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
+	BlockingRisk PlanCheckRun_Result_StatementLockImpact_BlockingRisk `protobuf:"varint,10,opt,name=blocking_risk,json=blockingRisk,proto3,enum=bytebase.v1.PlanCheckRun_Result_StatementLockImpact_BlockingRisk" json:"blocking_risk,omitempty"`
+	// The reasons of the lock mode, rewrite and scan behavior.
+	Reasons       []string `protobuf:"bytes,11,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) Reset() {
+	*x = PlanCheckRun_Result_StatementLockImpact{}
+	mi := &file_v1_plan_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_StatementLockImpact) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_StatementLockImpact.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_StatementLockImpact) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 3}
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetLockMode() PlanCheckRun_Result_StatementLockImpact_LockMode {
+	if x != nil {
+		return x.LockMode
+	}
+	return PlanCheckRun_Result_StatementLockImpact_LOCK_MODE_UNSPECIFIED
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetTableRewrite() bool {
+	if x != nil {
+		return x.TableRewrite
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetTableScan() bool {
+	if x != nil {
+		return x.TableScan
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetTableSize() int64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetBlockingRisk() PlanCheckRun_Result_StatementLockImpact_BlockingRisk {
+	if x != nil {
+		return x.BlockingRisk
+	}
+	return PlanCheckRun_Result_StatementLockImpact_BLOCKING_RISK_UNSPECIFIED
+}
+
+func (x *PlanCheckRun_Result_StatementLockImpact) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// OnlineDdlReport is the online DDL algorithm prediction of the ALTER TABLE statements.
+type PlanCheckRun_Result_OnlineDdlReport struct {
+	state         protoimpl.MessageState                    `protogen:"open.v1"`
+	Statements    []*PlanCheckRun_Result_StatementOnlineDdl `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_OnlineDdlReport) Reset() {
+	*x = PlanCheckRun_Result_OnlineDdlReport{}
+	mi := &file_v1_plan_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_OnlineDdlReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_OnlineDdlReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_OnlineDdlReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_OnlineDdlReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_OnlineDdlReport) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 4}
+}
+
+func (x *PlanCheckRun_Result_OnlineDdlReport) GetStatements() []*PlanCheckRun_Result_StatementOnlineDdl {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// StatementOnlineDdl is the predicted online DDL behavior of an ALTER TABLE statement.
+type PlanCheckRun_Result_StatementOnlineDdl struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of the statement.
+	StartPosition *Position `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	Statement     string    `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Database      string    `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table         string    `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The algorithm MySQL is expected to choose for the statement.
+	Algorithm PlanCheckRun_Result_StatementOnlineDdl_Algorithm `protobuf:"varint,5,opt,name=algorithm,proto3,enum=bytebase.v1.PlanCheckRun_Result_StatementOnlineDdl_Algorithm" json:"algorithm,omitempty"`
+	// The statement rebuilds the table.
+	TableRebuild bool `protobuf:"varint,6,opt,name=table_rebuild,json=tableRebuild,proto3" json:"table_rebuild,omitempty"`
+	// The statement permits concurrent DML while it is running.
+	ConcurrentDml bool `protobuf:"varint,7,opt,name=concurrent_dml,json=concurrentDml,proto3" json:"concurrent_dml,omitempty"`
+	// The row count of the table from the synced metadata.
+	TableRows int64 `protobuf:"varint,8,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	// The data size and index size in bytes of the table from the synced metadata.
+	TableSize int64 `protobuf:"varint,9,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
+	// Switching to the gh-ost migration is suggested because the table is copied while blocking writes.
+	SuggestGhost bool `protobuf:"varint,10,opt,name=suggest_ghost,json=suggestGhost,proto3" json:"suggest_ghost,omitempty"`
+	// The reasons of the predicted algorithm.
+	Reasons       []string `protobuf:"bytes,11,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) Reset() {
+	*x = PlanCheckRun_Result_StatementOnlineDdl{}
+	mi := &file_v1_plan_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_StatementOnlineDdl) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_StatementOnlineDdl.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_StatementOnlineDdl) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 5}
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetAlgorithm() PlanCheckRun_Result_StatementOnlineDdl_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return PlanCheckRun_Result_StatementOnlineDdl_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetTableRebuild() bool {
+	if x != nil {
+		return x.TableRebuild
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetConcurrentDml() bool {
+	if x != nil {
+		return x.ConcurrentDml
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetTableSize() int64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetSuggestGhost() bool {
+	if x != nil {
+		return x.SuggestGhost
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_StatementOnlineDdl) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
 var File_v1_plan_service_proto protoreflect.FileDescriptor
 
 const file_v1_plan_service_proto_rawDesc = "" +
@@ -2136,7 +2700,7 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11bytebase.com/PlanR\x06parent\x12&\n" +
 	"\x0fplan_check_runs\x18\x02 \x03(\tR\rplanCheckRuns\"\"\n" +
-	" BatchCancelPlanCheckRunsResponse\"\x80\v\n" +
+	" BatchCancelPlanCheckRunsResponse\"\x84\x19\n" +
 	"\fPlanCheckRun\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x1e.bytebase.v1.PlanCheckRun.TypeR\x04type\x128\n" +
@@ -2146,14 +2710,16 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\aresults\x18\a \x03(\v2 .bytebase.v1.PlanCheckRun.ResultR\aresults\x12\x14\n" +
 	"\x05error\x18\b \x01(\tR\x05error\x12@\n" +
 	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"createTime\x1a\x9a\x06\n" +
+	"createTime\x1a\xd7\x13\n" +
 	"\x06Result\x12?\n" +
 	"\x06status\x18\x01 \x01(\x0e2'.bytebase.v1.PlanCheckRun.Result.StatusR\x06status\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
 	"\x04code\x18\x04 \x01(\x05R\x04code\x12a\n" +
 	"\x12sql_summary_report\x18\x05 \x01(\v21.bytebase.v1.PlanCheckRun.Result.SqlSummaryReportH\x00R\x10sqlSummaryReport\x12^\n" +
-	"\x11sql_review_report\x18\x06 \x01(\v20.bytebase.v1.PlanCheckRun.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x1a\xb2\x01\n" +
+	"\x11sql_review_report\x18\x06 \x01(\v20.bytebase.v1.PlanCheckRun.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x12a\n" +
+	"\x12lock_impact_report\x18\a \x01(\v21.bytebase.v1.PlanCheckRun.Result.LockImpactReportH\x00R\x10lockImpactReport\x12^\n" +
+	"\x11online_ddl_report\x18\b \x01(\v20.bytebase.v1.PlanCheckRun.Result.OnlineDdlReportH\x00R\x0fonlineDdlReport\x1a\xb2\x01\n" +
 	"\x10SqlSummaryReport\x12'\n" +
 	"\x0fstatement_types\x18\x02 \x03(\tR\x0estatementTypes\x12#\n" +
 	"\raffected_rows\x18\x03 \x01(\x05R\faffectedRows\x12J\n" +
@@ -2162,20 +2728,82 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
 	"\x06column\x18\x02 \x01(\x05R\x06column\x12<\n" +
 	"\x0estart_position\x18\x05 \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x128\n" +
-	"\fend_position\x18\x06 \x01(\v2\x15.bytebase.v1.PositionR\vendPositionJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"E\n" +
+	"\fend_position\x18\x06 \x01(\v2\x15.bytebase.v1.PositionR\vendPositionJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\x1ah\n" +
+	"\x10LockImpactReport\x12T\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v24.bytebase.v1.PlanCheckRun.Result.StatementLockImpactR\n" +
+	"statements\x1a\x8e\x06\n" +
+	"\x13StatementLockImpact\x12<\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x12\x1c\n" +
+	"\tstatement\x18\x02 \x01(\tR\tstatement\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x04 \x01(\tR\x05table\x12Z\n" +
+	"\tlock_mode\x18\x05 \x01(\x0e2=.bytebase.v1.PlanCheckRun.Result.StatementLockImpact.LockModeR\blockMode\x12#\n" +
+	"\rtable_rewrite\x18\x06 \x01(\bR\ftableRewrite\x12\x1d\n" +
+	"\n" +
+	"table_scan\x18\a \x01(\bR\ttableScan\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\b \x01(\x03R\ttableRows\x12\x1d\n" +
+	"\n" +
+	"table_size\x18\t \x01(\x03R\ttableSize\x12f\n" +
+	"\rblocking_risk\x18\n" +
+	" \x01(\x0e2A.bytebase.v1.PlanCheckRun.Result.StatementLockImpact.BlockingRiskR\fblockingRisk\x12\x18\n" +
+	"\areasons\x18\v \x03(\tR\areasons\"\xbe\x01\n" +
+	"\bLockMode\x12\x19\n" +
+	"\x15LOCK_MODE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fACCESS_SHARE\x10\x01\x12\r\n" +
+	"\tROW_SHARE\x10\x02\x12\x11\n" +
+	"\rROW_EXCLUSIVE\x10\x03\x12\x1a\n" +
+	"\x16SHARE_UPDATE_EXCLUSIVE\x10\x04\x12\t\n" +
+	"\x05SHARE\x10\x05\x12\x17\n" +
+	"\x13SHARE_ROW_EXCLUSIVE\x10\x06\x12\r\n" +
+	"\tEXCLUSIVE\x10\a\x12\x14\n" +
+	"\x10ACCESS_EXCLUSIVE\x10\b\"L\n" +
+	"\fBlockingRisk\x12\x1d\n" +
+	"\x19BLOCKING_RISK_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03LOW\x10\x01\x12\n" +
+	"\n" +
+	"\x06MEDIUM\x10\x02\x12\b\n" +
+	"\x04HIGH\x10\x03\x1af\n" +
+	"\x0fOnlineDdlReport\x12S\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v23.bytebase.v1.PlanCheckRun.Result.StatementOnlineDdlR\n" +
+	"statements\x1a\x94\x04\n" +
+	"\x12StatementOnlineDdl\x12<\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x12\x1c\n" +
+	"\tstatement\x18\x02 \x01(\tR\tstatement\x12\x1a\n" +
+	"\bdatabase\x18\x03 \x01(\tR\bdatabase\x12\x14\n" +
+	"\x05table\x18\x04 \x01(\tR\x05table\x12[\n" +
+	"\talgorithm\x18\x05 \x01(\x0e2=.bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl.AlgorithmR\talgorithm\x12#\n" +
+	"\rtable_rebuild\x18\x06 \x01(\bR\ftableRebuild\x12%\n" +
+	"\x0econcurrent_dml\x18\a \x01(\bR\rconcurrentDml\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\b \x01(\x03R\ttableRows\x12\x1d\n" +
+	"\n" +
+	"table_size\x18\t \x01(\x03R\ttableSize\x12#\n" +
+	"\rsuggest_ghost\x18\n" +
+	" \x01(\bR\fsuggestGhost\x12\x18\n" +
+	"\areasons\x18\v \x03(\tR\areasons\"J\n" +
+	"\tAlgorithm\x12\x19\n" +
+	"\x15ALGORITHM_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aINSTANT\x10\x01\x12\v\n" +
+	"\aINPLACE\x10\x02\x12\b\n" +
+	"\x04COPY\x10\x03\"E\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ERROR\x10\x01\x12\v\n" +
 	"\aWARNING\x10\x02\x12\v\n" +
 	"\aSUCCESS\x10\x03B\b\n" +
-	"\x06report\"\xb5\x01\n" +
+	"\x06report\"\xfc\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eDATABASE_STATEMENT_FAKE_ADVISE\x10\x01\x12\x1d\n" +
 	"\x19DATABASE_STATEMENT_ADVISE\x10\x03\x12%\n" +
 	"!DATABASE_STATEMENT_SUMMARY_REPORT\x10\x05\x12\x14\n" +
 	"\x10DATABASE_CONNECT\x10\x06\x12\x17\n" +
-	"\x13DATABASE_GHOST_SYNC\x10\a\"Q\n" +
+	"\x13DATABASE_GHOST_SYNC\x10\a\x12\"\n" +
+	"\x1eDATABASE_STATEMENT_LOCK_IMPACT\x10\b\x12!\n" +
+	"\x1dDATABASE_STATEMENT_ONLINE_DDL\x10\t\"Q\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aRUNNING\x10\x01\x12\b\n" +
@@ -2208,95 +2836,111 @@ func file_v1_plan_service_proto_rawDescGZIP() []byte {
 	return file_v1_plan_service_proto_rawDescData
 }
 
-var file_v1_plan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_v1_plan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_v1_plan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_v1_plan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_v1_plan_service_proto_goTypes = []any{
-	(Plan_ChangeDatabaseConfig_Type)(0),          // 0: bytebase.v1.Plan.ChangeDatabaseConfig.Type
-	(PlanCheckRun_Type)(0),                       // 1: bytebase.v1.PlanCheckRun.Type
-	(PlanCheckRun_Status)(0),                     // 2: bytebase.v1.PlanCheckRun.Status
-	(PlanCheckRun_Result_Status)(0),              // 3: bytebase.v1.PlanCheckRun.Result.Status
-	(*GetPlanRequest)(nil),                       // 4: bytebase.v1.GetPlanRequest
-	(*ListPlansRequest)(nil),                     // 5: bytebase.v1.ListPlansRequest
-	(*ListPlansResponse)(nil),                    // 6: bytebase.v1.ListPlansResponse
-	(*SearchPlansRequest)(nil),                   // 7: bytebase.v1.SearchPlansRequest
-	(*SearchPlansResponse)(nil),                  // 8: bytebase.v1.SearchPlansResponse
-	(*CreatePlanRequest)(nil),                    // 9: bytebase.v1.CreatePlanRequest
-	(*UpdatePlanRequest)(nil),                    // 10: bytebase.v1.UpdatePlanRequest
-	(*Plan)(nil),                                 // 11: bytebase.v1.Plan
-	(*ListPlanCheckRunsRequest)(nil),             // 12: bytebase.v1.ListPlanCheckRunsRequest
-	(*ListPlanCheckRunsResponse)(nil),            // 13: bytebase.v1.ListPlanCheckRunsResponse
-	(*RunPlanChecksRequest)(nil),                 // 14: bytebase.v1.RunPlanChecksRequest
-	(*RunPlanChecksResponse)(nil),                // 15: bytebase.v1.RunPlanChecksResponse
-	(*BatchCancelPlanCheckRunsRequest)(nil),      // 16: bytebase.v1.BatchCancelPlanCheckRunsRequest
-	(*BatchCancelPlanCheckRunsResponse)(nil),     // 17: bytebase.v1.BatchCancelPlanCheckRunsResponse
-	(*PlanCheckRun)(nil),                         // 18: bytebase.v1.PlanCheckRun
-	(*Plan_Spec)(nil),                            // 19: bytebase.v1.Plan.Spec
-	nil,                                          // 20: bytebase.v1.Plan.PlanCheckRunStatusCountEntry
-	(*Plan_CreateDatabaseConfig)(nil),            // 21: bytebase.v1.Plan.CreateDatabaseConfig
-	(*Plan_ChangeDatabaseConfig)(nil),            // 22: bytebase.v1.Plan.ChangeDatabaseConfig
-	(*Plan_ExportDataConfig)(nil),                // 23: bytebase.v1.Plan.ExportDataConfig
-	(*Plan_Deployment)(nil),                      // 24: bytebase.v1.Plan.Deployment
-	nil,                                          // 25: bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
-	(*Plan_Deployment_DatabaseGroupMapping)(nil), // 26: bytebase.v1.Plan.Deployment.DatabaseGroupMapping
-	(*PlanCheckRun_Result)(nil),                  // 27: bytebase.v1.PlanCheckRun.Result
-	(*PlanCheckRun_Result_SqlSummaryReport)(nil), // 28: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	(*PlanCheckRun_Result_SqlReviewReport)(nil),  // 29: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	(*fieldmaskpb.FieldMask)(nil),                // 30: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),                // 31: google.protobuf.Timestamp
-	(ExportFormat)(0),                            // 32: bytebase.v1.ExportFormat
-	(*ChangedResources)(nil),                     // 33: bytebase.v1.ChangedResources
-	(*Position)(nil),                             // 34: bytebase.v1.Position
+	(Plan_ChangeDatabaseConfig_Type)(0),                       // 0: bytebase.v1.Plan.ChangeDatabaseConfig.Type
+	(PlanCheckRun_Type)(0),                                    // 1: bytebase.v1.PlanCheckRun.Type
+	(PlanCheckRun_Status)(0),                                  // 2: bytebase.v1.PlanCheckRun.Status
+	(PlanCheckRun_Result_Status)(0),                           // 3: bytebase.v1.PlanCheckRun.Result.Status
+	(PlanCheckRun_Result_StatementLockImpact_LockMode)(0),     // 4: bytebase.v1.PlanCheckRun.Result.StatementLockImpact.LockMode
+	(PlanCheckRun_Result_StatementLockImpact_BlockingRisk)(0), // 5: bytebase.v1.PlanCheckRun.Result.StatementLockImpact.BlockingRisk
+	(PlanCheckRun_Result_StatementOnlineDdl_Algorithm)(0),     // 6: bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl.Algorithm
+	(*GetPlanRequest)(nil),                                    // 7: bytebase.v1.GetPlanRequest
+	(*ListPlansRequest)(nil),                                  // 8: bytebase.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                                 // 9: bytebase.v1.ListPlansResponse
+	(*SearchPlansRequest)(nil),                                // 10: bytebase.v1.SearchPlansRequest
+	(*SearchPlansResponse)(nil),                               // 11: bytebase.v1.SearchPlansResponse
+	(*CreatePlanRequest)(nil),                                 // 12: bytebase.v1.CreatePlanRequest
+	(*UpdatePlanRequest)(nil),                                 // 13: bytebase.v1.UpdatePlanRequest
+	(*Plan)(nil),                                              // 14: bytebase.v1.Plan
+	(*ListPlanCheckRunsRequest)(nil),                          // 15: bytebase.v1.ListPlanCheckRunsRequest
+	(*ListPlanCheckRunsResponse)(nil),                         // 16: bytebase.v1.ListPlanCheckRunsResponse
+	(*RunPlanChecksRequest)(nil),                              // 17: bytebase.v1.RunPlanChecksRequest
+	(*RunPlanChecksResponse)(nil),                             // 18: bytebase.v1.RunPlanChecksResponse
+	(*BatchCancelPlanCheckRunsRequest)(nil),                   // 19: bytebase.v1.BatchCancelPlanCheckRunsRequest
+	(*BatchCancelPlanCheckRunsResponse)(nil),                  // 20: bytebase.v1.BatchCancelPlanCheckRunsResponse
+	(*PlanCheckRun)(nil),                                      // 21: bytebase.v1.PlanCheckRun
+	(*Plan_Spec)(nil),                                         // 22: bytebase.v1.Plan.Spec
+	nil,                                                       // 23: bytebase.v1.Plan.PlanCheckRunStatusCountEntry
+	(*Plan_CreateDatabaseConfig)(nil),                         // 24: bytebase.v1.Plan.CreateDatabaseConfig
+	(*Plan_ChangeDatabaseConfig)(nil),                         // 25: bytebase.v1.Plan.ChangeDatabaseConfig
+	(*Plan_ExportDataConfig)(nil),                             // 26: bytebase.v1.Plan.ExportDataConfig
+	(*Plan_Deployment)(nil),                                   // 27: bytebase.v1.Plan.Deployment
+	nil,                                                       // 28: bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
+	(*Plan_Deployment_DatabaseGroupMapping)(nil),              // 29: bytebase.v1.Plan.Deployment.DatabaseGroupMapping
+	(*PlanCheckRun_Result)(nil),                               // 30: bytebase.v1.PlanCheckRun.Result
+	(*PlanCheckRun_Result_SqlSummaryReport)(nil),              // 31: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	(*PlanCheckRun_Result_SqlReviewReport)(nil),               // 32: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	(*PlanCheckRun_Result_LockImpactReport)(nil),              // 33: bytebase.v1.PlanCheckRun.Result.LockImpactReport
+	(*PlanCheckRun_Result_StatementLockImpact)(nil),           // 34: bytebase.v1.PlanCheckRun.Result.StatementLockImpact
+	(*PlanCheckRun_Result_OnlineDdlReport)(nil),               // 35: bytebase.v1.PlanCheckRun.Result.OnlineDdlReport
+	(*PlanCheckRun_Result_StatementOnlineDdl)(nil),            // 36: bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl
+	(*fieldmaskpb.FieldMask)(nil),                             // 37: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                             // 38: google.protobuf.Timestamp
+	(ExportFormat)(0),                                         // 39: bytebase.v1.ExportFormat
+	(*ChangedResources)(nil),                                  // 40: bytebase.v1.ChangedResources
+	(*Position)(nil),                                          // 41: bytebase.v1.Position
 }
 var file_v1_plan_service_proto_depIdxs = []int32{
-	11, // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
-	11, // 1: bytebase.v1.SearchPlansResponse.plans:type_name -> bytebase.v1.Plan
-	11, // 2: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	11, // 3: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	30, // 4: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
-	19, // 5: bytebase.v1.Plan.specs:type_name -> bytebase.v1.Plan.Spec
-	31, // 6: bytebase.v1.Plan.create_time:type_name -> google.protobuf.Timestamp
-	31, // 7: bytebase.v1.Plan.update_time:type_name -> google.protobuf.Timestamp
-	20, // 8: bytebase.v1.Plan.plan_check_run_status_count:type_name -> bytebase.v1.Plan.PlanCheckRunStatusCountEntry
-	24, // 9: bytebase.v1.Plan.deployment:type_name -> bytebase.v1.Plan.Deployment
-	18, // 10: bytebase.v1.ListPlanCheckRunsResponse.plan_check_runs:type_name -> bytebase.v1.PlanCheckRun
+	14, // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
+	14, // 1: bytebase.v1.SearchPlansResponse.plans:type_name -> bytebase.v1.Plan
+	14, // 2: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
+	14, // 3: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
+	37, // 4: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22, // 5: bytebase.v1.Plan.specs:type_name -> bytebase.v1.Plan.Spec
+	38, // 6: bytebase.v1.Plan.create_time:type_name -> google.protobuf.Timestamp
+	38, // 7: bytebase.v1.Plan.update_time:type_name -> google.protobuf.Timestamp
+	23, // 8: bytebase.v1.Plan.plan_check_run_status_count:type_name -> bytebase.v1.Plan.PlanCheckRunStatusCountEntry
+	27, // 9: bytebase.v1.Plan.deployment:type_name -> bytebase.v1.Plan.Deployment
+	21, // 10: bytebase.v1.ListPlanCheckRunsResponse.plan_check_runs:type_name -> bytebase.v1.PlanCheckRun
 	1,  // 11: bytebase.v1.PlanCheckRun.type:type_name -> bytebase.v1.PlanCheckRun.Type
 	2,  // 12: bytebase.v1.PlanCheckRun.status:type_name -> bytebase.v1.PlanCheckRun.Status
-	27, // 13: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
-	31, // 14: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
-	21, // 15: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
-	22, // 16: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
-	23, // 17: bytebase.v1.Plan.Spec.export_data_config:type_name -> bytebase.v1.Plan.ExportDataConfig
+	30, // 13: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
+	38, // 14: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
+	24, // 15: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
+	25, // 16: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
+	26, // 17: bytebase.v1.Plan.Spec.export_data_config:type_name -> bytebase.v1.Plan.ExportDataConfig
 	0,  // 18: bytebase.v1.Plan.ChangeDatabaseConfig.type:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.Type
-	25, // 19: bytebase.v1.Plan.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
-	32, // 20: bytebase.v1.Plan.ExportDataConfig.format:type_name -> bytebase.v1.ExportFormat
-	26, // 21: bytebase.v1.Plan.Deployment.database_group_mappings:type_name -> bytebase.v1.Plan.Deployment.DatabaseGroupMapping
+	28, // 19: bytebase.v1.Plan.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
+	39, // 20: bytebase.v1.Plan.ExportDataConfig.format:type_name -> bytebase.v1.ExportFormat
+	29, // 21: bytebase.v1.Plan.Deployment.database_group_mappings:type_name -> bytebase.v1.Plan.Deployment.DatabaseGroupMapping
 	3,  // 22: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.PlanCheckRun.Result.Status
-	28, // 23: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	29, // 24: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	33, // 25: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.v1.ChangedResources
-	34, // 26: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.start_position:type_name -> bytebase.v1.Position
-	34, // 27: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.end_position:type_name -> bytebase.v1.Position
-	4,  // 28: bytebase.v1.PlanService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
-	5,  // 29: bytebase.v1.PlanService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
-	7,  // 30: bytebase.v1.PlanService.SearchPlans:input_type -> bytebase.v1.SearchPlansRequest
-	9,  // 31: bytebase.v1.PlanService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
-	10, // 32: bytebase.v1.PlanService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
-	12, // 33: bytebase.v1.PlanService.ListPlanCheckRuns:input_type -> bytebase.v1.ListPlanCheckRunsRequest
-	14, // 34: bytebase.v1.PlanService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
-	16, // 35: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:input_type -> bytebase.v1.BatchCancelPlanCheckRunsRequest
-	11, // 36: bytebase.v1.PlanService.GetPlan:output_type -> bytebase.v1.Plan
-	6,  // 37: bytebase.v1.PlanService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
-	8,  // 38: bytebase.v1.PlanService.SearchPlans:output_type -> bytebase.v1.SearchPlansResponse
-	11, // 39: bytebase.v1.PlanService.CreatePlan:output_type -> bytebase.v1.Plan
-	11, // 40: bytebase.v1.PlanService.UpdatePlan:output_type -> bytebase.v1.Plan
-	13, // 41: bytebase.v1.PlanService.ListPlanCheckRuns:output_type -> bytebase.v1.ListPlanCheckRunsResponse
-	15, // 42: bytebase.v1.PlanService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
-	17, // 43: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:output_type -> bytebase.v1.BatchCancelPlanCheckRunsResponse
-	36, // [36:44] is the sub-list for method output_type
-	28, // [28:36] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	31, // 23: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	32, // 24: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	33, // 25: bytebase.v1.PlanCheckRun.Result.lock_impact_report:type_name -> bytebase.v1.PlanCheckRun.Result.LockImpactReport
+	35, // 26: bytebase.v1.PlanCheckRun.Result.online_ddl_report:type_name -> bytebase.v1.PlanCheckRun.Result.OnlineDdlReport
+	40, // 27: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.v1.ChangedResources
+	41, // 28: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.start_position:type_name -> bytebase.v1.Position
+	41, // 29: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.end_position:type_name -> bytebase.v1.Position
+	34, // 30: bytebase.v1.PlanCheckRun.Result.LockImpactReport.statements:type_name -> bytebase.v1.PlanCheckRun.Result.StatementLockImpact
+	41, // 31: bytebase.v1.PlanCheckRun.Result.StatementLockImpact.start_position:type_name -> bytebase.v1.Position
+	4,  // 32: bytebase.v1.PlanCheckRun.Result.StatementLockImpact.lock_mode:type_name -> bytebase.v1.PlanCheckRun.Result.StatementLockImpact.LockMode
+	5,  // 33: bytebase.v1.PlanCheckRun.Result.StatementLockImpact.blocking_risk:type_name -> bytebase.v1.PlanCheckRun.Result.StatementLockImpact.BlockingRisk
+	36, // 34: bytebase.v1.PlanCheckRun.Result.OnlineDdlReport.statements:type_name -> bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl
+	41, // 35: bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl.start_position:type_name -> bytebase.v1.Position
+	6,  // 36: bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl.algorithm:type_name -> bytebase.v1.PlanCheckRun.Result.StatementOnlineDdl.Algorithm
+	7,  // 37: bytebase.v1.PlanService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
+	8,  // 38: bytebase.v1.PlanService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
+	10, // 39: bytebase.v1.PlanService.SearchPlans:input_type -> bytebase.v1.SearchPlansRequest
+	12, // 40: bytebase.v1.PlanService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
+	13, // 41: bytebase.v1.PlanService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
+	15, // 42: bytebase.v1.PlanService.ListPlanCheckRuns:input_type -> bytebase.v1.ListPlanCheckRunsRequest
+	17, // 43: bytebase.v1.PlanService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
+	19, // 44: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:input_type -> bytebase.v1.BatchCancelPlanCheckRunsRequest
+	14, // 45: bytebase.v1.PlanService.GetPlan:output_type -> bytebase.v1.Plan
+	9,  // 46: bytebase.v1.PlanService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
+	11, // 47: bytebase.v1.PlanService.SearchPlans:output_type -> bytebase.v1.SearchPlansResponse
+	14, // 48: bytebase.v1.PlanService.CreatePlan:output_type -> bytebase.v1.Plan
+	14, // 49: bytebase.v1.PlanService.UpdatePlan:output_type -> bytebase.v1.Plan
+	16, // 50: bytebase.v1.PlanService.ListPlanCheckRuns:output_type -> bytebase.v1.ListPlanCheckRunsResponse
+	18, // 51: bytebase.v1.PlanService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
+	20, // 52: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:output_type -> bytebase.v1.BatchCancelPlanCheckRunsResponse
+	45, // [45:53] is the sub-list for method output_type
+	37, // [37:45] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_v1_plan_service_proto_init() }
@@ -2317,14 +2961,16 @@ func file_v1_plan_service_proto_init() {
 	file_v1_plan_service_proto_msgTypes[23].OneofWrappers = []any{
 		(*PlanCheckRun_Result_SqlSummaryReport_)(nil),
 		(*PlanCheckRun_Result_SqlReviewReport_)(nil),
+		(*PlanCheckRun_Result_LockImpactReport_)(nil),
+		(*PlanCheckRun_Result_OnlineDdlReport_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_plan_service_proto_rawDesc), len(file_v1_plan_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   26,
+			NumEnums:      7,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -10,8 +10,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -36,20 +38,25 @@ const (
 	Webhook_WECOM            Webhook_Type = 6
 	Webhook_LARK             Webhook_Type = 8
 	Webhook_CUSTOM           Webhook_Type = 9
+	// EVENT_SINK emits the events as CloudEvents 1.0 structured JSON envelopes.
+	// The url can be an http(s) endpoint, or a Kafka REST proxy topic in the form of
+	// kafka+http(s)://{host}/topics/{topic}.
+	Webhook_EVENT_SINK Webhook_Type = 10
 )
 
 // Enum value maps for Webhook_Type.
 var (
 	Webhook_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "SLACK",
-		2: "DISCORD",
-		3: "TEAMS",
-		4: "DINGTALK",
-		5: "FEISHU",
-		6: "WECOM",
-		8: "LARK",
-		9: "CUSTOM",
+		0:  "TYPE_UNSPECIFIED",
+		1:  "SLACK",
+		2:  "DISCORD",
+		3:  "TEAMS",
+		4:  "DINGTALK",
+		5:  "FEISHU",
+		6:  "WECOM",
+		8:  "LARK",
+		9:  "CUSTOM",
+		10: "EVENT_SINK",
 	}
 	Webhook_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
@@ -61,6 +68,7 @@ var (
 		"WECOM":            6,
 		"LARK":             8,
 		"CUSTOM":           9,
+		"EVENT_SINK":       10,
 	}
 )
 
@@ -91,6 +99,62 @@ func (Webhook_Type) EnumDescriptor() ([]byte, []int) {
 	return file_v1_project_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
+type WebhookDelivery_Status int32
+
+const (
+	WebhookDelivery_STATUS_UNSPECIFIED WebhookDelivery_Status = 0
+	// The delivery is waiting to be sent or retried.
+	WebhookDelivery_PENDING WebhookDelivery_Status = 1
+	// The delivery has been sent successfully.
+	WebhookDelivery_DONE WebhookDelivery_Status = 2
+	// The delivery has exhausted its retries and will not be sent again
+	// unless it's re-sent manually.
+	WebhookDelivery_DEAD WebhookDelivery_Status = 3
+)
+
+// Enum value maps for WebhookDelivery_Status.
+var (
+	WebhookDelivery_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "DONE",
+		3: "DEAD",
+	}
+	WebhookDelivery_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"DONE":               2,
+		"DEAD":               3,
+	}
+)
+
+func (x WebhookDelivery_Status) Enum() *WebhookDelivery_Status {
+	p := new(WebhookDelivery_Status)
+	*p = x
+	return p
+}
+
+func (x WebhookDelivery_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDelivery_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_project_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhookDelivery_Status) Type() protoreflect.EnumType {
+	return &file_v1_project_service_proto_enumTypes[1]
+}
+
+func (x WebhookDelivery_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDelivery_Status.Descriptor instead.
+func (WebhookDelivery_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{25, 0}
+}
+
 type Activity_Type int32
 
 const (
@@ -101,6 +165,8 @@ const (
 	Activity_NOTIFY_ISSUE_APPROVED Activity_Type = 23
 	// NOTIFY_PIPELINE_ROLLOUT represents the pipeline rollout notification.
 	Activity_NOTIFY_PIPELINE_ROLLOUT Activity_Type = 24
+	// NOTIFY_SCHEMA_DRIFT represents the database schema drift notification.
+	Activity_NOTIFY_SCHEMA_DRIFT Activity_Type = 25
 	// Issue related activity types.
 	//
 	// ISSUE_CREATE represents creating an issue.
@@ -125,6 +191,7 @@ var (
 		0:  "TYPE_UNSPECIFIED",
 		23: "NOTIFY_ISSUE_APPROVED",
 		24: "NOTIFY_PIPELINE_ROLLOUT",
+		25: "NOTIFY_SCHEMA_DRIFT",
 		1:  "ISSUE_CREATE",
 		2:  "ISSUE_COMMENT_CREATE",
 		3:  "ISSUE_FIELD_UPDATE",
@@ -137,6 +204,7 @@ var (
 		"TYPE_UNSPECIFIED":                      0,
 		"NOTIFY_ISSUE_APPROVED":                 23,
 		"NOTIFY_PIPELINE_ROLLOUT":               24,
+		"NOTIFY_SCHEMA_DRIFT":                   25,
 		"ISSUE_CREATE":                          1,
 		"ISSUE_COMMENT_CREATE":                  2,
 		"ISSUE_FIELD_UPDATE":                    3,
@@ -158,11 +226,11 @@ func (x Activity_Type) String() string {
 }
 
 func (Activity_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_project_service_proto_enumTypes[1].Descriptor()
+	return file_v1_project_service_proto_enumTypes[2].Descriptor()
 }
 
 func (Activity_Type) Type() protoreflect.EnumType {
-	return &file_v1_project_service_proto_enumTypes[1]
+	return &file_v1_project_service_proto_enumTypes[2]
 }
 
 func (x Activity_Type) Number() protoreflect.EnumNumber {
@@ -171,7 +239,7 @@ func (x Activity_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Activity_Type.Descriptor instead.
 func (Activity_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_project_service_proto_rawDescGZIP(), []int{20, 0}
+	return file_v1_project_service_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type GetProjectRequest struct {
@@ -1288,7 +1356,7 @@ type TestWebhookRequest struct {
 	// The name of the project which owns the webhook to test.
 	// Format: projects/{project}
 	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	// The webhook to test. Identified by its url.
+	// The webhook to test. If its name is set, the test payload is signed with the signing secrets of the webhook.
 	Webhook       *Webhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1408,8 +1476,14 @@ type Webhook struct {
 	// - TYPE_ISSUE_FIELD_UPDATE
 	// - TYPE_ISSUE_COMMENT_CREATE
 	NotificationTypes []Activity_Type `protobuf:"varint,5,rep,packed,name=notification_types,json=notificationTypes,proto3,enum=bytebase.v1.Activity_Type" json:"notification_types,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// signed is whether the payloads are signed with a signing secret.
+	// Use RotateWebhookSecret to set up the signing secret of a custom or event sink webhook.
+	Signed bool `protobuf:"varint,7,opt,name=signed,proto3" json:"signed,omitempty"`
+	// signing_secret is the signing secret generated for a custom or event sink webhook.
+	// It's only returned once by AddWebhook, use RotateWebhookSecret to get a new one.
+	SigningSecret string `protobuf:"bytes,8,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Webhook) Reset() {
@@ -1484,6 +1558,400 @@ func (x *Webhook) GetNotificationTypes() []Activity_Type {
 	return nil
 }
 
+func (x *Webhook) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+func (x *Webhook) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+type RotateWebhookSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook.
+	// Format: projects/{project}/webhooks/{webhook}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The period during which payloads are still signed with the previous secret.
+	// Defaults to 24 hours if unset. Zero discards the previous secret immediately.
+	GracePeriod   *durationpb.Duration `protobuf:"bytes,2,opt,name=grace_period,json=gracePeriod,proto3,oneof" json:"grace_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateWebhookSecretRequest) Reset() {
+	*x = RotateWebhookSecretRequest{}
+	mi := &file_v1_project_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateWebhookSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateWebhookSecretRequest) ProtoMessage() {}
+
+func (x *RotateWebhookSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateWebhookSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateWebhookSecretRequest) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateWebhookSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RotateWebhookSecretRequest) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type RotateWebhookSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new signing secret. It's only returned once.
+	SigningSecret string `protobuf:"bytes,1,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// The time when the previous secret stops signing payloads.
+	// Unset if there is no previous secret.
+	PreviousSecretExpireTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=previous_secret_expire_time,json=previousSecretExpireTime,proto3" json:"previous_secret_expire_time,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RotateWebhookSecretResponse) Reset() {
+	*x = RotateWebhookSecretResponse{}
+	mi := &file_v1_project_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateWebhookSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateWebhookSecretResponse) ProtoMessage() {}
+
+func (x *RotateWebhookSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateWebhookSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateWebhookSecretResponse) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RotateWebhookSecretResponse) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *RotateWebhookSecretResponse) GetPreviousSecretExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousSecretExpireTime
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent webhook.
+	// Format: projects/{project}/webhooks/{webhook}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of deliveries to return. The service may return fewer
+	// than this value. If unspecified, at most 10 deliveries will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListWebhookDeliveries` call.
+	// Provide this to retrieve the subsequent page.
+	//
+	// When paginating, all other parameters provided to `ListWebhookDeliveries` must match
+	// the call that provided the page token.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_v1_project_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deliveries of the webhook.
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_v1_project_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResendWebhookDeliveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery to re-send.
+	// Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendWebhookDeliveryRequest) Reset() {
+	*x = ResendWebhookDeliveryRequest{}
+	mi := &file_v1_project_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *ResendWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ResendWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResendWebhookDeliveryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery.
+	// Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The activity type that triggered the delivery.
+	EventType Activity_Type `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=bytebase.v1.Activity_Type" json:"event_type,omitempty"`
+	// The title of the notification.
+	Title  string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status WebhookDelivery_Status `protobuf:"varint,4,opt,name=status,proto3,enum=bytebase.v1.WebhookDelivery_Status" json:"status,omitempty"`
+	// The delivery attempts in chronological order.
+	Attempts   []*WebhookDelivery_Attempt `protobuf:"bytes,5,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	CreateTime *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the next attempt. Only set for pending deliveries.
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_v1_project_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WebhookDelivery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() Activity_Type {
+	if x != nil {
+		return x.EventType
+	}
+	return Activity_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDelivery_Status {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDelivery_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() []*WebhookDelivery_Attempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
 // TODO(zp): move to activity later.
 type Activity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1493,7 +1961,7 @@ type Activity struct {
 
 func (x *Activity) Reset() {
 	*x = Activity{}
-	mi := &file_v1_project_service_proto_msgTypes[20]
+	mi := &file_v1_project_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1505,7 +1973,7 @@ func (x *Activity) String() string {
 func (*Activity) ProtoMessage() {}
 
 func (x *Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_project_service_proto_msgTypes[20]
+	mi := &file_v1_project_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,7 +1986,7 @@ func (x *Activity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Activity.ProtoReflect.Descriptor instead.
 func (*Activity) Descriptor() ([]byte, []int) {
-	return file_v1_project_service_proto_rawDescGZIP(), []int{20}
+	return file_v1_project_service_proto_rawDescGZIP(), []int{26}
 }
 
 type BatchGetIamPolicyResponse_PolicyResult struct {
@@ -1531,7 +1999,7 @@ type BatchGetIamPolicyResponse_PolicyResult struct {
 
 func (x *BatchGetIamPolicyResponse_PolicyResult) Reset() {
 	*x = BatchGetIamPolicyResponse_PolicyResult{}
-	mi := &file_v1_project_service_proto_msgTypes[21]
+	mi := &file_v1_project_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1543,7 +2011,7 @@ func (x *BatchGetIamPolicyResponse_PolicyResult) String() string {
 func (*BatchGetIamPolicyResponse_PolicyResult) ProtoMessage() {}
 
 func (x *BatchGetIamPolicyResponse_PolicyResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_project_service_proto_msgTypes[21]
+	mi := &file_v1_project_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +2051,7 @@ type Project_ExecutionRetryPolicy struct {
 
 func (x *Project_ExecutionRetryPolicy) Reset() {
 	*x = Project_ExecutionRetryPolicy{}
-	mi := &file_v1_project_service_proto_msgTypes[22]
+	mi := &file_v1_project_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +2063,7 @@ func (x *Project_ExecutionRetryPolicy) String() string {
 func (*Project_ExecutionRetryPolicy) ProtoMessage() {}
 
 func (x *Project_ExecutionRetryPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_project_service_proto_msgTypes[22]
+	mi := &file_v1_project_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,11 +2086,81 @@ func (x *Project_ExecutionRetryPolicy) GetMaximumRetries() int32 {
 	return 0
 }
 
+type WebhookDelivery_Attempt struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The HTTP status code returned by the receiver, 0 if unknown.
+	StatusCode int32                `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Latency    *durationpb.Duration `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The error message, empty if the attempt succeeded.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery_Attempt) Reset() {
+	*x = WebhookDelivery_Attempt{}
+	mi := &file_v1_project_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery_Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery_Attempt) ProtoMessage() {}
+
+func (x *WebhookDelivery_Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery_Attempt.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery_Attempt) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *WebhookDelivery_Attempt) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery_Attempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery_Attempt) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *WebhookDelivery_Attempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_v1_project_service_proto protoreflect.FileDescriptor
 
 const file_v1_project_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18v1/project_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x13v1/iam_policy.proto\"E\n" +
+	"\x18v1/project_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x13v1/iam_policy.proto\"E\n" +
 	"\x11GetProjectRequest\x120\n" +
 	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x04name\"\x8c\x01\n" +
@@ -1711,14 +2249,16 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x14bytebase.com/ProjectR\aproject\x123\n" +
 	"\awebhook\x18\x02 \x01(\v2\x14.bytebase.v1.WebhookB\x03\xe0A\x02R\awebhook\"+\n" +
 	"\x13TestWebhookResponse\x12\x14\n" +
-	"\x05error\x18\x01 \x01(\tR\x05error\"\xb8\x03\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"\x92\x04\n" +
 	"\aWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x19.bytebase.v1.Webhook.TypeB\x03\xe0A\x02R\x04type\x12\x19\n" +
 	"\x05title\x18\x03 \x01(\tB\x03\xe0A\x02R\x05title\x12\x15\n" +
 	"\x03url\x18\x04 \x01(\tB\x03\xe0A\x02R\x03url\x12%\n" +
 	"\x0edirect_message\x18\x06 \x01(\bR\rdirectMessage\x12N\n" +
-	"\x12notification_types\x18\x05 \x03(\x0e2\x1a.bytebase.v1.Activity.TypeB\x03\xe0A\x06R\x11notificationTypes\"z\n" +
+	"\x12notification_types\x18\x05 \x03(\x0e2\x1a.bytebase.v1.Activity.TypeB\x03\xe0A\x06R\x11notificationTypes\x12\x1b\n" +
+	"\x06signed\x18\a \x01(\bB\x03\xe0A\x03R\x06signed\x12*\n" +
+	"\x0esigning_secret\x18\b \x01(\tB\x03\xe0A\x03R\rsigningSecret\"\x8a\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05SLACK\x10\x01\x12\v\n" +
@@ -1730,20 +2270,69 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x05WECOM\x10\x06\x12\b\n" +
 	"\x04LARK\x10\b\x12\n" +
 	"\n" +
-	"\x06CUSTOM\x10\t:@\xeaA=\n" +
-	"\x14bytebase.com/Webhook\x12%projects/{project}/webhooks/{webhook}\"\xac\x02\n" +
-	"\bActivity\"\x9f\x02\n" +
+	"\x06CUSTOM\x10\t\x12\x0e\n" +
+	"\n" +
+	"EVENT_SINK\x10\n" +
+	":@\xeaA=\n" +
+	"\x14bytebase.com/Webhook\x12%projects/{project}/webhooks/{webhook}\"\xa2\x01\n" +
+	"\x1aRotateWebhookSecretRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/WebhookR\x04name\x12A\n" +
+	"\fgrace_period\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\vgracePeriod\x88\x01\x01B\x0f\n" +
+	"\r_grace_period\"\x9f\x01\n" +
+	"\x1bRotateWebhookSecretResponse\x12%\n" +
+	"\x0esigning_secret\x18\x01 \x01(\tR\rsigningSecret\x12Y\n" +
+	"\x1bprevious_secret_expire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x18previousSecretExpireTime\"\x90\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/WebhookR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12<\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1c.bytebase.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"X\n" +
+	"\x1cResendWebhookDeliveryRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cbytebase.com/WebhookDeliveryR\x04name\"\xd2\x05\n" +
+	"\x0fWebhookDelivery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e2\x1a.bytebase.v1.Activity.TypeR\teventType\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12;\n" +
+	"\x06status\x18\x04 \x01(\x0e2#.bytebase.v1.WebhookDelivery.StatusR\x06status\x12@\n" +
+	"\battempts\x18\x05 \x03(\v2$.bytebase.v1.WebhookDelivery.AttemptR\battempts\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12F\n" +
+	"\x11next_attempt_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextAttemptTime\x1a\xb2\x01\n" +
+	"\aAttempt\x12;\n" +
+	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x123\n" +
+	"\alatency\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"A\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\b\n" +
+	"\x04DONE\x10\x02\x12\b\n" +
+	"\x04DEAD\x10\x03:^\xeaA[\n" +
+	"\x1cbytebase.com/WebhookDelivery\x12;projects/{project}/webhooks/{webhook}/deliveries/{delivery}\"\xc5\x02\n" +
+	"\bActivity\"\xb8\x02\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15NOTIFY_ISSUE_APPROVED\x10\x17\x12\x1b\n" +
-	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x10\n" +
+	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x17\n" +
+	"\x13NOTIFY_SCHEMA_DRIFT\x10\x19\x12\x10\n" +
 	"\fISSUE_CREATE\x10\x01\x12\x18\n" +
 	"\x14ISSUE_COMMENT_CREATE\x10\x02\x12\x16\n" +
 	"\x12ISSUE_FIELD_UPDATE\x10\x03\x12\x17\n" +
 	"\x13ISSUE_STATUS_UPDATE\x10\x04\x12\x19\n" +
 	"\x15ISSUE_APPROVAL_NOTIFY\x10\x15\x12&\n" +
 	"\"ISSUE_PIPELINE_STAGE_STATUS_UPDATE\x10\x05\x12)\n" +
-	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x162\x8b\x12\n" +
+	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x162\xde\x16\n" +
 	"\x0eProjectService\x12\x7f\n" +
 	"\n" +
 	"GetProject\x12\x1e.bytebase.v1.GetProjectRequest\x1a\x14.bytebase.v1.Project\";\xdaA\x04name\x8a\xea0\x0fbb.projects.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=projects/*}\x12\x84\x01\n" +
@@ -1761,7 +2350,10 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"AddWebhook\x12\x1e.bytebase.v1.AddWebhookRequest\x1a\x14.bytebase.v1.Project\"H\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/{project=projects/*}:addWebhook\x12\xbb\x01\n" +
 	"\rUpdateWebhook\x12!.bytebase.v1.UpdateWebhookRequest\x1a\x14.bytebase.v1.Project\"q\xdaA\x13webhook,update_mask\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/{webhook.name=projects/*/webhooks/*}:updateWebhook\x12\xa5\x01\n" +
 	"\rRemoveWebhook\x12!.bytebase.v1.RemoveWebhookRequest\x1a\x14.bytebase.v1.Project\"[\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/{webhook.name=projects/*/webhooks/*}:removeWebhook\x12\x9b\x01\n" +
-	"\vTestWebhook\x12\x1f.bytebase.v1.TestWebhookRequest\x1a .bytebase.v1.TestWebhookResponse\"I\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02):\x01*\"$/v1/{project=projects/*}:testWebhookB6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
+	"\vTestWebhook\x12\x1f.bytebase.v1.TestWebhookRequest\x1a .bytebase.v1.TestWebhookResponse\"I\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02):\x01*\"$/v1/{project=projects/*}:testWebhook\x12\xc3\x01\n" +
+	"\x13RotateWebhookSecret\x12'.bytebase.v1.RotateWebhookSecretRequest\x1a(.bytebase.v1.RotateWebhookSecretResponse\"Y\xdaA\x04name\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x022:\x01*\"-/v1/{name=projects/*/webhooks/*}:rotateSecret\x12\xc5\x01\n" +
+	"\x15ListWebhookDeliveries\x12).bytebase.v1.ListWebhookDeliveriesRequest\x1a*.bytebase.v1.ListWebhookDeliveriesResponse\"U\xdaA\x06parent\x8a\xea0\x0fbb.projects.get\x90\xea0\x01\x82\xd3\xe4\x93\x02/\x12-/v1/{parent=projects/*/webhooks/*}/deliveries\x12\xc2\x01\n" +
+	"\x15ResendWebhookDelivery\x12).bytebase.v1.ResendWebhookDeliveryRequest\x1a\x1c.bytebase.v1.WebhookDelivery\"`\xdaA\x04name\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x029:\x01*\"4/v1/{name=projects/*/webhooks/*/deliveries/*}:resendB6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
 
 var (
 	file_v1_project_service_proto_rawDescOnce sync.Once
@@ -1775,95 +2367,121 @@ func file_v1_project_service_proto_rawDescGZIP() []byte {
 	return file_v1_project_service_proto_rawDescData
 }
 
-var file_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_v1_project_service_proto_goTypes = []any{
 	(Webhook_Type)(0),                              // 0: bytebase.v1.Webhook.Type
-	(Activity_Type)(0),                             // 1: bytebase.v1.Activity.Type
-	(*GetProjectRequest)(nil),                      // 2: bytebase.v1.GetProjectRequest
-	(*ListProjectsRequest)(nil),                    // 3: bytebase.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                   // 4: bytebase.v1.ListProjectsResponse
-	(*SearchProjectsRequest)(nil),                  // 5: bytebase.v1.SearchProjectsRequest
-	(*SearchProjectsResponse)(nil),                 // 6: bytebase.v1.SearchProjectsResponse
-	(*CreateProjectRequest)(nil),                   // 7: bytebase.v1.CreateProjectRequest
-	(*UpdateProjectRequest)(nil),                   // 8: bytebase.v1.UpdateProjectRequest
-	(*DeleteProjectRequest)(nil),                   // 9: bytebase.v1.DeleteProjectRequest
-	(*UndeleteProjectRequest)(nil),                 // 10: bytebase.v1.UndeleteProjectRequest
-	(*BatchDeleteProjectsRequest)(nil),             // 11: bytebase.v1.BatchDeleteProjectsRequest
-	(*BatchGetIamPolicyRequest)(nil),               // 12: bytebase.v1.BatchGetIamPolicyRequest
-	(*BatchGetIamPolicyResponse)(nil),              // 13: bytebase.v1.BatchGetIamPolicyResponse
-	(*Label)(nil),                                  // 14: bytebase.v1.Label
-	(*Project)(nil),                                // 15: bytebase.v1.Project
-	(*AddWebhookRequest)(nil),                      // 16: bytebase.v1.AddWebhookRequest
-	(*UpdateWebhookRequest)(nil),                   // 17: bytebase.v1.UpdateWebhookRequest
-	(*RemoveWebhookRequest)(nil),                   // 18: bytebase.v1.RemoveWebhookRequest
-	(*TestWebhookRequest)(nil),                     // 19: bytebase.v1.TestWebhookRequest
-	(*TestWebhookResponse)(nil),                    // 20: bytebase.v1.TestWebhookResponse
-	(*Webhook)(nil),                                // 21: bytebase.v1.Webhook
-	(*Activity)(nil),                               // 22: bytebase.v1.Activity
-	(*BatchGetIamPolicyResponse_PolicyResult)(nil), // 23: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
-	(*Project_ExecutionRetryPolicy)(nil),           // 24: bytebase.v1.Project.ExecutionRetryPolicy
-	(*fieldmaskpb.FieldMask)(nil),                  // 25: google.protobuf.FieldMask
-	(State)(0),                                     // 26: bytebase.v1.State
-	(*IamPolicy)(nil),                              // 27: bytebase.v1.IamPolicy
-	(*GetIamPolicyRequest)(nil),                    // 28: bytebase.v1.GetIamPolicyRequest
-	(*SetIamPolicyRequest)(nil),                    // 29: bytebase.v1.SetIamPolicyRequest
-	(*emptypb.Empty)(nil),                          // 30: google.protobuf.Empty
+	(WebhookDelivery_Status)(0),                    // 1: bytebase.v1.WebhookDelivery.Status
+	(Activity_Type)(0),                             // 2: bytebase.v1.Activity.Type
+	(*GetProjectRequest)(nil),                      // 3: bytebase.v1.GetProjectRequest
+	(*ListProjectsRequest)(nil),                    // 4: bytebase.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                   // 5: bytebase.v1.ListProjectsResponse
+	(*SearchProjectsRequest)(nil),                  // 6: bytebase.v1.SearchProjectsRequest
+	(*SearchProjectsResponse)(nil),                 // 7: bytebase.v1.SearchProjectsResponse
+	(*CreateProjectRequest)(nil),                   // 8: bytebase.v1.CreateProjectRequest
+	(*UpdateProjectRequest)(nil),                   // 9: bytebase.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),                   // 10: bytebase.v1.DeleteProjectRequest
+	(*UndeleteProjectRequest)(nil),                 // 11: bytebase.v1.UndeleteProjectRequest
+	(*BatchDeleteProjectsRequest)(nil),             // 12: bytebase.v1.BatchDeleteProjectsRequest
+	(*BatchGetIamPolicyRequest)(nil),               // 13: bytebase.v1.BatchGetIamPolicyRequest
+	(*BatchGetIamPolicyResponse)(nil),              // 14: bytebase.v1.BatchGetIamPolicyResponse
+	(*Label)(nil),                                  // 15: bytebase.v1.Label
+	(*Project)(nil),                                // 16: bytebase.v1.Project
+	(*AddWebhookRequest)(nil),                      // 17: bytebase.v1.AddWebhookRequest
+	(*UpdateWebhookRequest)(nil),                   // 18: bytebase.v1.UpdateWebhookRequest
+	(*RemoveWebhookRequest)(nil),                   // 19: bytebase.v1.RemoveWebhookRequest
+	(*TestWebhookRequest)(nil),                     // 20: bytebase.v1.TestWebhookRequest
+	(*TestWebhookResponse)(nil),                    // 21: bytebase.v1.TestWebhookResponse
+	(*Webhook)(nil),                                // 22: bytebase.v1.Webhook
+	(*RotateWebhookSecretRequest)(nil),             // 23: bytebase.v1.RotateWebhookSecretRequest
+	(*RotateWebhookSecretResponse)(nil),            // 24: bytebase.v1.RotateWebhookSecretResponse
+	(*ListWebhookDeliveriesRequest)(nil),           // 25: bytebase.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),          // 26: bytebase.v1.ListWebhookDeliveriesResponse
+	(*ResendWebhookDeliveryRequest)(nil),           // 27: bytebase.v1.ResendWebhookDeliveryRequest
+	(*WebhookDelivery)(nil),                        // 28: bytebase.v1.WebhookDelivery
+	(*Activity)(nil),                               // 29: bytebase.v1.Activity
+	(*BatchGetIamPolicyResponse_PolicyResult)(nil), // 30: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
+	(*Project_ExecutionRetryPolicy)(nil),           // 31: bytebase.v1.Project.ExecutionRetryPolicy
+	(*WebhookDelivery_Attempt)(nil),                // 32: bytebase.v1.WebhookDelivery.Attempt
+	(*fieldmaskpb.FieldMask)(nil),                  // 33: google.protobuf.FieldMask
+	(State)(0),                                     // 34: bytebase.v1.State
+	(*durationpb.Duration)(nil),                    // 35: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                  // 36: google.protobuf.Timestamp
+	(*IamPolicy)(nil),                              // 37: bytebase.v1.IamPolicy
+	(*GetIamPolicyRequest)(nil),                    // 38: bytebase.v1.GetIamPolicyRequest
+	(*SetIamPolicyRequest)(nil),                    // 39: bytebase.v1.SetIamPolicyRequest
+	(*emptypb.Empty)(nil),                          // 40: google.protobuf.Empty
 }
 var file_v1_project_service_proto_depIdxs = []int32{
-	15, // 0: bytebase.v1.ListProjectsResponse.projects:type_name -> bytebase.v1.Project
-	15, // 1: bytebase.v1.SearchProjectsResponse.projects:type_name -> bytebase.v1.Project
-	15, // 2: bytebase.v1.CreateProjectRequest.project:type_name -> bytebase.v1.Project
-	15, // 3: bytebase.v1.UpdateProjectRequest.project:type_name -> bytebase.v1.Project
-	25, // 4: bytebase.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	23, // 5: bytebase.v1.BatchGetIamPolicyResponse.policy_results:type_name -> bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
-	26, // 6: bytebase.v1.Project.state:type_name -> bytebase.v1.State
-	21, // 7: bytebase.v1.Project.webhooks:type_name -> bytebase.v1.Webhook
-	14, // 8: bytebase.v1.Project.issue_labels:type_name -> bytebase.v1.Label
-	24, // 9: bytebase.v1.Project.execution_retry_policy:type_name -> bytebase.v1.Project.ExecutionRetryPolicy
-	21, // 10: bytebase.v1.AddWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	21, // 11: bytebase.v1.UpdateWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	25, // 12: bytebase.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	21, // 13: bytebase.v1.RemoveWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	21, // 14: bytebase.v1.TestWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	16, // 0: bytebase.v1.ListProjectsResponse.projects:type_name -> bytebase.v1.Project
+	16, // 1: bytebase.v1.SearchProjectsResponse.projects:type_name -> bytebase.v1.Project
+	16, // 2: bytebase.v1.CreateProjectRequest.project:type_name -> bytebase.v1.Project
+	16, // 3: bytebase.v1.UpdateProjectRequest.project:type_name -> bytebase.v1.Project
+	33, // 4: bytebase.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	30, // 5: bytebase.v1.BatchGetIamPolicyResponse.policy_results:type_name -> bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
+	34, // 6: bytebase.v1.Project.state:type_name -> bytebase.v1.State
+	22, // 7: bytebase.v1.Project.webhooks:type_name -> bytebase.v1.Webhook
+	15, // 8: bytebase.v1.Project.issue_labels:type_name -> bytebase.v1.Label
+	31, // 9: bytebase.v1.Project.execution_retry_policy:type_name -> bytebase.v1.Project.ExecutionRetryPolicy
+	22, // 10: bytebase.v1.AddWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	22, // 11: bytebase.v1.UpdateWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	33, // 12: bytebase.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22, // 13: bytebase.v1.RemoveWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	22, // 14: bytebase.v1.TestWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
 	0,  // 15: bytebase.v1.Webhook.type:type_name -> bytebase.v1.Webhook.Type
-	1,  // 16: bytebase.v1.Webhook.notification_types:type_name -> bytebase.v1.Activity.Type
-	27, // 17: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult.policy:type_name -> bytebase.v1.IamPolicy
-	2,  // 18: bytebase.v1.ProjectService.GetProject:input_type -> bytebase.v1.GetProjectRequest
-	3,  // 19: bytebase.v1.ProjectService.ListProjects:input_type -> bytebase.v1.ListProjectsRequest
-	5,  // 20: bytebase.v1.ProjectService.SearchProjects:input_type -> bytebase.v1.SearchProjectsRequest
-	7,  // 21: bytebase.v1.ProjectService.CreateProject:input_type -> bytebase.v1.CreateProjectRequest
-	8,  // 22: bytebase.v1.ProjectService.UpdateProject:input_type -> bytebase.v1.UpdateProjectRequest
-	9,  // 23: bytebase.v1.ProjectService.DeleteProject:input_type -> bytebase.v1.DeleteProjectRequest
-	10, // 24: bytebase.v1.ProjectService.UndeleteProject:input_type -> bytebase.v1.UndeleteProjectRequest
-	11, // 25: bytebase.v1.ProjectService.BatchDeleteProjects:input_type -> bytebase.v1.BatchDeleteProjectsRequest
-	28, // 26: bytebase.v1.ProjectService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
-	12, // 27: bytebase.v1.ProjectService.BatchGetIamPolicy:input_type -> bytebase.v1.BatchGetIamPolicyRequest
-	29, // 28: bytebase.v1.ProjectService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
-	16, // 29: bytebase.v1.ProjectService.AddWebhook:input_type -> bytebase.v1.AddWebhookRequest
-	17, // 30: bytebase.v1.ProjectService.UpdateWebhook:input_type -> bytebase.v1.UpdateWebhookRequest
-	18, // 31: bytebase.v1.ProjectService.RemoveWebhook:input_type -> bytebase.v1.RemoveWebhookRequest
-	19, // 32: bytebase.v1.ProjectService.TestWebhook:input_type -> bytebase.v1.TestWebhookRequest
-	15, // 33: bytebase.v1.ProjectService.GetProject:output_type -> bytebase.v1.Project
-	4,  // 34: bytebase.v1.ProjectService.ListProjects:output_type -> bytebase.v1.ListProjectsResponse
-	6,  // 35: bytebase.v1.ProjectService.SearchProjects:output_type -> bytebase.v1.SearchProjectsResponse
-	15, // 36: bytebase.v1.ProjectService.CreateProject:output_type -> bytebase.v1.Project
-	15, // 37: bytebase.v1.ProjectService.UpdateProject:output_type -> bytebase.v1.Project
-	30, // 38: bytebase.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	15, // 39: bytebase.v1.ProjectService.UndeleteProject:output_type -> bytebase.v1.Project
-	30, // 40: bytebase.v1.ProjectService.BatchDeleteProjects:output_type -> google.protobuf.Empty
-	27, // 41: bytebase.v1.ProjectService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	13, // 42: bytebase.v1.ProjectService.BatchGetIamPolicy:output_type -> bytebase.v1.BatchGetIamPolicyResponse
-	27, // 43: bytebase.v1.ProjectService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	15, // 44: bytebase.v1.ProjectService.AddWebhook:output_type -> bytebase.v1.Project
-	15, // 45: bytebase.v1.ProjectService.UpdateWebhook:output_type -> bytebase.v1.Project
-	15, // 46: bytebase.v1.ProjectService.RemoveWebhook:output_type -> bytebase.v1.Project
-	20, // 47: bytebase.v1.ProjectService.TestWebhook:output_type -> bytebase.v1.TestWebhookResponse
-	33, // [33:48] is the sub-list for method output_type
-	18, // [18:33] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	2,  // 16: bytebase.v1.Webhook.notification_types:type_name -> bytebase.v1.Activity.Type
+	35, // 17: bytebase.v1.RotateWebhookSecretRequest.grace_period:type_name -> google.protobuf.Duration
+	36, // 18: bytebase.v1.RotateWebhookSecretResponse.previous_secret_expire_time:type_name -> google.protobuf.Timestamp
+	28, // 19: bytebase.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> bytebase.v1.WebhookDelivery
+	2,  // 20: bytebase.v1.WebhookDelivery.event_type:type_name -> bytebase.v1.Activity.Type
+	1,  // 21: bytebase.v1.WebhookDelivery.status:type_name -> bytebase.v1.WebhookDelivery.Status
+	32, // 22: bytebase.v1.WebhookDelivery.attempts:type_name -> bytebase.v1.WebhookDelivery.Attempt
+	36, // 23: bytebase.v1.WebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	36, // 24: bytebase.v1.WebhookDelivery.next_attempt_time:type_name -> google.protobuf.Timestamp
+	37, // 25: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult.policy:type_name -> bytebase.v1.IamPolicy
+	36, // 26: bytebase.v1.WebhookDelivery.Attempt.create_time:type_name -> google.protobuf.Timestamp
+	35, // 27: bytebase.v1.WebhookDelivery.Attempt.latency:type_name -> google.protobuf.Duration
+	3,  // 28: bytebase.v1.ProjectService.GetProject:input_type -> bytebase.v1.GetProjectRequest
+	4,  // 29: bytebase.v1.ProjectService.ListProjects:input_type -> bytebase.v1.ListProjectsRequest
+	6,  // 30: bytebase.v1.ProjectService.SearchProjects:input_type -> bytebase.v1.SearchProjectsRequest
+	8,  // 31: bytebase.v1.ProjectService.CreateProject:input_type -> bytebase.v1.CreateProjectRequest
+	9,  // 32: bytebase.v1.ProjectService.UpdateProject:input_type -> bytebase.v1.UpdateProjectRequest
+	10, // 33: bytebase.v1.ProjectService.DeleteProject:input_type -> bytebase.v1.DeleteProjectRequest
+	11, // 34: bytebase.v1.ProjectService.UndeleteProject:input_type -> bytebase.v1.UndeleteProjectRequest
+	12, // 35: bytebase.v1.ProjectService.BatchDeleteProjects:input_type -> bytebase.v1.BatchDeleteProjectsRequest
+	38, // 36: bytebase.v1.ProjectService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
+	13, // 37: bytebase.v1.ProjectService.BatchGetIamPolicy:input_type -> bytebase.v1.BatchGetIamPolicyRequest
+	39, // 38: bytebase.v1.ProjectService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
+	17, // 39: bytebase.v1.ProjectService.AddWebhook:input_type -> bytebase.v1.AddWebhookRequest
+	18, // 40: bytebase.v1.ProjectService.UpdateWebhook:input_type -> bytebase.v1.UpdateWebhookRequest
+	19, // 41: bytebase.v1.ProjectService.RemoveWebhook:input_type -> bytebase.v1.RemoveWebhookRequest
+	20, // 42: bytebase.v1.ProjectService.TestWebhook:input_type -> bytebase.v1.TestWebhookRequest
+	23, // 43: bytebase.v1.ProjectService.RotateWebhookSecret:input_type -> bytebase.v1.RotateWebhookSecretRequest
+	25, // 44: bytebase.v1.ProjectService.ListWebhookDeliveries:input_type -> bytebase.v1.ListWebhookDeliveriesRequest
+	27, // 45: bytebase.v1.ProjectService.ResendWebhookDelivery:input_type -> bytebase.v1.ResendWebhookDeliveryRequest
+	16, // 46: bytebase.v1.ProjectService.GetProject:output_type -> bytebase.v1.Project
+	5,  // 47: bytebase.v1.ProjectService.ListProjects:output_type -> bytebase.v1.ListProjectsResponse
+	7,  // 48: bytebase.v1.ProjectService.SearchProjects:output_type -> bytebase.v1.SearchProjectsResponse
+	16, // 49: bytebase.v1.ProjectService.CreateProject:output_type -> bytebase.v1.Project
+	16, // 50: bytebase.v1.ProjectService.UpdateProject:output_type -> bytebase.v1.Project
+	40, // 51: bytebase.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	16, // 52: bytebase.v1.ProjectService.UndeleteProject:output_type -> bytebase.v1.Project
+	40, // 53: bytebase.v1.ProjectService.BatchDeleteProjects:output_type -> google.protobuf.Empty
+	37, // 54: bytebase.v1.ProjectService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	14, // 55: bytebase.v1.ProjectService.BatchGetIamPolicy:output_type -> bytebase.v1.BatchGetIamPolicyResponse
+	37, // 56: bytebase.v1.ProjectService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	16, // 57: bytebase.v1.ProjectService.AddWebhook:output_type -> bytebase.v1.Project
+	16, // 58: bytebase.v1.ProjectService.UpdateWebhook:output_type -> bytebase.v1.Project
+	16, // 59: bytebase.v1.ProjectService.RemoveWebhook:output_type -> bytebase.v1.Project
+	21, // 60: bytebase.v1.ProjectService.TestWebhook:output_type -> bytebase.v1.TestWebhookResponse
+	24, // 61: bytebase.v1.ProjectService.RotateWebhookSecret:output_type -> bytebase.v1.RotateWebhookSecretResponse
+	26, // 62: bytebase.v1.ProjectService.ListWebhookDeliveries:output_type -> bytebase.v1.ListWebhookDeliveriesResponse
+	28, // 63: bytebase.v1.ProjectService.ResendWebhookDelivery:output_type -> bytebase.v1.WebhookDelivery
+	46, // [46:64] is the sub-list for method output_type
+	28, // [28:46] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_v1_project_service_proto_init() }
@@ -1874,13 +2492,14 @@ func file_v1_project_service_proto_init() {
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
 	file_v1_iam_policy_proto_init()
+	file_v1_project_service_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_project_service_proto_rawDesc), len(file_v1_project_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   23,
+			NumEnums:      3,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -175,6 +175,58 @@ func (Release_File_ChangeType) EnumDescriptor() ([]byte, []int) {
 	return file_v1_release_service_proto_rawDescGZIP(), []int{9, 0, 1}
 }
 
+type Release_File_Format int32
+
+const (
+	// Plain SQL.
+	Release_File_FORMAT_UNSPECIFIED Release_File_Format = 0
+	// MyBatis mapper xml.
+	Release_File_MYBATIS_MAPPER Release_File_Format = 1
+	// TypeORM migration class in typescript. The statements are extracted from the up() function.
+	Release_File_TYPEORM_MIGRATION Release_File_Format = 2
+)
+
+// Enum value maps for Release_File_Format.
+var (
+	Release_File_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "MYBATIS_MAPPER",
+		2: "TYPEORM_MIGRATION",
+	}
+	Release_File_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"MYBATIS_MAPPER":     1,
+		"TYPEORM_MIGRATION":  2,
+	}
+)
+
+func (x Release_File_Format) Enum() *Release_File_Format {
+	p := new(Release_File_Format)
+	*p = x
+	return p
+}
+
+func (x Release_File_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Release_File_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_release_service_proto_enumTypes[3].Descriptor()
+}
+
+func (Release_File_Format) Type() protoreflect.EnumType {
+	return &file_v1_release_service_proto_enumTypes[3]
+}
+
+func (x Release_File_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Release_File_Format.Descriptor instead.
+func (Release_File_Format) EnumDescriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{9, 0, 2}
+}
+
 type GetReleaseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Format: projects/{project}/releases/{release}
@@ -883,6 +935,10 @@ type Release_File struct {
 	SheetSha256 string `protobuf:"bytes,4,opt,name=sheet_sha256,json=sheetSha256,proto3" json:"sheet_sha256,omitempty"`
 	// The size of the statement in bytes.
 	StatementSize int64 `protobuf:"varint,8,opt,name=statement_size,json=statementSize,proto3" json:"statement_size,omitempty"`
+	// The format of the file content.
+	// Only CheckRelease accepts formats other than plain SQL. The SQL statements are extracted
+	// from the file, and the advice positions point at the lines in the original file.
+	Format        Release_File_Format `protobuf:"varint,10,opt,name=format,proto3,enum=bytebase.v1.Release_File_Format" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -980,6 +1036,13 @@ func (x *Release_File) GetStatementSize() int64 {
 	return 0
 }
 
+func (x *Release_File) GetFormat() Release_File_Format {
+	if x != nil {
+		return x.Format
+	}
+	return Release_File_FORMAT_UNSPECIFIED
+}
+
 type Release_VCSSource struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	VcsType VCSType                `protobuf:"varint,1,opt,name=vcs_type,json=vcsType,proto3,enum=bytebase.v1.VCSType" json:"vcs_type,omitempty"`
@@ -1086,7 +1149,7 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\x16RISK_LEVEL_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03LOW\x10\x01\x12\f\n" +
 	"\bMODERATE\x10\x02\x12\b\n" +
-	"\x04HIGH\x10\x03\"\xa6\a\n" +
+	"\x04HIGH\x10\x03\"\xad\b\n" +
 	"\aRelease\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12/\n" +
@@ -1096,7 +1159,7 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\acreator\x18\x05 \x01(\tB\x03\xe0A\x03R\acreator\x12@\n" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12-\n" +
-	"\x05state\x18\a \x01(\x0e2\x12.bytebase.v1.StateB\x03\xe0A\x03R\x05state\x1a\xd9\x03\n" +
+	"\x05state\x18\a \x01(\x0e2\x12.bytebase.v1.StateB\x03\xe0A\x03R\x05state\x1a\xe0\x04\n" +
 	"\x04File\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x122\n" +
@@ -1108,7 +1171,9 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\x12bytebase.com/SheetR\x05sheet\x12\x1c\n" +
 	"\tstatement\x18\a \x01(\fR\tstatement\x12&\n" +
 	"\fsheet_sha256\x18\x04 \x01(\tB\x03\xe0A\x03R\vsheetSha256\x12*\n" +
-	"\x0estatement_size\x18\b \x01(\x03B\x03\xe0A\x03R\rstatementSize\"+\n" +
+	"\x0estatement_size\x18\b \x01(\x03B\x03\xe0A\x03R\rstatementSize\x128\n" +
+	"\x06format\x18\n" +
+	" \x01(\x0e2 .bytebase.v1.Release.File.FormatR\x06format\"+\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tVERSIONED\x10\x01\"J\n" +
@@ -1117,7 +1182,11 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03DDL\x10\x01\x12\r\n" +
 	"\tDDL_GHOST\x10\x02\x12\a\n" +
-	"\x03DML\x10\x03\x1aN\n" +
+	"\x03DML\x10\x03\"K\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eMYBATIS_MAPPER\x10\x01\x12\x15\n" +
+	"\x11TYPEORM_MIGRATION\x10\x02\x1aN\n" +
 	"\tVCSSource\x12/\n" +
 	"\bvcs_type\x18\x01 \x01(\x0e2\x14.bytebase.v1.VCSTypeR\avcsType\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url:@\xeaA=\n" +
@@ -1144,68 +1213,70 @@ func file_v1_release_service_proto_rawDescGZIP() []byte {
 	return file_v1_release_service_proto_rawDescData
 }
 
-var file_v1_release_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v1_release_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_v1_release_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_v1_release_service_proto_goTypes = []any{
 	(CheckReleaseResponse_RiskLevel)(0),      // 0: bytebase.v1.CheckReleaseResponse.RiskLevel
 	(Release_File_Type)(0),                   // 1: bytebase.v1.Release.File.Type
 	(Release_File_ChangeType)(0),             // 2: bytebase.v1.Release.File.ChangeType
-	(*GetReleaseRequest)(nil),                // 3: bytebase.v1.GetReleaseRequest
-	(*ListReleasesRequest)(nil),              // 4: bytebase.v1.ListReleasesRequest
-	(*ListReleasesResponse)(nil),             // 5: bytebase.v1.ListReleasesResponse
-	(*CreateReleaseRequest)(nil),             // 6: bytebase.v1.CreateReleaseRequest
-	(*UpdateReleaseRequest)(nil),             // 7: bytebase.v1.UpdateReleaseRequest
-	(*DeleteReleaseRequest)(nil),             // 8: bytebase.v1.DeleteReleaseRequest
-	(*UndeleteReleaseRequest)(nil),           // 9: bytebase.v1.UndeleteReleaseRequest
-	(*CheckReleaseRequest)(nil),              // 10: bytebase.v1.CheckReleaseRequest
-	(*CheckReleaseResponse)(nil),             // 11: bytebase.v1.CheckReleaseResponse
-	(*Release)(nil),                          // 12: bytebase.v1.Release
-	(*CheckReleaseResponse_CheckResult)(nil), // 13: bytebase.v1.CheckReleaseResponse.CheckResult
-	(*Release_File)(nil),                     // 14: bytebase.v1.Release.File
-	(*Release_VCSSource)(nil),                // 15: bytebase.v1.Release.VCSSource
-	(*fieldmaskpb.FieldMask)(nil),            // 16: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),            // 17: google.protobuf.Timestamp
-	(State)(0),                               // 18: bytebase.v1.State
-	(*Advice)(nil),                           // 19: bytebase.v1.Advice
-	(VCSType)(0),                             // 20: bytebase.v1.VCSType
-	(*emptypb.Empty)(nil),                    // 21: google.protobuf.Empty
+	(Release_File_Format)(0),                 // 3: bytebase.v1.Release.File.Format
+	(*GetReleaseRequest)(nil),                // 4: bytebase.v1.GetReleaseRequest
+	(*ListReleasesRequest)(nil),              // 5: bytebase.v1.ListReleasesRequest
+	(*ListReleasesResponse)(nil),             // 6: bytebase.v1.ListReleasesResponse
+	(*CreateReleaseRequest)(nil),             // 7: bytebase.v1.CreateReleaseRequest
+	(*UpdateReleaseRequest)(nil),             // 8: bytebase.v1.UpdateReleaseRequest
+	(*DeleteReleaseRequest)(nil),             // 9: bytebase.v1.DeleteReleaseRequest
+	(*UndeleteReleaseRequest)(nil),           // 10: bytebase.v1.UndeleteReleaseRequest
+	(*CheckReleaseRequest)(nil),              // 11: bytebase.v1.CheckReleaseRequest
+	(*CheckReleaseResponse)(nil),             // 12: bytebase.v1.CheckReleaseResponse
+	(*Release)(nil),                          // 13: bytebase.v1.Release
+	(*CheckReleaseResponse_CheckResult)(nil), // 14: bytebase.v1.CheckReleaseResponse.CheckResult
+	(*Release_File)(nil),                     // 15: bytebase.v1.Release.File
+	(*Release_VCSSource)(nil),                // 16: bytebase.v1.Release.VCSSource
+	(*fieldmaskpb.FieldMask)(nil),            // 17: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
+	(State)(0),                               // 19: bytebase.v1.State
+	(*Advice)(nil),                           // 20: bytebase.v1.Advice
+	(VCSType)(0),                             // 21: bytebase.v1.VCSType
+	(*emptypb.Empty)(nil),                    // 22: google.protobuf.Empty
 }
 var file_v1_release_service_proto_depIdxs = []int32{
-	12, // 0: bytebase.v1.ListReleasesResponse.releases:type_name -> bytebase.v1.Release
-	12, // 1: bytebase.v1.CreateReleaseRequest.release:type_name -> bytebase.v1.Release
-	12, // 2: bytebase.v1.UpdateReleaseRequest.release:type_name -> bytebase.v1.Release
-	16, // 3: bytebase.v1.UpdateReleaseRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 4: bytebase.v1.CheckReleaseRequest.release:type_name -> bytebase.v1.Release
-	13, // 5: bytebase.v1.CheckReleaseResponse.results:type_name -> bytebase.v1.CheckReleaseResponse.CheckResult
+	13, // 0: bytebase.v1.ListReleasesResponse.releases:type_name -> bytebase.v1.Release
+	13, // 1: bytebase.v1.CreateReleaseRequest.release:type_name -> bytebase.v1.Release
+	13, // 2: bytebase.v1.UpdateReleaseRequest.release:type_name -> bytebase.v1.Release
+	17, // 3: bytebase.v1.UpdateReleaseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	13, // 4: bytebase.v1.CheckReleaseRequest.release:type_name -> bytebase.v1.Release
+	14, // 5: bytebase.v1.CheckReleaseResponse.results:type_name -> bytebase.v1.CheckReleaseResponse.CheckResult
 	0,  // 6: bytebase.v1.CheckReleaseResponse.risk_level:type_name -> bytebase.v1.CheckReleaseResponse.RiskLevel
-	14, // 7: bytebase.v1.Release.files:type_name -> bytebase.v1.Release.File
-	15, // 8: bytebase.v1.Release.vcs_source:type_name -> bytebase.v1.Release.VCSSource
-	17, // 9: bytebase.v1.Release.create_time:type_name -> google.protobuf.Timestamp
-	18, // 10: bytebase.v1.Release.state:type_name -> bytebase.v1.State
-	19, // 11: bytebase.v1.CheckReleaseResponse.CheckResult.advices:type_name -> bytebase.v1.Advice
+	15, // 7: bytebase.v1.Release.files:type_name -> bytebase.v1.Release.File
+	16, // 8: bytebase.v1.Release.vcs_source:type_name -> bytebase.v1.Release.VCSSource
+	18, // 9: bytebase.v1.Release.create_time:type_name -> google.protobuf.Timestamp
+	19, // 10: bytebase.v1.Release.state:type_name -> bytebase.v1.State
+	20, // 11: bytebase.v1.CheckReleaseResponse.CheckResult.advices:type_name -> bytebase.v1.Advice
 	0,  // 12: bytebase.v1.CheckReleaseResponse.CheckResult.risk_level:type_name -> bytebase.v1.CheckReleaseResponse.RiskLevel
 	1,  // 13: bytebase.v1.Release.File.type:type_name -> bytebase.v1.Release.File.Type
 	2,  // 14: bytebase.v1.Release.File.change_type:type_name -> bytebase.v1.Release.File.ChangeType
-	20, // 15: bytebase.v1.Release.VCSSource.vcs_type:type_name -> bytebase.v1.VCSType
-	3,  // 16: bytebase.v1.ReleaseService.GetRelease:input_type -> bytebase.v1.GetReleaseRequest
-	4,  // 17: bytebase.v1.ReleaseService.ListReleases:input_type -> bytebase.v1.ListReleasesRequest
-	6,  // 18: bytebase.v1.ReleaseService.CreateRelease:input_type -> bytebase.v1.CreateReleaseRequest
-	7,  // 19: bytebase.v1.ReleaseService.UpdateRelease:input_type -> bytebase.v1.UpdateReleaseRequest
-	8,  // 20: bytebase.v1.ReleaseService.DeleteRelease:input_type -> bytebase.v1.DeleteReleaseRequest
-	9,  // 21: bytebase.v1.ReleaseService.UndeleteRelease:input_type -> bytebase.v1.UndeleteReleaseRequest
-	10, // 22: bytebase.v1.ReleaseService.CheckRelease:input_type -> bytebase.v1.CheckReleaseRequest
-	12, // 23: bytebase.v1.ReleaseService.GetRelease:output_type -> bytebase.v1.Release
-	5,  // 24: bytebase.v1.ReleaseService.ListReleases:output_type -> bytebase.v1.ListReleasesResponse
-	12, // 25: bytebase.v1.ReleaseService.CreateRelease:output_type -> bytebase.v1.Release
-	12, // 26: bytebase.v1.ReleaseService.UpdateRelease:output_type -> bytebase.v1.Release
-	21, // 27: bytebase.v1.ReleaseService.DeleteRelease:output_type -> google.protobuf.Empty
-	12, // 28: bytebase.v1.ReleaseService.UndeleteRelease:output_type -> bytebase.v1.Release
-	11, // 29: bytebase.v1.ReleaseService.CheckRelease:output_type -> bytebase.v1.CheckReleaseResponse
-	23, // [23:30] is the sub-list for method output_type
-	16, // [16:23] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	3,  // 15: bytebase.v1.Release.File.format:type_name -> bytebase.v1.Release.File.Format
+	21, // 16: bytebase.v1.Release.VCSSource.vcs_type:type_name -> bytebase.v1.VCSType
+	4,  // 17: bytebase.v1.ReleaseService.GetRelease:input_type -> bytebase.v1.GetReleaseRequest
+	5,  // 18: bytebase.v1.ReleaseService.ListReleases:input_type -> bytebase.v1.ListReleasesRequest
+	7,  // 19: bytebase.v1.ReleaseService.CreateRelease:input_type -> bytebase.v1.CreateReleaseRequest
+	8,  // 20: bytebase.v1.ReleaseService.UpdateRelease:input_type -> bytebase.v1.UpdateReleaseRequest
+	9,  // 21: bytebase.v1.ReleaseService.DeleteRelease:input_type -> bytebase.v1.DeleteReleaseRequest
+	10, // 22: bytebase.v1.ReleaseService.UndeleteRelease:input_type -> bytebase.v1.UndeleteReleaseRequest
+	11, // 23: bytebase.v1.ReleaseService.CheckRelease:input_type -> bytebase.v1.CheckReleaseRequest
+	13, // 24: bytebase.v1.ReleaseService.GetRelease:output_type -> bytebase.v1.Release
+	6,  // 25: bytebase.v1.ReleaseService.ListReleases:output_type -> bytebase.v1.ListReleasesResponse
+	13, // 26: bytebase.v1.ReleaseService.CreateRelease:output_type -> bytebase.v1.Release
+	13, // 27: bytebase.v1.ReleaseService.UpdateRelease:output_type -> bytebase.v1.Release
+	22, // 28: bytebase.v1.ReleaseService.DeleteRelease:output_type -> google.protobuf.Empty
+	13, // 29: bytebase.v1.ReleaseService.UndeleteRelease:output_type -> bytebase.v1.Release
+	12, // 30: bytebase.v1.ReleaseService.CheckRelease:output_type -> bytebase.v1.CheckReleaseResponse
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_v1_release_service_proto_init() }
@@ -1221,7 +1292,7 @@ func file_v1_release_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_release_service_proto_rawDesc), len(file_v1_release_service_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
@@ -1012,8 +1012,11 @@ type WorkspaceProfileSetting struct {
 	DatabaseChangeMode DatabaseChangeMode `protobuf:"varint,11,opt,name=database_change_mode,json=databaseChangeMode,proto3,enum=bytebase.v1.DatabaseChangeMode" json:"database_change_mode,omitempty"`
 	// Whether to disallow password signin. (Except workspace admins)
 	DisallowPasswordSignin bool `protobuf:"varint,12,opt,name=disallow_password_signin,json=disallowPasswordSignin,proto3" json:"disallow_password_signin,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Require WebAuthn as the second factor for users having any of the workspace permissions, e.g. bb.databases.update.
+	// TOTP and recovery codes are not accepted for these users.
+	WebauthnRequiredPermissions []string `protobuf:"bytes,13,rep,name=webauthn_required_permissions,json=webauthnRequiredPermissions,proto3" json:"webauthn_required_permissions,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *WorkspaceProfileSetting) Reset() {
@@ -1116,6 +1119,13 @@ func (x *WorkspaceProfileSetting) GetDisallowPasswordSignin() bool {
 	return false
 }
 
+func (x *WorkspaceProfileSetting) GetWebauthnRequiredPermissions() []string {
+	if x != nil {
+		return x.WebauthnRequiredPermissions
+	}
+	return nil
+}
+
 type Announcement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The alert level of announcemnt
@@ -1379,6 +1389,10 @@ type Algorithm struct {
 	//	*Algorithm_RangeMask_
 	//	*Algorithm_Md5Mask
 	//	*Algorithm_InnerOuterMask_
+	//	*Algorithm_FormatPreservingMask_
+	//	*Algorithm_HmacMask_
+	//	*Algorithm_DateShiftMask_
+	//	*Algorithm_NumericBucketMask_
 	Mask          isAlgorithm_Mask `protobuf_oneof:"mask"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1457,6 +1471,42 @@ func (x *Algorithm) GetInnerOuterMask() *Algorithm_InnerOuterMask {
 	return nil
 }
 
+func (x *Algorithm) GetFormatPreservingMask() *Algorithm_FormatPreservingMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_FormatPreservingMask_); ok {
+			return x.FormatPreservingMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetHmacMask() *Algorithm_HmacMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_HmacMask_); ok {
+			return x.HmacMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDateShiftMask() *Algorithm_DateShiftMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_DateShiftMask_); ok {
+			return x.DateShiftMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericBucketMask() *Algorithm_NumericBucketMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericBucketMask_); ok {
+			return x.NumericBucketMask
+		}
+	}
+	return nil
+}
+
 type isAlgorithm_Mask interface {
 	isAlgorithm_Mask()
 }
@@ -1477,6 +1527,22 @@ type Algorithm_InnerOuterMask_ struct {
 	InnerOuterMask *Algorithm_InnerOuterMask `protobuf:"bytes,8,opt,name=inner_outer_mask,json=innerOuterMask,proto3,oneof"`
 }
 
+type Algorithm_FormatPreservingMask_ struct {
+	FormatPreservingMask *Algorithm_FormatPreservingMask `protobuf:"bytes,9,opt,name=format_preserving_mask,json=formatPreservingMask,proto3,oneof"`
+}
+
+type Algorithm_HmacMask_ struct {
+	HmacMask *Algorithm_HmacMask `protobuf:"bytes,10,opt,name=hmac_mask,json=hmacMask,proto3,oneof"`
+}
+
+type Algorithm_DateShiftMask_ struct {
+	DateShiftMask *Algorithm_DateShiftMask `protobuf:"bytes,11,opt,name=date_shift_mask,json=dateShiftMask,proto3,oneof"`
+}
+
+type Algorithm_NumericBucketMask_ struct {
+	NumericBucketMask *Algorithm_NumericBucketMask `protobuf:"bytes,12,opt,name=numeric_bucket_mask,json=numericBucketMask,proto3,oneof"`
+}
+
 func (*Algorithm_FullMask_) isAlgorithm_Mask() {}
 
 func (*Algorithm_RangeMask_) isAlgorithm_Mask() {}
@@ -1485,6 +1551,14 @@ func (*Algorithm_Md5Mask) isAlgorithm_Mask() {}
 
 func (*Algorithm_InnerOuterMask_) isAlgorithm_Mask() {}
 
+func (*Algorithm_FormatPreservingMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_HmacMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_DateShiftMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericBucketMask_) isAlgorithm_Mask() {}
+
 type SQLQueryRestrictionSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The size limit in bytes.
@@ -2891,6 +2965,249 @@ func (x *Algorithm_InnerOuterMask) GetSubstitution() string {
 	return ""
 }
 
+// FormatPreservingMask replaces the digits and letters with the keyed format-preserving encryption (FF1),
+// the other characters are kept, so the same value is always masked to the same value in the same format.
+type Algorithm_FormatPreservingMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the secret key of the encryption. It's write-only: the stored key is kept if it's empty, and the key derived from the workspace secret is used if it's never set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// tweak is mixed into the encryption, the same value is masked differently with different tweaks.
+	Tweak         string `protobuf:"bytes,2,opt,name=tweak,proto3" json:"tweak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_FormatPreservingMask) Reset() {
+	*x = Algorithm_FormatPreservingMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_FormatPreservingMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_FormatPreservingMask) ProtoMessage() {}
+
+func (x *Algorithm_FormatPreservingMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_FormatPreservingMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_FormatPreservingMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 4}
+}
+
+func (x *Algorithm_FormatPreservingMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_FormatPreservingMask) GetTweak() string {
+	if x != nil {
+		return x.Tweak
+	}
+	return ""
+}
+
+// HmacMask replaces the value with the token generated by the keyed HMAC-SHA256,
+// so the same value is always masked to the same token.
+type Algorithm_HmacMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the secret key of the HMAC. It's write-only: the stored key is kept if it's empty, and the key derived from the workspace secret is used if it's never set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// length is the number of the hex characters kept in the token, the whole digest is kept if it's 0.
+	Length int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	// prefix is prepended to the token, e.g. "tok_".
+	Prefix        string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_HmacMask) Reset() {
+	*x = Algorithm_HmacMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_HmacMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_HmacMask) ProtoMessage() {}
+
+func (x *Algorithm_HmacMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_HmacMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_HmacMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 5}
+}
+
+func (x *Algorithm_HmacMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_HmacMask) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Algorithm_HmacMask) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// DateShiftMask shifts the date and timestamp values by a random but consistent number of days per subject,
+// so the intervals between the values of the same subject are preserved.
+type Algorithm_DateShiftMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the secret key deriving the shift of each subject. It's write-only: the stored key is kept if it's empty, and the key derived from the workspace secret is used if it's never set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// max_shift_days is the maximum number of days shifted in either direction.
+	MaxShiftDays int32 `protobuf:"varint,2,opt,name=max_shift_days,json=maxShiftDays,proto3" json:"max_shift_days,omitempty"`
+	// subject_column is the name of the result column identifying the subject, e.g. "patient_id".
+	// All values are shifted by the same number of days if it's empty or not in the result.
+	SubjectColumn string `protobuf:"bytes,3,opt,name=subject_column,json=subjectColumn,proto3" json:"subject_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_DateShiftMask) Reset() {
+	*x = Algorithm_DateShiftMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_DateShiftMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_DateShiftMask) ProtoMessage() {}
+
+func (x *Algorithm_DateShiftMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_DateShiftMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_DateShiftMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 6}
+}
+
+func (x *Algorithm_DateShiftMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_DateShiftMask) GetMaxShiftDays() int32 {
+	if x != nil {
+		return x.MaxShiftDays
+	}
+	return 0
+}
+
+func (x *Algorithm_DateShiftMask) GetSubjectColumn() string {
+	if x != nil {
+		return x.SubjectColumn
+	}
+	return ""
+}
+
+// NumericBucketMask generalizes the numeric values into the buckets, e.g. the salary ranges or the age bands.
+type Algorithm_NumericBucketMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// boundaries are the bucket boundaries in ascending order,
+	// e.g. [18, 30, 50] generalizes the values into "< 18", "[18, 30)", "[30, 50)" and ">= 50".
+	Boundaries []float64 `protobuf:"fixed64,1,rep,packed,name=boundaries,proto3" json:"boundaries,omitempty"`
+	// labels are the optional bucket labels, there must be one more label than the boundaries if it's set.
+	Labels        []string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_NumericBucketMask) Reset() {
+	*x = Algorithm_NumericBucketMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericBucketMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericBucketMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericBucketMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_NumericBucketMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericBucketMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 7}
+}
+
+func (x *Algorithm_NumericBucketMask) GetBoundaries() []float64 {
+	if x != nil {
+		return x.Boundaries
+	}
+	return nil
+}
+
+func (x *Algorithm_NumericBucketMask) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type Algorithm_RangeMask_Slice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// start is the start index of the original value, start from 0 and should be less than stop.
@@ -2905,7 +3222,7 @@ type Algorithm_RangeMask_Slice struct {
 
 func (x *Algorithm_RangeMask_Slice) Reset() {
 	*x = Algorithm_RangeMask_Slice{}
-	mi := &file_v1_setting_service_proto_msgTypes[38]
+	mi := &file_v1_setting_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2917,7 +3234,7 @@ func (x *Algorithm_RangeMask_Slice) String() string {
 func (*Algorithm_RangeMask_Slice) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[38]
+	mi := &file_v1_setting_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,7 +3291,7 @@ type EnvironmentSetting_Environment struct {
 
 func (x *EnvironmentSetting_Environment) Reset() {
 	*x = EnvironmentSetting_Environment{}
-	mi := &file_v1_setting_service_proto_msgTypes[39]
+	mi := &file_v1_setting_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2986,7 +3303,7 @@ func (x *EnvironmentSetting_Environment) String() string {
 func (*EnvironmentSetting_Environment) ProtoMessage() {}
 
 func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[39]
+	mi := &file_v1_setting_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3130,7 +3447,7 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\tclient_id\x18\x02 \x01(\tB\x03\xe0A\x04R\bclientId\x12(\n" +
 	"\rclient_secret\x18\x03 \x01(\tB\x03\xe0A\x04R\fclientSecret\x12\"\n" +
 	"\n" +
-	"robot_code\x18\x04 \x01(\tB\x03\xe0A\x04R\trobotCode\"\xb9\x04\n" +
+	"robot_code\x18\x04 \x01(\tB\x03\xe0A\x04R\trobotCode\"\xfd\x04\n" +
 	"\x17WorkspaceProfileSetting\x12!\n" +
 	"\fexternal_url\x18\x01 \x01(\tR\vexternalUrl\x12'\n" +
 	"\x0fdisallow_signup\x18\x02 \x01(\bR\x0edisallowSignup\x12\x1f\n" +
@@ -3143,7 +3460,8 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x17enforce_identity_domain\x18\n" +
 	" \x01(\bR\x15enforceIdentityDomain\x12Q\n" +
 	"\x14database_change_mode\x18\v \x01(\x0e2\x1f.bytebase.v1.DatabaseChangeModeR\x12databaseChangeMode\x128\n" +
-	"\x18disallow_password_signin\x18\f \x01(\bR\x16disallowPasswordSignin\"\xc2\x01\n" +
+	"\x18disallow_password_signin\x18\f \x01(\bR\x16disallowPasswordSignin\x12B\n" +
+	"\x1dwebauthn_required_permissions\x18\r \x03(\tR\x1bwebauthnRequiredPermissions\"\xc2\x01\n" +
 	"\fAnnouncement\x12:\n" +
 	"\x05level\x18\x01 \x01(\x0e2$.bytebase.v1.Announcement.AlertLevelR\x05level\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
@@ -3208,13 +3526,18 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
 	"\talgorithm\x18\x06 \x01(\v2\x16.bytebase.v1.AlgorithmR\talgorithm\x12\x12\n" +
-	"\x04icon\x18\a \x01(\tR\x04icon\"\x8e\x06\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\"\xaa\v\n" +
 	"\tAlgorithm\x12>\n" +
 	"\tfull_mask\x18\x05 \x01(\v2\x1f.bytebase.v1.Algorithm.FullMaskH\x00R\bfullMask\x12A\n" +
 	"\n" +
 	"range_mask\x18\x06 \x01(\v2 .bytebase.v1.Algorithm.RangeMaskH\x00R\trangeMask\x12;\n" +
 	"\bmd5_mask\x18\a \x01(\v2\x1e.bytebase.v1.Algorithm.MD5MaskH\x00R\amd5Mask\x12Q\n" +
-	"\x10inner_outer_mask\x18\b \x01(\v2%.bytebase.v1.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x1a.\n" +
+	"\x10inner_outer_mask\x18\b \x01(\v2%.bytebase.v1.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x12c\n" +
+	"\x16format_preserving_mask\x18\t \x01(\v2+.bytebase.v1.Algorithm.FormatPreservingMaskH\x00R\x14formatPreservingMask\x12>\n" +
+	"\thmac_mask\x18\n" +
+	" \x01(\v2\x1f.bytebase.v1.Algorithm.HmacMaskH\x00R\bhmacMask\x12N\n" +
+	"\x0fdate_shift_mask\x18\v \x01(\v2$.bytebase.v1.Algorithm.DateShiftMaskH\x00R\rdateShiftMask\x12Z\n" +
+	"\x13numeric_bucket_mask\x18\f \x01(\v2(.bytebase.v1.Algorithm.NumericBucketMaskH\x00R\x11numericBucketMask\x1a.\n" +
 	"\bFullMask\x12\"\n" +
 	"\fsubstitution\x18\x01 \x01(\tR\fsubstitution\x1a\xa0\x01\n" +
 	"\tRangeMask\x12>\n" +
@@ -3235,7 +3558,23 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\bMaskType\x12\x19\n" +
 	"\x15MASK_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05INNER\x10\x01\x12\t\n" +
-	"\x05OUTER\x10\x02B\x06\n" +
+	"\x05OUTER\x10\x02\x1a>\n" +
+	"\x14FormatPreservingMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05tweak\x18\x02 \x01(\tR\x05tweak\x1aL\n" +
+	"\bHmacMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x1an\n" +
+	"\rDateShiftMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x0emax_shift_days\x18\x02 \x01(\x05R\fmaxShiftDays\x12%\n" +
+	"\x0esubject_column\x18\x03 \x01(\tR\rsubjectColumn\x1aK\n" +
+	"\x11NumericBucketMask\x12\x1e\n" +
+	"\n" +
+	"boundaries\x18\x01 \x03(\x01R\n" +
+	"boundaries\x12\x16\n" +
+	"\x06labels\x18\x02 \x03(\tR\x06labelsB\x06\n" +
 	"\x04mask\"|\n" +
 	"\x1aSQLQueryRestrictionSetting\x12.\n" +
 	"\x13maximum_result_size\x18\x01 \x01(\x03R\x11maximumResultSize\x12.\n" +
@@ -3301,7 +3640,7 @@ func file_v1_setting_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_v1_setting_service_proto_goTypes = []any{
 	(DatabaseChangeMode)(0),                                          // 0: bytebase.v1.DatabaseChangeMode
 	(Setting_SettingName)(0),                                         // 1: bytebase.v1.Setting.SettingName
@@ -3346,24 +3685,28 @@ var file_v1_setting_service_proto_goTypes = []any{
 	(*Algorithm_RangeMask)(nil),              // 40: bytebase.v1.Algorithm.RangeMask
 	(*Algorithm_MD5Mask)(nil),                // 41: bytebase.v1.Algorithm.MD5Mask
 	(*Algorithm_InnerOuterMask)(nil),         // 42: bytebase.v1.Algorithm.InnerOuterMask
-	(*Algorithm_RangeMask_Slice)(nil),        // 43: bytebase.v1.Algorithm.RangeMask.Slice
-	(*EnvironmentSetting_Environment)(nil),   // 44: bytebase.v1.EnvironmentSetting.Environment
-	nil,                                      // 45: bytebase.v1.EnvironmentSetting.Environment.TagsEntry
-	(*fieldmaskpb.FieldMask)(nil),            // 46: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),              // 47: google.protobuf.Duration
-	(*ApprovalTemplate)(nil),                 // 48: bytebase.v1.ApprovalTemplate
-	(*expr.Expr)(nil),                        // 49: google.type.Expr
-	(Engine)(0),                              // 50: bytebase.v1.Engine
-	(*ColumnMetadata)(nil),                   // 51: bytebase.v1.ColumnMetadata
-	(*ColumnCatalog)(nil),                    // 52: bytebase.v1.ColumnCatalog
-	(*TableMetadata)(nil),                    // 53: bytebase.v1.TableMetadata
-	(*TableCatalog)(nil),                     // 54: bytebase.v1.TableCatalog
+	(*Algorithm_FormatPreservingMask)(nil),   // 43: bytebase.v1.Algorithm.FormatPreservingMask
+	(*Algorithm_HmacMask)(nil),               // 44: bytebase.v1.Algorithm.HmacMask
+	(*Algorithm_DateShiftMask)(nil),          // 45: bytebase.v1.Algorithm.DateShiftMask
+	(*Algorithm_NumericBucketMask)(nil),      // 46: bytebase.v1.Algorithm.NumericBucketMask
+	(*Algorithm_RangeMask_Slice)(nil),        // 47: bytebase.v1.Algorithm.RangeMask.Slice
+	(*EnvironmentSetting_Environment)(nil),   // 48: bytebase.v1.EnvironmentSetting.Environment
+	nil,                                      // 49: bytebase.v1.EnvironmentSetting.Environment.TagsEntry
+	(*fieldmaskpb.FieldMask)(nil),            // 50: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),              // 51: google.protobuf.Duration
+	(*ApprovalTemplate)(nil),                 // 52: bytebase.v1.ApprovalTemplate
+	(*expr.Expr)(nil),                        // 53: google.type.Expr
+	(Engine)(0),                              // 54: bytebase.v1.Engine
+	(*ColumnMetadata)(nil),                   // 55: bytebase.v1.ColumnMetadata
+	(*ColumnCatalog)(nil),                    // 56: bytebase.v1.ColumnCatalog
+	(*TableMetadata)(nil),                    // 57: bytebase.v1.TableMetadata
+	(*TableCatalog)(nil),                     // 58: bytebase.v1.TableCatalog
 }
 var file_v1_setting_service_proto_depIdxs = []int32{
 	10, // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
 	10, // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
 	10, // 2: bytebase.v1.UpdateSettingRequest.setting:type_name -> bytebase.v1.Setting
-	46, // 3: bytebase.v1.UpdateSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	50, // 3: bytebase.v1.UpdateSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
 	11, // 4: bytebase.v1.Setting.value:type_name -> bytebase.v1.Value
 	12, // 5: bytebase.v1.Value.app_im_setting_value:type_name -> bytebase.v1.AppIMSetting
 	13, // 6: bytebase.v1.Value.workspace_profile_setting_value:type_name -> bytebase.v1.WorkspaceProfileSetting
@@ -3381,9 +3724,9 @@ var file_v1_setting_service_proto_depIdxs = []int32{
 	27, // 18: bytebase.v1.AppIMSetting.wecom:type_name -> bytebase.v1.AppIMSetting.Wecom
 	28, // 19: bytebase.v1.AppIMSetting.lark:type_name -> bytebase.v1.AppIMSetting.Lark
 	29, // 20: bytebase.v1.AppIMSetting.dingtalk:type_name -> bytebase.v1.AppIMSetting.DingTalk
-	47, // 21: bytebase.v1.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
+	51, // 21: bytebase.v1.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
 	14, // 22: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
-	47, // 23: bytebase.v1.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
+	51, // 23: bytebase.v1.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
 	0,  // 24: bytebase.v1.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.v1.DatabaseChangeMode
 	2,  // 25: bytebase.v1.Announcement.level:type_name -> bytebase.v1.Announcement.AlertLevel
 	30, // 26: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
@@ -3396,36 +3739,40 @@ var file_v1_setting_service_proto_depIdxs = []int32{
 	40, // 33: bytebase.v1.Algorithm.range_mask:type_name -> bytebase.v1.Algorithm.RangeMask
 	41, // 34: bytebase.v1.Algorithm.md5_mask:type_name -> bytebase.v1.Algorithm.MD5Mask
 	42, // 35: bytebase.v1.Algorithm.inner_outer_mask:type_name -> bytebase.v1.Algorithm.InnerOuterMask
-	47, // 36: bytebase.v1.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
-	4,  // 37: bytebase.v1.AISetting.provider:type_name -> bytebase.v1.AISetting.Provider
-	44, // 38: bytebase.v1.EnvironmentSetting.environments:type_name -> bytebase.v1.EnvironmentSetting.Environment
-	48, // 39: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
-	49, // 40: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	50, // 41: bytebase.v1.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.v1.Engine
-	51, // 42: bytebase.v1.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.v1.ColumnMetadata
-	52, // 43: bytebase.v1.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.v1.ColumnCatalog
-	50, // 44: bytebase.v1.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.v1.Engine
-	50, // 45: bytebase.v1.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.v1.Engine
-	53, // 46: bytebase.v1.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.v1.TableMetadata
-	54, // 47: bytebase.v1.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.v1.TableCatalog
-	35, // 48: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	37, // 49: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	36, // 50: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	19, // 51: bytebase.v1.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.v1.Algorithm
-	43, // 52: bytebase.v1.Algorithm.RangeMask.slices:type_name -> bytebase.v1.Algorithm.RangeMask.Slice
-	3,  // 53: bytebase.v1.Algorithm.InnerOuterMask.type:type_name -> bytebase.v1.Algorithm.InnerOuterMask.MaskType
-	45, // 54: bytebase.v1.EnvironmentSetting.Environment.tags:type_name -> bytebase.v1.EnvironmentSetting.Environment.TagsEntry
-	5,  // 55: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
-	7,  // 56: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
-	9,  // 57: bytebase.v1.SettingService.UpdateSetting:input_type -> bytebase.v1.UpdateSettingRequest
-	6,  // 58: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
-	10, // 59: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
-	10, // 60: bytebase.v1.SettingService.UpdateSetting:output_type -> bytebase.v1.Setting
-	58, // [58:61] is the sub-list for method output_type
-	55, // [55:58] is the sub-list for method input_type
-	55, // [55:55] is the sub-list for extension type_name
-	55, // [55:55] is the sub-list for extension extendee
-	0,  // [0:55] is the sub-list for field type_name
+	43, // 36: bytebase.v1.Algorithm.format_preserving_mask:type_name -> bytebase.v1.Algorithm.FormatPreservingMask
+	44, // 37: bytebase.v1.Algorithm.hmac_mask:type_name -> bytebase.v1.Algorithm.HmacMask
+	45, // 38: bytebase.v1.Algorithm.date_shift_mask:type_name -> bytebase.v1.Algorithm.DateShiftMask
+	46, // 39: bytebase.v1.Algorithm.numeric_bucket_mask:type_name -> bytebase.v1.Algorithm.NumericBucketMask
+	51, // 40: bytebase.v1.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
+	4,  // 41: bytebase.v1.AISetting.provider:type_name -> bytebase.v1.AISetting.Provider
+	48, // 42: bytebase.v1.EnvironmentSetting.environments:type_name -> bytebase.v1.EnvironmentSetting.Environment
+	52, // 43: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
+	53, // 44: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	54, // 45: bytebase.v1.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.v1.Engine
+	55, // 46: bytebase.v1.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.v1.ColumnMetadata
+	56, // 47: bytebase.v1.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.v1.ColumnCatalog
+	54, // 48: bytebase.v1.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.v1.Engine
+	54, // 49: bytebase.v1.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.v1.Engine
+	57, // 50: bytebase.v1.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.v1.TableMetadata
+	58, // 51: bytebase.v1.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.v1.TableCatalog
+	35, // 52: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	37, // 53: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	36, // 54: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	19, // 55: bytebase.v1.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.v1.Algorithm
+	47, // 56: bytebase.v1.Algorithm.RangeMask.slices:type_name -> bytebase.v1.Algorithm.RangeMask.Slice
+	3,  // 57: bytebase.v1.Algorithm.InnerOuterMask.type:type_name -> bytebase.v1.Algorithm.InnerOuterMask.MaskType
+	49, // 58: bytebase.v1.EnvironmentSetting.Environment.tags:type_name -> bytebase.v1.EnvironmentSetting.Environment.TagsEntry
+	5,  // 59: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
+	7,  // 60: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
+	9,  // 61: bytebase.v1.SettingService.UpdateSetting:input_type -> bytebase.v1.UpdateSettingRequest
+	6,  // 62: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
+	10, // 63: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
+	10, // 64: bytebase.v1.SettingService.UpdateSetting:output_type -> bytebase.v1.Setting
+	62, // [62:65] is the sub-list for method output_type
+	59, // [59:62] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_v1_setting_service_proto_init() }
@@ -3457,6 +3804,10 @@ func file_v1_setting_service_proto_init() {
 		(*Algorithm_RangeMask_)(nil),
 		(*Algorithm_Md5Mask)(nil),
 		(*Algorithm_InnerOuterMask_)(nil),
+		(*Algorithm_FormatPreservingMask_)(nil),
+		(*Algorithm_HmacMask_)(nil),
+		(*Algorithm_DateShiftMask_)(nil),
+		(*Algorithm_NumericBucketMask_)(nil),
 	}
 	file_v1_setting_service_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
@@ -3465,7 +3816,7 @@ func file_v1_setting_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_setting_service_proto_rawDesc), len(file_v1_setting_service_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   41,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QueryPagesResponse_Pagination int32
+
+const (
+	QueryPagesResponse_PAGINATION_UNSPECIFIED QueryPagesResponse_Pagination = 0
+	// The pages are fetched by the keyset of the unique key of the source table,
+	// and the rows are ordered by the key.
+	QueryPagesResponse_KEYSET QueryPagesResponse_Pagination = 1
+	// The query is executed once, and the pages are served from the cached result.
+	QueryPagesResponse_CACHED QueryPagesResponse_Pagination = 2
+)
+
+// Enum value maps for QueryPagesResponse_Pagination.
+var (
+	QueryPagesResponse_Pagination_name = map[int32]string{
+		0: "PAGINATION_UNSPECIFIED",
+		1: "KEYSET",
+		2: "CACHED",
+	}
+	QueryPagesResponse_Pagination_value = map[string]int32{
+		"PAGINATION_UNSPECIFIED": 0,
+		"KEYSET":                 1,
+		"CACHED":                 2,
+	}
+)
+
+func (x QueryPagesResponse_Pagination) Enum() *QueryPagesResponse_Pagination {
+	p := new(QueryPagesResponse_Pagination)
+	*p = x
+	return p
+}
+
+func (x QueryPagesResponse_Pagination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryPagesResponse_Pagination) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_sql_service_proto_enumTypes[0].Descriptor()
+}
+
+func (QueryPagesResponse_Pagination) Type() protoreflect.EnumType {
+	return &file_v1_sql_service_proto_enumTypes[0]
+}
+
+func (x QueryPagesResponse_Pagination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryPagesResponse_Pagination.Descriptor instead.
+func (QueryPagesResponse_Pagination) EnumDescriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type QueryOption_RedisRunCommandsOn int32
 
 const (
@@ -59,11 +111,11 @@ func (x QueryOption_RedisRunCommandsOn) String() string {
 }
 
 func (QueryOption_RedisRunCommandsOn) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[0].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[1].Descriptor()
 }
 
 func (QueryOption_RedisRunCommandsOn) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[0]
+	return &file_v1_sql_service_proto_enumTypes[1]
 }
 
 func (x QueryOption_RedisRunCommandsOn) Number() protoreflect.EnumNumber {
@@ -72,7 +124,7 @@ func (x QueryOption_RedisRunCommandsOn) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryOption_RedisRunCommandsOn.Descriptor instead.
 func (QueryOption_RedisRunCommandsOn) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{4, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type QueryResult_Message_Level int32
@@ -121,11 +173,11 @@ func (x QueryResult_Message_Level) String() string {
 }
 
 func (QueryResult_Message_Level) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[1].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[2].Descriptor()
 }
 
 func (QueryResult_Message_Level) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[1]
+	return &file_v1_sql_service_proto_enumTypes[2]
 }
 
 func (x QueryResult_Message_Level) Number() protoreflect.EnumNumber {
@@ -134,7 +186,103 @@ func (x QueryResult_Message_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryResult_Message_Level.Descriptor instead.
 func (QueryResult_Message_Level) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 1, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{7, 1, 0}
+}
+
+type QueryPlan_Format int32
+
+const (
+	QueryPlan_FORMAT_UNSPECIFIED QueryPlan_Format = 0
+	QueryPlan_JSON               QueryPlan_Format = 1
+	QueryPlan_XML                QueryPlan_Format = 2
+)
+
+// Enum value maps for QueryPlan_Format.
+var (
+	QueryPlan_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "XML",
+	}
+	QueryPlan_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JSON":               1,
+		"XML":                2,
+	}
+)
+
+func (x QueryPlan_Format) Enum() *QueryPlan_Format {
+	p := new(QueryPlan_Format)
+	*p = x
+	return p
+}
+
+func (x QueryPlan_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryPlan_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_sql_service_proto_enumTypes[3].Descriptor()
+}
+
+func (QueryPlan_Format) Type() protoreflect.EnumType {
+	return &file_v1_sql_service_proto_enumTypes[3]
+}
+
+func (x QueryPlan_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryPlan_Format.Descriptor instead.
+func (QueryPlan_Format) EnumDescriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type QueryPlanWarning_Type int32
+
+const (
+	QueryPlanWarning_TYPE_UNSPECIFIED QueryPlanWarning_Type = 0
+	// The plan scans the whole large table.
+	QueryPlanWarning_FULL_TABLE_SCAN QueryPlanWarning_Type = 1
+)
+
+// Enum value maps for QueryPlanWarning_Type.
+var (
+	QueryPlanWarning_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "FULL_TABLE_SCAN",
+	}
+	QueryPlanWarning_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"FULL_TABLE_SCAN":  1,
+	}
+)
+
+func (x QueryPlanWarning_Type) Enum() *QueryPlanWarning_Type {
+	p := new(QueryPlanWarning_Type)
+	*p = x
+	return p
+}
+
+func (x QueryPlanWarning_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryPlanWarning_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_sql_service_proto_enumTypes[4].Descriptor()
+}
+
+func (QueryPlanWarning_Type) Type() protoreflect.EnumType {
+	return &file_v1_sql_service_proto_enumTypes[4]
+}
+
+func (x QueryPlanWarning_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryPlanWarning_Type.Descriptor instead.
+func (QueryPlanWarning_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type Advice_Status int32
@@ -174,11 +322,11 @@ func (x Advice_Status) String() string {
 }
 
 func (Advice_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[2].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[5].Descriptor()
 }
 
 func (Advice_Status) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[2]
+	return &file_v1_sql_service_proto_enumTypes[5]
 }
 
 func (x Advice_Status) Number() protoreflect.EnumNumber {
@@ -187,7 +335,7 @@ func (x Advice_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Advice_Status.Descriptor instead.
 func (Advice_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{9, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type CheckRequest_ChangeType int32
@@ -229,11 +377,11 @@ func (x CheckRequest_ChangeType) String() string {
 }
 
 func (CheckRequest_ChangeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[3].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[6].Descriptor()
 }
 
 func (CheckRequest_ChangeType) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[3]
+	return &file_v1_sql_service_proto_enumTypes[6]
 }
 
 func (x CheckRequest_ChangeType) Number() protoreflect.EnumNumber {
@@ -242,7 +390,7 @@ func (x CheckRequest_ChangeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckRequest_ChangeType.Descriptor instead.
 func (CheckRequest_ChangeType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type QueryHistory_Type int32
@@ -278,11 +426,11 @@ func (x QueryHistory_Type) String() string {
 }
 
 func (QueryHistory_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[4].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[7].Descriptor()
 }
 
 func (QueryHistory_Type) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[4]
+	return &file_v1_sql_service_proto_enumTypes[7]
 }
 
 func (x QueryHistory_Type) Number() protoreflect.EnumNumber {
@@ -291,7 +439,7 @@ func (x QueryHistory_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryHistory_Type.Descriptor instead.
 func (QueryHistory_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{22, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type AdminExecuteRequest struct {
@@ -581,6 +729,175 @@ func (x *QueryResponse) GetResults() []*QueryResult {
 	return nil
 }
 
+type QueryPagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name is the database name to execute the query against.
+	// Format: instances/{instance}/databases/{databaseName}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The SQL statement to execute, it must be a single SELECT statement.
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	// The id of data source.
+	DataSourceId string `protobuf:"bytes,3,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// The default schema to search objects. Equals to the current schema in
+	// Oracle and search path in Postgres.
+	Schema *string `protobuf:"bytes,4,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+	// The maximum number of rows in a page.
+	// The default value is 1000, and the maximum value is 10000.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The maximum number of pages to stream.
+	// The stream continues until the result is exhausted if it's not positive.
+	PageCount int32 `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	// A page token, received from a previous `QueryPages` response.
+	// Provide this to resume the result session from the next page.
+	// The statement, data source and schema must match the request creating the session.
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPagesRequest) Reset() {
+	*x = QueryPagesRequest{}
+	mi := &file_v1_sql_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPagesRequest) ProtoMessage() {}
+
+func (x *QueryPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPagesRequest.ProtoReflect.Descriptor instead.
+func (*QueryPagesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryPagesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueryPagesRequest) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *QueryPagesRequest) GetDataSourceId() string {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return ""
+}
+
+func (x *QueryPagesRequest) GetSchema() string {
+	if x != nil && x.Schema != nil {
+		return *x.Schema
+	}
+	return ""
+}
+
+func (x *QueryPagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *QueryPagesRequest) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *QueryPagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type QueryPagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The page of the query result, the rows are masked.
+	Result *QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// A token to resume the result session from the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The pagination of the result session.
+	Pagination    QueryPagesResponse_Pagination `protobuf:"varint,3,opt,name=pagination,proto3,enum=bytebase.v1.QueryPagesResponse_Pagination" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPagesResponse) Reset() {
+	*x = QueryPagesResponse{}
+	mi := &file_v1_sql_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPagesResponse) ProtoMessage() {}
+
+func (x *QueryPagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPagesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPagesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryPagesResponse) GetResult() *QueryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *QueryPagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *QueryPagesResponse) GetPagination() QueryPagesResponse_Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return QueryPagesResponse_PAGINATION_UNSPECIFIED
+}
+
 type QueryOption struct {
 	state              protoimpl.MessageState         `protogen:"open.v1"`
 	RedisRunCommandsOn QueryOption_RedisRunCommandsOn `protobuf:"varint,1,opt,name=redis_run_commands_on,json=redisRunCommandsOn,proto3,enum=bytebase.v1.QueryOption_RedisRunCommandsOn" json:"redis_run_commands_on,omitempty"`
@@ -590,7 +907,7 @@ type QueryOption struct {
 
 func (x *QueryOption) Reset() {
 	*x = QueryOption{}
-	mi := &file_v1_sql_service_proto_msgTypes[4]
+	mi := &file_v1_sql_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -602,7 +919,7 @@ func (x *QueryOption) String() string {
 func (*QueryOption) ProtoMessage() {}
 
 func (x *QueryOption) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[4]
+	mi := &file_v1_sql_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +932,7 @@ func (x *QueryOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryOption.ProtoReflect.Descriptor instead.
 func (*QueryOption) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{4}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryOption) GetRedisRunCommandsOn() QueryOption_RedisRunCommandsOn {
@@ -651,14 +968,17 @@ type QueryResult struct {
 	// Examples include PostgreSQL's RAISE NOTICE, MSSQL's PRINT, or Oracle's DBMS_OUTPUT.PUT_LINE.
 	Messages []*QueryResult_Message `protobuf:"bytes,12,rep,name=messages,proto3" json:"messages,omitempty"`
 	// Masking reasons for each column (empty for non-masked columns).
-	Masked        []*MaskingReason `protobuf:"bytes,4,rep,name=masked,proto3" json:"masked,omitempty"`
+	Masked []*MaskingReason `protobuf:"bytes,4,rep,name=masked,proto3" json:"masked,omitempty"`
+	// The normalized query plan of the explain query.
+	// It's only set for the engines supporting the structured plan, i.e. MySQL, PostgreSQL and SQL Server.
+	Plan          *QueryPlan `protobuf:"bytes,13,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueryResult) Reset() {
 	*x = QueryResult{}
-	mi := &file_v1_sql_service_proto_msgTypes[5]
+	mi := &file_v1_sql_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -670,7 +990,7 @@ func (x *QueryResult) String() string {
 func (*QueryResult) ProtoMessage() {}
 
 func (x *QueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[5]
+	mi := &file_v1_sql_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +1003,7 @@ func (x *QueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult.ProtoReflect.Descriptor instead.
 func (*QueryResult) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryResult) GetColumnNames() []string {
@@ -751,37 +1071,316 @@ func (x *QueryResult) GetPostgresError() *QueryResult_PostgresError {
 	return nil
 }
 
-func (x *QueryResult) GetAllowExport() bool {
+func (x *QueryResult) GetAllowExport() bool {
+	if x != nil {
+		return x.AllowExport
+	}
+	return false
+}
+
+func (x *QueryResult) GetMessages() []*QueryResult_Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *QueryResult) GetMasked() []*MaskingReason {
+	if x != nil {
+		return x.Masked
+	}
+	return nil
+}
+
+func (x *QueryResult) GetPlan() *QueryPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type isQueryResult_DetailedError interface {
+	isQueryResult_DetailedError()
+}
+
+type QueryResult_PostgresError_ struct {
+	PostgresError *QueryResult_PostgresError `protobuf:"bytes,9,opt,name=postgres_error,json=postgresError,proto3,oneof"`
+}
+
+func (*QueryResult_PostgresError_) isQueryResult_DetailedError() {}
+
+// QueryPlan is the execution plan normalized from the engine specific plan,
+// e.g. EXPLAIN (FORMAT JSON) of PostgreSQL, EXPLAIN FORMAT=JSON of MySQL and SHOWPLAN_XML of SQL Server.
+type QueryPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The root node of the plan tree.
+	Root *QueryPlanNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// The raw plan returned by the database.
+	Raw string `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	// The format of the raw plan.
+	Format QueryPlan_Format `protobuf:"varint,3,opt,name=format,proto3,enum=bytebase.v1.QueryPlan_Format" json:"format,omitempty"`
+	// The warnings of the plan, e.g. the full scans on large tables.
+	Warnings      []*QueryPlanWarning `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPlan) Reset() {
+	*x = QueryPlan{}
+	mi := &file_v1_sql_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlan) ProtoMessage() {}
+
+func (x *QueryPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlan.ProtoReflect.Descriptor instead.
+func (*QueryPlan) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPlan) GetRoot() *QueryPlanNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *QueryPlan) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *QueryPlan) GetFormat() QueryPlan_Format {
+	if x != nil {
+		return x.Format
+	}
+	return QueryPlan_FORMAT_UNSPECIFIED
+}
+
+func (x *QueryPlan) GetWarnings() []*QueryPlanWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type QueryPlanNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation of the node, e.g. "Seq Scan" and "Nested Loop" in PostgreSQL,
+	// "ALL" and "ref" in MySQL, "Clustered Index Scan" in SQL Server.
+	NodeType string `protobuf:"bytes,1,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	// The schema of the table referenced by the node.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table referenced by the node.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The index used by the node.
+	Index string `protobuf:"bytes,4,opt,name=index,proto3" json:"index,omitempty"`
+	// The estimated number of rows produced by the node.
+	EstimatedRows float64 `protobuf:"fixed64,5,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	// The actual number of rows produced by the node, only set for the analyzed plans.
+	ActualRows *float64 `protobuf:"fixed64,6,opt,name=actual_rows,json=actualRows,proto3,oneof" json:"actual_rows,omitempty"`
+	// The estimated total cost of the node, in the unit of the engine.
+	EstimatedCost float64 `protobuf:"fixed64,7,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	// The node scans the whole table.
+	FullScan bool `protobuf:"varint,8,opt,name=full_scan,json=fullScan,proto3" json:"full_scan,omitempty"`
+	// The child nodes.
+	Children      []*QueryPlanNode `protobuf:"bytes,9,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPlanNode) Reset() {
+	*x = QueryPlanNode{}
+	mi := &file_v1_sql_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlanNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanNode) ProtoMessage() {}
+
+func (x *QueryPlanNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanNode.ProtoReflect.Descriptor instead.
+func (*QueryPlanNode) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryPlanNode) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetEstimatedRows() float64 {
+	if x != nil {
+		return x.EstimatedRows
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetActualRows() float64 {
+	if x != nil && x.ActualRows != nil {
+		return *x.ActualRows
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetFullScan() bool {
+	if x != nil {
+		return x.FullScan
+	}
+	return false
+}
+
+func (x *QueryPlanNode) GetChildren() []*QueryPlanNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type QueryPlanWarning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  QueryPlanWarning_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.v1.QueryPlanWarning_Type" json:"type,omitempty"`
+	// The warning message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The schema of the table.
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table of the warning.
+	Table string `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The number of rows of the table from the synced metadata.
+	TableRows     int64 `protobuf:"varint,5,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPlanWarning) Reset() {
+	*x = QueryPlanWarning{}
+	mi := &file_v1_sql_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlanWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanWarning) ProtoMessage() {}
+
+func (x *QueryPlanWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanWarning.ProtoReflect.Descriptor instead.
+func (*QueryPlanWarning) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryPlanWarning) GetType() QueryPlanWarning_Type {
 	if x != nil {
-		return x.AllowExport
+		return x.Type
 	}
-	return false
+	return QueryPlanWarning_TYPE_UNSPECIFIED
 }
 
-func (x *QueryResult) GetMessages() []*QueryResult_Message {
+func (x *QueryPlanWarning) GetMessage() string {
 	if x != nil {
-		return x.Messages
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryResult) GetMasked() []*MaskingReason {
+func (x *QueryPlanWarning) GetSchema() string {
 	if x != nil {
-		return x.Masked
+		return x.Schema
 	}
-	return nil
+	return ""
 }
 
-type isQueryResult_DetailedError interface {
-	isQueryResult_DetailedError()
+func (x *QueryPlanWarning) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
 }
 
-type QueryResult_PostgresError_ struct {
-	PostgresError *QueryResult_PostgresError `protobuf:"bytes,9,opt,name=postgres_error,json=postgresError,proto3,oneof"`
+func (x *QueryPlanWarning) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
 }
 
-func (*QueryResult_PostgresError_) isQueryResult_DetailedError() {}
-
 type MaskingReason struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The semantic type that triggered masking (e.g., "SSN", "email", "phone").
@@ -804,7 +1403,7 @@ type MaskingReason struct {
 
 func (x *MaskingReason) Reset() {
 	*x = MaskingReason{}
-	mi := &file_v1_sql_service_proto_msgTypes[6]
+	mi := &file_v1_sql_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +1415,7 @@ func (x *MaskingReason) String() string {
 func (*MaskingReason) ProtoMessage() {}
 
 func (x *MaskingReason) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[6]
+	mi := &file_v1_sql_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1428,7 @@ func (x *MaskingReason) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaskingReason.ProtoReflect.Descriptor instead.
 func (*MaskingReason) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{6}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MaskingReason) GetSemanticTypeId() string {
@@ -891,7 +1490,7 @@ type QueryRow struct {
 
 func (x *QueryRow) Reset() {
 	*x = QueryRow{}
-	mi := &file_v1_sql_service_proto_msgTypes[7]
+	mi := &file_v1_sql_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +1502,7 @@ func (x *QueryRow) String() string {
 func (*QueryRow) ProtoMessage() {}
 
 func (x *QueryRow) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[7]
+	mi := &file_v1_sql_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +1515,7 @@ func (x *QueryRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRow.ProtoReflect.Descriptor instead.
 func (*QueryRow) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{7}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryRow) GetValues() []*RowValue {
@@ -950,7 +1549,7 @@ type RowValue struct {
 
 func (x *RowValue) Reset() {
 	*x = RowValue{}
-	mi := &file_v1_sql_service_proto_msgTypes[8]
+	mi := &file_v1_sql_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1561,7 @@ func (x *RowValue) String() string {
 func (*RowValue) ProtoMessage() {}
 
 func (x *RowValue) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[8]
+	mi := &file_v1_sql_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1574,7 @@ func (x *RowValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowValue.ProtoReflect.Descriptor instead.
 func (*RowValue) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{8}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RowValue) GetKind() isRowValue_Kind {
@@ -1205,13 +1804,15 @@ type Advice struct {
 	// TODO: use range instead
 	StartPosition *Position `protobuf:"bytes,8,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	EndPosition   *Position `protobuf:"bytes,9,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	// The machine-applicable fixes for the advice.
+	Fixes         []*Advice_Fix `protobuf:"bytes,10,rep,name=fixes,proto3" json:"fixes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Advice) Reset() {
 	*x = Advice{}
-	mi := &file_v1_sql_service_proto_msgTypes[9]
+	mi := &file_v1_sql_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1824,7 @@ func (x *Advice) String() string {
 func (*Advice) ProtoMessage() {}
 
 func (x *Advice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[9]
+	mi := &file_v1_sql_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1837,7 @@ func (x *Advice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Advice.ProtoReflect.Descriptor instead.
 func (*Advice) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{9}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Advice) GetStatus() Advice_Status {
@@ -1281,6 +1882,13 @@ func (x *Advice) GetEndPosition() *Position {
 	return nil
 }
 
+func (x *Advice) GetFixes() []*Advice_Fix {
+	if x != nil {
+		return x.Fixes
+	}
+	return nil
+}
+
 type ExportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name is the instance name to execute the query against.
@@ -1309,7 +1917,7 @@ type ExportRequest struct {
 
 func (x *ExportRequest) Reset() {
 	*x = ExportRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[10]
+	mi := &file_v1_sql_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1321,7 +1929,7 @@ func (x *ExportRequest) String() string {
 func (*ExportRequest) ProtoMessage() {}
 
 func (x *ExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[10]
+	mi := &file_v1_sql_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,7 +1942,7 @@ func (x *ExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
 func (*ExportRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{10}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExportRequest) GetName() string {
@@ -1388,7 +1996,8 @@ func (x *ExportRequest) GetDataSourceId() string {
 
 type ExportResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The export file content.
+	// A chunk of the export file content.
+	// The export file is the concatenation of the chunks in order.
 	Content       []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1396,7 +2005,7 @@ type ExportResponse struct {
 
 func (x *ExportResponse) Reset() {
 	*x = ExportResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[11]
+	mi := &file_v1_sql_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1408,7 +2017,7 @@ func (x *ExportResponse) String() string {
 func (*ExportResponse) ProtoMessage() {}
 
 func (x *ExportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[11]
+	mi := &file_v1_sql_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +2030,7 @@ func (x *ExportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportResponse.ProtoReflect.Descriptor instead.
 func (*ExportResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ExportResponse) GetContent() []byte {
@@ -1447,7 +2056,7 @@ type PrettyRequest struct {
 
 func (x *PrettyRequest) Reset() {
 	*x = PrettyRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[12]
+	mi := &file_v1_sql_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +2068,7 @@ func (x *PrettyRequest) String() string {
 func (*PrettyRequest) ProtoMessage() {}
 
 func (x *PrettyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[12]
+	mi := &file_v1_sql_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +2081,7 @@ func (x *PrettyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrettyRequest.ProtoReflect.Descriptor instead.
 func (*PrettyRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{12}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PrettyRequest) GetEngine() Engine {
@@ -1508,7 +2117,7 @@ type PrettyResponse struct {
 
 func (x *PrettyResponse) Reset() {
 	*x = PrettyResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[13]
+	mi := &file_v1_sql_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1520,7 +2129,7 @@ func (x *PrettyResponse) String() string {
 func (*PrettyResponse) ProtoMessage() {}
 
 func (x *PrettyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[13]
+	mi := &file_v1_sql_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +2142,7 @@ func (x *PrettyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrettyResponse.ProtoReflect.Descriptor instead.
 func (*PrettyResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{13}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PrettyResponse) GetCurrentSchema() string {
@@ -1563,7 +2172,7 @@ type CheckRequest struct {
 
 func (x *CheckRequest) Reset() {
 	*x = CheckRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[14]
+	mi := &file_v1_sql_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1575,7 +2184,7 @@ func (x *CheckRequest) String() string {
 func (*CheckRequest) ProtoMessage() {}
 
 func (x *CheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[14]
+	mi := &file_v1_sql_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1588,7 +2197,7 @@ func (x *CheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{14}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CheckRequest) GetName() string {
@@ -1623,7 +2232,7 @@ type CheckResponse struct {
 
 func (x *CheckResponse) Reset() {
 	*x = CheckResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[15]
+	mi := &file_v1_sql_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1635,7 +2244,7 @@ func (x *CheckResponse) String() string {
 func (*CheckResponse) ProtoMessage() {}
 
 func (x *CheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[15]
+	mi := &file_v1_sql_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1648,7 +2257,7 @@ func (x *CheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{15}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CheckResponse) GetAdvices() []*Advice {
@@ -1674,7 +2283,7 @@ type ParseMyBatisMapperRequest struct {
 
 func (x *ParseMyBatisMapperRequest) Reset() {
 	*x = ParseMyBatisMapperRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[16]
+	mi := &file_v1_sql_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1686,7 +2295,7 @@ func (x *ParseMyBatisMapperRequest) String() string {
 func (*ParseMyBatisMapperRequest) ProtoMessage() {}
 
 func (x *ParseMyBatisMapperRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[16]
+	mi := &file_v1_sql_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2308,7 @@ func (x *ParseMyBatisMapperRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseMyBatisMapperRequest.ProtoReflect.Descriptor instead.
 func (*ParseMyBatisMapperRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{16}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ParseMyBatisMapperRequest) GetContent() []byte {
@@ -1718,7 +2327,7 @@ type ParseMyBatisMapperResponse struct {
 
 func (x *ParseMyBatisMapperResponse) Reset() {
 	*x = ParseMyBatisMapperResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[17]
+	mi := &file_v1_sql_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1730,7 +2339,7 @@ func (x *ParseMyBatisMapperResponse) String() string {
 func (*ParseMyBatisMapperResponse) ProtoMessage() {}
 
 func (x *ParseMyBatisMapperResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[17]
+	mi := &file_v1_sql_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2352,7 @@ func (x *ParseMyBatisMapperResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseMyBatisMapperResponse.ProtoReflect.Descriptor instead.
 func (*ParseMyBatisMapperResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{17}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ParseMyBatisMapperResponse) GetStatements() []string {
@@ -1772,7 +2381,7 @@ type DiffMetadataRequest struct {
 
 func (x *DiffMetadataRequest) Reset() {
 	*x = DiffMetadataRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[18]
+	mi := &file_v1_sql_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1784,7 +2393,7 @@ func (x *DiffMetadataRequest) String() string {
 func (*DiffMetadataRequest) ProtoMessage() {}
 
 func (x *DiffMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[18]
+	mi := &file_v1_sql_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,7 +2406,7 @@ func (x *DiffMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffMetadataRequest.ProtoReflect.Descriptor instead.
 func (*DiffMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{18}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DiffMetadataRequest) GetSourceMetadata() *DatabaseMetadata {
@@ -1852,7 +2461,7 @@ type DiffMetadataResponse struct {
 
 func (x *DiffMetadataResponse) Reset() {
 	*x = DiffMetadataResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[19]
+	mi := &file_v1_sql_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1864,7 +2473,7 @@ func (x *DiffMetadataResponse) String() string {
 func (*DiffMetadataResponse) ProtoMessage() {}
 
 func (x *DiffMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[19]
+	mi := &file_v1_sql_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1877,7 +2486,7 @@ func (x *DiffMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffMetadataResponse.ProtoReflect.Descriptor instead.
 func (*DiffMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{19}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DiffMetadataResponse) GetDiff() string {
@@ -1922,7 +2531,7 @@ type SearchQueryHistoriesRequest struct {
 
 func (x *SearchQueryHistoriesRequest) Reset() {
 	*x = SearchQueryHistoriesRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[20]
+	mi := &file_v1_sql_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1934,7 +2543,7 @@ func (x *SearchQueryHistoriesRequest) String() string {
 func (*SearchQueryHistoriesRequest) ProtoMessage() {}
 
 func (x *SearchQueryHistoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[20]
+	mi := &file_v1_sql_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +2556,7 @@ func (x *SearchQueryHistoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchQueryHistoriesRequest.ProtoReflect.Descriptor instead.
 func (*SearchQueryHistoriesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{20}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SearchQueryHistoriesRequest) GetPageSize() int32 {
@@ -1985,7 +2594,7 @@ type SearchQueryHistoriesResponse struct {
 
 func (x *SearchQueryHistoriesResponse) Reset() {
 	*x = SearchQueryHistoriesResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[21]
+	mi := &file_v1_sql_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1997,7 +2606,7 @@ func (x *SearchQueryHistoriesResponse) String() string {
 func (*SearchQueryHistoriesResponse) ProtoMessage() {}
 
 func (x *SearchQueryHistoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[21]
+	mi := &file_v1_sql_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,7 +2619,7 @@ func (x *SearchQueryHistoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchQueryHistoriesResponse.ProtoReflect.Descriptor instead.
 func (*SearchQueryHistoriesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{21}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SearchQueryHistoriesResponse) GetQueryHistories() []*QueryHistory {
@@ -2047,7 +2656,7 @@ type QueryHistory struct {
 
 func (x *QueryHistory) Reset() {
 	*x = QueryHistory{}
-	mi := &file_v1_sql_service_proto_msgTypes[22]
+	mi := &file_v1_sql_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2059,7 +2668,7 @@ func (x *QueryHistory) String() string {
 func (*QueryHistory) ProtoMessage() {}
 
 func (x *QueryHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[22]
+	mi := &file_v1_sql_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,7 +2681,7 @@ func (x *QueryHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHistory.ProtoReflect.Descriptor instead.
 func (*QueryHistory) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{22}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryHistory) GetName() string {
@@ -2140,7 +2749,7 @@ type AICompletionRequest struct {
 
 func (x *AICompletionRequest) Reset() {
 	*x = AICompletionRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[23]
+	mi := &file_v1_sql_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2152,7 +2761,7 @@ func (x *AICompletionRequest) String() string {
 func (*AICompletionRequest) ProtoMessage() {}
 
 func (x *AICompletionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[23]
+	mi := &file_v1_sql_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,7 +2774,7 @@ func (x *AICompletionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionRequest.ProtoReflect.Descriptor instead.
 func (*AICompletionRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{23}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AICompletionRequest) GetMessages() []*AICompletionRequest_Message {
@@ -2186,7 +2795,7 @@ type AICompletionResponse struct {
 
 func (x *AICompletionResponse) Reset() {
 	*x = AICompletionResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[24]
+	mi := &file_v1_sql_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2198,7 +2807,7 @@ func (x *AICompletionResponse) String() string {
 func (*AICompletionResponse) ProtoMessage() {}
 
 func (x *AICompletionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[24]
+	mi := &file_v1_sql_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,7 +2820,7 @@ func (x *AICompletionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionResponse.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{24}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AICompletionResponse) GetCandidates() []*AICompletionResponse_Candidate {
@@ -2248,7 +2857,7 @@ type QueryResult_PostgresError struct {
 
 func (x *QueryResult_PostgresError) Reset() {
 	*x = QueryResult_PostgresError{}
-	mi := &file_v1_sql_service_proto_msgTypes[25]
+	mi := &file_v1_sql_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2260,7 +2869,7 @@ func (x *QueryResult_PostgresError) String() string {
 func (*QueryResult_PostgresError) ProtoMessage() {}
 
 func (x *QueryResult_PostgresError) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[25]
+	mi := &file_v1_sql_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2882,7 @@ func (x *QueryResult_PostgresError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult_PostgresError.ProtoReflect.Descriptor instead.
 func (*QueryResult_PostgresError) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *QueryResult_PostgresError) GetSeverity() string {
@@ -2405,7 +3014,7 @@ type QueryResult_Message struct {
 
 func (x *QueryResult_Message) Reset() {
 	*x = QueryResult_Message{}
-	mi := &file_v1_sql_service_proto_msgTypes[26]
+	mi := &file_v1_sql_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2417,7 +3026,7 @@ func (x *QueryResult_Message) String() string {
 func (*QueryResult_Message) ProtoMessage() {}
 
 func (x *QueryResult_Message) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[26]
+	mi := &file_v1_sql_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,7 +3039,7 @@ func (x *QueryResult_Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult_Message.ProtoReflect.Descriptor instead.
 func (*QueryResult_Message) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 1}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *QueryResult_Message) GetLevel() QueryResult_Message_Level {
@@ -2458,7 +3067,7 @@ type RowValue_Timestamp struct {
 
 func (x *RowValue_Timestamp) Reset() {
 	*x = RowValue_Timestamp{}
-	mi := &file_v1_sql_service_proto_msgTypes[27]
+	mi := &file_v1_sql_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2470,7 +3079,7 @@ func (x *RowValue_Timestamp) String() string {
 func (*RowValue_Timestamp) ProtoMessage() {}
 
 func (x *RowValue_Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[27]
+	mi := &file_v1_sql_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2483,7 +3092,7 @@ func (x *RowValue_Timestamp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowValue_Timestamp.ProtoReflect.Descriptor instead.
 func (*RowValue_Timestamp) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *RowValue_Timestamp) GetGoogleTimestamp() *timestamppb.Timestamp {
@@ -2519,7 +3128,7 @@ type RowValue_TimestampTZ struct {
 
 func (x *RowValue_TimestampTZ) Reset() {
 	*x = RowValue_TimestampTZ{}
-	mi := &file_v1_sql_service_proto_msgTypes[28]
+	mi := &file_v1_sql_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +3140,7 @@ func (x *RowValue_TimestampTZ) String() string {
 func (*RowValue_TimestampTZ) ProtoMessage() {}
 
 func (x *RowValue_TimestampTZ) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[28]
+	mi := &file_v1_sql_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +3153,7 @@ func (x *RowValue_TimestampTZ) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowValue_TimestampTZ.ProtoReflect.Descriptor instead.
 func (*RowValue_TimestampTZ) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{8, 1}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *RowValue_TimestampTZ) GetGoogleTimestamp() *timestamppb.Timestamp {
@@ -2575,6 +3184,125 @@ func (x *RowValue_TimestampTZ) GetAccuracy() int32 {
 	return 0
 }
 
+// Fix is a set of text edits resolving the advice.
+type Advice_Fix struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The fix title shown to the user.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The text edits to apply, positioned in the original statement.
+	// The edits do not overlap.
+	Edits         []*Advice_TextEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advice_Fix) Reset() {
+	*x = Advice_Fix{}
+	mi := &file_v1_sql_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advice_Fix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advice_Fix) ProtoMessage() {}
+
+func (x *Advice_Fix) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advice_Fix.ProtoReflect.Descriptor instead.
+func (*Advice_Fix) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *Advice_Fix) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Advice_Fix) GetEdits() []*Advice_TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+// TextEdit replaces the text between start_position and end_position with new_text.
+// The start_position is inclusive and the end_position is exclusive.
+// An insertion has the same start_position and end_position.
+type Advice_TextEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartPosition *Position              `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	EndPosition   *Position              `protobuf:"bytes,2,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	NewText       string                 `protobuf:"bytes,3,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advice_TextEdit) Reset() {
+	*x = Advice_TextEdit{}
+	mi := &file_v1_sql_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advice_TextEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advice_TextEdit) ProtoMessage() {}
+
+func (x *Advice_TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advice_TextEdit.ProtoReflect.Descriptor instead.
+func (*Advice_TextEdit) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *Advice_TextEdit) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *Advice_TextEdit) GetEndPosition() *Position {
+	if x != nil {
+		return x.EndPosition
+	}
+	return nil
+}
+
+func (x *Advice_TextEdit) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
 type AICompletionRequest_Message struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -2585,7 +3313,7 @@ type AICompletionRequest_Message struct {
 
 func (x *AICompletionRequest_Message) Reset() {
 	*x = AICompletionRequest_Message{}
-	mi := &file_v1_sql_service_proto_msgTypes[29]
+	mi := &file_v1_sql_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2597,7 +3325,7 @@ func (x *AICompletionRequest_Message) String() string {
 func (*AICompletionRequest_Message) ProtoMessage() {}
 
 func (x *AICompletionRequest_Message) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[29]
+	mi := &file_v1_sql_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,7 +3338,7 @@ func (x *AICompletionRequest_Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionRequest_Message.ProtoReflect.Descriptor instead.
 func (*AICompletionRequest_Message) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{23, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *AICompletionRequest_Message) GetRole() string {
@@ -2636,7 +3364,7 @@ type AICompletionResponse_Candidate struct {
 
 func (x *AICompletionResponse_Candidate) Reset() {
 	*x = AICompletionResponse_Candidate{}
-	mi := &file_v1_sql_service_proto_msgTypes[30]
+	mi := &file_v1_sql_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2648,7 +3376,7 @@ func (x *AICompletionResponse_Candidate) String() string {
 func (*AICompletionResponse_Candidate) ProtoMessage() {}
 
 func (x *AICompletionResponse_Candidate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[30]
+	mi := &file_v1_sql_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2661,7 +3389,7 @@ func (x *AICompletionResponse_Candidate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionResponse_Candidate.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse_Candidate) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{24, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *AICompletionResponse_Candidate) GetContent() *AICompletionResponse_Candidate_Content {
@@ -2681,7 +3409,7 @@ type AICompletionResponse_Candidate_Content struct {
 
 func (x *AICompletionResponse_Candidate_Content) Reset() {
 	*x = AICompletionResponse_Candidate_Content{}
-	mi := &file_v1_sql_service_proto_msgTypes[31]
+	mi := &file_v1_sql_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2693,7 +3421,7 @@ func (x *AICompletionResponse_Candidate_Content) String() string {
 func (*AICompletionResponse_Candidate_Content) ProtoMessage() {}
 
 func (x *AICompletionResponse_Candidate_Content) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[31]
+	mi := &file_v1_sql_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +3434,7 @@ func (x *AICompletionResponse_Candidate_Content) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AICompletionResponse_Candidate_Content.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse_Candidate_Content) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{24, 0, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{29, 0, 0}
 }
 
 func (x *AICompletionResponse_Candidate_Content) GetParts() []*AICompletionResponse_Candidate_Content_Part {
@@ -2725,7 +3453,7 @@ type AICompletionResponse_Candidate_Content_Part struct {
 
 func (x *AICompletionResponse_Candidate_Content_Part) Reset() {
 	*x = AICompletionResponse_Candidate_Content_Part{}
-	mi := &file_v1_sql_service_proto_msgTypes[32]
+	mi := &file_v1_sql_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2737,7 +3465,7 @@ func (x *AICompletionResponse_Candidate_Content_Part) String() string {
 func (*AICompletionResponse_Candidate_Content_Part) ProtoMessage() {}
 
 func (x *AICompletionResponse_Candidate_Content_Part) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[32]
+	mi := &file_v1_sql_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2750,7 +3478,7 @@ func (x *AICompletionResponse_Candidate_Content_Part) ProtoReflect() protoreflec
 
 // Deprecated: Use AICompletionResponse_Candidate_Content_Part.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse_Candidate_Content_Part) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{24, 0, 0, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{29, 0, 0, 0}
 }
 
 func (x *AICompletionResponse_Candidate_Content_Part) GetText() string {
@@ -2792,13 +3520,38 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\n" +
 	"_containerJ\x04\b\x02\x10\x03\"I\n" +
 	"\rQueryResponse\x122\n" +
-	"\aresults\x18\x01 \x03(\v2\x18.bytebase.v1.QueryResultR\aresultsJ\x04\b\x02\x10\x03\"\xca\x01\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.bytebase.v1.QueryResultR\aresultsJ\x04\b\x02\x10\x03\"\x92\x02\n" +
+	"\x11QueryPagesRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\x12\x1c\n" +
+	"\tstatement\x18\x02 \x01(\tR\tstatement\x12)\n" +
+	"\x0edata_source_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fdataSourceId\x12\x1b\n" +
+	"\x06schema\x18\x04 \x01(\tH\x00R\x06schema\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x06 \x01(\x05R\tpageCount\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageTokenB\t\n" +
+	"\a_schema\"\xfc\x01\n" +
+	"\x12QueryPagesResponse\x120\n" +
+	"\x06result\x18\x01 \x01(\v2\x18.bytebase.v1.QueryResultR\x06result\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12J\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\x0e2*.bytebase.v1.QueryPagesResponse.PaginationR\n" +
+	"pagination\"@\n" +
+	"\n" +
+	"Pagination\x12\x1a\n" +
+	"\x16PAGINATION_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06KEYSET\x10\x01\x12\n" +
+	"\n" +
+	"\x06CACHED\x10\x02\"\xca\x01\n" +
 	"\vQueryOption\x12^\n" +
 	"\x15redis_run_commands_on\x18\x01 \x01(\x0e2+.bytebase.v1.QueryOption.RedisRunCommandsOnR\x12redisRunCommandsOn\"[\n" +
 	"\x12RedisRunCommandsOn\x12%\n" +
 	"!REDIS_RUN_COMMANDS_ON_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vSINGLE_NODE\x10\x01\x12\r\n" +
-	"\tALL_NODES\x10\x02\"\xd1\t\n" +
+	"\tALL_NODES\x10\x02\"\xfd\t\n" +
 	"\vQueryResult\x12!\n" +
 	"\fcolumn_names\x18\x01 \x03(\tR\vcolumnNames\x12*\n" +
 	"\x11column_type_names\x18\x02 \x03(\tR\x0fcolumnTypeNames\x12)\n" +
@@ -2812,7 +3565,8 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\x0epostgres_error\x18\t \x01(\v2&.bytebase.v1.QueryResult.PostgresErrorH\x00R\rpostgresError\x12!\n" +
 	"\fallow_export\x18\v \x01(\bR\vallowExport\x12<\n" +
 	"\bmessages\x18\f \x03(\v2 .bytebase.v1.QueryResult.MessageR\bmessages\x122\n" +
-	"\x06masked\x18\x04 \x03(\v2\x1a.bytebase.v1.MaskingReasonR\x06masked\x1a\xfd\x03\n" +
+	"\x06masked\x18\x04 \x03(\v2\x1a.bytebase.v1.MaskingReasonR\x06masked\x12*\n" +
+	"\x04plan\x18\r \x01(\v2\x16.bytebase.v1.QueryPlanR\x04plan\x1a\xfd\x03\n" +
 	"\rPostgresError\x12\x1a\n" +
 	"\bseverity\x18\x01 \x01(\tR\bseverity\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
@@ -2847,7 +3601,38 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06NOTICE\x10\x05\x12\r\n" +
 	"\tEXCEPTION\x10\x06B\x10\n" +
-	"\x0edetailed_error\"\xaa\x02\n" +
+	"\x0edetailed_error\"\xf4\x01\n" +
+	"\tQueryPlan\x12.\n" +
+	"\x04root\x18\x01 \x01(\v2\x1a.bytebase.v1.QueryPlanNodeR\x04root\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\tR\x03raw\x125\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1d.bytebase.v1.QueryPlan.FormatR\x06format\x129\n" +
+	"\bwarnings\x18\x04 \x03(\v2\x1d.bytebase.v1.QueryPlanWarningR\bwarnings\"3\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\x12\a\n" +
+	"\x03XML\x10\x02\"\xc9\x02\n" +
+	"\rQueryPlanNode\x12\x1b\n" +
+	"\tnode_type\x18\x01 \x01(\tR\bnodeType\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x12\x14\n" +
+	"\x05index\x18\x04 \x01(\tR\x05index\x12%\n" +
+	"\x0eestimated_rows\x18\x05 \x01(\x01R\restimatedRows\x12$\n" +
+	"\vactual_rows\x18\x06 \x01(\x01H\x00R\n" +
+	"actualRows\x88\x01\x01\x12%\n" +
+	"\x0eestimated_cost\x18\a \x01(\x01R\restimatedCost\x12\x1b\n" +
+	"\tfull_scan\x18\b \x01(\bR\bfullScan\x126\n" +
+	"\bchildren\x18\t \x03(\v2\x1a.bytebase.v1.QueryPlanNodeR\bchildrenB\x0e\n" +
+	"\f_actual_rows\"\xe4\x01\n" +
+	"\x10QueryPlanWarning\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\".bytebase.v1.QueryPlanWarning.TypeR\x04type\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x04 \x01(\tR\x05table\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\x05 \x01(\x03R\ttableRows\"1\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fFULL_TABLE_SCAN\x10\x01\"\xaa\x02\n" +
 	"\rMaskingReason\x12(\n" +
 	"\x10semantic_type_id\x18\x01 \x01(\tR\x0esemanticTypeId\x12.\n" +
 	"\x13semantic_type_title\x18\x02 \x01(\tR\x11semanticTypeTitle\x12&\n" +
@@ -2888,14 +3673,23 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\x04zone\x18\x02 \x01(\tR\x04zone\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1a\n" +
 	"\baccuracy\x18\x04 \x01(\x05R\baccuracyB\x06\n" +
-	"\x04kind\"\xd1\x02\n" +
+	"\x04kind\"\xf1\x04\n" +
 	"\x06Advice\x122\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1a.bytebase.v1.Advice.StatusR\x06status\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12<\n" +
 	"\x0estart_position\x18\b \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x128\n" +
-	"\fend_position\x18\t \x01(\v2\x15.bytebase.v1.PositionR\vendPosition\"E\n" +
+	"\fend_position\x18\t \x01(\v2\x15.bytebase.v1.PositionR\vendPosition\x12-\n" +
+	"\x05fixes\x18\n" +
+	" \x03(\v2\x17.bytebase.v1.Advice.FixR\x05fixes\x1aO\n" +
+	"\x03Fix\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x122\n" +
+	"\x05edits\x18\x02 \x03(\v2\x1c.bytebase.v1.Advice.TextEditR\x05edits\x1a\x9d\x01\n" +
+	"\bTextEdit\x12<\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x128\n" +
+	"\fend_position\x18\x02 \x01(\v2\x15.bytebase.v1.PositionR\vendPosition\x12\x19\n" +
+	"\bnew_text\x18\x03 \x01(\tR\anewText\"E\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aSUCCESS\x10\x01\x12\v\n" +
@@ -2989,13 +3783,15 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\aContent\x12N\n" +
 	"\x05parts\x18\x01 \x03(\v28.bytebase.v1.AICompletionResponse.Candidate.Content.PartR\x05parts\x1a\x1a\n" +
 	"\x04Part\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text2\xda\t\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text2\x84\v\n" +
 	"\n" +
 	"SQLService\x12\xb2\x01\n" +
-	"\x05Query\x12\x19.bytebase.v1.QueryRequest\x1a\x1a.bytebase.v1.QueryResponse\"r\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02P:\x01*Z!:\x01*\"\x1c/v1/{name=instances/*}:query\"(/v1/{name=instances/*/databases/*}:query\x12\x89\x01\n" +
+	"\x05Query\x12\x19.bytebase.v1.QueryRequest\x1a\x1a.bytebase.v1.QueryResponse\"r\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02P:\x01*Z!:\x01*\"\x1c/v1/{name=instances/*}:query\"(/v1/{name=instances/*/databases/*}:query\x12\xa5\x01\n" +
+	"\n" +
+	"QueryPages\x12\x1e.bytebase.v1.QueryPagesRequest\x1a\x1f.bytebase.v1.QueryPagesResponse\"T\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x022:\x01*\"-/v1/{name=instances/*/databases/*}:queryPages0\x01\x12\x89\x01\n" +
 	"\fAdminExecute\x12 .bytebase.v1.AdminExecuteRequest\x1a!.bytebase.v1.AdminExecuteResponse\"0\x8a\xea0\fbb.sql.admin\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1:adminExecute(\x010\x01\x12\x95\x01\n" +
-	"\x14SearchQueryHistories\x12(.bytebase.v1.SearchQueryHistoriesRequest\x1a).bytebase.v1.SearchQueryHistoriesResponse\"(\x90\xea0\x02\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/queryHistories:search\x12\x9e\x02\n" +
-	"\x06Export\x12\x1a.bytebase.v1.ExportRequest\x1a\x1b.bytebase.v1.ExportResponse\"\xda\x01\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\xb7\x01:\x01*Z\":\x01*\"\x1d/v1/{name=instances/*}:exportZ,:\x01*\"'/v1/{name=projects/*/rollouts/*}:exportZ5:\x01*\"0/v1/{name=projects/*/rollouts/*/stages/*}:export\")/v1/{name=instances/*/databases/*}:export\x12r\n" +
+	"\x14SearchQueryHistories\x12(.bytebase.v1.SearchQueryHistoriesRequest\x1a).bytebase.v1.SearchQueryHistoriesResponse\"(\x90\xea0\x02\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/queryHistories:search\x12\xa0\x02\n" +
+	"\x06Export\x12\x1a.bytebase.v1.ExportRequest\x1a\x1b.bytebase.v1.ExportResponse\"\xda\x01\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\xb7\x01:\x01*Z\":\x01*\"\x1d/v1/{name=instances/*}:exportZ,:\x01*\"'/v1/{name=projects/*/rollouts/*}:exportZ5:\x01*\"0/v1/{name=projects/*/rollouts/*/stages/*}:export\")/v1/{name=instances/*/databases/*}:export0\x01\x12r\n" +
 	"\x05Check\x12\x19.bytebase.v1.CheckRequest\x1a\x1a.bytebase.v1.CheckResponse\"2\x8a\xea0\x12bb.databases.check\x90\xea0\x01\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/sql/check\x12`\n" +
 	"\x06Pretty\x12\x1a.bytebase.v1.PrettyRequest\x1a\x1b.bytebase.v1.PrettyResponse\"\x1d\x80\xea0\x01\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/sql/pretty\x12\x81\x01\n" +
 	"\fDiffMetadata\x12 .bytebase.v1.DiffMetadataRequest\x1a!.bytebase.v1.DiffMetadataResponse\",\x80\xea0\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/schemaDesign:diffMetadata\x12x\n" +
@@ -3013,116 +3809,140 @@ func file_v1_sql_service_proto_rawDescGZIP() []byte {
 	return file_v1_sql_service_proto_rawDescData
 }
 
-var file_v1_sql_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_v1_sql_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_v1_sql_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_v1_sql_service_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_v1_sql_service_proto_goTypes = []any{
-	(QueryOption_RedisRunCommandsOn)(0),                 // 0: bytebase.v1.QueryOption.RedisRunCommandsOn
-	(QueryResult_Message_Level)(0),                      // 1: bytebase.v1.QueryResult.Message.Level
-	(Advice_Status)(0),                                  // 2: bytebase.v1.Advice.Status
-	(CheckRequest_ChangeType)(0),                        // 3: bytebase.v1.CheckRequest.ChangeType
-	(QueryHistory_Type)(0),                              // 4: bytebase.v1.QueryHistory.Type
-	(*AdminExecuteRequest)(nil),                         // 5: bytebase.v1.AdminExecuteRequest
-	(*AdminExecuteResponse)(nil),                        // 6: bytebase.v1.AdminExecuteResponse
-	(*QueryRequest)(nil),                                // 7: bytebase.v1.QueryRequest
-	(*QueryResponse)(nil),                               // 8: bytebase.v1.QueryResponse
-	(*QueryOption)(nil),                                 // 9: bytebase.v1.QueryOption
-	(*QueryResult)(nil),                                 // 10: bytebase.v1.QueryResult
-	(*MaskingReason)(nil),                               // 11: bytebase.v1.MaskingReason
-	(*QueryRow)(nil),                                    // 12: bytebase.v1.QueryRow
-	(*RowValue)(nil),                                    // 13: bytebase.v1.RowValue
-	(*Advice)(nil),                                      // 14: bytebase.v1.Advice
-	(*ExportRequest)(nil),                               // 15: bytebase.v1.ExportRequest
-	(*ExportResponse)(nil),                              // 16: bytebase.v1.ExportResponse
-	(*PrettyRequest)(nil),                               // 17: bytebase.v1.PrettyRequest
-	(*PrettyResponse)(nil),                              // 18: bytebase.v1.PrettyResponse
-	(*CheckRequest)(nil),                                // 19: bytebase.v1.CheckRequest
-	(*CheckResponse)(nil),                               // 20: bytebase.v1.CheckResponse
-	(*ParseMyBatisMapperRequest)(nil),                   // 21: bytebase.v1.ParseMyBatisMapperRequest
-	(*ParseMyBatisMapperResponse)(nil),                  // 22: bytebase.v1.ParseMyBatisMapperResponse
-	(*DiffMetadataRequest)(nil),                         // 23: bytebase.v1.DiffMetadataRequest
-	(*DiffMetadataResponse)(nil),                        // 24: bytebase.v1.DiffMetadataResponse
-	(*SearchQueryHistoriesRequest)(nil),                 // 25: bytebase.v1.SearchQueryHistoriesRequest
-	(*SearchQueryHistoriesResponse)(nil),                // 26: bytebase.v1.SearchQueryHistoriesResponse
-	(*QueryHistory)(nil),                                // 27: bytebase.v1.QueryHistory
-	(*AICompletionRequest)(nil),                         // 28: bytebase.v1.AICompletionRequest
-	(*AICompletionResponse)(nil),                        // 29: bytebase.v1.AICompletionResponse
-	(*QueryResult_PostgresError)(nil),                   // 30: bytebase.v1.QueryResult.PostgresError
-	(*QueryResult_Message)(nil),                         // 31: bytebase.v1.QueryResult.Message
-	(*RowValue_Timestamp)(nil),                          // 32: bytebase.v1.RowValue.Timestamp
-	(*RowValue_TimestampTZ)(nil),                        // 33: bytebase.v1.RowValue.TimestampTZ
-	(*AICompletionRequest_Message)(nil),                 // 34: bytebase.v1.AICompletionRequest.Message
-	(*AICompletionResponse_Candidate)(nil),              // 35: bytebase.v1.AICompletionResponse.Candidate
-	(*AICompletionResponse_Candidate_Content)(nil),      // 36: bytebase.v1.AICompletionResponse.Candidate.Content
-	(*AICompletionResponse_Candidate_Content_Part)(nil), // 37: bytebase.v1.AICompletionResponse.Candidate.Content.Part
-	(*durationpb.Duration)(nil),                         // 38: google.protobuf.Duration
-	(structpb.NullValue)(0),                             // 39: google.protobuf.NullValue
-	(*structpb.Value)(nil),                              // 40: google.protobuf.Value
-	(*Position)(nil),                                    // 41: bytebase.v1.Position
-	(ExportFormat)(0),                                   // 42: bytebase.v1.ExportFormat
-	(Engine)(0),                                         // 43: bytebase.v1.Engine
-	(*DatabaseMetadata)(nil),                            // 44: bytebase.v1.DatabaseMetadata
-	(*DatabaseCatalog)(nil),                             // 45: bytebase.v1.DatabaseCatalog
-	(*timestamppb.Timestamp)(nil),                       // 46: google.protobuf.Timestamp
+	(QueryPagesResponse_Pagination)(0),                  // 0: bytebase.v1.QueryPagesResponse.Pagination
+	(QueryOption_RedisRunCommandsOn)(0),                 // 1: bytebase.v1.QueryOption.RedisRunCommandsOn
+	(QueryResult_Message_Level)(0),                      // 2: bytebase.v1.QueryResult.Message.Level
+	(QueryPlan_Format)(0),                               // 3: bytebase.v1.QueryPlan.Format
+	(QueryPlanWarning_Type)(0),                          // 4: bytebase.v1.QueryPlanWarning.Type
+	(Advice_Status)(0),                                  // 5: bytebase.v1.Advice.Status
+	(CheckRequest_ChangeType)(0),                        // 6: bytebase.v1.CheckRequest.ChangeType
+	(QueryHistory_Type)(0),                              // 7: bytebase.v1.QueryHistory.Type
+	(*AdminExecuteRequest)(nil),                         // 8: bytebase.v1.AdminExecuteRequest
+	(*AdminExecuteResponse)(nil),                        // 9: bytebase.v1.AdminExecuteResponse
+	(*QueryRequest)(nil),                                // 10: bytebase.v1.QueryRequest
+	(*QueryResponse)(nil),                               // 11: bytebase.v1.QueryResponse
+	(*QueryPagesRequest)(nil),                           // 12: bytebase.v1.QueryPagesRequest
+	(*QueryPagesResponse)(nil),                          // 13: bytebase.v1.QueryPagesResponse
+	(*QueryOption)(nil),                                 // 14: bytebase.v1.QueryOption
+	(*QueryResult)(nil),                                 // 15: bytebase.v1.QueryResult
+	(*QueryPlan)(nil),                                   // 16: bytebase.v1.QueryPlan
+	(*QueryPlanNode)(nil),                               // 17: bytebase.v1.QueryPlanNode
+	(*QueryPlanWarning)(nil),                            // 18: bytebase.v1.QueryPlanWarning
+	(*MaskingReason)(nil),                               // 19: bytebase.v1.MaskingReason
+	(*QueryRow)(nil),                                    // 20: bytebase.v1.QueryRow
+	(*RowValue)(nil),                                    // 21: bytebase.v1.RowValue
+	(*Advice)(nil),                                      // 22: bytebase.v1.Advice
+	(*ExportRequest)(nil),                               // 23: bytebase.v1.ExportRequest
+	(*ExportResponse)(nil),                              // 24: bytebase.v1.ExportResponse
+	(*PrettyRequest)(nil),                               // 25: bytebase.v1.PrettyRequest
+	(*PrettyResponse)(nil),                              // 26: bytebase.v1.PrettyResponse
+	(*CheckRequest)(nil),                                // 27: bytebase.v1.CheckRequest
+	(*CheckResponse)(nil),                               // 28: bytebase.v1.CheckResponse
+	(*ParseMyBatisMapperRequest)(nil),                   // 29: bytebase.v1.ParseMyBatisMapperRequest
+	(*ParseMyBatisMapperResponse)(nil),                  // 30: bytebase.v1.ParseMyBatisMapperResponse
+	(*DiffMetadataRequest)(nil),                         // 31: bytebase.v1.DiffMetadataRequest
+	(*DiffMetadataResponse)(nil),                        // 32: bytebase.v1.DiffMetadataResponse
+	(*SearchQueryHistoriesRequest)(nil),                 // 33: bytebase.v1.SearchQueryHistoriesRequest
+	(*SearchQueryHistoriesResponse)(nil),                // 34: bytebase.v1.SearchQueryHistoriesResponse
+	(*QueryHistory)(nil),                                // 35: bytebase.v1.QueryHistory
+	(*AICompletionRequest)(nil),                         // 36: bytebase.v1.AICompletionRequest
+	(*AICompletionResponse)(nil),                        // 37: bytebase.v1.AICompletionResponse
+	(*QueryResult_PostgresError)(nil),                   // 38: bytebase.v1.QueryResult.PostgresError
+	(*QueryResult_Message)(nil),                         // 39: bytebase.v1.QueryResult.Message
+	(*RowValue_Timestamp)(nil),                          // 40: bytebase.v1.RowValue.Timestamp
+	(*RowValue_TimestampTZ)(nil),                        // 41: bytebase.v1.RowValue.TimestampTZ
+	(*Advice_Fix)(nil),                                  // 42: bytebase.v1.Advice.Fix
+	(*Advice_TextEdit)(nil),                             // 43: bytebase.v1.Advice.TextEdit
+	(*AICompletionRequest_Message)(nil),                 // 44: bytebase.v1.AICompletionRequest.Message
+	(*AICompletionResponse_Candidate)(nil),              // 45: bytebase.v1.AICompletionResponse.Candidate
+	(*AICompletionResponse_Candidate_Content)(nil),      // 46: bytebase.v1.AICompletionResponse.Candidate.Content
+	(*AICompletionResponse_Candidate_Content_Part)(nil), // 47: bytebase.v1.AICompletionResponse.Candidate.Content.Part
+	(*durationpb.Duration)(nil),                         // 48: google.protobuf.Duration
+	(structpb.NullValue)(0),                             // 49: google.protobuf.NullValue
+	(*structpb.Value)(nil),                              // 50: google.protobuf.Value
+	(*Position)(nil),                                    // 51: bytebase.v1.Position
+	(ExportFormat)(0),                                   // 52: bytebase.v1.ExportFormat
+	(Engine)(0),                                         // 53: bytebase.v1.Engine
+	(*DatabaseMetadata)(nil),                            // 54: bytebase.v1.DatabaseMetadata
+	(*DatabaseCatalog)(nil),                             // 55: bytebase.v1.DatabaseCatalog
+	(*timestamppb.Timestamp)(nil),                       // 56: google.protobuf.Timestamp
 }
 var file_v1_sql_service_proto_depIdxs = []int32{
-	10, // 0: bytebase.v1.AdminExecuteResponse.results:type_name -> bytebase.v1.QueryResult
-	9,  // 1: bytebase.v1.QueryRequest.query_option:type_name -> bytebase.v1.QueryOption
-	10, // 2: bytebase.v1.QueryResponse.results:type_name -> bytebase.v1.QueryResult
-	0,  // 3: bytebase.v1.QueryOption.redis_run_commands_on:type_name -> bytebase.v1.QueryOption.RedisRunCommandsOn
-	12, // 4: bytebase.v1.QueryResult.rows:type_name -> bytebase.v1.QueryRow
-	38, // 5: bytebase.v1.QueryResult.latency:type_name -> google.protobuf.Duration
-	30, // 6: bytebase.v1.QueryResult.postgres_error:type_name -> bytebase.v1.QueryResult.PostgresError
-	31, // 7: bytebase.v1.QueryResult.messages:type_name -> bytebase.v1.QueryResult.Message
-	11, // 8: bytebase.v1.QueryResult.masked:type_name -> bytebase.v1.MaskingReason
-	13, // 9: bytebase.v1.QueryRow.values:type_name -> bytebase.v1.RowValue
-	39, // 10: bytebase.v1.RowValue.null_value:type_name -> google.protobuf.NullValue
-	40, // 11: bytebase.v1.RowValue.value_value:type_name -> google.protobuf.Value
-	32, // 12: bytebase.v1.RowValue.timestamp_value:type_name -> bytebase.v1.RowValue.Timestamp
-	33, // 13: bytebase.v1.RowValue.timestamp_tz_value:type_name -> bytebase.v1.RowValue.TimestampTZ
-	2,  // 14: bytebase.v1.Advice.status:type_name -> bytebase.v1.Advice.Status
-	41, // 15: bytebase.v1.Advice.start_position:type_name -> bytebase.v1.Position
-	41, // 16: bytebase.v1.Advice.end_position:type_name -> bytebase.v1.Position
-	42, // 17: bytebase.v1.ExportRequest.format:type_name -> bytebase.v1.ExportFormat
-	43, // 18: bytebase.v1.PrettyRequest.engine:type_name -> bytebase.v1.Engine
-	3,  // 19: bytebase.v1.CheckRequest.change_type:type_name -> bytebase.v1.CheckRequest.ChangeType
-	14, // 20: bytebase.v1.CheckResponse.advices:type_name -> bytebase.v1.Advice
-	44, // 21: bytebase.v1.DiffMetadataRequest.source_metadata:type_name -> bytebase.v1.DatabaseMetadata
-	44, // 22: bytebase.v1.DiffMetadataRequest.target_metadata:type_name -> bytebase.v1.DatabaseMetadata
-	45, // 23: bytebase.v1.DiffMetadataRequest.source_catalog:type_name -> bytebase.v1.DatabaseCatalog
-	45, // 24: bytebase.v1.DiffMetadataRequest.target_catalog:type_name -> bytebase.v1.DatabaseCatalog
-	43, // 25: bytebase.v1.DiffMetadataRequest.engine:type_name -> bytebase.v1.Engine
-	27, // 26: bytebase.v1.SearchQueryHistoriesResponse.query_histories:type_name -> bytebase.v1.QueryHistory
-	46, // 27: bytebase.v1.QueryHistory.create_time:type_name -> google.protobuf.Timestamp
-	38, // 28: bytebase.v1.QueryHistory.duration:type_name -> google.protobuf.Duration
-	4,  // 29: bytebase.v1.QueryHistory.type:type_name -> bytebase.v1.QueryHistory.Type
-	34, // 30: bytebase.v1.AICompletionRequest.messages:type_name -> bytebase.v1.AICompletionRequest.Message
-	35, // 31: bytebase.v1.AICompletionResponse.candidates:type_name -> bytebase.v1.AICompletionResponse.Candidate
-	1,  // 32: bytebase.v1.QueryResult.Message.level:type_name -> bytebase.v1.QueryResult.Message.Level
-	46, // 33: bytebase.v1.RowValue.Timestamp.google_timestamp:type_name -> google.protobuf.Timestamp
-	46, // 34: bytebase.v1.RowValue.TimestampTZ.google_timestamp:type_name -> google.protobuf.Timestamp
-	36, // 35: bytebase.v1.AICompletionResponse.Candidate.content:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content
-	37, // 36: bytebase.v1.AICompletionResponse.Candidate.Content.parts:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content.Part
-	7,  // 37: bytebase.v1.SQLService.Query:input_type -> bytebase.v1.QueryRequest
-	5,  // 38: bytebase.v1.SQLService.AdminExecute:input_type -> bytebase.v1.AdminExecuteRequest
-	25, // 39: bytebase.v1.SQLService.SearchQueryHistories:input_type -> bytebase.v1.SearchQueryHistoriesRequest
-	15, // 40: bytebase.v1.SQLService.Export:input_type -> bytebase.v1.ExportRequest
-	19, // 41: bytebase.v1.SQLService.Check:input_type -> bytebase.v1.CheckRequest
-	17, // 42: bytebase.v1.SQLService.Pretty:input_type -> bytebase.v1.PrettyRequest
-	23, // 43: bytebase.v1.SQLService.DiffMetadata:input_type -> bytebase.v1.DiffMetadataRequest
-	28, // 44: bytebase.v1.SQLService.AICompletion:input_type -> bytebase.v1.AICompletionRequest
-	8,  // 45: bytebase.v1.SQLService.Query:output_type -> bytebase.v1.QueryResponse
-	6,  // 46: bytebase.v1.SQLService.AdminExecute:output_type -> bytebase.v1.AdminExecuteResponse
-	26, // 47: bytebase.v1.SQLService.SearchQueryHistories:output_type -> bytebase.v1.SearchQueryHistoriesResponse
-	16, // 48: bytebase.v1.SQLService.Export:output_type -> bytebase.v1.ExportResponse
-	20, // 49: bytebase.v1.SQLService.Check:output_type -> bytebase.v1.CheckResponse
-	18, // 50: bytebase.v1.SQLService.Pretty:output_type -> bytebase.v1.PrettyResponse
-	24, // 51: bytebase.v1.SQLService.DiffMetadata:output_type -> bytebase.v1.DiffMetadataResponse
-	29, // 52: bytebase.v1.SQLService.AICompletion:output_type -> bytebase.v1.AICompletionResponse
-	45, // [45:53] is the sub-list for method output_type
-	37, // [37:45] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	15, // 0: bytebase.v1.AdminExecuteResponse.results:type_name -> bytebase.v1.QueryResult
+	14, // 1: bytebase.v1.QueryRequest.query_option:type_name -> bytebase.v1.QueryOption
+	15, // 2: bytebase.v1.QueryResponse.results:type_name -> bytebase.v1.QueryResult
+	15, // 3: bytebase.v1.QueryPagesResponse.result:type_name -> bytebase.v1.QueryResult
+	0,  // 4: bytebase.v1.QueryPagesResponse.pagination:type_name -> bytebase.v1.QueryPagesResponse.Pagination
+	1,  // 5: bytebase.v1.QueryOption.redis_run_commands_on:type_name -> bytebase.v1.QueryOption.RedisRunCommandsOn
+	20, // 6: bytebase.v1.QueryResult.rows:type_name -> bytebase.v1.QueryRow
+	48, // 7: bytebase.v1.QueryResult.latency:type_name -> google.protobuf.Duration
+	38, // 8: bytebase.v1.QueryResult.postgres_error:type_name -> bytebase.v1.QueryResult.PostgresError
+	39, // 9: bytebase.v1.QueryResult.messages:type_name -> bytebase.v1.QueryResult.Message
+	19, // 10: bytebase.v1.QueryResult.masked:type_name -> bytebase.v1.MaskingReason
+	16, // 11: bytebase.v1.QueryResult.plan:type_name -> bytebase.v1.QueryPlan
+	17, // 12: bytebase.v1.QueryPlan.root:type_name -> bytebase.v1.QueryPlanNode
+	3,  // 13: bytebase.v1.QueryPlan.format:type_name -> bytebase.v1.QueryPlan.Format
+	18, // 14: bytebase.v1.QueryPlan.warnings:type_name -> bytebase.v1.QueryPlanWarning
+	17, // 15: bytebase.v1.QueryPlanNode.children:type_name -> bytebase.v1.QueryPlanNode
+	4,  // 16: bytebase.v1.QueryPlanWarning.type:type_name -> bytebase.v1.QueryPlanWarning.Type
+	21, // 17: bytebase.v1.QueryRow.values:type_name -> bytebase.v1.RowValue
+	49, // 18: bytebase.v1.RowValue.null_value:type_name -> google.protobuf.NullValue
+	50, // 19: bytebase.v1.RowValue.value_value:type_name -> google.protobuf.Value
+	40, // 20: bytebase.v1.RowValue.timestamp_value:type_name -> bytebase.v1.RowValue.Timestamp
+	41, // 21: bytebase.v1.RowValue.timestamp_tz_value:type_name -> bytebase.v1.RowValue.TimestampTZ
+	5,  // 22: bytebase.v1.Advice.status:type_name -> bytebase.v1.Advice.Status
+	51, // 23: bytebase.v1.Advice.start_position:type_name -> bytebase.v1.Position
+	51, // 24: bytebase.v1.Advice.end_position:type_name -> bytebase.v1.Position
+	42, // 25: bytebase.v1.Advice.fixes:type_name -> bytebase.v1.Advice.Fix
+	52, // 26: bytebase.v1.ExportRequest.format:type_name -> bytebase.v1.ExportFormat
+	53, // 27: bytebase.v1.PrettyRequest.engine:type_name -> bytebase.v1.Engine
+	6,  // 28: bytebase.v1.CheckRequest.change_type:type_name -> bytebase.v1.CheckRequest.ChangeType
+	22, // 29: bytebase.v1.CheckResponse.advices:type_name -> bytebase.v1.Advice
+	54, // 30: bytebase.v1.DiffMetadataRequest.source_metadata:type_name -> bytebase.v1.DatabaseMetadata
+	54, // 31: bytebase.v1.DiffMetadataRequest.target_metadata:type_name -> bytebase.v1.DatabaseMetadata
+	55, // 32: bytebase.v1.DiffMetadataRequest.source_catalog:type_name -> bytebase.v1.DatabaseCatalog
+	55, // 33: bytebase.v1.DiffMetadataRequest.target_catalog:type_name -> bytebase.v1.DatabaseCatalog
+	53, // 34: bytebase.v1.DiffMetadataRequest.engine:type_name -> bytebase.v1.Engine
+	35, // 35: bytebase.v1.SearchQueryHistoriesResponse.query_histories:type_name -> bytebase.v1.QueryHistory
+	56, // 36: bytebase.v1.QueryHistory.create_time:type_name -> google.protobuf.Timestamp
+	48, // 37: bytebase.v1.QueryHistory.duration:type_name -> google.protobuf.Duration
+	7,  // 38: bytebase.v1.QueryHistory.type:type_name -> bytebase.v1.QueryHistory.Type
+	44, // 39: bytebase.v1.AICompletionRequest.messages:type_name -> bytebase.v1.AICompletionRequest.Message
+	45, // 40: bytebase.v1.AICompletionResponse.candidates:type_name -> bytebase.v1.AICompletionResponse.Candidate
+	2,  // 41: bytebase.v1.QueryResult.Message.level:type_name -> bytebase.v1.QueryResult.Message.Level
+	56, // 42: bytebase.v1.RowValue.Timestamp.google_timestamp:type_name -> google.protobuf.Timestamp
+	56, // 43: bytebase.v1.RowValue.TimestampTZ.google_timestamp:type_name -> google.protobuf.Timestamp
+	43, // 44: bytebase.v1.Advice.Fix.edits:type_name -> bytebase.v1.Advice.TextEdit
+	51, // 45: bytebase.v1.Advice.TextEdit.start_position:type_name -> bytebase.v1.Position
+	51, // 46: bytebase.v1.Advice.TextEdit.end_position:type_name -> bytebase.v1.Position
+	46, // 47: bytebase.v1.AICompletionResponse.Candidate.content:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content
+	47, // 48: bytebase.v1.AICompletionResponse.Candidate.Content.parts:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content.Part
+	10, // 49: bytebase.v1.SQLService.Query:input_type -> bytebase.v1.QueryRequest
+	12, // 50: bytebase.v1.SQLService.QueryPages:input_type -> bytebase.v1.QueryPagesRequest
+	8,  // 51: bytebase.v1.SQLService.AdminExecute:input_type -> bytebase.v1.AdminExecuteRequest
+	33, // 52: bytebase.v1.SQLService.SearchQueryHistories:input_type -> bytebase.v1.SearchQueryHistoriesRequest
+	23, // 53: bytebase.v1.SQLService.Export:input_type -> bytebase.v1.ExportRequest
+	27, // 54: bytebase.v1.SQLService.Check:input_type -> bytebase.v1.CheckRequest
+	25, // 55: bytebase.v1.SQLService.Pretty:input_type -> bytebase.v1.PrettyRequest
+	31, // 56: bytebase.v1.SQLService.DiffMetadata:input_type -> bytebase.v1.DiffMetadataRequest
+	36, // 57: bytebase.v1.SQLService.AICompletion:input_type -> bytebase.v1.AICompletionRequest
+	11, // 58: bytebase.v1.SQLService.Query:output_type -> bytebase.v1.QueryResponse
+	13, // 59: bytebase.v1.SQLService.QueryPages:output_type -> bytebase.v1.QueryPagesResponse
+	9,  // 60: bytebase.v1.SQLService.AdminExecute:output_type -> bytebase.v1.AdminExecuteResponse
+	34, // 61: bytebase.v1.SQLService.SearchQueryHistories:output_type -> bytebase.v1.SearchQueryHistoriesResponse
+	24, // 62: bytebase.v1.SQLService.Export:output_type -> bytebase.v1.ExportResponse
+	28, // 63: bytebase.v1.SQLService.Check:output_type -> bytebase.v1.CheckResponse
+	26, // 64: bytebase.v1.SQLService.Pretty:output_type -> bytebase.v1.PrettyResponse
+	32, // 65: bytebase.v1.SQLService.DiffMetadata:output_type -> bytebase.v1.DiffMetadataResponse
+	37, // 66: bytebase.v1.SQLService.AICompletion:output_type -> bytebase.v1.AICompletionResponse
+	58, // [58:67] is the sub-list for method output_type
+	49, // [49:58] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_v1_sql_service_proto_init() }
@@ -3136,10 +3956,12 @@ func file_v1_sql_service_proto_init() {
 	file_v1_database_service_proto_init()
 	file_v1_sql_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_v1_sql_service_proto_msgTypes[2].OneofWrappers = []any{}
-	file_v1_sql_service_proto_msgTypes[5].OneofWrappers = []any{
+	file_v1_sql_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_v1_sql_service_proto_msgTypes[7].OneofWrappers = []any{
 		(*QueryResult_PostgresError_)(nil),
 	}
-	file_v1_sql_service_proto_msgTypes[8].OneofWrappers = []any{
+	file_v1_sql_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_v1_sql_service_proto_msgTypes[13].OneofWrappers = []any{
 		(*RowValue_NullValue)(nil),
 		(*RowValue_BoolValue)(nil),
 		(*RowValue_BytesValue)(nil),
@@ -3154,14 +3976,14 @@ func file_v1_sql_service_proto_init() {
 		(*RowValue_TimestampValue)(nil),
 		(*RowValue_TimestampTzValue)(nil),
 	}
-	file_v1_sql_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_v1_sql_service_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_sql_service_proto_rawDesc), len(file_v1_sql_service_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   33,
+			NumEnums:      8,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
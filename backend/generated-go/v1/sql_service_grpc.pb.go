@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SQLService_Query_FullMethodName                = "/bytebase.v1.SQLService/Query"
+	SQLService_QueryPages_FullMethodName           = "/bytebase.v1.SQLService/QueryPages"
 	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
 	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
 	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
@@ -35,13 +36,18 @@ const (
 type SQLServiceClient interface {
 	// Permissions required: bb.databases.get
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// QueryPages executes the query in a result session and streams the result page by page,
+	// so browsing a big result doesn't re-run the full query on every scroll.
+	// The session can be resumed with the page token of the last response.
+	// Permissions required: bb.databases.get
+	QueryPages(ctx context.Context, in *QueryPagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryPagesResponse], error)
 	// Permissions required: bb.sql.admin
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error)
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None
 	SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error)
 	// Permissions required: bb.databases.get
-	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error)
 	// Permissions required: bb.databases.check
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	// Permissions required: None
@@ -70,9 +76,28 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) QueryPages(ctx context.Context, in *QueryPagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryPagesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_QueryPages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QueryPagesRequest, QueryPagesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_QueryPagesClient = grpc.ServerStreamingClient[QueryPagesResponse]
+
 func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_AdminExecute_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_AdminExecute_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,16 +118,25 @@ func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, in *SearchQ
 	return out, nil
 }
 
-func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExportResponse)
-	err := c.cc.Invoke(ctx, SQLService_Export_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[2], SQLService_Export_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[ExportRequest, ExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportClient = grpc.ServerStreamingClient[ExportResponse]
+
 func (c *sQLServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckResponse)
@@ -149,13 +183,18 @@ func (c *sQLServiceClient) AICompletion(ctx context.Context, in *AICompletionReq
 type SQLServiceServer interface {
 	// Permissions required: bb.databases.get
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// QueryPages executes the query in a result session and streams the result page by page,
+	// so browsing a big result doesn't re-run the full query on every scroll.
+	// The session can be resumed with the page token of the last response.
+	// Permissions required: bb.databases.get
+	QueryPages(*QueryPagesRequest, grpc.ServerStreamingServer[QueryPagesResponse]) error
 	// Permissions required: bb.sql.admin
 	AdminExecute(grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]) error
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None
 	SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error)
 	// Permissions required: bb.databases.get
-	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	Export(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error
 	// Permissions required: bb.databases.check
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
 	// Permissions required: None
@@ -177,14 +216,17 @@ type UnimplementedSQLServiceServer struct{}
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) QueryPages(*QueryPagesRequest, grpc.ServerStreamingServer[QueryPagesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method QueryPages not implemented")
+}
 func (UnimplementedSQLServiceServer) AdminExecute(grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method AdminExecute not implemented")
 }
 func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchQueryHistories not implemented")
 }
-func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+func (UnimplementedSQLServiceServer) Export(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
 func (UnimplementedSQLServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
@@ -237,6 +279,17 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_QueryPages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryPagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).QueryPages(m, &grpc.GenericServerStream[QueryPagesRequest, QueryPagesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_QueryPagesServer = grpc.ServerStreamingServer[QueryPagesResponse]
+
 func _SQLService_AdminExecute_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SQLServiceServer).AdminExecute(&grpc.GenericServerStream[AdminExecuteRequest, AdminExecuteResponse]{ServerStream: stream})
 }
@@ -262,24 +315,17 @@ func _SQLService_SearchQueryHistories_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SQLServiceServer).Export(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: SQLService_Export_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SQLServiceServer).Export(ctx, req.(*ExportRequest))
+func _SQLService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(SQLServiceServer).Export(m, &grpc.GenericServerStream[ExportRequest, ExportResponse]{ServerStream: stream})
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportServer = grpc.ServerStreamingServer[ExportResponse]
+
 func _SQLService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRequest)
 	if err := dec(in); err != nil {
@@ -367,10 +413,6 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchQueryHistories",
 			Handler:    _SQLService_SearchQueryHistories_Handler,
 		},
-		{
-			MethodName: "Export",
-			Handler:    _SQLService_Export_Handler,
-		},
 		{
 			MethodName: "Check",
 			Handler:    _SQLService_Check_Handler,
@@ -389,12 +431,22 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryPages",
+			Handler:       _SQLService_QueryPages_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AdminExecute",
 			Handler:       _SQLService_AdminExecute_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Export",
+			Handler:       _SQLService_Export_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/sql_service.proto",
 }
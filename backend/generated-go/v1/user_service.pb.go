@@ -611,10 +611,12 @@ type User struct {
 	RecoveryCodes []string `protobuf:"bytes,11,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
 	// Should be a valid E.164 compliant phone number.
 	// Could be empty.
-	Phone         string        `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
-	Profile       *User_Profile `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Phone   string        `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
+	Profile *User_Profile `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The webauthn_enabled flag means if the user has registered any WebAuthn credential.
+	WebauthnEnabled bool `protobuf:"varint,14,opt,name=webauthn_enabled,json=webauthnEnabled,proto3" json:"webauthn_enabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -731,6 +733,754 @@ func (x *User) GetProfile() *User_Profile {
 	return nil
 }
 
+func (x *User) GetWebauthnEnabled() bool {
+	if x != nil {
+		return x.WebauthnEnabled
+	}
+	return false
+}
+
+type GenerateWebAuthnCreationOptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to register the credential for.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateWebAuthnCreationOptionsRequest) Reset() {
+	*x = GenerateWebAuthnCreationOptionsRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWebAuthnCreationOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWebAuthnCreationOptionsRequest) ProtoMessage() {}
+
+func (x *GenerateWebAuthnCreationOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWebAuthnCreationOptionsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWebAuthnCreationOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateWebAuthnCreationOptionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type GenerateWebAuthnCreationOptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The PublicKeyCredentialCreationOptionsJSON for navigator.credentials.create().
+	CreationOptions string `protobuf:"bytes,1,opt,name=creation_options,json=creationOptions,proto3" json:"creation_options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateWebAuthnCreationOptionsResponse) Reset() {
+	*x = GenerateWebAuthnCreationOptionsResponse{}
+	mi := &file_v1_user_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWebAuthnCreationOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWebAuthnCreationOptionsResponse) ProtoMessage() {}
+
+func (x *GenerateWebAuthnCreationOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWebAuthnCreationOptionsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateWebAuthnCreationOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenerateWebAuthnCreationOptionsResponse) GetCreationOptions() string {
+	if x != nil {
+		return x.CreationOptions
+	}
+	return ""
+}
+
+type CreateWebAuthnCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to register the credential for.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The credential to create, only the title is used.
+	WebauthnCredential *WebAuthnCredential `protobuf:"bytes,2,opt,name=webauthn_credential,json=webauthnCredential,proto3" json:"webauthn_credential,omitempty"`
+	// The RegistrationResponseJSON returned by navigator.credentials.create().
+	RegistrationResponse string `protobuf:"bytes,3,opt,name=registration_response,json=registrationResponse,proto3" json:"registration_response,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateWebAuthnCredentialRequest) Reset() {
+	*x = CreateWebAuthnCredentialRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebAuthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebAuthnCredentialRequest) ProtoMessage() {}
+
+func (x *CreateWebAuthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebAuthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebAuthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateWebAuthnCredentialRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateWebAuthnCredentialRequest) GetWebauthnCredential() *WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredential
+	}
+	return nil
+}
+
+func (x *CreateWebAuthnCredentialRequest) GetRegistrationResponse() string {
+	if x != nil {
+		return x.RegistrationResponse
+	}
+	return ""
+}
+
+type ListWebAuthnCredentialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user of the credentials.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebAuthnCredentialsRequest) Reset() {
+	*x = ListWebAuthnCredentialsRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebAuthnCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebAuthnCredentialsRequest) ProtoMessage() {}
+
+func (x *ListWebAuthnCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebAuthnCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebAuthnCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListWebAuthnCredentialsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListWebAuthnCredentialsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credentials of the user.
+	WebauthnCredentials []*WebAuthnCredential `protobuf:"bytes,1,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListWebAuthnCredentialsResponse) Reset() {
+	*x = ListWebAuthnCredentialsResponse{}
+	mi := &file_v1_user_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebAuthnCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebAuthnCredentialsResponse) ProtoMessage() {}
+
+func (x *ListWebAuthnCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebAuthnCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebAuthnCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListWebAuthnCredentialsResponse) GetWebauthnCredentials() []*WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredentials
+	}
+	return nil
+}
+
+type UpdateWebAuthnCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential to update.
+	//
+	// The credential's `name` field is used to identify the credential to update.
+	// Format: users/{user}/webAuthnCredentials/{webauthn_credential}
+	WebauthnCredential *WebAuthnCredential `protobuf:"bytes,1,opt,name=webauthn_credential,json=webauthnCredential,proto3" json:"webauthn_credential,omitempty"`
+	// The list of fields to update, only title is supported.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebAuthnCredentialRequest) Reset() {
+	*x = UpdateWebAuthnCredentialRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebAuthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebAuthnCredentialRequest) ProtoMessage() {}
+
+func (x *UpdateWebAuthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebAuthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebAuthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateWebAuthnCredentialRequest) GetWebauthnCredential() *WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredential
+	}
+	return nil
+}
+
+func (x *UpdateWebAuthnCredentialRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteWebAuthnCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the credential to delete.
+	// Format: users/{user}/webAuthnCredentials/{webauthn_credential}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebAuthnCredentialRequest) Reset() {
+	*x = DeleteWebAuthnCredentialRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebAuthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebAuthnCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteWebAuthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebAuthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebAuthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteWebAuthnCredentialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WebAuthnCredential struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the credential.
+	// Format: users/{user}/webAuthnCredentials/{webauthn_credential}. {webauthn_credential} is the base64url encoded credential id.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the credential, e.g. YubiKey.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The transports of the authenticator, e.g. usb, nfc and internal.
+	Transports    []string               `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	LastUseTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_use_time,json=lastUseTime,proto3" json:"last_use_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
+	mi := &file_v1_user_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthnCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnCredential) ProtoMessage() {}
+
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WebAuthnCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetLastUseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUseTime
+	}
+	return nil
+}
+
+type CreateAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to create the token for.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The token to create.
+	AccessToken   *AccessToken `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenRequest) Reset() {
+	*x = CreateAccessTokenRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenRequest) ProtoMessage() {}
+
+func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateAccessTokenRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenRequest) GetAccessToken() *AccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+type ListAccessTokensRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user of the tokens.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Show the revoked tokens if specified.
+	ShowRevoked   bool `protobuf:"varint,2,opt,name=show_revoked,json=showRevoked,proto3" json:"show_revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessTokensRequest) Reset() {
+	*x = ListAccessTokensRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessTokensRequest) ProtoMessage() {}
+
+func (x *ListAccessTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAccessTokensRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListAccessTokensRequest) GetShowRevoked() bool {
+	if x != nil {
+		return x.ShowRevoked
+	}
+	return false
+}
+
+type ListAccessTokensResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tokens of the user.
+	AccessTokens  []*AccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessTokensResponse) Reset() {
+	*x = ListAccessTokensResponse{}
+	mi := &file_v1_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessTokensResponse) ProtoMessage() {}
+
+func (x *ListAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAccessTokensResponse) GetAccessTokens() []*AccessToken {
+	if x != nil {
+		return x.AccessTokens
+	}
+	return nil
+}
+
+type RevokeAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the token to revoke.
+	// Format: users/{user}/accessTokens/{access_token}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessTokenRequest) Reset() {
+	*x = RevokeAccessTokenRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenRequest) ProtoMessage() {}
+
+func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the token.
+	// Format: users/{user}/accessTokens/{access_token}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the token, e.g. CI pipeline.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The projects the token can access.
+	// Format: projects/{project}
+	// The token can access all projects of the user if empty.
+	Projects []string `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	// The permissions the token can use, e.g. bb.databases.get.
+	// The token can use all permissions of the user if empty.
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// The token never expires if not set.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The secret of the token.
+	// It is only returned when the token is created.
+	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Revoked       bool                   `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
+	mi := &file_v1_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessToken) ProtoMessage() {}
+
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessToken) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AccessToken) GetProjects() []string {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *AccessToken) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccessToken) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *AccessToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AccessToken) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AccessToken) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
 type User_Profile struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	LastLoginTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`
@@ -743,7 +1493,7 @@ type User_Profile struct {
 
 func (x *User_Profile) Reset() {
 	*x = User_Profile{}
-	mi := &file_v1_user_service_proto_msgTypes[10]
+	mi := &file_v1_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -755,7 +1505,7 @@ func (x *User_Profile) String() string {
 func (*User_Profile) ProtoMessage() {}
 
 func (x *User_Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_user_service_proto_msgTypes[10]
+	mi := &file_v1_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1579,7 @@ const file_v1_user_service_proto_rawDesc = "" +
 	"\x11bytebase.com/UserR\x04name\"D\n" +
 	"\x13UndeleteUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
-	"\x11bytebase.com/UserR\x04name\"\x87\x05\n" +
+	"\x11bytebase.com/UserR\x04name\"\xb7\x05\n" +
 	"\x04User\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12(\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x12.bytebase.v1.StateR\x05state\x12\x14\n" +
@@ -846,18 +1596,76 @@ const file_v1_user_service_proto_rawDesc = "" +
 	" \x01(\tR\tmfaSecret\x12%\n" +
 	"\x0erecovery_codes\x18\v \x03(\tR\rrecoveryCodes\x12\x14\n" +
 	"\x05phone\x18\f \x01(\tR\x05phone\x123\n" +
-	"\aprofile\x18\r \x01(\v2\x19.bytebase.v1.User.ProfileR\aprofile\x1a\xbc\x01\n" +
+	"\aprofile\x18\r \x01(\v2\x19.bytebase.v1.User.ProfileR\aprofile\x12.\n" +
+	"\x10webauthn_enabled\x18\x0e \x01(\bB\x03\xe0A\x03R\x0fwebauthnEnabled\x1a\xbc\x01\n" +
 	"\aProfile\x12B\n" +
 	"\x0flast_login_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastLoginTime\x12U\n" +
 	"\x19last_change_password_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x16lastChangePasswordTime\x12\x16\n" +
 	"\x06source\x18\x03 \x01(\tR\x06source:$\xeaA!\n" +
-	"\x11bytebase.com/User\x12\fusers/{user}*T\n" +
+	"\x11bytebase.com/User\x12\fusers/{user}\"[\n" +
+	"&GenerateWebAuthnCreationOptionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\"T\n" +
+	"'GenerateWebAuthnCreationOptionsResponse\x12)\n" +
+	"\x10creation_options\x18\x01 \x01(\tR\x0fcreationOptions\"\xe5\x01\n" +
+	"\x1fCreateWebAuthnCredentialRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\x12U\n" +
+	"\x13webauthn_credential\x18\x02 \x01(\v2\x1f.bytebase.v1.WebAuthnCredentialB\x03\xe0A\x02R\x12webauthnCredential\x128\n" +
+	"\x15registration_response\x18\x03 \x01(\tB\x03\xe0A\x02R\x14registrationResponse\"S\n" +
+	"\x1eListWebAuthnCredentialsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\"u\n" +
+	"\x1fListWebAuthnCredentialsResponse\x12R\n" +
+	"\x14webauthn_credentials\x18\x01 \x03(\v2\x1f.bytebase.v1.WebAuthnCredentialR\x13webauthnCredentials\"\xb5\x01\n" +
+	"\x1fUpdateWebAuthnCredentialRequest\x12U\n" +
+	"\x13webauthn_credential\x18\x01 \x01(\v2\x1f.bytebase.v1.WebAuthnCredentialB\x03\xe0A\x02R\x12webauthnCredential\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"^\n" +
+	"\x1fDeleteWebAuthnCredentialRequest\x12;\n" +
+	"\x04name\x18\x01 \x01(\tB'\xe0A\x02\xfaA!\n" +
+	"\x1fbytebase.com/WebAuthnCredentialR\x04name\"\xcd\x02\n" +
+	"\x12WebAuthnCredential\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tB\x03\xe0A\x03R\n" +
+	"transports\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12C\n" +
+	"\rlast_use_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastUseTime:\\\xeaAY\n" +
+	"\x1fbytebase.com/WebAuthnCredential\x126users/{user}/webAuthnCredentials/{webauthn_credential}\"\x8f\x01\n" +
+	"\x18CreateAccessTokenRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\x12@\n" +
+	"\faccess_token\x18\x02 \x01(\v2\x18.bytebase.v1.AccessTokenB\x03\xe0A\x02R\vaccessToken\"o\n" +
+	"\x17ListAccessTokensRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\x12!\n" +
+	"\fshow_revoked\x18\x02 \x01(\bR\vshowRevoked\"Y\n" +
+	"\x18ListAccessTokensResponse\x12=\n" +
+	"\raccess_tokens\x18\x01 \x03(\v2\x18.bytebase.v1.AccessTokenR\faccessTokens\"P\n" +
+	"\x18RevokeAccessTokenRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18bytebase.com/AccessTokenR\x04name\"\x81\x03\n" +
+	"\vAccessToken\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1a\n" +
+	"\bprojects\x18\x03 \x03(\tR\bprojects\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x19\n" +
+	"\x05token\x18\x06 \x01(\tB\x03\xe0A\x03R\x05token\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x1d\n" +
+	"\arevoked\x18\b \x01(\bB\x03\xe0A\x03R\arevoked:G\xeaAD\n" +
+	"\x18bytebase.com/AccessToken\x12(users/{user}/accessTokens/{access_token}*T\n" +
 	"\bUserType\x12\x19\n" +
 	"\x15USER_TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04USER\x10\x01\x12\x0e\n" +
 	"\n" +
 	"SYSTEM_BOT\x10\x02\x12\x13\n" +
-	"\x0fSERVICE_ACCOUNT\x10\x032\x85\a\n" +
+	"\x0fSERVICE_ACCOUNT\x10\x032\xda\x12\n" +
 	"\vUserService\x12`\n" +
 	"\aGetUser\x12\x1b.bytebase.v1.GetUserRequest\x1a\x11.bytebase.v1.User\"%\xdaA\x04name\x90\xea0\x02\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/{name=users/*}\x12v\n" +
 	"\rBatchGetUsers\x12!.bytebase.v1.BatchGetUsersRequest\x1a\".bytebase.v1.BatchGetUsersResponse\"\x1e\x90\xea0\x02\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users:batchGet\x12Y\n" +
@@ -869,7 +1677,15 @@ const file_v1_user_service_proto_rawDesc = "" +
 	"UpdateUser\x12\x1e.bytebase.v1.UpdateUserRequest\x1a\x11.bytebase.v1.User\"@\xdaA\x10user,update_mask\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02\x1f:\x04user2\x17/v1/{user.name=users/*}\x12o\n" +
 	"\n" +
 	"DeleteUser\x12\x1e.bytebase.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02\x14*\x12/v1/{name=users/*}\x12s\n" +
-	"\fUndeleteUser\x12 .bytebase.v1.UndeleteUserRequest\x1a\x11.bytebase.v1.User\".\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/{name=users/*}:undeleteB6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
+	"\fUndeleteUser\x12 .bytebase.v1.UndeleteUserRequest\x1a\x11.bytebase.v1.User\".\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/{name=users/*}:undelete\x12\xdd\x01\n" +
+	"\x1fGenerateWebAuthnCreationOptions\x123.bytebase.v1.GenerateWebAuthnCreationOptionsRequest\x1a4.bytebase.v1.GenerateWebAuthnCreationOptionsResponse\"O\x90\xea0\x02\x82\xd3\xe4\x93\x02E:\x01*\"@/v1/{parent=users/*}/webAuthnCredentials:generateCreationOptions\x12\xc3\x01\n" +
+	"\x18CreateWebAuthnCredential\x12,.bytebase.v1.CreateWebAuthnCredentialRequest\x1a\x1f.bytebase.v1.WebAuthnCredential\"X\xdaA\x1aparent,webauthn_credential\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/{parent=users/*}/webAuthnCredentials\x12\xb3\x01\n" +
+	"\x17ListWebAuthnCredentials\x12+.bytebase.v1.ListWebAuthnCredentialsRequest\x1a,.bytebase.v1.ListWebAuthnCredentialsResponse\"=\xdaA\x06parent\x90\xea0\x02\x82\xd3\xe4\x93\x02*\x12(/v1/{parent=users/*}/webAuthnCredentials\x12\xef\x01\n" +
+	"\x18UpdateWebAuthnCredential\x12,.bytebase.v1.UpdateWebAuthnCredentialRequest\x1a\x1f.bytebase.v1.WebAuthnCredential\"\x83\x01\xdaA\x1fwebauthn_credential,update_mask\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02S:\x13webauthn_credential2</v1/{webauthn_credential.name=users/*/webAuthnCredentials/*}\x12\xa1\x01\n" +
+	"\x18DeleteWebAuthnCredential\x12,.bytebase.v1.DeleteWebAuthnCredentialRequest\x1a\x16.google.protobuf.Empty\"?\xdaA\x04name\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02**(/v1/{name=users/*/webAuthnCredentials/*}\x12\xab\x01\n" +
+	"\x11CreateAccessToken\x12%.bytebase.v1.CreateAccessTokenRequest\x1a\x18.bytebase.v1.AccessToken\"U\xdaA\x13parent,access_token\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x021:\faccess_token\"!/v1/{parent=users/*}/accessTokens\x12\x97\x01\n" +
+	"\x10ListAccessTokens\x12$.bytebase.v1.ListAccessTokensRequest\x1a%.bytebase.v1.ListAccessTokensResponse\"6\xdaA\x06parent\x90\xea0\x02\x82\xd3\xe4\x93\x02#\x12!/v1/{parent=users/*}/accessTokens\x12\x98\x01\n" +
+	"\x11RevokeAccessToken\x12%.bytebase.v1.RevokeAccessTokenRequest\x1a\x18.bytebase.v1.AccessToken\"B\xdaA\x04name\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/{name=users/*/accessTokens/*}:revokeB6Z4github.com/bytebase/bytebase/backend/generated-go/v1b\x06proto3"
 
 var (
 	file_v1_user_service_proto_rawDescOnce sync.Once
@@ -884,57 +1700,96 @@ func file_v1_user_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_v1_user_service_proto_goTypes = []any{
-	(UserType)(0),                 // 0: bytebase.v1.UserType
-	(*GetUserRequest)(nil),        // 1: bytebase.v1.GetUserRequest
-	(*BatchGetUsersRequest)(nil),  // 2: bytebase.v1.BatchGetUsersRequest
-	(*BatchGetUsersResponse)(nil), // 3: bytebase.v1.BatchGetUsersResponse
-	(*ListUsersRequest)(nil),      // 4: bytebase.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 5: bytebase.v1.ListUsersResponse
-	(*CreateUserRequest)(nil),     // 6: bytebase.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),     // 7: bytebase.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),     // 8: bytebase.v1.DeleteUserRequest
-	(*UndeleteUserRequest)(nil),   // 9: bytebase.v1.UndeleteUserRequest
-	(*User)(nil),                  // 10: bytebase.v1.User
-	(*User_Profile)(nil),          // 11: bytebase.v1.User.Profile
-	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
-	(State)(0),                    // 13: bytebase.v1.State
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 15: google.protobuf.Empty
+	(UserType)(0),                                   // 0: bytebase.v1.UserType
+	(*GetUserRequest)(nil),                          // 1: bytebase.v1.GetUserRequest
+	(*BatchGetUsersRequest)(nil),                    // 2: bytebase.v1.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),                   // 3: bytebase.v1.BatchGetUsersResponse
+	(*ListUsersRequest)(nil),                        // 4: bytebase.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                       // 5: bytebase.v1.ListUsersResponse
+	(*CreateUserRequest)(nil),                       // 6: bytebase.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                       // 7: bytebase.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                       // 8: bytebase.v1.DeleteUserRequest
+	(*UndeleteUserRequest)(nil),                     // 9: bytebase.v1.UndeleteUserRequest
+	(*User)(nil),                                    // 10: bytebase.v1.User
+	(*GenerateWebAuthnCreationOptionsRequest)(nil),  // 11: bytebase.v1.GenerateWebAuthnCreationOptionsRequest
+	(*GenerateWebAuthnCreationOptionsResponse)(nil), // 12: bytebase.v1.GenerateWebAuthnCreationOptionsResponse
+	(*CreateWebAuthnCredentialRequest)(nil),         // 13: bytebase.v1.CreateWebAuthnCredentialRequest
+	(*ListWebAuthnCredentialsRequest)(nil),          // 14: bytebase.v1.ListWebAuthnCredentialsRequest
+	(*ListWebAuthnCredentialsResponse)(nil),         // 15: bytebase.v1.ListWebAuthnCredentialsResponse
+	(*UpdateWebAuthnCredentialRequest)(nil),         // 16: bytebase.v1.UpdateWebAuthnCredentialRequest
+	(*DeleteWebAuthnCredentialRequest)(nil),         // 17: bytebase.v1.DeleteWebAuthnCredentialRequest
+	(*WebAuthnCredential)(nil),                      // 18: bytebase.v1.WebAuthnCredential
+	(*CreateAccessTokenRequest)(nil),                // 19: bytebase.v1.CreateAccessTokenRequest
+	(*ListAccessTokensRequest)(nil),                 // 20: bytebase.v1.ListAccessTokensRequest
+	(*ListAccessTokensResponse)(nil),                // 21: bytebase.v1.ListAccessTokensResponse
+	(*RevokeAccessTokenRequest)(nil),                // 22: bytebase.v1.RevokeAccessTokenRequest
+	(*AccessToken)(nil),                             // 23: bytebase.v1.AccessToken
+	(*User_Profile)(nil),                            // 24: bytebase.v1.User.Profile
+	(*fieldmaskpb.FieldMask)(nil),                   // 25: google.protobuf.FieldMask
+	(State)(0),                                      // 26: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),                   // 27: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                           // 28: google.protobuf.Empty
 }
 var file_v1_user_service_proto_depIdxs = []int32{
 	10, // 0: bytebase.v1.BatchGetUsersResponse.users:type_name -> bytebase.v1.User
 	10, // 1: bytebase.v1.ListUsersResponse.users:type_name -> bytebase.v1.User
 	10, // 2: bytebase.v1.CreateUserRequest.user:type_name -> bytebase.v1.User
 	10, // 3: bytebase.v1.UpdateUserRequest.user:type_name -> bytebase.v1.User
-	12, // 4: bytebase.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	13, // 5: bytebase.v1.User.state:type_name -> bytebase.v1.State
+	25, // 4: bytebase.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	26, // 5: bytebase.v1.User.state:type_name -> bytebase.v1.State
 	0,  // 6: bytebase.v1.User.user_type:type_name -> bytebase.v1.UserType
-	11, // 7: bytebase.v1.User.profile:type_name -> bytebase.v1.User.Profile
-	14, // 8: bytebase.v1.User.Profile.last_login_time:type_name -> google.protobuf.Timestamp
-	14, // 9: bytebase.v1.User.Profile.last_change_password_time:type_name -> google.protobuf.Timestamp
-	1,  // 10: bytebase.v1.UserService.GetUser:input_type -> bytebase.v1.GetUserRequest
-	2,  // 11: bytebase.v1.UserService.BatchGetUsers:input_type -> bytebase.v1.BatchGetUsersRequest
-	15, // 12: bytebase.v1.UserService.GetCurrentUser:input_type -> google.protobuf.Empty
-	4,  // 13: bytebase.v1.UserService.ListUsers:input_type -> bytebase.v1.ListUsersRequest
-	6,  // 14: bytebase.v1.UserService.CreateUser:input_type -> bytebase.v1.CreateUserRequest
-	7,  // 15: bytebase.v1.UserService.UpdateUser:input_type -> bytebase.v1.UpdateUserRequest
-	8,  // 16: bytebase.v1.UserService.DeleteUser:input_type -> bytebase.v1.DeleteUserRequest
-	9,  // 17: bytebase.v1.UserService.UndeleteUser:input_type -> bytebase.v1.UndeleteUserRequest
-	10, // 18: bytebase.v1.UserService.GetUser:output_type -> bytebase.v1.User
-	3,  // 19: bytebase.v1.UserService.BatchGetUsers:output_type -> bytebase.v1.BatchGetUsersResponse
-	10, // 20: bytebase.v1.UserService.GetCurrentUser:output_type -> bytebase.v1.User
-	5,  // 21: bytebase.v1.UserService.ListUsers:output_type -> bytebase.v1.ListUsersResponse
-	10, // 22: bytebase.v1.UserService.CreateUser:output_type -> bytebase.v1.User
-	10, // 23: bytebase.v1.UserService.UpdateUser:output_type -> bytebase.v1.User
-	15, // 24: bytebase.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	10, // 25: bytebase.v1.UserService.UndeleteUser:output_type -> bytebase.v1.User
-	18, // [18:26] is the sub-list for method output_type
-	10, // [10:18] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	24, // 7: bytebase.v1.User.profile:type_name -> bytebase.v1.User.Profile
+	18, // 8: bytebase.v1.CreateWebAuthnCredentialRequest.webauthn_credential:type_name -> bytebase.v1.WebAuthnCredential
+	18, // 9: bytebase.v1.ListWebAuthnCredentialsResponse.webauthn_credentials:type_name -> bytebase.v1.WebAuthnCredential
+	18, // 10: bytebase.v1.UpdateWebAuthnCredentialRequest.webauthn_credential:type_name -> bytebase.v1.WebAuthnCredential
+	25, // 11: bytebase.v1.UpdateWebAuthnCredentialRequest.update_mask:type_name -> google.protobuf.FieldMask
+	27, // 12: bytebase.v1.WebAuthnCredential.create_time:type_name -> google.protobuf.Timestamp
+	27, // 13: bytebase.v1.WebAuthnCredential.last_use_time:type_name -> google.protobuf.Timestamp
+	23, // 14: bytebase.v1.CreateAccessTokenRequest.access_token:type_name -> bytebase.v1.AccessToken
+	23, // 15: bytebase.v1.ListAccessTokensResponse.access_tokens:type_name -> bytebase.v1.AccessToken
+	27, // 16: bytebase.v1.AccessToken.expire_time:type_name -> google.protobuf.Timestamp
+	27, // 17: bytebase.v1.AccessToken.create_time:type_name -> google.protobuf.Timestamp
+	27, // 18: bytebase.v1.User.Profile.last_login_time:type_name -> google.protobuf.Timestamp
+	27, // 19: bytebase.v1.User.Profile.last_change_password_time:type_name -> google.protobuf.Timestamp
+	1,  // 20: bytebase.v1.UserService.GetUser:input_type -> bytebase.v1.GetUserRequest
+	2,  // 21: bytebase.v1.UserService.BatchGetUsers:input_type -> bytebase.v1.BatchGetUsersRequest
+	28, // 22: bytebase.v1.UserService.GetCurrentUser:input_type -> google.protobuf.Empty
+	4,  // 23: bytebase.v1.UserService.ListUsers:input_type -> bytebase.v1.ListUsersRequest
+	6,  // 24: bytebase.v1.UserService.CreateUser:input_type -> bytebase.v1.CreateUserRequest
+	7,  // 25: bytebase.v1.UserService.UpdateUser:input_type -> bytebase.v1.UpdateUserRequest
+	8,  // 26: bytebase.v1.UserService.DeleteUser:input_type -> bytebase.v1.DeleteUserRequest
+	9,  // 27: bytebase.v1.UserService.UndeleteUser:input_type -> bytebase.v1.UndeleteUserRequest
+	11, // 28: bytebase.v1.UserService.GenerateWebAuthnCreationOptions:input_type -> bytebase.v1.GenerateWebAuthnCreationOptionsRequest
+	13, // 29: bytebase.v1.UserService.CreateWebAuthnCredential:input_type -> bytebase.v1.CreateWebAuthnCredentialRequest
+	14, // 30: bytebase.v1.UserService.ListWebAuthnCredentials:input_type -> bytebase.v1.ListWebAuthnCredentialsRequest
+	16, // 31: bytebase.v1.UserService.UpdateWebAuthnCredential:input_type -> bytebase.v1.UpdateWebAuthnCredentialRequest
+	17, // 32: bytebase.v1.UserService.DeleteWebAuthnCredential:input_type -> bytebase.v1.DeleteWebAuthnCredentialRequest
+	19, // 33: bytebase.v1.UserService.CreateAccessToken:input_type -> bytebase.v1.CreateAccessTokenRequest
+	20, // 34: bytebase.v1.UserService.ListAccessTokens:input_type -> bytebase.v1.ListAccessTokensRequest
+	22, // 35: bytebase.v1.UserService.RevokeAccessToken:input_type -> bytebase.v1.RevokeAccessTokenRequest
+	10, // 36: bytebase.v1.UserService.GetUser:output_type -> bytebase.v1.User
+	3,  // 37: bytebase.v1.UserService.BatchGetUsers:output_type -> bytebase.v1.BatchGetUsersResponse
+	10, // 38: bytebase.v1.UserService.GetCurrentUser:output_type -> bytebase.v1.User
+	5,  // 39: bytebase.v1.UserService.ListUsers:output_type -> bytebase.v1.ListUsersResponse
+	10, // 40: bytebase.v1.UserService.CreateUser:output_type -> bytebase.v1.User
+	10, // 41: bytebase.v1.UserService.UpdateUser:output_type -> bytebase.v1.User
+	28, // 42: bytebase.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	10, // 43: bytebase.v1.UserService.UndeleteUser:output_type -> bytebase.v1.User
+	12, // 44: bytebase.v1.UserService.GenerateWebAuthnCreationOptions:output_type -> bytebase.v1.GenerateWebAuthnCreationOptionsResponse
+	18, // 45: bytebase.v1.UserService.CreateWebAuthnCredential:output_type -> bytebase.v1.WebAuthnCredential
+	15, // 46: bytebase.v1.UserService.ListWebAuthnCredentials:output_type -> bytebase.v1.ListWebAuthnCredentialsResponse
+	18, // 47: bytebase.v1.UserService.UpdateWebAuthnCredential:output_type -> bytebase.v1.WebAuthnCredential
+	28, // 48: bytebase.v1.UserService.DeleteWebAuthnCredential:output_type -> google.protobuf.Empty
+	23, // 49: bytebase.v1.UserService.CreateAccessToken:output_type -> bytebase.v1.AccessToken
+	21, // 50: bytebase.v1.UserService.ListAccessTokens:output_type -> bytebase.v1.ListAccessTokensResponse
+	23, // 51: bytebase.v1.UserService.RevokeAccessToken:output_type -> bytebase.v1.AccessToken
+	36, // [36:52] is the sub-list for method output_type
+	20, // [20:36] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_v1_user_service_proto_init() }
@@ -951,7 +1806,7 @@ func file_v1_user_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_service_proto_rawDesc), len(file_v1_user_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
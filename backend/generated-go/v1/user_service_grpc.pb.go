@@ -20,14 +20,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUser_FullMethodName        = "/bytebase.v1.UserService/GetUser"
-	UserService_BatchGetUsers_FullMethodName  = "/bytebase.v1.UserService/BatchGetUsers"
-	UserService_GetCurrentUser_FullMethodName = "/bytebase.v1.UserService/GetCurrentUser"
-	UserService_ListUsers_FullMethodName      = "/bytebase.v1.UserService/ListUsers"
-	UserService_CreateUser_FullMethodName     = "/bytebase.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName     = "/bytebase.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName     = "/bytebase.v1.UserService/DeleteUser"
-	UserService_UndeleteUser_FullMethodName   = "/bytebase.v1.UserService/UndeleteUser"
+	UserService_GetUser_FullMethodName                         = "/bytebase.v1.UserService/GetUser"
+	UserService_BatchGetUsers_FullMethodName                   = "/bytebase.v1.UserService/BatchGetUsers"
+	UserService_GetCurrentUser_FullMethodName                  = "/bytebase.v1.UserService/GetCurrentUser"
+	UserService_ListUsers_FullMethodName                       = "/bytebase.v1.UserService/ListUsers"
+	UserService_CreateUser_FullMethodName                      = "/bytebase.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                      = "/bytebase.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                      = "/bytebase.v1.UserService/DeleteUser"
+	UserService_UndeleteUser_FullMethodName                    = "/bytebase.v1.UserService/UndeleteUser"
+	UserService_GenerateWebAuthnCreationOptions_FullMethodName = "/bytebase.v1.UserService/GenerateWebAuthnCreationOptions"
+	UserService_CreateWebAuthnCredential_FullMethodName        = "/bytebase.v1.UserService/CreateWebAuthnCredential"
+	UserService_ListWebAuthnCredentials_FullMethodName         = "/bytebase.v1.UserService/ListWebAuthnCredentials"
+	UserService_UpdateWebAuthnCredential_FullMethodName        = "/bytebase.v1.UserService/UpdateWebAuthnCredential"
+	UserService_DeleteWebAuthnCredential_FullMethodName        = "/bytebase.v1.UserService/DeleteWebAuthnCredential"
+	UserService_CreateAccessToken_FullMethodName               = "/bytebase.v1.UserService/CreateAccessToken"
+	UserService_ListAccessTokens_FullMethodName                = "/bytebase.v1.UserService/ListAccessTokens"
+	UserService_RevokeAccessToken_FullMethodName               = "/bytebase.v1.UserService/RevokeAccessToken"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -64,6 +72,38 @@ type UserServiceClient interface {
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	// Permissions required: bb.users.undelete
 	UndeleteUser(ctx context.Context, in *UndeleteUserRequest, opts ...grpc.CallOption) (*User, error)
+	// Generate the PublicKeyCredentialCreationOptionsJSON to register a WebAuthn credential.
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	GenerateWebAuthnCreationOptions(ctx context.Context, in *GenerateWebAuthnCreationOptionsRequest, opts ...grpc.CallOption) (*GenerateWebAuthnCreationOptionsResponse, error)
+	// Register a WebAuthn credential with the response of navigator.credentials.create().
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	CreateWebAuthnCredential(ctx context.Context, in *CreateWebAuthnCredentialRequest, opts ...grpc.CallOption) (*WebAuthnCredential, error)
+	// List the WebAuthn credentials of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the credentials.
+	// Permissions required: bb.users.update
+	ListWebAuthnCredentials(ctx context.Context, in *ListWebAuthnCredentialsRequest, opts ...grpc.CallOption) (*ListWebAuthnCredentialsResponse, error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can update the credential.
+	// Permissions required: bb.users.update
+	UpdateWebAuthnCredential(ctx context.Context, in *UpdateWebAuthnCredentialRequest, opts ...grpc.CallOption) (*WebAuthnCredential, error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can delete the credential,
+	// e.g. the workspace admin removes the lost security key of the user.
+	// Permissions required: bb.users.update
+	DeleteWebAuthnCredential(ctx context.Context, in *DeleteWebAuthnCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create a personal access token for the user.
+	// The token is scoped to the projects and permissions, and it is only returned once in the response.
+	// Only the user itself can create the token for an end user. The user with bb.users.update permission on the workspace can also create the token for a service account.
+	// Permissions required: bb.users.update
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error)
+	// List the personal access tokens of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the tokens.
+	// Permissions required: bb.users.update
+	ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error)
+	// Revoke the personal access token. The token cannot be used after it is revoked.
+	// Only the user itself and the user with bb.users.update permission on the workspace can revoke the token.
+	// Permissions required: bb.users.update
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error)
 }
 
 type userServiceClient struct {
@@ -154,6 +194,86 @@ func (c *userServiceClient) UndeleteUser(ctx context.Context, in *UndeleteUserRe
 	return out, nil
 }
 
+func (c *userServiceClient) GenerateWebAuthnCreationOptions(ctx context.Context, in *GenerateWebAuthnCreationOptionsRequest, opts ...grpc.CallOption) (*GenerateWebAuthnCreationOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateWebAuthnCreationOptionsResponse)
+	err := c.cc.Invoke(ctx, UserService_GenerateWebAuthnCreationOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateWebAuthnCredential(ctx context.Context, in *CreateWebAuthnCredentialRequest, opts ...grpc.CallOption) (*WebAuthnCredential, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebAuthnCredential)
+	err := c.cc.Invoke(ctx, UserService_CreateWebAuthnCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListWebAuthnCredentials(ctx context.Context, in *ListWebAuthnCredentialsRequest, opts ...grpc.CallOption) (*ListWebAuthnCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebAuthnCredentialsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListWebAuthnCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateWebAuthnCredential(ctx context.Context, in *UpdateWebAuthnCredentialRequest, opts ...grpc.CallOption) (*WebAuthnCredential, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebAuthnCredential)
+	err := c.cc.Invoke(ctx, UserService_UpdateWebAuthnCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteWebAuthnCredential(ctx context.Context, in *DeleteWebAuthnCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteWebAuthnCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessToken)
+	err := c.cc.Invoke(ctx, UserService_CreateAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessTokensResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAccessTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessToken)
+	err := c.cc.Invoke(ctx, UserService_RevokeAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -188,6 +308,38 @@ type UserServiceServer interface {
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	// Permissions required: bb.users.undelete
 	UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error)
+	// Generate the PublicKeyCredentialCreationOptionsJSON to register a WebAuthn credential.
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	GenerateWebAuthnCreationOptions(context.Context, *GenerateWebAuthnCreationOptionsRequest) (*GenerateWebAuthnCreationOptionsResponse, error)
+	// Register a WebAuthn credential with the response of navigator.credentials.create().
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	CreateWebAuthnCredential(context.Context, *CreateWebAuthnCredentialRequest) (*WebAuthnCredential, error)
+	// List the WebAuthn credentials of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the credentials.
+	// Permissions required: bb.users.update
+	ListWebAuthnCredentials(context.Context, *ListWebAuthnCredentialsRequest) (*ListWebAuthnCredentialsResponse, error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can update the credential.
+	// Permissions required: bb.users.update
+	UpdateWebAuthnCredential(context.Context, *UpdateWebAuthnCredentialRequest) (*WebAuthnCredential, error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can delete the credential,
+	// e.g. the workspace admin removes the lost security key of the user.
+	// Permissions required: bb.users.update
+	DeleteWebAuthnCredential(context.Context, *DeleteWebAuthnCredentialRequest) (*emptypb.Empty, error)
+	// Create a personal access token for the user.
+	// The token is scoped to the projects and permissions, and it is only returned once in the response.
+	// Only the user itself can create the token for an end user. The user with bb.users.update permission on the workspace can also create the token for a service account.
+	// Permissions required: bb.users.update
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*AccessToken, error)
+	// List the personal access tokens of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the tokens.
+	// Permissions required: bb.users.update
+	ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error)
+	// Revoke the personal access token. The token cannot be used after it is revoked.
+	// Only the user itself and the user with bb.users.update permission on the workspace can revoke the token.
+	// Permissions required: bb.users.update
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*AccessToken, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -222,6 +374,30 @@ func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserReq
 func (UnimplementedUserServiceServer) UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) GenerateWebAuthnCreationOptions(context.Context, *GenerateWebAuthnCreationOptionsRequest) (*GenerateWebAuthnCreationOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWebAuthnCreationOptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateWebAuthnCredential(context.Context, *CreateWebAuthnCredentialRequest) (*WebAuthnCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebAuthnCredential not implemented")
+}
+func (UnimplementedUserServiceServer) ListWebAuthnCredentials(context.Context, *ListWebAuthnCredentialsRequest) (*ListWebAuthnCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebAuthnCredentials not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateWebAuthnCredential(context.Context, *UpdateWebAuthnCredentialRequest) (*WebAuthnCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebAuthnCredential not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteWebAuthnCredential(context.Context, *DeleteWebAuthnCredentialRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebAuthnCredential not implemented")
+}
+func (UnimplementedUserServiceServer) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*AccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedUserServiceServer) ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessTokens not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*AccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -387,6 +563,150 @@ func _UserService_UndeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GenerateWebAuthnCreationOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateWebAuthnCreationOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GenerateWebAuthnCreationOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GenerateWebAuthnCreationOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GenerateWebAuthnCreationOptions(ctx, req.(*GenerateWebAuthnCreationOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebAuthnCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateWebAuthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateWebAuthnCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateWebAuthnCredential(ctx, req.(*CreateWebAuthnCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListWebAuthnCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebAuthnCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListWebAuthnCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListWebAuthnCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListWebAuthnCredentials(ctx, req.(*ListWebAuthnCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebAuthnCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateWebAuthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateWebAuthnCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateWebAuthnCredential(ctx, req.(*UpdateWebAuthnCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebAuthnCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteWebAuthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteWebAuthnCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteWebAuthnCredential(ctx, req.(*DeleteWebAuthnCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAccessTokens(ctx, req.(*ListAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAccessToken(ctx, req.(*RevokeAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -426,6 +746,38 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UndeleteUser",
 			Handler:    _UserService_UndeleteUser_Handler,
 		},
+		{
+			MethodName: "GenerateWebAuthnCreationOptions",
+			Handler:    _UserService_GenerateWebAuthnCreationOptions_Handler,
+		},
+		{
+			MethodName: "CreateWebAuthnCredential",
+			Handler:    _UserService_CreateWebAuthnCredential_Handler,
+		},
+		{
+			MethodName: "ListWebAuthnCredentials",
+			Handler:    _UserService_ListWebAuthnCredentials_Handler,
+		},
+		{
+			MethodName: "UpdateWebAuthnCredential",
+			Handler:    _UserService_UpdateWebAuthnCredential_Handler,
+		},
+		{
+			MethodName: "DeleteWebAuthnCredential",
+			Handler:    _UserService_DeleteWebAuthnCredential_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _UserService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _UserService_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _UserService_RevokeAccessToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/user_service.proto",
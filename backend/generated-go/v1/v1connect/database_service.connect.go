@@ -61,6 +61,9 @@ const (
 	// DatabaseServiceGetDatabaseSchemaProcedure is the fully-qualified name of the DatabaseService's
 	// GetDatabaseSchema RPC.
 	DatabaseServiceGetDatabaseSchemaProcedure = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	// DatabaseServiceGetDatabaseDriftReportProcedure is the fully-qualified name of the
+	// DatabaseService's GetDatabaseDriftReport RPC.
+	DatabaseServiceGetDatabaseDriftReportProcedure = "/bytebase.v1.DatabaseService/GetDatabaseDriftReport"
 	// DatabaseServiceDiffSchemaProcedure is the fully-qualified name of the DatabaseService's
 	// DiffSchema RPC.
 	DatabaseServiceDiffSchemaProcedure = "/bytebase.v1.DatabaseService/DiffSchema"
@@ -105,6 +108,8 @@ type DatabaseServiceClient interface {
 	// Permissions required: bb.databases.getSchema
 	GetDatabaseSchema(context.Context, *connect.Request[v1.GetDatabaseSchemaRequest]) (*connect.Response[v1.DatabaseSchema], error)
 	// Permissions required: bb.databases.get
+	GetDatabaseDriftReport(context.Context, *connect.Request[v1.GetDatabaseDriftReportRequest]) (*connect.Response[v1.DatabaseDriftReport], error)
+	// Permissions required: bb.databases.get
 	DiffSchema(context.Context, *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error)
 	// Permissions required: bb.databaseSecrets.list
 	ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error)
@@ -185,6 +190,12 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseSchema")),
 			connect.WithClientOptions(opts...),
 		),
+		getDatabaseDriftReport: connect.NewClient[v1.GetDatabaseDriftReportRequest, v1.DatabaseDriftReport](
+			httpClient,
+			baseURL+DatabaseServiceGetDatabaseDriftReportProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseDriftReport")),
+			connect.WithClientOptions(opts...),
+		),
 		diffSchema: connect.NewClient[v1.DiffSchemaRequest, v1.DiffSchemaResponse](
 			httpClient,
 			baseURL+DatabaseServiceDiffSchemaProcedure,
@@ -232,22 +243,23 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 
 // databaseServiceClient implements DatabaseServiceClient.
 type databaseServiceClient struct {
-	getDatabase          *connect.Client[v1.GetDatabaseRequest, v1.Database]
-	batchGetDatabases    *connect.Client[v1.BatchGetDatabasesRequest, v1.BatchGetDatabasesResponse]
-	listDatabases        *connect.Client[v1.ListDatabasesRequest, v1.ListDatabasesResponse]
-	updateDatabase       *connect.Client[v1.UpdateDatabaseRequest, v1.Database]
-	batchUpdateDatabases *connect.Client[v1.BatchUpdateDatabasesRequest, v1.BatchUpdateDatabasesResponse]
-	syncDatabase         *connect.Client[v1.SyncDatabaseRequest, v1.SyncDatabaseResponse]
-	batchSyncDatabases   *connect.Client[v1.BatchSyncDatabasesRequest, v1.BatchSyncDatabasesResponse]
-	getDatabaseMetadata  *connect.Client[v1.GetDatabaseMetadataRequest, v1.DatabaseMetadata]
-	getDatabaseSchema    *connect.Client[v1.GetDatabaseSchemaRequest, v1.DatabaseSchema]
-	diffSchema           *connect.Client[v1.DiffSchemaRequest, v1.DiffSchemaResponse]
-	listSecrets          *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
-	updateSecret         *connect.Client[v1.UpdateSecretRequest, v1.Secret]
-	deleteSecret         *connect.Client[v1.DeleteSecretRequest, emptypb.Empty]
-	listChangelogs       *connect.Client[v1.ListChangelogsRequest, v1.ListChangelogsResponse]
-	getChangelog         *connect.Client[v1.GetChangelogRequest, v1.Changelog]
-	getSchemaString      *connect.Client[v1.GetSchemaStringRequest, v1.GetSchemaStringResponse]
+	getDatabase            *connect.Client[v1.GetDatabaseRequest, v1.Database]
+	batchGetDatabases      *connect.Client[v1.BatchGetDatabasesRequest, v1.BatchGetDatabasesResponse]
+	listDatabases          *connect.Client[v1.ListDatabasesRequest, v1.ListDatabasesResponse]
+	updateDatabase         *connect.Client[v1.UpdateDatabaseRequest, v1.Database]
+	batchUpdateDatabases   *connect.Client[v1.BatchUpdateDatabasesRequest, v1.BatchUpdateDatabasesResponse]
+	syncDatabase           *connect.Client[v1.SyncDatabaseRequest, v1.SyncDatabaseResponse]
+	batchSyncDatabases     *connect.Client[v1.BatchSyncDatabasesRequest, v1.BatchSyncDatabasesResponse]
+	getDatabaseMetadata    *connect.Client[v1.GetDatabaseMetadataRequest, v1.DatabaseMetadata]
+	getDatabaseSchema      *connect.Client[v1.GetDatabaseSchemaRequest, v1.DatabaseSchema]
+	getDatabaseDriftReport *connect.Client[v1.GetDatabaseDriftReportRequest, v1.DatabaseDriftReport]
+	diffSchema             *connect.Client[v1.DiffSchemaRequest, v1.DiffSchemaResponse]
+	listSecrets            *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
+	updateSecret           *connect.Client[v1.UpdateSecretRequest, v1.Secret]
+	deleteSecret           *connect.Client[v1.DeleteSecretRequest, emptypb.Empty]
+	listChangelogs         *connect.Client[v1.ListChangelogsRequest, v1.ListChangelogsResponse]
+	getChangelog           *connect.Client[v1.GetChangelogRequest, v1.Changelog]
+	getSchemaString        *connect.Client[v1.GetSchemaStringRequest, v1.GetSchemaStringResponse]
 }
 
 // GetDatabase calls bytebase.v1.DatabaseService.GetDatabase.
@@ -295,6 +307,11 @@ func (c *databaseServiceClient) GetDatabaseSchema(ctx context.Context, req *conn
 	return c.getDatabaseSchema.CallUnary(ctx, req)
 }
 
+// GetDatabaseDriftReport calls bytebase.v1.DatabaseService.GetDatabaseDriftReport.
+func (c *databaseServiceClient) GetDatabaseDriftReport(ctx context.Context, req *connect.Request[v1.GetDatabaseDriftReportRequest]) (*connect.Response[v1.DatabaseDriftReport], error) {
+	return c.getDatabaseDriftReport.CallUnary(ctx, req)
+}
+
 // DiffSchema calls bytebase.v1.DatabaseService.DiffSchema.
 func (c *databaseServiceClient) DiffSchema(ctx context.Context, req *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error) {
 	return c.diffSchema.CallUnary(ctx, req)
@@ -351,6 +368,8 @@ type DatabaseServiceHandler interface {
 	// Permissions required: bb.databases.getSchema
 	GetDatabaseSchema(context.Context, *connect.Request[v1.GetDatabaseSchemaRequest]) (*connect.Response[v1.DatabaseSchema], error)
 	// Permissions required: bb.databases.get
+	GetDatabaseDriftReport(context.Context, *connect.Request[v1.GetDatabaseDriftReportRequest]) (*connect.Response[v1.DatabaseDriftReport], error)
+	// Permissions required: bb.databases.get
 	DiffSchema(context.Context, *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error)
 	// Permissions required: bb.databaseSecrets.list
 	ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error)
@@ -427,6 +446,12 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseSchema")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceGetDatabaseDriftReportHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetDatabaseDriftReportProcedure,
+		svc.GetDatabaseDriftReport,
+		connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseDriftReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceDiffSchemaHandler := connect.NewUnaryHandler(
 		DatabaseServiceDiffSchemaProcedure,
 		svc.DiffSchema,
@@ -489,6 +514,8 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceGetDatabaseMetadataHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetDatabaseSchemaProcedure:
 			databaseServiceGetDatabaseSchemaHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetDatabaseDriftReportProcedure:
+			databaseServiceGetDatabaseDriftReportHandler.ServeHTTP(w, r)
 		case DatabaseServiceDiffSchemaProcedure:
 			databaseServiceDiffSchemaHandler.ServeHTTP(w, r)
 		case DatabaseServiceListSecretsProcedure:
@@ -548,6 +575,10 @@ func (UnimplementedDatabaseServiceHandler) GetDatabaseSchema(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetDatabaseSchema is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) GetDatabaseDriftReport(context.Context, *connect.Request[v1.GetDatabaseDriftReportRequest]) (*connect.Response[v1.DatabaseDriftReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetDatabaseDriftReport is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) DiffSchema(context.Context, *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.DiffSchema is not implemented"))
 }
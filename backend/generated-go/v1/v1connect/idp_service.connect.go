@@ -52,6 +52,9 @@ const (
 	// IdentityProviderServiceTestIdentityProviderProcedure is the fully-qualified name of the
 	// IdentityProviderService's TestIdentityProvider RPC.
 	IdentityProviderServiceTestIdentityProviderProcedure = "/bytebase.v1.IdentityProviderService/TestIdentityProvider"
+	// IdentityProviderServiceSyncLDAPGroupsProcedure is the fully-qualified name of the
+	// IdentityProviderService's SyncLDAPGroups RPC.
+	IdentityProviderServiceSyncLDAPGroupsProcedure = "/bytebase.v1.IdentityProviderService/SyncLDAPGroups"
 )
 
 // IdentityProviderServiceClient is a client for the bytebase.v1.IdentityProviderService service.
@@ -68,6 +71,9 @@ type IdentityProviderServiceClient interface {
 	DeleteIdentityProvider(context.Context, *connect.Request[v1.DeleteIdentityProviderRequest]) (*connect.Response[emptypb.Empty], error)
 	// Permissions required: bb.identityProviders.update
 	TestIdentityProvider(context.Context, *connect.Request[v1.TestIdentityProviderRequest]) (*connect.Response[v1.TestIdentityProviderResponse], error)
+	// Synchronizes the LDAP groups into Bytebase groups.
+	// Permissions required: bb.identityProviders.update
+	SyncLDAPGroups(context.Context, *connect.Request[v1.SyncLDAPGroupsRequest]) (*connect.Response[v1.SyncLDAPGroupsResponse], error)
 }
 
 // NewIdentityProviderServiceClient constructs a client for the bytebase.v1.IdentityProviderService
@@ -117,6 +123,12 @@ func NewIdentityProviderServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(identityProviderServiceMethods.ByName("TestIdentityProvider")),
 			connect.WithClientOptions(opts...),
 		),
+		syncLDAPGroups: connect.NewClient[v1.SyncLDAPGroupsRequest, v1.SyncLDAPGroupsResponse](
+			httpClient,
+			baseURL+IdentityProviderServiceSyncLDAPGroupsProcedure,
+			connect.WithSchema(identityProviderServiceMethods.ByName("SyncLDAPGroups")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -128,6 +140,7 @@ type identityProviderServiceClient struct {
 	updateIdentityProvider *connect.Client[v1.UpdateIdentityProviderRequest, v1.IdentityProvider]
 	deleteIdentityProvider *connect.Client[v1.DeleteIdentityProviderRequest, emptypb.Empty]
 	testIdentityProvider   *connect.Client[v1.TestIdentityProviderRequest, v1.TestIdentityProviderResponse]
+	syncLDAPGroups         *connect.Client[v1.SyncLDAPGroupsRequest, v1.SyncLDAPGroupsResponse]
 }
 
 // GetIdentityProvider calls bytebase.v1.IdentityProviderService.GetIdentityProvider.
@@ -160,6 +173,11 @@ func (c *identityProviderServiceClient) TestIdentityProvider(ctx context.Context
 	return c.testIdentityProvider.CallUnary(ctx, req)
 }
 
+// SyncLDAPGroups calls bytebase.v1.IdentityProviderService.SyncLDAPGroups.
+func (c *identityProviderServiceClient) SyncLDAPGroups(ctx context.Context, req *connect.Request[v1.SyncLDAPGroupsRequest]) (*connect.Response[v1.SyncLDAPGroupsResponse], error) {
+	return c.syncLDAPGroups.CallUnary(ctx, req)
+}
+
 // IdentityProviderServiceHandler is an implementation of the bytebase.v1.IdentityProviderService
 // service.
 type IdentityProviderServiceHandler interface {
@@ -175,6 +193,9 @@ type IdentityProviderServiceHandler interface {
 	DeleteIdentityProvider(context.Context, *connect.Request[v1.DeleteIdentityProviderRequest]) (*connect.Response[emptypb.Empty], error)
 	// Permissions required: bb.identityProviders.update
 	TestIdentityProvider(context.Context, *connect.Request[v1.TestIdentityProviderRequest]) (*connect.Response[v1.TestIdentityProviderResponse], error)
+	// Synchronizes the LDAP groups into Bytebase groups.
+	// Permissions required: bb.identityProviders.update
+	SyncLDAPGroups(context.Context, *connect.Request[v1.SyncLDAPGroupsRequest]) (*connect.Response[v1.SyncLDAPGroupsResponse], error)
 }
 
 // NewIdentityProviderServiceHandler builds an HTTP handler from the service implementation. It
@@ -220,6 +241,12 @@ func NewIdentityProviderServiceHandler(svc IdentityProviderServiceHandler, opts
 		connect.WithSchema(identityProviderServiceMethods.ByName("TestIdentityProvider")),
 		connect.WithHandlerOptions(opts...),
 	)
+	identityProviderServiceSyncLDAPGroupsHandler := connect.NewUnaryHandler(
+		IdentityProviderServiceSyncLDAPGroupsProcedure,
+		svc.SyncLDAPGroups,
+		connect.WithSchema(identityProviderServiceMethods.ByName("SyncLDAPGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.IdentityProviderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IdentityProviderServiceGetIdentityProviderProcedure:
@@ -234,6 +261,8 @@ func NewIdentityProviderServiceHandler(svc IdentityProviderServiceHandler, opts
 			identityProviderServiceDeleteIdentityProviderHandler.ServeHTTP(w, r)
 		case IdentityProviderServiceTestIdentityProviderProcedure:
 			identityProviderServiceTestIdentityProviderHandler.ServeHTTP(w, r)
+		case IdentityProviderServiceSyncLDAPGroupsProcedure:
+			identityProviderServiceSyncLDAPGroupsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -266,3 +295,7 @@ func (UnimplementedIdentityProviderServiceHandler) DeleteIdentityProvider(contex
 func (UnimplementedIdentityProviderServiceHandler) TestIdentityProvider(context.Context, *connect.Request[v1.TestIdentityProviderRequest]) (*connect.Response[v1.TestIdentityProviderResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.IdentityProviderService.TestIdentityProvider is not implemented"))
 }
+
+func (UnimplementedIdentityProviderServiceHandler) SyncLDAPGroups(context.Context, *connect.Request[v1.SyncLDAPGroupsRequest]) (*connect.Response[v1.SyncLDAPGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.IdentityProviderService.SyncLDAPGroups is not implemented"))
+}
@@ -79,6 +79,15 @@ const (
 	// ProjectServiceTestWebhookProcedure is the fully-qualified name of the ProjectService's
 	// TestWebhook RPC.
 	ProjectServiceTestWebhookProcedure = "/bytebase.v1.ProjectService/TestWebhook"
+	// ProjectServiceRotateWebhookSecretProcedure is the fully-qualified name of the ProjectService's
+	// RotateWebhookSecret RPC.
+	ProjectServiceRotateWebhookSecretProcedure = "/bytebase.v1.ProjectService/RotateWebhookSecret"
+	// ProjectServiceListWebhookDeliveriesProcedure is the fully-qualified name of the ProjectService's
+	// ListWebhookDeliveries RPC.
+	ProjectServiceListWebhookDeliveriesProcedure = "/bytebase.v1.ProjectService/ListWebhookDeliveries"
+	// ProjectServiceResendWebhookDeliveryProcedure is the fully-qualified name of the ProjectService's
+	// ResendWebhookDelivery RPC.
+	ProjectServiceResendWebhookDeliveryProcedure = "/bytebase.v1.ProjectService/ResendWebhookDelivery"
 )
 
 // ProjectServiceClient is a client for the bytebase.v1.ProjectService service.
@@ -116,6 +125,16 @@ type ProjectServiceClient interface {
 	RemoveWebhook(context.Context, *connect.Request[v1.RemoveWebhookRequest]) (*connect.Response[v1.Project], error)
 	// Permissions required: bb.projects.update
 	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
+	// Rotates the signing secret of a custom or event sink webhook.
+	// The previous secret keeps signing payloads during the grace period.
+	// Permissions required: bb.projects.update
+	RotateWebhookSecret(context.Context, *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error)
+	// Lists the deliveries of a webhook, newest first.
+	// Permissions required: bb.projects.get
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// Re-sends a webhook delivery.
+	// Permissions required: bb.projects.update
+	ResendWebhookDelivery(context.Context, *connect.Request[v1.ResendWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error)
 }
 
 // NewProjectServiceClient constructs a client for the bytebase.v1.ProjectService service. By
@@ -219,26 +238,47 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceMethods.ByName("TestWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateWebhookSecret: connect.NewClient[v1.RotateWebhookSecretRequest, v1.RotateWebhookSecretResponse](
+			httpClient,
+			baseURL+ProjectServiceRotateWebhookSecretProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RotateWebhookSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+ProjectServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		resendWebhookDelivery: connect.NewClient[v1.ResendWebhookDeliveryRequest, v1.WebhookDelivery](
+			httpClient,
+			baseURL+ProjectServiceResendWebhookDeliveryProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ResendWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // projectServiceClient implements ProjectServiceClient.
 type projectServiceClient struct {
-	getProject          *connect.Client[v1.GetProjectRequest, v1.Project]
-	listProjects        *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
-	searchProjects      *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
-	createProject       *connect.Client[v1.CreateProjectRequest, v1.Project]
-	updateProject       *connect.Client[v1.UpdateProjectRequest, v1.Project]
-	deleteProject       *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
-	undeleteProject     *connect.Client[v1.UndeleteProjectRequest, v1.Project]
-	batchDeleteProjects *connect.Client[v1.BatchDeleteProjectsRequest, emptypb.Empty]
-	getIamPolicy        *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
-	batchGetIamPolicy   *connect.Client[v1.BatchGetIamPolicyRequest, v1.BatchGetIamPolicyResponse]
-	setIamPolicy        *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
-	addWebhook          *connect.Client[v1.AddWebhookRequest, v1.Project]
-	updateWebhook       *connect.Client[v1.UpdateWebhookRequest, v1.Project]
-	removeWebhook       *connect.Client[v1.RemoveWebhookRequest, v1.Project]
-	testWebhook         *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
+	getProject            *connect.Client[v1.GetProjectRequest, v1.Project]
+	listProjects          *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	searchProjects        *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
+	createProject         *connect.Client[v1.CreateProjectRequest, v1.Project]
+	updateProject         *connect.Client[v1.UpdateProjectRequest, v1.Project]
+	deleteProject         *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
+	undeleteProject       *connect.Client[v1.UndeleteProjectRequest, v1.Project]
+	batchDeleteProjects   *connect.Client[v1.BatchDeleteProjectsRequest, emptypb.Empty]
+	getIamPolicy          *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
+	batchGetIamPolicy     *connect.Client[v1.BatchGetIamPolicyRequest, v1.BatchGetIamPolicyResponse]
+	setIamPolicy          *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
+	addWebhook            *connect.Client[v1.AddWebhookRequest, v1.Project]
+	updateWebhook         *connect.Client[v1.UpdateWebhookRequest, v1.Project]
+	removeWebhook         *connect.Client[v1.RemoveWebhookRequest, v1.Project]
+	testWebhook           *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
+	rotateWebhookSecret   *connect.Client[v1.RotateWebhookSecretRequest, v1.RotateWebhookSecretResponse]
+	listWebhookDeliveries *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	resendWebhookDelivery *connect.Client[v1.ResendWebhookDeliveryRequest, v1.WebhookDelivery]
 }
 
 // GetProject calls bytebase.v1.ProjectService.GetProject.
@@ -316,6 +356,21 @@ func (c *projectServiceClient) TestWebhook(ctx context.Context, req *connect.Req
 	return c.testWebhook.CallUnary(ctx, req)
 }
 
+// RotateWebhookSecret calls bytebase.v1.ProjectService.RotateWebhookSecret.
+func (c *projectServiceClient) RotateWebhookSecret(ctx context.Context, req *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error) {
+	return c.rotateWebhookSecret.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls bytebase.v1.ProjectService.ListWebhookDeliveries.
+func (c *projectServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ResendWebhookDelivery calls bytebase.v1.ProjectService.ResendWebhookDelivery.
+func (c *projectServiceClient) ResendWebhookDelivery(ctx context.Context, req *connect.Request[v1.ResendWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return c.resendWebhookDelivery.CallUnary(ctx, req)
+}
+
 // ProjectServiceHandler is an implementation of the bytebase.v1.ProjectService service.
 type ProjectServiceHandler interface {
 	// GetProject retrieves a project by name.
@@ -351,6 +406,16 @@ type ProjectServiceHandler interface {
 	RemoveWebhook(context.Context, *connect.Request[v1.RemoveWebhookRequest]) (*connect.Response[v1.Project], error)
 	// Permissions required: bb.projects.update
 	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
+	// Rotates the signing secret of a custom or event sink webhook.
+	// The previous secret keeps signing payloads during the grace period.
+	// Permissions required: bb.projects.update
+	RotateWebhookSecret(context.Context, *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error)
+	// Lists the deliveries of a webhook, newest first.
+	// Permissions required: bb.projects.get
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// Re-sends a webhook delivery.
+	// Permissions required: bb.projects.update
+	ResendWebhookDelivery(context.Context, *connect.Request[v1.ResendWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error)
 }
 
 // NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -450,6 +515,24 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceMethods.ByName("TestWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceRotateWebhookSecretHandler := connect.NewUnaryHandler(
+		ProjectServiceRotateWebhookSecretProcedure,
+		svc.RotateWebhookSecret,
+		connect.WithSchema(projectServiceMethods.ByName("RotateWebhookSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		ProjectServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(projectServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceResendWebhookDeliveryHandler := connect.NewUnaryHandler(
+		ProjectServiceResendWebhookDeliveryProcedure,
+		svc.ResendWebhookDelivery,
+		connect.WithSchema(projectServiceMethods.ByName("ResendWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProjectServiceGetProjectProcedure:
@@ -482,6 +565,12 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceRemoveWebhookHandler.ServeHTTP(w, r)
 		case ProjectServiceTestWebhookProcedure:
 			projectServiceTestWebhookHandler.ServeHTTP(w, r)
+		case ProjectServiceRotateWebhookSecretProcedure:
+			projectServiceRotateWebhookSecretHandler.ServeHTTP(w, r)
+		case ProjectServiceListWebhookDeliveriesProcedure:
+			projectServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case ProjectServiceResendWebhookDeliveryProcedure:
+			projectServiceResendWebhookDeliveryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -550,3 +639,15 @@ func (UnimplementedProjectServiceHandler) RemoveWebhook(context.Context, *connec
 func (UnimplementedProjectServiceHandler) TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.TestWebhook is not implemented"))
 }
+
+func (UnimplementedProjectServiceHandler) RotateWebhookSecret(context.Context, *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.RotateWebhookSecret is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ResendWebhookDelivery(context.Context, *connect.Request[v1.ResendWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.ResendWebhookDelivery is not implemented"))
+}
@@ -35,6 +35,8 @@ const (
 const (
 	// SQLServiceQueryProcedure is the fully-qualified name of the SQLService's Query RPC.
 	SQLServiceQueryProcedure = "/bytebase.v1.SQLService/Query"
+	// SQLServiceQueryPagesProcedure is the fully-qualified name of the SQLService's QueryPages RPC.
+	SQLServiceQueryPagesProcedure = "/bytebase.v1.SQLService/QueryPages"
 	// SQLServiceAdminExecuteProcedure is the fully-qualified name of the SQLService's AdminExecute RPC.
 	SQLServiceAdminExecuteProcedure = "/bytebase.v1.SQLService/AdminExecute"
 	// SQLServiceSearchQueryHistoriesProcedure is the fully-qualified name of the SQLService's
@@ -56,13 +58,18 @@ const (
 type SQLServiceClient interface {
 	// Permissions required: bb.databases.get
 	Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error)
+	// QueryPages executes the query in a result session and streams the result page by page,
+	// so browsing a big result doesn't re-run the full query on every scroll.
+	// The session can be resumed with the page token of the last response.
+	// Permissions required: bb.databases.get
+	QueryPages(context.Context, *connect.Request[v1.QueryPagesRequest]) (*connect.ServerStreamForClient[v1.QueryPagesResponse], error)
 	// Permissions required: bb.sql.admin
 	AdminExecute(context.Context) *connect.BidiStreamForClient[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None
 	SearchQueryHistories(context.Context, *connect.Request[v1.SearchQueryHistoriesRequest]) (*connect.Response[v1.SearchQueryHistoriesResponse], error)
 	// Permissions required: bb.databases.get
-	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportResponse], error)
 	// Permissions required: bb.databases.check
 	Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error)
 	// Permissions required: None
@@ -90,6 +97,12 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sQLServiceMethods.ByName("Query")),
 			connect.WithClientOptions(opts...),
 		),
+		queryPages: connect.NewClient[v1.QueryPagesRequest, v1.QueryPagesResponse](
+			httpClient,
+			baseURL+SQLServiceQueryPagesProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("QueryPages")),
+			connect.WithClientOptions(opts...),
+		),
 		adminExecute: connect.NewClient[v1.AdminExecuteRequest, v1.AdminExecuteResponse](
 			httpClient,
 			baseURL+SQLServiceAdminExecuteProcedure,
@@ -138,6 +151,7 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 // sQLServiceClient implements SQLServiceClient.
 type sQLServiceClient struct {
 	query                *connect.Client[v1.QueryRequest, v1.QueryResponse]
+	queryPages           *connect.Client[v1.QueryPagesRequest, v1.QueryPagesResponse]
 	adminExecute         *connect.Client[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
 	searchQueryHistories *connect.Client[v1.SearchQueryHistoriesRequest, v1.SearchQueryHistoriesResponse]
 	export               *connect.Client[v1.ExportRequest, v1.ExportResponse]
@@ -152,6 +166,11 @@ func (c *sQLServiceClient) Query(ctx context.Context, req *connect.Request[v1.Qu
 	return c.query.CallUnary(ctx, req)
 }
 
+// QueryPages calls bytebase.v1.SQLService.QueryPages.
+func (c *sQLServiceClient) QueryPages(ctx context.Context, req *connect.Request[v1.QueryPagesRequest]) (*connect.ServerStreamForClient[v1.QueryPagesResponse], error) {
+	return c.queryPages.CallServerStream(ctx, req)
+}
+
 // AdminExecute calls bytebase.v1.SQLService.AdminExecute.
 func (c *sQLServiceClient) AdminExecute(ctx context.Context) *connect.BidiStreamForClient[v1.AdminExecuteRequest, v1.AdminExecuteResponse] {
 	return c.adminExecute.CallBidiStream(ctx)
@@ -163,8 +182,8 @@ func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, req *connec
 }
 
 // Export calls bytebase.v1.SQLService.Export.
-func (c *sQLServiceClient) Export(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error) {
-	return c.export.CallUnary(ctx, req)
+func (c *sQLServiceClient) Export(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportResponse], error) {
+	return c.export.CallServerStream(ctx, req)
 }
 
 // Check calls bytebase.v1.SQLService.Check.
@@ -191,13 +210,18 @@ func (c *sQLServiceClient) AICompletion(ctx context.Context, req *connect.Reques
 type SQLServiceHandler interface {
 	// Permissions required: bb.databases.get
 	Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error)
+	// QueryPages executes the query in a result session and streams the result page by page,
+	// so browsing a big result doesn't re-run the full query on every scroll.
+	// The session can be resumed with the page token of the last response.
+	// Permissions required: bb.databases.get
+	QueryPages(context.Context, *connect.Request[v1.QueryPagesRequest], *connect.ServerStream[v1.QueryPagesResponse]) error
 	// Permissions required: bb.sql.admin
 	AdminExecute(context.Context, *connect.BidiStream[v1.AdminExecuteRequest, v1.AdminExecuteResponse]) error
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None
 	SearchQueryHistories(context.Context, *connect.Request[v1.SearchQueryHistoriesRequest]) (*connect.Response[v1.SearchQueryHistoriesResponse], error)
 	// Permissions required: bb.databases.get
-	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	Export(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportResponse]) error
 	// Permissions required: bb.databases.check
 	Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error)
 	// Permissions required: None
@@ -221,6 +245,12 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("Query")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceQueryPagesHandler := connect.NewServerStreamHandler(
+		SQLServiceQueryPagesProcedure,
+		svc.QueryPages,
+		connect.WithSchema(sQLServiceMethods.ByName("QueryPages")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceAdminExecuteHandler := connect.NewBidiStreamHandler(
 		SQLServiceAdminExecuteProcedure,
 		svc.AdminExecute,
@@ -233,7 +263,7 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("SearchQueryHistories")),
 		connect.WithHandlerOptions(opts...),
 	)
-	sQLServiceExportHandler := connect.NewUnaryHandler(
+	sQLServiceExportHandler := connect.NewServerStreamHandler(
 		SQLServiceExportProcedure,
 		svc.Export,
 		connect.WithSchema(sQLServiceMethods.ByName("Export")),
@@ -267,6 +297,8 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		switch r.URL.Path {
 		case SQLServiceQueryProcedure:
 			sQLServiceQueryHandler.ServeHTTP(w, r)
+		case SQLServiceQueryPagesProcedure:
+			sQLServiceQueryPagesHandler.ServeHTTP(w, r)
 		case SQLServiceAdminExecuteProcedure:
 			sQLServiceAdminExecuteHandler.ServeHTTP(w, r)
 		case SQLServiceSearchQueryHistoriesProcedure:
@@ -294,6 +326,10 @@ func (UnimplementedSQLServiceHandler) Query(context.Context, *connect.Request[v1
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Query is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) QueryPages(context.Context, *connect.Request[v1.QueryPagesRequest], *connect.ServerStream[v1.QueryPagesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.QueryPages is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) AdminExecute(context.Context, *connect.BidiStream[v1.AdminExecuteRequest, v1.AdminExecuteResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.AdminExecute is not implemented"))
 }
@@ -302,8 +338,8 @@ func (UnimplementedSQLServiceHandler) SearchQueryHistories(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.SearchQueryHistories is not implemented"))
 }
 
-func (UnimplementedSQLServiceHandler) Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Export is not implemented"))
+func (UnimplementedSQLServiceHandler) Export(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Export is not implemented"))
 }
 
 func (UnimplementedSQLServiceHandler) Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error) {
@@ -53,6 +53,30 @@ const (
 	// UserServiceUndeleteUserProcedure is the fully-qualified name of the UserService's UndeleteUser
 	// RPC.
 	UserServiceUndeleteUserProcedure = "/bytebase.v1.UserService/UndeleteUser"
+	// UserServiceGenerateWebAuthnCreationOptionsProcedure is the fully-qualified name of the
+	// UserService's GenerateWebAuthnCreationOptions RPC.
+	UserServiceGenerateWebAuthnCreationOptionsProcedure = "/bytebase.v1.UserService/GenerateWebAuthnCreationOptions"
+	// UserServiceCreateWebAuthnCredentialProcedure is the fully-qualified name of the UserService's
+	// CreateWebAuthnCredential RPC.
+	UserServiceCreateWebAuthnCredentialProcedure = "/bytebase.v1.UserService/CreateWebAuthnCredential"
+	// UserServiceListWebAuthnCredentialsProcedure is the fully-qualified name of the UserService's
+	// ListWebAuthnCredentials RPC.
+	UserServiceListWebAuthnCredentialsProcedure = "/bytebase.v1.UserService/ListWebAuthnCredentials"
+	// UserServiceUpdateWebAuthnCredentialProcedure is the fully-qualified name of the UserService's
+	// UpdateWebAuthnCredential RPC.
+	UserServiceUpdateWebAuthnCredentialProcedure = "/bytebase.v1.UserService/UpdateWebAuthnCredential"
+	// UserServiceDeleteWebAuthnCredentialProcedure is the fully-qualified name of the UserService's
+	// DeleteWebAuthnCredential RPC.
+	UserServiceDeleteWebAuthnCredentialProcedure = "/bytebase.v1.UserService/DeleteWebAuthnCredential"
+	// UserServiceCreateAccessTokenProcedure is the fully-qualified name of the UserService's
+	// CreateAccessToken RPC.
+	UserServiceCreateAccessTokenProcedure = "/bytebase.v1.UserService/CreateAccessToken"
+	// UserServiceListAccessTokensProcedure is the fully-qualified name of the UserService's
+	// ListAccessTokens RPC.
+	UserServiceListAccessTokensProcedure = "/bytebase.v1.UserService/ListAccessTokens"
+	// UserServiceRevokeAccessTokenProcedure is the fully-qualified name of the UserService's
+	// RevokeAccessToken RPC.
+	UserServiceRevokeAccessTokenProcedure = "/bytebase.v1.UserService/RevokeAccessToken"
 )
 
 // UserServiceClient is a client for the bytebase.v1.UserService service.
@@ -87,6 +111,38 @@ type UserServiceClient interface {
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	// Permissions required: bb.users.undelete
 	UndeleteUser(context.Context, *connect.Request[v1.UndeleteUserRequest]) (*connect.Response[v1.User], error)
+	// Generate the PublicKeyCredentialCreationOptionsJSON to register a WebAuthn credential.
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	GenerateWebAuthnCreationOptions(context.Context, *connect.Request[v1.GenerateWebAuthnCreationOptionsRequest]) (*connect.Response[v1.GenerateWebAuthnCreationOptionsResponse], error)
+	// Register a WebAuthn credential with the response of navigator.credentials.create().
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	CreateWebAuthnCredential(context.Context, *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error)
+	// List the WebAuthn credentials of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the credentials.
+	// Permissions required: bb.users.update
+	ListWebAuthnCredentials(context.Context, *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can update the credential.
+	// Permissions required: bb.users.update
+	UpdateWebAuthnCredential(context.Context, *connect.Request[v1.UpdateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can delete the credential,
+	// e.g. the workspace admin removes the lost security key of the user.
+	// Permissions required: bb.users.update
+	DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error)
+	// Create a personal access token for the user.
+	// The token is scoped to the projects and permissions, and it is only returned once in the response.
+	// Only the user itself can create the token for an end user. The user with bb.users.update permission on the workspace can also create the token for a service account.
+	// Permissions required: bb.users.update
+	CreateAccessToken(context.Context, *connect.Request[v1.CreateAccessTokenRequest]) (*connect.Response[v1.AccessToken], error)
+	// List the personal access tokens of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the tokens.
+	// Permissions required: bb.users.update
+	ListAccessTokens(context.Context, *connect.Request[v1.ListAccessTokensRequest]) (*connect.Response[v1.ListAccessTokensResponse], error)
+	// Revoke the personal access token. The token cannot be used after it is revoked.
+	// Only the user itself and the user with bb.users.update permission on the workspace can revoke the token.
+	// Permissions required: bb.users.update
+	RevokeAccessToken(context.Context, *connect.Request[v1.RevokeAccessTokenRequest]) (*connect.Response[v1.AccessToken], error)
 }
 
 // NewUserServiceClient constructs a client for the bytebase.v1.UserService service. By default, it
@@ -148,19 +204,75 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("UndeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		generateWebAuthnCreationOptions: connect.NewClient[v1.GenerateWebAuthnCreationOptionsRequest, v1.GenerateWebAuthnCreationOptionsResponse](
+			httpClient,
+			baseURL+UserServiceGenerateWebAuthnCreationOptionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GenerateWebAuthnCreationOptions")),
+			connect.WithClientOptions(opts...),
+		),
+		createWebAuthnCredential: connect.NewClient[v1.CreateWebAuthnCredentialRequest, v1.WebAuthnCredential](
+			httpClient,
+			baseURL+UserServiceCreateWebAuthnCredentialProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateWebAuthnCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebAuthnCredentials: connect.NewClient[v1.ListWebAuthnCredentialsRequest, v1.ListWebAuthnCredentialsResponse](
+			httpClient,
+			baseURL+UserServiceListWebAuthnCredentialsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListWebAuthnCredentials")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebAuthnCredential: connect.NewClient[v1.UpdateWebAuthnCredentialRequest, v1.WebAuthnCredential](
+			httpClient,
+			baseURL+UserServiceUpdateWebAuthnCredentialProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateWebAuthnCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebAuthnCredential: connect.NewClient[v1.DeleteWebAuthnCredentialRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteWebAuthnCredentialProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteWebAuthnCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		createAccessToken: connect.NewClient[v1.CreateAccessTokenRequest, v1.AccessToken](
+			httpClient,
+			baseURL+UserServiceCreateAccessTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateAccessToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccessTokens: connect.NewClient[v1.ListAccessTokensRequest, v1.ListAccessTokensResponse](
+			httpClient,
+			baseURL+UserServiceListAccessTokensProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAccessTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAccessToken: connect.NewClient[v1.RevokeAccessTokenRequest, v1.AccessToken](
+			httpClient,
+			baseURL+UserServiceRevokeAccessTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAccessToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getUser        *connect.Client[v1.GetUserRequest, v1.User]
-	batchGetUsers  *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
-	getCurrentUser *connect.Client[emptypb.Empty, v1.User]
-	listUsers      *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	createUser     *connect.Client[v1.CreateUserRequest, v1.User]
-	updateUser     *connect.Client[v1.UpdateUserRequest, v1.User]
-	deleteUser     *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
-	undeleteUser   *connect.Client[v1.UndeleteUserRequest, v1.User]
+	getUser                         *connect.Client[v1.GetUserRequest, v1.User]
+	batchGetUsers                   *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
+	getCurrentUser                  *connect.Client[emptypb.Empty, v1.User]
+	listUsers                       *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	createUser                      *connect.Client[v1.CreateUserRequest, v1.User]
+	updateUser                      *connect.Client[v1.UpdateUserRequest, v1.User]
+	deleteUser                      *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
+	undeleteUser                    *connect.Client[v1.UndeleteUserRequest, v1.User]
+	generateWebAuthnCreationOptions *connect.Client[v1.GenerateWebAuthnCreationOptionsRequest, v1.GenerateWebAuthnCreationOptionsResponse]
+	createWebAuthnCredential        *connect.Client[v1.CreateWebAuthnCredentialRequest, v1.WebAuthnCredential]
+	listWebAuthnCredentials         *connect.Client[v1.ListWebAuthnCredentialsRequest, v1.ListWebAuthnCredentialsResponse]
+	updateWebAuthnCredential        *connect.Client[v1.UpdateWebAuthnCredentialRequest, v1.WebAuthnCredential]
+	deleteWebAuthnCredential        *connect.Client[v1.DeleteWebAuthnCredentialRequest, emptypb.Empty]
+	createAccessToken               *connect.Client[v1.CreateAccessTokenRequest, v1.AccessToken]
+	listAccessTokens                *connect.Client[v1.ListAccessTokensRequest, v1.ListAccessTokensResponse]
+	revokeAccessToken               *connect.Client[v1.RevokeAccessTokenRequest, v1.AccessToken]
 }
 
 // GetUser calls bytebase.v1.UserService.GetUser.
@@ -203,6 +315,46 @@ func (c *userServiceClient) UndeleteUser(ctx context.Context, req *connect.Reque
 	return c.undeleteUser.CallUnary(ctx, req)
 }
 
+// GenerateWebAuthnCreationOptions calls bytebase.v1.UserService.GenerateWebAuthnCreationOptions.
+func (c *userServiceClient) GenerateWebAuthnCreationOptions(ctx context.Context, req *connect.Request[v1.GenerateWebAuthnCreationOptionsRequest]) (*connect.Response[v1.GenerateWebAuthnCreationOptionsResponse], error) {
+	return c.generateWebAuthnCreationOptions.CallUnary(ctx, req)
+}
+
+// CreateWebAuthnCredential calls bytebase.v1.UserService.CreateWebAuthnCredential.
+func (c *userServiceClient) CreateWebAuthnCredential(ctx context.Context, req *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error) {
+	return c.createWebAuthnCredential.CallUnary(ctx, req)
+}
+
+// ListWebAuthnCredentials calls bytebase.v1.UserService.ListWebAuthnCredentials.
+func (c *userServiceClient) ListWebAuthnCredentials(ctx context.Context, req *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error) {
+	return c.listWebAuthnCredentials.CallUnary(ctx, req)
+}
+
+// UpdateWebAuthnCredential calls bytebase.v1.UserService.UpdateWebAuthnCredential.
+func (c *userServiceClient) UpdateWebAuthnCredential(ctx context.Context, req *connect.Request[v1.UpdateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error) {
+	return c.updateWebAuthnCredential.CallUnary(ctx, req)
+}
+
+// DeleteWebAuthnCredential calls bytebase.v1.UserService.DeleteWebAuthnCredential.
+func (c *userServiceClient) DeleteWebAuthnCredential(ctx context.Context, req *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebAuthnCredential.CallUnary(ctx, req)
+}
+
+// CreateAccessToken calls bytebase.v1.UserService.CreateAccessToken.
+func (c *userServiceClient) CreateAccessToken(ctx context.Context, req *connect.Request[v1.CreateAccessTokenRequest]) (*connect.Response[v1.AccessToken], error) {
+	return c.createAccessToken.CallUnary(ctx, req)
+}
+
+// ListAccessTokens calls bytebase.v1.UserService.ListAccessTokens.
+func (c *userServiceClient) ListAccessTokens(ctx context.Context, req *connect.Request[v1.ListAccessTokensRequest]) (*connect.Response[v1.ListAccessTokensResponse], error) {
+	return c.listAccessTokens.CallUnary(ctx, req)
+}
+
+// RevokeAccessToken calls bytebase.v1.UserService.RevokeAccessToken.
+func (c *userServiceClient) RevokeAccessToken(ctx context.Context, req *connect.Request[v1.RevokeAccessTokenRequest]) (*connect.Response[v1.AccessToken], error) {
+	return c.revokeAccessToken.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the bytebase.v1.UserService service.
 type UserServiceHandler interface {
 	// Get the user.
@@ -235,6 +387,38 @@ type UserServiceHandler interface {
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	// Permissions required: bb.users.undelete
 	UndeleteUser(context.Context, *connect.Request[v1.UndeleteUserRequest]) (*connect.Response[v1.User], error)
+	// Generate the PublicKeyCredentialCreationOptionsJSON to register a WebAuthn credential.
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	GenerateWebAuthnCreationOptions(context.Context, *connect.Request[v1.GenerateWebAuthnCreationOptionsRequest]) (*connect.Response[v1.GenerateWebAuthnCreationOptionsResponse], error)
+	// Register a WebAuthn credential with the response of navigator.credentials.create().
+	// Only the user itself can register the credential.
+	// Permissions required: None
+	CreateWebAuthnCredential(context.Context, *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error)
+	// List the WebAuthn credentials of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the credentials.
+	// Permissions required: bb.users.update
+	ListWebAuthnCredentials(context.Context, *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can update the credential.
+	// Permissions required: bb.users.update
+	UpdateWebAuthnCredential(context.Context, *connect.Request[v1.UpdateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error)
+	// Only the user itself and the user with bb.users.update permission on the workspace can delete the credential,
+	// e.g. the workspace admin removes the lost security key of the user.
+	// Permissions required: bb.users.update
+	DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error)
+	// Create a personal access token for the user.
+	// The token is scoped to the projects and permissions, and it is only returned once in the response.
+	// Only the user itself can create the token for an end user. The user with bb.users.update permission on the workspace can also create the token for a service account.
+	// Permissions required: bb.users.update
+	CreateAccessToken(context.Context, *connect.Request[v1.CreateAccessTokenRequest]) (*connect.Response[v1.AccessToken], error)
+	// List the personal access tokens of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the tokens.
+	// Permissions required: bb.users.update
+	ListAccessTokens(context.Context, *connect.Request[v1.ListAccessTokensRequest]) (*connect.Response[v1.ListAccessTokensResponse], error)
+	// Revoke the personal access token. The token cannot be used after it is revoked.
+	// Only the user itself and the user with bb.users.update permission on the workspace can revoke the token.
+	// Permissions required: bb.users.update
+	RevokeAccessToken(context.Context, *connect.Request[v1.RevokeAccessTokenRequest]) (*connect.Response[v1.AccessToken], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -292,6 +476,54 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("UndeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGenerateWebAuthnCreationOptionsHandler := connect.NewUnaryHandler(
+		UserServiceGenerateWebAuthnCreationOptionsProcedure,
+		svc.GenerateWebAuthnCreationOptions,
+		connect.WithSchema(userServiceMethods.ByName("GenerateWebAuthnCreationOptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateWebAuthnCredentialHandler := connect.NewUnaryHandler(
+		UserServiceCreateWebAuthnCredentialProcedure,
+		svc.CreateWebAuthnCredential,
+		connect.WithSchema(userServiceMethods.ByName("CreateWebAuthnCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListWebAuthnCredentialsHandler := connect.NewUnaryHandler(
+		UserServiceListWebAuthnCredentialsProcedure,
+		svc.ListWebAuthnCredentials,
+		connect.WithSchema(userServiceMethods.ByName("ListWebAuthnCredentials")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateWebAuthnCredentialHandler := connect.NewUnaryHandler(
+		UserServiceUpdateWebAuthnCredentialProcedure,
+		svc.UpdateWebAuthnCredential,
+		connect.WithSchema(userServiceMethods.ByName("UpdateWebAuthnCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteWebAuthnCredentialHandler := connect.NewUnaryHandler(
+		UserServiceDeleteWebAuthnCredentialProcedure,
+		svc.DeleteWebAuthnCredential,
+		connect.WithSchema(userServiceMethods.ByName("DeleteWebAuthnCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateAccessTokenHandler := connect.NewUnaryHandler(
+		UserServiceCreateAccessTokenProcedure,
+		svc.CreateAccessToken,
+		connect.WithSchema(userServiceMethods.ByName("CreateAccessToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAccessTokensHandler := connect.NewUnaryHandler(
+		UserServiceListAccessTokensProcedure,
+		svc.ListAccessTokens,
+		connect.WithSchema(userServiceMethods.ByName("ListAccessTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAccessTokenHandler := connect.NewUnaryHandler(
+		UserServiceRevokeAccessTokenProcedure,
+		svc.RevokeAccessToken,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAccessToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetUserProcedure:
@@ -310,6 +542,22 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
 		case UserServiceUndeleteUserProcedure:
 			userServiceUndeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceGenerateWebAuthnCreationOptionsProcedure:
+			userServiceGenerateWebAuthnCreationOptionsHandler.ServeHTTP(w, r)
+		case UserServiceCreateWebAuthnCredentialProcedure:
+			userServiceCreateWebAuthnCredentialHandler.ServeHTTP(w, r)
+		case UserServiceListWebAuthnCredentialsProcedure:
+			userServiceListWebAuthnCredentialsHandler.ServeHTTP(w, r)
+		case UserServiceUpdateWebAuthnCredentialProcedure:
+			userServiceUpdateWebAuthnCredentialHandler.ServeHTTP(w, r)
+		case UserServiceDeleteWebAuthnCredentialProcedure:
+			userServiceDeleteWebAuthnCredentialHandler.ServeHTTP(w, r)
+		case UserServiceCreateAccessTokenProcedure:
+			userServiceCreateAccessTokenHandler.ServeHTTP(w, r)
+		case UserServiceListAccessTokensProcedure:
+			userServiceListAccessTokensHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAccessTokenProcedure:
+			userServiceRevokeAccessTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -350,3 +598,35 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) UndeleteUser(context.Context, *connect.Request[v1.UndeleteUserRequest]) (*connect.Response[v1.User], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.UndeleteUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) GenerateWebAuthnCreationOptions(context.Context, *connect.Request[v1.GenerateWebAuthnCreationOptionsRequest]) (*connect.Response[v1.GenerateWebAuthnCreationOptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.GenerateWebAuthnCreationOptions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateWebAuthnCredential(context.Context, *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.CreateWebAuthnCredential is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListWebAuthnCredentials(context.Context, *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.ListWebAuthnCredentials is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateWebAuthnCredential(context.Context, *connect.Request[v1.UpdateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.UpdateWebAuthnCredential is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.DeleteWebAuthnCredential is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateAccessToken(context.Context, *connect.Request[v1.CreateAccessTokenRequest]) (*connect.Response[v1.AccessToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.CreateAccessToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAccessTokens(context.Context, *connect.Request[v1.ListAccessTokensRequest]) (*connect.Response[v1.ListAccessTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.ListAccessTokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAccessToken(context.Context, *connect.Request[v1.RevokeAccessTokenRequest]) (*connect.Response[v1.AccessToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.RevokeAccessToken is not implemented"))
+}
@@ -0,0 +1,18 @@
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    project text NOT NULL REFERENCES project(resource_id),
+    webhook_id integer NOT NULL REFERENCES project_webhook(id) ON DELETE CASCADE,
+    event_type text NOT NULL,
+    status text NOT NULL CHECK (status IN ('PENDING', 'DONE', 'DEAD')),
+    retry_count integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_at ON webhook_delivery(status, next_attempt_at);
@@ -0,0 +1,16 @@
+CREATE TABLE access_token (
+    id bigserial PRIMARY KEY,
+    principal_id integer NOT NULL REFERENCES principal(id),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz,
+    revoked boolean NOT NULL DEFAULT FALSE,
+    -- The SHA-256 hex digest of the token.
+    token_hash text NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE access_token_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_access_token_unique_token_hash ON access_token(token_hash);
+
+CREATE INDEX idx_access_token_principal_id ON access_token(principal_id);
@@ -0,0 +1,9 @@
+-- The consumed SAML assertions are shared by all the replicas so that an assertion cannot be replayed on another replica.
+CREATE TABLE saml_assertion (
+    issuer text NOT NULL,
+    assertion_id text NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (issuer, assertion_id)
+);
+
+CREATE INDEX idx_saml_assertion_expires_at ON saml_assertion(expires_at);
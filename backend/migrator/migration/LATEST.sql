@@ -4,7 +4,7 @@ CREATE TABLE idp (
   resource_id text NOT NULL,
   name text NOT NULL,
   domain text NOT NULL,
-  type text NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP')),
+  type text NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP', 'SAML')),
   -- config stores the corresponding configuration of the IdP, which may vary depending on the type of the IdP.
   config jsonb NOT NULL DEFAULT '{}'
 );
@@ -13,6 +13,16 @@ CREATE UNIQUE INDEX idx_idp_unique_resource_id ON idp(resource_id);
 
 ALTER SEQUENCE idp_id_seq RESTART WITH 101;
 
+-- The consumed SAML assertions are shared by all the replicas so that an assertion cannot be replayed on another replica.
+CREATE TABLE saml_assertion (
+    issuer text NOT NULL,
+    assertion_id text NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (issuer, assertion_id)
+);
+
+CREATE INDEX idx_saml_assertion_expires_at ON saml_assertion(expires_at);
+
 -- principal
 CREATE TABLE principal (
     id serial PRIMARY KEY,
@@ -27,6 +37,23 @@ CREATE TABLE principal (
     profile jsonb NOT NULL DEFAULT '{}'
 );
 
+CREATE TABLE access_token (
+    id bigserial PRIMARY KEY,
+    principal_id integer NOT NULL REFERENCES principal(id),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz,
+    revoked boolean NOT NULL DEFAULT FALSE,
+    -- The SHA-256 hex digest of the token.
+    token_hash text NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE access_token_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_access_token_unique_token_hash ON access_token(token_hash);
+
+CREATE INDEX idx_access_token_principal_id ON access_token(principal_id);
+
 -- Setting
 CREATE TABLE setting (
     id serial PRIMARY KEY,
@@ -96,6 +123,25 @@ CREATE INDEX idx_project_webhook_project ON project_webhook(project);
 
 ALTER SEQUENCE project_webhook_id_seq RESTART WITH 101;
 
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    project text NOT NULL REFERENCES project(resource_id),
+    webhook_id integer NOT NULL REFERENCES project_webhook(id) ON DELETE CASCADE,
+    event_type text NOT NULL,
+    status text NOT NULL CHECK (status IN ('PENDING', 'DONE', 'DEAD')),
+    retry_count integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_at ON webhook_delivery(status, next_attempt_at);
+
 -- Instance
 CREATE TABLE instance (
     id serial PRIMARY KEY,
@@ -0,0 +1,44 @@
+// Package bigquery is the advisor for BigQuery database.
+package bigquery
+
+import (
+	"strings"
+
+	parser "github.com/bytebase/google-sql-parser"
+)
+
+// normalizeIdentifier returns the identifier without the enclosing backticks.
+func normalizeIdentifier(identifier parser.IIdentifierContext) string {
+	if identifier == nil {
+		return ""
+	}
+	return strings.Trim(identifier.GetText(), "`")
+}
+
+// normalizeTableName returns the table name of the path expression such as `project.dataset.table`,
+// the project and dataset parts are omitted.
+func normalizeTableName(path string) string {
+	parts := strings.Split(strings.ReplaceAll(path, "`", ""), ".")
+	return parts[len(parts)-1]
+}
+
+// normalizeColumnType returns the upper case column type without type parameters.
+func normalizeColumnType(schema parser.ITable_column_schemaContext) string {
+	if schema == nil || schema.Column_schema_inner() == nil || schema.Column_schema_inner().Raw_column_schema_inner() == nil {
+		return ""
+	}
+	return strings.ToUpper(schema.Column_schema_inner().Raw_column_schema_inner().GetText())
+}
+
+// tableNameOfAlterAction returns the table name of the ALTER TABLE statement containing the alter action.
+func tableNameOfAlterAction(ctx *parser.Alter_actionContext) string {
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		if alter, ok := parent.(*parser.Alter_statementContext); ok {
+			if alter.Table_or_table_function() == nil || alter.Table_or_table_function().FUNCTION_SYMBOL() != nil || alter.Maybe_dashed_path_expression() == nil {
+				return ""
+			}
+			return normalizeTableName(alter.Maybe_dashed_path_expression().GetText())
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/google-sql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*ColumnTypeDisallowListAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_BIGQUERY, advisor.BigQueryColumnTypeDisallowList, &ColumnTypeDisallowListAdvisor{})
+}
+
+// ColumnTypeDisallowListAdvisor is the advisor checking for disallowed types for column.
+type ColumnTypeDisallowListAdvisor struct {
+}
+
+// Check checks for disallowed types for column.
+func (*ColumnTypeDisallowListAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalStringArrayTypeRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &columnTypeDisallowListChecker{
+		level:           level,
+		title:           string(checkCtx.Rule.Type),
+		typeRestriction: make(map[string]bool),
+	}
+	for _, tp := range payload.List {
+		listener.typeRestriction[strings.ToUpper(tp)] = true
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type columnTypeDisallowListChecker struct {
+	*parser.BaseGoogleSQLParserListener
+
+	level           storepb.Advice_Status
+	title           string
+	typeRestriction map[string]bool
+
+	adviceList []*storepb.Advice
+}
+
+// EnterCreate_table_statement is called when production create_table_statement is entered.
+func (l *columnTypeDisallowListChecker) EnterCreate_table_statement(ctx *parser.Create_table_statementContext) {
+	if ctx.Maybe_dashed_path_expression() == nil || ctx.Table_element_list() == nil {
+		return
+	}
+	tableName := normalizeTableName(ctx.Maybe_dashed_path_expression().GetText())
+	for _, element := range ctx.Table_element_list().AllTable_element() {
+		l.checkColumn(tableName, element.Table_column_definition())
+	}
+}
+
+// EnterAlter_action is called when production alter_action is entered.
+func (l *columnTypeDisallowListChecker) EnterAlter_action(ctx *parser.Alter_actionContext) {
+	// ADD COLUMN [IF NOT EXISTS] column_definition.
+	if ctx.ADD_SYMBOL() == nil || ctx.COLUMN_SYMBOL() == nil {
+		return
+	}
+	if tableName := tableNameOfAlterAction(ctx); tableName != "" {
+		l.checkColumn(tableName, ctx.Table_column_definition())
+	}
+}
+
+func (l *columnTypeDisallowListChecker) checkColumn(tableName string, column parser.ITable_column_definitionContext) {
+	if column == nil {
+		return
+	}
+	columnType := normalizeColumnType(column.Table_column_schema())
+	if !l.typeRestriction[columnType] {
+		return
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.DisabledColumnType.Int32(),
+		Title:         l.title,
+		Content:       fmt.Sprintf("Disallow column type %s but column `%s`.`%s` is", columnType, tableName, normalizeIdentifier(column.Identifier())),
+		StartPosition: common.ConvertANTLRLineToPosition(column.GetStart().GetLine()),
+	})
+}
@@ -0,0 +1,121 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/google-sql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*NamingColumnAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_BIGQUERY, advisor.BigQueryNamingColumnConvention, &NamingColumnAdvisor{})
+}
+
+// NamingColumnAdvisor is the advisor checking for column naming convention.
+type NamingColumnAdvisor struct {
+}
+
+// Check checks for column naming convention.
+func (*NamingColumnAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &namingColumnChecker{
+		level:     level,
+		title:     string(checkCtx.Rule.Type),
+		format:    format,
+		maxLength: maxLength,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type namingColumnChecker struct {
+	*parser.BaseGoogleSQLParserListener
+
+	level     storepb.Advice_Status
+	title     string
+	format    *regexp.Regexp
+	maxLength int
+
+	adviceList []*storepb.Advice
+}
+
+// EnterCreate_table_statement is called when production create_table_statement is entered.
+func (l *namingColumnChecker) EnterCreate_table_statement(ctx *parser.Create_table_statementContext) {
+	if ctx.Maybe_dashed_path_expression() == nil || ctx.Table_element_list() == nil {
+		return
+	}
+	tableName := normalizeTableName(ctx.Maybe_dashed_path_expression().GetText())
+	for _, element := range ctx.Table_element_list().AllTable_element() {
+		if column := element.Table_column_definition(); column != nil {
+			l.checkColumnName(tableName, normalizeIdentifier(column.Identifier()), column.GetStart().GetLine())
+		}
+	}
+}
+
+// EnterAlter_action is called when production alter_action is entered.
+func (l *namingColumnChecker) EnterAlter_action(ctx *parser.Alter_actionContext) {
+	if ctx.COLUMN_SYMBOL() == nil {
+		return
+	}
+	tableName := tableNameOfAlterAction(ctx)
+	if tableName == "" {
+		return
+	}
+	switch {
+	// ADD COLUMN [IF NOT EXISTS] column_definition.
+	case ctx.ADD_SYMBOL() != nil && ctx.Table_column_definition() != nil:
+		column := ctx.Table_column_definition()
+		l.checkColumnName(tableName, normalizeIdentifier(column.Identifier()), column.GetStart().GetLine())
+	// RENAME COLUMN [IF EXISTS] old_name TO new_name.
+	case ctx.RENAME_SYMBOL() != nil && len(ctx.AllIdentifier()) == 2:
+		l.checkColumnName(tableName, normalizeIdentifier(ctx.Identifier(1)), ctx.Identifier(1).GetStart().GetLine())
+	default:
+	}
+}
+
+func (l *namingColumnChecker) checkColumnName(tableName, columnName string, line int) {
+	if !l.format.MatchString(columnName) {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingColumnConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s`.`%s` mismatches column naming convention, naming format should be %q", tableName, columnName, l.format),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+	if l.maxLength > 0 && len(columnName) > l.maxLength {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingColumnConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s`.`%s` mismatches column naming convention, its length should be within %d characters", tableName, columnName, l.maxLength),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+}
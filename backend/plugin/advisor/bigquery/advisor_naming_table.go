@@ -0,0 +1,117 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/google-sql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*NamingTableAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_BIGQUERY, advisor.BigQueryNamingTableConvention, &NamingTableAdvisor{})
+}
+
+// NamingTableAdvisor is the advisor checking for table naming convention.
+type NamingTableAdvisor struct {
+}
+
+// Check checks for table naming convention.
+func (*NamingTableAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &namingTableChecker{
+		level:     level,
+		title:     string(checkCtx.Rule.Type),
+		format:    format,
+		maxLength: maxLength,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type namingTableChecker struct {
+	*parser.BaseGoogleSQLParserListener
+
+	level     storepb.Advice_Status
+	title     string
+	format    *regexp.Regexp
+	maxLength int
+
+	adviceList []*storepb.Advice
+}
+
+// EnterCreate_table_statement is called when production create_table_statement is entered.
+func (l *namingTableChecker) EnterCreate_table_statement(ctx *parser.Create_table_statementContext) {
+	if ctx.Maybe_dashed_path_expression() == nil {
+		return
+	}
+	l.checkTableName(normalizeTableName(ctx.Maybe_dashed_path_expression().GetText()), ctx.GetStart().GetLine())
+}
+
+// EnterAlter_action is called when production alter_action is entered.
+func (l *namingTableChecker) EnterAlter_action(ctx *parser.Alter_actionContext) {
+	// ALTER TABLE ... RENAME TO new_name.
+	if ctx.RENAME_SYMBOL() == nil || ctx.COLUMN_SYMBOL() != nil || ctx.Path_expression() == nil {
+		return
+	}
+	if tableNameOfAlterAction(ctx) == "" {
+		return
+	}
+	l.checkTableName(normalizeTableName(ctx.Path_expression().GetText()), ctx.GetStart().GetLine())
+}
+
+// EnterRename_statement is called when production rename_statement is entered.
+func (l *namingTableChecker) EnterRename_statement(ctx *parser.Rename_statementContext) {
+	// RENAME TABLE old_name TO new_name.
+	if !strings.EqualFold(ctx.Identifier().GetText(), "TABLE") || ctx.Path_expression(1) == nil {
+		return
+	}
+	l.checkTableName(normalizeTableName(ctx.Path_expression(1).GetText()), ctx.GetStart().GetLine())
+}
+
+func (l *namingTableChecker) checkTableName(tableName string, line int) {
+	if !l.format.MatchString(tableName) {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingTableConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s` mismatches table naming convention, naming format should be %q", tableName, l.format),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+	if l.maxLength > 0 && len(tableName) > l.maxLength {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingTableConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s` mismatches table naming convention, its length should be within %d characters", tableName, l.maxLength),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package bigquery
+
+import (
+	"context"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/google-sql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*SelectNoSelectAllAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_BIGQUERY, advisor.BigQueryNoSelectAll, &SelectNoSelectAllAdvisor{})
+}
+
+// SelectNoSelectAllAdvisor is the advisor checking for no select all.
+type SelectNoSelectAllAdvisor struct {
+}
+
+// Check checks for no select all.
+func (*SelectNoSelectAllAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &selectNoSelectAllChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type selectNoSelectAllChecker struct {
+	*parser.BaseGoogleSQLParserListener
+
+	level storepb.Advice_Status
+	title string
+
+	adviceList []*storepb.Advice
+}
+
+// EnterSelect_column_star is called when production select_column_star is entered.
+func (l *selectNoSelectAllChecker) EnterSelect_column_star(ctx *parser.Select_column_starContext) {
+	l.addAdvice(ctx)
+}
+
+// EnterSelect_column_dot_star is called when production select_column_dot_star is entered.
+func (l *selectNoSelectAllChecker) EnterSelect_column_dot_star(ctx *parser.Select_column_dot_starContext) {
+	l.addAdvice(ctx)
+}
+
+func (l *selectNoSelectAllChecker) addAdvice(ctx antlr.ParserRuleContext) {
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.StatementSelectAll.Int32(),
+		Title:         l.title,
+		Content:       "Avoid using SELECT *.",
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	})
+}
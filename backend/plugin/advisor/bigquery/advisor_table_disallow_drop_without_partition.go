@@ -0,0 +1,85 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/google-sql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*TableDisallowDropWithoutPartitionAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_BIGQUERY, advisor.BigQueryTableDisallowDropWithoutPartition, &TableDisallowDropWithoutPartitionAdvisor{})
+}
+
+// TableDisallowDropWithoutPartitionAdvisor is the advisor disallowing DROP TABLE and TRUNCATE TABLE without a filter,
+// the data should be removed by DELETE with a filter on the partitioning column instead.
+type TableDisallowDropWithoutPartitionAdvisor struct {
+}
+
+// Check checks for DROP TABLE and TRUNCATE TABLE statements.
+func (*TableDisallowDropWithoutPartitionAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &tableDisallowDropWithoutPartitionChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type tableDisallowDropWithoutPartitionChecker struct {
+	*parser.BaseGoogleSQLParserListener
+
+	level storepb.Advice_Status
+	title string
+
+	adviceList []*storepb.Advice
+}
+
+// EnterDrop_statement is called when production drop_statement is entered.
+func (l *tableDisallowDropWithoutPartitionChecker) EnterDrop_statement(ctx *parser.Drop_statementContext) {
+	// Only DROP TABLE is checked, DROP TABLE FUNCTION and DROP SNAPSHOT TABLE don't remove the data of the base table.
+	if ctx.Table_or_table_function() == nil || ctx.Table_or_table_function().FUNCTION_SYMBOL() != nil || ctx.Maybe_dashed_path_expression() == nil {
+		return
+	}
+	l.addAdvice(ctx, "DROP TABLE", ctx.Maybe_dashed_path_expression().GetText())
+}
+
+// EnterTruncate_statement is called when production truncate_statement is entered.
+func (l *tableDisallowDropWithoutPartitionChecker) EnterTruncate_statement(ctx *parser.Truncate_statementContext) {
+	if ctx.Opt_where_expression() != nil || ctx.Maybe_dashed_path_expression() == nil {
+		return
+	}
+	l.addAdvice(ctx, "TRUNCATE TABLE", ctx.Maybe_dashed_path_expression().GetText())
+}
+
+func (l *tableDisallowDropWithoutPartitionChecker) addAdvice(ctx antlr.ParserRuleContext, statementType, path string) {
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.TableDropWithoutPartition.Int32(),
+		Title:         l.title,
+		Content:       fmt.Sprintf("%s removes all data of table `%s`, use DELETE with a filter on the partitioning column to drop the partitions explicitly", statementType, normalizeTableName(path)),
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	})
+}
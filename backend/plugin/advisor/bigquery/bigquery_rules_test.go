@@ -0,0 +1,23 @@
+package bigquery
+
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+func TestBigQueryRules(t *testing.T) {
+	bigqueryRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleStatementRequireWhereForUpdateDelete,
+		advisor.SchemaRuleStatementNoSelectAll,
+		advisor.SchemaRuleTableNaming,
+		advisor.SchemaRuleColumnNaming,
+		advisor.SchemaRuleColumnTypeDisallowList,
+		advisor.SchemaRuleTableDisallowDropWithoutPartition,
+	}
+
+	for _, rule := range bigqueryRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_BIGQUERY, false, false /* record */)
+	}
+}
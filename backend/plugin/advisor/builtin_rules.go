@@ -8,7 +8,7 @@ const (
 
 func GetBuiltinRules(engine storepb.Engine) []*storepb.SQLReviewRule {
 	switch engine {
-	case storepb.Engine_MYSQL, storepb.Engine_POSTGRES, storepb.Engine_TIDB, storepb.Engine_MSSQL, storepb.Engine_ORACLE:
+	case storepb.Engine_MYSQL, storepb.Engine_POSTGRES, storepb.Engine_TIDB, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE, storepb.Engine_STARROCKS, storepb.Engine_DORIS:
 		return []*storepb.SQLReviewRule{
 			{
 				Type:    string(BuiltinRulePriorBackupCheck),
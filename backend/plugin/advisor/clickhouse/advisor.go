@@ -0,0 +1,300 @@
+// Package clickhouse is the advisor for ClickHouse database.
+package clickhouse
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	chparser "github.com/bytebase/bytebase/backend/plugin/parser/clickhouse"
+)
+
+func getStatements(ast any) ([]*chparser.Statement, error) {
+	stmtList, ok := ast.([]*chparser.Statement)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to ClickHouse statements")
+	}
+	return stmtList, nil
+}
+
+// matchKeywords returns true if the tokens starting at i are the keywords.
+func matchKeywords(tokens []*chparser.Token, i int, keywords ...string) bool {
+	if i+len(keywords) > len(tokens) {
+		return false
+	}
+	for j, keyword := range keywords {
+		if !tokens[i+j].IsKeyword(keyword) {
+			return false
+		}
+	}
+	return true
+}
+
+// skipKeywords returns the index after the keywords if the tokens starting at i are the keywords, otherwise i.
+func skipKeywords(tokens []*chparser.Token, i int, keywords ...string) int {
+	if matchKeywords(tokens, i, keywords...) {
+		return i + len(keywords)
+	}
+	return i
+}
+
+// parseName parses the possibly qualified name starting at i, such as db.table.
+// It returns the last part of the name and the index after the name, or an empty name if there is no name at i.
+func parseName(tokens []*chparser.Token, i int) (string, int) {
+	if i >= len(tokens) || !isIdentifier(tokens[i]) {
+		return "", i
+	}
+	for i+2 < len(tokens) && tokens[i+1].IsOperator(".") && isIdentifier(tokens[i+2]) {
+		i += 2
+	}
+	return tokens[i].Identifier(), i + 1
+}
+
+// skipOnCluster skips the ON CLUSTER clause starting at i.
+func skipOnCluster(tokens []*chparser.Token, i int) int {
+	if matchKeywords(tokens, i, "ON", "CLUSTER") {
+		_, next := parseName(tokens, i+2)
+		if next == i+2 && i+2 < len(tokens) {
+			// The cluster name is a string literal.
+			next++
+		}
+		return next
+	}
+	return i
+}
+
+func isIdentifier(token *chparser.Token) bool {
+	return token.Type == chparser.TokenIdentifier || token.Type == chparser.TokenQuotedIdentifier
+}
+
+// findTopLevelKeywords returns the index of the first keywords outside parentheses, or -1 if not found.
+func findTopLevelKeywords(tokens []*chparser.Token, keywords ...string) int {
+	depth := 0
+	for i, token := range tokens {
+		switch {
+		case token.IsOperator("(") || token.IsOperator("["):
+			depth++
+		case token.IsOperator(")") || token.IsOperator("]"):
+			depth--
+		case depth == 0 && matchKeywords(tokens, i, keywords...):
+			return i
+		default:
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits the tokens by the commas outside parentheses.
+func splitTopLevel(tokens []*chparser.Token) [][]*chparser.Token {
+	var result [][]*chparser.Token
+	depth, start := 0, 0
+	for i, token := range tokens {
+		switch {
+		case token.IsOperator("(") || token.IsOperator("["):
+			depth++
+		case token.IsOperator(")") || token.IsOperator("]"):
+			depth--
+		case depth == 0 && token.IsOperator(","):
+			result = append(result, tokens[start:i])
+			start = i + 1
+		default:
+		}
+	}
+	return append(result, tokens[start:])
+}
+
+// findClosingParenthesis returns the index of the parenthesis closing the one at i, or -1 if not found.
+func findClosingParenthesis(tokens []*chparser.Token, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch {
+		case tokens[i].IsOperator("("):
+			depth++
+		case tokens[i].IsOperator(")"):
+			depth--
+			if depth == 0 {
+				return i
+			}
+		default:
+		}
+	}
+	return -1
+}
+
+// joinTokens joins the text of the tokens without whitespaces.
+func joinTokens(tokens []*chparser.Token) string {
+	var buf strings.Builder
+	for _, token := range tokens {
+		_, _ = buf.WriteString(token.Text)
+	}
+	return buf.String()
+}
+
+// columnDefinition is the column definition in CREATE TABLE or ALTER TABLE.
+type columnDefinition struct {
+	name string
+	// tp is the compact text of the column type, such as Nullable(String).
+	tp   string
+	line int
+}
+
+// columnAttributeKeywords are the keywords following the column type in the column definition.
+var columnAttributeKeywords = []string{"NULL", "NOT", "DEFAULT", "MATERIALIZED", "ALIAS", "EPHEMERAL", "CODEC", "TTL", "COMMENT", "PRIMARY", "STATISTICS", "SETTINGS", "FIRST", "AFTER"}
+
+// parseColumnDefinition parses the column definition such as `id UInt64 DEFAULT 0`.
+func parseColumnDefinition(tokens []*chparser.Token) *columnDefinition {
+	if len(tokens) == 0 || !isIdentifier(tokens[0]) {
+		return nil
+	}
+	column := &columnDefinition{
+		name: tokens[0].Identifier(),
+		line: tokens[0].Line,
+	}
+	end := len(tokens)
+	for _, keyword := range columnAttributeKeywords {
+		if i := findTopLevelKeywords(tokens[1:], keyword); i >= 0 && i+1 < end {
+			end = i + 1
+		}
+	}
+	column.tp = joinTokens(tokens[1:end])
+	return column
+}
+
+// createTableStatement is the CREATE TABLE statement.
+type createTableStatement struct {
+	name    string
+	line    int
+	columns []*columnDefinition
+	// engine is the table engine, such as ReplicatedMergeTree.
+	engine string
+	// clauses are the tokens after the table elements, such as ENGINE = MergeTree ORDER BY id.
+	clauses []*chparser.Token
+}
+
+// parseCreateTable parses the CREATE [OR REPLACE] [TEMPORARY] TABLE statement, it returns nil for other statements.
+func parseCreateTable(stmt *chparser.Statement) *createTableStatement {
+	tokens := stmt.Tokens
+	if !matchKeywords(tokens, 0, "CREATE") {
+		return nil
+	}
+	i := skipKeywords(tokens, 1, "OR", "REPLACE")
+	i = skipKeywords(tokens, i, "TEMPORARY")
+	if !matchKeywords(tokens, i, "TABLE") {
+		return nil
+	}
+	i = skipKeywords(tokens, i+1, "IF", "NOT", "EXISTS")
+	name, i := parseName(tokens, i)
+	if name == "" {
+		return nil
+	}
+	createTable := &createTableStatement{
+		name: name,
+		line: tokens[0].Line,
+	}
+	i = skipOnCluster(tokens, i)
+	if matchKeywords(tokens, i, "UUID") {
+		i += 2
+	}
+	if i < len(tokens) && tokens[i].IsOperator("(") {
+		end := findClosingParenthesis(tokens, i)
+		if end < 0 {
+			return nil
+		}
+		for _, element := range splitTopLevel(tokens[i+1 : end]) {
+			if len(element) == 0 || matchKeywords(element, 0, "INDEX") || matchKeywords(element, 0, "PROJECTION") || matchKeywords(element, 0, "CONSTRAINT") || matchKeywords(element, 0, "PRIMARY", "KEY") {
+				continue
+			}
+			if column := parseColumnDefinition(element); column != nil {
+				createTable.columns = append(createTable.columns, column)
+			}
+		}
+		i = end + 1
+	}
+	if i < len(tokens) {
+		createTable.clauses = tokens[i:]
+	}
+	// Exclude the query of CREATE TABLE ... AS SELECT.
+	for _, keyword := range []string{"SELECT", "WITH"} {
+		if j := findTopLevelKeywords(createTable.clauses, "AS", keyword); j >= 0 {
+			createTable.clauses = createTable.clauses[:j]
+		}
+	}
+	if j := findTopLevelKeywords(createTable.clauses, "ENGINE"); j >= 0 {
+		j++
+		if j < len(createTable.clauses) && createTable.clauses[j].IsOperator("=") {
+			j++
+		}
+		if j < len(createTable.clauses) {
+			createTable.engine = createTable.clauses[j].Text
+		}
+	}
+	return createTable
+}
+
+// isMergeTree returns true if the table engine belongs to the MergeTree family.
+func (c *createTableStatement) isMergeTree() bool {
+	return strings.HasSuffix(c.engine, "MergeTree")
+}
+
+// hasClause returns true if the table has the clause such as PARTITION BY, and the clause is not an empty tuple.
+func (c *createTableStatement) hasClause(keywords ...string) bool {
+	i := findTopLevelKeywords(c.clauses, keywords...)
+	if i < 0 {
+		return false
+	}
+	expr := strings.ToLower(joinTokens(c.clauses[i+len(keywords):]))
+	return !strings.HasPrefix(expr, "tuple()") && !strings.HasPrefix(expr, "()")
+}
+
+// alterTableStatement is the ALTER TABLE statement.
+type alterTableStatement struct {
+	name string
+	// commands are the comma-separated commands, such as DELETE WHERE id = 1.
+	commands [][]*chparser.Token
+}
+
+// parseAlterTable parses the ALTER TABLE statement, it returns nil for other statements.
+func parseAlterTable(stmt *chparser.Statement) *alterTableStatement {
+	tokens := stmt.Tokens
+	if !matchKeywords(tokens, 0, "ALTER", "TABLE") {
+		return nil
+	}
+	name, i := parseName(tokens, 2)
+	if name == "" {
+		return nil
+	}
+	i = skipOnCluster(tokens, i)
+	alterTable := &alterTableStatement{name: name}
+	start := i
+	for _, element := range splitTopLevel(tokens[i:]) {
+		// The assignments of UPDATE are also separated by commas, such as UPDATE a = 1, b = 2 WHERE id = 1.
+		if start < i && !isAlterCommand(element) {
+			i += len(element) + 1
+			continue
+		}
+		if start < i {
+			alterTable.commands = append(alterTable.commands, tokens[start:i-1])
+		}
+		start = i
+		i += len(element) + 1
+	}
+	if start < len(tokens) {
+		alterTable.commands = append(alterTable.commands, tokens[start:])
+	}
+	return alterTable
+}
+
+// alterCommandKeywords are the keywords starting the commands of ALTER TABLE.
+var alterCommandKeywords = []string{"ADD", "DROP", "MODIFY", "ALTER", "RENAME", "CLEAR", "COMMENT", "UPDATE", "DELETE", "MATERIALIZE", "ATTACH", "DETACH", "FREEZE", "UNFREEZE", "MOVE", "REPLACE", "FETCH", "APPLY", "REMOVE", "RESET"}
+
+func isAlterCommand(tokens []*chparser.Token) bool {
+	if len(tokens) == 0 || (len(tokens) > 1 && tokens[1].IsOperator("=")) {
+		return false
+	}
+	for _, keyword := range alterCommandKeywords {
+		if tokens[0].IsKeyword(keyword) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*ColumnTypeDisallowListAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseColumnTypeDisallowList, &ColumnTypeDisallowListAdvisor{})
+}
+
+// ColumnTypeDisallowListAdvisor is the advisor checking for disallowed types for column.
+type ColumnTypeDisallowListAdvisor struct {
+}
+
+// Check checks for disallowed types for column.
+func (*ColumnTypeDisallowListAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalStringArrayTypeRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	typeRestriction := make(map[string]bool)
+	for _, tp := range payload.List {
+		typeRestriction[strings.ToUpper(tp)] = true
+	}
+
+	var adviceList []*storepb.Advice
+	check := func(tableName string, column *columnDefinition) {
+		if column == nil {
+			return
+		}
+		columnType := strings.ToUpper(column.tp)
+		if typeRestriction[columnType] {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.DisabledColumnType.Int32(),
+				Title:         string(checkCtx.Rule.Type),
+				Content:       fmt.Sprintf("Disallow column type %s but column `%s`.`%s` is", columnType, tableName, column.name),
+				StartPosition: common.ConvertANTLRLineToPosition(column.line),
+			})
+		}
+	}
+	for _, stmt := range stmtList {
+		if createTable := parseCreateTable(stmt); createTable != nil {
+			for _, column := range createTable.columns {
+				check(createTable.name, column)
+			}
+			continue
+		}
+		alterTable := parseAlterTable(stmt)
+		if alterTable == nil {
+			continue
+		}
+		for _, command := range alterTable.commands {
+			switch {
+			// ADD COLUMN [IF NOT EXISTS] name type
+			case matchKeywords(command, 0, "ADD", "COLUMN"):
+				check(alterTable.name, parseColumnDefinition(command[skipKeywords(command, 2, "IF", "NOT", "EXISTS"):]))
+			// MODIFY COLUMN [IF EXISTS] name type
+			case matchKeywords(command, 0, "MODIFY", "COLUMN"):
+				check(alterTable.name, parseColumnDefinition(command[skipKeywords(command, 2, "IF", "EXISTS"):]))
+			default:
+			}
+		}
+	}
+
+	return adviceList, nil
+}
@@ -0,0 +1,94 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*NamingColumnAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseNamingColumnConvention, &NamingColumnAdvisor{})
+}
+
+// NamingColumnAdvisor is the advisor checking for column naming convention.
+type NamingColumnAdvisor struct {
+}
+
+// Check checks for column naming convention.
+func (*NamingColumnAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	title := string(checkCtx.Rule.Type)
+
+	var adviceList []*storepb.Advice
+	check := func(tableName, columnName string, line int) {
+		if !format.MatchString(columnName) {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.NamingColumnConventionMismatch.Int32(),
+				Title:         title,
+				Content:       fmt.Sprintf("`%s`.`%s` mismatches column naming convention, naming format should be %q", tableName, columnName, format),
+				StartPosition: common.ConvertANTLRLineToPosition(line),
+			})
+		}
+		if maxLength > 0 && len(columnName) > maxLength {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.NamingColumnConventionMismatch.Int32(),
+				Title:         title,
+				Content:       fmt.Sprintf("`%s`.`%s` mismatches column naming convention, its length should be within %d characters", tableName, columnName, maxLength),
+				StartPosition: common.ConvertANTLRLineToPosition(line),
+			})
+		}
+	}
+	for _, stmt := range stmtList {
+		if createTable := parseCreateTable(stmt); createTable != nil {
+			for _, column := range createTable.columns {
+				check(createTable.name, column.name, column.line)
+			}
+			continue
+		}
+		alterTable := parseAlterTable(stmt)
+		if alterTable == nil {
+			continue
+		}
+		for _, command := range alterTable.commands {
+			switch {
+			// ADD COLUMN [IF NOT EXISTS] name type
+			case matchKeywords(command, 0, "ADD", "COLUMN"):
+				if column := parseColumnDefinition(command[skipKeywords(command, 2, "IF", "NOT", "EXISTS"):]); column != nil {
+					check(alterTable.name, column.name, column.line)
+				}
+			// RENAME COLUMN [IF EXISTS] old TO new
+			case matchKeywords(command, 0, "RENAME", "COLUMN"):
+				_, i := parseName(command, skipKeywords(command, 2, "IF", "EXISTS"))
+				if matchKeywords(command, i, "TO") {
+					if columnName, _ := parseName(command, i+1); columnName != "" {
+						check(alterTable.name, columnName, command[i+1].Line)
+					}
+				}
+			default:
+			}
+		}
+	}
+
+	return adviceList, nil
+}
@@ -0,0 +1,83 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*NamingTableAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseNamingTableConvention, &NamingTableAdvisor{})
+}
+
+// NamingTableAdvisor is the advisor checking for table naming convention.
+type NamingTableAdvisor struct {
+}
+
+// Check checks for table naming convention.
+func (*NamingTableAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	title := string(checkCtx.Rule.Type)
+
+	var adviceList []*storepb.Advice
+	check := func(tableName string, line int) {
+		if !format.MatchString(tableName) {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.NamingTableConventionMismatch.Int32(),
+				Title:         title,
+				Content:       fmt.Sprintf("`%s` mismatches table naming convention, naming format should be %q", tableName, format),
+				StartPosition: common.ConvertANTLRLineToPosition(line),
+			})
+		}
+		if maxLength > 0 && len(tableName) > maxLength {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.NamingTableConventionMismatch.Int32(),
+				Title:         title,
+				Content:       fmt.Sprintf("`%s` mismatches table naming convention, its length should be within %d characters", tableName, maxLength),
+				StartPosition: common.ConvertANTLRLineToPosition(line),
+			})
+		}
+	}
+	for _, stmt := range stmtList {
+		if createTable := parseCreateTable(stmt); createTable != nil {
+			check(createTable.name, createTable.line)
+			continue
+		}
+		// RENAME TABLE a TO b [, c TO d]
+		if !matchKeywords(stmt.Tokens, 0, "RENAME", "TABLE") {
+			continue
+		}
+		for _, pair := range splitTopLevel(stmt.Tokens[2:]) {
+			_, i := parseName(pair, 0)
+			if !matchKeywords(pair, i, "TO") {
+				continue
+			}
+			if tableName, _ := parseName(pair, i+1); tableName != "" {
+				check(tableName, pair[i+1].Line)
+			}
+		}
+	}
+
+	return adviceList, nil
+}
@@ -0,0 +1,58 @@
+package clickhouse
+
+import (
+	"context"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*SelectNoSelectAllAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseNoSelectAll, &SelectNoSelectAllAdvisor{})
+}
+
+// SelectNoSelectAllAdvisor is the advisor checking for no select all.
+type SelectNoSelectAllAdvisor struct {
+}
+
+// Check checks for no select all.
+func (*SelectNoSelectAllAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, stmt := range stmtList {
+		for i, token := range stmt.Tokens {
+			if !token.IsOperator("*") || i == 0 {
+				continue
+			}
+			// The asterisk is a select item if it follows SELECT, DISTINCT, a comma or a table qualifier,
+			// otherwise it is a multiplication or an argument such as count(*).
+			prev := stmt.Tokens[i-1]
+			if !prev.IsKeyword("SELECT") && !prev.IsKeyword("DISTINCT") && !prev.IsKeyword("ALL") && !prev.IsOperator(",") && !prev.IsOperator(".") {
+				continue
+			}
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.StatementSelectAll.Int32(),
+				Title:         string(checkCtx.Rule.Type),
+				Content:       "Avoid using SELECT *.",
+				StartPosition: common.ConvertANTLRLineToPosition(token.Line),
+			})
+		}
+	}
+
+	return adviceList, nil
+}
@@ -0,0 +1,72 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*TableDisallowDropWithoutPartitionAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseTableDisallowDropWithoutPartition, &TableDisallowDropWithoutPartitionAdvisor{})
+}
+
+// TableDisallowDropWithoutPartitionAdvisor is the advisor disallowing DROP TABLE and TRUNCATE TABLE,
+// the data should be removed by ALTER TABLE ... DROP PARTITION instead.
+type TableDisallowDropWithoutPartitionAdvisor struct {
+}
+
+// Check checks for DROP TABLE and TRUNCATE TABLE statements.
+func (*TableDisallowDropWithoutPartitionAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, stmt := range stmtList {
+		tokens := stmt.Tokens
+		var statementType string
+		i := 1
+		switch {
+		case matchKeywords(tokens, 0, "DROP"):
+			statementType = "DROP TABLE"
+			i = skipKeywords(tokens, i, "TEMPORARY")
+			if !matchKeywords(tokens, i, "TABLE") {
+				continue
+			}
+			i = skipKeywords(tokens, i+1, "IF", "EXISTS")
+		case matchKeywords(tokens, 0, "TRUNCATE"):
+			statementType = "TRUNCATE TABLE"
+			i = skipKeywords(tokens, i, "TEMPORARY")
+			i = skipKeywords(tokens, i, "TABLE")
+			i = skipKeywords(tokens, i, "IF", "EXISTS")
+		default:
+			continue
+		}
+		tableName, _ := parseName(tokens, i)
+		if tableName == "" {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Code:          advisor.TableDropWithoutPartition.Int32(),
+			Title:         string(checkCtx.Rule.Type),
+			Content:       fmt.Sprintf("%s removes all data of table `%s`, use ALTER TABLE ... DROP PARTITION to drop the partitions explicitly", statementType, tableName),
+			StartPosition: common.ConvertANTLRLineToPosition(tokens[0].Line),
+		})
+	}
+
+	return adviceList, nil
+}
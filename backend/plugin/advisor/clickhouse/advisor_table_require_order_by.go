@@ -0,0 +1,53 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*TableRequireOrderByAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseTableRequireOrderBy, &TableRequireOrderByAdvisor{})
+}
+
+// TableRequireOrderByAdvisor is the advisor checking the MergeTree family tables have the sorting key.
+type TableRequireOrderByAdvisor struct {
+}
+
+// Check checks the MergeTree family tables have a non-empty ORDER BY clause.
+// The PRIMARY KEY clause is also accepted because it is the sorting key if ORDER BY is omitted.
+func (*TableRequireOrderByAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, stmt := range stmtList {
+		createTable := parseCreateTable(stmt)
+		if createTable == nil || !createTable.isMergeTree() || createTable.hasClause("ORDER", "BY") || createTable.hasClause("PRIMARY", "KEY") {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Code:          advisor.TableNoOrderBy.Int32(),
+			Title:         string(checkCtx.Rule.Type),
+			Content:       fmt.Sprintf("Table `%s` with %s engine requires ORDER BY clause with sorting key", createTable.name, createTable.engine),
+			StartPosition: common.ConvertANTLRLineToPosition(createTable.line),
+		})
+	}
+
+	return adviceList, nil
+}
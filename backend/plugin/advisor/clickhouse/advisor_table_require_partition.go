@@ -0,0 +1,52 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*TableRequirePartitionAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseTableRequirePartition, &TableRequirePartitionAdvisor{})
+}
+
+// TableRequirePartitionAdvisor is the advisor checking the MergeTree family tables have the partition key.
+type TableRequirePartitionAdvisor struct {
+}
+
+// Check checks the MergeTree family tables have the PARTITION BY clause.
+func (*TableRequirePartitionAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, stmt := range stmtList {
+		createTable := parseCreateTable(stmt)
+		if createTable == nil || !createTable.isMergeTree() || createTable.hasClause("PARTITION", "BY") {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Code:          advisor.TableNoPartition.Int32(),
+			Title:         string(checkCtx.Rule.Type),
+			Content:       fmt.Sprintf("Table `%s` with %s engine requires PARTITION BY clause", createTable.name, createTable.engine),
+			StartPosition: common.ConvertANTLRLineToPosition(createTable.line),
+		})
+	}
+
+	return adviceList, nil
+}
@@ -0,0 +1,92 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	chparser "github.com/bytebase/bytebase/backend/plugin/parser/clickhouse"
+)
+
+var (
+	_ advisor.Advisor = (*WhereRequireForUpdateDeleteAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseWhereRequirementForUpdateDelete, &WhereRequireForUpdateDeleteAdvisor{})
+}
+
+// WhereRequireForUpdateDeleteAdvisor is the advisor checking for WHERE clause requirement for UPDATE and DELETE statements,
+// including the ALTER TABLE ... UPDATE and ALTER TABLE ... DELETE mutations.
+type WhereRequireForUpdateDeleteAdvisor struct {
+}
+
+// Check checks for WHERE clause requirement.
+func (*WhereRequireForUpdateDeleteAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, err := getStatements(checkCtx.AST)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	title := string(checkCtx.Rule.Type)
+
+	var adviceList []*storepb.Advice
+	check := func(tokens []*chparser.Token, statementType string) {
+		if content := checkWhereClause(tokens, statementType); content != "" {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Code:          advisor.StatementNoWhere.Int32(),
+				Title:         title,
+				Content:       content,
+				StartPosition: common.ConvertANTLRLineToPosition(tokens[0].Line),
+			})
+		}
+	}
+	for _, stmt := range stmtList {
+		switch {
+		case matchKeywords(stmt.Tokens, 0, "DELETE"):
+			check(stmt.Tokens, "DELETE")
+		case matchKeywords(stmt.Tokens, 0, "UPDATE"):
+			check(stmt.Tokens, "UPDATE")
+		case matchKeywords(stmt.Tokens, 0, "ALTER", "TABLE"):
+			alterTable := parseAlterTable(stmt)
+			if alterTable == nil {
+				continue
+			}
+			for _, command := range alterTable.commands {
+				switch {
+				case matchKeywords(command, 0, "DELETE"):
+					check(command, "ALTER TABLE ... DELETE")
+				case matchKeywords(command, 0, "UPDATE"):
+					check(command, "ALTER TABLE ... UPDATE")
+				default:
+				}
+			}
+		default:
+		}
+	}
+
+	return adviceList, nil
+}
+
+// checkWhereClause returns the advice content if the WHERE clause is missing or always true.
+func checkWhereClause(tokens []*chparser.Token, statementType string) string {
+	i := findTopLevelKeywords(tokens, "WHERE")
+	if i < 0 {
+		return fmt.Sprintf("WHERE clause is required for %s statement.", statementType)
+	}
+	condition := tokens[i+1:]
+	if j := findTopLevelKeywords(condition, "SETTINGS"); j >= 0 {
+		condition = condition[:j]
+	}
+	if advisor.IsAlwaysTrueCondition(joinTokens(condition)) {
+		return fmt.Sprintf("WHERE clause of %s statement is always true, it affects all rows.", statementType)
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package clickhouse
+
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+func TestClickHouseRules(t *testing.T) {
+	clickhouseRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleStatementRequireWhereForUpdateDelete,
+		advisor.SchemaRuleStatementNoSelectAll,
+		advisor.SchemaRuleTableNaming,
+		advisor.SchemaRuleColumnNaming,
+		advisor.SchemaRuleColumnTypeDisallowList,
+		advisor.SchemaRuleTableRequirePartition,
+		advisor.SchemaRuleTableRequireOrderBy,
+		advisor.SchemaRuleTableDisallowDropWithoutPartition,
+	}
+
+	for _, rule := range clickhouseRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_CLICKHOUSE, false, false /* record */)
+	}
+}
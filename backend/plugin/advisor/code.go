@@ -130,6 +130,9 @@ const (
 	TableExceedLimitSize              Code = 615
 	NoCharset                         Code = 616
 	NoCollation                       Code = 617
+	TableNoPartition                  Code = 618
+	TableNoOrderBy                    Code = 619
+	TableDropWithoutPartition         Code = 620
 
 	// 701 ~ 799 database advisor error code.
 	DatabaseNotEmpty       Code = 701
@@ -205,6 +208,9 @@ const (
 
 	// 2001 ~ 2099 builtin error code.
 	BuiltinPriorBackupCheck Code = 2001
+
+	// 2101 ~ 2199 custom rule error code.
+	CustomRuleViolation Code = 2101
 )
 
 // Int returns the int type of code.
@@ -472,6 +478,9 @@ const (
 	// MySQLBuiltinPriorBackupCheck is an advisor type for MySQL prior backup check.
 	MySQLBuiltinPriorBackupCheck Type = "bb.plugin.advisor.mysql.builtin.prior-backup-check"
 
+	// MySQLCustomRule is an advisor type for MySQL user-defined CEL rules.
+	MySQLCustomRule Type = "bb.plugin.advisor.mysql.custom-rule"
+
 	// MySQLStatementAddColumnWithoutPosition is an advisor type for MySQL checking no position in ADD COLUMN clause.
 	MySQLStatementAddColumnWithoutPosition Type = "bb.plugin.advisor.mysql.statement.add-column-without-position"
 
@@ -657,6 +666,9 @@ const (
 	// PostgreSQLBuiltinPriorBackupCheck is an advisor type for PostgreSQL do prior backup check.
 	PostgreSQLBuiltinPriorBackupCheck Type = "bb.plugin.advisor.postgresql.builtin.prior-backup-check"
 
+	// PostgreSQLCustomRule is an advisor type for PostgreSQL user-defined CEL rules.
+	PostgreSQLCustomRule Type = "bb.plugin.advisor.postgresql.custom-rule"
+
 	// PostgreSQLStatementObjectOwnerCheck is an advisor type for PostgreSQL do object owner check.
 	PostgreSQLStatementObjectOwnerCheck Type = "bb.plugin.advisor.postgresql.statement.object-owner-check"
 
@@ -792,6 +804,9 @@ const (
 	// SnowflakeMigrationCompatibility is an advisor type for Snowflake migration compatibility.
 	SnowflakeMigrationCompatibility Type = "bb.plugin.advisor.snowflake.migration-compatibility"
 
+	// SnowflakeBuiltinPriorBackupCheck is an advisor type for Snowflake prior backup check.
+	SnowflakeBuiltinPriorBackupCheck Type = "bb.plugin.advisor.snowflake.builtin.prior-backup-check"
+
 	// MSSQL Advisor.
 
 	// MSSQLSyntax is an advisor type for MSSQL syntax.
@@ -864,4 +879,75 @@ const (
 
 	MSSQLStatementDisallowMixInDDL Type = "bb.plugin.advisor.mssql.statement.disallow-mix-in-ddl"
 	MSSQLStatementDisallowMixInDML Type = "bb.plugin.advisor.mssql.statement.disallow-mix-in-dml"
+
+	// ClickHouse Advisor.
+
+	// ClickHouseWhereRequirementForUpdateDelete is an advisor type for ClickHouse WHERE clause requirement for UPDATE/DELETE statements and mutations.
+	ClickHouseWhereRequirementForUpdateDelete Type = "bb.plugin.advisor.clickhouse.where.require.update-delete"
+
+	// ClickHouseNoSelectAll is an advisor type for ClickHouse no select all.
+	ClickHouseNoSelectAll Type = "bb.plugin.advisor.clickhouse.select.no-select-all"
+
+	// ClickHouseNamingTableConvention is an advisor type for ClickHouse table naming convention.
+	ClickHouseNamingTableConvention Type = "bb.plugin.advisor.clickhouse.naming.table"
+
+	// ClickHouseNamingColumnConvention is an advisor type for ClickHouse column naming convention.
+	ClickHouseNamingColumnConvention Type = "bb.plugin.advisor.clickhouse.naming.column"
+
+	// ClickHouseColumnTypeDisallowList is an advisor type for ClickHouse column type disallow list.
+	ClickHouseColumnTypeDisallowList Type = "bb.plugin.advisor.clickhouse.column.type-disallow-list"
+
+	// ClickHouseTableRequirePartition is an advisor type for ClickHouse MergeTree table require partition key.
+	ClickHouseTableRequirePartition Type = "bb.plugin.advisor.clickhouse.table.require-partition"
+
+	// ClickHouseTableRequireOrderBy is an advisor type for ClickHouse MergeTree table require sorting key.
+	ClickHouseTableRequireOrderBy Type = "bb.plugin.advisor.clickhouse.table.require-order-by"
+
+	// ClickHouseTableDisallowDropWithoutPartition is an advisor type for ClickHouse disallow dropping the whole table.
+	ClickHouseTableDisallowDropWithoutPartition Type = "bb.plugin.advisor.clickhouse.table.disallow-drop-without-partition"
+
+	// BigQuery Advisor.
+
+	// BigQueryWhereRequirementForUpdateDelete is an advisor type for BigQuery WHERE clause requirement for UPDATE/DELETE statements.
+	BigQueryWhereRequirementForUpdateDelete Type = "bb.plugin.advisor.bigquery.where.require.update-delete"
+
+	// BigQueryNoSelectAll is an advisor type for BigQuery no select all.
+	BigQueryNoSelectAll Type = "bb.plugin.advisor.bigquery.select.no-select-all"
+
+	// BigQueryNamingTableConvention is an advisor type for BigQuery table naming convention.
+	BigQueryNamingTableConvention Type = "bb.plugin.advisor.bigquery.naming.table"
+
+	// BigQueryNamingColumnConvention is an advisor type for BigQuery column naming convention.
+	BigQueryNamingColumnConvention Type = "bb.plugin.advisor.bigquery.naming.column"
+
+	// BigQueryColumnTypeDisallowList is an advisor type for BigQuery column type disallow list.
+	BigQueryColumnTypeDisallowList Type = "bb.plugin.advisor.bigquery.column.type-disallow-list"
+
+	// BigQueryTableDisallowDropWithoutPartition is an advisor type for BigQuery disallow dropping the whole table.
+	BigQueryTableDisallowDropWithoutPartition Type = "bb.plugin.advisor.bigquery.table.disallow-drop-without-partition"
+
+	// Trino Advisor.
+
+	// TrinoWhereRequirementForUpdateDelete is an advisor type for Trino WHERE clause requirement for UPDATE/DELETE statements.
+	TrinoWhereRequirementForUpdateDelete Type = "bb.plugin.advisor.trino.where.require.update-delete"
+
+	// TrinoNoSelectAll is an advisor type for Trino no select all.
+	TrinoNoSelectAll Type = "bb.plugin.advisor.trino.select.no-select-all"
+
+	// TrinoNamingTableConvention is an advisor type for Trino table naming convention.
+	TrinoNamingTableConvention Type = "bb.plugin.advisor.trino.naming.table"
+
+	// TrinoNamingColumnConvention is an advisor type for Trino column naming convention.
+	TrinoNamingColumnConvention Type = "bb.plugin.advisor.trino.naming.column"
+
+	// TrinoColumnTypeDisallowList is an advisor type for Trino column type disallow list.
+	TrinoColumnTypeDisallowList Type = "bb.plugin.advisor.trino.column.type-disallow-list"
+
+	// TrinoTableDisallowDropWithoutPartition is an advisor type for Trino disallow dropping the whole table.
+	TrinoTableDisallowDropWithoutPartition Type = "bb.plugin.advisor.trino.table.disallow-drop-without-partition"
+
+	// Doris Advisor.
+
+	// DorisBuiltinPriorBackupCheck is an advisor type for Doris and StarRocks prior backup check.
+	DorisBuiltinPriorBackupCheck Type = "bb.plugin.advisor.doris.builtin.prior-backup-check"
 )
@@ -0,0 +1,267 @@
+package advisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	// ColumnActionAdd is the action for new columns, including the columns in CREATE TABLE.
+	ColumnActionAdd = "ADD"
+	// ColumnActionModify is the action for changing the definition of existing columns.
+	ColumnActionModify = "MODIFY"
+	// ColumnActionDrop is the action for dropping columns.
+	ColumnActionDrop = "DROP"
+)
+
+// CustomRulePayload is the payload for the custom rule.
+type CustomRulePayload struct {
+	Title string `json:"title"`
+	// Expression is the CEL expression over the statement facts.
+	// The statement violates the rule if the expression evaluates to true.
+	Expression string `json:"expression"`
+	// Message is the advice content reported on violation.
+	Message string `json:"message"`
+}
+
+// UnmarshalCustomRulePayload will unmarshal payload to CustomRulePayload and compile the expression.
+func UnmarshalCustomRulePayload(payload string) (*CustomRulePayload, cel.Program, error) {
+	var crp CustomRulePayload
+	if err := json.Unmarshal([]byte(payload), &crp); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to unmarshal custom rule payload %q", payload)
+	}
+	if crp.Title == "" {
+		return nil, nil, errors.Errorf("invalid custom rule payload, title cannot be empty")
+	}
+	if crp.Expression == "" {
+		return nil, nil, errors.Errorf("invalid custom rule payload, expression cannot be empty")
+	}
+	e, err := cel.NewEnv(common.SQLReviewCustomRuleCELAttributes...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cel env")
+	}
+	ast, issues := e.Compile(crp.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, nil, errors.Wrapf(issues.Err(), "failed to compile custom rule expression %q", crp.Expression)
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, nil, errors.Errorf("custom rule expression %q must evaluate to bool, got %s", crp.Expression, ast.OutputType())
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create program for custom rule expression %q", crp.Expression)
+	}
+	return &crp, prog, nil
+}
+
+// StatementFact is the normalized fact of a single statement evaluated by the custom rule.
+type StatementFact struct {
+	// Type is the statement type, such as CREATE_TABLE, ALTER_TABLE, DROP_TABLE, TRUNCATE and INSERT.
+	Type    string
+	Text    string
+	Tables  []*TableFact
+	Columns []*ColumnFact
+}
+
+// TableFact is the fact of a table affected by the statement.
+type TableFact struct {
+	Schema string
+	Name   string
+	// Exists is true if the table exists in the database before the change.
+	Exists              bool
+	Classification      string
+	ClassificationLevel string
+}
+
+// ColumnFact is the fact of a column affected by the statement.
+// Nullable and HasDefault are nil if the statement doesn't define them,
+// and will be filled from the database schema.
+type ColumnFact struct {
+	Schema string
+	Table  string
+	Name   string
+	// Type is the lower case column type without length or precision, such as varchar and int.
+	Type       string
+	Nullable   *bool
+	HasDefault *bool
+	// Action is one of ColumnActionAdd, ColumnActionModify and ColumnActionDrop.
+	Action string
+	// Comment is the column comment defined in the statement.
+	Comment string
+
+	// Exists is true if the column exists in the database before the change.
+	Exists              bool
+	Classification      string
+	ClassificationLevel string
+}
+
+// CustomRuleChecker evaluates the custom rule against the statement facts.
+type CustomRuleChecker struct {
+	payload              *CustomRulePayload
+	program              cel.Program
+	dbSchema             *storepb.DatabaseSchemaMetadata
+	classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig
+}
+
+// NewCustomRuleChecker creates a custom rule checker from the advisor context.
+func NewCustomRuleChecker(checkCtx Context) (*CustomRuleChecker, error) {
+	payload, program, err := UnmarshalCustomRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &CustomRuleChecker{
+		payload:              payload,
+		program:              program,
+		dbSchema:             checkCtx.DBSchema,
+		classificationConfig: checkCtx.ClassificationConfig,
+	}, nil
+}
+
+// Title returns the advice title.
+func (c *CustomRuleChecker) Title() string {
+	return c.payload.Title
+}
+
+// Content returns the advice content.
+func (c *CustomRuleChecker) Content() string {
+	if c.payload.Message != "" {
+		return c.payload.Message
+	}
+	return fmt.Sprintf("The statement violates the custom rule %q", c.payload.Title)
+}
+
+// Violated annotates the fact with the database schema and classification, and returns true if the fact violates the rule.
+func (c *CustomRuleChecker) Violated(fact *StatementFact) (bool, error) {
+	c.annotate(fact)
+	out, _, err := c.program.Eval(map[string]any{
+		"statement": fact.toCELValue(),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate custom rule %q", c.payload.Title)
+	}
+	violated, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("custom rule %q evaluates to %v, expect bool", c.payload.Title, out.Value())
+	}
+	return violated, nil
+}
+
+func (c *CustomRuleChecker) annotate(fact *StatementFact) {
+	for _, table := range fact.Tables {
+		tableMetadata := c.findTable(table.Schema, table.Name)
+		if tableMetadata == nil {
+			continue
+		}
+		table.Exists = true
+		table.Classification, table.ClassificationLevel = c.getClassification(tableMetadata.Comment)
+	}
+	for _, column := range fact.Columns {
+		comment := column.Comment
+		if columnMetadata := c.findColumn(column.Schema, column.Table, column.Name); columnMetadata != nil {
+			column.Exists = true
+			if column.Type == "" {
+				column.Type = columnMetadata.Type
+			}
+			if column.Nullable == nil {
+				nullable := columnMetadata.Nullable
+				column.Nullable = &nullable
+			}
+			if column.HasDefault == nil {
+				hasDefault := columnMetadata.Default != "" || columnMetadata.DefaultExpression != "" || columnMetadata.DefaultNull
+				column.HasDefault = &hasDefault
+			}
+			if comment == "" {
+				comment = columnMetadata.Comment
+			}
+		}
+		column.Type = normalizeColumnType(column.Type)
+		column.Classification, column.ClassificationLevel = c.getClassification(comment)
+	}
+}
+
+func (c *CustomRuleChecker) findTable(schemaName, tableName string) *storepb.TableMetadata {
+	for _, schema := range c.dbSchema.GetSchemas() {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+func (c *CustomRuleChecker) findColumn(schemaName, tableName, columnName string) *storepb.ColumnMetadata {
+	table := c.findTable(schemaName, tableName)
+	if table == nil {
+		return nil
+	}
+	for _, column := range table.Columns {
+		if column.Name == columnName {
+			return column
+		}
+	}
+	return nil
+}
+
+func (c *CustomRuleChecker) getClassification(comment string) (string, string) {
+	classification, _ := common.GetClassificationAndUserComment(comment, c.classificationConfig)
+	if classification == "" {
+		return "", ""
+	}
+	return classification, c.classificationConfig.GetClassification()[classification].GetLevelId()
+}
+
+// normalizeColumnType strips the length or precision from the column type, e.g. varchar(255) to varchar.
+func normalizeColumnType(tp string) string {
+	for i, c := range tp {
+		if c == '(' {
+			tp = tp[:i]
+			break
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(tp))
+}
+
+func (fact *StatementFact) toCELValue() map[string]any {
+	tables := []map[string]any{}
+	for _, table := range fact.Tables {
+		tables = append(tables, map[string]any{
+			"schema":               table.Schema,
+			"name":                 table.Name,
+			"exists":               table.Exists,
+			"classification":       table.Classification,
+			"classification_level": table.ClassificationLevel,
+		})
+	}
+	columns := []map[string]any{}
+	for _, column := range fact.Columns {
+		columns = append(columns, map[string]any{
+			"schema":               column.Schema,
+			"table":                column.Table,
+			"name":                 column.Name,
+			"type":                 column.Type,
+			"nullable":             column.Nullable != nil && *column.Nullable,
+			"has_default":          column.HasDefault != nil && *column.HasDefault,
+			"action":               column.Action,
+			"exists":               column.Exists,
+			"classification":       column.Classification,
+			"classification_level": column.ClassificationLevel,
+		})
+	}
+	return map[string]any{
+		"type":    fact.Type,
+		"text":    fact.Text,
+		"tables":  tables,
+		"columns": columns,
+	}
+}
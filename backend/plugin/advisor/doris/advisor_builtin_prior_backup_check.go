@@ -0,0 +1,101 @@
+// Package doris is the advisor for Doris and StarRocks database.
+package doris
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	dorisparser "github.com/bytebase/bytebase/backend/plugin/parser/doris"
+)
+
+var (
+	_ advisor.Advisor = (*StatementPriorBackupCheckAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DORIS, advisor.DorisBuiltinPriorBackupCheck, &StatementPriorBackupCheckAdvisor{})
+	advisor.Register(storepb.Engine_STARROCKS, advisor.DorisBuiltinPriorBackupCheck, &StatementPriorBackupCheckAdvisor{})
+}
+
+// StatementPriorBackupCheckAdvisor is the advisor checking whether the statements can be backed up before execution.
+type StatementPriorBackupCheckAdvisor struct {
+}
+
+// Check checks whether the statements can be backed up before execution.
+func (*StatementPriorBackupCheckAdvisor) Check(ctx context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	if !checkCtx.EnablePriorBackup || checkCtx.ChangeType != storepb.PlanCheckRunConfig_DML {
+		return nil, nil
+	}
+
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	title := string(checkCtx.Rule.Type)
+
+	var adviceList []*storepb.Advice
+	if len(checkCtx.Statements) > common.MaxSheetCheckSize {
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Title:         title,
+			Content:       fmt.Sprintf("The size of the SQL statements exceeds the maximum limit of %d bytes for backup", common.MaxSheetCheckSize),
+			Code:          advisor.BuiltinPriorBackupCheck.Int32(),
+			StartPosition: common.FirstLinePosition,
+		})
+	}
+
+	if line := dorisparser.GetFirstDDLLine(tree); line > 0 {
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Title:         title,
+			Content:       "Prior backup cannot deal with mixed DDL and DML statements",
+			Code:          advisor.BuiltinPriorBackupCheck.Int32(),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+
+	databaseName := common.BackupDatabaseNameOfEngine(storepb.Engine_DORIS)
+	if !advisor.DatabaseExists(ctx, checkCtx, databaseName) {
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Title:         title,
+			Content:       fmt.Sprintf("Need database %q to do prior backup but it does not exist", databaseName),
+			Code:          advisor.DatabaseNotExists.Int32(),
+			StartPosition: common.FirstLinePosition,
+		})
+	}
+
+	statementTypes := make(map[string]dorisparser.StatementType)
+	for _, table := range dorisparser.ExtractDMLTables(checkCtx.DBSchema.GetName(), tree) {
+		key := fmt.Sprintf("%s.%s", table.Database, table.Table)
+		statementType, ok := statementTypes[key]
+		if !ok {
+			statementTypes[key] = table.StatementType
+			continue
+		}
+		if statementType != table.StatementType && statementType != dorisparser.StatementTypeUnknown {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:        level,
+				Title:         title,
+				Content:       fmt.Sprintf("Prior backup cannot handle mixed DML statements on the same table %q", key),
+				Code:          advisor.BuiltinPriorBackupCheck.Int32(),
+				StartPosition: common.FirstLinePosition,
+			})
+			// Report each table once.
+			statementTypes[key] = dorisparser.StatementTypeUnknown
+		}
+	}
+
+	return adviceList, nil
+}
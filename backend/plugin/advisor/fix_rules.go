@@ -0,0 +1,27 @@
+package advisor
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// fixableRules are the SQL review rules whose advices come with fixes.
+var fixableRules = map[SQLReviewRuleType]bool{
+	SchemaRuleCreateIndexConcurrently:          true,
+	SchemaRuleStatementInsertMustSpecifyColumn: true,
+	SchemaRuleStatementAddFKNotValid:           true,
+	SchemaRuleTableRequirePK:                   true,
+}
+
+// GetFixableRules returns the enabled rules in the rule list whose advices come with fixes.
+func GetFixableRules(ruleList []*storepb.SQLReviewRule) []*storepb.SQLReviewRule {
+	var rules []*storepb.SQLReviewRule
+	for _, rule := range ruleList {
+		if rule.Level == storepb.SQLReviewRuleLevel_DISABLED {
+			continue
+		}
+		if fixableRules[SQLReviewRuleType(rule.Type)] {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
@@ -0,0 +1,255 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	mysql "github.com/bytebase/mysql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+)
+
+var (
+	_ advisor.Advisor = (*CustomRuleAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_MYSQL, advisor.MySQLCustomRule, &CustomRuleAdvisor{})
+}
+
+// CustomRuleAdvisor is the advisor evaluating the user-defined CEL rule against each statement.
+type CustomRuleAdvisor struct {
+}
+
+// Check evaluates the custom rule against each statement.
+func (*CustomRuleAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	list, ok := checkCtx.AST.([]*mysqlparser.ParseResult)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to mysql parser result")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	ruleChecker, err := advisor.NewCustomRuleChecker(checkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, stmt := range list {
+		extractor := &customRuleFactExtractor{
+			fact: &advisor.StatementFact{
+				Type: mysqlparser.GetStatementType(stmt),
+				Text: strings.TrimSpace(stmt.Tokens.GetAllText()),
+			},
+		}
+		antlr.ParseTreeWalkerDefault.Walk(extractor, stmt.Tree)
+
+		violated, err := ruleChecker.Violated(extractor.fact)
+		if err != nil {
+			return nil, err
+		}
+		if !violated {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Code:          advisor.CustomRuleViolation.Int32(),
+			Title:         ruleChecker.Title(),
+			Content:       ruleChecker.Content(),
+			StartPosition: common.ConvertANTLRLineToPosition(stmt.BaseLine + extractor.line),
+		})
+	}
+
+	return adviceList, nil
+}
+
+// customRuleFactExtractor extracts the affected tables and columns of a statement.
+type customRuleFactExtractor struct {
+	*mysql.BaseMySQLParserListener
+
+	fact *advisor.StatementFact
+	// line is the first line of the statement.
+	line int
+}
+
+func (e *customRuleFactExtractor) EnterQuery(ctx *mysql.QueryContext) {
+	e.line = ctx.GetStart().GetLine()
+}
+
+func (e *customRuleFactExtractor) addTable(tableName string) {
+	if tableName == "" {
+		return
+	}
+	for _, table := range e.fact.Tables {
+		if table.Name == tableName {
+			return
+		}
+	}
+	e.fact.Tables = append(e.fact.Tables, &advisor.TableFact{Name: tableName})
+}
+
+func (e *customRuleFactExtractor) addColumn(tableName, columnName, action string, ctx mysql.IFieldDefinitionContext) {
+	column := &advisor.ColumnFact{
+		Table:  tableName,
+		Name:   columnName,
+		Action: action,
+	}
+	if ctx != nil {
+		if ctx.DataType() != nil {
+			column.Type = mysqlparser.NormalizeMySQLDataType(ctx.DataType(), true /* compact */)
+		}
+		nullable, hasDefault := true, false
+		for _, attribute := range ctx.AllColumnAttribute() {
+			if attribute == nil {
+				continue
+			}
+			switch {
+			case attribute.NullLiteral() != nil && attribute.NOT_SYMBOL() != nil:
+				nullable = false
+			case attribute.PRIMARY_SYMBOL() != nil:
+				nullable = false
+			case attribute.DEFAULT_SYMBOL() != nil:
+				hasDefault = true
+			case attribute.GetValue() != nil && attribute.GetValue().GetTokenType() == mysql.MySQLParserCOMMENT_SYMBOL && attribute.TextLiteral() != nil:
+				column.Comment = mysqlparser.NormalizeMySQLTextLiteral(attribute.TextLiteral())
+			}
+		}
+		column.Nullable = &nullable
+		column.HasDefault = &hasDefault
+	}
+	e.fact.Columns = append(e.fact.Columns, column)
+}
+
+func (e *customRuleFactExtractor) EnterCreateTable(ctx *mysql.CreateTableContext) {
+	if !mysqlparser.IsTopMySQLRule(&ctx.BaseParserRuleContext) {
+		return
+	}
+	if ctx.TableName() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableName(ctx.TableName())
+	e.addTable(tableName)
+	if ctx.TableElementList() == nil {
+		return
+	}
+	for _, tableElement := range ctx.TableElementList().AllTableElement() {
+		if tableElement == nil || tableElement.ColumnDefinition() == nil || tableElement.ColumnDefinition().ColumnName() == nil {
+			continue
+		}
+		_, _, columnName := mysqlparser.NormalizeMySQLColumnName(tableElement.ColumnDefinition().ColumnName())
+		e.addColumn(tableName, columnName, advisor.ColumnActionAdd, tableElement.ColumnDefinition().FieldDefinition())
+	}
+}
+
+func (e *customRuleFactExtractor) EnterAlterTable(ctx *mysql.AlterTableContext) {
+	if !mysqlparser.IsTopMySQLRule(&ctx.BaseParserRuleContext) {
+		return
+	}
+	if ctx.TableRef() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	e.addTable(tableName)
+	if ctx.AlterTableActions() == nil || ctx.AlterTableActions().AlterCommandList() == nil || ctx.AlterTableActions().AlterCommandList().AlterList() == nil {
+		return
+	}
+	for _, item := range ctx.AlterTableActions().AlterCommandList().AlterList().AllAlterListItem() {
+		if item == nil {
+			continue
+		}
+		switch {
+		// add column
+		case item.ADD_SYMBOL() != nil:
+			switch {
+			case item.Identifier() != nil && item.FieldDefinition() != nil:
+				columnName := mysqlparser.NormalizeMySQLIdentifier(item.Identifier())
+				e.addColumn(tableName, columnName, advisor.ColumnActionAdd, item.FieldDefinition())
+			case item.OPEN_PAR_SYMBOL() != nil && item.TableElementList() != nil:
+				for _, tableElement := range item.TableElementList().AllTableElement() {
+					if tableElement.ColumnDefinition() == nil || tableElement.ColumnDefinition().ColumnName() == nil || tableElement.ColumnDefinition().FieldDefinition() == nil {
+						continue
+					}
+					_, _, columnName := mysqlparser.NormalizeMySQLColumnName(tableElement.ColumnDefinition().ColumnName())
+					e.addColumn(tableName, columnName, advisor.ColumnActionAdd, tableElement.ColumnDefinition().FieldDefinition())
+				}
+			}
+		// modify column
+		case item.MODIFY_SYMBOL() != nil && item.ColumnInternalRef() != nil && item.FieldDefinition() != nil:
+			columnName := mysqlparser.NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())
+			e.addColumn(tableName, columnName, advisor.ColumnActionModify, item.FieldDefinition())
+		// change column
+		case item.CHANGE_SYMBOL() != nil && item.ColumnInternalRef() != nil && item.FieldDefinition() != nil:
+			columnName := mysqlparser.NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())
+			e.addColumn(tableName, columnName, advisor.ColumnActionModify, item.FieldDefinition())
+		// drop column
+		case item.DROP_SYMBOL() != nil && item.ColumnInternalRef() != nil:
+			columnName := mysqlparser.NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())
+			e.addColumn(tableName, columnName, advisor.ColumnActionDrop, nil)
+		}
+	}
+}
+
+func (e *customRuleFactExtractor) EnterDropTable(ctx *mysql.DropTableContext) {
+	if ctx.TableRefList() == nil {
+		return
+	}
+	for _, tableRef := range ctx.TableRefList().AllTableRef() {
+		_, tableName := mysqlparser.NormalizeMySQLTableRef(tableRef)
+		e.addTable(tableName)
+	}
+}
+
+func (e *customRuleFactExtractor) EnterRenameTableStatement(ctx *mysql.RenameTableStatementContext) {
+	for _, renamePair := range ctx.AllRenamePair() {
+		if renamePair.TableRef() == nil {
+			continue
+		}
+		_, tableName := mysqlparser.NormalizeMySQLTableRef(renamePair.TableRef())
+		e.addTable(tableName)
+	}
+}
+
+func (e *customRuleFactExtractor) EnterTruncateTableStatement(ctx *mysql.TruncateTableStatementContext) {
+	if ctx.TableRef() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	e.addTable(tableName)
+}
+
+func (e *customRuleFactExtractor) EnterInsertStatement(ctx *mysql.InsertStatementContext) {
+	if ctx.TableRef() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	e.addTable(tableName)
+}
+
+func (e *customRuleFactExtractor) EnterUpdateStatement(ctx *mysql.UpdateStatementContext) {
+	if ctx.TableReferenceList() == nil {
+		return
+	}
+	tables, err := extractTableReferenceList(ctx.TableReferenceList())
+	if err != nil {
+		return
+	}
+	for _, table := range tables {
+		e.addTable(table.table)
+	}
+}
+
+func (e *customRuleFactExtractor) EnterDeleteStatement(ctx *mysql.DeleteStatementContext) {
+	if ctx.TableRef() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	e.addTable(tableName)
+}
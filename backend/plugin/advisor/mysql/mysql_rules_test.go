@@ -165,6 +165,9 @@ func TestMySQLRules(t *testing.T) {
 		advisor.SchemaRuleFunctionDisallowList,
 		advisor.SchemaRuleStatementDisallowMixInDDL,
 		advisor.SchemaRuleStatementDisallowMixInDML,
+
+		// advisor.SchemaRuleCustom evaluates the user-defined CEL rule.
+		advisor.SchemaRuleCustom,
 	}
 
 	for _, rule := range mysqlRules {
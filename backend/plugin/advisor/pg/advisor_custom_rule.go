@@ -0,0 +1,188 @@
+package pg
+
+import (
+	"context"
+
+	pgquery "github.com/pganalyze/pg_query_go/v6"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+)
+
+var (
+	_ advisor.Advisor = (*CustomRuleAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_POSTGRES, advisor.PostgreSQLCustomRule, &CustomRuleAdvisor{})
+}
+
+// CustomRuleAdvisor is the advisor evaluating the user-defined CEL rule against each statement.
+type CustomRuleAdvisor struct {
+}
+
+// Check evaluates the custom rule against each statement.
+func (*CustomRuleAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	stmtList, ok := checkCtx.AST.([]ast.Node)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Node")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	ruleChecker, err := advisor.NewCustomRuleChecker(checkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, stmt := range stmtList {
+		violated, err := ruleChecker.Violated(extractStatementFact(stmt))
+		if err != nil {
+			return nil, err
+		}
+		if !violated {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:        level,
+			Code:          advisor.CustomRuleViolation.Int32(),
+			Title:         ruleChecker.Title(),
+			Content:       ruleChecker.Content(),
+			StartPosition: common.ConvertPGParserLineToPosition(stmt.LastLine()),
+		})
+	}
+
+	return adviceList, nil
+}
+
+// extractStatementFact extracts the affected tables and columns of the statement.
+func extractStatementFact(stmt ast.Node) *advisor.StatementFact {
+	fact := &advisor.StatementFact{
+		Type: pgparser.GetStatementType(stmt),
+		Text: stmt.Text(),
+	}
+	addTable := func(table *ast.TableDef) {
+		if table == nil {
+			return
+		}
+		schemaName := normalizeSchemaName(table.Schema)
+		for _, t := range fact.Tables {
+			if t.Schema == schemaName && t.Name == table.Name {
+				return
+			}
+		}
+		fact.Tables = append(fact.Tables, &advisor.TableFact{
+			Schema: schemaName,
+			Name:   table.Name,
+		})
+	}
+	addColumn := func(table *ast.TableDef, columnName, action string) *advisor.ColumnFact {
+		column := &advisor.ColumnFact{
+			Schema: normalizeSchemaName(table.Schema),
+			Table:  table.Name,
+			Name:   columnName,
+			Action: action,
+		}
+		fact.Columns = append(fact.Columns, column)
+		return column
+	}
+	addColumnDef := func(table *ast.TableDef, columnDef *ast.ColumnDef, action string) {
+		column := addColumn(table, columnDef.ColumnName, action)
+		column.Type = deparseColumnType(columnDef.Type)
+		nullable, hasDefault := true, false
+		for _, constraint := range columnDef.ConstraintList {
+			switch constraint.Type {
+			case ast.ConstraintTypeNotNull, ast.ConstraintTypePrimary:
+				nullable = false
+			case ast.ConstraintTypeDefault:
+				hasDefault = true
+			default:
+			}
+		}
+		column.Nullable = &nullable
+		column.HasDefault = &hasDefault
+	}
+
+	switch node := stmt.(type) {
+	case *ast.CreateTableStmt:
+		addTable(node.Name)
+		for _, columnDef := range node.ColumnList {
+			addColumnDef(node.Name, columnDef, advisor.ColumnActionAdd)
+		}
+	case *ast.AlterTableStmt:
+		addTable(node.Table)
+		for _, item := range node.AlterItemList {
+			switch cmd := item.(type) {
+			case *ast.AddColumnListStmt:
+				for _, columnDef := range cmd.ColumnList {
+					addColumnDef(node.Table, columnDef, advisor.ColumnActionAdd)
+				}
+			case *ast.DropColumnStmt:
+				addColumn(node.Table, cmd.ColumnName, advisor.ColumnActionDrop)
+			case *ast.AlterColumnTypeStmt:
+				column := addColumn(node.Table, cmd.ColumnName, advisor.ColumnActionModify)
+				column.Type = deparseColumnType(cmd.Type)
+			case *ast.SetNotNullStmt:
+				column := addColumn(node.Table, cmd.ColumnName, advisor.ColumnActionModify)
+				nullable := false
+				column.Nullable = &nullable
+			case *ast.DropNotNullStmt:
+				column := addColumn(node.Table, cmd.ColumnName, advisor.ColumnActionModify)
+				nullable := true
+				column.Nullable = &nullable
+			default:
+			}
+		}
+	case *ast.DropTableStmt:
+		for _, table := range node.TableList {
+			addTable(table)
+		}
+	case *ast.RenameTableStmt:
+		addTable(node.Table)
+	case *ast.InsertStmt:
+		addTable(node.Table)
+	case *ast.UpdateStmt:
+		addTable(node.Table)
+	case *ast.DeleteStmt:
+		addTable(node.Table)
+	case *ast.UnconvertedStmt:
+		// TRUNCATE is not converted, so we parse it again.
+		res, err := pgquery.Parse(node.Text())
+		if err != nil || len(res.GetStmts()) != 1 {
+			break
+		}
+		truncate := res.GetStmts()[0].GetStmt().GetTruncateStmt()
+		if truncate == nil {
+			break
+		}
+		fact.Type = "TRUNCATE"
+		for _, relation := range truncate.GetRelations() {
+			rangeVar := relation.GetRangeVar()
+			if rangeVar == nil {
+				continue
+			}
+			addTable(&ast.TableDef{Schema: rangeVar.GetSchemaname(), Name: rangeVar.GetRelname()})
+		}
+	default:
+	}
+	return fact
+}
+
+func deparseColumnType(tp ast.DataType) string {
+	if tp == nil {
+		return ""
+	}
+	text, err := pgrawparser.Deparse(pgrawparser.DeparseContext{}, tp)
+	if err != nil {
+		return ""
+	}
+	return text
+}
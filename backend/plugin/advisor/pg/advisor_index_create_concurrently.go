@@ -43,6 +43,7 @@ func (*IndexConcurrentlyAdvisor) Check(_ context.Context, checkCtx advisor.Conte
 	}
 
 	for _, stmt := range stmtList {
+		checker.statementText = newStatementText(checkCtx.Statements, stmt)
 		ast.Walk(checker, stmt)
 	}
 
@@ -53,6 +54,8 @@ type indexCreateConcurrentlyChecker struct {
 	adviceList []*storepb.Advice
 	level      storepb.Advice_Status
 	title      string
+	// statementText is the text of the current statement, used to attach the fixes.
+	statementText *statementText
 }
 
 // Visit implements ast.Visitor interface.
@@ -66,6 +69,7 @@ func (checker *indexCreateConcurrentlyChecker) Visit(in ast.Node) ast.Visitor {
 				Title:         checker.title,
 				Content:       "Creating indexes will block writes on the table, unless use CONCURRENTLY",
 				StartPosition: common.ConvertPGParserLineToPosition(in.LastLine()),
+				Fixes:         checker.fixes(),
 			})
 		}
 	case *ast.DropIndexStmt:
@@ -76,8 +80,16 @@ func (checker *indexCreateConcurrentlyChecker) Visit(in ast.Node) ast.Visitor {
 				Title:         checker.title,
 				Content:       "Droping indexes will block writes on the table, unless use CONCURRENTLY",
 				StartPosition: common.ConvertPGParserLineToPosition(in.LastLine()),
+				Fixes:         checker.fixes(),
 			})
 		}
 	}
 	return checker
 }
+
+func (checker *indexCreateConcurrentlyChecker) fixes() []*storepb.Advice_Fix {
+	if fix := concurrentlyFix(checker.statementText); fix != nil {
+		return []*storepb.Advice_Fix{fix}
+	}
+	return nil
+}
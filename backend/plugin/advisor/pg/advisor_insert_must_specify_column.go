@@ -39,12 +39,14 @@ func (*InsertMustSpecifyColumnAdvisor) Check(_ context.Context, checkCtx advisor
 		return nil, err
 	}
 	checker := &insertMustSpecifyColumnChecker{
-		level: level,
-		title: string(checkCtx.Rule.Type),
+		level:    level,
+		title:    string(checkCtx.Rule.Type),
+		dbSchema: checkCtx.DBSchema,
 	}
 
 	for _, stmt := range stmtList {
 		checker.text = advisor.NormalizeStatement(stmt.Text())
+		checker.statementText = newStatementText(checkCtx.Statements, stmt)
 		ast.Walk(checker, stmt)
 	}
 
@@ -56,6 +58,9 @@ type insertMustSpecifyColumnChecker struct {
 	level      storepb.Advice_Status
 	title      string
 	text       string
+	dbSchema   *storepb.DatabaseSchemaMetadata
+	// statementText is the text of the current statement, used to attach the fixes.
+	statementText *statementText
 }
 
 // Visit implements ast.Visitor interface.
@@ -67,8 +72,16 @@ func (checker *insertMustSpecifyColumnChecker) Visit(in ast.Node) ast.Visitor {
 			Title:         checker.title,
 			Content:       fmt.Sprintf("The INSERT statement must specify columns but \"%s\" does not", checker.text),
 			StartPosition: common.ConvertPGParserLineToPosition(node.LastLine()),
+			Fixes:         checker.fixes(),
 		})
 	}
 
 	return checker
 }
+
+func (checker *insertMustSpecifyColumnChecker) fixes() []*storepb.Advice_Fix {
+	if fix := insertColumnListFix(checker.statementText, checker.dbSchema); fix != nil {
+		return []*storepb.Advice_Fix{fix}
+	}
+	return nil
+}
@@ -44,6 +44,8 @@ func (*StatementAddFKNotValidAdvisor) Check(_ context.Context, checkCtx advisor.
 
 	for _, stmt := range stmtList {
 		checker.line = stmt.LastLine()
+		checker.statementText = newStatementText(checkCtx.Statements, stmt)
+		checker.fixIndex = 0
 		ast.Walk(checker, stmt)
 	}
 
@@ -55,6 +57,10 @@ type statementAddFKNotValidChecker struct {
 	level      storepb.Advice_Status
 	title      string
 	line       int
+	// statementText is the text of the current statement, used to attach the fixes.
+	statementText *statementText
+	// fixIndex is the index of the foreign key with validation in the current statement.
+	fixIndex int
 }
 
 // Visit implements ast.Visitor interface.
@@ -67,9 +73,19 @@ func (checker *statementAddFKNotValidChecker) Visit(in ast.Node) ast.Visitor {
 				Title:         checker.title,
 				Content:       "Adding foreign keys with validation will block reads and writes. You can add check foreign keys not valid and then validate separately",
 				StartPosition: common.ConvertPGParserLineToPosition(checker.line),
+				Fixes:         checker.fixes(),
 			})
+			checker.fixIndex++
 		}
 	}
 
 	return checker
 }
+
+func (checker *statementAddFKNotValidChecker) fixes() []*storepb.Advice_Fix {
+	fixes := notValidFixes(checker.statementText)
+	if checker.fixIndex >= len(fixes) {
+		return nil
+	}
+	return []*storepb.Advice_Fix{fixes[checker.fixIndex]}
+}
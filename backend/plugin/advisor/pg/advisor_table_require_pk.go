@@ -46,6 +46,7 @@ func (*TableRequirePKAdvisor) Check(_ context.Context, checkCtx advisor.Context)
 
 	for _, stmt := range stmts {
 		checker.text = stmt.Text()
+		checker.statementText = newStatementText(checkCtx.Statements, stmt)
 		ast.Walk(checker, stmt)
 	}
 
@@ -58,11 +59,14 @@ type tableRequirePKChecker struct {
 	title      string
 	catalog    *catalog.Finder
 	text       string
+	// statementText is the text of the current statement, used to attach the fixes.
+	statementText *statementText
 }
 
 // Visit implements the ast.Visitor interface.
 func (checker *tableRequirePKChecker) Visit(node ast.Node) ast.Visitor {
 	var missingPK *ast.TableDef
+	var fixes []*storepb.Advice_Fix
 	switch n := node.(type) {
 	// CREATE TABLE
 	case *ast.CreateTableStmt:
@@ -77,6 +81,9 @@ func (checker *tableRequirePKChecker) Visit(node ast.Node) ast.Visitor {
 		}
 		if !hasPK {
 			missingPK = n.Name
+			if fix := primaryKeyFix(checker.statementText, n); fix != nil {
+				fixes = append(fixes, fix)
+			}
 		}
 	// DROP CONSTRAINT
 	case *ast.DropConstraintStmt:
@@ -114,6 +121,7 @@ func (checker *tableRequirePKChecker) Visit(node ast.Node) ast.Visitor {
 				checker.text,
 			),
 			StartPosition: common.ConvertPGParserLineToPosition(node.LastLine()),
+			Fixes:         fixes,
 		})
 	}
 
@@ -62,6 +62,7 @@ func TestPostgreSQLRules(t *testing.T) {
 		advisor.SchemaRuleColumnCommentConvention,
 		advisor.SchemaRuleStatementDisallowMixInDDL,
 		advisor.SchemaRuleStatementDisallowMixInDML,
+		advisor.SchemaRuleCustom,
 	}
 
 	for _, rule := range pgRules {
@@ -72,6 +73,8 @@ func TestPostgreSQLRules(t *testing.T) {
 
 // Add SQL review type here if you need metadata for test.
 var advisorNeedMockData = map[advisor.SQLReviewRuleType]bool{
-	advisor.SchemaRuleFullyQualifiedObjectName: true,
-	advisor.BuiltinRulePriorBackupCheck:        true,
+	advisor.SchemaRuleFullyQualifiedObjectName:         true,
+	advisor.BuiltinRulePriorBackupCheck:                true,
+	advisor.SchemaRuleCustom:                           true,
+	advisor.SchemaRuleStatementInsertMustSpecifyColumn: true,
 }
@@ -0,0 +1,302 @@
+package pg
+
+import (
+	"fmt"
+	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v6"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+)
+
+// statementText is the text of a single statement located in the original statements,
+// it converts the byte offsets in the statement text to the positions in the original statements.
+type statementText struct {
+	text string
+	// line and column are the zero-based position of the first byte of text in the original statements.
+	line   int
+	column int
+}
+
+// newStatementText locates the text of the node in the original statements by the byte offset of the node,
+// so that the identical statements are located separately.
+// It returns nil if the text cannot be located.
+func newStatementText(statements string, node ast.Node) *statementText {
+	text := node.Text()
+	offset := node.ByteOffset()
+	if text == "" || offset < 0 || offset+len(text) > len(statements) || statements[offset:offset+len(text)] != text {
+		return nil
+	}
+	prefix := statements[:offset]
+	s := &statementText{
+		text:   text,
+		line:   strings.Count(prefix, "\n"),
+		column: offset,
+	}
+	if lastNewline := strings.LastIndexByte(prefix, '\n'); lastNewline >= 0 {
+		s.column = offset - lastNewline - 1
+	}
+	return s
+}
+
+func (s *statementText) position(offset int) *storepb.Position {
+	prefix := s.text[:offset]
+	lastNewline := strings.LastIndexByte(prefix, '\n')
+	if lastNewline < 0 {
+		return &storepb.Position{Line: int32(s.line), Column: int32(s.column + offset)}
+	}
+	return &storepb.Position{
+		Line:   int32(s.line + strings.Count(prefix, "\n")),
+		Column: int32(offset - lastNewline - 1),
+	}
+}
+
+// insert returns the text edit inserting newText at the byte offset of the statement text.
+func (s *statementText) insert(offset int, newText string) *storepb.Advice_TextEdit {
+	return &storepb.Advice_TextEdit{
+		StartPosition: s.position(offset),
+		EndPosition:   s.position(offset),
+		NewText:       newText,
+	}
+}
+
+func (s *statementText) tokens() []*pgquery.ScanToken {
+	res, err := pgquery.Scan(s.text)
+	if err != nil {
+		return nil
+	}
+	return res.GetTokens()
+}
+
+// tokenIndexAt returns the index of the first token starting at or after the offset.
+func tokenIndexAt(tokens []*pgquery.ScanToken, offset int32) int {
+	for i, token := range tokens {
+		if token.Start >= offset {
+			return i
+		}
+	}
+	return len(tokens)
+}
+
+// skipQualifiedName returns the index of the last token of the qualified name starting at tokens[i].
+func skipQualifiedName(tokens []*pgquery.ScanToken, i int) int {
+	for i+2 < len(tokens) && tokens[i+1].Token == pgquery.Token_ASCII_46 {
+		i += 2
+	}
+	return i
+}
+
+// concurrentlyFix returns the fix adding CONCURRENTLY to CREATE INDEX or DROP INDEX.
+func concurrentlyFix(s *statementText) *storepb.Advice_Fix {
+	if s == nil {
+		return nil
+	}
+	for _, token := range s.tokens() {
+		if token.Token == pgquery.Token_INDEX {
+			return &storepb.Advice_Fix{
+				Title: "Use CONCURRENTLY",
+				Edits: []*storepb.Advice_TextEdit{s.insert(int(token.End), " CONCURRENTLY")},
+			}
+		}
+	}
+	return nil
+}
+
+// notValidFixes returns the fixes adding NOT VALID to the foreign keys with validation
+// in ALTER TABLE ADD CONSTRAINT, in the order they appear in the statement.
+func notValidFixes(s *statementText) []*storepb.Advice_Fix {
+	if s == nil {
+		return nil
+	}
+	res, err := pgquery.Parse(s.text)
+	if err != nil || len(res.GetStmts()) != 1 {
+		return nil
+	}
+	alterTable := res.GetStmts()[0].GetStmt().GetAlterTableStmt()
+	if alterTable == nil {
+		return nil
+	}
+	tokens := s.tokens()
+	var fixes []*storepb.Advice_Fix
+	for _, cmd := range alterTable.GetCmds() {
+		alterCmd := cmd.GetAlterTableCmd()
+		if alterCmd.GetSubtype() != pgquery.AlterTableType_AT_AddConstraint {
+			continue
+		}
+		constraint := alterCmd.GetDef().GetConstraint()
+		if constraint.GetContype() != pgquery.ConstrType_CONSTR_FOREIGN || constraint.GetSkipValidation() {
+			continue
+		}
+		// The constraint ends before the next top-level comma or the semicolon.
+		end, depth := -1, 0
+		for _, token := range tokens[tokenIndexAt(tokens, constraint.GetLocation()):] {
+			if depth == 0 && (token.Token == pgquery.Token_ASCII_44 || token.Token == pgquery.Token_ASCII_59) {
+				break
+			}
+			switch token.Token {
+			case pgquery.Token_ASCII_40:
+				depth++
+			case pgquery.Token_ASCII_41:
+				depth--
+			default:
+			}
+			end = int(token.End)
+		}
+		if end < 0 {
+			return nil
+		}
+		fixes = append(fixes, &storepb.Advice_Fix{
+			Title: "Add NOT VALID",
+			Edits: []*storepb.Advice_TextEdit{s.insert(end, " NOT VALID")},
+		})
+	}
+	return fixes
+}
+
+// insertColumnListFix returns the fix specifying the column list for INSERT ... VALUES.
+// The column list contains the leading table columns matching the number of values.
+func insertColumnListFix(s *statementText, dbSchema *storepb.DatabaseSchemaMetadata) *storepb.Advice_Fix {
+	if s == nil {
+		return nil
+	}
+	res, err := pgquery.Parse(s.text)
+	if err != nil || len(res.GetStmts()) != 1 {
+		return nil
+	}
+	insert := res.GetStmts()[0].GetStmt().GetInsertStmt()
+	if insert == nil || len(insert.GetCols()) > 0 {
+		return nil
+	}
+	valuesLists := insert.GetSelectStmt().GetSelectStmt().GetValuesLists()
+	if len(valuesLists) == 0 {
+		return nil
+	}
+	valueCount := len(valuesLists[0].GetList().GetItems())
+	table := findTableMetadata(dbSchema, normalizeSchemaName(insert.GetRelation().GetSchemaname()), insert.GetRelation().GetRelname())
+	if table == nil || valueCount == 0 || valueCount > len(table.GetColumns()) {
+		return nil
+	}
+	var columns []string
+	for _, column := range table.GetColumns()[:valueCount] {
+		columns = append(columns, quoteIdentifier(column.Name))
+	}
+
+	tokens := s.tokens()
+	i := tokenIndexAt(tokens, insert.GetRelation().GetLocation())
+	if i >= len(tokens) {
+		return nil
+	}
+	i = skipQualifiedName(tokens, i)
+	if i+2 < len(tokens) && tokens[i+1].Token == pgquery.Token_AS {
+		i += 2
+	}
+	return &storepb.Advice_Fix{
+		Title: "Specify the column list",
+		Edits: []*storepb.Advice_TextEdit{s.insert(int(tokens[i].End), fmt.Sprintf(" (%s)", strings.Join(columns, ", ")))},
+	}
+}
+
+// primaryKeyFix returns the fix adding the primary key to CREATE TABLE.
+// It uses the id column if exists, otherwise adds a generated id column.
+func primaryKeyFix(s *statementText, node *ast.CreateTableStmt) *storepb.Advice_Fix {
+	if s == nil {
+		return nil
+	}
+	res, err := pgquery.Parse(s.text)
+	if err != nil || len(res.GetStmts()) != 1 {
+		return nil
+	}
+	createTable := res.GetStmts()[0].GetStmt().GetCreateStmt()
+	if createTable == nil {
+		return nil
+	}
+	tokens := s.tokens()
+	i := tokenIndexAt(tokens, createTable.GetRelation().GetLocation())
+	if i >= len(tokens) {
+		return nil
+	}
+	i = skipQualifiedName(tokens, i) + 1
+	if i >= len(tokens) || tokens[i].Token != pgquery.Token_ASCII_40 {
+		return nil
+	}
+	open := i
+	// Find the matching close parenthesis.
+	for depth := 0; i < len(tokens); i++ {
+		switch tokens[i].Token {
+		case pgquery.Token_ASCII_40:
+			depth++
+		case pgquery.Token_ASCII_41:
+			depth--
+		default:
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	if i >= len(tokens) {
+		return nil
+	}
+
+	fix := &storepb.Advice_Fix{Title: "Add primary key"}
+	for _, column := range node.ColumnList {
+		if column.ColumnName == "id" {
+			fix.Edits = append(fix.Edits, s.insert(int(tokens[i].Start), fmt.Sprintf(", PRIMARY KEY (%s)", quoteIdentifier(column.ColumnName))))
+			return fix
+		}
+	}
+	newText := "id bigint GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY"
+	if i > open+1 {
+		newText += ", "
+	}
+	fix.Edits = append(fix.Edits, s.insert(int(tokens[open].End), newText))
+	return fix
+}
+
+// quoteIdentifier returns the identifier double-quoted unless it can be used without quotes,
+// that's a lower case simple identifier which is not a reserved keyword.
+func quoteIdentifier(identifier string) string {
+	if isLowerSimpleIdentifier(identifier) {
+		res, err := pgquery.Scan(identifier)
+		if err == nil && len(res.GetTokens()) == 1 {
+			switch res.GetTokens()[0].KeywordKind {
+			case pgquery.KeywordKind_NO_KEYWORD, pgquery.KeywordKind_UNRESERVED_KEYWORD:
+				return identifier
+			default:
+			}
+		}
+	}
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func isLowerSimpleIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+	for i, c := range identifier {
+		switch {
+		case c >= 'a' && c <= 'z', c == '_':
+		case (c >= '0' && c <= '9') || c == '$':
+			if i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func findTableMetadata(dbSchema *storepb.DatabaseSchemaMetadata, schemaName, tableName string) *storepb.TableMetadata {
+	for _, schema := range dbSchema.GetSchemas() {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+)
+
+func TestQuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		identifier string
+		want       string
+	}{
+		{identifier: "id", want: "id"},
+		{identifier: "user_id2", want: "user_id2"},
+		{identifier: "_name$", want: "_name$"},
+		// Unreserved keywords can be used without quotes.
+		{identifier: "name", want: "name"},
+		{identifier: "UserID", want: `"UserID"`},
+		{identifier: "order", want: `"order"`},
+		{identifier: "user", want: `"user"`},
+		{identifier: "1st", want: `"1st"`},
+		{identifier: "first name", want: `"first name"`},
+		{identifier: `a"b`, want: `"a""b"`},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, quoteIdentifier(test.identifier), test.identifier)
+	}
+}
+
+func TestNewStatementText(t *testing.T) {
+	a := require.New(t)
+	statements := "CREATE INDEX idx ON t (a); CREATE INDEX idx ON t (a);\n" +
+		"  /* comment */ CREATE INDEX idx ON t (a);"
+	nodes, err := pgrawparser.Parse(pgrawparser.ParseContext{}, statements)
+	a.NoError(err)
+	a.Len(nodes, 3)
+
+	// The identical statements are fixed separately.
+	want := []*storepb.Position{
+		{Line: 0, Column: 12},
+		{Line: 0, Column: 39},
+		{Line: 1, Column: 28},
+	}
+	for i, node := range nodes {
+		fix := concurrentlyFix(newStatementText(statements, node))
+		a.NotNil(fix, i)
+		a.Len(fix.Edits, 1, i)
+		a.Equal(want[i], fix.Edits[0].StartPosition, i)
+	}
+	a.Nil(newStatementText("SELECT 1;", nodes[0]))
+}
@@ -168,6 +168,12 @@ const (
 	SchemaRuleTableRequireCharset SQLReviewRuleType = "table.require-charset"
 	// SchemaRuleTableRequireCollation enforce the table collation.
 	SchemaRuleTableRequireCollation SQLReviewRuleType = "table.require-collation"
+	// SchemaRuleTableRequirePartition require the table to have a partition key.
+	SchemaRuleTableRequirePartition SQLReviewRuleType = "table.require-partition"
+	// SchemaRuleTableRequireOrderBy require the table to have a sorting key.
+	SchemaRuleTableRequireOrderBy SQLReviewRuleType = "table.require-order-by"
+	// SchemaRuleTableDisallowDropWithoutPartition disallow dropping or truncating the whole table instead of the explicit partitions.
+	SchemaRuleTableDisallowDropWithoutPartition SQLReviewRuleType = "table.disallow-drop-without-partition"
 	// SchemaRuleRequiredColumn enforce the required columns in each table.
 	SchemaRuleRequiredColumn SQLReviewRuleType = "column.required"
 	// SchemaRuleColumnNotNull enforce the columns cannot have NULL value.
@@ -258,6 +264,9 @@ const (
 	// SchemaRuleOnlineMigration advises using online migration to migrate large tables.
 	SchemaRuleOnlineMigration SQLReviewRuleType = "advice.online-migration"
 
+	// SchemaRuleCustom is the user-defined rule whose condition is a CEL expression over the statement facts.
+	SchemaRuleCustom SQLReviewRuleType = "custom"
+
 	// TableNameTemplateToken is the token for table name.
 	TableNameTemplateToken = "{{table}}"
 	// ColumnListTemplateToken is the token for column name list.
@@ -510,7 +519,7 @@ type SQLReviewCheckContext struct {
 	// Snowflake specific fields
 	CurrentDatabase string
 
-	// Used for test only.
+	// NoAppendBuiltin skips the builtin rules, used for test and checking the fixable rules only.
 	NoAppendBuiltin bool
 
 	// UsePostgresDatabaseOwner is true if the advisor should use the database owner as default role.
@@ -525,6 +534,12 @@ func SQLReviewCheck(
 	ruleList []*storepb.SQLReviewRule,
 	checkContext SQLReviewCheckContext,
 ) ([]*storepb.Advice, error) {
+	// The statements are not parsed unless the SQL review rules of the engine are configured,
+	// otherwise the parse failures would show up in every check.
+	if common.EngineSupportStatementAdviseWithRules(checkContext.DBType) && !HasEnabledRules(ruleList, checkContext.DBType) {
+		return nil, nil
+	}
+
 	asts, parseResult := sm.GetASTsForChecks(checkContext.DBType, statements)
 
 	builtinOnly := len(ruleList) == 0
@@ -620,6 +635,16 @@ func SQLReviewCheck(
 	return advices, nil
 }
 
+// HasEnabledRules returns true if any SQL review rule of the engine is enabled.
+func HasEnabledRules(ruleList []*storepb.SQLReviewRule, engine storepb.Engine) bool {
+	for _, rule := range ruleList {
+		if rule.Engine == engine && rule.Level != storepb.SQLReviewRuleLevel_DISABLED {
+			return true
+		}
+	}
+	return false
+}
+
 func convertWalkThroughErrorToAdvice(err error) ([]*storepb.Advice, error) {
 	walkThroughError, ok := err.(*catalog.WalkThroughError)
 	if !ok {
@@ -837,6 +862,12 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeWhereRequirementForUpdateDelete, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLWhereRequirementForUpdateDelete, nil
+		case storepb.Engine_CLICKHOUSE:
+			return ClickHouseWhereRequirementForUpdateDelete, nil
+		case storepb.Engine_BIGQUERY:
+			return BigQueryWhereRequirementForUpdateDelete, nil
+		case storepb.Engine_TRINO:
+			return TrinoWhereRequirementForUpdateDelete, nil
 		}
 	case SchemaRuleStatementNoLeadingWildcardLike:
 		switch engine {
@@ -859,6 +890,12 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeNoSelectAll, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLNoSelectAll, nil
+		case storepb.Engine_CLICKHOUSE:
+			return ClickHouseNoSelectAll, nil
+		case storepb.Engine_BIGQUERY:
+			return BigQueryNoSelectAll, nil
+		case storepb.Engine_TRINO:
+			return TrinoNoSelectAll, nil
 		}
 	case SchemaRuleSchemaBackwardCompatibility:
 		switch engine {
@@ -887,6 +924,12 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeNamingTableConvention, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLNamingTableConvention, nil
+		case storepb.Engine_CLICKHOUSE:
+			return ClickHouseNamingTableConvention, nil
+		case storepb.Engine_BIGQUERY:
+			return BigQueryNamingTableConvention, nil
+		case storepb.Engine_TRINO:
+			return TrinoNamingTableConvention, nil
 		}
 	case SchemaRuleIDXNaming:
 		switch engine {
@@ -919,6 +962,12 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return MySQLNamingColumnConvention, nil
 		case storepb.Engine_POSTGRES:
 			return PostgreSQLNamingColumnConvention, nil
+		case storepb.Engine_CLICKHOUSE:
+			return ClickHouseNamingColumnConvention, nil
+		case storepb.Engine_BIGQUERY:
+			return BigQueryNamingColumnConvention, nil
+		case storepb.Engine_TRINO:
+			return TrinoNamingColumnConvention, nil
 		}
 	case SchemaRuleAutoIncrementColumnNaming:
 		switch engine {
@@ -1035,6 +1084,12 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return OracleColumnTypeDisallowList, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLColumnTypeDisallowList, nil
+		case storepb.Engine_CLICKHOUSE:
+			return ClickHouseColumnTypeDisallowList, nil
+		case storepb.Engine_BIGQUERY:
+			return BigQueryColumnTypeDisallowList, nil
+		case storepb.Engine_TRINO:
+			return TrinoColumnTypeDisallowList, nil
 		}
 	case SchemaRuleColumnDisallowSetCharset:
 		switch engine {
@@ -1196,6 +1251,23 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		if engine == storepb.Engine_MYSQL {
 			return MySQLTableRequireCollation, nil
 		}
+	case SchemaRuleTableRequirePartition:
+		if engine == storepb.Engine_CLICKHOUSE {
+			return ClickHouseTableRequirePartition, nil
+		}
+	case SchemaRuleTableRequireOrderBy:
+		if engine == storepb.Engine_CLICKHOUSE {
+			return ClickHouseTableRequireOrderBy, nil
+		}
+	case SchemaRuleTableDisallowDropWithoutPartition:
+		switch engine {
+		case storepb.Engine_CLICKHOUSE:
+			return ClickHouseTableDisallowDropWithoutPartition, nil
+		case storepb.Engine_BIGQUERY:
+			return BigQueryTableDisallowDropWithoutPartition, nil
+		case storepb.Engine_TRINO:
+			return TrinoTableDisallowDropWithoutPartition, nil
+		}
 	case SchemaRuleMySQLEngine:
 		switch engine {
 		case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
@@ -1509,13 +1581,24 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		if engine == storepb.Engine_OCEANBASE {
 			return MySQLDisallowOfflineDDL, nil
 		}
+	case SchemaRuleCustom:
+		switch engine {
+		case storepb.Engine_MYSQL:
+			return MySQLCustomRule, nil
+		case storepb.Engine_POSTGRES:
+			return PostgreSQLCustomRule, nil
+		}
 	// ----------------- Builtin Rules -----------------------
 	case BuiltinRulePriorBackupCheck:
 		switch engine {
 		case storepb.Engine_MYSQL, storepb.Engine_TIDB:
 			return MySQLBuiltinPriorBackupCheck, nil
+		case storepb.Engine_STARROCKS, storepb.Engine_DORIS:
+			return DorisBuiltinPriorBackupCheck, nil
 		case storepb.Engine_POSTGRES:
 			return PostgreSQLBuiltinPriorBackupCheck, nil
+		case storepb.Engine_SNOWFLAKE:
+			return SnowflakeBuiltinPriorBackupCheck, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLBuiltinPriorBackupCheck, nil
 		case storepb.Engine_ORACLE:
@@ -0,0 +1,36 @@
+// Package trino is the advisor for Trino database.
+package trino
+
+import (
+	"strings"
+
+	parser "github.com/bytebase/trino-parser"
+
+	trinoparser "github.com/bytebase/bytebase/backend/plugin/parser/trino"
+)
+
+// normalizeTableName returns the table name of the qualified name such as catalog.schema.table,
+// the catalog and schema parts are omitted.
+func normalizeTableName(ctx parser.IQualifiedNameContext) string {
+	parts := trinoparser.ExtractQualifiedNameParts(ctx)
+	if len(parts) == 0 {
+		return ""
+	}
+	return parts[len(parts)-1]
+}
+
+// normalizeColumnType returns the upper case column type without type parameters.
+func normalizeColumnType(ctx parser.ITypeContext) string {
+	switch tp := ctx.(type) {
+	case nil:
+		return ""
+	case *parser.GenericTypeContext:
+		return strings.ToUpper(trinoparser.NormalizeTrinoIdentifier(tp.Identifier().GetText()))
+	case *parser.DateTimeTypeContext:
+		return strings.ToUpper(tp.GetBase_().GetText())
+	case *parser.ArrayTypeContext, *parser.LegacyArrayTypeContext:
+		return "ARRAY"
+	default:
+		return strings.ToUpper(ctx.GetStart().GetText())
+	}
+}
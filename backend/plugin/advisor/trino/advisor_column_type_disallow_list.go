@@ -0,0 +1,108 @@
+package trino
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/trino-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	trinoparser "github.com/bytebase/bytebase/backend/plugin/parser/trino"
+)
+
+var (
+	_ advisor.Advisor = (*ColumnTypeDisallowListAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_TRINO, advisor.TrinoColumnTypeDisallowList, &ColumnTypeDisallowListAdvisor{})
+}
+
+// ColumnTypeDisallowListAdvisor is the advisor checking for disallowed types for column.
+type ColumnTypeDisallowListAdvisor struct {
+}
+
+// Check checks for disallowed types for column.
+func (*ColumnTypeDisallowListAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalStringArrayTypeRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &columnTypeDisallowListChecker{
+		level:           level,
+		title:           string(checkCtx.Rule.Type),
+		typeRestriction: make(map[string]bool),
+	}
+	for _, tp := range payload.List {
+		listener.typeRestriction[strings.ToUpper(tp)] = true
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type columnTypeDisallowListChecker struct {
+	*parser.BaseTrinoParserListener
+
+	level           storepb.Advice_Status
+	title           string
+	typeRestriction map[string]bool
+
+	adviceList []*storepb.Advice
+}
+
+// EnterCreateTable is called when production createTable is entered.
+func (l *columnTypeDisallowListChecker) EnterCreateTable(ctx *parser.CreateTableContext) {
+	tableName := normalizeTableName(ctx.QualifiedName())
+	for _, element := range ctx.AllTableElement() {
+		if column := element.ColumnDefinition(); column != nil {
+			l.checkColumnType(tableName, column.Identifier().GetText(), column.Type_(), column.GetStart().GetLine())
+		}
+	}
+}
+
+// EnterAddColumn is called when production addColumn is entered.
+func (l *columnTypeDisallowListChecker) EnterAddColumn(ctx *parser.AddColumnContext) {
+	if column := ctx.GetColumn(); column != nil {
+		l.checkColumnType(normalizeTableName(ctx.GetTableName()), column.Identifier().GetText(), column.Type_(), column.GetStart().GetLine())
+	}
+}
+
+// EnterSetColumnType is called when production setColumnType is entered.
+func (l *columnTypeDisallowListChecker) EnterSetColumnType(ctx *parser.SetColumnTypeContext) {
+	columnName := ctx.GetColumnName()
+	if columnName == nil {
+		return
+	}
+	l.checkColumnType(normalizeTableName(ctx.GetTableName()), columnName.GetText(), ctx.Type_(), ctx.GetStart().GetLine())
+}
+
+func (l *columnTypeDisallowListChecker) checkColumnType(tableName, columnName string, tp parser.ITypeContext, line int) {
+	columnType := normalizeColumnType(tp)
+	if !l.typeRestriction[columnType] {
+		return
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.DisabledColumnType.Int32(),
+		Title:         l.title,
+		Content:       fmt.Sprintf("Disallow column type %s but column `%s`.`%s` is", columnType, tableName, trinoparser.NormalizeTrinoIdentifier(columnName)),
+		StartPosition: common.ConvertANTLRLineToPosition(line),
+	})
+}
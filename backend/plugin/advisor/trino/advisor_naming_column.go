@@ -0,0 +1,112 @@
+package trino
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/trino-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	trinoparser "github.com/bytebase/bytebase/backend/plugin/parser/trino"
+)
+
+var (
+	_ advisor.Advisor = (*NamingColumnAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_TRINO, advisor.TrinoNamingColumnConvention, &NamingColumnAdvisor{})
+}
+
+// NamingColumnAdvisor is the advisor checking for column naming convention.
+type NamingColumnAdvisor struct {
+}
+
+// Check checks for column naming convention.
+func (*NamingColumnAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &namingColumnChecker{
+		level:     level,
+		title:     string(checkCtx.Rule.Type),
+		format:    format,
+		maxLength: maxLength,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type namingColumnChecker struct {
+	*parser.BaseTrinoParserListener
+
+	level     storepb.Advice_Status
+	title     string
+	format    *regexp.Regexp
+	maxLength int
+
+	adviceList []*storepb.Advice
+}
+
+// EnterCreateTable is called when production createTable is entered.
+func (l *namingColumnChecker) EnterCreateTable(ctx *parser.CreateTableContext) {
+	tableName := normalizeTableName(ctx.QualifiedName())
+	for _, element := range ctx.AllTableElement() {
+		if column := element.ColumnDefinition(); column != nil {
+			l.checkColumnName(tableName, trinoparser.NormalizeTrinoIdentifier(column.Identifier().GetText()), column.GetStart().GetLine())
+		}
+	}
+}
+
+// EnterAddColumn is called when production addColumn is entered.
+func (l *namingColumnChecker) EnterAddColumn(ctx *parser.AddColumnContext) {
+	if column := ctx.GetColumn(); column != nil {
+		l.checkColumnName(normalizeTableName(ctx.GetTableName()), trinoparser.NormalizeTrinoIdentifier(column.Identifier().GetText()), column.GetStart().GetLine())
+	}
+}
+
+// EnterRenameColumn is called when production renameColumn is entered.
+func (l *namingColumnChecker) EnterRenameColumn(ctx *parser.RenameColumnContext) {
+	if to := ctx.GetTo(); to != nil {
+		l.checkColumnName(normalizeTableName(ctx.GetTableName()), trinoparser.NormalizeTrinoIdentifier(to.GetText()), to.GetStart().GetLine())
+	}
+}
+
+func (l *namingColumnChecker) checkColumnName(tableName, columnName string, line int) {
+	if !l.format.MatchString(columnName) {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingColumnConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s`.`%s` mismatches column naming convention, naming format should be %q", tableName, columnName, l.format),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+	if l.maxLength > 0 && len(columnName) > l.maxLength {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingColumnConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s`.`%s` mismatches column naming convention, its length should be within %d characters", tableName, columnName, l.maxLength),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+}
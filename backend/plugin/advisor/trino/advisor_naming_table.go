@@ -0,0 +1,105 @@
+package trino
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/trino-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*NamingTableAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_TRINO, advisor.TrinoNamingTableConvention, &NamingTableAdvisor{})
+}
+
+// NamingTableAdvisor is the advisor checking for table naming convention.
+type NamingTableAdvisor struct {
+}
+
+// Check checks for table naming convention.
+func (*NamingTableAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &namingTableChecker{
+		level:     level,
+		title:     string(checkCtx.Rule.Type),
+		format:    format,
+		maxLength: maxLength,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type namingTableChecker struct {
+	*parser.BaseTrinoParserListener
+
+	level     storepb.Advice_Status
+	title     string
+	format    *regexp.Regexp
+	maxLength int
+
+	adviceList []*storepb.Advice
+}
+
+// EnterCreateTable is called when production createTable is entered.
+func (l *namingTableChecker) EnterCreateTable(ctx *parser.CreateTableContext) {
+	l.checkTableName(normalizeTableName(ctx.QualifiedName()), ctx.GetStart().GetLine())
+}
+
+// EnterCreateTableAsSelect is called when production createTableAsSelect is entered.
+func (l *namingTableChecker) EnterCreateTableAsSelect(ctx *parser.CreateTableAsSelectContext) {
+	l.checkTableName(normalizeTableName(ctx.QualifiedName()), ctx.GetStart().GetLine())
+}
+
+// EnterRenameTable is called when production renameTable is entered.
+func (l *namingTableChecker) EnterRenameTable(ctx *parser.RenameTableContext) {
+	l.checkTableName(normalizeTableName(ctx.GetTo()), ctx.GetStart().GetLine())
+}
+
+func (l *namingTableChecker) checkTableName(tableName string, line int) {
+	if tableName == "" {
+		return
+	}
+	if !l.format.MatchString(tableName) {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingTableConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s` mismatches table naming convention, naming format should be %q", tableName, l.format),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+	if l.maxLength > 0 && len(tableName) > l.maxLength {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          advisor.NamingTableConventionMismatch.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("`%s` mismatches table naming convention, its length should be within %d characters", tableName, l.maxLength),
+			StartPosition: common.ConvertANTLRLineToPosition(line),
+		})
+	}
+}
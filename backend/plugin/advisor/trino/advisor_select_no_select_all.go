@@ -0,0 +1,67 @@
+package trino
+
+import (
+	"context"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/trino-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*SelectNoSelectAllAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_TRINO, advisor.TrinoNoSelectAll, &SelectNoSelectAllAdvisor{})
+}
+
+// SelectNoSelectAllAdvisor is the advisor checking for no select all.
+type SelectNoSelectAllAdvisor struct {
+}
+
+// Check checks for no select all.
+func (*SelectNoSelectAllAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &selectNoSelectAllChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type selectNoSelectAllChecker struct {
+	*parser.BaseTrinoParserListener
+
+	level storepb.Advice_Status
+	title string
+
+	adviceList []*storepb.Advice
+}
+
+// EnterSelectAll is called when production selectAll is entered.
+func (l *selectNoSelectAllChecker) EnterSelectAll(ctx *parser.SelectAllContext) {
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.StatementSelectAll.Int32(),
+		Title:         l.title,
+		Content:       "Avoid using SELECT *.",
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	})
+}
@@ -0,0 +1,81 @@
+package trino
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/trino-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*TableDisallowDropWithoutPartitionAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_TRINO, advisor.TrinoTableDisallowDropWithoutPartition, &TableDisallowDropWithoutPartitionAdvisor{})
+}
+
+// TableDisallowDropWithoutPartitionAdvisor is the advisor disallowing DROP TABLE and TRUNCATE TABLE,
+// the data should be removed by DELETE with a filter on the partition columns instead.
+type TableDisallowDropWithoutPartitionAdvisor struct {
+}
+
+// Check checks for DROP TABLE and TRUNCATE TABLE statements.
+func (*TableDisallowDropWithoutPartitionAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &tableDisallowDropWithoutPartitionChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type tableDisallowDropWithoutPartitionChecker struct {
+	*parser.BaseTrinoParserListener
+
+	level storepb.Advice_Status
+	title string
+
+	adviceList []*storepb.Advice
+}
+
+// EnterDropTable is called when production dropTable is entered.
+func (l *tableDisallowDropWithoutPartitionChecker) EnterDropTable(ctx *parser.DropTableContext) {
+	l.addAdvice(ctx, "DROP TABLE", normalizeTableName(ctx.QualifiedName()))
+}
+
+// EnterTruncateTable is called when production truncateTable is entered.
+func (l *tableDisallowDropWithoutPartitionChecker) EnterTruncateTable(ctx *parser.TruncateTableContext) {
+	l.addAdvice(ctx, "TRUNCATE TABLE", normalizeTableName(ctx.QualifiedName()))
+}
+
+func (l *tableDisallowDropWithoutPartitionChecker) addAdvice(ctx antlr.ParserRuleContext, statementType, tableName string) {
+	if tableName == "" {
+		return
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.TableDropWithoutPartition.Int32(),
+		Title:         l.title,
+		Content:       fmt.Sprintf("%s removes all data of table `%s`, use DELETE with a filter on the partition columns to drop the partitions explicitly", statementType, tableName),
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	})
+}
@@ -0,0 +1,86 @@
+package trino
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/trino-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var (
+	_ advisor.Advisor = (*WhereRequireForUpdateDeleteAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_TRINO, advisor.TrinoWhereRequirementForUpdateDelete, &WhereRequireForUpdateDeleteAdvisor{})
+}
+
+// WhereRequireForUpdateDeleteAdvisor is the advisor checking for WHERE clause requirement for UPDATE and DELETE statements.
+type WhereRequireForUpdateDeleteAdvisor struct {
+}
+
+// Check checks for WHERE clause requirement.
+func (*WhereRequireForUpdateDeleteAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &whereRequireForUpdateDeleteChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.adviceList, nil
+}
+
+type whereRequireForUpdateDeleteChecker struct {
+	*parser.BaseTrinoParserListener
+
+	level storepb.Advice_Status
+	title string
+
+	adviceList []*storepb.Advice
+}
+
+// EnterUpdate is called when production update is entered.
+func (l *whereRequireForUpdateDeleteChecker) EnterUpdate(ctx *parser.UpdateContext) {
+	l.checkWhereClause(ctx, ctx.GetWhere(), "UPDATE")
+}
+
+// EnterDelete is called when production delete is entered.
+func (l *whereRequireForUpdateDeleteChecker) EnterDelete(ctx *parser.DeleteContext) {
+	l.checkWhereClause(ctx, ctx.BooleanExpression(), "DELETE")
+}
+
+func (l *whereRequireForUpdateDeleteChecker) checkWhereClause(ctx antlr.ParserRuleContext, where parser.IBooleanExpressionContext, statementType string) {
+	var content string
+	switch {
+	case where == nil:
+		content = fmt.Sprintf("WHERE clause is required for %s statement.", statementType)
+	case advisor.IsAlwaysTrueCondition(where.GetText()):
+		content = fmt.Sprintf("WHERE clause of %s statement is always true, it affects all rows.", statementType)
+	default:
+		return
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:        l.level,
+		Code:          advisor.StatementNoWhere.Int32(),
+		Title:         l.title,
+		Content:       content,
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	})
+}
@@ -0,0 +1,23 @@
+package trino
+
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+func TestTrinoRules(t *testing.T) {
+	trinoRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleStatementRequireWhereForUpdateDelete,
+		advisor.SchemaRuleStatementNoSelectAll,
+		advisor.SchemaRuleTableNaming,
+		advisor.SchemaRuleColumnNaming,
+		advisor.SchemaRuleColumnTypeDisallowList,
+		advisor.SchemaRuleTableDisallowDropWithoutPartition,
+	}
+
+	for _, rule := range trinoRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_TRINO, false, false /* record */)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -163,3 +164,51 @@ func DatabaseExists(ctx context.Context, checkCtx Context, database string) bool
 
 	return false
 }
+
+// IsAlwaysTrueCondition returns true if the condition is a tautology on literals which matches all rows,
+// such as 1, TRUE, 1=1 and 'a'='a'. The condition text should not contain whitespaces between tokens.
+func IsAlwaysTrueCondition(condition string) bool {
+	condition = trimParentheses(strings.ToLower(strings.TrimSpace(condition)))
+	if condition == "1" || condition == "true" {
+		return true
+	}
+	i := strings.IndexByte(condition, '=')
+	if i <= 0 || strings.ContainsRune("!<>", rune(condition[i-1])) {
+		return false
+	}
+	left := trimParentheses(condition[:i])
+	right := trimParentheses(strings.TrimPrefix(condition[i+1:], "="))
+	return left == right && isLiteral(left)
+}
+
+// trimParentheses removes the parentheses enclosing the whole text.
+func trimParentheses(text string) string {
+	for len(text) >= 2 && text[0] == '(' && text[len(text)-1] == ')' {
+		depth := 0
+		for i := 0; i < len(text)-1; i++ {
+			switch text[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			default:
+			}
+			if depth == 0 {
+				// The first parenthesis is closed before the end, such as (a)=(b).
+				return text
+			}
+		}
+		text = text[1 : len(text)-1]
+	}
+	return text
+}
+
+func isLiteral(text string) bool {
+	if text == "true" {
+		return true
+	}
+	if _, err := strconv.ParseFloat(text, 64); err == nil {
+		return true
+	}
+	return len(text) >= 2 && (text[0] == '\'' || text[0] == '"') && text[len(text)-1] == text[0]
+}
@@ -398,7 +398,10 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		SchemaRuleStatementRequireLockOption,
 		SchemaRuleTableDisallowSetCharset,
 		SchemaRuleStatementDisallowCrossDBQueries,
-		SchemaRuleIndexNotRedundant:
+		SchemaRuleIndexNotRedundant,
+		SchemaRuleTableRequirePartition,
+		SchemaRuleTableRequireOrderBy,
+		SchemaRuleTableDisallowDropWithoutPartition:
 	case SchemaRuleTableDropNamingConvention:
 		payload, err = json.Marshal(NamingRulePayload{
 			Format: "_delete$",
@@ -514,6 +517,12 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		payload, err = json.Marshal(NumberTypeRulePayload{
 			Number: 5,
 		})
+	case SchemaRuleCustom:
+		payload, err = json.Marshal(CustomRulePayload{
+			Title:      "Custom rule",
+			Expression: `statement.type == "TRUNCATE" || statement.columns.exists(c, c.type == "json" || (statement.type == "ALTER_TABLE" && c.action == "ADD" && !c.nullable && !c.has_default))`,
+			Message:    "The statement violates the custom rule.",
+		})
 	case SchemaRuleCharsetAllowlist:
 		payload, err = json.Marshal(StringArrayTypeRulePayload{
 			List: []string{"utf8mb4", "UTF8"},
@@ -197,6 +197,25 @@ type Driver interface {
 	Dump(ctx context.Context, out io.Writer, dbSchema *storepb.DatabaseSchemaMetadata) error
 }
 
+// QueryResultHandler handles a chunk of the query result.
+type QueryResultHandler func(chunk *v1pb.QueryResult) error
+
+// QueryResultStreamer is the optional interface for the drivers streaming the query result.
+// It's used by the data export so that the rows are not loaded into memory all at once.
+type QueryResultStreamer interface {
+	// QueryConnStream streams the result of the last statement to the handler in chunks.
+	// Every chunk has the column names and types, and the first chunk is sent even if there is no row.
+	// The previous statements are executed without returning the results.
+	QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext QueryContext, handler QueryResultHandler) error
+}
+
+// SessionResetter is the optional interface for the drivers that can be reused by another caller.
+// The drivers not implementing it are not pooled, because the session state, e.g. the role and the session variables, would leak to the next caller.
+type SessionResetter interface {
+	// ResetSession clears the session state left by the previous caller.
+	ResetSession(ctx context.Context) error
+}
+
 // Register makes a database driver available by the provided type.
 // If Register is called twice with the same name or if driver is nil,
 // it panics.
@@ -229,6 +248,18 @@ func Open(ctx context.Context, dbType storepb.Engine, connectionConfig Connectio
 	return driver, nil
 }
 
+// Unwrap returns the underlying driver of a wrapped driver, e.g. the one borrowed from the driver pool.
+// Use it before asserting the driver to the engine-specific type.
+func Unwrap(driver Driver) Driver {
+	for {
+		w, ok := driver.(interface{ Unwrap() Driver })
+		if !ok {
+			return driver
+		}
+		driver = w.Unwrap()
+	}
+}
+
 // ExecuteOptions is the options for execute.
 type ExecuteOptions struct {
 	CreateDatabase   bool
@@ -0,0 +1,170 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/xml"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// showPlanElement is the generic element of the SHOWPLAN_XML output.
+// https://learn.microsoft.com/en-us/sql/t-sql/statements/set-showplan-xml-transact-sql
+type showPlanElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr        `xml:",any,attr"`
+	Children []showPlanElement `xml:",any"`
+}
+
+func (e *showPlanElement) attr(name string) string {
+	for _, attr := range e.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (e *showPlanElement) number(name string) float64 {
+	f, err := strconv.ParseFloat(e.attr(name), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// getQueryPlan returns the normalized query plan of the statement.
+func getQueryPlan(ctx context.Context, conn *sql.Conn, statement string) (*v1pb.QueryPlan, error) {
+	// SET SHOWPLAN_XML must be the only statement in the batch.
+	if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_XML ON"); err != nil {
+		return nil, errors.Wrap(err, "failed to enable SHOWPLAN_XML mode")
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_XML OFF"); err != nil {
+			slog.Warn("failed to disable SHOWPLAN_XML mode", log.BBError(err))
+		}
+	}()
+	var raw string
+	if err := conn.QueryRowContext(ctx, statement).Scan(&raw); err != nil {
+		return nil, errors.Wrap(err, "failed to get the XML execution plan")
+	}
+	return convertShowPlanXML(raw)
+}
+
+// convertShowPlanXML converts the output of SHOWPLAN_XML to the query plan.
+func convertShowPlanXML(raw string) (*v1pb.QueryPlan, error) {
+	var showPlan showPlanElement
+	decoder := xml.NewDecoder(strings.NewReader(raw))
+	// The plan is decoded to UTF-8 by the driver, regardless of the declared encoding, e.g. "utf-16".
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	if err := decoder.Decode(&showPlan); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the plan")
+	}
+	var statements []*v1pb.QueryPlanNode
+	walkShowPlanElements(&showPlan, func(e *showPlanElement) bool {
+		if e.XMLName.Local != "StmtSimple" {
+			return true
+		}
+		statements = append(statements, &v1pb.QueryPlanNode{
+			NodeType:      e.attr("StatementType"),
+			EstimatedRows: e.number("StatementEstRows"),
+			EstimatedCost: e.number("StatementSubTreeCost"),
+			Children:      convertShowPlanRelOps(e),
+		})
+		return false
+	})
+	if len(statements) == 0 {
+		return nil, errors.New("plan not found")
+	}
+	root := statements[0]
+	if len(statements) > 1 {
+		root = &v1pb.QueryPlanNode{NodeType: "Batch", Children: statements}
+	}
+	return &v1pb.QueryPlan{
+		Root:   root,
+		Raw:    raw,
+		Format: v1pb.QueryPlan_XML,
+	}, nil
+}
+
+// convertShowPlanRelOps converts the outermost RelOp elements under the element to the plan nodes.
+func convertShowPlanRelOps(e *showPlanElement) []*v1pb.QueryPlanNode {
+	var nodes []*v1pb.QueryPlanNode
+	for i := range e.Children {
+		walkShowPlanElements(&e.Children[i], func(child *showPlanElement) bool {
+			if child.XMLName.Local != "RelOp" {
+				return true
+			}
+			nodes = append(nodes, convertShowPlanRelOp(child))
+			return false
+		})
+	}
+	return nodes
+}
+
+func convertShowPlanRelOp(relOp *showPlanElement) *v1pb.QueryPlanNode {
+	physicalOp := relOp.attr("PhysicalOp")
+	node := &v1pb.QueryPlanNode{
+		NodeType:      physicalOp,
+		EstimatedRows: relOp.number("EstimateRows"),
+		EstimatedCost: relOp.number("EstimatedTotalSubtreeCost"),
+		// The clustered index scan reads the whole table as the table scan does.
+		FullScan: physicalOp == "Table Scan" || physicalOp == "Clustered Index Scan",
+		Children: convertShowPlanRelOps(relOp),
+	}
+	var actualRows *float64
+	for i := range relOp.Children {
+		walkShowPlanElements(&relOp.Children[i], func(child *showPlanElement) bool {
+			switch child.XMLName.Local {
+			case "RelOp":
+				// The elements of the child operators are not for this operator.
+				return false
+			case "Object":
+				if node.Table == "" {
+					node.Schema = trimShowPlanIdentifier(child.attr("Schema"))
+					node.Table = trimShowPlanIdentifier(child.attr("Table"))
+					node.Index = trimShowPlanIdentifier(child.attr("Index"))
+				}
+			case "RunTimeCountersPerThread":
+				// The actual rows of the operator are the sum of the threads.
+				rows := child.number("ActualRows")
+				if actualRows == nil {
+					actualRows = &rows
+				} else {
+					*actualRows += rows
+				}
+			default:
+			}
+			return true
+		})
+	}
+	node.ActualRows = actualRows
+	return node
+}
+
+// walkShowPlanElements walks the element tree in pre-order, the children are skipped if f returns false.
+func walkShowPlanElements(e *showPlanElement, f func(*showPlanElement) bool) {
+	if !f(e) {
+		return
+	}
+	for i := range e.Children {
+		walkShowPlanElements(&e.Children[i], f)
+	}
+}
+
+// trimShowPlanIdentifier trims the brackets of the identifier, e.g. "[dbo]" to "dbo".
+func trimShowPlanIdentifier(identifier string) string {
+	if strings.HasPrefix(identifier, "[") && strings.HasSuffix(identifier, "]") {
+		return strings.ReplaceAll(identifier[1:len(identifier)-1], "]]", "]")
+	}
+	return identifier
+}
@@ -0,0 +1,78 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestConvertShowPlanXML(t *testing.T) {
+	a := require.New(t)
+	raw := `<?xml version="1.0" encoding="utf-16"?>
+<ShowPlanXML xmlns="http://schemas.microsoft.com/sqlserver/2004/07/showplan" Version="1.564" Build="16.0.1000.6">
+  <BatchSequence>
+    <Batch>
+      <Statements>
+        <StmtSimple StatementText="SELECT * FROM orders o JOIN users u ON o.user_id = u.id" StatementId="1" StatementType="SELECT" StatementSubTreeCost="0.0065" StatementEstRows="10">
+          <QueryPlan CachedPlanSize="24">
+            <RelOp NodeId="0" PhysicalOp="Nested Loops" LogicalOp="Inner Join" EstimateRows="10" EstimatedTotalSubtreeCost="0.0065">
+              <OutputList />
+              <NestedLoops Optimized="0">
+                <RelOp NodeId="1" PhysicalOp="Table Scan" LogicalOp="Table Scan" EstimateRows="10" EstimatedTotalSubtreeCost="0.0032">
+                  <OutputList />
+                  <RunTimeInformation>
+                    <RunTimeCountersPerThread Thread="1" ActualRows="6" />
+                    <RunTimeCountersPerThread Thread="2" ActualRows="4" />
+                  </RunTimeInformation>
+                  <TableScan Ordered="0">
+                    <Object Database="[shop]" Schema="[dbo]" Table="[orders]" Alias="[o]" IndexKind="Heap" Storage="RowStore" />
+                  </TableScan>
+                </RelOp>
+                <RelOp NodeId="2" PhysicalOp="Clustered Index Seek" LogicalOp="Clustered Index Seek" EstimateRows="1" EstimatedTotalSubtreeCost="0.0031">
+                  <OutputList />
+                  <IndexScan Ordered="1">
+                    <Object Database="[shop]" Schema="[dbo]" Table="[users]" Index="[PK_users]" Alias="[u]" IndexKind="Clustered" Storage="RowStore" />
+                  </IndexScan>
+                </RelOp>
+              </NestedLoops>
+            </RelOp>
+          </QueryPlan>
+        </StmtSimple>
+      </Statements>
+    </Batch>
+  </BatchSequence>
+</ShowPlanXML>`
+	plan, err := convertShowPlanXML(raw)
+	a.NoError(err)
+	a.Equal(v1pb.QueryPlan_XML, plan.Format)
+
+	root := plan.Root
+	a.Equal("SELECT", root.NodeType)
+	a.Equal(10.0, root.EstimatedRows)
+	a.Equal(0.0065, root.EstimatedCost)
+	a.Len(root.Children, 1)
+
+	join := root.Children[0]
+	a.Equal("Nested Loops", join.NodeType)
+	// The objects of the child operators don't belong to the join.
+	a.Empty(join.Table)
+	a.Nil(join.ActualRows)
+	a.Len(join.Children, 2)
+
+	scan := join.Children[0]
+	a.Equal("Table Scan", scan.NodeType)
+	a.Equal("dbo", scan.Schema)
+	a.Equal("orders", scan.Table)
+	a.True(scan.FullScan)
+	a.Equal(10.0, scan.GetActualRows())
+
+	seek := join.Children[1]
+	a.Equal("users", seek.Table)
+	a.Equal("PK_users", seek.Index)
+	a.False(seek.FullScan)
+
+	_, err = convertShowPlanXML(`<ShowPlanXML></ShowPlanXML>`)
+	a.Error(err)
+}
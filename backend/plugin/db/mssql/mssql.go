@@ -425,62 +425,21 @@ func (*Driver) queryBatch(ctx context.Context, conn *sql.Conn, batch string, que
 
 	// Special handling for EXPLAIN queries in MSSQL using SHOWPLAN_ALL
 	if queryContext.Explain {
-		// Enable SHOWPLAN_ALL mode once for all statements
-		if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_ALL ON"); err != nil {
-			return nil, errors.Wrap(err, "failed to enable SHOWPLAN_ALL mode")
+		results, err := explainBatch(ctx, conn, singleSQLs, queryContext)
+		if err != nil {
+			return nil, err
 		}
-		// Ensure SHOWPLAN_ALL is turned off after processing
-		defer func() {
-			if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_ALL OFF"); err != nil {
-				slog.Warn("failed to disable SHOWPLAN_ALL mode", log.BBError(err))
+		// The XML plans are fetched after SHOWPLAN_ALL is turned off, because only one SHOWPLAN mode can be on.
+		for i, result := range results {
+			if result.Error != "" {
+				continue
 			}
-		}()
-
-		var results []*v1pb.QueryResult
-
-		// Process each statement with SHOWPLAN_ALL enabled
-		for _, singleSQL := range singleSQLs {
-			startTime := time.Now()
-
-			queryResult, err := func() (*v1pb.QueryResult, error) {
-				// Execute query to get execution plan
-				rows, err := conn.QueryContext(ctx, singleSQL.Text)
-				if err != nil {
-					return nil, errors.Wrap(err, "failed to get execution plan")
-				}
-				defer rows.Close()
-
-				// Convert to query result
-				r, err := util.RowsToQueryResult(rows, makeValueByTypeName, convertValue, queryContext.MaximumSQLResultSize)
-				if err != nil {
-					return nil, errors.Wrap(err, "failed to convert execution plan results")
-				}
-
-				if err = rows.Err(); err != nil {
-					return nil, errors.Wrap(err, "error after processing rows")
-				}
-
-				return r, nil
-			}()
-
-			stop := false
+			plan, err := getQueryPlan(ctx, conn, singleSQLs[i].Text)
 			if err != nil {
-				queryResult = &v1pb.QueryResult{
-					Error: err.Error(),
-				}
-				stop = true
-			}
-
-			queryResult.Statement = singleSQL.Text
-			queryResult.Latency = durationpb.New(time.Since(startTime))
-			queryResult.RowsCount = int64(len(queryResult.Rows))
-
-			results = append(results, queryResult)
-			if stop {
-				break
+				slog.Warn("failed to get query plan", log.BBError(err))
 			}
+			result.Plan = plan
 		}
-
 		return results, nil
 	}
 
@@ -590,6 +549,67 @@ func (*Driver) queryBatch(ctx context.Context, conn *sql.Conn, batch string, que
 	return ret, nil
 }
 
+// explainBatch returns the SHOWPLAN_ALL results of the statements.
+func explainBatch(ctx context.Context, conn *sql.Conn, singleSQLs []base.SingleSQL, queryContext db.QueryContext) ([]*v1pb.QueryResult, error) {
+	// Enable SHOWPLAN_ALL mode once for all statements
+	if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_ALL ON"); err != nil {
+		return nil, errors.Wrap(err, "failed to enable SHOWPLAN_ALL mode")
+	}
+	// Ensure SHOWPLAN_ALL is turned off after processing
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_ALL OFF"); err != nil {
+			slog.Warn("failed to disable SHOWPLAN_ALL mode", log.BBError(err))
+		}
+	}()
+
+	var results []*v1pb.QueryResult
+
+	// Process each statement with SHOWPLAN_ALL enabled
+	for _, singleSQL := range singleSQLs {
+		startTime := time.Now()
+
+		queryResult, err := func() (*v1pb.QueryResult, error) {
+			// Execute query to get execution plan
+			rows, err := conn.QueryContext(ctx, singleSQL.Text)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get execution plan")
+			}
+			defer rows.Close()
+
+			// Convert to query result
+			r, err := util.RowsToQueryResult(rows, makeValueByTypeName, convertValue, queryContext.MaximumSQLResultSize)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to convert execution plan results")
+			}
+
+			if err = rows.Err(); err != nil {
+				return nil, errors.Wrap(err, "error after processing rows")
+			}
+
+			return r, nil
+		}()
+
+		stop := false
+		if err != nil {
+			queryResult = &v1pb.QueryResult{
+				Error: err.Error(),
+			}
+			stop = true
+		}
+
+		queryResult.Statement = singleSQL.Text
+		queryResult.Latency = durationpb.New(time.Since(startTime))
+		queryResult.RowsCount = int64(len(queryResult.Rows))
+
+		results = append(results, queryResult)
+		if stop {
+			break
+		}
+	}
+
+	return results, nil
+}
+
 func isExitError(err error) error {
 	if err == nil {
 		return nil
@@ -0,0 +1,165 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// explainOperations are the operations of EXPLAIN FORMAT=JSON in the order of the plan tree.
+// https://dev.mysql.com/doc/refman/8.0/en/explain-output.html
+var explainOperations = []string{
+	"query_block",
+	"union_result",
+	"query_specifications",
+	"windowing",
+	"ordering_operation",
+	"grouping_operation",
+	"duplicates_removal",
+	"buffer_result",
+	"nested_loop",
+	"table",
+	"materialized_from_subquery",
+	"attached_subqueries",
+	"optimized_away_subqueries",
+	"subqueries",
+}
+
+// getQueryPlan returns the normalized query plan of the statement.
+func getQueryPlan(ctx context.Context, conn *sql.Conn, statement string) (*v1pb.QueryPlan, error) {
+	var raw string
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement)).Scan(&raw); err != nil {
+		return nil, errors.Wrap(err, "failed to explain the statement in JSON format")
+	}
+	return convertExplainJSON(raw)
+}
+
+// convertExplainJSON converts the output of EXPLAIN FORMAT=JSON to the query plan.
+func convertExplainJSON(raw string) (*v1pb.QueryPlan, error) {
+	var explain map[string]any
+	if err := json.Unmarshal([]byte(raw), &explain); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the plan")
+	}
+	nodes := convertExplainNodes(explain)
+	if len(nodes) == 0 {
+		return nil, errors.New("plan not found")
+	}
+	root := nodes[0]
+	if len(nodes) > 1 {
+		root = &v1pb.QueryPlanNode{NodeType: "Query", Children: nodes}
+	}
+	return &v1pb.QueryPlan{
+		Root:   root,
+		Raw:    raw,
+		Format: v1pb.QueryPlan_JSON,
+	}, nil
+}
+
+// convertExplainNodes converts the operations in the object to the plan nodes.
+func convertExplainNodes(object map[string]any) []*v1pb.QueryPlanNode {
+	var nodes []*v1pb.QueryPlanNode
+	for _, operation := range explainOperations {
+		value, ok := object[operation]
+		if !ok {
+			continue
+		}
+		switch operation {
+		case "query_specifications", "attached_subqueries", "optimized_away_subqueries", "subqueries":
+			// The lists of the query blocks are flattened to the parent node.
+			list, _ := value.([]any)
+			for _, item := range list {
+				if itemObject, ok := item.(map[string]any); ok {
+					nodes = append(nodes, convertExplainNodes(itemObject)...)
+				}
+			}
+		case "nested_loop":
+			node := &v1pb.QueryPlanNode{NodeType: "Nested Loop"}
+			list, _ := value.([]any)
+			for _, item := range list {
+				if itemObject, ok := item.(map[string]any); ok {
+					node.Children = append(node.Children, convertExplainNodes(itemObject)...)
+				}
+			}
+			// The cost of the join is the prefix cost of the last table.
+			if len(node.Children) > 0 {
+				last := node.Children[len(node.Children)-1]
+				node.EstimatedRows = last.EstimatedRows
+				node.EstimatedCost = last.EstimatedCost
+			}
+			nodes = append(nodes, node)
+		case "table":
+			if tableObject, ok := value.(map[string]any); ok {
+				nodes = append(nodes, convertExplainTable(tableObject))
+			}
+		default:
+			operationObject, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			node := &v1pb.QueryPlanNode{
+				NodeType: getExplainOperationName(operation),
+				Children: convertExplainNodes(operationObject),
+			}
+			if costInfo, ok := operationObject["cost_info"].(map[string]any); ok {
+				node.EstimatedCost = getExplainNumber(costInfo["query_cost"])
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+func convertExplainTable(table map[string]any) *v1pb.QueryPlanNode {
+	accessType, _ := table["access_type"].(string)
+	tableName, _ := table["table_name"].(string)
+	key, _ := table["key"].(string)
+	node := &v1pb.QueryPlanNode{
+		NodeType: accessType,
+		Table:    tableName,
+		Index:    key,
+		// ALL is the full table scan.
+		FullScan: accessType == "ALL",
+		Children: convertExplainNodes(table),
+	}
+	if rows, ok := table["rows_produced_per_join"]; ok {
+		node.EstimatedRows = getExplainNumber(rows)
+	} else {
+		node.EstimatedRows = getExplainNumber(table["rows_examined_per_scan"])
+	}
+	if costInfo, ok := table["cost_info"].(map[string]any); ok {
+		node.EstimatedCost = getExplainNumber(costInfo["prefix_cost"])
+	}
+	return node
+}
+
+// getExplainOperationName returns the name of the operation, e.g. "Ordering Operation" for "ordering_operation".
+func getExplainOperationName(operation string) string {
+	words := strings.Split(operation, "_")
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// getExplainNumber returns the number in the plan, the costs are strings in the plan, e.g. "1.25".
+func getExplainNumber(value any) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,91 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestConvertExplainJSON(t *testing.T) {
+	a := require.New(t)
+	raw := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "4.75"},
+    "ordering_operation": {
+      "using_filesort": true,
+      "nested_loop": [
+        {
+          "table": {
+            "table_name": "o",
+            "access_type": "ALL",
+            "rows_examined_per_scan": 10,
+            "rows_produced_per_join": 10,
+            "filtered": "100.00",
+            "cost_info": {"read_cost": "0.25", "eval_cost": "1.00", "prefix_cost": "1.25"}
+          }
+        },
+        {
+          "table": {
+            "table_name": "u",
+            "access_type": "eq_ref",
+            "possible_keys": ["PRIMARY"],
+            "key": "PRIMARY",
+            "rows_examined_per_scan": 1,
+            "rows_produced_per_join": 10,
+            "cost_info": {"read_cost": "2.50", "eval_cost": "1.00", "prefix_cost": "4.75"},
+            "attached_subqueries": [
+              {
+                "dependent": true,
+                "query_block": {
+                  "select_id": 2,
+                  "cost_info": {"query_cost": "0.35"},
+                  "table": {"table_name": "t", "access_type": "ref", "key": "idx_user", "rows_examined_per_scan": 1, "rows_produced_per_join": 1}
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  }
+}`
+	plan, err := convertExplainJSON(raw)
+	a.NoError(err)
+	a.Equal(v1pb.QueryPlan_JSON, plan.Format)
+
+	root := plan.Root
+	a.Equal("Query Block", root.NodeType)
+	a.Equal(4.75, root.EstimatedCost)
+	a.Len(root.Children, 1)
+
+	ordering := root.Children[0]
+	a.Equal("Ordering Operation", ordering.NodeType)
+	a.Len(ordering.Children, 1)
+
+	join := ordering.Children[0]
+	a.Equal("Nested Loop", join.NodeType)
+	a.Equal(4.75, join.EstimatedCost)
+	a.Len(join.Children, 2)
+
+	scan := join.Children[0]
+	a.Equal("ALL", scan.NodeType)
+	a.Equal("o", scan.Table)
+	a.True(scan.FullScan)
+	a.Equal(10.0, scan.EstimatedRows)
+	a.Equal(1.25, scan.EstimatedCost)
+
+	lookup := join.Children[1]
+	a.Equal("eq_ref", lookup.NodeType)
+	a.Equal("PRIMARY", lookup.Index)
+	a.False(lookup.FullScan)
+	// The attached subquery is the child of the table.
+	a.Len(lookup.Children, 1)
+	a.Equal("Query Block", lookup.Children[0].NodeType)
+	a.Equal("idx_user", lookup.Children[0].Children[0].Index)
+
+	_, err = convertExplainJSON(`{}`)
+	a.Error(err)
+}
@@ -525,6 +525,14 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 			}
 			stop = true
 		}
+		// The JSON plans of TiDB and MariaDB are in the different formats.
+		if queryContext.Explain && !stop && d.dbType == storepb.Engine_MYSQL {
+			plan, err := getQueryPlan(ctx, conn, singleSQL.Text)
+			if err != nil {
+				slog.Warn("failed to get query plan", log.BBError(err))
+			}
+			queryResult.Plan = plan
+		}
 		queryResult.Statement = statement
 		queryResult.Latency = durationpb.New(time.Since(startTime))
 		queryResult.RowsCount = int64(len(queryResult.Rows))
@@ -537,6 +545,65 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryConnStream streams the result of the last statement to the handler.
+func (d *Driver) QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext, handler db.QueryResultHandler) error {
+	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_MYSQL, statement)
+	if err != nil {
+		return err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return errors.Errorf("no statement to query")
+	}
+
+	connectionID, err := getConnectionID(ctx, conn)
+	if err != nil {
+		return err
+	}
+	err = func() error {
+		for _, singleSQL := range singleSQLs[:len(singleSQLs)-1] {
+			if _, err := conn.ExecContext(ctx, singleSQL.Text); err != nil {
+				return err
+			}
+		}
+
+		statement := singleSQLs[len(singleSQLs)-1].Text
+		if queryContext.Limit > 0 {
+			statement = getStatementWithResultLimit(statement, queryContext.Limit)
+		}
+		_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_MYSQL, statement)
+		if err != nil {
+			slog.Error("failed to validate sql", slog.String("statement", statement), log.BBError(err))
+			allQuery = true
+		}
+		if !allQuery {
+			sqlResult, err := conn.ExecContext(ctx, statement)
+			if err != nil {
+				return err
+			}
+			affectedRows, err := sqlResult.RowsAffected()
+			if err != nil {
+				slog.Info("rowsAffected returns error", log.BBError(err))
+			}
+			return handler(util.BuildAffectedRowsResult(affectedRows, nil))
+		}
+
+		rows, err := conn.QueryContext(ctx, util.MySQLPrependBytebaseAppComment(statement))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		return util.StreamRowsToQueryResult(rows, makeValueByTypeName, convertValue, queryContext.MaximumSQLResultSize, handler)
+	}()
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		slog.Info("cancel connection", slog.String("connectionID", connectionID))
+		if err := d.StopConnectionByID(connectionID); err != nil {
+			slog.Error("failed to cancel connection", slog.String("connectionID", connectionID), log.BBError(err))
+		}
+	}
+	return err
+}
+
 func (d *Driver) StopConnectionByID(id string) error {
 	// We cannot use placeholder parameter because TiDB doesn't accept it.
 	_, err := d.db.Exec(fmt.Sprintf("KILL QUERY %s", id))
@@ -0,0 +1,78 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// explainPlanNode is the plan node of EXPLAIN (FORMAT JSON).
+// https://www.postgresql.org/docs/current/sql-explain.html
+type explainPlanNode struct {
+	NodeType     string             `json:"Node Type"`
+	Schema       string             `json:"Schema"`
+	RelationName string             `json:"Relation Name"`
+	IndexName    string             `json:"Index Name"`
+	PlanRows     float64            `json:"Plan Rows"`
+	TotalCost    float64            `json:"Total Cost"`
+	ActualRows   *float64           `json:"Actual Rows"`
+	ActualLoops  *float64           `json:"Actual Loops"`
+	Plans        []*explainPlanNode `json:"Plans"`
+}
+
+// getQueryPlan returns the normalized query plan of the statement.
+func getQueryPlan(ctx context.Context, conn *sql.Conn, statement string) (*v1pb.QueryPlan, error) {
+	var raw string
+	// VERBOSE is required to return the schema of the relations.
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN (FORMAT JSON, VERBOSE) %s", statement)).Scan(&raw); err != nil {
+		return nil, errors.Wrap(err, "failed to explain the statement in JSON format")
+	}
+	return convertExplainJSON(raw)
+}
+
+// convertExplainJSON converts the output of EXPLAIN (FORMAT JSON) to the query plan.
+func convertExplainJSON(raw string) (*v1pb.QueryPlan, error) {
+	var explains []struct {
+		Plan *explainPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(raw), &explains); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the plan")
+	}
+	if len(explains) == 0 || explains[0].Plan == nil {
+		return nil, errors.New("plan not found")
+	}
+	return &v1pb.QueryPlan{
+		Root:   convertExplainPlanNode(explains[0].Plan),
+		Raw:    raw,
+		Format: v1pb.QueryPlan_JSON,
+	}, nil
+}
+
+func convertExplainPlanNode(node *explainPlanNode) *v1pb.QueryPlanNode {
+	result := &v1pb.QueryPlanNode{
+		NodeType:      node.NodeType,
+		Schema:        node.Schema,
+		Table:         node.RelationName,
+		Index:         node.IndexName,
+		EstimatedRows: node.PlanRows,
+		EstimatedCost: node.TotalCost,
+		FullScan:      node.NodeType == "Seq Scan",
+	}
+	if node.ActualRows != nil {
+		// The actual rows are the average of the loops.
+		actualRows := *node.ActualRows
+		if node.ActualLoops != nil {
+			actualRows *= *node.ActualLoops
+		}
+		result.ActualRows = &actualRows
+	}
+	for _, child := range node.Plans {
+		result.Children = append(result.Children, convertExplainPlanNode(child))
+	}
+	return result
+}
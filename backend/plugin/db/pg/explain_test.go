@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestConvertExplainJSON(t *testing.T) {
+	a := require.New(t)
+	raw := `[
+  {
+    "Plan": {
+      "Node Type": "Hash Join",
+      "Join Type": "Inner",
+      "Startup Cost": 1.09,
+      "Total Cost": 2.32,
+      "Plan Rows": 10,
+      "Plan Width": 68,
+      "Actual Rows": 10,
+      "Actual Loops": 1,
+      "Plans": [
+        {
+          "Node Type": "Seq Scan",
+          "Parent Relationship": "Outer",
+          "Relation Name": "orders",
+          "Schema": "public",
+          "Alias": "o",
+          "Total Cost": 1.10,
+          "Plan Rows": 10,
+          "Actual Rows": 10,
+          "Actual Loops": 1
+        },
+        {
+          "Node Type": "Index Scan",
+          "Parent Relationship": "Inner",
+          "Relation Name": "users",
+          "Schema": "public",
+          "Index Name": "users_pkey",
+          "Total Cost": 0.29,
+          "Plan Rows": 1,
+          "Actual Rows": 1,
+          "Actual Loops": 10
+        }
+      ]
+    },
+    "Planning Time": 0.2,
+    "Execution Time": 0.1
+  }
+]`
+	plan, err := convertExplainJSON(raw)
+	a.NoError(err)
+	a.Equal(v1pb.QueryPlan_JSON, plan.Format)
+	a.Equal(raw, plan.Raw)
+
+	root := plan.Root
+	a.Equal("Hash Join", root.NodeType)
+	a.Equal(2.32, root.EstimatedCost)
+	a.Equal(10.0, root.GetActualRows())
+	a.Len(root.Children, 2)
+
+	seqScan := root.Children[0]
+	a.Equal("Seq Scan", seqScan.NodeType)
+	a.Equal("public", seqScan.Schema)
+	a.Equal("orders", seqScan.Table)
+	a.True(seqScan.FullScan)
+
+	indexScan := root.Children[1]
+	a.Equal("users_pkey", indexScan.Index)
+	a.False(indexScan.FullScan)
+	// The actual rows are multiplied by the loops.
+	a.Equal(10.0, indexScan.GetActualRows())
+	a.Equal(1.0, indexScan.EstimatedRows)
+
+	// The plan without ANALYZE doesn't have the actual rows.
+	plan, err = convertExplainJSON(`[{"Plan": {"Node Type": "Result", "Total Cost": 0.01, "Plan Rows": 1}}]`)
+	a.NoError(err)
+	a.Nil(plan.Root.ActualRows)
+
+	_, err = convertExplainJSON(`[]`)
+	a.Error(err)
+}
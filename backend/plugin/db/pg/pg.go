@@ -4,12 +4,14 @@ package pg
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log/slog"
 	"net"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 	"unicode"
 
@@ -35,10 +37,8 @@ import (
 )
 
 var (
-	// driverName is the driver name that our driver dependence register, now is "pgx".
-	driverName = "pgx"
-
-	_ db.Driver = (*Driver)(nil)
+	_ db.Driver          = (*Driver)(nil)
+	_ db.SessionResetter = (*Driver)(nil)
 )
 
 func init() {
@@ -49,15 +49,20 @@ func init() {
 type Driver struct {
 	config db.ConnectionConfig
 
-	db        *sql.DB
-	sshClient *ssh.Client
-	// connectionString is the connection string registered by pgx.
-	// Unregister connectionString if we don't need it.
-	connectionString string
-	databaseName     string
-	connectionCtx    db.ConnectionContext
+	db           *sql.DB
+	sshClient    *ssh.Client
+	databaseName string
+	// databaseOwner is the role set on every connection if the database owner is used.
+	databaseOwner string
+	connectionCtx db.ConnectionContext
+
+	// sessionGeneration is increased by ResetSession, and the connections of an older generation are reset before being used.
+	sessionGeneration atomic.Int64
 }
 
+// sessionGenerationKey is the key of the session generation in the custom data of a connection.
+const sessionGenerationKey = "bytebase_session_generation"
+
 func newDriver() db.Driver {
 	return &Driver{}
 }
@@ -111,18 +116,18 @@ func (d *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Connectio
 
 	pgxConnConfig.OnNotice = d.onNotice
 
-	d.connectionString = stdlib.RegisterConnConfig(pgxConnConfig)
-	db, err := sql.Open(driverName, d.connectionString)
-	if err != nil {
-		return nil, err
-	}
-	d.db = db
+	d.db = stdlib.OpenDB(*pgxConnConfig, stdlib.OptionAfterConnect(d.afterConnect), stdlib.OptionResetSession(d.resetConnSession))
 	if config.ConnectionContext.UseDatabaseOwner {
 		owner, err := d.GetCurrentDatabaseOwner(ctx)
 		if err != nil {
+			_ = d.Close(ctx)
 			return nil, errors.Wrapf(err, "failed to get database owner")
 		}
-		if _, err := d.db.ExecContext(ctx, fmt.Sprintf("SET ROLE \"%s\";", owner)); err != nil {
+		d.databaseOwner = owner
+		// The role is set on the opened connection before it is used again.
+		d.sessionGeneration.Add(1)
+		if err := d.db.PingContext(ctx); err != nil {
+			_ = d.Close(ctx)
 			return nil, errors.Wrapf(err, "failed to set role to database owner %q", owner)
 		}
 	}
@@ -130,6 +135,52 @@ func (d *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Connectio
 	return d, nil
 }
 
+// ResetSession clears the session state left by the previous caller, so that the driver can be reused.
+// Every connection is reset before it is used again, including the role, the search path and the session variables.
+func (d *Driver) ResetSession(context.Context) error {
+	d.connectionCtx.MessageBuffer = nil
+	d.sessionGeneration.Add(1)
+	return nil
+}
+
+// afterConnect sets the role of the new connection.
+func (d *Driver) afterConnect(ctx context.Context, conn *pgx.Conn) error {
+	conn.PgConn().CustomData()[sessionGenerationKey] = d.sessionGeneration.Load()
+	return d.setRole(ctx, conn)
+}
+
+// resetConnSession resets the connection of an older session generation before it is used.
+func (d *Driver) resetConnSession(ctx context.Context, conn *pgx.Conn) error {
+	generation := d.sessionGeneration.Load()
+	if g, ok := conn.PgConn().CustomData()[sessionGenerationKey].(int64); ok && g == generation {
+		return nil
+	}
+	// DISCARD ALL also resets the role, so it is set again.
+	if _, err := conn.Exec(ctx, "DISCARD ALL"); err != nil {
+		slog.Debug("failed to discard the session state", log.BBError(err))
+		return driver.ErrBadConn
+	}
+	// DISCARD ALL deallocates the prepared statements on the server, so the statement caches of pgx are cleared.
+	if err := conn.DeallocateAll(ctx); err != nil {
+		slog.Debug("failed to deallocate the prepared statements", log.BBError(err))
+		return driver.ErrBadConn
+	}
+	if err := d.setRole(ctx, conn); err != nil {
+		slog.Debug("failed to set role to database owner", log.BBError(err))
+		return driver.ErrBadConn
+	}
+	conn.PgConn().CustomData()[sessionGenerationKey] = generation
+	return nil
+}
+
+func (d *Driver) setRole(ctx context.Context, conn *pgx.Conn) error {
+	if d.databaseOwner == "" {
+		return nil
+	}
+	_, err := conn.Exec(ctx, fmt.Sprintf("SET ROLE \"%s\";", d.databaseOwner))
+	return err
+}
+
 func (d *Driver) onNotice(_ *pgconn.PgConn, n *pgconn.Notice) {
 	if n == nil {
 		return
@@ -269,7 +320,6 @@ func getCloudSQLConnectionConfig(ctx context.Context, conf db.ConnectionConfig)
 
 // Close closes the driver.
 func (d *Driver) Close(context.Context) error {
-	stdlib.UnregisterConnConfig(d.connectionString)
 	var err error
 	err = multierr.Append(err, d.db.Close())
 	if d.sshClient != nil {
@@ -878,6 +928,13 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 			}
 			stop = true
 		}
+		if queryContext.Explain && !stop {
+			plan, err := getQueryPlan(ctx, conn, singleSQL.Text)
+			if err != nil {
+				slog.Warn("failed to get query plan", log.BBError(err))
+			}
+			queryResult.Plan = plan
+		}
 		queryResult.Statement = statement
 		queryResult.Latency = durationpb.New(time.Since(startTime))
 		queryResult.RowsCount = int64(len(queryResult.Rows))
@@ -890,6 +947,59 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryConnStream streams the result of the last statement to the handler.
+func (d *Driver) QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext, handler db.QueryResultHandler) error {
+	singleSQLs, err := pgparser.SplitSQL(statement)
+	if err != nil {
+		return err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return errors.Errorf("no statement to query")
+	}
+
+	// If the queryContext.Schema is not empty, set the search path for the database connection to the specified schema.
+	if queryContext.Schema != "" {
+		// Sanitize the schema name by escaping any quotes.
+		safeSchemeName := strings.ReplaceAll(queryContext.Schema, "\"", "\"\"")
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf(`SET search_path TO "%s";`, safeSchemeName)); err != nil {
+			return err
+		}
+	}
+	for _, singleSQL := range singleSQLs[:len(singleSQLs)-1] {
+		if _, err := conn.ExecContext(ctx, singleSQL.Text); err != nil {
+			return err
+		}
+	}
+
+	statement = singleSQLs[len(singleSQLs)-1].Text
+	if queryContext.Limit > 0 {
+		statement = getStatementWithResultLimit(statement, queryContext.Limit)
+	}
+	_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_POSTGRES, statement)
+	if err != nil {
+		return err
+	}
+	if !allQuery {
+		sqlResult, err := conn.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+		affectedRows, err := sqlResult.RowsAffected()
+		if err != nil {
+			slog.Info("rowsAffected returns error", log.BBError(err))
+		}
+		return handler(util.BuildAffectedRowsResult(affectedRows, d.PushAndClearMessages()))
+	}
+
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return util.StreamRowsToQueryResult(rows, makeValueByTypeName, convertValue, queryContext.MaximumSQLResultSize, handler)
+}
+
 func getPgError(e error) *v1pb.QueryResult_PostgresError_ {
 	if e == nil {
 		return nil
@@ -467,6 +467,11 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 		return nil, nil, util.FormatErrorWithQuery(err, columnQuery)
 	}
 
+	primaryKeyMap, err := d.getPrimaryKeys(ctx, database)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	tableQuery := fmt.Sprintf(`
 		SELECT
 			TABLE_SCHEMA,
@@ -497,6 +502,9 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 		if columns, ok := columnMap[db.TableKey{Schema: schemaName, Table: table.Name}]; ok {
 			table.Columns = columns
 		}
+		if primaryKey, ok := primaryKeyMap[db.TableKey{Schema: schemaName, Table: table.Name}]; ok {
+			table.Indexes = []*storepb.IndexMetadata{primaryKey}
+		}
 
 		tableMap[schemaName] = append(tableMap[schemaName], table)
 	}
@@ -550,3 +558,79 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 
 	return tableMap, viewMap, nil
 }
+
+// getPrimaryKeys returns the primary key map of the given database.
+// Snowflake doesn't enforce the primary keys, but they are used to locate the rows, such as in the prior backup restore.
+func (d *Driver) getPrimaryKeys(ctx context.Context, database string) (map[db.TableKey]*storepb.IndexMetadata, error) {
+	primaryKeyMap := make(map[db.TableKey]*storepb.IndexMetadata)
+	keySequenceMap := make(map[db.TableKey][]int)
+
+	query := fmt.Sprintf(`SHOW PRIMARY KEYS IN DATABASE "%s";`, database)
+	rows, err := d.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get primary keys from %q query", query)
+	}
+	var schemaNameIndex, tableNameIndex, columnNameIndex, keySequenceIndex, constraintNameIndex int
+	// https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys#output
+	for i, n := range columns {
+		switch strings.ToLower(n) {
+		case "schema_name":
+			schemaNameIndex = i
+		case "table_name":
+			tableNameIndex = i
+		case "column_name":
+			columnNameIndex = i
+		case "key_sequence":
+			keySequenceIndex = i
+		case "constraint_name":
+			constraintNameIndex = i
+		}
+	}
+
+	for rows.Next() {
+		cols := make([]any, len(columns))
+		var schemaName, tableName, columnName, constraintName string
+		var keySequence int
+		var unused any
+		cols[schemaNameIndex] = &schemaName
+		cols[tableNameIndex] = &tableName
+		cols[columnNameIndex] = &columnName
+		cols[keySequenceIndex] = &keySequence
+		cols[constraintNameIndex] = &constraintName
+		for i, v := range cols {
+			if v == nil {
+				cols[i] = &unused
+			}
+		}
+		if err := rows.Scan(cols...); err != nil {
+			return nil, err
+		}
+		if systemSchemas[strings.ToLower(schemaName)] {
+			continue
+		}
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		primaryKey, ok := primaryKeyMap[key]
+		if !ok {
+			primaryKey = &storepb.IndexMetadata{
+				Name:    constraintName,
+				Primary: true,
+				Unique:  true,
+			}
+			primaryKeyMap[key] = primaryKey
+		}
+		// Keep the expressions in the order of the key sequence.
+		i, _ := slices.BinarySearch(keySequenceMap[key], keySequence)
+		keySequenceMap[key] = slices.Insert(keySequenceMap[key], i, keySequence)
+		primaryKey.Expressions = slices.Insert(primaryKey.Expressions, i, columnName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return primaryKeyMap, nil
+}
@@ -47,42 +47,17 @@ var NullRowValue = &v1pb.RowValue{
 }
 
 func RowsToQueryResult(rows *sql.Rows, valueMaker func(string, *sql.ColumnType) any, rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue, limit int64) (*v1pb.QueryResult, error) {
-	columnNames, err := rows.Columns()
-	if err != nil {
-		return nil, err
-	}
-	columnTypes, err := rows.ColumnTypes()
+	result, columnTypes, err := newQueryResultWithColumns(rows)
 	if err != nil {
 		return nil, err
 	}
-	// DatabaseTypeName returns the database system name of the column type.
-	// refer: https://pkg.go.dev/database/sql#ColumnType.DatabaseTypeName
-	var columnTypeNames []string
-	for _, v := range columnTypes {
-		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
-	}
-	result := &v1pb.QueryResult{
-		ColumnNames:     columnNames,
-		ColumnTypeNames: columnTypeNames,
-	}
-	columnLength := len(columnNames)
 
-	if columnLength > 0 {
+	if len(result.ColumnNames) > 0 {
 		for rows.Next() {
-			values := make([]any, columnLength)
-			for i, v := range columnTypeNames {
-				values[i] = valueMaker(v, columnTypes[i])
-			}
-
-			if err := rows.Scan(values...); err != nil {
+			row, err := scanQueryRow(rows, columnTypes, result.ColumnTypeNames, valueMaker, rowValueConverter)
+			if err != nil {
 				return nil, err
 			}
-
-			row := &v1pb.QueryRow{}
-			for i := 0; i < columnLength; i++ {
-				row.Values = append(row.Values, rowValueConverter(columnTypeNames[i], columnTypes[i], values[i]))
-			}
-
 			result.Rows = append(result.Rows, row)
 			n := len(result.Rows)
 			if (n&(n-1) == 0) && int64(proto.Size(result)) > limit {
@@ -99,6 +74,102 @@ func RowsToQueryResult(rows *sql.Rows, valueMaker func(string, *sql.ColumnType)
 	return result, nil
 }
 
+// QueryResultChunkSize is the maximum number of rows in a chunk of the streamed query result.
+const QueryResultChunkSize = 1000
+
+// StreamRowsToQueryResult converts the rows to the query result chunks and sends them to the handler one by one.
+// It returns an error once the total size of the chunks exceeds the limit.
+func StreamRowsToQueryResult(rows *sql.Rows, valueMaker func(string, *sql.ColumnType) any, rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue, limit int64, handler func(*v1pb.QueryResult) error) error {
+	columns, columnTypes, err := newQueryResultWithColumns(rows)
+	if err != nil {
+		return err
+	}
+	newChunk := func() *v1pb.QueryResult {
+		return &v1pb.QueryResult{
+			ColumnNames:     columns.ColumnNames,
+			ColumnTypeNames: columns.ColumnTypeNames,
+		}
+	}
+
+	var size int64
+	sent := false
+	chunk := newChunk()
+	send := func() error {
+		size += int64(proto.Size(chunk))
+		if limit > 0 && size > limit {
+			return errors.New(common.FormatMaximumSQLResultSizeMessage(limit))
+		}
+		chunk.RowsCount = int64(len(chunk.Rows))
+		if err := handler(chunk); err != nil {
+			return err
+		}
+		sent = true
+		chunk = newChunk()
+		return nil
+	}
+
+	if len(columns.ColumnNames) > 0 {
+		for rows.Next() {
+			row, err := scanQueryRow(rows, columnTypes, columns.ColumnTypeNames, valueMaker, rowValueConverter)
+			if err != nil {
+				return err
+			}
+			chunk.Rows = append(chunk.Rows, row)
+			if len(chunk.Rows) >= QueryResultChunkSize {
+				if err := send(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(chunk.Rows) > 0 || !sent {
+		return send()
+	}
+	return nil
+}
+
+func newQueryResultWithColumns(rows *sql.Rows) (*v1pb.QueryResult, []*sql.ColumnType, error) {
+	columnNames, err := rows.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, err
+	}
+	// DatabaseTypeName returns the database system name of the column type.
+	// refer: https://pkg.go.dev/database/sql#ColumnType.DatabaseTypeName
+	var columnTypeNames []string
+	for _, v := range columnTypes {
+		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+	}
+	return &v1pb.QueryResult{
+		ColumnNames:     columnNames,
+		ColumnTypeNames: columnTypeNames,
+	}, columnTypes, nil
+}
+
+func scanQueryRow(rows *sql.Rows, columnTypes []*sql.ColumnType, columnTypeNames []string, valueMaker func(string, *sql.ColumnType) any, rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue) (*v1pb.QueryRow, error) {
+	values := make([]any, len(columnTypeNames))
+	for i, v := range columnTypeNames {
+		values[i] = valueMaker(v, columnTypes[i])
+	}
+
+	if err := rows.Scan(values...); err != nil {
+		return nil, err
+	}
+
+	row := &v1pb.QueryRow{}
+	for i := range columnTypeNames {
+		row.Values = append(row.Values, rowValueConverter(columnTypeNames[i], columnTypes[i], values[i]))
+	}
+	return row, nil
+}
+
 func MakeCommonValueByTypeName(typeName string, _ *sql.ColumnType) any {
 	switch typeName {
 	case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
@@ -0,0 +1,191 @@
+package ldap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	defaultMemberAttribute = "member"
+	memberOfAttribute      = "memberOf"
+)
+
+// GroupMembers returns the identifiers of the users in the LDAP group mapping.
+// The users are either the ones matching the filter of the mapping, or the members of the group,
+// which are resolved by the member attribute of the group entries or the "memberOf" attribute of the user entries.
+func (p *IdentityProvider) GroupMembers(conn *ldap.Conn, mapping *storepb.LDAPGroupMapping) ([]string, error) {
+	if mapping.GetFilter() != "" {
+		return p.searchIdentifiers(conn, mapping.Filter)
+	}
+	if mapping.GetGroupDn() == "" {
+		return nil, errors.New("either the group DN or the filter is required")
+	}
+	if p.config.GroupSync.GetUseMemberOf() {
+		return p.groupMembersByMemberOf(conn, mapping.GroupDn)
+	}
+	return p.groupMembersByMemberAttribute(conn, mapping.GroupDn)
+}
+
+// groupMembersByMemberAttribute walks the member attribute of the group entries.
+func (p *IdentityProvider) groupMembersByMemberAttribute(conn *ldap.Conn, groupDN string) ([]string, error) {
+	memberAttribute := p.memberAttribute()
+	identifierAttribute := p.config.FieldMapping.Identifier
+
+	var identifiers []string
+	visited := map[string]bool{normalizeDN(groupDN): true}
+	queue := []string{groupDN}
+	for len(queue) > 0 {
+		group, err := p.readEntry(conn, queue[0], memberAttribute)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read group %q", queue[0])
+		}
+		queue = queue[1:]
+		for _, memberDN := range group.GetAttributeValues(memberAttribute) {
+			if visited[normalizeDN(memberDN)] {
+				continue
+			}
+			visited[normalizeDN(memberDN)] = true
+
+			member, err := p.readEntry(conn, memberDN, memberAttribute, identifierAttribute)
+			if err != nil {
+				if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+					// The member may be deleted while the group is not updated yet.
+					continue
+				}
+				return nil, errors.Wrapf(err, "failed to read member %q", memberDN)
+			}
+			if identifier := member.GetAttributeValue(identifierAttribute); identifier != "" {
+				identifiers = append(identifiers, identifier)
+				continue
+			}
+			// The member without the identifier is regarded as a nested group.
+			if p.config.GroupSync.GetNested() && len(member.GetAttributeValues(memberAttribute)) > 0 {
+				queue = append(queue, memberDN)
+			}
+		}
+	}
+	return identifiers, nil
+}
+
+// groupMembersByMemberOf searches the users whose "memberOf" attribute contains the group or its nested groups.
+func (p *IdentityProvider) groupMembersByMemberOf(conn *ldap.Conn, groupDN string) ([]string, error) {
+	groupDNs := []string{groupDN}
+	if p.config.GroupSync.GetNested() {
+		groupBaseDN := p.config.GroupSync.GetGroupBaseDn()
+		if groupBaseDN == "" {
+			groupBaseDN = p.config.BaseDN
+		}
+		visited := map[string]bool{normalizeDN(groupDN): true}
+		for i := 0; i < len(groupDNs); i++ {
+			sr, err := conn.Search(ldap.NewSearchRequest(
+				groupBaseDN,
+				ldap.ScopeWholeSubtree,
+				ldap.NeverDerefAliases,
+				0,
+				0,
+				false,
+				fmt.Sprintf("(%s=%s)", memberOfAttribute, ldap.EscapeFilter(groupDNs[i])),
+				[]string{"dn", p.config.FieldMapping.Identifier},
+				nil,
+			))
+			if err != nil {
+				return nil, errors.Errorf("search nested groups of %q: %v", groupDNs[i], err)
+			}
+			for _, entry := range sr.Entries {
+				// The users are members of the group as well, only the groups are followed.
+				if entry.GetAttributeValue(p.config.FieldMapping.Identifier) != "" || visited[normalizeDN(entry.DN)] {
+					continue
+				}
+				visited[normalizeDN(entry.DN)] = true
+				groupDNs = append(groupDNs, entry.DN)
+			}
+		}
+	}
+
+	var filter strings.Builder
+	filter.WriteString("(|")
+	for _, dn := range groupDNs {
+		fmt.Fprintf(&filter, "(%s=%s)", memberOfAttribute, ldap.EscapeFilter(dn))
+	}
+	filter.WriteString(")")
+	return p.searchIdentifiers(conn, filter.String())
+}
+
+// searchIdentifiers returns the identifiers of the users matching the filter under the base DN.
+func (p *IdentityProvider) searchIdentifiers(conn *ldap.Conn, filter string) ([]string, error) {
+	identifierAttribute := p.config.FieldMapping.Identifier
+	sr, err := conn.SearchWithPaging(
+		ldap.NewSearchRequest(
+			p.config.BaseDN,
+			ldap.ScopeWholeSubtree,
+			ldap.NeverDerefAliases,
+			0,
+			0,
+			false,
+			filter,
+			[]string{"dn", identifierAttribute},
+			nil,
+		),
+		500,
+	)
+	if err != nil {
+		return nil, errors.Errorf("search users with filter %q: %v", filter, err)
+	}
+	var identifiers []string
+	for _, entry := range sr.Entries {
+		if identifier := entry.GetAttributeValue(identifierAttribute); identifier != "" {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	return identifiers, nil
+}
+
+func (*IdentityProvider) readEntry(conn *ldap.Conn, dn string, attributes ...string) (*ldap.Entry, error) {
+	sr, err := conn.Search(ldap.NewSearchRequest(
+		dn,
+		ldap.ScopeBaseObject,
+		ldap.NeverDerefAliases,
+		0,
+		0,
+		false,
+		"(objectClass=*)",
+		attributes,
+		nil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(sr.Entries) != 1 {
+		return nil, ldap.NewError(ldap.LDAPResultNoSuchObject, errors.Errorf("expect 1 entry but got %d", len(sr.Entries)))
+	}
+	return sr.Entries[0], nil
+}
+
+func (p *IdentityProvider) memberAttribute() string {
+	if v := p.config.GroupSync.GetMemberAttribute(); v != "" {
+		return v
+	}
+	return defaultMemberAttribute
+}
+
+// normalizeDN normalizes the DN for comparison, falling back to the lower-cased DN if it cannot be parsed.
+func normalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(dn)
+	}
+	var rdns []string
+	for _, rdn := range parsed.RDNs {
+		var attributes []string
+		for _, attribute := range rdn.Attributes {
+			attributes = append(attributes, strings.ToLower(attribute.Type)+"="+strings.ToLower(attribute.Value))
+		}
+		rdns = append(rdns, strings.Join(attributes, "+"))
+	}
+	return strings.Join(rdns, ",")
+}
@@ -0,0 +1,199 @@
+package ldap
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lor00x/goldap/message"
+	"github.com/stretchr/testify/require"
+	"github.com/vjeantet/ldapserver"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// testDirectory is the in-process LDAP directory keyed by the DN.
+var testDirectory = map[string]map[string][]string{
+	"uid=alice,ou=users,dc=example,dc=com": {
+		"uid":        {"alice"},
+		"department": {"DBA"},
+		"memberOf":   {"cn=dba,ou=groups,dc=example,dc=com"},
+	},
+	"uid=bob,ou=users,dc=example,dc=com": {
+		"uid":      {"bob"},
+		"memberOf": {"cn=backend,ou=groups,dc=example,dc=com"},
+	},
+	"uid=carol,ou=users,dc=example,dc=com": {
+		"uid":        {"carol"},
+		"department": {"DBA"},
+		"memberOf":   {"cn=dev,ou=groups,dc=example,dc=com"},
+	},
+	"cn=dba,ou=groups,dc=example,dc=com": {
+		"cn": {"dba"},
+		"member": {
+			"uid=alice,ou=users,dc=example,dc=com",
+			"cn=backend,ou=groups,dc=example,dc=com",
+			// The deleted user is ignored.
+			"uid=ghost,ou=users,dc=example,dc=com",
+		},
+		"memberOf": {"cn=backend,ou=groups,dc=example,dc=com"},
+	},
+	"cn=backend,ou=groups,dc=example,dc=com": {
+		"cn": {"backend"},
+		"member": {
+			"uid=bob,ou=users,dc=example,dc=com",
+			// The cycle is broken.
+			"cn=dba,ou=groups,dc=example,dc=com",
+		},
+		"memberOf": {"cn=dba,ou=groups,dc=example,dc=com"},
+	},
+}
+
+func matchFilter(attributes map[string][]string, filter message.Filter) bool {
+	switch f := filter.(type) {
+	case message.FilterAnd:
+		for _, child := range f {
+			if !matchFilter(attributes, child) {
+				return false
+			}
+		}
+		return true
+	case message.FilterOr:
+		for _, child := range f {
+			if matchFilter(attributes, child) {
+				return true
+			}
+		}
+		return false
+	case message.FilterNot:
+		return !matchFilter(attributes, f.Filter)
+	case message.FilterPresent:
+		return strings.EqualFold(string(f), "objectClass") || len(attributes[string(f)]) > 0
+	case message.FilterEqualityMatch:
+		return slices.ContainsFunc(attributes[string(f.AttributeDesc())], func(v string) bool {
+			return strings.EqualFold(v, string(f.AssertionValue()))
+		})
+	default:
+		return false
+	}
+}
+
+func newMockDirectoryServer(t *testing.T) (string, int) {
+	server := ldapserver.NewServer()
+	routes := ldapserver.NewRouteMux()
+	routes.Bind(func(w ldapserver.ResponseWriter, _ *ldapserver.Message) {
+		w.Write(ldapserver.NewBindResponse(ldapserver.LDAPResultSuccess))
+	})
+	routes.Search(func(w ldapserver.ResponseWriter, m *ldapserver.Message) {
+		r := m.GetSearchRequest()
+		baseDN := strings.ToLower(string(r.BaseObject()))
+		found := false
+		for dn, attributes := range testDirectory {
+			if r.Scope() == ldapserver.SearchRequestScopeBaseObject {
+				if dn != baseDN {
+					continue
+				}
+				found = true
+			} else if !strings.HasSuffix(dn, baseDN) {
+				continue
+			}
+			if !matchFilter(attributes, r.Filter()) {
+				continue
+			}
+			e := ldapserver.NewSearchResultEntry(dn)
+			for name, values := range attributes {
+				var attributeValues []message.AttributeValue
+				for _, v := range values {
+					attributeValues = append(attributeValues, message.AttributeValue(v))
+				}
+				e.AddAttribute(message.AttributeDescription(name), attributeValues...)
+			}
+			w.Write(e)
+		}
+		if r.Scope() == ldapserver.SearchRequestScopeBaseObject && !found {
+			w.Write(ldapserver.NewSearchResultDoneResponse(ldapserver.LDAPResultNoSuchObject))
+			return
+		}
+		w.Write(ldapserver.NewSearchResultDoneResponse(ldapserver.LDAPResultSuccess))
+	})
+	server.Handle(routes)
+
+	go func() {
+		err := server.ListenAndServe("127.0.0.1:10390")
+		require.NoError(t, err)
+	}()
+	t.Cleanup(func() { server.Stop() })
+
+	// Give a second for the server to start
+	time.Sleep(time.Second)
+	return "127.0.0.1", 10390
+}
+
+func TestGroupMembers(t *testing.T) {
+	host, port := newMockDirectoryServer(t)
+
+	tests := []struct {
+		name      string
+		groupSync *storepb.LDAPGroupSyncConfig
+		mapping   *storepb.LDAPGroupMapping
+		want      []string
+	}{
+		{
+			name:      "member attribute",
+			groupSync: &storepb.LDAPGroupSyncConfig{},
+			mapping:   &storepb.LDAPGroupMapping{GroupDn: "cn=dba,ou=groups,dc=example,dc=com"},
+			want:      []string{"alice"},
+		},
+		{
+			name:      "nested member attribute",
+			groupSync: &storepb.LDAPGroupSyncConfig{Nested: true},
+			mapping:   &storepb.LDAPGroupMapping{GroupDn: "cn=dba,ou=groups,dc=example,dc=com"},
+			want:      []string{"alice", "bob"},
+		},
+		{
+			name:      "memberOf",
+			groupSync: &storepb.LDAPGroupSyncConfig{UseMemberOf: true},
+			mapping:   &storepb.LDAPGroupMapping{GroupDn: "cn=dba,ou=groups,dc=example,dc=com"},
+			want:      []string{"alice"},
+		},
+		{
+			name:      "nested memberOf",
+			groupSync: &storepb.LDAPGroupSyncConfig{UseMemberOf: true, Nested: true, GroupBaseDn: "ou=groups,dc=example,dc=com"},
+			mapping:   &storepb.LDAPGroupMapping{GroupDn: "cn=dba,ou=groups,dc=example,dc=com"},
+			want:      []string{"alice", "bob"},
+		},
+		{
+			name:      "filter",
+			groupSync: &storepb.LDAPGroupSyncConfig{},
+			mapping:   &storepb.LDAPGroupMapping{Filter: "(department=DBA)"},
+			want:      []string{"alice", "carol"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			p, err := NewIdentityProvider(IdentityProviderConfig{
+				Host:         host,
+				Port:         port,
+				BindDN:       "uid=system,ou=users,dc=example,dc=com",
+				BindPassword: "pa$$word",
+				BaseDN:       "dc=example,dc=com",
+				UserFilter:   "(uid=%s)",
+				FieldMapping: &storepb.FieldMapping{
+					Identifier: "uid",
+				},
+				GroupSync: test.groupSync,
+			})
+			a.NoError(err)
+			conn, err := p.Connect()
+			a.NoError(err)
+			defer conn.Close()
+
+			members, err := p.GroupMembers(conn, test.mapping)
+			a.NoError(err)
+			slices.Sort(members)
+			a.Equal(test.want, members)
+		})
+	}
+}
@@ -44,6 +44,9 @@ type IdentityProviderConfig struct {
 	// FieldMapping is the mapping of the user attributes returned by the LDAP
 	// server.
 	FieldMapping *storepb.FieldMapping `json:"fieldMapping"`
+	// GroupSync is the configuration to synchronize the LDAP groups, which is
+	// only required for resolving the group members.
+	GroupSync *storepb.LDAPGroupSyncConfig `json:"groupSync"`
 }
 
 // NewIdentityProvider initializes a new LDAP Identity Provider with the given
@@ -0,0 +1,509 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	namespaceProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	namespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	bindingHTTPPost    = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	statusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	confirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	// NameIDAttribute is the field mapping name referring to the name identifier of the subject.
+	NameIDAttribute = "NameID"
+
+	// maxClockSkew is the tolerance of the clock difference between the identity provider and us.
+	maxClockSkew = 3 * time.Minute
+)
+
+// EntityID returns the entity ID of Bytebase as the service provider for the identity provider, which is also the metadata URL.
+func EntityID(externalURL, identityProviderID string) string {
+	return fmt.Sprintf("%s/saml/metadata/%s", strings.TrimSuffix(externalURL, "/"), identityProviderID)
+}
+
+// ACSURL returns the assertion consumer service URL of Bytebase as the service provider for the identity provider.
+func ACSURL(externalURL, identityProviderID string) string {
+	return fmt.Sprintf("%s/saml/acs/%s", strings.TrimSuffix(externalURL, "/"), identityProviderID)
+}
+
+// IdentityProvider represents a SAML 2.0 Identity Provider.
+type IdentityProvider struct {
+	config *storepb.SAMLIdentityProviderConfig
+	// certificate is the certificate of the identity provider.
+	certificate *x509.Certificate
+	// spPrivateKey is the private key of the service provider, nil if the AuthnRequest is not signed.
+	spPrivateKey *rsa.PrivateKey
+	// spCertificate is the DER encoded certificate of the service provider.
+	spCertificate []byte
+	// spEntityID is the entity ID of the service provider, i.e. Bytebase.
+	spEntityID string
+	// acsURL is the assertion consumer service URL of the service provider.
+	acsURL string
+}
+
+// NewIdentityProvider initializes a new SAML Identity Provider with the given
+// configuration and the service provider's entity ID and assertion consumer service URL.
+func NewIdentityProvider(config *storepb.SAMLIdentityProviderConfig, spEntityID, acsURL string) (*IdentityProvider, error) {
+	// Check the required fields in order so that the same config always reports the same missing field.
+	for _, required := range []struct {
+		field string
+		value string
+	}{
+		{field: "entityId", value: config.GetEntityId()},
+		{field: "ssoUrl", value: config.GetSsoUrl()},
+		{field: "certificate", value: config.GetCertificate()},
+		{field: "fieldMapping.identifier", value: config.GetFieldMapping().GetIdentifier()},
+	} {
+		if required.value == "" {
+			return nil, errors.Errorf("the field %q is empty but required", required.field)
+		}
+	}
+	certificate, err := parseCertificate(config.Certificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid identity provider certificate")
+	}
+	p := &IdentityProvider{
+		config:      config,
+		certificate: certificate,
+		spEntityID:  spEntityID,
+		acsURL:      acsURL,
+	}
+	if config.SpCertificate != "" {
+		spCertificate, err := parseCertificate(config.SpCertificate)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid service provider certificate")
+		}
+		p.spCertificate = spCertificate.Raw
+	}
+	if config.SpPrivateKey != "" {
+		p.spPrivateKey, err = parsePrivateKey(config.SpPrivateKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid service provider private key")
+		}
+	}
+	return p, nil
+}
+
+// Metadata returns the SAML metadata of the service provider.
+func (p *IdentityProvider) Metadata() ([]byte, error) {
+	type x509Data struct {
+		Certificate string `xml:"X509Certificate"`
+	}
+	type keyInfo struct {
+		XMLName  xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+		X509Data x509Data `xml:"X509Data"`
+	}
+	type keyDescriptor struct {
+		Use     string  `xml:"use,attr"`
+		KeyInfo keyInfo `xml:"KeyInfo"`
+	}
+	type endpoint struct {
+		Binding  string `xml:"Binding,attr"`
+		Location string `xml:"Location,attr"`
+		Index    int    `xml:"index,attr"`
+	}
+	type spSSODescriptor struct {
+		AuthnRequestsSigned        bool            `xml:"AuthnRequestsSigned,attr"`
+		WantAssertionsSigned       bool            `xml:"WantAssertionsSigned,attr"`
+		ProtocolSupportEnumeration string          `xml:"protocolSupportEnumeration,attr"`
+		KeyDescriptors             []keyDescriptor `xml:"KeyDescriptor"`
+		NameIDFormat               string          `xml:"NameIDFormat,omitempty"`
+		AssertionConsumerService   endpoint        `xml:"AssertionConsumerService"`
+	}
+	type entityDescriptor struct {
+		XMLName         xml.Name        `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+		EntityID        string          `xml:"entityID,attr"`
+		SPSSODescriptor spSSODescriptor `xml:"SPSSODescriptor"`
+	}
+
+	descriptor := entityDescriptor{
+		EntityID: p.spEntityID,
+		SPSSODescriptor: spSSODescriptor{
+			AuthnRequestsSigned:        p.spPrivateKey != nil,
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: namespaceProtocol,
+			NameIDFormat:               p.config.NameIdFormat,
+			AssertionConsumerService: endpoint{
+				Binding:  bindingHTTPPost,
+				Location: p.acsURL,
+				Index:    0,
+			},
+		},
+	}
+	if p.spCertificate != nil {
+		descriptor.SPSSODescriptor.KeyDescriptors = append(descriptor.SPSSODescriptor.KeyDescriptors, keyDescriptor{
+			Use:     "signing",
+			KeyInfo: keyInfo{X509Data: x509Data{Certificate: base64.StdEncoding.EncodeToString(p.spCertificate)}},
+		})
+	}
+	b, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal metadata")
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// AuthnRequestURL returns the URL redirecting the user to the identity provider with an AuthnRequest
+// using the HTTP-Redirect binding, and the ID of the AuthnRequest which the SAMLResponse must be in response to.
+// The AuthnRequest is signed if the service provider private key is configured.
+func (p *IdentityProvider) AuthnRequestURL(now time.Time) (string, string, error) {
+	type nameIDPolicy struct {
+		Format      string `xml:"Format,attr,omitempty"`
+		AllowCreate bool   `xml:"AllowCreate,attr"`
+	}
+	type authnRequest struct {
+		XMLName                     xml.Name      `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+		ID                          string        `xml:"ID,attr"`
+		Version                     string        `xml:"Version,attr"`
+		IssueInstant                string        `xml:"IssueInstant,attr"`
+		Destination                 string        `xml:"Destination,attr"`
+		ProtocolBinding             string        `xml:"ProtocolBinding,attr"`
+		AssertionConsumerServiceURL string        `xml:"AssertionConsumerServiceURL,attr"`
+		Issuer                      string        `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		NameIDPolicy                *nameIDPolicy `xml:"NameIDPolicy"`
+	}
+
+	id, err := newID()
+	if err != nil {
+		return "", "", err
+	}
+	request := authnRequest{
+		ID:                          id,
+		Version:                     "2.0",
+		IssueInstant:                now.UTC().Format(time.RFC3339),
+		Destination:                 p.config.SsoUrl,
+		ProtocolBinding:             bindingHTTPPost,
+		AssertionConsumerServiceURL: p.acsURL,
+		Issuer:                      p.spEntityID,
+		NameIDPolicy: &nameIDPolicy{
+			Format:      p.config.NameIdFormat,
+			AllowCreate: true,
+		},
+	}
+	b, err := xml.Marshal(request)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to marshal AuthnRequest")
+	}
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to create deflate writer")
+	}
+	if _, err := w.Write(b); err != nil {
+		return "", "", errors.Wrap(err, "failed to deflate AuthnRequest")
+	}
+	if err := w.Close(); err != nil {
+		return "", "", errors.Wrap(err, "failed to deflate AuthnRequest")
+	}
+
+	// The signature is computed over the query string in the order defined by the HTTP-Redirect binding.
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if p.spPrivateKey != nil {
+		query += "&SigAlg=" + url.QueryEscape(dsig.RSASHA256SignatureMethod)
+		digest := sha256.Sum256([]byte(query))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, p.spPrivateKey, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to sign AuthnRequest")
+		}
+		query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+	}
+
+	separator := "?"
+	if strings.Contains(p.config.SsoUrl, "?") {
+		separator = "&"
+	}
+	return p.config.SsoUrl + separator + query, id, nil
+}
+
+// AssertionConsumer marks the assertion as consumed until it expires, and returns false if it has already been consumed.
+// It must be shared by all the replicas, otherwise a captured SAMLResponse can be replayed on another replica.
+type AssertionConsumer func(ctx context.Context, issuer, id string, expiresAt time.Time) (bool, error)
+
+// UserInfo validates the base64 encoded SAMLResponse posted by the identity provider in response to
+// the AuthnRequest with the request ID, and returns the user information and the attributes of the assertion.
+// Each assertion is accepted only once by the consumer.
+func (p *IdentityProvider) UserInfo(ctx context.Context, samlResponse, requestID string, consume AssertionConsumer, now time.Time) (*storepb.IdentityProviderUserInfo, map[string][]string, error) {
+	raw, err := base64.StdEncoding.DecodeString(removeWhitespace(samlResponse))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode SAMLResponse")
+	}
+	response, err := parseXML(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	assertion, err := p.validateResponse(ctx, response, requestID, consume, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attributes := map[string][]string{}
+	nameID := text(findChild(findChild(assertion, namespaceAssertion, "Subject"), namespaceAssertion, "NameID"))
+	if nameID != "" {
+		attributes[NameIDAttribute] = []string{nameID}
+	}
+	for _, statement := range findChildren(assertion, namespaceAssertion, "AttributeStatement") {
+		for _, attribute := range findChildren(statement, namespaceAssertion, "Attribute") {
+			var values []string
+			for _, value := range findChildren(attribute, namespaceAssertion, "AttributeValue") {
+				values = append(values, text(value))
+			}
+			// The attribute can be referred by either the name or the friendly name.
+			if name, ok := attr(attribute, "Name"); ok && name != "" {
+				attributes[name] = append(attributes[name], values...)
+			}
+			if friendlyName, ok := attr(attribute, "FriendlyName"); ok && friendlyName != "" {
+				attributes[friendlyName] = append(attributes[friendlyName], values...)
+			}
+		}
+	}
+	slog.Debug("SAML attributes", slog.Any("attributes", attributes))
+
+	fieldMapping := p.config.FieldMapping
+	userInfo := &storepb.IdentityProviderUserInfo{}
+	if v := attributes[fieldMapping.Identifier]; len(v) > 0 {
+		userInfo.Identifier = v[0]
+	}
+	if fieldMapping.DisplayName != "" {
+		if v := attributes[fieldMapping.DisplayName]; len(v) > 0 {
+			userInfo.DisplayName = v[0]
+		}
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.Phone != "" {
+		if v := attributes[fieldMapping.Phone]; len(v) > 0 {
+			// Only set phone if it's valid.
+			if err := common.ValidatePhone(v[0]); err == nil {
+				userInfo.Phone = v[0]
+			}
+		}
+	}
+	if fieldMapping.Groups != "" {
+		if v, ok := attributes[fieldMapping.Groups]; ok {
+			userInfo.HasGroups = true
+			userInfo.Groups = v
+		}
+	}
+	return userInfo, attributes, nil
+}
+
+// validateResponse validates the response to the request with the ID, and returns the assertion
+// as covered by the verified signature, either of the response or of the assertion itself.
+func (p *IdentityProvider) validateResponse(ctx context.Context, response *etree.Element, requestID string, consume AssertionConsumer, now time.Time) (*etree.Element, error) {
+	if !isElement(response, namespaceProtocol, "Response") {
+		return nil, errors.Errorf("unexpected root element %q", response.Tag)
+	}
+	// Verify the signature first, so that the rest only reads the signed content.
+	responseSigned := isSigned(response)
+	if responseSigned {
+		verified, err := verifySignature(response, p.certificate, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to verify response signature")
+		}
+		response = verified
+	}
+
+	if version, _ := attr(response, "Version"); version != "2.0" {
+		return nil, errors.Errorf("unsupported SAML version %q", version)
+	}
+	if destination, _ := attr(response, "Destination"); destination != p.acsURL {
+		return nil, errors.Errorf("mismatched destination, want %q but got %q", p.acsURL, destination)
+	}
+	if inResponseTo, _ := attr(response, "InResponseTo"); requestID == "" || inResponseTo != requestID {
+		return nil, errors.Errorf("the response is in response to %q instead of the request %q", inResponseTo, requestID)
+	}
+	if issuer := findChild(response, namespaceAssertion, "Issuer"); issuer != nil && text(issuer) != p.config.EntityId {
+		return nil, errors.Errorf("mismatched response issuer, want %q but got %q", p.config.EntityId, text(issuer))
+	}
+	status := findChild(response, namespaceProtocol, "Status")
+	if statusCode, _ := attr(findChild(status, namespaceProtocol, "StatusCode"), "Value"); statusCode != statusSuccess {
+		message := text(findChild(status, namespaceProtocol, "StatusMessage"))
+		return nil, errors.Errorf("the identity provider returned status %q: %s", statusCode, message)
+	}
+
+	if len(findChildren(response, namespaceAssertion, "EncryptedAssertion")) > 0 {
+		return nil, errors.New("encrypted assertions are not supported")
+	}
+	assertions := findChildren(response, namespaceAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, errors.Errorf("expect exactly one assertion, got %d", len(assertions))
+	}
+	assertion := assertions[0]
+	if isSigned(assertion) {
+		verified, err := verifySignature(assertion, p.certificate, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to verify assertion signature")
+		}
+		assertion = verified
+	} else if !responseSigned {
+		return nil, errors.New("neither the response nor the assertion is signed")
+	}
+
+	id, _ := attr(assertion, "ID")
+	if id == "" {
+		return nil, errors.New("missing assertion ID")
+	}
+	issuer := text(findChild(assertion, namespaceAssertion, "Issuer"))
+	if issuer != p.config.EntityId {
+		return nil, errors.Errorf("mismatched assertion issuer, want %q but got %q", p.config.EntityId, issuer)
+	}
+	expiry, err := p.validateSubject(findChild(assertion, namespaceAssertion, "Subject"), requestID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validateConditions(findChild(assertion, namespaceAssertion, "Conditions"), now); err != nil {
+		return nil, err
+	}
+	// The assertion is consumed only after it is fully validated.
+	consumed, err := consume(ctx, issuer, id, expiry.Add(maxClockSkew))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consume assertion")
+	}
+	if !consumed {
+		return nil, errors.Errorf("the assertion %q has already been used", id)
+	}
+	return assertion, nil
+}
+
+// validateSubject validates the bearer subject confirmation, and returns the time the confirmation expires.
+func (p *IdentityProvider) validateSubject(subject *etree.Element, requestID string, now time.Time) (time.Time, error) {
+	if subject == nil {
+		return time.Time{}, errors.New("missing subject")
+	}
+	for _, confirmation := range findChildren(subject, namespaceAssertion, "SubjectConfirmation") {
+		if method, _ := attr(confirmation, "Method"); method != confirmationBearer {
+			continue
+		}
+		data := findChild(confirmation, namespaceAssertion, "SubjectConfirmationData")
+		if recipient, _ := attr(data, "Recipient"); recipient != p.acsURL {
+			return time.Time{}, errors.Errorf("mismatched recipient, want %q but got %q", p.acsURL, recipient)
+		}
+		if inResponseTo, _ := attr(data, "InResponseTo"); inResponseTo != requestID {
+			return time.Time{}, errors.Errorf("the subject confirmation is in response to %q instead of the request %q", inResponseTo, requestID)
+		}
+		notOnOrAfter, ok := attr(data, "NotOnOrAfter")
+		if !ok {
+			return time.Time{}, errors.New("missing subject confirmation NotOnOrAfter")
+		}
+		t, err := time.Parse(time.RFC3339, notOnOrAfter)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "invalid subject confirmation NotOnOrAfter %q", notOnOrAfter)
+		}
+		if !now.Before(t.Add(maxClockSkew)) {
+			return time.Time{}, errors.Errorf("the subject confirmation expired at %s", notOnOrAfter)
+		}
+		return t, nil
+	}
+	return time.Time{}, errors.New("missing bearer subject confirmation")
+}
+
+func (p *IdentityProvider) validateConditions(conditions *etree.Element, now time.Time) error {
+	if conditions == nil {
+		return errors.New("missing conditions")
+	}
+	if notBefore, ok := attr(conditions, "NotBefore"); ok {
+		t, err := time.Parse(time.RFC3339, notBefore)
+		if err != nil {
+			return errors.Wrapf(err, "invalid NotBefore %q", notBefore)
+		}
+		if now.Add(maxClockSkew).Before(t) {
+			return errors.Errorf("the assertion is not valid before %s", notBefore)
+		}
+	}
+	if notOnOrAfter, ok := attr(conditions, "NotOnOrAfter"); ok {
+		t, err := time.Parse(time.RFC3339, notOnOrAfter)
+		if err != nil {
+			return errors.Wrapf(err, "invalid NotOnOrAfter %q", notOnOrAfter)
+		}
+		if !now.Before(t.Add(maxClockSkew)) {
+			return errors.Errorf("the assertion expired at %s", notOnOrAfter)
+		}
+	}
+	// The assertion must be restricted to us, and each audience restriction must be satisfied.
+	restrictions := findChildren(conditions, namespaceAssertion, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return errors.New("missing audience restriction")
+	}
+	for _, restriction := range restrictions {
+		matched := false
+		for _, audience := range findChildren(restriction, namespaceAssertion, "Audience") {
+			if text(audience) == p.spEntityID {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return errors.Errorf("the assertion is not intended for the audience %q", p.spEntityID)
+		}
+	}
+	return nil
+}
+
+func parseCertificate(s string) (*x509.Certificate, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "-----BEGIN") {
+		// Accept the base64 encoded DER as copied from the IdP metadata.
+		s = fmt.Sprintf("-----BEGIN CERTIFICATE-----\n%s\n-----END CERTIFICATE-----", s)
+	}
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(s)))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key must be an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// newID returns a random ID which is a valid xsd:ID, i.e. not starting with a digit.
+func newID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate ID")
+	}
+	return "id-" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,463 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	testEntityID   = "https://idp.example.com/metadata"
+	testSPEntityID = "https://bytebase.example.com/saml/metadata/idp-1"
+	testACSURL     = "https://bytebase.example.com/saml/acs/idp-1"
+	testRequestID  = "id-request-1"
+)
+
+type testKeyPair struct {
+	key         *rsa.PrivateKey
+	certificate []byte
+}
+
+func (k *testKeyPair) GetKeyPair() (*rsa.PrivateKey, []byte, error) {
+	return k.key, k.certificate, nil
+}
+
+func (k *testKeyPair) certificatePEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: k.certificate}))
+}
+
+func newTestKeyPair(t *testing.T) *testKeyPair {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-24 * time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &testKeyPair{key: key, certificate: der}
+}
+
+func newTestIdentityProvider(t *testing.T, certificate string) *IdentityProvider {
+	p, err := NewIdentityProvider(&storepb.SAMLIdentityProviderConfig{
+		EntityId:    testEntityID,
+		SsoUrl:      "https://idp.example.com/sso",
+		Certificate: certificate,
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  "email",
+			DisplayName: "displayName",
+			Groups:      "groups",
+		},
+	}, testSPEntityID, testACSURL)
+	require.NoError(t, err)
+	return p
+}
+
+// newTestAssertionConsumer returns the consumer remembering the consumed assertions in memory.
+func newTestAssertionConsumer() AssertionConsumer {
+	consumed := map[string]bool{}
+	return func(_ context.Context, issuer, id string, _ time.Time) (bool, error) {
+		key := issuer + "\x00" + id
+		if consumed[key] {
+			return false, nil
+		}
+		consumed[key] = true
+		return true, nil
+	}
+}
+
+// sign adds an enveloped signature to the element, as the identity provider does.
+func sign(t *testing.T, keyPair *testKeyPair, e *etree.Element) {
+	// The signature is computed over a detached copy since the canonicalization modifies the element.
+	ctx, err := etreeutils.NSBuildParentContext(e)
+	require.NoError(t, err)
+	detached, err := etreeutils.NSDetatch(ctx, e)
+	require.NoError(t, err)
+	signingContext := dsig.NewDefaultSigningContext(keyPair)
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signature, err := signingContext.ConstructSignature(detached, true)
+	require.NoError(t, err)
+	e.AddChild(signature)
+}
+
+type testResponse struct {
+	now                 time.Time
+	assertionID         string
+	destination         string
+	inResponseTo        string
+	subjectInResponseTo string
+	recipient           string
+	// audience is the audience of the assertion, no audience restriction if empty.
+	audience      string
+	signResponse  bool
+	signAssertion bool
+}
+
+func newTestResponse(t *testing.T, now time.Time) testResponse {
+	assertionID, err := newID()
+	require.NoError(t, err)
+	return testResponse{
+		now:                 now,
+		assertionID:         assertionID,
+		destination:         testACSURL,
+		inResponseTo:        testRequestID,
+		subjectInResponseTo: testRequestID,
+		recipient:           testACSURL,
+		audience:            testSPEntityID,
+		signAssertion:       true,
+	}
+}
+
+func (r testResponse) build(t *testing.T, keyPair *testKeyPair) string {
+	notOnOrAfter := r.now.Add(5 * time.Minute).UTC().Format(time.RFC3339)
+	audienceRestriction := ""
+	if r.audience != "" {
+		audienceRestriction = `<saml:AudienceRestriction><saml:Audience>` + r.audience + `</saml:Audience></saml:AudienceRestriction>`
+	}
+	document := `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ` +
+		`ID="response-1" Version="2.0" Destination="` + r.destination + `" InResponseTo="` + r.inResponseTo + `">` +
+		`<saml:Issuer>` + testEntityID + `</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+		`<saml:Assertion ID="` + r.assertionID + `" Version="2.0">` +
+		`<saml:Issuer>` + testEntityID + `</saml:Issuer>` +
+		`<saml:Subject><saml:NameID>alice@example.com</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">` +
+		`<saml:SubjectConfirmationData NotOnOrAfter="` + notOnOrAfter + `" Recipient="` + r.recipient + `" InResponseTo="` + r.subjectInResponseTo + `"/>` +
+		`</saml:SubjectConfirmation></saml:Subject>` +
+		`<saml:Conditions NotBefore="` + r.now.Add(-time.Minute).UTC().Format(time.RFC3339) + `" NotOnOrAfter="` + notOnOrAfter + `">` +
+		audienceRestriction + `</saml:Conditions>` +
+		`<saml:AttributeStatement>` +
+		`<saml:Attribute Name="urn:oid:0.9.2342.19200300.100.1.3" FriendlyName="email"><saml:AttributeValue>alice@example.com</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute Name="displayName"><saml:AttributeValue>Alice &amp; Co</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute Name="groups"><saml:AttributeValue>dev</saml:AttributeValue><saml:AttributeValue>dba</saml:AttributeValue></saml:Attribute>` +
+		`</saml:AttributeStatement></saml:Assertion></samlp:Response>`
+
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(document))
+	if r.signAssertion {
+		sign(t, keyPair, findChild(doc.Root(), namespaceAssertion, "Assertion"))
+	}
+	if r.signResponse {
+		sign(t, keyPair, doc.Root())
+	}
+	s, err := doc.WriteToString()
+	require.NoError(t, err)
+	return s
+}
+
+func TestUserInfo(t *testing.T) {
+	keyPair := newTestKeyPair(t)
+	otherKeyPair := newTestKeyPair(t)
+	p := newTestIdentityProvider(t, keyPair.certificatePEM())
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		response    func(r testResponse) string
+		now         time.Time
+		containsErr string
+	}{
+		{
+			name:     "signed assertion",
+			response: func(r testResponse) string { return r.build(t, keyPair) },
+			now:      now,
+		},
+		{
+			name: "signed response",
+			response: func(r testResponse) string {
+				r.signAssertion = false
+				r.signResponse = true
+				return r.build(t, keyPair)
+			},
+			now: now,
+		},
+		{
+			name: "signed response and assertion",
+			response: func(r testResponse) string {
+				r.signResponse = true
+				return r.build(t, keyPair)
+			},
+			now: now,
+		},
+		{
+			name: "unsigned",
+			response: func(r testResponse) string {
+				r.signAssertion = false
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "neither the response nor the assertion is signed",
+		},
+		{
+			name:        "signed by another key",
+			response:    func(r testResponse) string { return r.build(t, otherKeyPair) },
+			now:         now,
+			containsErr: "failed to verify assertion signature",
+		},
+		{
+			name: "tampered",
+			response: func(r testResponse) string {
+				return strings.Replace(r.build(t, keyPair), "alice@example.com</saml:AttributeValue>", "admin@example.com</saml:AttributeValue>", 1)
+			},
+			now:         now,
+			containsErr: "failed to verify assertion signature",
+		},
+		{
+			name: "signature wrapping",
+			response: func(r testResponse) string {
+				// The signature still references the original assertion, which has been replaced.
+				return strings.Replace(r.build(t, keyPair), `ID="`+r.assertionID+`"`, `ID="id-other"`, 1)
+			},
+			now:         now,
+			containsErr: "failed to verify assertion signature",
+		},
+		{
+			name: "wrong destination",
+			response: func(r testResponse) string {
+				r.destination = "https://other.example.com/acs"
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "mismatched destination",
+		},
+		{
+			name: "missing destination",
+			response: func(r testResponse) string {
+				return strings.Replace(r.build(t, keyPair), ` Destination="`+testACSURL+`"`, "", 1)
+			},
+			now:         now,
+			containsErr: "mismatched destination",
+		},
+		{
+			name: "response to another request",
+			response: func(r testResponse) string {
+				r.inResponseTo = "id-request-2"
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "instead of the request",
+		},
+		{
+			name: "subject confirmation for another request",
+			response: func(r testResponse) string {
+				r.subjectInResponseTo = "id-request-2"
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "the subject confirmation is in response to",
+		},
+		{
+			name: "unsolicited response",
+			response: func(r testResponse) string {
+				r.inResponseTo = ""
+				r.subjectInResponseTo = ""
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "instead of the request",
+		},
+		{
+			name: "wrong audience",
+			response: func(r testResponse) string {
+				r.audience = "https://other.example.com"
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "not intended for the audience",
+		},
+		{
+			name: "missing audience restriction",
+			response: func(r testResponse) string {
+				r.audience = ""
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "missing audience restriction",
+		},
+		{
+			name: "wrong recipient",
+			response: func(r testResponse) string {
+				r.recipient = "https://other.example.com/acs"
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "mismatched recipient",
+		},
+		{
+			name: "missing recipient",
+			response: func(r testResponse) string {
+				r.recipient = ""
+				return r.build(t, keyPair)
+			},
+			now:         now,
+			containsErr: "mismatched recipient",
+		},
+		{
+			name:        "expired",
+			response:    func(r testResponse) string { return r.build(t, keyPair) },
+			now:         now.Add(time.Hour),
+			containsErr: "expired",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			samlResponse := base64.StdEncoding.EncodeToString([]byte(test.response(newTestResponse(t, now))))
+			userInfo, attributes, err := p.UserInfo(context.Background(), samlResponse, testRequestID, newTestAssertionConsumer(), test.now)
+			if test.containsErr != "" {
+				a.ErrorContains(err, test.containsErr)
+				return
+			}
+			a.NoError(err)
+			a.Equal("alice@example.com", userInfo.Identifier)
+			a.Equal("Alice & Co", userInfo.DisplayName)
+			a.True(userInfo.HasGroups)
+			a.Equal([]string{"dev", "dba"}, userInfo.Groups)
+			a.Equal([]string{"alice@example.com"}, attributes[NameIDAttribute])
+			a.Equal([]string{"alice@example.com"}, attributes["urn:oid:0.9.2342.19200300.100.1.3"])
+		})
+	}
+}
+
+func TestUserInfoReplay(t *testing.T) {
+	a := require.New(t)
+	keyPair := newTestKeyPair(t)
+	p := newTestIdentityProvider(t, keyPair.certificatePEM())
+	now := time.Now()
+	samlResponse := base64.StdEncoding.EncodeToString([]byte(newTestResponse(t, now).build(t, keyPair)))
+
+	consume := newTestAssertionConsumer()
+	_, _, err := p.UserInfo(context.Background(), samlResponse, testRequestID, consume, now)
+	a.NoError(err)
+	_, _, err = p.UserInfo(context.Background(), samlResponse, testRequestID, consume, now)
+	a.ErrorContains(err, "has already been used")
+}
+
+func TestParseXMLRejectsDirective(t *testing.T) {
+	_, err := parseXML([]byte(`<!DOCTYPE foo [<!ENTITY x "y">]><foo>x</foo>`))
+	require.ErrorContains(t, err, "XML directives are not allowed")
+}
+
+func TestRequestState(t *testing.T) {
+	a := require.New(t)
+	now := time.Now()
+	state := &RequestState{IdentityProviderID: "idp-1", RequestID: testRequestID, ExpireTime: now.Add(RequestStateDuration)}
+	value, err := EncodeRequestState(state, "secret")
+	a.NoError(err)
+
+	decoded, err := DecodeRequestState(value, "secret", now)
+	a.NoError(err)
+	a.Equal(state.IdentityProviderID, decoded.IdentityProviderID)
+	a.Equal(state.RequestID, decoded.RequestID)
+
+	_, err = DecodeRequestState(value, "other-secret", now)
+	a.ErrorContains(err, "invalid request state signature")
+	_, err = DecodeRequestState(value, "secret", now.Add(RequestStateDuration))
+	a.ErrorContains(err, "expired")
+	forged, err := EncodeRequestState(&RequestState{IdentityProviderID: "idp-1", RequestID: "id-request-2", ExpireTime: state.ExpireTime}, "other-secret")
+	a.NoError(err)
+	payload, _, _ := strings.Cut(forged, ".")
+	_, signature, _ := strings.Cut(value, ".")
+	_, err = DecodeRequestState(payload+"."+signature, "secret", now)
+	a.ErrorContains(err, "invalid request state signature")
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	a := require.New(t)
+	spKeyPair := newTestKeyPair(t)
+	keyPair := newTestKeyPair(t)
+	p, err := NewIdentityProvider(&storepb.SAMLIdentityProviderConfig{
+		EntityId:      testEntityID,
+		SsoUrl:        "https://idp.example.com/sso?tenant=1",
+		Certificate:   keyPair.certificatePEM(),
+		SpCertificate: spKeyPair.certificatePEM(),
+		SpPrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKeyPair.key)})),
+		NameIdFormat:  "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		FieldMapping:  &storepb.FieldMapping{Identifier: NameIDAttribute},
+	}, testSPEntityID, testACSURL)
+	a.NoError(err)
+
+	authnRequestURL, requestID, err := p.AuthnRequestURL(time.Now())
+	a.NoError(err)
+	u, err := url.Parse(authnRequestURL)
+	a.NoError(err)
+	query := u.Query()
+	a.Equal("1", query.Get("tenant"))
+	a.Empty(query.Get("RelayState"))
+	a.Equal(dsig.RSASHA256SignatureMethod, query.Get("SigAlg"))
+
+	deflated, err := base64.StdEncoding.DecodeString(query.Get("SAMLRequest"))
+	a.NoError(err)
+	inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	a.NoError(err)
+	request, err := parseXML(inflated)
+	a.NoError(err)
+	a.True(isElement(request, namespaceProtocol, "AuthnRequest"))
+	a.Equal(testSPEntityID, text(findChild(request, namespaceAssertion, "Issuer")))
+	id, _ := attr(request, "ID")
+	a.Equal(requestID, id)
+	acsURL, _ := attr(request, "AssertionConsumerServiceURL")
+	a.Equal(testACSURL, acsURL)
+
+	// The signature is over the raw query string without the signature itself.
+	rawQuery := u.RawQuery
+	signedQuery := rawQuery[strings.Index(rawQuery, "SAMLRequest="):strings.Index(rawQuery, "&Signature=")]
+	signature, err := base64.StdEncoding.DecodeString(query.Get("Signature"))
+	a.NoError(err)
+	hashed := sha256.Sum256([]byte(signedQuery))
+	a.NoError(rsa.VerifyPKCS1v15(&spKeyPair.key.PublicKey, crypto.SHA256, hashed[:], signature))
+
+	metadata, err := p.Metadata()
+	a.NoError(err)
+	descriptor, err := parseXML(bytes.TrimPrefix(metadata, []byte(`<?xml version="1.0" encoding="UTF-8"?>`)))
+	a.NoError(err)
+	entityID, _ := attr(descriptor, "entityID")
+	a.Equal(testSPEntityID, entityID)
+	a.Contains(string(metadata), `AuthnRequestsSigned="true"`)
+	a.Contains(string(metadata), testACSURL)
+}
+
+func TestNewIdentityProviderRequiredFields(t *testing.T) {
+	a := require.New(t)
+	keyPair := newTestKeyPair(t)
+	tests := []struct {
+		config *storepb.SAMLIdentityProviderConfig
+		field  string
+	}{
+		{&storepb.SAMLIdentityProviderConfig{}, "entityId"},
+		{&storepb.SAMLIdentityProviderConfig{EntityId: testEntityID}, "ssoUrl"},
+		{&storepb.SAMLIdentityProviderConfig{EntityId: testEntityID, SsoUrl: "https://idp.example.com/sso"}, "certificate"},
+		{&storepb.SAMLIdentityProviderConfig{EntityId: testEntityID, SsoUrl: "https://idp.example.com/sso", Certificate: keyPair.certificatePEM()}, "fieldMapping.identifier"},
+	}
+	for _, test := range tests {
+		// The first missing field is always reported.
+		for i := 0; i < 10; i++ {
+			_, err := NewIdentityProvider(test.config, testSPEntityID, testACSURL)
+			a.EqualError(err, fmt.Sprintf("the field %q is empty but required", test.field))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package saml
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RequestStateCookieName is the name of the cookie binding the login to the browser which started it.
+	RequestStateCookieName = "bb-saml-request"
+	// RequestStateDuration is how long the user has to finish the login at the identity provider.
+	RequestStateDuration = 10 * time.Minute
+)
+
+// RequestState is the state of an AuthnRequest kept by the browser which started the login.
+// The SAMLResponse is only accepted if it responds to the request in the state, so that an
+// attacker cannot log the victim in with the attacker's own response.
+type RequestState struct {
+	IdentityProviderID string    `json:"idp"`
+	RequestID          string    `json:"requestId"`
+	ExpireTime         time.Time `json:"expireTime"`
+}
+
+// EncodeRequestState encodes the request state signed with the secret.
+func EncodeRequestState(state *RequestState, secret string) (string, error) {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request state")
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(signRequestState(payload, secret)), nil
+}
+
+// DecodeRequestState verifies the signature and the expiry of the encoded request state, and returns the state.
+func DecodeRequestState(value, secret string, now time.Time) (*RequestState, error) {
+	payload, signature, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil, errors.New("malformed request state")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed request state signature")
+	}
+	if !hmac.Equal(mac, signRequestState(payload, secret)) {
+		return nil, errors.New("invalid request state signature")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed request state")
+	}
+	state := &RequestState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, errors.Wrap(err, "malformed request state")
+	}
+	if !now.Before(state.ExpireTime) {
+		return nil, errors.New("the request state has expired")
+	}
+	return state, nil
+}
+
+func signRequestState(payload, secret string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write([]byte("saml-request-state."))
+	_, _ = h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,112 @@
+package saml
+
+import (
+	"crypto/x509"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+// parseXML parses the document and returns the root element.
+// Directives such as DTDs are rejected since a SAML message never contains them.
+func parseXML(data []byte) (*etree.Element, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, errors.Wrap(err, "failed to parse XML")
+	}
+	for _, token := range doc.Child {
+		if _, ok := token.(*etree.Directive); ok {
+			return nil, errors.New("XML directives are not allowed")
+		}
+	}
+	root := doc.Root()
+	if root == nil {
+		return nil, errors.New("missing root element")
+	}
+	return root, nil
+}
+
+// isElement returns whether the element has the namespace and local name.
+func isElement(e *etree.Element, namespace, tag string) bool {
+	return e != nil && e.Tag == tag && e.NamespaceURI() == namespace
+}
+
+// findChildren returns the child elements with the namespace and local name.
+func findChildren(e *etree.Element, namespace, tag string) []*etree.Element {
+	if e == nil {
+		return nil
+	}
+	var children []*etree.Element
+	for _, child := range e.ChildElements() {
+		if isElement(child, namespace, tag) {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// findChild returns the first child element with the namespace and local name, or nil if there is none.
+func findChild(e *etree.Element, namespace, tag string) *etree.Element {
+	if children := findChildren(e, namespace, tag); len(children) > 0 {
+		return children[0]
+	}
+	return nil
+}
+
+// attr returns the value of the unqualified attribute, and whether it exists.
+func attr(e *etree.Element, key string) (string, bool) {
+	if e == nil {
+		return "", false
+	}
+	for _, a := range e.Attr {
+		if a.Space == "" && a.Key == key {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// text returns the trimmed character data of the element.
+func text(e *etree.Element) string {
+	if e == nil {
+		return ""
+	}
+	return strings.TrimSpace(e.Text())
+}
+
+// isSigned returns whether the element has an enveloped signature.
+func isSigned(e *etree.Element) bool {
+	return findChild(e, dsig.Namespace, dsig.SignatureTag) != nil
+}
+
+// verifySignature verifies the enveloped signature of the element with the certificate, and returns
+// the element as covered by the signature. Only the returned element may be trusted: it is rebuilt
+// from the signed content, so that nothing injected outside the signed content can be read from it.
+func verifySignature(e *etree.Element, certificate *x509.Certificate, now time.Time) (*etree.Element, error) {
+	// Carry the namespaces declared on the ancestors since the element is verified on its own.
+	ctx, err := etreeutils.NSBuildParentContext(e)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build namespace context")
+	}
+	detached, err := etreeutils.NSDetatch(ctx, e)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to detach element")
+	}
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: []*x509.Certificate{certificate},
+	})
+	validationContext.Clock = dsig.NewFakeClockAt(now)
+	verified, err := validationContext.Validate(detached)
+	if err != nil {
+		return nil, err
+	}
+	return verified, nil
+}
+
+func removeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
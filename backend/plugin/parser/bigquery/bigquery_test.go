@@ -0,0 +1,52 @@
+package bigquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestParseBigQueryDDL(t *testing.T) {
+	statement := `CREATE TABLE IF NOT EXISTS ` + "`my-project.mydataset.events`" + `
+(
+  event_id STRING NOT NULL OPTIONS(description = "The event id"),
+  event_time TIMESTAMP,
+  user STRUCT<id INT64, name STRING>,
+  tags ARRAY<STRING>,
+  amount NUMERIC(10, 2) DEFAULT 0
+)
+PARTITION BY DATE(event_time)
+CLUSTER BY event_id
+OPTIONS (partition_expiration_days = 90, description = "events");
+ALTER TABLE mydataset.events ADD COLUMN IF NOT EXISTS country STRING;
+ALTER TABLE mydataset.events SET OPTIONS (description = "user events");
+CREATE OR REPLACE VIEW mydataset.daily AS
+SELECT DATE(event_time) AS d, COUNT(*) AS c FROM mydataset.events GROUP BY d;
+CREATE MATERIALIZED VIEW mydataset.daily_mv AS SELECT event_id, SUM(amount) AS total FROM mydataset.events GROUP BY event_id;
+DROP TABLE IF EXISTS mydataset.events_tmp;`
+	_, err := ParseBigQuerySQL(statement)
+	require.NoError(t, err)
+
+	// The parse failures are reported as syntax errors at the line of the failure.
+	tests := []struct {
+		statement string
+		line      int32
+	}{
+		{
+			statement: "CREATE TABLE mydataset.t\n(\n  id INT64\n  name STRING\n)",
+			line:      3,
+		},
+		{
+			statement: "ALTER TABLE mydataset.t ADD COLUMN country STRING;\nALTER TABLE mydataset.t DROP COLUMN;\nDROP TABLE mydataset.t_tmp;",
+			line:      1,
+		},
+	}
+	for _, test := range tests {
+		_, err := ParseBigQuerySQL(test.statement)
+		syntaxErr, ok := err.(*base.SyntaxError)
+		require.True(t, ok, test.statement)
+		require.Equal(t, test.line, syntaxErr.Position.Line, test.statement)
+	}
+}
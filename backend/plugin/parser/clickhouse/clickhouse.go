@@ -0,0 +1,238 @@
+// Package clickhouse provides a lightweight lexical parser for ClickHouse SQL.
+package clickhouse
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+// TokenType is the type of the token.
+type TokenType int
+
+const (
+	// TokenIdentifier is an unquoted identifier or keyword.
+	TokenIdentifier TokenType = iota
+	// TokenQuotedIdentifier is an identifier quoted by backticks or double quotes.
+	TokenQuotedIdentifier
+	// TokenString is a single-quoted string literal.
+	TokenString
+	// TokenNumber is a numeric literal.
+	TokenNumber
+	// TokenOperator is an operator or punctuation.
+	TokenOperator
+)
+
+// Token is a lexical token of the statement.
+type Token struct {
+	Type TokenType
+	Text string
+	// Line is the one-based line of the first byte of the token in the original statements.
+	Line int
+}
+
+// IsKeyword returns true if the token is the unquoted keyword, case-insensitively.
+func (t *Token) IsKeyword(keyword string) bool {
+	return t.Type == TokenIdentifier && strings.EqualFold(t.Text, keyword)
+}
+
+// IsOperator returns true if the token is the operator.
+func (t *Token) IsOperator(operator string) bool {
+	return t.Type == TokenOperator && t.Text == operator
+}
+
+// Identifier returns the unquoted identifier of the token.
+func (t *Token) Identifier() string {
+	if t.Type != TokenQuotedIdentifier || len(t.Text) < 2 {
+		return t.Text
+	}
+	quote := t.Text[:1]
+	return strings.ReplaceAll(t.Text[1:len(t.Text)-1], quote+quote, quote)
+}
+
+// Statement is a single statement.
+type Statement struct {
+	// Text is the text of the statement without the trailing semicolon.
+	Text string
+	// Tokens are the tokens of the statement, comments and whitespaces are excluded.
+	Tokens []*Token
+}
+
+// ParseClickHouse tokenizes the statements and splits them by semicolons.
+// Empty statements are omitted.
+func ParseClickHouse(statement string) ([]*Statement, error) {
+	l := &lexer{input: statement, line: 1}
+	var result []*Statement
+	var tokens []*Token
+	start := 0
+	for {
+		token, offset, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil || token.IsOperator(";") {
+			if len(tokens) > 0 {
+				result = append(result, &Statement{
+					Text:   strings.TrimRightFunc(statement[start:offset], unicode.IsSpace),
+					Tokens: tokens,
+				})
+			}
+			if token == nil {
+				return result, nil
+			}
+			tokens = nil
+			continue
+		}
+		if len(tokens) == 0 {
+			start = offset
+		}
+		tokens = append(tokens, token)
+	}
+}
+
+// multiCharOperators are the operators consisting of more than one character.
+var multiCharOperators = []string{"::", "->", "<=", ">=", "!=", "<>", "==", "||"}
+
+type lexer struct {
+	input string
+	pos   int
+	// line is the one-based line of pos.
+	line int
+}
+
+// next returns the next token and its byte offset, or nil at the end of the input.
+func (l *lexer) next() (*Token, int, error) {
+	if err := l.skipSpacesAndComments(); err != nil {
+		return nil, l.pos, err
+	}
+	if l.pos >= len(l.input) {
+		return nil, l.pos, nil
+	}
+
+	start, line := l.pos, l.line
+	token := &Token{Line: line}
+	c := l.input[l.pos]
+	switch {
+	case c == '`' || c == '"':
+		token.Type = TokenQuotedIdentifier
+		if err := l.skipQuoted(c); err != nil {
+			return nil, start, err
+		}
+	case c == '\'':
+		token.Type = TokenString
+		if err := l.skipQuoted(c); err != nil {
+			return nil, start, err
+		}
+	case isIdentifierStart(c):
+		token.Type = TokenIdentifier
+		for l.pos < len(l.input) && isIdentifierPart(l.input[l.pos]) {
+			l.pos++
+		}
+	case isDigit(c) || (c == '.' && l.pos+1 < len(l.input) && isDigit(l.input[l.pos+1])):
+		token.Type = TokenNumber
+		for l.pos < len(l.input) {
+			ch := l.input[l.pos]
+			if isIdentifierPart(ch) || ch == '.' {
+				l.pos++
+				continue
+			}
+			// The sign of the exponent, such as 1e-5.
+			if (ch == '+' || ch == '-') && (l.input[l.pos-1] == 'e' || l.input[l.pos-1] == 'E') && !strings.HasPrefix(strings.ToLower(l.input[start:l.pos]), "0x") {
+				l.pos++
+				continue
+			}
+			break
+		}
+	default:
+		token.Type = TokenOperator
+		l.pos++
+		for _, operator := range multiCharOperators {
+			if strings.HasPrefix(l.input[start:], operator) {
+				l.pos = start + len(operator)
+				break
+			}
+		}
+	}
+	token.Text = l.input[start:l.pos]
+	return token, start, nil
+}
+
+func (l *lexer) skipSpacesAndComments() error {
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == '\n':
+			l.line++
+			l.pos++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			l.pos++
+		case strings.HasPrefix(l.input[l.pos:], "--") || c == '#':
+			for l.pos < len(l.input) && l.input[l.pos] != '\n' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.input[l.pos:], "/*"):
+			line := l.line
+			end := strings.Index(l.input[l.pos+2:], "*/")
+			if end < 0 {
+				return l.syntaxError(line, "unterminated comment")
+			}
+			end += l.pos + 4
+			l.line += strings.Count(l.input[l.pos:end], "\n")
+			l.pos = end
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// skipQuoted skips the quoted string or identifier starting at pos.
+// The quote is escaped by backslash or doubling it.
+func (l *lexer) skipQuoted(quote byte) error {
+	line := l.line
+	l.pos++
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == '\\':
+			l.pos++
+			if l.pos < len(l.input) && l.input[l.pos] == '\n' {
+				l.line++
+			}
+		case c == quote:
+			if l.pos+1 < len(l.input) && l.input[l.pos+1] == quote {
+				l.pos++
+				break
+			}
+			l.pos++
+			return nil
+		case c == '\n':
+			l.line++
+		default:
+		}
+		l.pos++
+	}
+	return l.syntaxError(line, fmt.Sprintf("unterminated quoted text starting with %c", quote))
+}
+
+func (*lexer) syntaxError(line int, message string) *base.SyntaxError {
+	return &base.SyntaxError{
+		Position: &storepb.Position{Line: int32(line - 1)},
+		Message:  fmt.Sprintf("Syntax error at line %d: %s", line, message),
+	}
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '$'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,154 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestParseClickHouse(t *testing.T) {
+	type token struct {
+		tp   TokenType
+		text string
+		line int
+	}
+	tests := []struct {
+		statement string
+		texts     []string
+		tokens    [][]token
+	}{
+		{
+			statement: "SELECT 1",
+			texts:     []string{"SELECT 1"},
+			tokens: [][]token{
+				{{TokenIdentifier, "SELECT", 1}, {TokenNumber, "1", 1}},
+			},
+		},
+		{
+			statement: `-- comment
+/* multi-line
+comment */ ALTER TABLE ` + "`my``table`" + ` DELETE WHERE a != 'x;''y';;
+# another comment
+SELECT "b", 1e-5, 0x1F::UInt8 FROM t`,
+			texts: []string{
+				"ALTER TABLE `my``table` DELETE WHERE a != 'x;''y'",
+				`SELECT "b", 1e-5, 0x1F::UInt8 FROM t`,
+			},
+			tokens: [][]token{
+				{
+					{TokenIdentifier, "ALTER", 3},
+					{TokenIdentifier, "TABLE", 3},
+					{TokenQuotedIdentifier, "`my``table`", 3},
+					{TokenIdentifier, "DELETE", 3},
+					{TokenIdentifier, "WHERE", 3},
+					{TokenIdentifier, "a", 3},
+					{TokenOperator, "!=", 3},
+					{TokenString, "'x;''y'", 3},
+				},
+				{
+					{TokenIdentifier, "SELECT", 5},
+					{TokenQuotedIdentifier, `"b"`, 5},
+					{TokenOperator, ",", 5},
+					{TokenNumber, "1e-5", 5},
+					{TokenOperator, ",", 5},
+					{TokenNumber, "0x1F", 5},
+					{TokenOperator, "::", 5},
+					{TokenIdentifier, "UInt8", 5},
+					{TokenIdentifier, "FROM", 5},
+					{TokenIdentifier, "t", 5},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		statements, err := ParseClickHouse(test.statement)
+		require.NoError(t, err)
+		require.Len(t, statements, len(test.texts))
+		for i, statement := range statements {
+			require.Equal(t, test.texts[i], statement.Text)
+			var tokens []token
+			for _, tk := range statement.Tokens {
+				tokens = append(tokens, token{tk.Type, tk.Text, tk.Line})
+			}
+			require.Equal(t, test.tokens[i], tokens)
+		}
+	}
+
+	statements, err := ParseClickHouse("CREATE TABLE `a`.`b``c` (id UInt64)")
+	require.NoError(t, err)
+	require.Equal(t, "b`c", statements[0].Tokens[4].Identifier())
+
+	_, err = ParseClickHouse("SELECT 1;\nSELECT 'abc")
+	require.EqualError(t, err, "Syntax error at line 2: unterminated quoted text starting with '")
+}
+
+func TestParseClickHouseDDL(t *testing.T) {
+	statement := `CREATE TABLE IF NOT EXISTS default.events ON CLUSTER '{cluster}'
+(
+    ` + "`event_date`" + ` Date DEFAULT toDate(event_time),
+    ` + "`event_time`" + ` DateTime64(3, 'UTC') CODEC(Delta, ZSTD(1)),
+    ` + "`user_id`" + ` UInt64,
+    ` + "`tags`" + ` Array(LowCardinality(String)),
+    ` + "`props`" + ` Map(String, String) COMMENT 'event\'s properties; key -> value',
+    INDEX idx_user user_id TYPE bloom_filter(0.01) GRANULARITY 4
+)
+ENGINE = ReplicatedMergeTree('/clickhouse/tables/{shard}/events', '{replica}')
+PARTITION BY toYYYYMM(event_date)
+ORDER BY (user_id, event_time)
+TTL event_date + INTERVAL 90 DAY
+SETTINGS index_granularity = 8192;
+ALTER TABLE default.events ON CLUSTER '{cluster}' ADD COLUMN IF NOT EXISTS ` + "`session_id`" + ` String AFTER user_id;
+CREATE MATERIALIZED VIEW default.events_daily_mv TO default.events_daily AS
+SELECT event_date, count() AS c FROM default.events GROUP BY event_date;
+ALTER TABLE default.events DELETE WHERE event_date < '2020-01-01';
+OPTIMIZE TABLE default.events FINAL;
+DROP TABLE IF EXISTS default.events_tmp SYNC;`
+	statements, err := ParseClickHouse(statement)
+	require.NoError(t, err)
+	var firstLines []int
+	for _, statement := range statements {
+		firstLines = append(firstLines, statement.Tokens[0].Line)
+	}
+	require.Equal(t, []int{1, 15, 16, 18, 19, 20}, firstLines)
+	// The escaped quote and the semicolon in the comment don't split the statement.
+	var comment string
+	for i, token := range statements[0].Tokens {
+		if token.IsKeyword("COMMENT") {
+			comment = statements[0].Tokens[i+1].Text
+		}
+	}
+	require.Equal(t, "'event\\'s properties; key -> value'", comment)
+
+	// The parse failures are reported as syntax errors at the line of the unterminated text.
+	tests := []struct {
+		statement string
+		line      int32
+		message   string
+	}{
+		{
+			statement: "CREATE TABLE t\n(\n    `id UInt64\n)\nENGINE = Memory",
+			line:      2,
+			message:   "Syntax error at line 3: unterminated quoted text starting with `",
+		},
+		{
+			statement: "CREATE TABLE t (id UInt64) ENGINE = Memory;\n/* comment\nDROP TABLE t;",
+			line:      1,
+			message:   "Syntax error at line 2: unterminated comment",
+		},
+		{
+			statement: "ALTER TABLE t\nUPDATE name = 'it\\'s' WHERE id = 1;\nALTER TABLE t DELETE WHERE name = 'x",
+			line:      2,
+			message:   "Syntax error at line 3: unterminated quoted text starting with '",
+		},
+	}
+	for _, test := range tests {
+		_, err := ParseClickHouse(test.statement)
+		syntaxErr, ok := err.(*base.SyntaxError)
+		require.True(t, ok, test.statement)
+		require.Equal(t, test.line, syntaxErr.Position.Line, test.statement)
+		require.Equal(t, test.message, syntaxErr.Message, test.statement)
+	}
+}
@@ -0,0 +1,312 @@
+package doris
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/doris-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func init() {
+	base.RegisterTransformDMLToSelect(storepb.Engine_STARROCKS, TransformDMLToSelect)
+	base.RegisterTransformDMLToSelect(storepb.Engine_DORIS, TransformDMLToSelect)
+}
+
+const (
+	maxTableNameLength = 64
+)
+
+// TransformDMLToSelect transforms the UPDATE and DELETE statements to the statements backing up the changed rows.
+func TransformDMLToSelect(ctx context.Context, tCtx base.TransformContext, statement string, sourceDatabase string, targetDatabase string, tablePrefix string) ([]base.BackupStatement, error) {
+	statementInfoList, err := prepareTransformation(sourceDatabase, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare transformation")
+	}
+
+	return generateSQL(ctx, tCtx, statementInfoList, targetDatabase, tablePrefix)
+}
+
+type statementInfo struct {
+	table         *TableReference
+	tree          antlr.ParserRuleContext
+	startPosition *storepb.Position
+	endPosition   *storepb.Position
+}
+
+func prepareTransformation(databaseName, statement string) ([]statementInfo, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to split sql")
+	}
+
+	var result []statementInfo
+	for _, item := range list {
+		if len(item.Text) == 0 || item.Empty {
+			continue
+		}
+		parseResult, err := ParseDorisSQL(item.Text)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse sql")
+		}
+		for _, dml := range extractDMLStatements(databaseName, parseResult.Tree) {
+			if dml.table.Database != databaseName {
+				return nil, errors.Errorf("database is not matched: %s != %s", dml.table.Database, databaseName)
+			}
+			result = append(result, statementInfo{
+				table:         dml.table,
+				tree:          dml.tree,
+				startPosition: item.Start,
+				endPosition:   item.End,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+func generateSQL(ctx context.Context, tCtx base.TransformContext, statementInfoList []statementInfo, databaseName string, tablePrefix string) ([]base.BackupStatement, error) {
+	groupByTable := make(map[string][]statementInfo)
+	for _, item := range statementInfoList {
+		key := fmt.Sprintf("%s.%s", item.table.Database, item.table.Table)
+		groupByTable[key] = append(groupByTable[key], item)
+	}
+
+	// Check if the statement type is the same for all statements in one table.
+	for key, list := range groupByTable {
+		stmtType := StatementTypeUnknown
+		for _, item := range list {
+			if stmtType == StatementTypeUnknown {
+				stmtType = item.table.StatementType
+			}
+			if stmtType != item.table.StatementType {
+				return nil, errors.Errorf("prior backup cannot handle mixed DML statements on the same table %s", key)
+			}
+		}
+	}
+
+	var result []base.BackupStatement
+	for key, list := range groupByTable {
+		backupStatement, err := generateSQLForTable(ctx, tCtx, list, databaseName, tablePrefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate SQL for table %s", key)
+		}
+		result = append(result, *backupStatement)
+	}
+
+	slices.SortFunc(result, func(i, j base.BackupStatement) int {
+		if i.StartPosition.Line != j.StartPosition.Line {
+			if i.StartPosition.Line < j.StartPosition.Line {
+				return -1
+			}
+			return 1
+		}
+		if i.StartPosition.Column != j.StartPosition.Column {
+			if i.StartPosition.Column < j.StartPosition.Column {
+				return -1
+			}
+			return 1
+		}
+		if i.SourceTableName < j.SourceTableName {
+			return -1
+		}
+		if i.SourceTableName > j.SourceTableName {
+			return 1
+		}
+		return 0
+	})
+
+	return result, nil
+}
+
+func generateSQLForTable(ctx context.Context, tCtx base.TransformContext, statementInfoList []statementInfo, databaseName string, tablePrefix string) (*base.BackupStatement, error) {
+	table := statementInfoList[0].table
+
+	generatedColumns, normalColumns, err := classifyColumns(ctx, tCtx.GetDatabaseMetadataFunc, tCtx.ListDatabaseNamesFunc, tCtx.IsCaseSensitive, tCtx.InstanceID, table)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to classify columns")
+	}
+
+	targetTable := fmt.Sprintf("%s_%s_%s", tablePrefix, table.Table, table.Database)
+	targetTable, _ = common.TruncateString(targetTable, maxTableNameLength)
+	var buf strings.Builder
+	if _, err := fmt.Fprintf(&buf, "CREATE TABLE `%s`.`%s` LIKE `%s`.`%s`;\n", databaseName, targetTable, table.Database, table.Table); err != nil {
+		return nil, errors.Wrap(err, "failed to write create table statement")
+	}
+
+	if _, err := fmt.Fprintf(&buf, "INSERT INTO `%s`.`%s`", databaseName, targetTable); err != nil {
+		return nil, errors.Wrap(err, "failed to write insert into statement")
+	}
+	if len(generatedColumns) > 0 {
+		if _, err := fmt.Fprintf(&buf, " (%s)", quoteColumns(normalColumns, "")); err != nil {
+			return nil, errors.Wrap(err, "failed to write insert into statement")
+		}
+	}
+	for i, item := range statementInfoList {
+		if i != 0 {
+			// We assume that the source table has a primary key.
+			// If we have multiple statements, we need to use UNION DISTINCT to avoid duplicate rows.
+			if _, err := buf.WriteString("\n  UNION DISTINCT\n"); err != nil {
+				return nil, errors.Wrap(err, "failed to write union distinct statement")
+			}
+		}
+		if _, err := buf.WriteString("  "); err != nil {
+			return nil, errors.Wrap(err, "failed to write space")
+		}
+		if cteString := extractCTE(item.tree); len(cteString) > 0 {
+			if _, err := fmt.Fprintf(&buf, "%s ", cteString); err != nil {
+				return nil, errors.Wrap(err, "failed to write cte")
+			}
+		}
+		if len(generatedColumns) == 0 {
+			if _, err := fmt.Fprintf(&buf, "SELECT `%s`.* FROM ", item.table.Table); err != nil {
+				return nil, errors.Wrap(err, "failed to write select statement")
+			}
+		} else {
+			if _, err := fmt.Fprintf(&buf, "SELECT %s FROM ", quoteColumns(normalColumns, item.table.Table)); err != nil {
+				return nil, errors.Wrap(err, "failed to write select statement")
+			}
+		}
+		suffix, err := extractSuffixSelectStatement(item.tree)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to extract suffix select statement")
+		}
+		if _, err := buf.WriteString(suffix); err != nil {
+			return nil, errors.Wrap(err, "failed to write suffix select statement")
+		}
+	}
+
+	if err := buf.WriteByte(';'); err != nil {
+		return nil, errors.Wrap(err, "failed to write semicolon")
+	}
+
+	return &base.BackupStatement{
+		Statement:       buf.String(),
+		SourceTableName: table.Table,
+		TargetTableName: targetTable,
+		StartPosition:   statementInfoList[0].startPosition,
+		EndPosition:     statementInfoList[len(statementInfoList)-1].endPosition,
+	}, nil
+}
+
+// quoteColumns returns the comma separated quoted columns, qualified by the table if it's not empty.
+func quoteColumns(columns []string, table string) string {
+	var quotedColumns []string
+	for _, column := range columns {
+		if table == "" {
+			quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+		} else {
+			quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`.`%s`", table, column))
+		}
+	}
+	return strings.Join(quotedColumns, ",")
+}
+
+func extractCTE(ctx antlr.ParserRuleContext) string {
+	switch node := ctx.(type) {
+	case *parser.UpdateStatementContext:
+		if node.WithClause() != nil {
+			return node.GetParser().GetTokenStream().GetTextFromRuleContext(node.WithClause())
+		}
+	case *parser.DeleteStatementContext:
+		if node.WithClause() != nil {
+			return node.GetParser().GetTokenStream().GetTextFromRuleContext(node.WithClause())
+		}
+	}
+
+	return ""
+}
+
+// extractSuffixSelectStatement returns the FROM and WHERE clauses selecting the rows changed by the statement.
+// The tables in UPDATE ... FROM and DELETE ... USING are joined with the changed table.
+func extractSuffixSelectStatement(ctx antlr.ParserRuleContext) (string, error) {
+	var parts []string
+	switch node := ctx.(type) {
+	case *parser.UpdateStatementContext:
+		stream := node.GetParser().GetTokenStream()
+		parts = append(parts, stream.GetTextFromRuleContext(node.QualifiedName()))
+		if from, ok := node.FromClause().(*parser.FromContext); ok && from.Relations() != nil {
+			parts = append(parts, ", "+stream.GetTextFromRuleContext(from.Relations()))
+		}
+		if node.GetWhere() != nil {
+			parts = append(parts, " WHERE "+stream.GetTextFromRuleContext(node.GetWhere()))
+		}
+	case *parser.DeleteStatementContext:
+		stream := node.GetParser().GetTokenStream()
+		parts = append(parts, stream.GetTextFromRuleContext(node.QualifiedName()))
+		if node.PartitionNames() != nil {
+			parts = append(parts, " "+stream.GetTextFromRuleContext(node.PartitionNames()))
+		}
+		if node.GetUsing() != nil {
+			parts = append(parts, ", "+stream.GetTextFromRuleContext(node.GetUsing()))
+		}
+		if node.GetWhere() != nil {
+			parts = append(parts, " WHERE "+stream.GetTextFromRuleContext(node.GetWhere()))
+		}
+	default:
+		return "", errors.Errorf("unexpected statement %T", ctx)
+	}
+	return strings.Join(parts, ""), nil
+}
+
+func classifyColumns(ctx context.Context, getDatabaseMetadataFunc base.GetDatabaseMetadataFunc, listDatabaseNamesFunc base.ListDatabaseNamesFunc, isCaseSensitive bool, instanceID string, table *TableReference) ([]string, []string, error) {
+	if getDatabaseMetadataFunc == nil {
+		return nil, nil, errors.New("GetDatabaseMetadataFunc is not set")
+	}
+
+	var dbSchema *model.DatabaseMetadata
+	allDatabaseNames, err := listDatabaseNamesFunc(ctx, instanceID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list databases names")
+	}
+	for _, db := range allDatabaseNames {
+		if db == table.Database || (!isCaseSensitive && strings.EqualFold(db, table.Database)) {
+			_, dbSchema, err = getDatabaseMetadataFunc(ctx, instanceID, db)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get database metadata for database %q", db)
+			}
+			break
+		}
+	}
+	if dbSchema == nil {
+		slog.Debug("failed to get database metadata", slog.String("instanceID", instanceID), slog.String("database", table.Database))
+		return nil, nil, errors.Errorf("failed to get database metadata for InstanceID %q, Database %q", instanceID, table.Database)
+	}
+
+	schema := dbSchema.GetSchema("")
+	if schema == nil {
+		return nil, nil, errors.New("failed to get schema metadata")
+	}
+
+	var tableSchema *model.TableMetadata
+	if !isCaseSensitive {
+		for _, tableName := range schema.ListTableNames() {
+			if strings.EqualFold(tableName, table.Table) {
+				tableSchema = schema.GetTable(tableName)
+				break
+			}
+		}
+	} else {
+		tableSchema = schema.GetTable(table.Table)
+	}
+
+	var generatedColumns, normalColumns []string
+	for _, column := range tableSchema.GetColumns() {
+		if column.GetGeneration() != nil {
+			generatedColumns = append(generatedColumns, column.GetName())
+		} else {
+			normalColumns = append(normalColumns, column.GetName())
+		}
+	}
+
+	return generatedColumns, normalColumns, nil
+}
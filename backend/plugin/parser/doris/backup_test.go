@@ -0,0 +1,138 @@
+package doris
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestBackup(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []base.BackupStatement
+	}{
+		{
+			input: "DELETE FROM t1 WHERE a = 1;",
+			want: []base.BackupStatement{
+				{
+					Statement:       "CREATE TABLE `backupDB`.`_rollback_t1_db` LIKE `db`.`t1`;\nINSERT INTO `backupDB`.`_rollback_t1_db`  SELECT `t1`.* FROM t1 WHERE a = 1;",
+					SourceTableName: "t1",
+					TargetTableName: "_rollback_t1_db",
+					StartPosition:   &storepb.Position{Line: 0, Column: 0},
+					EndPosition:     &storepb.Position{Line: 0, Column: 26},
+				},
+			},
+		},
+		{
+			input: "UPDATE t_generated SET b = 1 WHERE a = 1;\nUPDATE t_generated SET b = 2 WHERE a = 2;",
+			want: []base.BackupStatement{
+				{
+					Statement:       "CREATE TABLE `backupDB`.`_rollback_t_generated_db` LIKE `db`.`t_generated`;\nINSERT INTO `backupDB`.`_rollback_t_generated_db` (`a`,`b`)  SELECT `t_generated`.`a`,`t_generated`.`b` FROM t_generated WHERE a = 1\n  UNION DISTINCT\n  SELECT `t_generated`.`a`,`t_generated`.`b` FROM t_generated WHERE a = 2;",
+					SourceTableName: "t_generated",
+					TargetTableName: "_rollback_t_generated_db",
+					StartPosition:   &storepb.Position{Line: 0, Column: 0},
+					EndPosition:     &storepb.Position{Line: 1, Column: 40},
+				},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		getter, lister := buildFixedMockDatabaseMetadataGetterAndLister()
+		result, err := TransformDMLToSelect(context.Background(), base.TransformContext{
+			GetDatabaseMetadataFunc: getter,
+			ListDatabaseNamesFunc:   lister,
+			IsCaseSensitive:         false,
+		}, tc.input, "db", "backupDB", "_rollback")
+		a.NoError(err, tc.input)
+		a.Equal(tc.want, result, tc.input)
+	}
+}
+
+// TestCheckAndBackupShareParser runs the statements not supported by the MySQL parser
+// through both the prior backup check and the backup transformation.
+func TestCheckAndBackupShareParser(t *testing.T) {
+	tests := []struct {
+		input     string
+		tables    []*TableReference
+		statement string
+	}{
+		{
+			input: "UPDATE t1 SET b = 1 FROM t2 WHERE t1.a = t2.a;",
+			tables: []*TableReference{
+				{Database: "db", Table: "t1", StatementType: StatementTypeUpdate},
+			},
+			statement: "CREATE TABLE `backupDB`.`_rollback_t1_db` LIKE `db`.`t1`;\nINSERT INTO `backupDB`.`_rollback_t1_db`  SELECT `t1`.* FROM t1, t2 WHERE t1.a = t2.a;",
+		},
+		{
+			input: "DELETE FROM t1 PARTITION (p1) USING t2 WHERE t1.a = t2.a;",
+			tables: []*TableReference{
+				{Database: "db", Table: "t1", StatementType: StatementTypeDelete},
+			},
+			statement: "CREATE TABLE `backupDB`.`_rollback_t1_db` LIKE `db`.`t1`;\nINSERT INTO `backupDB`.`_rollback_t1_db`  SELECT `t1`.* FROM t1 PARTITION (p1), t2 WHERE t1.a = t2.a;",
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		// The check.
+		parseResult, err := ParseDorisSQL(tc.input)
+		a.NoError(err, tc.input)
+		a.Equal(tc.tables, ExtractDMLTables("db", parseResult.Tree), tc.input)
+
+		// The transformation.
+		getter, lister := buildFixedMockDatabaseMetadataGetterAndLister()
+		result, err := TransformDMLToSelect(context.Background(), base.TransformContext{
+			GetDatabaseMetadataFunc: getter,
+			ListDatabaseNamesFunc:   lister,
+			IsCaseSensitive:         false,
+		}, tc.input, "db", "backupDB", "_rollback")
+		a.NoError(err, tc.input)
+		a.Len(result, 1, tc.input)
+		a.Equal(tc.statement, result[0].Statement, tc.input)
+	}
+}
+
+func buildFixedMockDatabaseMetadataGetterAndLister() (base.GetDatabaseMetadataFunc, base.ListDatabaseNamesFunc) {
+	columns := []*storepb.ColumnMetadata{
+		{Name: "a"},
+		{Name: "b"},
+		{Name: "c"},
+	}
+	schemaMetadata := []*storepb.SchemaMetadata{
+		{
+			Name: "",
+			Tables: []*storepb.TableMetadata{
+				{
+					Name: "t_generated",
+					Columns: []*storepb.ColumnMetadata{
+						{Name: "a"},
+						{Name: "b"},
+						{
+							Name: "c_generated",
+							Generation: &storepb.GenerationMetadata{
+								Expression: "a + b",
+							},
+						},
+					},
+				},
+				{Name: "t1", Columns: columns},
+				{Name: "t2", Columns: columns},
+			},
+		},
+	}
+	return func(_ context.Context, _ string, database string) (string, *model.DatabaseMetadata, error) {
+			return database, model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+				Name:    database,
+				Schemas: schemaMetadata,
+			}, true /* isObjectCaseSensitive */, true /* isDetailCaseSensitive */), nil
+		}, func(_ context.Context, _ string) ([]string, error) {
+			return []string{"db"}, nil
+		}
+}
@@ -0,0 +1,106 @@
+package doris
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/doris-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+// StatementType is the type of the DML statement changing a table.
+type StatementType int
+
+const (
+	StatementTypeUnknown StatementType = iota
+	StatementTypeUpdate
+	StatementTypeDelete
+)
+
+// TableReference is the table changed by a DML statement.
+type TableReference struct {
+	Database      string
+	Table         string
+	StatementType StatementType
+}
+
+// ExtractDMLTables extracts the tables changed by the UPDATE and DELETE statements in the tree.
+// The unqualified tables are normalized with the database name.
+func ExtractDMLTables(databaseName string, tree antlr.Tree) []*TableReference {
+	var tables []*TableReference
+	for _, statement := range extractDMLStatements(databaseName, tree) {
+		tables = append(tables, statement.table)
+	}
+	return tables
+}
+
+// GetFirstDDLLine returns the one-based line of the first DDL statement in the tree, zero if there is no DDL.
+func GetFirstDDLLine(tree antlr.Tree) int {
+	checker := &ddlChecker{}
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree)
+	return checker.line
+}
+
+// dmlStatement is the UPDATE or DELETE statement and the table changed by it.
+type dmlStatement struct {
+	table *TableReference
+	tree  antlr.ParserRuleContext
+}
+
+// extractDMLStatements extracts the UPDATE and DELETE statements in the tree.
+// The prior backup check and the backup transformation share it, so they agree on the changed tables.
+func extractDMLStatements(databaseName string, tree antlr.Tree) []*dmlStatement {
+	extractor := &dmlExtractor{
+		databaseName: databaseName,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(extractor, tree)
+	return extractor.statements
+}
+
+type dmlExtractor struct {
+	*parser.BaseDorisSQLListener
+
+	databaseName string
+	statements   []*dmlStatement
+}
+
+func (e *dmlExtractor) EnterUpdateStatement(ctx *parser.UpdateStatementContext) {
+	e.appendStatement(ctx, ctx.QualifiedName(), StatementTypeUpdate)
+}
+
+func (e *dmlExtractor) EnterDeleteStatement(ctx *parser.DeleteStatementContext) {
+	e.appendStatement(ctx, ctx.QualifiedName(), StatementTypeDelete)
+}
+
+func (e *dmlExtractor) appendStatement(tree antlr.ParserRuleContext, ctx parser.IQualifiedNameContext, statementType StatementType) {
+	names := NormalizeQualifiedName(ctx)
+	if len(names) == 0 {
+		return
+	}
+	table := &TableReference{
+		Database:      e.databaseName,
+		Table:         names[len(names)-1],
+		StatementType: statementType,
+	}
+	if len(names) > 1 {
+		table.Database = names[len(names)-2]
+	}
+	e.statements = append(e.statements, &dmlStatement{
+		table: table,
+		tree:  tree,
+	})
+}
+
+type ddlChecker struct {
+	*parser.BaseDorisSQLListener
+
+	line int
+}
+
+func (c *ddlChecker) EnterSingleStatement(ctx *parser.SingleStatementContext) {
+	if c.line != 0 || ctx.Statement() == nil {
+		return
+	}
+	if getStatementQueryType(ctx.Statement()) == base.DDL {
+		c.line = ctx.GetStart().GetLine()
+	}
+}
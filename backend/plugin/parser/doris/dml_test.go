@@ -0,0 +1,21 @@
+package doris
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractDMLTables(t *testing.T) {
+	res, err := ParseDorisSQL("UPDATE t1 SET c1 = 1 WHERE id = 1;\nDELETE FROM `db2`.t1 WHERE id = 1;\nINSERT INTO t2 VALUES (1);")
+	require.NoError(t, err)
+	require.Equal(t, []*TableReference{
+		{Database: "db1", Table: "t1", StatementType: StatementTypeUpdate},
+		{Database: "db2", Table: "t1", StatementType: StatementTypeDelete},
+	}, ExtractDMLTables("db1", res.Tree))
+	require.Zero(t, GetFirstDDLLine(res.Tree))
+
+	res, err = ParseDorisSQL("UPDATE t1 SET c1 = 1 WHERE id = 1;\nSELECT * FROM t1;\nCREATE TABLE t2 (id INT) DISTRIBUTED BY HASH(id);")
+	require.NoError(t, err)
+	require.Equal(t, 3, GetFirstDDLLine(res.Tree))
+}
@@ -21,12 +21,15 @@ func (l *queryTypeListener) EnterSingleStatement(ctx *parser.SingleStatementCont
 	if s == nil {
 		return
 	}
+	l.result = getStatementQueryType(s)
+}
 
+func getStatementQueryType(s parser.IStatementContext) base.QueryType {
 	switch {
 	case s.QueryStatement() != nil:
-		l.result = base.Select
+		return base.Select
 	case s.InsertStatement() != nil, s.UpdateStatement() != nil, s.DeleteStatement() != nil:
-		l.result = base.DML
+		return base.DML
 	case s.ShowAlterStatement() != nil,
 		s.ShowAnalyzeStatement() != nil,
 		s.ShowAuthenticationStatement() != nil,
@@ -49,8 +52,8 @@ func (l *queryTypeListener) EnterSingleStatement(ctx *parser.SingleStatementCont
 		s.ShowTableStatusStatement() != nil,
 		s.ShowCreateDbStatement() != nil,
 		s.ShowCreateTableStatement() != nil:
-		l.result = base.SelectInfoSchema
+		return base.SelectInfoSchema
 	default:
-		l.result = base.DDL
+		return base.DDL
 	}
 }
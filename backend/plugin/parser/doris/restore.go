@@ -0,0 +1,140 @@
+package doris
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	maxCommentLength = 1000
+)
+
+func init() {
+	base.RegisterGenerateRestoreSQL(storepb.Engine_STARROCKS, GenerateRestoreSQL)
+	base.RegisterGenerateRestoreSQL(storepb.Engine_DORIS, GenerateRestoreSQL)
+}
+
+// GenerateRestoreSQL generates the restore SQL for StarRocks and Doris.
+// They don't support ON DUPLICATE KEY UPDATE, but UPDATE and DELETE are only allowed on the primary key
+// and unique key tables, on which INSERT replaces the rows with the same key.
+// So both the updated and the deleted rows are restored by inserting the backup rows.
+func GenerateRestoreSQL(ctx context.Context, rCtx base.RestoreContext, statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	originalSQL, err := extractSingleSQL(statement, backupItem)
+	if err != nil {
+		return "", errors.Errorf("failed to extract single SQL: %v", err)
+	}
+	if len(originalSQL) == 0 {
+		return "", errors.Errorf("no statement changes the table %s.%s", backupItem.SourceTable.Database, backupItem.SourceTable.Table)
+	}
+
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+	_, targetDatabase, err := common.GetInstanceDatabaseID(backupItem.TargetTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get target database ID for %s", backupItem.TargetTable.Database)
+	}
+	generatedColumns, normalColumns, err := classifyColumns(ctx, rCtx.GetDatabaseMetadataFunc, rCtx.ListDatabaseNamesFunc, rCtx.IsCaseSensitive, rCtx.InstanceID, &TableReference{
+		Database: sourceDatabase,
+		Table:    backupItem.SourceTable.Table,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to classify columns for %s.%s", backupItem.SourceTable.Database, backupItem.SourceTable.Table)
+	}
+
+	var restoreSQL string
+	if len(generatedColumns) == 0 {
+		restoreSQL = fmt.Sprintf("INSERT INTO `%s`.`%s` SELECT * FROM `%s`.`%s`;", sourceDatabase, backupItem.SourceTable.Table, targetDatabase, backupItem.TargetTable.Table)
+	} else {
+		var quotedColumns []string
+		for _, column := range normalColumns {
+			quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+		}
+		quotedColumnList := strings.Join(quotedColumns, ", ")
+		restoreSQL = fmt.Sprintf("INSERT INTO `%s`.`%s` (%s) SELECT %s FROM `%s`.`%s`;", sourceDatabase, backupItem.SourceTable.Table, quotedColumnList, quotedColumnList, targetDatabase, backupItem.TargetTable.Table)
+	}
+
+	sqlForComment, truncated := common.TruncateString(originalSQL, maxCommentLength)
+	if truncated {
+		sqlForComment += "..."
+	}
+	return fmt.Sprintf("/*\nOriginal SQL:\n%s\n*/\n%s", sqlForComment, restoreSQL), nil
+}
+
+// extractSingleSQL returns the statements in the range of the backup item changing the source table.
+func extractSingleSQL(statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	if backupItem == nil {
+		return "", errors.Errorf("backup item is nil")
+	}
+
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to split sql")
+	}
+
+	start := 0
+	end := len(list) - 1
+	for i, item := range list {
+		if equalOrLess(item.Start, backupItem.StartPosition) {
+			start = i
+		}
+	}
+
+	for i := len(list) - 1; i >= 0; i-- {
+		if equalOrGreater(list[i].Start, backupItem.EndPosition) {
+			end = i
+		}
+	}
+
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+
+	var result []string
+	// We only need statements that change the source table.
+	for i := start; i <= end; i++ {
+		if list[i].Empty {
+			continue
+		}
+		parseResult, err := ParseDorisSQL(list[i].Text)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse sql")
+		}
+		for _, table := range ExtractDMLTables(sourceDatabase, parseResult.Tree) {
+			if table.Database == sourceDatabase && table.Table == backupItem.SourceTable.Table {
+				result = append(result, list[i].Text)
+				break
+			}
+		}
+	}
+	return strings.Join(result, ""), nil
+}
+
+func equalOrLess(a, b *storepb.Position) bool {
+	if a.Line < b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column <= b.Column {
+		return true
+	}
+	return false
+}
+
+func equalOrGreater(a, b *storepb.Position) bool {
+	if a.Line > b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column >= b.Column {
+		return true
+	}
+	return false
+}
@@ -43,6 +43,12 @@ func (p *Parser) NewRestoreContext() *ast.RestoreContext {
 	}
 }
 
+// InputPos returns the one-based line and column of the current position in the mapper xml,
+// which is the position of the error if Parse fails.
+func (p *Parser) InputPos() (int, int) {
+	return p.d.InputPos()
+}
+
 // Parse parses the mybatis mapper xml statements, building AST without recursion, returns the root node of the AST.
 func (p *Parser) Parse() (*ast.RootNode, error) {
 	root := &ast.RootNode{}
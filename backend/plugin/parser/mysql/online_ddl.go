@@ -0,0 +1,557 @@
+package mysql
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/blang/semver/v4"
+	parser "github.com/bytebase/mysql-parser"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// OnlineDDLAlgorithm is the algorithm used by InnoDB to execute ALTER TABLE.
+// The algorithms are ordered by cost, so the most expensive one of the sub-commands wins.
+type OnlineDDLAlgorithm int
+
+const (
+	// OnlineDDLAlgorithmUnknown is the unknown algorithm.
+	OnlineDDLAlgorithmUnknown OnlineDDLAlgorithm = iota
+	// OnlineDDLAlgorithmInstant only modifies the metadata in the data dictionary.
+	OnlineDDLAlgorithmInstant
+	// OnlineDDLAlgorithmInplace avoids copying the table, but may rebuild it in place.
+	OnlineDDLAlgorithmInplace
+	// OnlineDDLAlgorithmCopy copies the table row by row, and blocks concurrent DML.
+	OnlineDDLAlgorithmCopy
+)
+
+// String implements the fmt.Stringer interface.
+func (a OnlineDDLAlgorithm) String() string {
+	switch a {
+	case OnlineDDLAlgorithmInstant:
+		return "INSTANT"
+	case OnlineDDLAlgorithmInplace:
+		return "INPLACE"
+	case OnlineDDLAlgorithmCopy:
+		return "COPY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// OnlineDDLPrediction is the predicted online DDL behavior of an ALTER TABLE statement.
+type OnlineDDLPrediction struct {
+	// Line is the line of the statement, counted the same way as the ANTLR parser.
+	Line          int
+	Statement     string
+	Database      string
+	Table         string
+	Algorithm     OnlineDDLAlgorithm
+	TableRebuild  bool
+	ConcurrentDML bool
+	Reasons       []string
+}
+
+func (p *OnlineDDLPrediction) merge(algorithm OnlineDDLAlgorithm, rebuild, concurrentDML bool, reason string) {
+	p.Algorithm = max(p.Algorithm, algorithm)
+	p.TableRebuild = p.TableRebuild || rebuild
+	p.ConcurrentDML = p.ConcurrentDML && concurrentDML
+	if reason != "" && !slices.Contains(p.Reasons, reason) {
+		p.Reasons = append(p.Reasons, reason)
+	}
+}
+
+// PredictOnlineDDL predicts the algorithm, the table rebuild and the concurrent DML permission
+// of each ALTER TABLE statement for MySQL 5.7 and 8.0 InnoDB tables.
+// The metadata is the synced metadata of the current database, it could be nil.
+// See https://dev.mysql.com/doc/refman/8.0/en/innodb-online-ddl-operations.html.
+func PredictOnlineDDL(asts []*ParseResult, engineVersion string, currentDatabase string, metadata *storepb.DatabaseSchemaMetadata) []*OnlineDDLPrediction {
+	l := &onlineDDLListener{
+		version:         parseOnlineDDLVersion(engineVersion),
+		currentDatabase: currentDatabase,
+		metadata:        metadata,
+	}
+	for _, ast := range asts {
+		l.baseLine = ast.BaseLine
+		antlr.ParseTreeWalkerDefault.Walk(l, ast.Tree)
+	}
+	return l.predictions
+}
+
+var mysqlVersionRegexp = regexp.MustCompile(`^\d+\.\d+(\.\d+)?`)
+
+// parseOnlineDDLVersion parses the engine version, the unknown version is treated as 5.7
+// so that INSTANT is never predicted for it.
+func parseOnlineDDLVersion(engineVersion string) semver.Version {
+	fallback := semver.MustParse("5.7.0")
+	v, err := semver.ParseTolerant(mysqlVersionRegexp.FindString(engineVersion))
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+type onlineDDLListener struct {
+	*parser.BaseMySQLParserListener
+
+	baseLine        int
+	version         semver.Version
+	currentDatabase string
+	metadata        *storepb.DatabaseSchemaMetadata
+	predictions     []*OnlineDDLPrediction
+}
+
+func (l *onlineDDLListener) atLeast(version string) bool {
+	return l.version.GE(semver.MustParse(version))
+}
+
+// EnterAlterTable is called when production alterTable is entered.
+func (l *onlineDDLListener) EnterAlterTable(ctx *parser.AlterTableContext) {
+	if ctx.TableRef() == nil || ctx.AlterTableActions() == nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx.TableRef())
+	var text string
+	if parent, ok := ctx.GetParent().(antlr.ParserRuleContext); ok {
+		text = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(parent)
+	}
+	prediction := &OnlineDDLPrediction{
+		Line:          l.baseLine + ctx.GetStart().GetLine(),
+		Statement:     text,
+		Database:      database,
+		Table:         table,
+		ConcurrentDML: true,
+	}
+	c := &onlineDDLClassifier{
+		listener:   l,
+		prediction: prediction,
+		table:      l.findTable(database, table),
+	}
+	c.classify(ctx.AlterTableActions())
+	if prediction.Algorithm == OnlineDDLAlgorithmUnknown {
+		return
+	}
+	l.predictions = append(l.predictions, prediction)
+}
+
+func (l *onlineDDLListener) findTable(database, table string) *storepb.TableMetadata {
+	if database != "" && !strings.EqualFold(database, l.currentDatabase) {
+		return nil
+	}
+	for _, schema := range l.metadata.GetSchemas() {
+		for _, t := range schema.GetTables() {
+			if strings.EqualFold(t.Name, table) {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+type onlineDDLClassifier struct {
+	listener   *onlineDDLListener
+	prediction *OnlineDDLPrediction
+	table      *storepb.TableMetadata
+
+	dropPrimaryKey bool
+	addPrimaryKey  bool
+	modifiers      []parser.IAlterCommandsModifierContext
+}
+
+func (c *onlineDDLClassifier) classify(actions parser.IAlterTableActionsContext) {
+	if actions.AlterCommandsModifierList() != nil {
+		c.modifiers = append(c.modifiers, actions.AlterCommandsModifierList().AllAlterCommandsModifier()...)
+	}
+	if commandList := actions.AlterCommandList(); commandList != nil {
+		if commandList.AlterCommandsModifierList() != nil {
+			c.modifiers = append(c.modifiers, commandList.AlterCommandsModifierList().AllAlterCommandsModifier()...)
+		}
+		if alterList := commandList.AlterList(); alterList != nil {
+			c.modifiers = append(c.modifiers, alterList.AllAlterCommandsModifier()...)
+			for _, item := range alterList.AllAlterListItem() {
+				c.classifyAlterListItem(item)
+			}
+			for _, options := range alterList.AllCreateTableOptionsSpaceSeparated() {
+				for _, option := range options.AllCreateTableOption() {
+					c.classifyTableOption(option)
+				}
+			}
+		}
+	}
+	if actions.PartitionClause() != nil {
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "partitioning the table copies the table")
+	}
+	if actions.RemovePartitioning() != nil {
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "removing partitioning copies the table")
+	}
+	if standalone := actions.StandaloneAlterCommands(); standalone != nil {
+		c.classifyStandaloneCommand(standalone)
+	}
+	if c.dropPrimaryKey && !c.addPrimaryKey {
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "dropping the primary key without adding a new one copies the table")
+	}
+	c.applyModifiers()
+}
+
+func (c *onlineDDLClassifier) classifyAlterListItem(item parser.IAlterListItemContext) {
+	switch {
+	case item.ADD_SYMBOL() != nil && item.TableConstraintDef() != nil:
+		c.classifyAddConstraint(item.TableConstraintDef())
+	case item.ADD_SYMBOL() != nil && item.TableElementList() != nil:
+		for _, element := range item.TableElementList().AllTableElement() {
+			if element.ColumnDefinition() != nil {
+				_, _, columnName := NormalizeMySQLColumnName(element.ColumnDefinition().ColumnName())
+				c.classifyAddColumn(columnName, element.ColumnDefinition().FieldDefinition(), nil)
+			}
+			if element.TableConstraintDef() != nil {
+				c.classifyAddConstraint(element.TableConstraintDef())
+			}
+		}
+	case item.ADD_SYMBOL() != nil && item.FieldDefinition() != nil:
+		c.classifyAddColumn(NormalizeMySQLIdentifier(item.Identifier()), item.FieldDefinition(), item.Place())
+	case item.CHANGE_SYMBOL() != nil || item.MODIFY_SYMBOL() != nil:
+		if item.ColumnInternalRef() == nil || item.FieldDefinition() == nil {
+			return
+		}
+		oldName := NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())
+		newName := oldName
+		if item.Identifier() != nil {
+			newName = NormalizeMySQLIdentifier(item.Identifier())
+		}
+		c.classifyModifyColumn(oldName, newName, item.FieldDefinition(), item.Place())
+	case item.DROP_SYMBOL() != nil:
+		c.classifyDrop(item)
+	case item.DISABLE_SYMBOL() != nil || item.ENABLE_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.ALTER_SYMBOL() != nil && item.INDEX_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.ALTER_SYMBOL() != nil && item.ConstraintEnforcement() != nil:
+		if item.ConstraintEnforcement().NOT_SYMBOL() != nil {
+			c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+			return
+		}
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "enforcing CHECK constraint validates all rows by copying the table")
+	case item.ALTER_SYMBOL() != nil:
+		// SET DEFAULT, DROP DEFAULT, SET VISIBLE and SET INVISIBLE.
+		if item.VISIBLE_SYMBOL() != nil || item.INVISIBLE_SYMBOL() != nil {
+			if c.listener.atLeast("8.0.23") {
+				c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+				return
+			}
+		} else if c.listener.atLeast("8.0.0") {
+			c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+			return
+		}
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.RENAME_SYMBOL() != nil && item.COLUMN_SYMBOL() != nil:
+		if c.listener.atLeast("8.0.28") {
+			c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+			return
+		}
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.RENAME_SYMBOL() != nil && item.KeyOrIndex() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.RENAME_SYMBOL() != nil:
+		if c.listener.atLeast("8.0.0") {
+			c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+			return
+		}
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.CONVERT_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "converting the character set copies the table")
+	case item.FORCE_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, "FORCE rebuilds the table")
+	case item.ORDER_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "ORDER BY copies the table")
+	case item.UPGRADE_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	default:
+	}
+}
+
+func (c *onlineDDLClassifier) classifyAddColumn(columnName string, field parser.IFieldDefinitionContext, place parser.IPlaceContext) {
+	if field == nil {
+		return
+	}
+	if field.AS_SYMBOL() != nil {
+		if field.STORED_SYMBOL() != nil {
+			c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, fmt.Sprintf("adding stored generated column %q copies the table", columnName))
+			return
+		}
+		if c.listener.atLeast("8.0.0") {
+			c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+			return
+		}
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+		return
+	}
+	for _, attribute := range field.AllColumnAttribute() {
+		if attribute.GetValue() == nil {
+			continue
+		}
+		switch attribute.GetValue().GetTokenType() {
+		case parser.MySQLParserAUTO_INCREMENT_SYMBOL:
+			c.prediction.merge(OnlineDDLAlgorithmInplace, true, false, fmt.Sprintf("adding AUTO_INCREMENT column %q rebuilds the table and blocks concurrent DML", columnName))
+			return
+		case parser.MySQLParserKEY_SYMBOL, parser.MySQLParserUNIQUE_SYMBOL:
+			c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, fmt.Sprintf("adding column %q with index rebuilds the table", columnName))
+			return
+		default:
+		}
+	}
+
+	if reason := c.instantAddColumnBlocker(place); reason != "" {
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, fmt.Sprintf("adding column %q rebuilds the table, %s", columnName, reason))
+		return
+	}
+	c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+}
+
+// instantAddColumnBlocker returns the reason why the column cannot be added instantly.
+func (c *onlineDDLClassifier) instantAddColumnBlocker(place parser.IPlaceContext) string {
+	if !c.listener.atLeast("8.0.12") {
+		return "INSTANT ADD COLUMN requires MySQL 8.0.12 or later"
+	}
+	if place != nil && !c.listener.atLeast("8.0.29") {
+		return "INSTANT ADD COLUMN with FIRST or AFTER requires MySQL 8.0.29 or later"
+	}
+	if strings.Contains(strings.ToLower(c.table.GetCreateOptions()), "row_format=compressed") {
+		return "INSTANT ADD COLUMN is not supported for compressed tables"
+	}
+	for _, index := range c.table.GetIndexes() {
+		if strings.EqualFold(index.Type, "FULLTEXT") {
+			return "INSTANT ADD COLUMN is not supported for tables with FULLTEXT index"
+		}
+	}
+	return ""
+}
+
+func (c *onlineDDLClassifier) classifyModifyColumn(oldName, newName string, field parser.IFieldDefinitionContext, place parser.IPlaceContext) {
+	column := c.findColumn(oldName)
+	if column == nil {
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, fmt.Sprintf("column %q is not found in the synced metadata, changing its definition is assumed to copy the table", oldName))
+		return
+	}
+	oldType := normalizeOnlineDDLColumnType(column.Type)
+	newType := normalizeOnlineDDLColumnType(NormalizeMySQLDataType(field.DataType(), false /* compact */))
+	if oldType != newType {
+		if isVarcharExtension(oldType, newType, column.CharacterSet) {
+			c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+		} else {
+			c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, fmt.Sprintf("changing the data type of column %q from %s to %s copies the table", oldName, oldType, newType))
+			return
+		}
+	}
+	if column.Nullable != isOnlineDDLFieldNullable(field) {
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, fmt.Sprintf("changing the nullability of column %q rebuilds the table", oldName))
+	}
+	if place != nil {
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, fmt.Sprintf("reordering column %q rebuilds the table", oldName))
+	}
+	if !strings.EqualFold(oldName, newName) && c.listener.atLeast("8.0.28") {
+		c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+		return
+	}
+	c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+}
+
+func (c *onlineDDLClassifier) classifyAddConstraint(constraint parser.ITableConstraintDefContext) {
+	if constraint.GetType_() == nil {
+		// CHECK constraint.
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "adding CHECK constraint validates all rows by copying the table")
+		return
+	}
+	switch constraint.GetType_().GetTokenType() {
+	case parser.MySQLParserPRIMARY_SYMBOL:
+		c.addPrimaryKey = true
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, "adding the primary key rebuilds the table")
+	case parser.MySQLParserFOREIGN_SYMBOL:
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "adding FOREIGN KEY copies the table unless foreign_key_checks is disabled")
+	case parser.MySQLParserFULLTEXT_SYMBOL:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, !c.hasFullTextIndex(), false, "adding FULLTEXT index blocks concurrent DML")
+	case parser.MySQLParserSPATIAL_SYMBOL:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, false, "adding SPATIAL index blocks concurrent DML")
+	default:
+		// Secondary index and unique index.
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	}
+}
+
+func (c *onlineDDLClassifier) classifyDrop(item parser.IAlterListItemContext) {
+	switch {
+	case item.FOREIGN_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.PRIMARY_SYMBOL() != nil:
+		c.dropPrimaryKey = true
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, "")
+	case item.KeyOrIndex() != nil, item.CHECK_SYMBOL() != nil, item.CONSTRAINT_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case item.ColumnInternalRef() != nil:
+		if c.listener.atLeast("8.0.29") {
+			c.prediction.merge(OnlineDDLAlgorithmInstant, false, true, "")
+			return
+		}
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, fmt.Sprintf("dropping column %q rebuilds the table", NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())))
+	default:
+	}
+}
+
+func (c *onlineDDLClassifier) classifyTableOption(option parser.ICreateTableOptionContext) {
+	if option.GetOption() == nil {
+		// DEFAULT CHARSET and DEFAULT COLLATE only change the default of the new columns.
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+		return
+	}
+	switch option.GetOption().GetTokenType() {
+	case parser.MySQLParserENGINE_SYMBOL:
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "changing the storage engine copies the table")
+	case parser.MySQLParserENCRYPTION_SYMBOL:
+		c.prediction.merge(OnlineDDLAlgorithmCopy, true, false, "changing the encryption copies the table")
+	case parser.MySQLParserROW_FORMAT_SYMBOL, parser.MySQLParserKEY_BLOCK_SIZE_SYMBOL:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, true, "changing the row format rebuilds the table")
+	default:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	}
+}
+
+func (c *onlineDDLClassifier) classifyStandaloneCommand(command parser.IStandaloneAlterCommandsContext) {
+	partition := command.AlterPartition()
+	if partition == nil {
+		// DISCARD, IMPORT TABLESPACE and SECONDARY_LOAD, SECONDARY_UNLOAD.
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, false, "")
+		return
+	}
+	switch {
+	case partition.ADD_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, true, "")
+	case partition.DROP_SYMBOL() != nil, partition.TRUNCATE_SYMBOL() != nil:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, false, false, "")
+	default:
+		c.prediction.merge(OnlineDDLAlgorithmInplace, true, false, "reorganizing the partitions rebuilds them and blocks concurrent DML")
+	}
+}
+
+// applyModifiers applies the explicit ALGORITHM and LOCK clauses.
+func (c *onlineDDLClassifier) applyModifiers() {
+	for _, modifier := range c.modifiers {
+		if option := modifier.AlterAlgorithmOption(); option != nil && option.Identifier() != nil {
+			var algorithm OnlineDDLAlgorithm
+			switch strings.ToUpper(NormalizeMySQLIdentifier(option.Identifier())) {
+			case "INSTANT":
+				algorithm = OnlineDDLAlgorithmInstant
+			case "INPLACE":
+				algorithm = OnlineDDLAlgorithmInplace
+			case "COPY":
+				algorithm = OnlineDDLAlgorithmCopy
+			default:
+				continue
+			}
+			if algorithm < c.prediction.Algorithm {
+				c.prediction.merge(c.prediction.Algorithm, false, true, fmt.Sprintf("ALGORITHM=%s is not supported by the operation, the statement fails", algorithm))
+				continue
+			}
+			if algorithm == OnlineDDLAlgorithmCopy {
+				c.prediction.merge(algorithm, true, false, "ALGORITHM=COPY copies the table")
+				continue
+			}
+			c.prediction.merge(algorithm, false, true, "")
+		}
+		if option := modifier.AlterLockOption(); option != nil && option.Identifier() != nil {
+			switch lock := strings.ToUpper(NormalizeMySQLIdentifier(option.Identifier())); lock {
+			case "SHARED", "EXCLUSIVE":
+				c.prediction.merge(c.prediction.Algorithm, false, false, fmt.Sprintf("LOCK=%s blocks concurrent DML", lock))
+			case "NONE":
+				if !c.prediction.ConcurrentDML {
+					c.prediction.merge(c.prediction.Algorithm, false, false, "LOCK=NONE is not supported by the operation, the statement fails")
+				}
+			default:
+			}
+		}
+	}
+}
+
+func (c *onlineDDLClassifier) findColumn(name string) *storepb.ColumnMetadata {
+	for _, column := range c.table.GetColumns() {
+		if strings.EqualFold(column.Name, name) {
+			return column
+		}
+	}
+	return nil
+}
+
+func (c *onlineDDLClassifier) hasFullTextIndex() bool {
+	for _, index := range c.table.GetIndexes() {
+		if strings.EqualFold(index.Type, "FULLTEXT") {
+			return true
+		}
+	}
+	return false
+}
+
+func isOnlineDDLFieldNullable(field parser.IFieldDefinitionContext) bool {
+	for _, attribute := range field.AllColumnAttribute() {
+		if attribute.NullLiteral() != nil && attribute.NOT_SYMBOL() != nil {
+			return false
+		}
+		if attribute.GetValue() != nil && attribute.GetValue().GetTokenType() == parser.MySQLParserKEY_SYMBOL && attribute.PRIMARY_SYMBOL() != nil {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	integerDisplayWidthRegexp = regexp.MustCompile(`^(tinyint|smallint|mediumint|int|bigint)\(\d+\)`)
+	varcharLengthRegexp       = regexp.MustCompile(`^varchar\((\d+)\)$`)
+)
+
+// normalizeOnlineDDLColumnType normalizes the column type to compare the type in the statement with the synced metadata.
+func normalizeOnlineDDLColumnType(tp string) string {
+	tp = strings.ToLower(strings.Join(strings.Fields(tp), " "))
+	tp = strings.ReplaceAll(tp, "integer", "int")
+	switch tp {
+	case "bool", "boolean":
+		return "tinyint(1)"
+	default:
+	}
+	if strings.HasPrefix(tp, "tinyint(1)") {
+		return tp
+	}
+	// The display width of integer types is deprecated since MySQL 8.0.17, and it's not in the synced metadata.
+	return integerDisplayWidthRegexp.ReplaceAllString(tp, "$1")
+}
+
+// isVarcharExtension returns true if the VARCHAR column is extended in place,
+// i.e. the number of length bytes stays the same.
+func isVarcharExtension(oldType, newType, charset string) bool {
+	oldMatch := varcharLengthRegexp.FindStringSubmatch(oldType)
+	newMatch := varcharLengthRegexp.FindStringSubmatch(newType)
+	if oldMatch == nil || newMatch == nil {
+		return false
+	}
+	oldLength, err := strconv.Atoi(oldMatch[1])
+	if err != nil {
+		return false
+	}
+	newLength, err := strconv.Atoi(newMatch[1])
+	if err != nil {
+		return false
+	}
+	if newLength < oldLength {
+		return false
+	}
+	bytesPerChar := 4
+	switch strings.ToLower(charset) {
+	case "latin1", "ascii", "binary":
+		bytesPerChar = 1
+	case "utf8", "utf8mb3":
+		bytesPerChar = 3
+	default:
+	}
+	// VARCHAR uses 1 length byte for values up to 255 bytes, and 2 length bytes otherwise.
+	return (oldLength*bytesPerChar < 256) == (newLength*bytesPerChar < 256)
+}
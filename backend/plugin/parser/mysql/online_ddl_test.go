@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestPredictOnlineDDL(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int"},
+							{Name: "name", Type: "varchar(20)", Nullable: true, CharacterSet: "utf8mb4"},
+							{Name: "code", Type: "varchar(10)", CharacterSet: "latin1"},
+						},
+					},
+					{
+						Name:    "doc",
+						Indexes: []*storepb.IndexMetadata{{Name: "ft", Type: "FULLTEXT"}},
+					},
+				},
+			},
+		},
+	}
+
+	type want struct {
+		algorithm     OnlineDDLAlgorithm
+		rebuild       bool
+		concurrentDML bool
+	}
+	tests := []struct {
+		version   string
+		statement string
+		want      want
+	}{
+		{"8.0.35", "ALTER TABLE t ADD COLUMN c INT", want{OnlineDDLAlgorithmInstant, false, true}},
+		{"8.0.20", "ALTER TABLE t ADD COLUMN c INT AFTER id", want{OnlineDDLAlgorithmInplace, true, true}},
+		{"8.0.35", "ALTER TABLE t ADD COLUMN c INT AFTER id", want{OnlineDDLAlgorithmInstant, false, true}},
+		{"5.7.44-log", "ALTER TABLE t ADD COLUMN c INT", want{OnlineDDLAlgorithmInplace, true, true}},
+		{"8.0.35", "ALTER TABLE doc ADD COLUMN c INT", want{OnlineDDLAlgorithmInplace, true, true}},
+		{"8.0.35", "ALTER TABLE t ADD COLUMN c INT AUTO_INCREMENT", want{OnlineDDLAlgorithmInplace, true, false}},
+		{"8.0.35", "ALTER TABLE t ADD COLUMN c INT AS (id + 1) STORED", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t DROP COLUMN name", want{OnlineDDLAlgorithmInstant, false, true}},
+		{"8.0.20", "ALTER TABLE t DROP COLUMN name", want{OnlineDDLAlgorithmInplace, true, true}},
+		{"8.0.35", "ALTER TABLE t MODIFY COLUMN id BIGINT", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t MODIFY COLUMN id INT(11)", want{OnlineDDLAlgorithmInplace, true, true}},
+		{"8.0.35", "ALTER TABLE t MODIFY COLUMN name VARCHAR(60)", want{OnlineDDLAlgorithmInplace, false, true}},
+		{"8.0.35", "ALTER TABLE t MODIFY COLUMN name VARCHAR(100)", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t MODIFY COLUMN code VARCHAR(200) NOT NULL", want{OnlineDDLAlgorithmInplace, false, true}},
+		{"8.0.35", "ALTER TABLE t CHANGE COLUMN name full_name VARCHAR(20)", want{OnlineDDLAlgorithmInstant, false, true}},
+		{"8.0.20", "ALTER TABLE t CHANGE COLUMN name full_name VARCHAR(20)", want{OnlineDDLAlgorithmInplace, false, true}},
+		{"8.0.35", "ALTER TABLE t ADD INDEX idx_name (name)", want{OnlineDDLAlgorithmInplace, false, true}},
+		{"8.0.35", "ALTER TABLE t ADD FULLTEXT INDEX ft_name (name)", want{OnlineDDLAlgorithmInplace, true, false}},
+		{"8.0.35", "ALTER TABLE t DROP PRIMARY KEY", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (id, name)", want{OnlineDDLAlgorithmInplace, true, true}},
+		{"8.0.35", "ALTER TABLE t ADD CONSTRAINT fk FOREIGN KEY (id) REFERENCES u (id)", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t CONVERT TO CHARACTER SET utf8mb4", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t ENGINE = InnoDB", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t ALTER COLUMN name SET DEFAULT 'a'", want{OnlineDDLAlgorithmInstant, false, true}},
+		{"8.0.35", "ALTER TABLE t RENAME COLUMN name TO full_name", want{OnlineDDLAlgorithmInstant, false, true}},
+		{"8.0.35", "ALTER TABLE t ADD COLUMN c INT, ALGORITHM=COPY", want{OnlineDDLAlgorithmCopy, true, false}},
+		{"8.0.35", "ALTER TABLE t ADD INDEX idx_name (name), LOCK=SHARED", want{OnlineDDLAlgorithmInplace, false, false}},
+		{"8.0.35", "ALTER TABLE unknown MODIFY COLUMN a INT", want{OnlineDDLAlgorithmCopy, true, false}},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		asts, err := ParseMySQL(test.statement)
+		a.NoError(err)
+		predictions := PredictOnlineDDL(asts, test.version, "db", metadata)
+		a.Len(predictions, 1, test.statement)
+		got := want{
+			algorithm:     predictions[0].Algorithm,
+			rebuild:       predictions[0].TableRebuild,
+			concurrentDML: predictions[0].ConcurrentDML,
+		}
+		a.Equal(test.want, got, "%s on %s, reasons: %v", test.statement, test.version, predictions[0].Reasons)
+	}
+}
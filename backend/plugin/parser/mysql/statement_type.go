@@ -12,7 +12,7 @@ func GetStatementTypes(asts any) ([]string, error) {
 	}
 	sqlTypeSet := make(map[string]bool)
 	for _, node := range nodes {
-		t := getStatementType(node)
+		t := GetStatementType(node)
 		sqlTypeSet[t] = true
 	}
 	var sqlTypes []string
@@ -22,8 +22,8 @@ func GetStatementTypes(asts any) ([]string, error) {
 	return sqlTypes, nil
 }
 
-// GetStatementType return the type of statement.
-func getStatementType(stmt *ParseResult) string {
+// GetStatementType returns the type of statement.
+func GetStatementType(stmt *ParseResult) string {
 	for _, child := range stmt.Tree.GetChildren() {
 		switch ctx := child.(type) {
 		case *mysql.QueryContext:
@@ -0,0 +1,132 @@
+// Package orm extracts the SQL statements from the ORM files, such as MyBatis mapper xml and TypeORM migrations,
+// so that they can be checked as plain SQL scripts.
+package orm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/mybatis/mapper"
+	"github.com/bytebase/bytebase/backend/plugin/parser/typeorm"
+)
+
+// Script is the SQL script extracted from an ORM file.
+type Script struct {
+	// Statement is the extracted statements, separated by semicolons and new lines.
+	Statement string
+
+	// lines is the mapping from the zero-based last line of each statement in the script
+	// to the zero-based line of the statement in the ORM file, sorted by the script line.
+	lines []lineMapping
+}
+
+// ExtractError is the error of an ORM file from which the SQL statements cannot be extracted.
+type ExtractError struct {
+	// Line is the zero-based line of the error in the ORM file.
+	Line int
+	Err  error
+}
+
+func (e *ExtractError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line+1, e.Err)
+}
+
+func (e *ExtractError) Unwrap() error {
+	return e.Err
+}
+
+type lineMapping struct {
+	scriptLastLine int
+	originalLine   int
+}
+
+// GetOriginalLine returns the zero-based line in the ORM file of the statement containing the zero-based script line.
+func (s *Script) GetOriginalLine(line int) int {
+	i := sort.Search(len(s.lines), func(i int) bool {
+		return s.lines[i].scriptLastLine >= line
+	})
+	if i == len(s.lines) {
+		if len(s.lines) == 0 {
+			return 0
+		}
+		i = len(s.lines) - 1
+	}
+	return s.lines[i].originalLine
+}
+
+// ExtractMyBatisMapper extracts the SQL statements from a MyBatis mapper xml.
+// The parameters are restored as the placeholders of the engine.
+func ExtractMyBatisMapper(content string, engine storepb.Engine) (*Script, error) {
+	parser := mapper.NewParser(content)
+	node, err := parser.Parse()
+	if err != nil {
+		line, _ := parser.InputPos()
+		return nil, &ExtractError{
+			Line: line - 1,
+			Err:  errors.Wrapf(err, "failed to parse mybatis mapper xml"),
+		}
+	}
+	var sb strings.Builder
+	mappings, err := node.RestoreSQLWithLineMapping(parser.NewRestoreContext().WithRestoreDataNodePlaceholder(getPlaceholder(engine)), &sb)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to restore sql from mybatis mapper xml")
+	}
+	script := &Script{
+		Statement: sb.String(),
+	}
+	for _, mapping := range mappings {
+		// Both lines are one-based in the mybatis mapper parser.
+		script.lines = append(script.lines, lineMapping{
+			scriptLastLine: mapping.SQLLastLine - 1,
+			originalLine:   mapping.OriginalEleLine - 1,
+		})
+	}
+	return script, nil
+}
+
+// ExtractTypeORMMigration extracts the SQL statements from the up() function of a TypeORM migration class.
+func ExtractTypeORMMigration(content string) (*Script, error) {
+	statements, err := typeorm.ParseStatements(content)
+	if err != nil {
+		var syntaxErr *typeorm.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, &ExtractError{
+				Line: syntaxErr.Line,
+				Err:  errors.Wrapf(errors.New(syntaxErr.Message), "failed to parse typeorm migration"),
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to parse typeorm migration")
+	}
+	script := &Script{}
+	var sb strings.Builder
+	line := 0
+	for _, statement := range statements {
+		text := strings.TrimSpace(statement.Text)
+		if !strings.HasSuffix(text, ";") {
+			text += ";"
+		}
+		_, _ = sb.WriteString(text)
+		_, _ = sb.WriteString("\n")
+		line += strings.Count(text, "\n")
+		script.lines = append(script.lines, lineMapping{
+			scriptLastLine: line,
+			originalLine:   statement.Line,
+		})
+		line++
+	}
+	script.Statement = sb.String()
+	return script, nil
+}
+
+func getPlaceholder(engine storepb.Engine) string {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_COCKROACHDB:
+		return "$1"
+	default:
+		return "?"
+	}
+}
@@ -0,0 +1,91 @@
+package orm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestExtractMyBatisMapper(t *testing.T) {
+	a := require.New(t)
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE mapper PUBLIC "-//mybatis.org//DTD Mapper 3.0//EN" "http://mybatis.org/dtd/mybatis-3-mapper.dtd">
+<mapper namespace="com.example.UserMapper">
+  <select id="getUser" resultType="User">
+    SELECT * FROM users WHERE id = #{id}
+  </select>
+
+  <update id="updateName">
+    UPDATE users
+    SET name = #{name}
+    WHERE id = #{id}
+  </update>
+</mapper>
+`
+	script, err := ExtractMyBatisMapper(content, storepb.Engine_MYSQL)
+	a.NoError(err)
+	a.Equal("SELECT * FROM users WHERE id = ?;\nUPDATE users\n    SET name = ?\n    WHERE id = ?;\n", script.Statement)
+	a.Equal(3, script.GetOriginalLine(0))
+	a.Equal(7, script.GetOriginalLine(1))
+	a.Equal(7, script.GetOriginalLine(3))
+
+	script, err = ExtractMyBatisMapper(content, storepb.Engine_POSTGRES)
+	a.NoError(err)
+	a.Equal("SELECT * FROM users WHERE id = $1;\nUPDATE users\n    SET name = $1\n    WHERE id = $1;\n", script.Statement)
+
+	_, err = ExtractMyBatisMapper("<mapper>\n  <select>SELECT 1\n</mapper>", storepb.Engine_MYSQL)
+	var extractErr *ExtractError
+	a.ErrorAs(err, &extractErr)
+	a.Equal(2, extractErr.Line)
+}
+
+func TestExtractTypeORMMigration(t *testing.T) {
+	a := require.New(t)
+	content := "import { MigrationInterface, QueryRunner } from 'typeorm';\n" +
+		"\n" +
+		"export class AddUser1700000000000 implements MigrationInterface {\n" +
+		"  public async up(queryRunner: QueryRunner): Promise<void> {\n" +
+		"    await queryRunner.query(`CREATE TABLE \"user\" (\"id\" int NOT NULL)`);\n" +
+		"    await queryRunner.query(\n" +
+		"      `ALTER TABLE \"user\" ADD \"name\" varchar NOT NULL`,\n" +
+		"    );\n" +
+		"  }\n" +
+		"\n" +
+		"  public async down(queryRunner: QueryRunner): Promise<void> {\n" +
+		"    await queryRunner.query(`DROP TABLE \"user\"`);\n" +
+		"  }\n" +
+		"}\n"
+	script, err := ExtractTypeORMMigration(content)
+	a.NoError(err)
+	a.Equal("CREATE TABLE \"user\" (\"id\" int NOT NULL);\nALTER TABLE \"user\" ADD \"name\" varchar NOT NULL;\n", script.Statement)
+	a.Equal(4, script.GetOriginalLine(0))
+	a.Equal(6, script.GetOriginalLine(1))
+
+	_, err = ExtractTypeORMMigration("export class Empty {}")
+	a.Error(err)
+
+	// The template literals spanning multiple lines are extracted.
+	content = "export class AddUser1700000000000 implements MigrationInterface {\n" +
+		"  public async up(queryRunner: QueryRunner): Promise<void> {\n" +
+		"    await queryRunner.query(`\n" +
+		"      CREATE TABLE \"user\" (\n" +
+		"        \"id\" int NOT NULL\n" +
+		"      )`);\n" +
+		"    await queryRunner.query(`CREATE TABLE ${name} (id int)`);\n" +
+		"  }\n" +
+		"}\n"
+	_, err = ExtractTypeORMMigration(content)
+	var extractErr *ExtractError
+	a.ErrorAs(err, &extractErr)
+	a.Equal(6, extractErr.Line)
+
+	script, err = ExtractTypeORMMigration(strings.Replace(content, "${name}", "\"name\"", 1))
+	a.NoError(err)
+	a.Equal("CREATE TABLE \"user\" (\n        \"id\" int NOT NULL\n      );\nCREATE TABLE \"name\" (id int);\n", script.Statement)
+	a.Equal(2, script.GetOriginalLine(0))
+	a.Equal(2, script.GetOriginalLine(2))
+	a.Equal(6, script.GetOriginalLine(3))
+}
@@ -13,7 +13,7 @@ func GetStatementTypes(asts any) ([]string, error) {
 	}
 	sqlTypeSet := make(map[string]bool)
 	for _, node := range nodes {
-		t := getStatementType(node)
+		t := GetStatementType(node)
 		sqlTypeSet[t] = true
 	}
 	var sqlTypes []string
@@ -23,7 +23,7 @@ func GetStatementTypes(asts any) ([]string, error) {
 	return sqlTypes, nil
 }
 
-func getStatementType(node ast.Node) string {
+func GetStatementType(node ast.Node) string {
 	switch node := node.(type) {
 	// DDL
 
@@ -0,0 +1,290 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	maxTableNameLength = 255
+	// defaultSchema is the schema of the unqualified tables and the backup tables.
+	defaultSchema = "PUBLIC"
+)
+
+func init() {
+	base.RegisterTransformDMLToSelect(storepb.Engine_SNOWFLAKE, TransformDMLToSelect)
+}
+
+type StatementType int
+
+const (
+	StatementTypeUnknown StatementType = iota
+	StatementTypeUpdate
+	StatementTypeDelete
+)
+
+// TableReference is the table changed by a DML statement.
+type TableReference struct {
+	Database      string
+	Schema        string
+	Table         string
+	StatementType StatementType
+}
+
+type statementInfo struct {
+	tree          antlr.ParserRuleContext
+	table         *TableReference
+	startPosition *storepb.Position
+	endPosition   *storepb.Position
+}
+
+// TransformDMLToSelect transforms the UPDATE and DELETE statements to the CREATE TABLE AS SELECT statements,
+// which back up the affected rows to the target database.
+func TransformDMLToSelect(_ context.Context, _ base.TransformContext, statement string, sourceDatabase string, targetDatabase string, tablePrefix string) ([]base.BackupStatement, error) {
+	statementInfoList, err := prepareTransformation(sourceDatabase, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare transformation")
+	}
+
+	return generateSQL(statementInfoList, targetDatabase, tablePrefix)
+}
+
+// ExtractDMLTables extracts the tables changed by the top-level UPDATE and DELETE statements in the tree.
+// The unqualified tables are normalized with the database name and the PUBLIC schema.
+func ExtractDMLTables(databaseName string, tree antlr.Tree) []*TableReference {
+	extractor := &dmlExtractor{
+		databaseName: databaseName,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(extractor, tree)
+	var result []*TableReference
+	for _, dml := range extractor.dmls {
+		result = append(result, dml.table)
+	}
+	return result
+}
+
+func prepareTransformation(databaseName, statement string) ([]statementInfo, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to split sql")
+	}
+
+	var result []statementInfo
+	for _, item := range list {
+		if len(item.Text) == 0 || item.Empty {
+			continue
+		}
+		parseResult, err := ParseSnowSQL(item.Text)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse sql")
+		}
+
+		extractor := &dmlExtractor{
+			databaseName: databaseName,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(extractor, parseResult.Tree)
+		for _, dml := range extractor.dmls {
+			dml.startPosition = item.Start
+			dml.endPosition = item.End
+			result = append(result, dml)
+		}
+	}
+	return result, nil
+}
+
+func generateSQL(statementInfoList []statementInfo, targetDatabase string, tablePrefix string) ([]base.BackupStatement, error) {
+	groupByTable := make(map[string][]statementInfo)
+	for _, item := range statementInfoList {
+		key := fmt.Sprintf("%s.%s.%s", item.table.Database, item.table.Schema, item.table.Table)
+		groupByTable[key] = append(groupByTable[key], item)
+	}
+
+	// Check if the statement type is the same for all statements in one table.
+	for key, list := range groupByTable {
+		stmtType := StatementTypeUnknown
+		for _, item := range list {
+			if stmtType == StatementTypeUnknown {
+				stmtType = item.table.StatementType
+			}
+			if stmtType != item.table.StatementType {
+				return nil, errors.Errorf("prior backup cannot handle mixed DML statements on the same table %s", key)
+			}
+		}
+	}
+
+	var result []base.BackupStatement
+	for key, list := range groupByTable {
+		backupStatement, err := generateSQLForTable(list, targetDatabase, tablePrefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate SQL for table %s", key)
+		}
+		result = append(result, *backupStatement)
+	}
+
+	slices.SortFunc(result, func(i, j base.BackupStatement) int {
+		if i.StartPosition.Line != j.StartPosition.Line {
+			if i.StartPosition.Line < j.StartPosition.Line {
+				return -1
+			}
+			return 1
+		}
+		if i.StartPosition.Column != j.StartPosition.Column {
+			if i.StartPosition.Column < j.StartPosition.Column {
+				return -1
+			}
+			return 1
+		}
+		if i.SourceTableName < j.SourceTableName {
+			return -1
+		}
+		if i.SourceTableName > j.SourceTableName {
+			return 1
+		}
+		return 0
+	})
+
+	return result, nil
+}
+
+func generateSQLForTable(statementInfoList []statementInfo, targetDatabase string, tablePrefix string) (*base.BackupStatement, error) {
+	table := statementInfoList[0].table
+
+	// The backup database is shared by all databases in the instance, so the source database is a part of the name.
+	targetTable := fmt.Sprintf("%s_%s_%s_%s", tablePrefix, table.Table, table.Schema, table.Database)
+	targetTable, _ = common.TruncateString(targetTable, maxTableNameLength)
+
+	var buf strings.Builder
+	if _, err := fmt.Fprintf(&buf, `CREATE TABLE "%s"."%s"."%s" AS`+"\n", targetDatabase, defaultSchema, targetTable); err != nil {
+		return nil, errors.Wrap(err, "failed to write create table statement")
+	}
+	for i, item := range statementInfoList {
+		if i != 0 {
+			// UNION removes the duplicate rows selected by multiple statements.
+			if _, err := buf.WriteString("\n  UNION\n"); err != nil {
+				return nil, errors.Wrap(err, "failed to write union statement")
+			}
+		}
+		if _, err := buf.WriteString("  "); err != nil {
+			return nil, errors.Wrap(err, "failed to write space")
+		}
+		if err := writeSelectStatement(&buf, item.tree); err != nil {
+			return nil, errors.Wrap(err, "failed to write select statement")
+		}
+	}
+	if err := buf.WriteByte(';'); err != nil {
+		return nil, errors.Wrap(err, "failed to write semicolon")
+	}
+
+	return &base.BackupStatement{
+		Statement:       buf.String(),
+		SourceSchema:    table.Schema,
+		SourceTableName: table.Table,
+		TargetTableName: targetTable,
+		StartPosition:   statementInfoList[0].startPosition,
+		EndPosition:     statementInfoList[len(statementInfoList)-1].endPosition,
+	}, nil
+}
+
+// writeSelectStatement writes the SELECT statement selecting the rows changed by the UPDATE or DELETE statement.
+func writeSelectStatement(buf *strings.Builder, tree antlr.ParserRuleContext) error {
+	var tokens antlr.TokenStream
+	var objectName parser.IObject_nameContext
+	var sources []string
+	var where parser.ISearch_conditionContext
+	switch ctx := tree.(type) {
+	case *parser.Update_statementContext:
+		tokens = ctx.GetParser().GetTokenStream()
+		objectName = ctx.Object_name()
+		if ctx.Table_sources() != nil {
+			sources = append(sources, tokens.GetTextFromRuleContext(ctx.Table_sources()))
+		}
+		where = ctx.Search_condition()
+	case *parser.Delete_statementContext:
+		tokens = ctx.GetParser().GetTokenStream()
+		objectName = ctx.Object_name()
+		for _, source := range ctx.AllTable_or_query() {
+			sources = append(sources, tokens.GetTextFromRuleContext(source))
+		}
+		where = ctx.Search_condition()
+	default:
+		return errors.Errorf("unexpected statement type %T", tree)
+	}
+
+	table := tokens.GetTextFromRuleContext(objectName)
+	if _, err := fmt.Fprintf(buf, "SELECT %s.* FROM %s", table, table); err != nil {
+		return err
+	}
+	for _, source := range sources {
+		if _, err := fmt.Fprintf(buf, ", %s", source); err != nil {
+			return err
+		}
+	}
+	if where != nil {
+		if _, err := fmt.Fprintf(buf, " WHERE %s", tokens.GetTextFromRuleContext(where)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsTopLevelStatement returns true if the statement is not nested in other statements.
+func IsTopLevelStatement(ctx antlr.Tree) bool {
+	if ctx == nil {
+		return true
+	}
+	switch ctx := ctx.(type) {
+	case *parser.BatchContext, *parser.Snowflake_fileContext:
+		return true
+	case *parser.Sql_commandContext, *parser.Dml_commandContext:
+		return IsTopLevelStatement(ctx.GetParent())
+	default:
+		return false
+	}
+}
+
+type dmlExtractor struct {
+	*parser.BaseSnowflakeParserListener
+
+	databaseName string
+	dmls         []statementInfo
+}
+
+func (e *dmlExtractor) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if !IsTopLevelStatement(ctx.GetParent()) {
+		return
+	}
+	e.dmls = append(e.dmls, statementInfo{
+		tree:  ctx,
+		table: e.newTableReference(ctx.Object_name(), StatementTypeUpdate),
+	})
+}
+
+func (e *dmlExtractor) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if !IsTopLevelStatement(ctx.GetParent()) {
+		return
+	}
+	e.dmls = append(e.dmls, statementInfo{
+		tree:  ctx,
+		table: e.newTableReference(ctx.Object_name(), StatementTypeDelete),
+	})
+}
+
+func (e *dmlExtractor) newTableReference(objectName parser.IObject_nameContext, statementType StatementType) *TableReference {
+	database, schema, table := normalizedObjectName(objectName, e.databaseName, defaultSchema)
+	return &TableReference{
+		Database:      database,
+		Schema:        schema,
+		Table:         table,
+		StatementType: statementType,
+	}
+}
@@ -0,0 +1,294 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	maxCommentLength = 1000
+)
+
+func init() {
+	base.RegisterGenerateRestoreSQL(storepb.Engine_SNOWFLAKE, GenerateRestoreSQL)
+}
+
+// GenerateRestoreSQL generates the SQL restoring the rows changed by the statement from the backup table.
+func GenerateRestoreSQL(ctx context.Context, rCtx base.RestoreContext, statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	originalSQL, err := extractSQL(statement, backupItem)
+	if err != nil {
+		return "", errors.Errorf("failed to extract single SQL: %v", err)
+	}
+
+	if len(originalSQL) == 0 {
+		return "", errors.Errorf("no original SQL")
+	}
+
+	parseResult, err := ParseSnowSQL(originalSQL)
+	if err != nil {
+		return "", err
+	}
+
+	// We only need the first statement.
+	// There are two cases:
+	// 1. The statement is a single SQL statement.
+	// 2. The statement is a multi SQL statement, but all SQL statements' backup is in the same table.
+	//    So we only need to restore the first SQL statement.
+	sqlForComment, truncated := common.TruncateString(originalSQL, maxCommentLength)
+	if truncated {
+		sqlForComment += "..."
+	}
+	return doGenerate(ctx, rCtx, sqlForComment, parseResult.Tree, backupItem)
+}
+
+func doGenerate(ctx context.Context, rCtx base.RestoreContext, sqlForComment string, tree antlr.Tree, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+	_, targetDatabase, err := common.GetInstanceDatabaseID(backupItem.TargetTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get target database ID for %s", backupItem.TargetTable.Database)
+	}
+
+	if rCtx.GetDatabaseMetadataFunc == nil {
+		return "", errors.Errorf("GetDatabaseMetadataFunc is required")
+	}
+
+	_, metadata, err := rCtx.GetDatabaseMetadataFunc(ctx, rCtx.InstanceID, sourceDatabase)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get database metadata for %s", sourceDatabase)
+	}
+	if metadata == nil {
+		return "", errors.Errorf("database metadata not found for %s", sourceDatabase)
+	}
+
+	schema := backupItem.SourceTable.Schema
+	if schema == "" {
+		schema = defaultSchema
+	}
+	schemaMetadata := metadata.GetSchema(schema)
+	if schemaMetadata == nil {
+		return "", errors.Errorf("schema metadata not found for %s", schema)
+	}
+	tableMetadata := schemaMetadata.GetTable(backupItem.SourceTable.Table)
+	if tableMetadata == nil {
+		return "", errors.Errorf("table metadata not found for %s.%s", schema, backupItem.SourceTable.Table)
+	}
+
+	backupSchema := backupItem.TargetTable.Schema
+	if backupSchema == "" {
+		backupSchema = defaultSchema
+	}
+	g := &generator{
+		backupTable:   fmt.Sprintf(`"%s"."%s"."%s"`, targetDatabase, backupSchema, backupItem.TargetTable.Table),
+		originalTable: fmt.Sprintf(`"%s"."%s"."%s"`, sourceDatabase, schema, backupItem.SourceTable.Table),
+		table:         tableMetadata,
+		isFirst:       true,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(g, tree)
+	if g.err != nil {
+		return "", g.err
+	}
+	return fmt.Sprintf("/*\nOriginal SQL:\n%s\n*/\n%s", sqlForComment, g.result), nil
+}
+
+type generator struct {
+	*parser.BaseSnowflakeParserListener
+
+	// backupTable and originalTable are the quoted full names.
+	backupTable   string
+	originalTable string
+	table         *model.TableMetadata
+
+	isFirst bool
+	result  string
+	err     error
+}
+
+func (g *generator) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if !IsTopLevelStatement(ctx.GetParent()) || !g.isFirst {
+		return
+	}
+
+	g.isFirst = false
+	g.result = fmt.Sprintf(`INSERT INTO %s SELECT * FROM %s;`, g.originalTable, g.backupTable)
+}
+
+func disjoint(a []string, b map[string]bool) bool {
+	for _, item := range a {
+		if _, ok := b[item]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// findDisjointUniqueKey finds the primary key or unique key which is not updated by the statement.
+// Snowflake doesn't enforce the keys, so the restore relies on the declared keys to locate the rows.
+func (g *generator) findDisjointUniqueKey(columns []string) ([]string, error) {
+	columnMap := make(map[string]bool)
+	for _, column := range columns {
+		columnMap[column] = true
+	}
+	if pk := g.table.GetPrimaryKey(); pk != nil {
+		if disjoint(pk.GetProto().Expressions, columnMap) {
+			return pk.GetProto().Expressions, nil
+		}
+	}
+	for _, index := range g.table.GetProto().Indexes {
+		if index.Primary || !index.Unique {
+			continue
+		}
+		if disjoint(index.Expressions, columnMap) {
+			return index.Expressions, nil
+		}
+	}
+	return nil, errors.Errorf("no disjoint primary key or unique key found for %s", g.originalTable)
+}
+
+func (g *generator) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if !IsTopLevelStatement(ctx.GetParent()) || !g.isFirst {
+		return
+	}
+
+	g.isFirst = false
+
+	var updateColumns []string
+	for _, column := range ctx.AllColumn_name() {
+		updateColumns = append(updateColumns, NormalizeSnowSQLObjectNamePart(column.Id_()))
+	}
+
+	uk, err := g.findDisjointUniqueKey(updateColumns)
+	if err != nil {
+		g.err = err
+		return
+	}
+
+	var buf strings.Builder
+	if _, err := fmt.Fprintf(&buf, "MERGE INTO %s AS t\nUSING %s AS b\n  ON", g.originalTable, g.backupTable); err != nil {
+		g.err = err
+		return
+	}
+	for i, column := range uk {
+		if i > 0 {
+			if _, err := fmt.Fprintf(&buf, " AND"); err != nil {
+				g.err = err
+				return
+			}
+		}
+		if _, err := fmt.Fprintf(&buf, ` t."%s" = b."%s"`, column, column); err != nil {
+			g.err = err
+			return
+		}
+	}
+	if _, err := fmt.Fprintf(&buf, "\nWHEN MATCHED THEN\n  UPDATE SET"); err != nil {
+		g.err = err
+		return
+	}
+	for i, column := range updateColumns {
+		if i > 0 {
+			if _, err := fmt.Fprintf(&buf, ","); err != nil {
+				g.err = err
+				return
+			}
+		}
+		if _, err := fmt.Fprintf(&buf, ` "%s" = b."%s"`, column, column); err != nil {
+			g.err = err
+			return
+		}
+	}
+	var columns, values []string
+	for _, column := range g.table.GetColumns() {
+		columns = append(columns, fmt.Sprintf(`"%s"`, column.Name))
+		values = append(values, fmt.Sprintf(`b."%s"`, column.Name))
+	}
+	if _, err := fmt.Fprintf(&buf, "\nWHEN NOT MATCHED THEN\n  INSERT (%s) VALUES (%s);", strings.Join(columns, ", "), strings.Join(values, ", ")); err != nil {
+		g.err = err
+		return
+	}
+	g.result = buf.String()
+}
+
+// extractSQL extracts the statements changing the source table of the backup item.
+func extractSQL(statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	if backupItem == nil {
+		return "", errors.Errorf("backup item is nil")
+	}
+
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to split sql")
+	}
+
+	start := 0
+	end := len(list) - 1
+	for i, item := range list {
+		if equalOrLess(item.Start, backupItem.StartPosition) {
+			start = i
+		}
+	}
+
+	for i := len(list) - 1; i >= 0; i-- {
+		if equalOrGreater(list[i].Start, backupItem.EndPosition) {
+			end = i
+		}
+	}
+
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+	sourceSchema := backupItem.SourceTable.Schema
+	if sourceSchema == "" {
+		sourceSchema = defaultSchema
+	}
+
+	var result []string
+	for i := start; i <= end; i++ {
+		if list[i].Empty {
+			continue
+		}
+		parseResult, err := ParseSnowSQL(list[i].Text)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse sql")
+		}
+		for _, table := range ExtractDMLTables(sourceDatabase, parseResult.Tree) {
+			if table.Database == sourceDatabase && table.Schema == sourceSchema && table.Table == backupItem.SourceTable.Table {
+				result = append(result, strings.TrimSpace(list[i].Text))
+				break
+			}
+		}
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+func equalOrLess(a, b *storepb.Position) bool {
+	if a.Line < b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column <= b.Column {
+		return true
+	}
+	return false
+}
+
+func equalOrGreater(a, b *storepb.Position) bool {
+	if a.Line > b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column >= b.Column {
+		return true
+	}
+	return false
+}
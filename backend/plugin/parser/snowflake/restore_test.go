@@ -0,0 +1,154 @@
+package snowflake
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+type restoreCase struct {
+	Input          string
+	BackupTable    string
+	OriginalSchema string
+	OriginalTable  string
+	Result         string
+}
+
+func TestRestore(t *testing.T) {
+	tests := []restoreCase{}
+
+	const (
+		record = false
+	)
+	var (
+		filepath = "test-data/test_restore.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(yamlFile.Close())
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	for i, t := range tests {
+		result, err := GenerateRestoreSQL(context.Background(), base.RestoreContext{
+			GetDatabaseMetadataFunc: fixedMockDatabaseMetadataGetter,
+		}, t.Input, &store.PriorBackupDetail_Item{
+			SourceTable: &store.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/DB",
+				Schema:   t.OriginalSchema,
+				Table:    t.OriginalTable,
+			},
+			TargetTable: &store.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/BBDATAARCHIVE",
+				Schema:   "PUBLIC",
+				Table:    t.BackupTable,
+			},
+			StartPosition: &store.Position{
+				Line:   0,
+				Column: 0,
+			},
+			EndPosition: &store.Position{
+				Line:   math.MaxInt32,
+				Column: 0,
+			},
+		})
+		a.NoError(err)
+
+		if record {
+			tests[i].Result = result
+		} else {
+			a.Equal(t.Result, result, t.Input)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
+
+func TestRestoreWithoutDisjointKey(t *testing.T) {
+	a := require.New(t)
+	_, err := GenerateRestoreSQL(context.Background(), base.RestoreContext{
+		GetDatabaseMetadataFunc: fixedMockDatabaseMetadataGetter,
+	}, "UPDATE T1 SET ID = 2 WHERE ID = 1;", &store.PriorBackupDetail_Item{
+		SourceTable: &store.PriorBackupDetail_Item_Table{
+			Database: "instances/i1/databases/DB",
+			Schema:   "PUBLIC",
+			Table:    "T1",
+		},
+		TargetTable: &store.PriorBackupDetail_Item_Table{
+			Database: "instances/i1/databases/BBDATAARCHIVE",
+			Schema:   "PUBLIC",
+			Table:    "_rollback_T1_PUBLIC_DB",
+		},
+		StartPosition: &store.Position{},
+		EndPosition:   &store.Position{Line: math.MaxInt32},
+	})
+	a.Error(err)
+}
+
+func fixedMockDatabaseMetadataGetter(_ context.Context, _ string, database string) (string, *model.DatabaseMetadata, error) {
+	return database, model.NewDatabaseMetadata(&store.DatabaseSchemaMetadata{
+		Name: database,
+		Schemas: []*store.SchemaMetadata{
+			{
+				Name: "PUBLIC",
+				Tables: []*store.TableMetadata{
+					{
+						Name: "T1",
+						Columns: []*store.ColumnMetadata{
+							{
+								Name: "ID",
+							},
+							{
+								Name: "NAME",
+							},
+							{
+								Name: "AGE",
+							},
+						},
+						Indexes: []*store.IndexMetadata{
+							{
+								Name:        "SYS_CONSTRAINT_T1",
+								Expressions: []string{"ID"},
+								Primary:     true,
+								Unique:      true,
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "SCHEMA1",
+				Tables: []*store.TableMetadata{
+					{
+						Name: "t2",
+						Columns: []*store.ColumnMetadata{
+							{
+								Name: "a",
+							},
+							{
+								Name: "b",
+							},
+						},
+					},
+				},
+			},
+		},
+	}, true /* isObjectCaseSensitive */, true /* isDetailCaseSensitive */), nil
+}
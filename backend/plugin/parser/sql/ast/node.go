@@ -14,12 +14,16 @@ type Node interface {
 	SetText(text string)
 	LastLine() int
 	SetLastLine(line int)
+	ByteOffset() int
+	SetByteOffset(offset int)
 }
 
 // node is the base struct for all Node.
 type node struct {
 	text     string
 	lastline int
+	// byteOffset is the zero-based byte offset of the text in the original statements.
+	byteOffset int
 	*pgquery.ParseResult
 }
 
@@ -40,3 +44,13 @@ func (n *node) LastLine() int {
 func (n *node) SetLastLine(line int) {
 	n.lastline = line
 }
+
+// ByteOffset implements the Node interface.
+func (n *node) ByteOffset() int {
+	return n.byteOffset
+}
+
+// SetByteOffset implements the Node interface.
+func (n *node) SetByteOffset(offset int) {
+	n.byteOffset = offset
+}
@@ -0,0 +1,9 @@
+package ast
+
+// SetTablespaceStmt is the struct for set tablespace statement.
+type SetTablespaceStmt struct {
+	node
+
+	Table      *TableDef
+	Tablespace string
+}
@@ -184,6 +184,10 @@ func Walk(v Visitor, node Node) {
 		if n.Table != nil {
 			Walk(v, n.Table)
 		}
+	case *SetTablespaceStmt:
+		if n.Table != nil {
+			Walk(v, n.Table)
+		}
 	case *StringDef:
 		// No members to walk through.
 	case *SubqueryDef:
@@ -208,5 +212,9 @@ func Walk(v Visitor, node Node) {
 		for _, subquery := range n.SubqueryList {
 			Walk(v, subquery)
 		}
+	case *ValidateConstraintStmt:
+		if n.Table != nil {
+			Walk(v, n.Table)
+		}
 	}
 }
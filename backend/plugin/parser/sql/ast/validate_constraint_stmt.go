@@ -0,0 +1,9 @@
+package ast
+
+// ValidateConstraintStmt is the struct for validate constraint statement.
+type ValidateConstraintStmt struct {
+	node
+
+	Table          *TableDef
+	ConstraintName string
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 
 	pgquery "github.com/pganalyze/pg_query_go/v6"
 	"github.com/pkg/errors"
@@ -20,6 +21,7 @@ func convert(node *pgquery.Node, statement base.SingleSQL) (res ast.Node, err er
 		if err == nil && res != nil {
 			res.SetText(strings.TrimSpace(statement.Text))
 			res.SetLastLine(int(statement.End.Line))
+			res.SetByteOffset(statement.ByteOffsetStart + len(statement.Text) - len(strings.TrimLeftFunc(statement.Text, unicode.IsSpace)))
 			switch n := res.(type) {
 			case *ast.CreateTableStmt:
 				err = setLineForCreateTableStmt(n)
@@ -95,6 +97,20 @@ func convert(node *pgquery.Node, statement base.SingleSQL) (res ast.Node, err er
 					}
 
 					alterTable.AlterItemList = append(alterTable.AlterItemList, dropConstraint)
+				case pgquery.AlterTableType_AT_ValidateConstraint:
+					validateConstraint := &ast.ValidateConstraintStmt{
+						Table:          alterTable.Table,
+						ConstraintName: alterCmd.Name,
+					}
+
+					alterTable.AlterItemList = append(alterTable.AlterItemList, validateConstraint)
+				case pgquery.AlterTableType_AT_SetTableSpace:
+					setTablespace := &ast.SetTablespaceStmt{
+						Table:      alterTable.Table,
+						Tablespace: alterCmd.Name,
+					}
+
+					alterTable.AlterItemList = append(alterTable.AlterItemList, setTablespace)
 				case pgquery.AlterTableType_AT_SetNotNull:
 					setNotNull := &ast.SetNotNullStmt{
 						Table:      alterTable.Table,
@@ -3415,6 +3415,72 @@ func TestAttachPartition(t *testing.T) {
 	runTests(t, tests)
 }
 
+func TestValidateConstraint(t *testing.T) {
+	tests := []testData{
+		{
+			stmt: `ALTER TABLE tech_book VALIDATE CONSTRAINT fk_author`,
+			want: []ast.Node{
+				&ast.AlterTableStmt{
+					Table: &ast.TableDef{
+						Type: ast.TableTypeBaseTable,
+						Name: "tech_book",
+					},
+					AlterItemList: []ast.Node{
+						&ast.ValidateConstraintStmt{
+							Table: &ast.TableDef{
+								Type: ast.TableTypeBaseTable,
+								Name: "tech_book",
+							},
+							ConstraintName: "fk_author",
+						},
+					},
+				},
+			},
+			statementList: []base.SingleSQL{
+				{
+					Text: `ALTER TABLE tech_book VALIDATE CONSTRAINT fk_author`,
+					End:  &store.Position{Line: 1, Column: 0},
+				},
+			},
+		},
+	}
+
+	runTests(t, tests)
+}
+
+func TestSetTablespace(t *testing.T) {
+	tests := []testData{
+		{
+			stmt: `ALTER TABLE tech_book SET TABLESPACE fast_ssd`,
+			want: []ast.Node{
+				&ast.AlterTableStmt{
+					Table: &ast.TableDef{
+						Type: ast.TableTypeBaseTable,
+						Name: "tech_book",
+					},
+					AlterItemList: []ast.Node{
+						&ast.SetTablespaceStmt{
+							Table: &ast.TableDef{
+								Type: ast.TableTypeBaseTable,
+								Name: "tech_book",
+							},
+							Tablespace: "fast_ssd",
+						},
+					},
+				},
+			},
+			statementList: []base.SingleSQL{
+				{
+					Text: `ALTER TABLE tech_book SET TABLESPACE fast_ssd`,
+					End:  &store.Position{Line: 1, Column: 0},
+				},
+			},
+		},
+	}
+
+	runTests(t, tests)
+}
+
 func TestCommit(t *testing.T) {
 	tests := []testData{
 		{
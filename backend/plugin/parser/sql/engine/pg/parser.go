@@ -82,7 +82,14 @@ func splitSQL(statement string) ([]base.SingleSQL, error) {
 		return nil, err
 	}
 	var results []base.SingleSQL
+	// The statements are consecutive in the original statement, separated by the blanks only.
+	offset := 0
 	for _, sql := range list {
+		if index := strings.Index(statement[offset:], sql.Text); index >= 0 {
+			sql.ByteOffsetStart = offset + index
+			sql.ByteOffsetEnd = sql.ByteOffsetStart + len(sql.Text)
+			offset = sql.ByteOffsetEnd
+		}
 		if sql.Empty {
 			continue
 		}
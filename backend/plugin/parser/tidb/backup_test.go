@@ -101,6 +101,15 @@ func buildFixedMockDatabaseMetadataGetterAndLister() (base.GetDatabaseMetadataFu
 							},
 						},
 					},
+					Indexes: []*store.IndexMetadata{
+						{
+							Name:        "PRIMARY",
+							Primary:     true,
+							Unique:      true,
+							Expressions: []string{"a"},
+						},
+					},
+					PrimaryKeyType: "CLUSTERED",
 				},
 				{
 					Name: "t1",
@@ -115,6 +124,15 @@ func buildFixedMockDatabaseMetadataGetterAndLister() (base.GetDatabaseMetadataFu
 							Name: "c",
 						},
 					},
+					Indexes: []*store.IndexMetadata{
+						{
+							Name:        "PRIMARY",
+							Primary:     true,
+							Unique:      true,
+							Expressions: []string{"a"},
+						},
+					},
+					PrimaryKeyType: "NONCLUSTERED",
 				},
 				{
 					Name: "t2",
@@ -129,6 +147,15 @@ func buildFixedMockDatabaseMetadataGetterAndLister() (base.GetDatabaseMetadataFu
 							Name: "c",
 						},
 					},
+					Indexes: []*store.IndexMetadata{
+						{
+							Name:        "PRIMARY",
+							Primary:     true,
+							Unique:      true,
+							Expressions: []string{"a", "b"},
+						},
+					},
+					PrimaryKeyType: "CLUSTERED",
 				},
 				{
 					Name: "test",
@@ -158,6 +185,55 @@ func buildFixedMockDatabaseMetadataGetterAndLister() (base.GetDatabaseMetadataFu
 						},
 					},
 				},
+				{
+					Name: "t_unique",
+					Columns: []*store.ColumnMetadata{
+						{
+							Name: "a",
+						},
+						{
+							Name: "b",
+						},
+						{
+							Name: "c",
+						},
+					},
+					Indexes: []*store.IndexMetadata{
+						{
+							Name:        "PRIMARY",
+							Primary:     true,
+							Unique:      true,
+							Expressions: []string{"a", "b"},
+						},
+						{
+							Name:        "uk_c",
+							Unique:      true,
+							Expressions: []string{"c"},
+						},
+					},
+					PrimaryKeyType: "CLUSTERED",
+				},
+				{
+					Name: "t_auto_random",
+					Columns: []*store.ColumnMetadata{
+						{
+							Name:    "id",
+							Default: "AUTO_RANDOM(5)",
+						},
+						{
+							Name: "name",
+						},
+					},
+					Indexes: []*store.IndexMetadata{
+						{
+							Name:        "PRIMARY",
+							Primary:     true,
+							Unique:      true,
+							Expressions: []string{"id"},
+						},
+					},
+					PrimaryKeyType: "CLUSTERED",
+				},
 			},
 		},
 	}
@@ -0,0 +1,384 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/mysql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	maxCommentLength = 1000
+
+	clusteredPrimaryKeyType = "CLUSTERED"
+	autoRandomPrefix        = "AUTO_RANDOM"
+)
+
+func init() {
+	base.RegisterGenerateRestoreSQL(store.Engine_TIDB, GenerateRestoreSQL)
+}
+
+// GenerateRestoreSQL generates the SQL restoring the rows changed by the statement from the backup table.
+func GenerateRestoreSQL(ctx context.Context, rCtx base.RestoreContext, statement string, backupItem *store.PriorBackupDetail_Item) (string, error) {
+	originalSQL, err := extractSingleSQL(statement, backupItem)
+	if err != nil {
+		return "", errors.Errorf("failed to extract single SQL: %v", err)
+	}
+
+	parseResult, err := mysql.ParseMySQL(originalSQL)
+	if err != nil {
+		return "", err
+	}
+
+	if len(parseResult) == 0 {
+		return "", errors.Errorf("no parse result")
+	}
+
+	// We only need the first parse result.
+	// There are two cases:
+	// 1. The statement is a single SQL statement.
+	// 2. The statement is a multi SQL statement, but all SQL statements' backup is in the same table.
+	//    So we only need to restore the first SQL statement.
+	sqlForComment, truncated := common.TruncateString(originalSQL, maxCommentLength)
+	if truncated {
+		sqlForComment += "..."
+	}
+	return doGenerate(ctx, rCtx, sqlForComment, parseResult[0], backupItem)
+}
+
+func doGenerate(ctx context.Context, rCtx base.RestoreContext, sqlForComment string, parseResult *mysql.ParseResult, backupItem *store.PriorBackupDetail_Item) (string, error) {
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+	_, targetDatabase, err := common.GetInstanceDatabaseID(backupItem.TargetTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get target database ID for %s", backupItem.TargetTable.Database)
+	}
+	generatedColumns, normalColumns, err := classifyColumns(ctx, rCtx.GetDatabaseMetadataFunc, rCtx.ListDatabaseNamesFunc, rCtx.IsCaseSensitive, rCtx.InstanceID, &TableReference{
+		Database: sourceDatabase,
+		Table:    backupItem.SourceTable.Table,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to classify columns for %s.%s", backupItem.SourceTable.Database, backupItem.SourceTable.Table)
+	}
+	tableMetadata, err := getTableMetadata(ctx, rCtx, sourceDatabase, backupItem.SourceTable.Table)
+	if err != nil {
+		return "", err
+	}
+
+	g := &generator{
+		backupDatabase:   targetDatabase,
+		backupTable:      backupItem.TargetTable.Table,
+		originalDatabase: sourceDatabase,
+		originalTable:    backupItem.SourceTable.Table,
+		table:            tableMetadata,
+		generatedColumns: generatedColumns,
+		normalColumns:    normalColumns,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(g, parseResult.Tree)
+	if g.err != nil {
+		return "", g.err
+	}
+	var buf strings.Builder
+	if _, err := fmt.Fprintf(&buf, "/*\nOriginal SQL:\n%s\n*/\n", sqlForComment); err != nil {
+		return "", err
+	}
+	if hasAutoRandomColumn(tableMetadata) {
+		// TiDB rejects the explicit values for the AUTO_RANDOM columns by default.
+		if _, err := buf.WriteString("SET @@allow_auto_random_explicit_insert = true;\n"); err != nil {
+			return "", err
+		}
+	}
+	if _, err := buf.WriteString(g.result); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func getTableMetadata(ctx context.Context, rCtx base.RestoreContext, database, table string) (*model.TableMetadata, error) {
+	if rCtx.GetDatabaseMetadataFunc == nil {
+		return nil, errors.Errorf("GetDatabaseMetadataFunc is nil")
+	}
+
+	_, metadata, err := rCtx.GetDatabaseMetadataFunc(ctx, rCtx.InstanceID, database)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database metadata for %s", database)
+	}
+	if metadata == nil {
+		return nil, errors.Errorf("database metadata is nil for %s", database)
+	}
+
+	schema := metadata.GetSchema("")
+	if schema == nil {
+		return nil, errors.Errorf("schema is nil for %s", database)
+	}
+
+	tableMetadata := schema.GetTable(table)
+	if tableMetadata == nil {
+		return nil, errors.Errorf("table metadata is nil for %s.%s", database, table)
+	}
+	return tableMetadata, nil
+}
+
+// hasAutoRandomColumn returns true if the table has the AUTO_RANDOM column, which is only allowed in the clustered primary key.
+func hasAutoRandomColumn(table *model.TableMetadata) bool {
+	if !strings.EqualFold(table.GetProto().PrimaryKeyType, clusteredPrimaryKeyType) {
+		return false
+	}
+	for _, column := range table.GetColumns() {
+		if strings.HasPrefix(strings.ToUpper(column.GetDefault()), autoRandomPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func extractSingleSQL(statement string, backupItem *store.PriorBackupDetail_Item) (string, error) {
+	if backupItem == nil {
+		return "", errors.Errorf("backup item is nil")
+	}
+
+	list, err := mysql.SplitSQL(statement)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to split sql")
+	}
+
+	start := 0
+	end := len(list) - 1
+	for i, item := range list {
+		if equalOrLess(item.Start, backupItem.StartPosition) {
+			start = i
+		}
+	}
+
+	for i := len(list) - 1; i >= 0; i-- {
+		if equalOrGreater(list[i].Start, backupItem.EndPosition) {
+			end = i
+		}
+	}
+
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+
+	var result []string
+	// We only need statements that contain the source table.
+	for i := start; i <= end; i++ {
+		if list[i].Empty {
+			continue
+		}
+		parseResult, err := mysql.ParseMySQL(list[i].Text)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse sql")
+		}
+		containsSourceTable := false
+		for _, sql := range parseResult {
+			tables, err := extractTables(sourceDatabase, sql, i)
+			if err != nil {
+				return "", errors.Wrap(err, "failed to extract tables")
+			}
+			for _, table := range tables {
+				if table.table.Database == sourceDatabase && table.table.Table == backupItem.SourceTable.Table {
+					containsSourceTable = true
+					break
+				}
+			}
+			if containsSourceTable {
+				break
+			}
+		}
+		if containsSourceTable {
+			result = append(result, list[i].Text)
+		}
+	}
+	return strings.Join(result, ""), nil
+}
+
+func equalOrLess(a, b *store.Position) bool {
+	if a.Line < b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column <= b.Column {
+		return true
+	}
+	return false
+}
+
+func equalOrGreater(a, b *store.Position) bool {
+	if a.Line > b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column >= b.Column {
+		return true
+	}
+	return false
+}
+
+type generator struct {
+	*parser.BaseMySQLParserListener
+
+	backupDatabase   string
+	backupTable      string
+	originalDatabase string
+	originalTable    string
+	table            *model.TableMetadata
+	generatedColumns []string
+	normalColumns    []string
+	result           string
+	err              error
+}
+
+func (g *generator) EnterDeleteStatement(ctx *parser.DeleteStatementContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	g.result = g.generateInsertFromBackup("")
+}
+
+// generateInsertFromBackup generates the INSERT statement copying the rows from the backup table with the suffix.
+func (g *generator) generateInsertFromBackup(suffix string) string {
+	if len(g.generatedColumns) == 0 {
+		return fmt.Sprintf("INSERT INTO `%s`.`%s` SELECT * FROM `%s`.`%s`%s;", g.originalDatabase, g.originalTable, g.backupDatabase, g.backupTable, suffix)
+	}
+	var quotedColumns []string
+	for _, column := range g.normalColumns {
+		quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+	}
+	quotedColumnList := strings.Join(quotedColumns, ", ")
+	return fmt.Sprintf("INSERT INTO `%s`.`%s` (%s) SELECT %s FROM `%s`.`%s`%s;", g.originalDatabase, g.originalTable, quotedColumnList, quotedColumnList, g.backupDatabase, g.backupTable, suffix)
+}
+
+// checkDisjointUniqueKey checks whether there is a primary key or unique key not updated by the statement,
+// so ON DUPLICATE KEY UPDATE can locate the updated rows by the backup.
+func (g *generator) checkDisjointUniqueKey(updateColumns []string) error {
+	columnMap := make(map[string]bool)
+	for _, column := range updateColumns {
+		columnMap[strings.ToLower(column)] = true
+	}
+
+	// The non-clustered primary key is a unique secondary index in TiDB, so it is treated as the unique key.
+	for _, index := range g.table.GetProto().Indexes {
+		if !index.Primary && !index.Unique {
+			continue
+		}
+		if disjoint(index.Expressions, columnMap) {
+			return nil
+		}
+	}
+
+	if g.table.GetPrimaryKey() == nil {
+		// The rows are located by the hidden _tidb_rowid, which is not backed up.
+		return errors.Errorf("no disjoint unique key found for %s.%s, and the table without primary key cannot be restored by the hidden row ID", g.originalDatabase, g.originalTable)
+	}
+	if strings.EqualFold(g.table.GetProto().PrimaryKeyType, clusteredPrimaryKeyType) {
+		return errors.Errorf("no disjoint unique key found for %s.%s, and the statement updates the clustered primary key", g.originalDatabase, g.originalTable)
+	}
+	return errors.Errorf("no disjoint unique key found for %s.%s", g.originalDatabase, g.originalTable)
+}
+
+func disjoint(a []string, b map[string]bool) bool {
+	for _, item := range a {
+		if _, ok := b[strings.ToLower(item)]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) EnterUpdateStatement(ctx *parser.UpdateStatementContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	singleTables := &singleTableListener{
+		databaseName: g.originalDatabase,
+		singleTables: make(map[string]*TableReference),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(singleTables, ctx.TableReferenceList())
+
+	updateItems := &updateItemListener{
+		database:      g.originalDatabase,
+		normalColumns: g.normalColumns,
+	}
+	for _, table := range singleTables.singleTables {
+		if strings.EqualFold(table.Table, g.originalTable) {
+			updateItems.table = table
+			break
+		}
+	}
+	if updateItems.table == nil {
+		g.err = errors.Errorf("cannot find table %s.%s in the update statement", g.originalDatabase, g.originalTable)
+		return
+	}
+	antlr.ParseTreeWalkerDefault.Walk(updateItems, ctx.UpdateList())
+
+	if err := g.checkDisjointUniqueKey(updateItems.result); err != nil {
+		g.err = err
+		return
+	}
+
+	var buf strings.Builder
+	if _, err := buf.WriteString(" ON DUPLICATE KEY UPDATE "); err != nil {
+		g.err = err
+		return
+	}
+	for i, field := range updateItems.result {
+		if i > 0 {
+			if _, err := buf.WriteString(", "); err != nil {
+				g.err = err
+				return
+			}
+		}
+
+		if _, err := fmt.Fprintf(&buf, "`%s` = VALUES(`%s`)", field, field); err != nil {
+			g.err = err
+			return
+		}
+	}
+	g.result = g.generateInsertFromBackup(buf.String())
+}
+
+type updateItemListener struct {
+	*parser.BaseMySQLParserListener
+
+	normalColumns []string
+	database      string
+	table         *TableReference
+	result        []string
+}
+
+func (l *updateItemListener) EnterUpdateElement(ctx *parser.UpdateElementContext) {
+	database, table, column := mysql.NormalizeMySQLColumnRef(ctx.ColumnRef())
+
+	if database != "" && !strings.EqualFold(database, l.database) {
+		return
+	}
+
+	if table == "" {
+		for _, c := range l.normalColumns {
+			if strings.EqualFold(c, column) {
+				l.result = append(l.result, column)
+				return
+			}
+		}
+		return
+	}
+
+	if l.table.Alias == table || strings.EqualFold(l.table.Table, table) {
+		l.result = append(l.result, column)
+		return
+	}
+}
@@ -0,0 +1,117 @@
+package tidb
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+type restoreCase struct {
+	Input            string
+	BackupDatabase   string
+	BackupTable      string
+	OriginalDatabase string
+	OriginalTable    string
+	Result           string
+}
+
+func TestRestore(t *testing.T) {
+	tests := []restoreCase{}
+
+	const (
+		record = false
+	)
+	var (
+		filepath = "test-data/test_restore.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(yamlFile.Close())
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	for i, t := range tests {
+		result, err := generateRestoreSQLForTest(t.Input, t.OriginalDatabase, t.OriginalTable, t.BackupDatabase, t.BackupTable)
+		a.NoError(err)
+
+		if record {
+			tests[i].Result = result
+		} else {
+			a.Equal(t.Result, result, t.Input)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
+
+func TestRestoreWithoutDisjointUniqueKey(t *testing.T) {
+	tests := []struct {
+		input   string
+		table   string
+		wantErr string
+	}{
+		{
+			input:   "UPDATE t2 SET a = 1 WHERE c = 1;",
+			table:   "t2",
+			wantErr: "the statement updates the clustered primary key",
+		},
+		{
+			input:   "UPDATE t1 SET a = 1 WHERE c = 1;",
+			table:   "t1",
+			wantErr: "no disjoint unique key found for db.t1",
+		},
+		{
+			input:   "UPDATE test SET a = 1 WHERE c = 1;",
+			table:   "test",
+			wantErr: "the table without primary key cannot be restored by the hidden row ID",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := generateRestoreSQLForTest(test.input, "db", test.table, "bbarchive", "prefix_"+test.table)
+		a.ErrorContains(err, test.wantErr, test.input)
+	}
+}
+
+func generateRestoreSQLForTest(statement, originalDatabase, originalTable, backupDatabase, backupTable string) (string, error) {
+	getter, lister := buildFixedMockDatabaseMetadataGetterAndLister()
+	return GenerateRestoreSQL(context.Background(), base.RestoreContext{
+		GetDatabaseMetadataFunc: getter,
+		ListDatabaseNamesFunc:   lister,
+		IsCaseSensitive:         false,
+	}, statement, &store.PriorBackupDetail_Item{
+		SourceTable: &store.PriorBackupDetail_Item_Table{
+			Database: "instances/i1/databases/" + originalDatabase,
+			Table:    originalTable,
+		},
+		TargetTable: &store.PriorBackupDetail_Item_Table{
+			Database: "instances/i1/databases/" + backupDatabase,
+			Table:    backupTable,
+		},
+		StartPosition: &store.Position{
+			Line:   0,
+			Column: 0,
+		},
+		EndPosition: &store.Position{
+			Line:   math.MaxInt32,
+			Column: 0,
+		},
+	})
+}
@@ -200,3 +200,45 @@ func TestMain(m *testing.M) {
 	code := m.Run()
 	os.Exit(code)
 }
+
+func TestParseTrinoStatementsDDL(t *testing.T) {
+	statement := `CREATE TABLE IF NOT EXISTS hive.web.page_views (
+  view_time TIMESTAMP(3) WITH TIME ZONE,
+  user_id BIGINT NOT NULL COMMENT 'the user id',
+  page_url VARCHAR,
+  props MAP(VARCHAR, VARCHAR),
+  ds DATE
+)
+COMMENT 'page views'
+WITH (format = 'ORC', partitioned_by = ARRAY['ds']);
+ALTER TABLE hive.web.page_views ADD COLUMN IF NOT EXISTS country VARCHAR;
+ALTER TABLE hive.web.page_views RENAME COLUMN page_url TO url;
+CREATE OR REPLACE VIEW hive.web.daily AS
+SELECT ds, count(*) AS c FROM hive.web.page_views GROUP BY ds;
+CREATE TABLE hive.web.page_views_2024 AS SELECT * FROM hive.web.page_views WHERE ds >= DATE '2024-01-01';
+DROP TABLE IF EXISTS hive.web.page_views_tmp;`
+	result, err := ParseTrinoStatements(statement)
+	require.NoError(t, err)
+	require.NotNil(t, result.Tree)
+
+	// The parse failures are reported as syntax errors at the line of the failure.
+	tests := []struct {
+		statement string
+		line      int32
+	}{
+		{
+			statement: "CREATE TABLE hive.web.t (\n  id BIGINT\n  name VARCHAR\n)",
+			line:      2,
+		},
+		{
+			statement: "ALTER TABLE hive.web.t ADD COLUMN country VARCHAR;\nALTER TABLE hive.web.t DROP COLUMN;",
+			line:      1,
+		},
+	}
+	for _, test := range tests {
+		_, err := ParseTrinoStatements(test.statement)
+		syntaxErr, ok := err.(*base.SyntaxError)
+		require.True(t, ok, test.statement)
+		require.Equal(t, test.line, syntaxErr.Position.Line, test.statement)
+	}
+}
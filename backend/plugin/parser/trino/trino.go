@@ -19,6 +19,15 @@ type ParseResult struct {
 
 // ParseTrino parses the given SQL and returns the ParseResult.
 func ParseTrino(sql string) (*ParseResult, error) {
+	return parseTrino(sql, false /* multiStatements */)
+}
+
+// ParseTrinoStatements parses the given SQL containing multiple statements separated by semicolons and returns the ParseResult.
+func ParseTrinoStatements(sql string) (*ParseResult, error) {
+	return parseTrino(sql, true /* multiStatements */)
+}
+
+func parseTrino(sql string, multiStatements bool) (*ParseResult, error) {
 	// Add a semicolon if it's missing to allow users to omit the semicolon
 	trimmedSQL := strings.TrimRightFunc(sql, unicode.IsSpace)
 	if len(trimmedSQL) > 0 && !strings.HasSuffix(trimmedSQL, ";") {
@@ -46,7 +55,12 @@ func ParseTrino(sql string) (*ParseResult, error) {
 	p.BuildParseTrees = true
 
 	// Parse the statement
-	tree := p.SingleStatement()
+	var tree antlr.Tree
+	if multiStatements {
+		tree = p.Parse()
+	} else {
+		tree = p.SingleStatement()
+	}
 
 	// Check for errors
 	if lexerErrorListener.Err != nil {
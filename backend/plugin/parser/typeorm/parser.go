@@ -2,40 +2,119 @@
 package typeorm
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
 )
 
+var queryRegex = regexp.MustCompile(`queryRunner\.query\(\s*`)
+
+// Statement is a SQL statement extracted from the typescript migration file.
+type Statement struct {
+	// Text is the SQL statement without quotes.
+	Text string
+	// Line is the zero-based line of the statement in the typescript migration file.
+	Line int
+}
+
+// SyntaxError is the error of a queryRunner.query() call whose statement cannot be extracted.
+type SyntaxError struct {
+	// Line is the zero-based line of the statement in the typescript migration file.
+	Line    int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line+1, e.Message)
+}
+
 // Parse parses the typescript migration file.
 func Parse(s string) ([]string, error) {
+	statements, err := ParseStatements(s)
+	if err != nil {
+		return nil, err
+	}
+	var stmts []string
+	for _, statement := range statements {
+		stmts = append(stmts, statement.Text)
+	}
+	return stmts, nil
+}
+
+// ParseStatements parses the typescript migration file, and returns the statements with their positions.
+func ParseStatements(s string) ([]*Statement, error) {
 	upIndex := strings.Index(s, "public async up(")
 	if upIndex < 0 {
 		return nil, errors.Errorf("unable to find up() function")
 	}
-	s = s[upIndex:]
-	downIndex := strings.Index(s, "public async down(")
+	up := s[upIndex:]
+	downIndex := strings.Index(up, "public async down(")
 	if downIndex >= 0 {
-		s = s[:downIndex]
+		up = up[:downIndex]
 	}
-	pattern := `await queryRunner\.query\(\s*(.+?)\s*[,]?\s*\);`
-
-	// Compile the regular expression
-	re := regexp.MustCompile(pattern)
 
 	// Find all matched statements
-	matches := re.FindAllStringSubmatch(s, -1)
-	var stmts []string
+	matches := queryRegex.FindAllStringIndex(up, -1)
+	var stmts []*Statement
 	for _, m := range matches {
-		stmt := m[1]
-		if len(stmt) <= 2 {
-			return nil, errors.Errorf("invalid statement %s", stmt)
+		line := strings.Count(s[:upIndex+m[1]], "\n")
+		stmt, err := extractStringLiteral(up[m[1]:])
+		if err != nil {
+			return nil, &SyntaxError{Line: line, Message: err.Error()}
 		}
-		if stmt[0] != stmt[len(stmt)-1] {
-			return nil, errors.Errorf("invalid statement %s", stmt)
+		if strings.TrimSpace(stmt) == "" {
+			return nil, &SyntaxError{Line: line, Message: "empty statement"}
 		}
-		stmts = append(stmts, stmt[1:len(stmt)-1])
+		stmts = append(stmts, &Statement{
+			Text: stmt,
+			Line: line,
+		})
 	}
 	return stmts, nil
 }
+
+// extractStringLiteral returns the content of the string literal at the beginning of s.
+// The template literals can span multiple lines, but the placeholders cannot be resolved statically.
+func extractStringLiteral(s string) (string, error) {
+	if len(s) == 0 {
+		return "", errors.New("expected a string literal, but got EOF")
+	}
+	quote := s[0]
+	if quote != '\'' && quote != '"' && quote != '`' {
+		return "", errors.Errorf("expected a string literal, but got %q", strings.SplitN(s, "\n", 2)[0])
+	}
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote:
+			return sb.String(), nil
+		case c == '\\':
+			i++
+			if i == len(s) {
+				return "", errors.New("unterminated string literal")
+			}
+			switch s[i] {
+			case 'n':
+				_ = sb.WriteByte('\n')
+			case 'r':
+				_ = sb.WriteByte('\r')
+			case 't':
+				_ = sb.WriteByte('\t')
+			case '\n':
+				// Line continuation.
+			default:
+				_ = sb.WriteByte(s[i])
+			}
+		case c == '\n' && quote != '`':
+			return "", errors.New("unterminated string literal")
+		case c == '$' && quote == '`' && i+1 < len(s) && s[i+1] == '{':
+			return "", errors.New("template literal placeholders are not supported")
+		default:
+			_ = sb.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated string literal")
+}
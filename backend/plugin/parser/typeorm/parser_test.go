@@ -57,3 +57,74 @@ func TestParse(t *testing.T) {
 		require.Equal(t, tst.want, got)
 	}
 }
+
+func TestParseStatements(t *testing.T) {
+	for _, tst := range []struct {
+		filename string
+		want     []int
+	}{
+		{"core_table.ts", []int{7, 10, 13, 16, 19}},
+		{"update_user_table.ts", []int{6, 7}},
+	} {
+		bytes, err := os.ReadFile(path.Join("test-data", tst.filename))
+		require.NoError(t, err)
+		got, err := ParseStatements(string(bytes))
+		require.NoError(t, err)
+		var lines []int
+		for _, stmt := range got {
+			lines = append(lines, stmt.Line)
+		}
+		require.Equal(t, tst.want, lines, tst.filename)
+	}
+}
+
+func TestParseStatementsMultiLine(t *testing.T) {
+	content := "export class AddUser1700000000000 implements MigrationInterface {\n" +
+		"  public async up(queryRunner: QueryRunner): Promise<void> {\n" +
+		"    await queryRunner.query(`\n" +
+		"      CREATE TABLE \"user\" (\n" +
+		"        \"id\" int NOT NULL\n" +
+		"      )\n" +
+		"    `);\n" +
+		"    await queryRunner.query('ALTER TABLE \"user\" ADD \"name\" varchar NOT NULL DEFAULT \\'\\'', []);\n" +
+		"  }\n" +
+		"}\n"
+	got, err := ParseStatements(content)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "\n      CREATE TABLE \"user\" (\n        \"id\" int NOT NULL\n      )\n    ", got[0].Text)
+	require.Equal(t, 2, got[0].Line)
+	require.Equal(t, `ALTER TABLE "user" ADD "name" varchar NOT NULL DEFAULT ''`, got[1].Text)
+	require.Equal(t, 7, got[1].Line)
+}
+
+func TestParseStatementsError(t *testing.T) {
+	for _, tst := range []struct {
+		content string
+		line    int
+	}{
+		{
+			"  public async up(queryRunner: QueryRunner): Promise<void> {\n" +
+				"    await queryRunner.query(`CREATE TABLE ${table} (id int)`);\n" +
+				"  }\n",
+			1,
+		},
+		{
+			"  public async up(queryRunner: QueryRunner): Promise<void> {\n" +
+				"    const sql = 'SELECT 1';\n" +
+				"    await queryRunner.query(sql);\n" +
+				"  }\n",
+			2,
+		},
+		{
+			"  public async up(queryRunner: QueryRunner): Promise<void> {\n" +
+				"    await queryRunner.query(`CREATE TABLE t (id int)\n",
+			1,
+		},
+	} {
+		_, err := ParseStatements(tst.content)
+		var syntaxErr *SyntaxError
+		require.ErrorAs(t, err, &syntaxErr, tst.content)
+		require.Equal(t, tst.line, syntaxErr.Line, tst.content)
+	}
+}
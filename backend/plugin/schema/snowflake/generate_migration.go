@@ -0,0 +1,645 @@
+package snowflake
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_SNOWFLAKE, generateMigration)
+}
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// Safe order for migrations:
+	// 1. Drop dependent objects first (in reverse dependency order)
+	// 2. Create/Alter objects (in dependency order)
+	dropObjectsInOrder(diff, &buf)
+
+	dropPhaseHasContent := buf.Len() > 0
+	var createBuf strings.Builder
+	if err := createObjectsInOrder(diff, &createBuf); err != nil {
+		return "", err
+	}
+	if dropPhaseHasContent && createBuf.Len() > 0 {
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(createBuf.String())
+
+	return buf.String(), nil
+}
+
+// dropObjectsInOrder drops all objects in reverse topological order (most dependent first).
+func dropObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) {
+	// Drop the foreign keys of the altered tables first, they might reference the tables being dropped.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, fkDiff := range tableDiff.ForeignKeyChanges {
+			if fkDiff.Action == schema.MetadataDiffActionDrop {
+				writeDropConstraint(buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.OldForeignKey.Name)
+			}
+		}
+	}
+
+	graph := parserbase.NewGraph()
+	viewMap := make(map[string]*schema.ViewDiff)
+	materializedViewMap := make(map[string]*schema.MaterializedViewDiff)
+	tableMap := make(map[string]*schema.TableDiff)
+	functionMap := make(map[string]*schema.FunctionDiff)
+	procedureMap := make(map[string]*schema.ProcedureDiff)
+	allObjects := make(map[string]bool)
+
+	// Snowflake doesn't support altering the view query, so the altered views are recreated.
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop || viewDiff.Action == schema.MetadataDiffActionAlter {
+			viewID := getMigrationObjectID(viewDiff.SchemaName, viewDiff.ViewName)
+			graph.AddNode(viewID)
+			viewMap[viewID] = viewDiff
+			allObjects[viewID] = true
+		}
+	}
+	for _, mvDiff := range diff.MaterializedViewChanges {
+		if mvDiff.Action == schema.MetadataDiffActionDrop || mvDiff.Action == schema.MetadataDiffActionAlter {
+			mvID := getMigrationObjectID(mvDiff.SchemaName, mvDiff.MaterializedViewName)
+			graph.AddNode(mvID)
+			materializedViewMap[mvID] = mvDiff
+			allObjects[mvID] = true
+		}
+	}
+	for _, funcDiff := range diff.FunctionChanges {
+		if funcDiff.Action == schema.MetadataDiffActionDrop {
+			funcID := getFunctionObjectID(funcDiff.SchemaName, funcDiff.OldFunction)
+			graph.AddNode(funcID)
+			functionMap[funcID] = funcDiff
+			allObjects[funcID] = true
+		}
+	}
+	for _, procDiff := range diff.ProcedureChanges {
+		if procDiff.Action == schema.MetadataDiffActionDrop || procDiff.Action == schema.MetadataDiffActionAlter {
+			procID := getMigrationObjectID(procDiff.SchemaName, procDiff.ProcedureName)
+			graph.AddNode(procID)
+			procedureMap[procID] = procDiff
+			allObjects[procID] = true
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionDrop {
+			tableID := getMigrationObjectID(tableDiff.SchemaName, tableDiff.TableName)
+			graph.AddNode(tableID)
+			tableMap[tableID] = tableDiff
+			allObjects[tableID] = true
+		}
+	}
+
+	// Add the edges from the dependent objects to their dependencies.
+	for viewID, viewDiff := range viewMap {
+		for _, dep := range viewDiff.OldView.GetDependencyColumns() {
+			depID := getMigrationObjectID(dep.Schema, dep.Table)
+			if allObjects[depID] && depID != viewID {
+				graph.AddEdge(viewID, depID)
+			}
+		}
+	}
+	for mvID, mvDiff := range materializedViewMap {
+		for _, dep := range mvDiff.OldMaterializedView.GetDependencyColumns() {
+			depID := getMigrationObjectID(dep.Schema, dep.Table)
+			if allObjects[depID] && depID != mvID {
+				graph.AddEdge(mvID, depID)
+			}
+		}
+	}
+	for funcID, funcDiff := range functionMap {
+		for _, dep := range funcDiff.OldFunction.GetDependencyTables() {
+			depID := getMigrationObjectID(dep.Schema, dep.Table)
+			if allObjects[depID] {
+				graph.AddEdge(funcID, depID)
+			}
+		}
+	}
+	for tableID, tableDiff := range tableMap {
+		for _, fk := range tableDiff.OldTable.GetForeignKeys() {
+			depID := getMigrationObjectID(fk.ReferencedSchema, fk.ReferencedTable)
+			if allObjects[depID] && depID != tableID {
+				graph.AddEdge(tableID, depID)
+			}
+		}
+	}
+
+	writeDrop := func(objID string) {
+		if viewDiff, ok := viewMap[objID]; ok {
+			writeDropView(buf, viewDiff.SchemaName, viewDiff.ViewName)
+		} else if mvDiff, ok := materializedViewMap[objID]; ok {
+			writeDropMaterializedView(buf, mvDiff.SchemaName, mvDiff.MaterializedViewName)
+		} else if funcDiff, ok := functionMap[objID]; ok {
+			writeDropFunction(buf, funcDiff.SchemaName, funcDiff.OldFunction)
+		} else if procDiff, ok := procedureMap[objID]; ok {
+			writeDropProcedure(buf, procDiff.SchemaName, procDiff.OldProcedure)
+		} else if tableDiff, ok := tableMap[objID]; ok {
+			writeDropTable(buf, tableDiff.SchemaName, tableDiff.TableName)
+		}
+	}
+	orderedList, err := graph.TopologicalSort()
+	if err != nil {
+		// If there's a cycle, fall back to the order of views, functions, procedures and tables.
+		// Snowflake doesn't enforce the foreign keys, so the tables can be dropped in any order.
+		for _, objIDs := range [][]string{
+			sortedKeys(viewMap),
+			sortedKeys(materializedViewMap),
+			sortedKeys(functionMap),
+			sortedKeys(procedureMap),
+			sortedKeys(tableMap),
+		} {
+			for _, objID := range objIDs {
+				writeDrop(objID)
+			}
+		}
+	} else {
+		for _, objID := range orderedList {
+			writeDrop(objID)
+		}
+	}
+
+	// Drop the constraints and columns of the altered tables.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, indexDiff := range tableDiff.IndexChanges {
+			if indexDiff.Action == schema.MetadataDiffActionDrop {
+				writeDropIndex(buf, tableDiff.SchemaName, tableDiff.TableName, indexDiff.OldIndex)
+			}
+		}
+		for _, colDiff := range tableDiff.ColumnChanges {
+			if colDiff.Action == schema.MetadataDiffActionDrop {
+				writeDropColumn(buf, tableDiff.SchemaName, tableDiff.TableName, colDiff.OldColumn.Name)
+			}
+		}
+	}
+
+	// Drop the sequences after the tables, they might be used in the column defaults.
+	for _, seqDiff := range diff.SequenceChanges {
+		if seqDiff.Action == schema.MetadataDiffActionDrop {
+			writeDropSequence(buf, seqDiff.SchemaName, seqDiff.SequenceName)
+		}
+	}
+
+	// Dropping a schema drops all objects in it.
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionDrop {
+			writeDropSchema(buf, schemaDiff.SchemaName)
+		}
+	}
+}
+
+// createObjectsInOrder creates all objects in topological order (dependencies first).
+func createObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) error {
+	var schemasToCreate []string
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionCreate {
+			schemasToCreate = append(schemasToCreate, schemaDiff.SchemaName)
+		}
+	}
+	slices.Sort(schemasToCreate)
+	for _, schemaName := range schemasToCreate {
+		writeCreateSchema(buf, schemaName)
+	}
+
+	// Create the sequences before the tables, they might be used in the column defaults.
+	for _, seqDiff := range diff.SequenceChanges {
+		if seqDiff.Action == schema.MetadataDiffActionCreate {
+			writeCreateSequence(buf, seqDiff.SchemaName, seqDiff.NewSequence)
+		}
+	}
+
+	graph := parserbase.NewGraph()
+	viewMap := make(map[string]*schema.ViewDiff)
+	materializedViewMap := make(map[string]*schema.MaterializedViewDiff)
+	tableMap := make(map[string]*schema.TableDiff)
+	functionMap := make(map[string]*schema.FunctionDiff)
+	procedureMap := make(map[string]*schema.ProcedureDiff)
+	allObjects := make(map[string]bool)
+
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionCreate {
+			tableID := getMigrationObjectID(tableDiff.SchemaName, tableDiff.TableName)
+			graph.AddNode(tableID)
+			tableMap[tableID] = tableDiff
+			allObjects[tableID] = true
+		}
+	}
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			viewID := getMigrationObjectID(viewDiff.SchemaName, viewDiff.ViewName)
+			graph.AddNode(viewID)
+			viewMap[viewID] = viewDiff
+			allObjects[viewID] = true
+		}
+	}
+	for _, mvDiff := range diff.MaterializedViewChanges {
+		if mvDiff.Action == schema.MetadataDiffActionCreate || mvDiff.Action == schema.MetadataDiffActionAlter {
+			mvID := getMigrationObjectID(mvDiff.SchemaName, mvDiff.MaterializedViewName)
+			graph.AddNode(mvID)
+			materializedViewMap[mvID] = mvDiff
+			allObjects[mvID] = true
+		}
+	}
+	for _, funcDiff := range diff.FunctionChanges {
+		if funcDiff.Action == schema.MetadataDiffActionCreate {
+			funcID := getFunctionObjectID(funcDiff.SchemaName, funcDiff.NewFunction)
+			graph.AddNode(funcID)
+			functionMap[funcID] = funcDiff
+			allObjects[funcID] = true
+		}
+	}
+	for _, procDiff := range diff.ProcedureChanges {
+		if procDiff.Action == schema.MetadataDiffActionCreate || procDiff.Action == schema.MetadataDiffActionAlter {
+			procID := getMigrationObjectID(procDiff.SchemaName, procDiff.ProcedureName)
+			graph.AddNode(procID)
+			procedureMap[procID] = procDiff
+			allObjects[procID] = true
+		}
+	}
+
+	// Add the edges from the dependencies to the dependent objects.
+	for viewID, viewDiff := range viewMap {
+		for _, dep := range viewDiff.NewView.GetDependencyColumns() {
+			depID := getMigrationObjectID(dep.Schema, dep.Table)
+			if allObjects[depID] && depID != viewID {
+				graph.AddEdge(depID, viewID)
+			}
+		}
+	}
+	for mvID, mvDiff := range materializedViewMap {
+		for _, dep := range mvDiff.NewMaterializedView.GetDependencyColumns() {
+			depID := getMigrationObjectID(dep.Schema, dep.Table)
+			if allObjects[depID] && depID != mvID {
+				graph.AddEdge(depID, mvID)
+			}
+		}
+	}
+	for funcID, funcDiff := range functionMap {
+		for _, dep := range funcDiff.NewFunction.GetDependencyTables() {
+			depID := getMigrationObjectID(dep.Schema, dep.Table)
+			if allObjects[depID] {
+				graph.AddEdge(depID, funcID)
+			}
+		}
+	}
+
+	writeCreate := func(objID string) error {
+		if tableDiff, ok := tableMap[objID]; ok {
+			return writeCreateTable(buf, tableDiff.SchemaName, tableDiff.TableName, tableDiff.NewTable)
+		} else if viewDiff, ok := viewMap[objID]; ok {
+			writeCreateView(buf, viewDiff.SchemaName, viewDiff.NewView)
+		} else if mvDiff, ok := materializedViewMap[objID]; ok {
+			writeCreateMaterializedView(buf, mvDiff.SchemaName, mvDiff.NewMaterializedView)
+		} else if funcDiff, ok := functionMap[objID]; ok {
+			writeDefinition(buf, funcDiff.NewFunction.Definition)
+		} else if procDiff, ok := procedureMap[objID]; ok {
+			writeDefinition(buf, procDiff.NewProcedure.Definition)
+		}
+		return nil
+	}
+	orderedList, err := graph.TopologicalSort()
+	if err != nil {
+		// If there's a cycle, fall back to the order of tables, views, functions and procedures.
+		orderedList = nil
+		for _, objIDs := range [][]string{
+			sortedKeys(tableMap),
+			sortedKeys(viewMap),
+			sortedKeys(materializedViewMap),
+			sortedKeys(functionMap),
+			sortedKeys(procedureMap),
+		} {
+			orderedList = append(orderedList, objIDs...)
+		}
+	}
+	for _, objID := range orderedList {
+		if err := writeCreate(objID); err != nil {
+			return err
+		}
+	}
+
+	// Alter the tables.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter {
+			writeAlterTable(buf, tableDiff)
+		}
+	}
+
+	// Add the foreign keys after all tables are created.
+	for _, tableID := range sortedKeys(tableMap) {
+		tableDiff := tableMap[tableID]
+		for _, fk := range tableDiff.NewTable.GetForeignKeys() {
+			writeAddForeignKey(buf, tableDiff.SchemaName, tableDiff.TableName, fk)
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, fkDiff := range tableDiff.ForeignKeyChanges {
+			if fkDiff.Action == schema.MetadataDiffActionCreate {
+				writeAddForeignKey(buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.NewForeignKey)
+			}
+		}
+	}
+	return nil
+}
+
+func writeCreateTable(buf *strings.Builder, schemaName, tableName string, table *storepb.TableMetadata) error {
+	if len(table.GetColumns()) == 0 {
+		return errors.Errorf("table %s.%s has no columns", schemaName, tableName)
+	}
+	_, _ = fmt.Fprintf(buf, "CREATE TABLE %s (\n", getQualifiedName(schemaName, tableName))
+	var definitions []string
+	for _, column := range table.Columns {
+		definitions = append(definitions, "  "+getColumnDefinition(column))
+	}
+	for _, index := range table.Indexes {
+		if definition := getConstraintDefinition(index); definition != "" {
+			definitions = append(definitions, "  "+definition)
+		}
+	}
+	_, _ = buf.WriteString(strings.Join(definitions, ",\n"))
+	_, _ = buf.WriteString("\n)")
+	if table.Comment != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT = %s", quoteString(table.Comment))
+	}
+	_, _ = buf.WriteString(";\n\n")
+	return nil
+}
+
+func writeAlterTable(buf *strings.Builder, tableDiff *schema.TableDiff) {
+	tableName := getQualifiedName(tableDiff.SchemaName, tableDiff.TableName)
+	for _, colDiff := range tableDiff.ColumnChanges {
+		switch colDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN %s;\n", tableName, getColumnDefinition(colDiff.NewColumn))
+		case schema.MetadataDiffActionAlter:
+			writeAlterColumn(buf, tableName, colDiff.OldColumn, colDiff.NewColumn)
+		default:
+		}
+	}
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action != schema.MetadataDiffActionCreate {
+			continue
+		}
+		if definition := getConstraintDefinition(indexDiff.NewIndex); definition != "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD %s;\n", tableName, definition)
+		}
+	}
+	if tableDiff.OldTable.GetComment() != tableDiff.NewTable.GetComment() {
+		if tableDiff.NewTable.GetComment() == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s UNSET COMMENT;\n", tableName)
+		} else {
+			_, _ = fmt.Fprintf(buf, "COMMENT ON TABLE %s IS %s;\n", tableName, quoteString(tableDiff.NewTable.GetComment()))
+		}
+	}
+}
+
+// writeAlterColumn writes the statements altering the column in place.
+// Snowflake only allows to change the default value to another sequence or drop it, so the
+// other default changes are left as comments for the users to handle them manually.
+func writeAlterColumn(buf *strings.Builder, tableName string, oldColumn, newColumn *storepb.ColumnMetadata) {
+	columnName := quoteIdentifier(newColumn.Name)
+	if oldColumn.Type != newColumn.Type {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET DATA TYPE %s;\n", tableName, columnName, newColumn.Type)
+	}
+	if oldColumn.Nullable != newColumn.Nullable {
+		if newColumn.Nullable {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL;\n", tableName, columnName)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;\n", tableName, columnName)
+		}
+	}
+	if oldColumn.GetDefault() != newColumn.GetDefault() {
+		switch {
+		case newColumn.GetDefault() == "":
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;\n", tableName, columnName)
+		case isSequenceDefault(newColumn.GetDefault()):
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;\n", tableName, columnName, newColumn.GetDefault())
+		default:
+			_, _ = fmt.Fprintf(buf, "-- Snowflake cannot change the default of column %s on %s to %s.\n", columnName, tableName, newColumn.GetDefault())
+		}
+	}
+	if oldColumn.Comment != newColumn.Comment {
+		if newColumn.Comment == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s UNSET COMMENT;\n", tableName, columnName)
+		} else {
+			_, _ = fmt.Fprintf(buf, "COMMENT ON COLUMN %s.%s IS %s;\n", tableName, columnName, quoteString(newColumn.Comment))
+		}
+	}
+}
+
+func isSequenceDefault(defaultValue string) bool {
+	return strings.HasSuffix(strings.ToUpper(strings.TrimSpace(defaultValue)), ".NEXTVAL")
+}
+
+func getColumnDefinition(column *storepb.ColumnMetadata) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "%s %s", quoteIdentifier(column.Name), column.Type)
+	if column.Collation != "" {
+		_, _ = fmt.Fprintf(&buf, " COLLATE %s", quoteString(column.Collation))
+	}
+	if column.GetDefault() != "" {
+		_, _ = fmt.Fprintf(&buf, " DEFAULT %s", column.GetDefault())
+	}
+	if !column.Nullable {
+		_, _ = buf.WriteString(" NOT NULL")
+	}
+	if column.Comment != "" {
+		_, _ = fmt.Fprintf(&buf, " COMMENT %s", quoteString(column.Comment))
+	}
+	return buf.String()
+}
+
+// getConstraintDefinition returns the definition of the primary key or unique constraint.
+// Snowflake has no secondary indexes on the standard tables, so the other indexes are ignored.
+func getConstraintDefinition(index *storepb.IndexMetadata) string {
+	var keyType string
+	switch {
+	case index.Primary:
+		keyType = "PRIMARY KEY"
+	case index.Unique:
+		keyType = "UNIQUE"
+	default:
+		return ""
+	}
+	var columns []string
+	for _, expression := range index.Expressions {
+		columns = append(columns, quoteIdentifier(expression))
+	}
+	return fmt.Sprintf("CONSTRAINT %s %s (%s)", quoteIdentifier(index.Name), keyType, strings.Join(columns, ", "))
+}
+
+func writeAddForeignKey(buf *strings.Builder, schemaName, tableName string, fk *storepb.ForeignKeyMetadata) {
+	var columns, referencedColumns []string
+	for _, column := range fk.Columns {
+		columns = append(columns, quoteIdentifier(column))
+	}
+	for _, column := range fk.ReferencedColumns {
+		referencedColumns = append(referencedColumns, quoteIdentifier(column))
+	}
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s);\n",
+		getQualifiedName(schemaName, tableName),
+		quoteIdentifier(fk.Name),
+		strings.Join(columns, ", "),
+		getQualifiedName(fk.ReferencedSchema, fk.ReferencedTable),
+		strings.Join(referencedColumns, ", "),
+	)
+}
+
+func writeCreateView(buf *strings.Builder, schemaName string, view *storepb.ViewMetadata) {
+	// The view definition synced from Snowflake is the whole CREATE VIEW statement.
+	if isCreateStatement(view.Definition) {
+		writeDefinition(buf, view.Definition)
+		return
+	}
+	_, _ = fmt.Fprintf(buf, "CREATE VIEW %s", getQualifiedName(schemaName, view.Name))
+	if view.Comment != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT = %s", quoteString(view.Comment))
+	}
+	_, _ = buf.WriteString(" AS\n")
+	writeDefinition(buf, view.Definition)
+}
+
+func writeCreateMaterializedView(buf *strings.Builder, schemaName string, view *storepb.MaterializedViewMetadata) {
+	if isCreateStatement(view.Definition) {
+		writeDefinition(buf, view.Definition)
+		return
+	}
+	_, _ = fmt.Fprintf(buf, "CREATE MATERIALIZED VIEW %s", getQualifiedName(schemaName, view.Name))
+	if view.Comment != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT = %s", quoteString(view.Comment))
+	}
+	_, _ = buf.WriteString(" AS\n")
+	writeDefinition(buf, view.Definition)
+}
+
+func isCreateStatement(definition string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(definition)), "CREATE ")
+}
+
+// writeDefinition writes the statement and terminates it with the semicolon.
+func writeDefinition(buf *strings.Builder, definition string) {
+	definition = strings.TrimSpace(definition)
+	_, _ = buf.WriteString(definition)
+	if !strings.HasSuffix(definition, ";") {
+		_, _ = buf.WriteString(";")
+	}
+	_, _ = buf.WriteString("\n\n")
+}
+
+func writeCreateSequence(buf *strings.Builder, schemaName string, sequence *storepb.SequenceMetadata) {
+	_, _ = fmt.Fprintf(buf, "CREATE SEQUENCE %s", getQualifiedName(schemaName, sequence.Name))
+	if sequence.Start != "" {
+		_, _ = fmt.Fprintf(buf, " START = %s", sequence.Start)
+	}
+	if sequence.Increment != "" {
+		_, _ = fmt.Fprintf(buf, " INCREMENT = %s", sequence.Increment)
+	}
+	if sequence.Comment != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT = %s", quoteString(sequence.Comment))
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeCreateSchema(buf *strings.Builder, schemaName string) {
+	_, _ = fmt.Fprintf(buf, "CREATE SCHEMA %s;\n", quoteIdentifier(schemaName))
+}
+
+func writeDropSchema(buf *strings.Builder, schemaName string) {
+	_, _ = fmt.Fprintf(buf, "DROP SCHEMA %s CASCADE;\n", quoteIdentifier(schemaName))
+}
+
+func writeDropTable(buf *strings.Builder, schemaName, tableName string) {
+	_, _ = fmt.Fprintf(buf, "DROP TABLE %s;\n", getQualifiedName(schemaName, tableName))
+}
+
+func writeDropView(buf *strings.Builder, schemaName, viewName string) {
+	_, _ = fmt.Fprintf(buf, "DROP VIEW %s;\n", getQualifiedName(schemaName, viewName))
+}
+
+func writeDropMaterializedView(buf *strings.Builder, schemaName, viewName string) {
+	_, _ = fmt.Fprintf(buf, "DROP MATERIALIZED VIEW %s;\n", getQualifiedName(schemaName, viewName))
+}
+
+// writeDropFunction drops the function by the argument types, which identify the overloaded functions.
+func writeDropFunction(buf *strings.Builder, schemaName string, function *storepb.FunctionMetadata) {
+	_, _ = fmt.Fprintf(buf, "DROP FUNCTION %s%s;\n", getQualifiedName(schemaName, function.Name), getArgumentTypes(function.Signature))
+}
+
+func writeDropProcedure(buf *strings.Builder, schemaName string, procedure *storepb.ProcedureMetadata) {
+	_, _ = fmt.Fprintf(buf, "DROP PROCEDURE %s%s;\n", getQualifiedName(schemaName, procedure.Name), getArgumentTypes(procedure.Signature))
+}
+
+func writeDropSequence(buf *strings.Builder, schemaName, sequenceName string) {
+	_, _ = fmt.Fprintf(buf, "DROP SEQUENCE %s;\n", getQualifiedName(schemaName, sequenceName))
+}
+
+func writeDropConstraint(buf *strings.Builder, schemaName, tableName, constraintName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP CONSTRAINT %s;\n", getQualifiedName(schemaName, tableName), quoteIdentifier(constraintName))
+}
+
+func writeDropIndex(buf *strings.Builder, schemaName, tableName string, index *storepb.IndexMetadata) {
+	if !index.Primary && !index.Unique {
+		return
+	}
+	writeDropConstraint(buf, schemaName, tableName, index.Name)
+}
+
+func writeDropColumn(buf *strings.Builder, schemaName, tableName, columnName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", getQualifiedName(schemaName, tableName), quoteIdentifier(columnName))
+}
+
+// getArgumentTypes returns the argument types in the signature, such as "(NUMBER, VARCHAR)" for "ADD(NUMBER, VARCHAR)".
+func getArgumentTypes(signature string) string {
+	if i := strings.Index(signature, "("); i >= 0 {
+		return signature[i:]
+	}
+	return "()"
+}
+
+func getMigrationObjectID(schemaName, objectName string) string {
+	return fmt.Sprintf("%s.%s", schemaName, objectName)
+}
+
+func getFunctionObjectID(schemaName string, function *storepb.FunctionMetadata) string {
+	if function.Signature != "" {
+		return getMigrationObjectID(schemaName, function.Signature)
+	}
+	return getMigrationObjectID(schemaName, function.Name)
+}
+
+func getQualifiedName(schemaName, objectName string) string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schemaName), quoteIdentifier(objectName))
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,222 @@
+package snowflake
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	usersTable := &storepb.TableMetadata{
+		Name: "USERS",
+		Columns: []*storepb.ColumnMetadata{
+			{Name: "ID", Type: "NUMBER(38,0)", Default: `"PUBLIC"."USER_SEQ".NEXTVAL`},
+			{Name: "NAME", Type: "VARCHAR(100)", Nullable: true, Comment: "user's name"},
+		},
+		Indexes: []*storepb.IndexMetadata{
+			{Name: "PK_USERS", Primary: true, Unique: true, Expressions: []string{"ID"}},
+		},
+		Comment: "all users",
+	}
+	ordersTable := &storepb.TableMetadata{
+		Name: "ORDERS",
+		Columns: []*storepb.ColumnMetadata{
+			{Name: "ID", Type: "NUMBER(38,0)"},
+			{Name: "USER_ID", Type: "NUMBER(38,0)"},
+		},
+		ForeignKeys: []*storepb.ForeignKeyMetadata{
+			{Name: "FK_USER", Columns: []string{"USER_ID"}, ReferencedSchema: "PUBLIC", ReferencedTable: "USERS", ReferencedColumns: []string{"ID"}},
+		},
+	}
+	userView := &storepb.ViewMetadata{
+		Name:       "USER_NAMES",
+		Definition: `create or replace view USER_NAMES as select NAME from USERS`,
+		DependencyColumns: []*storepb.DependencyColumn{
+			{Schema: "PUBLIC", Table: "USERS", Column: "NAME"},
+		},
+	}
+
+	tests := []struct {
+		description string
+		oldSchemas  []*storepb.SchemaMetadata
+		newSchemas  []*storepb.SchemaMetadata
+		want        string
+	}{
+		{
+			description: "create tables, views, sequences and functions",
+			oldSchemas:  []*storepb.SchemaMetadata{{Name: "PUBLIC"}},
+			newSchemas: []*storepb.SchemaMetadata{
+				{
+					Name:      "PUBLIC",
+					Tables:    []*storepb.TableMetadata{ordersTable, usersTable},
+					Views:     []*storepb.ViewMetadata{userView},
+					Sequences: []*storepb.SequenceMetadata{{Name: "USER_SEQ", Start: "1", Increment: "1"}},
+					Functions: []*storepb.FunctionMetadata{
+						{
+							Name:       "ADD_ONE",
+							Signature:  "ADD_ONE(NUMBER)",
+							Definition: "CREATE FUNCTION ADD_ONE(X NUMBER) RETURNS NUMBER AS 'X + 1'",
+						},
+					},
+				},
+			},
+			want: `CREATE SEQUENCE "PUBLIC"."USER_SEQ" START = 1 INCREMENT = 1;
+CREATE FUNCTION ADD_ONE(X NUMBER) RETURNS NUMBER AS 'X + 1';
+
+CREATE TABLE "PUBLIC"."ORDERS" (
+  "ID" NUMBER(38,0) NOT NULL,
+  "USER_ID" NUMBER(38,0) NOT NULL
+);
+
+CREATE TABLE "PUBLIC"."USERS" (
+  "ID" NUMBER(38,0) DEFAULT "PUBLIC"."USER_SEQ".NEXTVAL NOT NULL,
+  "NAME" VARCHAR(100) COMMENT 'user''s name',
+  CONSTRAINT "PK_USERS" PRIMARY KEY ("ID")
+) COMMENT = 'all users';
+
+create or replace view USER_NAMES as select NAME from USERS;
+
+ALTER TABLE "PUBLIC"."ORDERS" ADD CONSTRAINT "FK_USER" FOREIGN KEY ("USER_ID") REFERENCES "PUBLIC"."USERS" ("ID");
+`,
+		},
+		{
+			description: "drop the view before the table it depends on",
+			oldSchemas: []*storepb.SchemaMetadata{
+				{
+					Name:   "PUBLIC",
+					Tables: []*storepb.TableMetadata{usersTable},
+					Views:  []*storepb.ViewMetadata{userView},
+				},
+			},
+			newSchemas: []*storepb.SchemaMetadata{{Name: "PUBLIC"}},
+			want: `DROP VIEW "PUBLIC"."USER_NAMES";
+DROP TABLE "PUBLIC"."USERS";
+`,
+		},
+		{
+			description: "alter table columns, constraints and comments",
+			oldSchemas: []*storepb.SchemaMetadata{
+				{
+					Name:   "PUBLIC",
+					Tables: []*storepb.TableMetadata{usersTable},
+				},
+			},
+			newSchemas: []*storepb.SchemaMetadata{
+				{
+					Name: "PUBLIC",
+					Tables: []*storepb.TableMetadata{
+						{
+							Name: "USERS",
+							Columns: []*storepb.ColumnMetadata{
+								{Name: "ID", Type: "NUMBER(38,0)"},
+								{Name: "NAME", Type: "VARCHAR(200)", Comment: "full name"},
+								{Name: "EMAIL", Type: "VARCHAR(100)", Nullable: true},
+							},
+							Indexes: []*storepb.IndexMetadata{
+								{Name: "UK_NAME", Unique: true, Expressions: []string{"NAME"}},
+							},
+						},
+					},
+				},
+			},
+			want: `ALTER TABLE "PUBLIC"."USERS" DROP CONSTRAINT "PK_USERS";
+
+ALTER TABLE "PUBLIC"."USERS" ALTER COLUMN "ID" DROP DEFAULT;
+ALTER TABLE "PUBLIC"."USERS" ALTER COLUMN "NAME" SET DATA TYPE VARCHAR(200);
+ALTER TABLE "PUBLIC"."USERS" ALTER COLUMN "NAME" SET NOT NULL;
+COMMENT ON COLUMN "PUBLIC"."USERS"."NAME" IS 'full name';
+ALTER TABLE "PUBLIC"."USERS" ADD COLUMN "EMAIL" VARCHAR(100);
+ALTER TABLE "PUBLIC"."USERS" ADD CONSTRAINT "UK_NAME" UNIQUE ("NAME");
+ALTER TABLE "PUBLIC"."USERS" UNSET COMMENT;
+`,
+		},
+		{
+			description: "recreate the altered view and procedure",
+			oldSchemas: []*storepb.SchemaMetadata{
+				{
+					Name:   "PUBLIC",
+					Tables: []*storepb.TableMetadata{usersTable},
+					Views:  []*storepb.ViewMetadata{userView},
+					Procedures: []*storepb.ProcedureMetadata{
+						{
+							Name:       "CLEANUP",
+							Signature:  "CLEANUP()",
+							Definition: "CREATE PROCEDURE CLEANUP() RETURNS VARCHAR LANGUAGE SQL AS 'SELECT 1'",
+						},
+					},
+				},
+			},
+			newSchemas: []*storepb.SchemaMetadata{
+				{
+					Name:   "PUBLIC",
+					Tables: []*storepb.TableMetadata{usersTable},
+					Views: []*storepb.ViewMetadata{
+						{
+							Name:       "USER_NAMES",
+							Definition: "SELECT NAME, ID FROM USERS",
+						},
+					},
+					Procedures: []*storepb.ProcedureMetadata{
+						{
+							Name:       "CLEANUP",
+							Signature:  "CLEANUP()",
+							Definition: "CREATE PROCEDURE CLEANUP() RETURNS VARCHAR LANGUAGE SQL AS 'SELECT 2'",
+						},
+					},
+				},
+			},
+			want: `DROP PROCEDURE "PUBLIC"."CLEANUP"();
+DROP VIEW "PUBLIC"."USER_NAMES";
+
+CREATE PROCEDURE CLEANUP() RETURNS VARCHAR LANGUAGE SQL AS 'SELECT 2';
+
+CREATE VIEW "PUBLIC"."USER_NAMES" AS
+SELECT NAME, ID FROM USERS;
+
+`,
+		},
+		{
+			description: "create and drop schemas",
+			oldSchemas: []*storepb.SchemaMetadata{
+				{Name: "PUBLIC"},
+				{Name: "STAGING", Tables: []*storepb.TableMetadata{ordersTable}},
+			},
+			newSchemas: []*storepb.SchemaMetadata{
+				{Name: "PUBLIC"},
+				{Name: "ANALYTICS"},
+			},
+			want: `DROP SCHEMA "STAGING" CASCADE;
+
+CREATE SCHEMA "ANALYTICS";
+`,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		oldSchema := model.NewDatabaseSchema(&storepb.DatabaseSchemaMetadata{Name: "DB", Schemas: test.oldSchemas}, nil, nil, storepb.Engine_SNOWFLAKE, true)
+		newSchema := model.NewDatabaseSchema(&storepb.DatabaseSchemaMetadata{Name: "DB", Schemas: test.newSchemas}, nil, nil, storepb.Engine_SNOWFLAKE, true)
+		diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_SNOWFLAKE, oldSchema, newSchema)
+		a.NoError(err, test.description)
+
+		got, err := generateMigration(diff)
+		a.NoError(err, test.description)
+		a.Equal(test.want, got, test.description)
+
+		// The parser requires the column list in DROP CONSTRAINT, which Snowflake doesn't.
+		var statements []string
+		for _, line := range strings.Split(got, "\n") {
+			if !strings.Contains(line, " DROP CONSTRAINT ") {
+				statements = append(statements, line)
+			}
+		}
+		_, err = snowsqlparser.ParseSnowSQL(strings.Join(statements, "\n"))
+		a.NoError(err, test.description)
+	}
+}
@@ -161,6 +161,7 @@ func init() {
 	RegisterViewComparer(storepb.Engine_TIDB, defaultComparer)
 	RegisterViewComparer(storepb.Engine_ORACLE, defaultComparer)
 	RegisterViewComparer(storepb.Engine_MSSQL, defaultComparer)
+	RegisterViewComparer(storepb.Engine_SNOWFLAKE, defaultComparer)
 
 	// Note: PostgreSQL might need a custom comparer to be registered separately
 	// due to its unique handling of materialized views and indexes.
@@ -0,0 +1,342 @@
+// Package webauthn is the plugin for the WebAuthn relying party, which registers and verifies the passkeys and security keys.
+// The ceremonies are verified by github.com/go-webauthn/webauthn.
+// Docs: https://www.w3.org/TR/webauthn-3/
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Timeout is the timeout of the registration and authentication ceremonies.
+	Timeout = 5 * time.Minute
+
+	challengeSize = 32
+
+	credentialType = "public-key"
+
+	attestationConveyanceNone = "none"
+)
+
+// The COSE algorithms supported for the credential public keys.
+// Docs: https://www.iana.org/assignments/cose/cose.xhtml#algorithms
+const (
+	AlgorithmES256 = int64(webauthncose.AlgES256)
+	AlgorithmEdDSA = int64(webauthncose.AlgEdDSA)
+	AlgorithmRS256 = int64(webauthncose.AlgRS256)
+)
+
+// RelyingParty is the WebAuthn relying party, i.e. the Bytebase workspace.
+type RelyingParty struct {
+	// ID is the domain of the relying party, e.g. bytebase.example.com.
+	ID string
+	// Name is the human-palatable name of the relying party shown by the browser.
+	Name string
+	// Origin is the origin of the web page calling the WebAuthn API, e.g. https://bytebase.example.com.
+	Origin string
+}
+
+// NewRelyingParty returns the relying party for the external URL of the workspace.
+func NewRelyingParty(externalURL, name string) (*RelyingParty, error) {
+	if externalURL == "" {
+		return nil, errors.New("external URL is required for WebAuthn")
+	}
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid external URL %q", externalURL)
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("invalid external URL %q", externalURL)
+	}
+	// WebAuthn is only available in the secure context, and the browsers treat localhost as secure.
+	if u.Scheme != "https" && u.Hostname() != "localhost" {
+		return nil, errors.Errorf("WebAuthn requires the external URL %q to use https", externalURL)
+	}
+	return &RelyingParty{
+		ID:     u.Hostname(),
+		Name:   name,
+		Origin: u.Scheme + "://" + u.Host,
+	}, nil
+}
+
+// User is the user account of the credentials.
+type User struct {
+	// ID is the opaque user handle, which must not contain the personally identifying information.
+	ID          []byte
+	Name        string
+	DisplayName string
+}
+
+// Credential is the registered public key credential.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE_Key encoded credential public key.
+	PublicKey []byte
+	// SignCount is the signature counter of the authenticator, which detects the cloned authenticators.
+	SignCount  uint32
+	Transports []string
+	// AAGUID identifies the model of the authenticator.
+	AAGUID []byte
+}
+
+// NewChallenge returns a random challenge for the ceremony.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, challengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, errors.Wrapf(err, "failed to generate challenge")
+	}
+	return challenge, nil
+}
+
+// CreationOptions is the PublicKeyCredentialCreationOptionsJSON for navigator.credentials.create().
+// Docs: https://www.w3.org/TR/webauthn-3/#dictdef-publickeycredentialcreationoptionsjson
+type CreationOptions struct {
+	RP                     RelyingPartyEntity      `json:"rp"`
+	User                   UserEntity              `json:"user"`
+	Challenge              string                  `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter   `json:"pubKeyCredParams"`
+	Timeout                int64                   `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor  `json:"excludeCredentials"`
+	AuthenticatorSelection *AuthenticatorSelection `json:"authenticatorSelection,omitempty"`
+	Attestation            string                  `json:"attestation"`
+}
+
+// RequestOptions is the PublicKeyCredentialRequestOptionsJSON for navigator.credentials.get().
+// Docs: https://www.w3.org/TR/webauthn-3/#dictdef-publickeycredentialrequestoptionsjson
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// RelyingPartyEntity is the PublicKeyCredentialRpEntity.
+type RelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserEntity is the PublicKeyCredentialUserEntityJSON.
+type UserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// CredentialParameter is the PublicKeyCredentialParameters.
+type CredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int64  `json:"alg"`
+}
+
+// CredentialDescriptor is the PublicKeyCredentialDescriptorJSON.
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+// AuthenticatorSelection is the AuthenticatorSelectionCriteria.
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// CreationOptions returns the options of the registration ceremony for the user.
+// The existing credentials of the user are excluded so that the same authenticator will not be registered twice.
+func (rp *RelyingParty) CreationOptions(user *User, challenge []byte, existing []*Credential) *CreationOptions {
+	return &CreationOptions{
+		RP: RelyingPartyEntity{ID: rp.ID, Name: rp.Name},
+		User: UserEntity{
+			ID:          encode(user.ID),
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+		},
+		Challenge: encode(challenge),
+		PubKeyCredParams: []CredentialParameter{
+			{Type: credentialType, Algorithm: AlgorithmES256},
+			{Type: credentialType, Algorithm: AlgorithmEdDSA},
+			{Type: credentialType, Algorithm: AlgorithmRS256},
+		},
+		Timeout:            Timeout.Milliseconds(),
+		ExcludeCredentials: descriptors(existing),
+		AuthenticatorSelection: &AuthenticatorSelection{
+			ResidentKey:      "discouraged",
+			UserVerification: "preferred",
+		},
+		Attestation: attestationConveyanceNone,
+	}
+}
+
+// RequestOptions returns the options of the authentication ceremony with the credentials of the user.
+func (rp *RelyingParty) RequestOptions(challenge []byte, credentials []*Credential) *RequestOptions {
+	return &RequestOptions{
+		Challenge:        encode(challenge),
+		Timeout:          Timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: descriptors(credentials),
+		UserVerification: "preferred",
+	}
+}
+
+func descriptors(credentials []*Credential) []CredentialDescriptor {
+	result := []CredentialDescriptor{}
+	for _, credential := range credentials {
+		result = append(result, CredentialDescriptor{
+			Type:       credentialType,
+			ID:         encode(credential.ID),
+			Transports: credential.Transports,
+		})
+	}
+	return result
+}
+
+// RegistrationResponse is the RegistrationResponseJSON returned by navigator.credentials.create().
+// Docs: https://www.w3.org/TR/webauthn-3/#dictdef-registrationresponsejson
+type RegistrationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string   `json:"clientDataJSON"`
+		AttestationObject string   `json:"attestationObject"`
+		Transports        []string `json:"transports,omitempty"`
+	} `json:"response"`
+}
+
+// AuthenticationResponse is the AuthenticationResponseJSON returned by navigator.credentials.get().
+// Docs: https://www.w3.org/TR/webauthn-3/#dictdef-authenticationresponsejson
+type AuthenticationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle,omitempty"`
+	} `json:"response"`
+}
+
+// VerifyRegistration verifies the response of the registration ceremony and returns the new credential.
+// We request no attestation, so the trustworthiness of the attestation certificate is not evaluated.
+// Docs: https://www.w3.org/TR/webauthn-3/#sctn-registering-a-new-credential
+func (rp *RelyingParty) VerifyRegistration(challenge []byte, response []byte) (*Credential, error) {
+	if len(challenge) == 0 {
+		return nil, errors.New("challenge is missing")
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, errors.Wrapf(describe(err), "invalid registration response")
+	}
+	if parsed.Response.CollectedClientData.CrossOrigin {
+		return nil, errors.New("cross-origin ceremony is not allowed")
+	}
+	if _, err := parsed.Verify(encode(challenge), false /* verifyUser */, rp.ID, []string{rp.Origin}, nil, protocol.TopOriginIgnoreVerificationMode, nil, credentialParameters()); err != nil {
+		return nil, errors.Wrapf(describe(err), "failed to verify registration")
+	}
+
+	attestedData := parsed.Response.AttestationObject.AuthData.AttData
+	var transports []string
+	for _, transport := range parsed.Response.Transports {
+		transports = append(transports, string(transport))
+	}
+	return &Credential{
+		ID:         attestedData.CredentialID,
+		PublicKey:  attestedData.CredentialPublicKey,
+		SignCount:  parsed.Response.AttestationObject.AuthData.Counter,
+		Transports: transports,
+		AAGUID:     attestedData.AAGUID,
+	}, nil
+}
+
+// VerifyAssertion verifies the response of the authentication ceremony against the credentials of the user.
+// It returns the used credential with the updated signature counter.
+// Docs: https://www.w3.org/TR/webauthn-3/#sctn-verifying-assertion
+func (rp *RelyingParty) VerifyAssertion(challenge []byte, response []byte, credentials []*Credential) (*Credential, error) {
+	if len(challenge) == 0 {
+		return nil, errors.New("challenge is missing")
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, errors.Wrapf(describe(err), "invalid authentication response")
+	}
+	var credential *Credential
+	for _, c := range credentials {
+		if bytes.Equal(c.ID, parsed.RawID) {
+			credential = c
+			break
+		}
+	}
+	if credential == nil {
+		return nil, errors.New("credential is not registered for the user")
+	}
+	if parsed.Response.CollectedClientData.CrossOrigin {
+		return nil, errors.New("cross-origin ceremony is not allowed")
+	}
+	if err := parsed.Verify(encode(challenge), rp.ID, []string{rp.Origin}, nil, protocol.TopOriginIgnoreVerificationMode, "" /* appID */, false /* verifyUser */, credential.PublicKey); err != nil {
+		return nil, errors.Wrapf(describe(err), "failed to verify assertion")
+	}
+
+	// The authenticators without the signature counter always return zero.
+	signCount := parsed.Response.AuthenticatorData.Counter
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		return nil, errors.New("signature counter did not increase, the authenticator may be cloned")
+	}
+
+	return &Credential{
+		ID:         credential.ID,
+		PublicKey:  credential.PublicKey,
+		SignCount:  signCount,
+		Transports: credential.Transports,
+		AAGUID:     credential.AAGUID,
+	}, nil
+}
+
+// credentialParameters returns the public key algorithms accepted by the relying party.
+func credentialParameters() []protocol.CredentialParameter {
+	return []protocol.CredentialParameter{
+		{Type: protocol.PublicKeyCredentialType, Algorithm: webauthncose.AlgES256},
+		{Type: protocol.PublicKeyCredentialType, Algorithm: webauthncose.AlgEdDSA},
+		{Type: protocol.PublicKeyCredentialType, Algorithm: webauthncose.AlgRS256},
+	}
+}
+
+// describe returns the details of the protocol error, which are not included in its message.
+// The developer info is dropped because it may contain the expected challenge.
+func describe(err error) error {
+	var protocolErr *protocol.Error
+	if errors.As(err, &protocolErr) && protocolErr.Details != "" {
+		return errors.New(protocolErr.Details)
+	}
+	return err
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decode decodes the base64url encoded data, and the padding is tolerated.
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// EncodeID encodes the credential id in the resource name and the JSON.
+func EncodeID(id []byte) string {
+	return encode(id)
+}
+
+// DecodeID decodes the credential id encoded by EncodeID.
+func DecodeID(s string) ([]byte, error) {
+	return decode(s)
+}
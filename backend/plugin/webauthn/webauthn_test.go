@@ -0,0 +1,122 @@
+package webauthn_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/webauthn"
+	"github.com/bytebase/bytebase/backend/plugin/webauthn/webauthntest"
+)
+
+func register(t *testing.T, rp *webauthn.RelyingParty, authenticator *webauthntest.Authenticator) *webauthn.Credential {
+	a := require.New(t)
+	challenge, err := webauthn.NewChallenge()
+	a.NoError(err)
+	options, err := json.Marshal(rp.CreationOptions(&webauthn.User{ID: []byte("101"), Name: "alice@example.com", DisplayName: "Alice"}, challenge, nil))
+	a.NoError(err)
+	response, err := authenticator.Create(options)
+	a.NoError(err)
+	credential, err := rp.VerifyRegistration(challenge, response)
+	a.NoError(err)
+	return credential
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	a := require.New(t)
+	rp, err := webauthn.NewRelyingParty("https://bytebase.example.com/", "Bytebase")
+	a.NoError(err)
+	a.Equal("bytebase.example.com", rp.ID)
+	a.Equal("https://bytebase.example.com", rp.Origin)
+
+	authenticator, err := webauthntest.NewAuthenticator(rp.Origin)
+	a.NoError(err)
+	credential := register(t, rp, authenticator)
+	a.Equal(authenticator.CredentialID(), credential.ID)
+	a.Equal([]string{"usb"}, credential.Transports)
+	a.Equal(uint32(0), credential.SignCount)
+
+	// The registered authenticator is excluded in the next registration.
+	challenge, err := webauthn.NewChallenge()
+	a.NoError(err)
+	options, err := json.Marshal(rp.CreationOptions(&webauthn.User{ID: []byte("101")}, challenge, []*webauthn.Credential{credential}))
+	a.NoError(err)
+	_, err = authenticator.Create(options)
+	a.Error(err)
+
+	for i := 1; i <= 2; i++ {
+		challenge, err := webauthn.NewChallenge()
+		a.NoError(err)
+		options, err := json.Marshal(rp.RequestOptions(challenge, []*webauthn.Credential{credential}))
+		a.NoError(err)
+		response, err := authenticator.Get(options)
+		a.NoError(err)
+		used, err := rp.VerifyAssertion(challenge, response, []*webauthn.Credential{credential})
+		a.NoError(err)
+		a.Equal(uint32(i), used.SignCount)
+		credential = used
+	}
+}
+
+func TestAssertionError(t *testing.T) {
+	a := require.New(t)
+	rp, err := webauthn.NewRelyingParty("https://bytebase.example.com", "Bytebase")
+	a.NoError(err)
+	authenticator, err := webauthntest.NewAuthenticator(rp.Origin)
+	a.NoError(err)
+	credential := register(t, rp, authenticator)
+
+	challenge, err := webauthn.NewChallenge()
+	a.NoError(err)
+	options, err := json.Marshal(rp.RequestOptions(challenge, []*webauthn.Credential{credential}))
+	a.NoError(err)
+	response, err := authenticator.Get(options)
+	a.NoError(err)
+
+	// Wrong challenge.
+	otherChallenge, err := webauthn.NewChallenge()
+	a.NoError(err)
+	_, err = rp.VerifyAssertion(otherChallenge, response, []*webauthn.Credential{credential})
+	a.ErrorContains(err, "Error validating challenge")
+
+	// Unknown credential.
+	other, err := webauthntest.NewAuthenticator(rp.Origin)
+	a.NoError(err)
+	otherCredential := register(t, rp, other)
+	_, err = rp.VerifyAssertion(challenge, response, []*webauthn.Credential{otherCredential})
+	a.ErrorContains(err, "not registered")
+
+	// Wrong public key.
+	forged := *credential
+	forged.PublicKey = otherCredential.PublicKey
+	_, err = rp.VerifyAssertion(challenge, response, []*webauthn.Credential{&forged})
+	a.ErrorContains(err, "Error validating the assertion signature")
+
+	// Replayed signature counter.
+	replayed := *credential
+	replayed.SignCount = 1
+	_, err = rp.VerifyAssertion(challenge, response, []*webauthn.Credential{&replayed})
+	a.ErrorContains(err, "signature counter")
+
+	// Phishing site with a different origin.
+	authenticator.Origin = "https://bytebase.example.com.evil.com"
+	response, err = authenticator.Get(options)
+	a.NoError(err)
+	_, err = rp.VerifyAssertion(challenge, response, []*webauthn.Credential{credential})
+	a.ErrorContains(err, "Error validating origin")
+}
+
+func TestNewRelyingParty(t *testing.T) {
+	a := require.New(t)
+
+	rp, err := webauthn.NewRelyingParty("http://localhost:8080", "Bytebase")
+	a.NoError(err)
+	a.Equal("localhost", rp.ID)
+	a.Equal("http://localhost:8080", rp.Origin)
+
+	_, err = webauthn.NewRelyingParty("http://bytebase.example.com", "Bytebase")
+	a.Error(err)
+	_, err = webauthn.NewRelyingParty("", "Bytebase")
+	a.Error(err)
+}
@@ -0,0 +1,187 @@
+// Package webauthntest provides a software authenticator for testing the WebAuthn ceremonies.
+package webauthntest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/webauthn"
+)
+
+// noneAttestationObject is the attestation object of the none attestation.
+type noneAttestationObject struct {
+	Format    string         `cbor:"fmt"`
+	Statement map[string]any `cbor:"attStmt"`
+	AuthData  []byte         `cbor:"authData"`
+}
+
+// Authenticator is a software authenticator with a single ES256 credential, which plays the role of the browser and the security key.
+type Authenticator struct {
+	// Origin is the origin of the web page calling the WebAuthn API.
+	Origin string
+
+	privateKey   *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+// NewAuthenticator returns a new software authenticator for the origin.
+func NewAuthenticator(origin string) (*Authenticator, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		return nil, err
+	}
+	return &Authenticator{
+		Origin:       origin,
+		privateKey:   privateKey,
+		credentialID: credentialID,
+	}, nil
+}
+
+// CredentialID returns the id of the credential of the authenticator.
+func (a *Authenticator) CredentialID() []byte {
+	return a.credentialID
+}
+
+// Create performs navigator.credentials.create() with the PublicKeyCredentialCreationOptionsJSON,
+// and returns the RegistrationResponseJSON with none attestation.
+func (a *Authenticator) Create(options []byte) ([]byte, error) {
+	var creationOptions webauthn.CreationOptions
+	if err := json.Unmarshal(options, &creationOptions); err != nil {
+		return nil, err
+	}
+	for _, descriptor := range creationOptions.ExcludeCredentials {
+		if descriptor.ID == encode(a.credentialID) {
+			return nil, errors.New("the authenticator is already registered")
+		}
+	}
+	supported := false
+	for _, parameter := range creationOptions.PubKeyCredParams {
+		if parameter.Algorithm == webauthn.AlgorithmES256 {
+			supported = true
+		}
+	}
+	if !supported {
+		return nil, errors.New("ES256 is not supported by the relying party")
+	}
+	clientDataJSON, err := a.clientData("webauthn.create", creationOptions.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := webauthncbor.Marshal(&webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: webauthn.AlgorithmES256,
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.privateKey.X.FillBytes(make([]byte, 32)),
+		YCoord: a.privateKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var attestedCredentialData bytes.Buffer
+	attestedCredentialData.Write(make([]byte, 16))
+	_ = binary.Write(&attestedCredentialData, binary.BigEndian, uint16(len(a.credentialID)))
+	attestedCredentialData.Write(a.credentialID)
+	attestedCredentialData.Write(publicKey)
+	// User present, user verified and attested credential data included.
+	authData := a.authenticatorData(creationOptions.RP.ID, 0x01|0x04|0x40, attestedCredentialData.Bytes())
+
+	attestationObject, err := webauthncbor.Marshal(&noneAttestationObject{
+		Format:    "none",
+		Statement: map[string]any{},
+		AuthData:  authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &webauthn.RegistrationResponse{
+		ID:    encode(a.credentialID),
+		RawID: encode(a.credentialID),
+		Type:  "public-key",
+	}
+	response.Response.ClientDataJSON = encode(clientDataJSON)
+	response.Response.AttestationObject = encode(attestationObject)
+	response.Response.Transports = []string{"usb"}
+	return json.Marshal(response)
+}
+
+// Get performs navigator.credentials.get() with the PublicKeyCredentialRequestOptionsJSON,
+// and returns the AuthenticationResponseJSON.
+func (a *Authenticator) Get(options []byte) ([]byte, error) {
+	var requestOptions webauthn.RequestOptions
+	if err := json.Unmarshal(options, &requestOptions); err != nil {
+		return nil, err
+	}
+	allowed := false
+	for _, descriptor := range requestOptions.AllowCredentials {
+		if descriptor.ID == encode(a.credentialID) {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return nil, errors.New("the authenticator is not allowed")
+	}
+	clientDataJSON, err := a.clientData("webauthn.get", requestOptions.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	a.signCount++
+	// User present and user verified.
+	authData := a.authenticatorData(requestOptions.RPID, 0x01|0x04, nil)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.privateKey, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	response := &webauthn.AuthenticationResponse{
+		ID:    encode(a.credentialID),
+		RawID: encode(a.credentialID),
+		Type:  "public-key",
+	}
+	response.Response.ClientDataJSON = encode(clientDataJSON)
+	response.Response.AuthenticatorData = encode(authData)
+	response.Response.Signature = encode(signature)
+	return json.Marshal(response)
+}
+
+func (a *Authenticator) clientData(ceremonyType, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":        ceremonyType,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func (a *Authenticator) authenticatorData(rpID string, flags byte, attestedCredentialData []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	var buf bytes.Buffer
+	buf.Write(rpIDHash[:])
+	buf.WriteByte(flags)
+	_ = binary.Write(&buf, binary.BigEndian, a.signCount)
+	buf.Write(attestedCredentialData)
+	return buf.Bytes()
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
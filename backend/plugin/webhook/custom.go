@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -25,7 +26,7 @@ func init() {
 // CustomReceiver is the receiver for Custom Webhook.
 type CustomReceiver struct{}
 
-func (*CustomReceiver) Post(context Context) error {
+func (*CustomReceiver) Post(context Context) (int, error) {
 	metaMap := make(map[string]string)
 	for _, meta := range context.GetMetaList() {
 		metaMap[meta.Name] = meta.Value
@@ -41,26 +42,27 @@ func (*CustomReceiver) Post(context Context) error {
 
 	body, err := json.Marshal(message)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook request to %s", context.URL)
 	}
 
 	req, err := http.NewRequest("POST", context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to create webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to create webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	SignRequest(req.Header, context.SigningSecrets, time.Now(), body)
 	client := &http.Client{Timeout: Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	respBody, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode >= 300 {
-		return errors.Errorf("custom webhook returned status %d, body: %s", resp.StatusCode, respBody)
+		return resp.StatusCode, &ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("custom webhook returned status %d, body: %s", resp.StatusCode, respBody)}
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
@@ -52,10 +52,10 @@ func init() {
 type Receiver struct {
 }
 
-func (*Receiver) Post(context webhook.Context) error {
+func (*Receiver) Post(context webhook.Context) (int, error) {
 	if context.DirectMessage && len(context.MentionEndUsers) > 0 {
 		sendDirectMessage(context)
-		return nil
+		return 0, nil
 	}
 	return sendMessage(context)
 }
@@ -114,7 +114,7 @@ func sendDirectMessage(webhookCtx webhook.Context) {
 	}
 }
 
-func sendMessage(context webhook.Context) error {
+func sendMessage(context webhook.Context) (int, error) {
 	text := getMarkdownText(context)
 	if len(context.MentionUsersByPhone) > 0 {
 		var ats []string
@@ -137,12 +137,12 @@ func sendMessage(context webhook.Context) error {
 
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -151,29 +151,29 @@ func sendMessage(context webhook.Context) error {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return resp.StatusCode, &webhook.ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)}
 	}
 
 	webhookResponse := &Response{}
 	if err := json.Unmarshal(b, webhookResponse); err != nil {
-		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "malformed webhook response from %s", context.URL)
 	}
 
 	if webhookResponse.ErrorCode != 0 {
-		return errors.Errorf("%s", webhookResponse.ErrorMessage)
+		return resp.StatusCode, errors.Errorf("%s", webhookResponse.ErrorMessage)
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
 
 func getMarkdownText(context webhook.Context) string {
@@ -51,7 +51,7 @@ func init() {
 type DiscordReceiver struct {
 }
 
-func (*DiscordReceiver) Post(context Context) error {
+func (*DiscordReceiver) Post(context Context) (int, error) {
 	embedList := []DiscordWebhookEmbed{}
 
 	fieldList := []DiscordWebhookEmbedField{}
@@ -84,12 +84,12 @@ func (*DiscordReceiver) Post(context Context) error {
 	}
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -98,27 +98,27 @@ func (*DiscordReceiver) Post(context Context) error {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return resp.StatusCode, &ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)}
 	}
 
 	webhookResponse := &DiscordWebhookResponse{}
 	if err := json.Unmarshal(b, webhookResponse); err != nil {
-		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "malformed webhook response from %s", context.URL)
 	}
 
 	if webhookResponse.Code != 0 {
-		return errors.Errorf("%s", webhookResponse.Message)
+		return resp.StatusCode, errors.Errorf("%s", webhookResponse.Message)
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
@@ -0,0 +1,263 @@
+// Package eventsink is the webhook receiver emitting Bytebase events as CloudEvents 1.0 structured JSON envelopes,
+// so that data platforms can consume the issue, rollout and task run events.
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+)
+
+const (
+	// WebhookType is the webhook type of the event sink.
+	WebhookType = "bb.plugin.webhook.eventsink"
+
+	// SpecVersion is the CloudEvents spec version of the emitted events.
+	SpecVersion = "1.0"
+	// ContentType is the content type of a CloudEvents structured JSON envelope.
+	ContentType = "application/cloudevents+json; charset=utf-8"
+
+	eventTypePrefix = "com.bytebase."
+	defaultSource   = "bytebase"
+)
+
+func init() {
+	webhook.Register(WebhookType, &Receiver{})
+}
+
+// CloudEvent is the CloudEvents 1.0 structured JSON envelope.
+type CloudEvent struct {
+	SpecVersion     string     `json:"specversion"`
+	ID              string     `json:"id"`
+	Source          string     `json:"source"`
+	Type            string     `json:"type"`
+	Subject         string     `json:"subject,omitempty"`
+	Time            time.Time  `json:"time"`
+	DataContentType string     `json:"datacontenttype"`
+	Data            *EventData `json:"data"`
+}
+
+// EventData is the data of a Bytebase event.
+type EventData struct {
+	EventType   string       `json:"eventType"`
+	Level       string       `json:"level"`
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	Link        string       `json:"link,omitempty"`
+	Actor       *Actor       `json:"actor,omitempty"`
+	Project     *Project     `json:"project,omitempty"`
+	Issue       *Issue       `json:"issue,omitempty"`
+	Rollout     *Rollout     `json:"rollout,omitempty"`
+	Stage       string       `json:"stage,omitempty"`
+	TaskResult  *TaskResult  `json:"taskResult,omitempty"`
+	SchemaDrift *SchemaDrift `json:"schemaDrift,omitempty"`
+}
+
+// Actor is the user who triggers the event.
+type Actor struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Project is the project of the event.
+type Project struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// Rollout is the rollout of the event.
+type Rollout struct {
+	UID   int    `json:"uid"`
+	Title string `json:"title"`
+}
+
+// Issue is the issue of the event.
+type Issue struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	Type         string `json:"type"`
+	Description  string `json:"description,omitempty"`
+	CreatorEmail string `json:"creatorEmail,omitempty"`
+}
+
+// TaskResult is the task result of the event.
+type TaskResult struct {
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	Detail        string `json:"detail,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+}
+
+// SchemaDrift is the schema drift of the event.
+type SchemaDrift struct {
+	Database string   `json:"database"`
+	Objects  []string `json:"objects"`
+}
+
+// Binding sends CloudEvents to a transport.
+// Send returns the HTTP status code of the transport response, or 0 if there is none.
+type Binding interface {
+	Send(ctx context.Context, event *CloudEvent, signingSecrets []string) (int, error)
+}
+
+// Receiver is the receiver for the event sink.
+type Receiver struct{}
+
+// Post sends the webhook context as a CloudEvent through the binding chosen by the webhook url.
+func (*Receiver) Post(context webhook.Context) (int, error) {
+	binding, err := NewBinding(context.URL)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := contextWithTimeout()
+	defer cancel()
+	return binding.Send(ctx, NewCloudEvent(context, time.Now()), context.SigningSecrets)
+}
+
+// NewBinding returns the binding for the url.
+// "http" and "https" urls use the HTTP binding.
+// "kafka+http" and "kafka+https" urls use the Kafka binding through a Kafka REST proxy,
+// e.g. kafka+https://proxy.example.com/topics/bytebase-events.
+// Other message brokers can be plugged in by implementing Publisher.
+func NewBinding(rawURL string) (Binding, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid event sink url %q", rawURL)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return &HTTPBinding{URL: rawURL}, nil
+	case "kafka+http", "kafka+https":
+		basePath, topic, ok := strings.Cut(u.Path, "/topics/")
+		if !ok || topic == "" || strings.Contains(topic, "/") {
+			return nil, errors.Errorf("kafka event sink url %q must be in the form of kafka+http(s)://host/topics/{topic}", rawURL)
+		}
+		u.Scheme = strings.TrimPrefix(u.Scheme, "kafka+")
+		u.Path = basePath
+		u.RawPath = ""
+		return &KafkaBinding{
+			Publisher: &KafkaRESTPublisher{URL: u.String()},
+			Topic:     topic,
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported event sink url scheme %q", u.Scheme)
+	}
+}
+
+// NewCloudEvent converts the webhook context to a CloudEvent.
+func NewCloudEvent(context webhook.Context, now time.Time) *CloudEvent {
+	id := context.EventID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	eventTime := now.UTC()
+	if context.CreatedTS > 0 {
+		eventTime = time.Unix(context.CreatedTS, 0).UTC()
+	}
+	source := defaultSource
+	if context.Project != nil && context.Project.Name != "" {
+		source = context.Project.Name
+	}
+
+	data := &EventData{
+		EventType:   context.EventType,
+		Level:       string(context.Level),
+		Title:       context.Title,
+		Description: context.Description,
+		Link:        context.Link,
+	}
+	if v := context.Project; v != nil {
+		data.Project = &Project{
+			Name:  v.Name,
+			Title: v.Title,
+		}
+	}
+	if v := context.Rollout; v != nil {
+		data.Rollout = &Rollout{
+			UID:   v.UID,
+			Title: v.Title,
+		}
+	}
+	if v := context.Stage; v != nil {
+		data.Stage = v.Name
+	}
+	if context.ActorName != "" || context.ActorEmail != "" {
+		data.Actor = &Actor{
+			ID:    context.ActorID,
+			Name:  context.ActorName,
+			Email: context.ActorEmail,
+		}
+	}
+	var subject string
+	if v := context.Issue; v != nil {
+		data.Issue = &Issue{
+			ID:          v.ID,
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+		}
+		if v.Creator != nil {
+			data.Issue.CreatorEmail = v.Creator.Email
+		}
+		subject = fmt.Sprintf("issues/%d", v.ID)
+	} else if v := context.Rollout; v != nil {
+		subject = fmt.Sprintf("rollouts/%d", v.UID)
+	}
+	if v := context.SchemaDrift; v != nil {
+		data.SchemaDrift = &SchemaDrift{
+			Database: v.Database,
+			Objects:  v.Objects,
+		}
+		if subject == "" {
+			subject = v.Database
+		}
+	}
+	if v := context.TaskResult; v != nil {
+		data.TaskResult = &TaskResult{
+			Name:          v.Name,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			SkippedReason: v.SkippedReason,
+		}
+	}
+
+	return &CloudEvent{
+		SpecVersion:     SpecVersion,
+		ID:              id,
+		Source:          source,
+		Type:            GetCloudEventType(context.EventType),
+		Subject:         subject,
+		Time:            eventTime,
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// GetCloudEventType converts the Bytebase event type to the CloudEvents type,
+// e.g. "bb.webhook.event.issue.create" to "com.bytebase.issue.create".
+func GetCloudEventType(eventType string) string {
+	return eventTypePrefix + strings.TrimPrefix(eventType, "bb.webhook.event.")
+}
+
+func marshalCloudEvent(event *CloudEvent) ([]byte, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal cloud event %s", event.ID)
+	}
+	return body, nil
+}
+
+func contextWithTimeout() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), webhook.Timeout)
+}
@@ -0,0 +1,178 @@
+package eventsink
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func newTestContext(url string) webhook.Context {
+	return webhook.Context{
+		URL:        url,
+		Level:      webhook.WebhookInfo,
+		EventType:  common.EventTypeIssueCreate,
+		Title:      "Issue created",
+		Link:       "https://bytebase.example.com/projects/p1/issues/101",
+		ActorID:    200,
+		ActorName:  "Alice",
+		ActorEmail: "alice@example.com",
+		CreatedTS:  1700000000,
+		EventID:    "42",
+		Issue: &webhook.Issue{
+			ID:      101,
+			Name:    "Add column",
+			Status:  "OPEN",
+			Type:    "bb.issue.database.schema.update",
+			Creator: &store.UserMessage{Email: "alice@example.com"},
+		},
+		Project: &webhook.Project{
+			Name:  "projects/p1",
+			Title: "Project 1",
+		},
+	}
+}
+
+func TestNewCloudEvent(t *testing.T) {
+	a := require.New(t)
+	event := NewCloudEvent(newTestContext("http://localhost"), time.Now())
+	a.Equal("1.0", event.SpecVersion)
+	a.Equal("42", event.ID)
+	a.Equal("projects/p1", event.Source)
+	a.Equal("com.bytebase.issue.create", event.Type)
+	a.Equal("issues/101", event.Subject)
+	a.Equal(time.Unix(1700000000, 0).UTC(), event.Time)
+	a.Equal("application/json", event.DataContentType)
+	a.Equal(common.EventTypeIssueCreate, event.Data.EventType)
+	a.Equal("alice@example.com", event.Data.Issue.CreatorEmail)
+	a.Equal("Project 1", event.Data.Project.Title)
+
+	// A random ID is generated if the context carries none.
+	noID := newTestContext("http://localhost")
+	noID.EventID = ""
+	a.NotEmpty(NewCloudEvent(noID, time.Now()).ID)
+}
+
+func TestGetCloudEventType(t *testing.T) {
+	a := require.New(t)
+	a.Equal("com.bytebase.issue.status.update", GetCloudEventType(common.EventTypeIssueStatusUpdate))
+	a.Equal("com.bytebase.stage.status.update", GetCloudEventType(common.EventTypeStageStatusUpdate))
+	a.Equal("com.bytebase.taskRun.status.update", GetCloudEventType(common.EventTypeTaskRunStatusUpdate))
+	a.Equal("com.bytebase.database.schema.drift", GetCloudEventType(common.EventTypeSchemaDrift))
+}
+
+func TestNewCloudEventSchemaDrift(t *testing.T) {
+	a := require.New(t)
+	ctx := newTestContext("http://localhost")
+	ctx.EventType = common.EventTypeSchemaDrift
+	ctx.Issue = nil
+	ctx.SchemaDrift = &webhook.SchemaDrift{
+		Database: "instances/prod/databases/db",
+		Objects:  []string{"ALTER TABLE public.users"},
+	}
+
+	event := NewCloudEvent(ctx, time.Now())
+	a.Equal("com.bytebase.database.schema.drift", event.Type)
+	a.Equal("instances/prod/databases/db", event.Subject)
+	a.Equal([]string{"ALTER TABLE public.users"}, event.Data.SchemaDrift.Objects)
+}
+
+func TestNewBinding(t *testing.T) {
+	a := require.New(t)
+
+	binding, err := NewBinding("https://sink.example.com/events")
+	a.NoError(err)
+	a.Equal(&HTTPBinding{URL: "https://sink.example.com/events"}, binding)
+
+	binding, err = NewBinding("kafka+https://proxy.example.com/kafka/topics/bytebase-events")
+	a.NoError(err)
+	a.Equal(&KafkaBinding{
+		Publisher: &KafkaRESTPublisher{URL: "https://proxy.example.com/kafka"},
+		Topic:     "bytebase-events",
+	}, binding)
+
+	_, err = NewBinding("kafka+https://proxy.example.com/bytebase-events")
+	a.Error(err)
+	_, err = NewBinding("ftp://sink.example.com")
+	a.Error(err)
+}
+
+func TestHTTPBinding(t *testing.T) {
+	a := require.New(t)
+	var gotHeader http.Header
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	ctx := newTestContext(server.URL)
+	ctx.SigningSecrets = []string{"secret"}
+	statusCode, err := (&Receiver{}).Post(ctx)
+	a.NoError(err)
+	a.Equal(http.StatusAccepted, statusCode)
+
+	a.Equal(ContentType, gotHeader.Get("Content-Type"))
+	a.NoError(webhook.VerifySignature(gotHeader, gotBody, "secret", 0, time.Now()))
+	var envelope map[string]any
+	a.NoError(json.Unmarshal(gotBody, &envelope))
+	a.Equal("1.0", envelope["specversion"])
+	a.Equal("42", envelope["id"])
+	a.Equal("com.bytebase.issue.create", envelope["type"])
+	a.Equal("2023-11-14T22:13:20Z", envelope["time"])
+	data, ok := envelope["data"].(map[string]any)
+	a.True(ok)
+	a.Equal("Issue created", data["title"])
+}
+
+func TestHTTPBindingError(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	statusCode, err := (&Receiver{}).Post(newTestContext(server.URL))
+	a.Error(err)
+	a.Equal(http.StatusServiceUnavailable, statusCode)
+	a.Equal(http.StatusServiceUnavailable, webhook.GetStatusCode(err))
+}
+
+func TestKafkaBinding(t *testing.T) {
+	a := require.New(t)
+	var gotPath, gotContentType string
+	var gotRecords kafkaRESTRecords
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		a.NoError(json.NewDecoder(r.Body).Decode(&gotRecords))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	url := "kafka+" + server.URL + "/topics/bytebase-events"
+	a.True(strings.HasPrefix(url, "kafka+http://"))
+	statusCode, err := (&Receiver{}).Post(newTestContext(url))
+	a.NoError(err)
+	a.Equal(http.StatusOK, statusCode)
+
+	a.Equal("/topics/bytebase-events", gotPath)
+	a.Equal("application/vnd.kafka.json.v2+json", gotContentType)
+	a.Len(gotRecords.Records, 1)
+	a.Equal("issues/101", gotRecords.Records[0].Key)
+	var event CloudEvent
+	a.NoError(json.Unmarshal(gotRecords.Records[0].Value, &event))
+	a.Equal("42", event.ID)
+	a.Equal("projects/p1", event.Source)
+}
@@ -0,0 +1,45 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+)
+
+// HTTPBinding sends CloudEvents in the structured content mode of the CloudEvents HTTP protocol binding.
+type HTTPBinding struct {
+	URL string
+}
+
+// Send posts the event to the url.
+func (b *HTTPBinding) Send(ctx context.Context, event *CloudEvent, signingSecrets []string) (int, error) {
+	body, err := marshalCloudEvent(event)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create event sink POST request to %s", b.URL)
+	}
+	req.Header.Set("Content-Type", ContentType)
+	webhook.SignRequest(req.Header, signingSecrets, time.Now(), body)
+	client := &http.Client{Timeout: webhook.Timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to POST event to %s", b.URL)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, &webhook.ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("event sink returned status %d, body: %s", resp.StatusCode, respBody)}
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,91 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+)
+
+// Publisher publishes a keyed message to a topic of a message broker,
+// such as Kafka or NATS JetStream.
+// Publish returns the HTTP status code of the broker response, or 0 if the broker is not reached over HTTP.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key string, value []byte) (int, error)
+}
+
+// KafkaBinding sends CloudEvents in the structured content mode of the CloudEvents Kafka protocol binding.
+// The event subject, or the event source if there is no subject, is used as the message key,
+// so that the events of the same issue land in the same partition in order.
+type KafkaBinding struct {
+	Publisher Publisher
+	Topic     string
+}
+
+// Send publishes the event to the topic.
+// The signing secrets are not used, the broker is expected to authenticate the producer.
+func (b *KafkaBinding) Send(ctx context.Context, event *CloudEvent, _ []string) (int, error) {
+	value, err := marshalCloudEvent(event)
+	if err != nil {
+		return 0, err
+	}
+	key := event.Subject
+	if key == "" {
+		key = event.Source
+	}
+	return b.Publisher.Publish(ctx, b.Topic, key, value)
+}
+
+// KafkaRESTPublisher publishes messages through the Kafka REST proxy v2 API.
+type KafkaRESTPublisher struct {
+	// URL is the base url of the Kafka REST proxy.
+	URL string
+}
+
+type kafkaRESTRecords struct {
+	Records []kafkaRESTRecord `json:"records"`
+}
+
+type kafkaRESTRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Publish produces a JSON record to the topic.
+func (p *KafkaRESTPublisher) Publish(ctx context.Context, topic string, key string, value []byte) (int, error) {
+	body, err := json.Marshal(kafkaRESTRecords{
+		Records: []kafkaRESTRecord{
+			{Key: key, Value: value},
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to marshal kafka records")
+	}
+
+	topicURL := strings.TrimSuffix(p.URL, "/") + "/topics/" + url.PathEscape(topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, topicURL, bytes.NewBuffer(body))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create kafka REST proxy request to %s", topicURL)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	client := &http.Client{Timeout: webhook.Timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to produce to kafka topic %s", topic)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, &webhook.ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("kafka REST proxy returned status %d, body: %s", resp.StatusCode, respBody)}
+	}
+	return resp.StatusCode, nil
+}
@@ -102,10 +102,10 @@ func init() {
 type larkReceiver struct {
 }
 
-func (*larkReceiver) Post(context webhook.Context) error {
+func (*larkReceiver) Post(context webhook.Context) (int, error) {
 	if context.DirectMessage && len(context.MentionEndUsers) > 0 {
 		postDirectMessage(context)
-		return nil
+		return 0, nil
 	}
 	return postMessage(context)
 }
@@ -158,19 +158,19 @@ func postDirectMessage(webhookCtx webhook.Context) {
 	}
 }
 
-func postMessage(context webhook.Context) error {
+func postMessage(context webhook.Context) (int, error) {
 	post := Webhook{
 		MessageType: "interactive",
 		Card:        getMessageCard(context),
 	}
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -179,29 +179,29 @@ func postMessage(context webhook.Context) error {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return resp.StatusCode, &webhook.ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)}
 	}
 
 	webhookResponse := &WebhookResponse{}
 	if err := json.Unmarshal(b, webhookResponse); err != nil {
-		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "malformed webhook response from %s", context.URL)
 	}
 
 	if webhookResponse.Code != 0 {
-		return errors.Errorf("%s", webhookResponse.Message)
+		return resp.StatusCode, errors.Errorf("%s", webhookResponse.Message)
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
 
 func getMessageCard(context webhook.Context) *WebhookCard {
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	// SignatureHeader is the header carrying the payload signatures.
+	// The value is a comma separated list of "v1=<hex-encoded HMAC-SHA256>",
+	// one for each active signing secret.
+	SignatureHeader = "X-Bytebase-Signature"
+	// TimestampHeader is the header carrying the unix timestamp in seconds when the payload is signed.
+	TimestampHeader = "X-Bytebase-Timestamp"
+	// DefaultSignatureTolerance is the default maximum age of a signed payload accepted by VerifySignature.
+	DefaultSignatureTolerance = 5 * time.Minute
+
+	signatureScheme = "v1"
+)
+
+// GenerateSigningSecret generates a random signing secret.
+func GenerateSigningSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrapf(err, "failed to generate signing secret")
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// GetSigningSecrets returns the active signing secrets of a webhook,
+// including the previous secret if it's still within the grace period.
+func GetSigningSecrets(payload *storepb.ProjectWebhookPayload, now time.Time) []string {
+	var secrets []string
+	if v := payload.GetSigningSecret(); v != "" {
+		secrets = append(secrets, v)
+	}
+	if v := payload.GetPreviousSigningSecret(); v != "" && now.Before(payload.GetPreviousSigningSecretExpireTime().AsTime()) {
+		secrets = append(secrets, v)
+	}
+	return secrets
+}
+
+// ComputeSignature returns the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" keyed by secret.
+func ComputeSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest sets the timestamp and signature headers of a request with the body signed by each secret.
+// Empty secrets are ignored, and the request is left untouched if there is no secret.
+func SignRequest(header http.Header, secrets []string, timestamp time.Time, body []byte) {
+	ts := timestamp.Unix()
+	var signatures []string
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		signatures = append(signatures, signatureScheme+"="+ComputeSignature(secret, ts, body))
+	}
+	if len(signatures) == 0 {
+		return
+	}
+	header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	header.Set(SignatureHeader, strings.Join(signatures, ","))
+}
+
+// VerifySignature verifies the signature and timestamp headers of a webhook request received by a downstream service.
+// The request is accepted if any signature matches the secret, and the timestamp is within tolerance of now.
+// A non-positive tolerance disables the timestamp check.
+func VerifySignature(header http.Header, body []byte, secret string, tolerance time.Duration, now time.Time) error {
+	timestampValue := header.Get(TimestampHeader)
+	if timestampValue == "" {
+		return errors.Errorf("missing %s header", TimestampHeader)
+	}
+	signatureValue := header.Get(SignatureHeader)
+	if signatureValue == "" {
+		return errors.Errorf("missing %s header", SignatureHeader)
+	}
+	ts, err := strconv.ParseInt(timestampValue, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid %s header %q", TimestampHeader, timestampValue)
+	}
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(ts, 0))
+		if age > tolerance || age < -tolerance {
+			return errors.Errorf("timestamp %d is outside of the tolerance %v", ts, tolerance)
+		}
+	}
+
+	expected := []byte(ComputeSignature(secret, ts, body))
+	for _, part := range strings.Split(signatureValue, ",") {
+		scheme, signature, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || scheme != signatureScheme {
+			continue
+		}
+		if hmac.Equal([]byte(signature), expected) {
+			return nil
+		}
+	}
+	return errors.New("no matching signature")
+}
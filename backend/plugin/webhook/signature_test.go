@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	a := require.New(t)
+	body := []byte(`{"title":"hello"}`)
+	now := time.Unix(1700000000, 0)
+
+	header := http.Header{}
+	SignRequest(header, []string{"new", "old"}, now, body)
+	a.Equal("1700000000", header.Get(TimestampHeader))
+	a.Equal(2, len(strings.Split(header.Get(SignatureHeader), ",")))
+
+	// Both the new and the previous secret verify during the grace period.
+	a.NoError(VerifySignature(header, body, "new", DefaultSignatureTolerance, now))
+	a.NoError(VerifySignature(header, body, "old", DefaultSignatureTolerance, now.Add(time.Minute)))
+
+	// Wrong secret, tampered body and stale timestamp are rejected.
+	a.Error(VerifySignature(header, body, "other", DefaultSignatureTolerance, now))
+	a.Error(VerifySignature(header, []byte(`{"title":"bye"}`), "new", DefaultSignatureTolerance, now))
+	a.Error(VerifySignature(header, body, "new", DefaultSignatureTolerance, now.Add(time.Hour)))
+	a.NoError(VerifySignature(header, body, "new", 0, now.Add(time.Hour)))
+
+	// Missing headers are rejected.
+	a.Error(VerifySignature(http.Header{}, body, "new", DefaultSignatureTolerance, now))
+}
+
+func TestSignRequestWithoutSecret(t *testing.T) {
+	a := require.New(t)
+	header := http.Header{}
+	SignRequest(header, []string{""}, time.Now(), []byte("body"))
+	a.Empty(header.Get(TimestampHeader))
+	a.Empty(header.Get(SignatureHeader))
+}
+
+func TestGetSigningSecrets(t *testing.T) {
+	a := require.New(t)
+	now := time.Now()
+	payload := &storepb.ProjectWebhookPayload{
+		SigningSecret:                   "new",
+		PreviousSigningSecret:           "old",
+		PreviousSigningSecretExpireTime: timestamppb.New(now.Add(time.Hour)),
+	}
+	a.Equal([]string{"new", "old"}, GetSigningSecrets(payload, now))
+	a.Equal([]string{"new"}, GetSigningSecrets(payload, now.Add(2*time.Hour)))
+	a.Empty(GetSigningSecrets(&storepb.ProjectWebhookPayload{}, now))
+	a.Empty(GetSigningSecrets(nil, now))
+}
+
+func TestCustomReceiverSignsPayload(t *testing.T) {
+	a := require.New(t)
+	var gotHeader http.Header
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	statusCode, err := (&CustomReceiver{}).Post(Context{
+		URL:            server.URL,
+		Title:          "title",
+		SigningSecrets: []string{"secret"},
+	})
+	a.NoError(err)
+	a.Equal(http.StatusOK, statusCode)
+	a.NoError(VerifySignature(gotHeader, gotBody, "secret", DefaultSignatureTolerance, time.Now()))
+}
@@ -122,15 +122,15 @@ func GetBlocks(context webhook.Context) []Block {
 	return blockList
 }
 
-func (*Receiver) Post(context webhook.Context) error {
+func (*Receiver) Post(context webhook.Context) (int, error) {
 	if context.DirectMessage && len(context.MentionEndUsers) > 0 {
 		postDirectMessage(context)
-		return nil
+		return 0, nil
 	}
 	return postMessage(context)
 }
 
-func postMessage(context webhook.Context) error {
+func postMessage(context webhook.Context) (int, error) {
 	blockList := GetBlocks(context)
 
 	post := MessagePayload{
@@ -139,12 +139,12 @@ func postMessage(context webhook.Context) error {
 	}
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -153,24 +153,24 @@ func postMessage(context webhook.Context) error {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return resp.StatusCode, &webhook.ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)}
 	}
 
 	if string(b) != "ok" {
-		return errors.Errorf("%.100s", string(b))
+		return resp.StatusCode, errors.Errorf("%.100s", string(b))
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
 
 func postDirectMessage(webhookCtx webhook.Context) {
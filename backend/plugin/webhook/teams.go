@@ -58,7 +58,7 @@ func init() {
 type TeamsReceiver struct {
 }
 
-func (*TeamsReceiver) Post(context Context) error {
+func (*TeamsReceiver) Post(context Context) (int, error) {
 	factList := []TeamsWebhookSectionFact{}
 	for _, meta := range context.GetMetaList() {
 		factList = append(factList, TeamsWebhookSectionFact(meta))
@@ -92,12 +92,12 @@ func (*TeamsReceiver) Post(context Context) error {
 	}
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -106,22 +106,22 @@ func (*TeamsReceiver) Post(context Context) error {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return resp.StatusCode, &ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)}
 	}
 
 	if string(b) != "1" {
-		return errors.Errorf("%.100s", string(b))
+		return resp.StatusCode, errors.Errorf("%.100s", string(b))
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
@@ -3,6 +3,7 @@ package webhook
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -70,6 +71,14 @@ type TaskResult struct {
 	SkippedReason string
 }
 
+// SchemaDrift is the schema drift of a database.
+type SchemaDrift struct {
+	// Database is the database resource name, e.g. instances/{instance}/databases/{database}.
+	Database string
+	// Objects are the drifted objects, e.g. "ALTER TABLE public.users".
+	Objects []string
+}
+
 // Project object of project.
 type Project struct {
 	Name  string
@@ -94,17 +103,49 @@ type Context struct {
 	Stage       *Stage
 	Project     *Project
 	TaskResult  *TaskResult
+	SchemaDrift *SchemaDrift
 	// End users that should be mentioned.
 	MentionEndUsers     []*store.UserMessage
 	MentionUsersByPhone []string
 
 	DirectMessage bool
 	IMSetting     *storepb.AppIMSetting
+	// SigningSecrets are the secrets to sign the payload with, only used by custom webhooks and event sinks.
+	SigningSecrets []string
+	// EventID identifies the event across retries, only used by event sinks.
+	// A random ID is generated if it's empty.
+	EventID string
+}
+
+// ResponseError is the error returned by a receiver when the webhook endpoint
+// responds with an unexpected HTTP status code.
+type ResponseError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *ResponseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
+// GetStatusCode returns the HTTP status code carried by err, or 0 if there is none.
+func GetStatusCode(err error) int {
+	var responseErr *ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode
+	}
+	return 0
 }
 
 // Receiver is the webhook receiver.
 type Receiver interface {
-	Post(context Context) error
+	// Post sends the message and returns the HTTP status code of the receiver response,
+	// or 0 if no response is received, e.g. the request fails or the message is sent as direct messages.
+	Post(context Context) (int, error)
 }
 
 func (c *Context) GetMetaList() []Meta {
@@ -174,6 +215,16 @@ func (c *Context) GetMetaList() []Meta {
 		}
 	}
 
+	if c.SchemaDrift != nil {
+		m = append(m, Meta{
+			Name:  "Database",
+			Value: c.SchemaDrift.Database,
+		}, Meta{
+			Name:  "Drifted Objects",
+			Value: common.TruncateStringWithDescription(strings.Join(c.SchemaDrift.Objects, "\n")),
+		})
+	}
+
 	return m
 }
 
@@ -244,6 +295,16 @@ func (c *Context) GetMetaListZh() []Meta {
 		}
 	}
 
+	if c.SchemaDrift != nil {
+		m = append(m, Meta{
+			Name:  "数据库",
+			Value: c.SchemaDrift.Database,
+		}, Meta{
+			Name:  "漂移对象",
+			Value: common.TruncateStringWithDescription(strings.Join(c.SchemaDrift.Objects, "\n")),
+		})
+	}
+
 	return m
 }
 
@@ -262,13 +323,13 @@ func Register(host string, r Receiver) {
 	receivers[host] = r
 }
 
-// Post posts the message to webhook.
-func Post(webhookType string, context Context) error {
+// Post posts the message to webhook and returns the HTTP status code of the receiver response.
+func Post(webhookType string, context Context) (int, error) {
 	receiverMu.RLock()
 	r, ok := receivers[webhookType]
 	receiverMu.RUnlock()
 	if !ok {
-		return errors.Errorf("webhook: no applicable receiver for webhook type: %v", webhookType)
+		return 0, errors.Errorf("webhook: no applicable receiver for webhook type: %v", webhookType)
 	}
 	return r.Post(context)
 }
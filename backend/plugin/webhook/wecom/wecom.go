@@ -69,32 +69,32 @@ func getMessageCard(context webhook.Context) *WebhookMarkdown {
 	}
 }
 
-func (r *Receiver) Post(context webhook.Context) error {
+func (r *Receiver) Post(context webhook.Context) (int, error) {
 	if context.DirectMessage && len(context.MentionEndUsers) > 0 {
 		if r.sendDirectMessage(context) {
-			return nil
+			return 0, nil
 		}
 	}
-	err := r.sendMessage(context)
+	statusCode, err := r.sendMessage(context)
 	if err != nil {
-		return backoff.Permanent(err)
+		return statusCode, backoff.Permanent(err)
 	}
-	return nil
+	return statusCode, nil
 }
 
-func (*Receiver) sendMessage(context webhook.Context) error {
+func (*Receiver) sendMessage(context webhook.Context) (int, error) {
 	post := Webhook{
 		MessageType: "markdown",
 		Markdown:    getMessageCard(context),
 	}
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -103,29 +103,29 @@ func (*Receiver) sendMessage(context webhook.Context) error {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return resp.StatusCode, &webhook.ResponseError{StatusCode: resp.StatusCode, Err: errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)}
 	}
 
 	webhookResponse := &WebhookResponse{}
 	if err := json.Unmarshal(b, webhookResponse); err != nil {
-		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+		return resp.StatusCode, errors.Wrapf(err, "malformed webhook response from %s", context.URL)
 	}
 
 	if webhookResponse.ErrorCode != 0 {
-		return errors.Errorf("%s", webhookResponse.ErrorMessage)
+		return resp.StatusCode, errors.Errorf("%s", webhookResponse.ErrorMessage)
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
 
 // sendDirectMessage sends direct message to users.
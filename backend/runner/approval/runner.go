@@ -19,6 +19,7 @@ import (
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/coordinator"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
@@ -38,10 +39,11 @@ type Runner struct {
 	stateCfg       *state.State
 	webhookManager *webhook.Manager
 	licenseService *enterprise.LicenseService
+	coordinator    *coordinator.Coordinator
 }
 
 // NewRunner creates a new runner.
-func NewRunner(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, stateCfg *state.State, webhookManager *webhook.Manager, licenseService *enterprise.LicenseService) *Runner {
+func NewRunner(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, stateCfg *state.State, webhookManager *webhook.Manager, licenseService *enterprise.LicenseService, coordinator *coordinator.Coordinator) *Runner {
 	return &Runner{
 		store:          store,
 		sheetManager:   sheetManager,
@@ -49,10 +51,15 @@ func NewRunner(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfac
 		stateCfg:       stateCfg,
 		webhookManager: webhookManager,
 		licenseService: licenseService,
+		coordinator:    coordinator,
 	}
 }
 
-const approvalRunnerInterval = 1 * time.Second
+const (
+	approvalRunnerInterval = 1 * time.Second
+	// approvalRetryInterval is the interval for the leader to pick up the issues enqueued on the other replicas in HA mode.
+	approvalRetryInterval = 1 * time.Minute
+)
 
 // Run runs the runner.
 func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
@@ -61,9 +68,15 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 	slog.Debug(fmt.Sprintf("Approval runner started and will run every %v", approvalRunnerInterval))
 	r.retryFindApprovalTemplate(ctx)
+	retryTicker := time.NewTicker(approvalRetryInterval)
+	defer retryTicker.Stop()
 
 	for {
 		select {
+		case <-retryTicker.C:
+			if r.coordinator.Enabled() && r.coordinator.IsLeader() {
+				r.retryFindApprovalTemplate(ctx)
+			}
 		case <-ticker.C:
 			func() {
 				defer func() {
@@ -84,6 +97,11 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 }
 
 func (r *Runner) runOnce(ctx context.Context) {
+	if !r.coordinator.IsActive() {
+		// The issues are picked up by the leader.
+		r.stateCfg.ApprovalFinding.Clear()
+		return
+	}
 	risks, err := r.store.ListRisks(ctx)
 	if err != nil {
 		slog.Error("failed to list risks", log.BBError(err))
@@ -100,7 +118,7 @@ func (r *Runner) runOnce(ctx context.Context) {
 		if !ok {
 			return true
 		}
-		done, err := r.findApprovalTemplateForIssue(ctx, issue, risks, approvalSetting)
+		done, err := r.tryFindApprovalTemplateForIssue(ctx, issue, risks, approvalSetting)
 		if err != nil {
 			slog.Error("failed to find approval template for issue", slog.Int("issue", issue.UID), log.BBError(err))
 		}
@@ -111,6 +129,32 @@ func (r *Runner) runOnce(ctx context.Context) {
 	})
 }
 
+// tryFindApprovalTemplateForIssue claims the issue so that it is not processed by other replicas at the same time.
+// It returns false if the issue is claimed by another replica.
+func (r *Runner) tryFindApprovalTemplateForIssue(ctx context.Context, issue *store.IssueMessage, risks []*store.RiskMessage, approvalSetting *storepb.WorkspaceApprovalSetting) (bool, error) {
+	if !r.coordinator.Enabled() {
+		return r.findApprovalTemplateForIssue(ctx, issue, risks, approvalSetting)
+	}
+	claimed, err := r.coordinator.TryLock(ctx, coordinator.LockKindIssueApproval, issue.UID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to claim issue")
+	}
+	if !claimed {
+		return false, nil
+	}
+	defer r.coordinator.Unlock(ctx, coordinator.LockKindIssueApproval, issue.UID)
+
+	// The issue may have been processed by another replica.
+	latest, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issue.UID})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get issue")
+	}
+	if latest == nil {
+		return true, nil
+	}
+	return r.findApprovalTemplateForIssue(ctx, latest, risks, approvalSetting)
+}
+
 func (r *Runner) retryFindApprovalTemplate(ctx context.Context) {
 	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
 		StatusList: []storepb.Issue_Status{storepb.Issue_OPEN},
@@ -355,7 +399,7 @@ func (r *Runner) getDatabaseGeneralIssueRisk(ctx context.Context, issue *store.I
 
 	planCheckRuns, err := r.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
 		PlanUID: &plan.UID,
-		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport},
+		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport, store.PlanCheckDatabaseStatementLockImpact},
 	})
 	if err != nil {
 		return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to list plan check runs for plan %v", plan.UID)
@@ -365,11 +409,16 @@ func (r *Runner) getDatabaseGeneralIssueRisk(ctx context.Context, issue *store.I
 		DatabaseName string
 	}
 	latestPlanCheckRun := map[Key]*store.PlanCheckRunMessage{}
+	latestLockImpactPlanCheckRun := map[Key]*store.PlanCheckRunMessage{}
 	for _, run := range planCheckRuns {
 		key := Key{
 			InstanceID:   run.Config.InstanceId,
 			DatabaseName: run.Config.DatabaseName,
 		}
+		if run.Type == store.PlanCheckDatabaseStatementLockImpact {
+			latestLockImpactPlanCheckRun[key] = run
+			continue
+		}
 		latestPlanCheckRun[key] = run
 	}
 
@@ -416,6 +465,13 @@ func (r *Runner) getDatabaseGeneralIssueRisk(ctx context.Context, issue *store.I
 		return 0, store.RiskSourceUnknown, false, nil
 	}
 
+	// The lock impact analysis does not query the database, wait for all of them to finish.
+	for _, run := range latestLockImpactPlanCheckRun {
+		if run.Status == store.PlanCheckRunStatusRunning {
+			return 0, store.RiskSourceUnknown, false, nil
+		}
+	}
+
 	pipelineCreate, err := apiv1.GetPipelineCreate(ctx, r.store, r.sheetManager, r.dbFactory, plan.Name, plan.Config.GetSpecs(), plan.Config.GetDeployment(), issue.Project)
 	if err != nil {
 		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get pipeline create")
@@ -498,6 +554,24 @@ func (r *Runner) getDatabaseGeneralIssueRisk(ctx context.Context, issue *store.I
 					}
 				}
 			}
+			if run, ok := latestLockImpactPlanCheckRun[Key{
+				InstanceID:   instance.ResourceID,
+				DatabaseName: databaseName,
+			}]; ok {
+				for _, result := range run.Result.GetResults() {
+					report := result.GetLockImpactReport()
+					if report == nil {
+						continue
+					}
+					riskLevel, err := apiv1.CalculateRiskLevelWithLockImpactReport(ctx, risks, commonArgs, riskSource, report)
+					if err != nil {
+						return 0, err
+					}
+					if riskLevel > greatestRiskLevel {
+						greatestRiskLevel = riskLevel
+					}
+				}
+			}
 			return greatestRiskLevel, nil
 		}()
 		if err != nil {
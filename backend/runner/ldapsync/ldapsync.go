@@ -0,0 +1,319 @@
+// Package ldapsync is the runner for synchronizing the LDAP groups into the Bytebase groups.
+package ldapsync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/coordinator"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	ldapSyncRunnerInterval = 10 * time.Minute
+	// ldapSource is the source of the groups synchronized from LDAP.
+	ldapSource = "LDAP"
+)
+
+// Syncer synchronizes the members of the Bytebase groups with the LDAP groups.
+type Syncer struct {
+	store      *store.Store
+	iamManager *iam.Manager
+}
+
+// NewSyncer creates a new syncer.
+func NewSyncer(store *store.Store, iamManager *iam.Manager) *Syncer {
+	return &Syncer{
+		store:      store,
+		iamManager: iamManager,
+	}
+}
+
+// Runner is the runner for synchronizing the LDAP groups periodically.
+type Runner struct {
+	syncer      *Syncer
+	store       *store.Store
+	coordinator *coordinator.Coordinator
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, syncer *Syncer, coordinator *coordinator.Coordinator) *Runner {
+	return &Runner{
+		syncer:      syncer,
+		store:       store,
+		coordinator: coordinator,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(ldapSyncRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("LDAP group sync runner started and will run every %v", ldapSyncRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			// Only the leader synchronizes the groups in HA mode.
+			if !r.coordinator.IsLeader() {
+				continue
+			}
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("LDAP group sync runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runOnce(ctx)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	idps, err := r.store.ListIdentityProviders(ctx, &store.FindIdentityProviderMessage{})
+	if err != nil {
+		slog.Error("failed to list identity providers", log.BBError(err))
+		return
+	}
+	for _, idp := range idps {
+		if idp.Type != storepb.IdentityProviderType_LDAP || !idp.Config.GetLdapConfig().GetGroupSync().GetEnabled() {
+			continue
+		}
+		if _, err := r.syncer.SyncGroups(ctx, idp, false /* validateOnly */, common.SystemBotID); err != nil {
+			slog.Error("failed to sync LDAP groups", slog.String("idp", idp.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+// SyncGroups synchronizes the Bytebase groups mapped by the LDAP identity provider and returns the changes.
+// The changes are only computed but not applied if validateOnly is true.
+// Otherwise, an audit log on behalf of the user is written for each changed group.
+func (s *Syncer) SyncGroups(ctx context.Context, idp *store.IdentityProviderMessage, validateOnly bool, userUID int) ([]*v1pb.LDAPGroupChange, error) {
+	ldapConfig := idp.Config.GetLdapConfig()
+	if idp.Type != storepb.IdentityProviderType_LDAP || ldapConfig == nil {
+		return nil, errors.Errorf("identity provider %q is not LDAP", idp.ResourceID)
+	}
+	groupSync := ldapConfig.GetGroupSync()
+	if len(groupSync.GetMappings()) == 0 {
+		return nil, nil
+	}
+
+	ldapIDP, err := ldap.NewIdentityProvider(
+		ldap.IdentityProviderConfig{
+			Host:             ldapConfig.Host,
+			Port:             int(ldapConfig.Port),
+			SkipTLSVerify:    ldapConfig.SkipTlsVerify,
+			BindDN:           ldapConfig.BindDn,
+			BindPassword:     ldapConfig.BindPassword,
+			BaseDN:           ldapConfig.BaseDn,
+			UserFilter:       ldapConfig.UserFilter,
+			SecurityProtocol: ldapConfig.SecurityProtocol,
+			FieldMapping:     ldapConfig.FieldMapping,
+			GroupSync:        groupSync,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create LDAP identity provider")
+	}
+	conn, err := ldapIDP.Connect()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to LDAP server")
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Multiple mappings to the same group are merged.
+	var groupEmails []string
+	desired := map[string][]string{}
+	for _, mapping := range groupSync.GetMappings() {
+		identifiers, err := ldapIDP.GroupMembers(conn, mapping)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get members of LDAP group mapped to %q", mapping.Group)
+		}
+		members, err := s.resolveMembers(ctx, idp, identifiers)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := desired[mapping.Group]; !ok {
+			groupEmails = append(groupEmails, mapping.Group)
+		}
+		desired[mapping.Group] = append(desired[mapping.Group], members...)
+	}
+
+	var changes []*v1pb.LDAPGroupChange
+	var workspaceID string
+	for _, groupEmail := range groupEmails {
+		group, err := s.store.GetGroup(ctx, groupEmail)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get group %q", groupEmail)
+		}
+		if group == nil {
+			return nil, errors.Errorf("group %q not found", groupEmail)
+		}
+		if group.Payload.Source != "" && group.Payload.Source != ldapSource {
+			return nil, errors.Errorf("group %q is synchronized from %s", groupEmail, group.Payload.Source)
+		}
+
+		members, added, removed := diffMembers(group.Payload.Members, desired[groupEmail])
+		if len(added) == 0 && len(removed) == 0 && group.Payload.Source == ldapSource {
+			continue
+		}
+		change := &v1pb.LDAPGroupChange{
+			Group: common.FormatGroupEmail(groupEmail),
+		}
+		if change.AddedMembers, err = s.formatMembers(ctx, added); err != nil {
+			return nil, err
+		}
+		if change.RemovedMembers, err = s.formatMembers(ctx, removed); err != nil {
+			return nil, err
+		}
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, change)
+		}
+		if validateOnly {
+			continue
+		}
+
+		if _, err := s.store.UpdateGroup(ctx, groupEmail, &store.UpdateGroupMessage{
+			Payload: &storepb.GroupPayload{
+				Members: members,
+				Source:  ldapSource,
+			},
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to update group %q", groupEmail)
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		if workspaceID == "" {
+			if workspaceID, err = s.store.GetWorkspaceID(ctx); err != nil {
+				return nil, errors.Wrapf(err, "failed to get workspace id")
+			}
+		}
+		if err := s.createAuditLog(ctx, workspaceID, idp, change, userUID); err != nil {
+			return nil, errors.Wrapf(err, "failed to create audit log for group %q", groupEmail)
+		}
+	}
+
+	if !validateOnly && len(changes) > 0 {
+		if err := s.iamManager.ReloadCache(ctx); err != nil {
+			return nil, errors.Wrapf(err, "failed to reload IAM cache")
+		}
+	}
+	return changes, nil
+}
+
+// resolveMembers resolves the LDAP identifiers to the Bytebase users in the "users/{uid}" format.
+// The identifiers are converted to emails in the same way as the LDAP login, and the users not in Bytebase are skipped.
+func (s *Syncer) resolveMembers(ctx context.Context, idp *store.IdentityProviderMessage, identifiers []string) ([]string, error) {
+	var members []string
+	for _, identifier := range identifiers {
+		email := strings.ToLower(identifier)
+		if _, err := mail.ParseAddress(email); err != nil {
+			if idp.Domain == "" {
+				slog.Debug("skip LDAP user with invalid email", slog.String("identifier", identifier))
+				continue
+			}
+			email = strings.ToLower(fmt.Sprintf("%s@%s", email, idp.Domain))
+		}
+		user, err := s.store.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", email)
+		}
+		if user == nil || user.MemberDeleted {
+			continue
+		}
+		members = append(members, common.FormatUserUID(user.ID))
+	}
+	return members, nil
+}
+
+// formatMembers converts the members in the "users/{uid}" format to the "users/{email}" format.
+func (s *Syncer) formatMembers(ctx context.Context, members []string) ([]string, error) {
+	var names []string
+	for _, member := range members {
+		uid, err := common.GetUserID(member)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid member %q", member)
+		}
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", uid)
+		}
+		if user == nil {
+			names = append(names, member)
+			continue
+		}
+		names = append(names, common.FormatUserEmail(user.Email))
+	}
+	return names, nil
+}
+
+func (s *Syncer) createAuditLog(ctx context.Context, workspaceID string, idp *store.IdentityProviderMessage, change *v1pb.LDAPGroupChange, userUID int) error {
+	request, err := protojson.Marshal(&v1pb.SyncLDAPGroupsRequest{
+		Name: common.IdentityProviderNamePrefix + idp.ResourceID,
+	})
+	if err != nil {
+		return err
+	}
+	response, err := protojson.Marshal(change)
+	if err != nil {
+		return err
+	}
+	return s.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:   common.FormatWorkspace(workspaceID),
+		Method:   v1connect.IdentityProviderServiceSyncLDAPGroupsProcedure,
+		Resource: change.Group,
+		Severity: storepb.AuditLog_INFO,
+		User:     common.FormatUserUID(userUID),
+		Request:  string(request),
+		Response: string(response),
+	})
+}
+
+// diffMembers returns the group members after the synchronization, along with the added and removed members.
+// The roles of the existing members are kept, and the new members are added with the MEMBER role.
+func diffMembers(current []*storepb.GroupMember, desired []string) ([]*storepb.GroupMember, []string, []string) {
+	var members []*storepb.GroupMember
+	var added, removed []string
+	for _, member := range current {
+		if slices.Contains(desired, member.Member) {
+			members = append(members, member)
+		} else {
+			removed = append(removed, member.Member)
+		}
+	}
+	for _, member := range desired {
+		if slices.ContainsFunc(members, func(m *storepb.GroupMember) bool { return m.Member == member }) {
+			continue
+		}
+		members = append(members, &storepb.GroupMember{
+			Role:   storepb.GroupMember_MEMBER,
+			Member: member,
+		})
+		added = append(added, member)
+	}
+	return members, added, removed
+}
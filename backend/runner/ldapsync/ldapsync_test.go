@@ -0,0 +1,37 @@
+package ldapsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestDiffMembers(t *testing.T) {
+	a := require.New(t)
+
+	current := []*storepb.GroupMember{
+		{Member: "users/101", Role: storepb.GroupMember_OWNER},
+		{Member: "users/102", Role: storepb.GroupMember_MEMBER},
+		{Member: "users/103", Role: storepb.GroupMember_MEMBER},
+	}
+	members, added, removed := diffMembers(current, []string{"users/103", "users/101", "users/104", "users/104"})
+	a.Equal([]*storepb.GroupMember{
+		{Member: "users/101", Role: storepb.GroupMember_OWNER},
+		{Member: "users/103", Role: storepb.GroupMember_MEMBER},
+		{Member: "users/104", Role: storepb.GroupMember_MEMBER},
+	}, members)
+	a.Equal([]string{"users/104"}, added)
+	a.Equal([]string{"users/102"}, removed)
+
+	members, added, removed = diffMembers(current, []string{"users/101", "users/102", "users/103"})
+	a.Equal(current, members)
+	a.Empty(added)
+	a.Empty(removed)
+
+	members, added, removed = diffMembers(current, nil)
+	a.Empty(members)
+	a.Empty(added)
+	a.Equal([]string{"users/101", "users/102", "users/103"}, removed)
+}
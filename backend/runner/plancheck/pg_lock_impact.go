@@ -0,0 +1,290 @@
+package plancheck
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+)
+
+const (
+	// Tables above these thresholds are considered large, a rewrite or scan takes minutes.
+	largeTableRows = 1_000_000
+	largeTableSize = 1 << 30
+	// Tables above these thresholds are considered medium, a rewrite or scan takes seconds.
+	mediumTableRows = 10_000
+	mediumTableSize = 100 << 20
+)
+
+// pgVolatileFunctionPrefixes are the volatile functions commonly used in column defaults.
+// PostgreSQL 11+ adds a column with a non-volatile default without rewriting the table,
+// but a volatile default has to be evaluated for every existing row.
+var pgVolatileFunctionPrefixes = []string{
+	"random(",
+	"gen_random_uuid(",
+	"uuid_generate_v",
+	"clock_timestamp(",
+	"timeofday(",
+	"nextval(",
+}
+
+// pgLockImpact is the lock impact of a single statement on a single table.
+type pgLockImpact struct {
+	line         int
+	statement    string
+	schema       string
+	table        string
+	lockMode     storepb.PlanCheckRunResult_Result_StatementLockImpact_LockMode
+	tableRewrite bool
+	tableScan    bool
+	reasons      []string
+}
+
+// merge merges the impact of another sub-command on the same table,
+// the statement holds the strongest lock of its sub-commands.
+func (i *pgLockImpact) merge(lockMode storepb.PlanCheckRunResult_Result_StatementLockImpact_LockMode, rewrite, scan bool, reason string) {
+	i.lockMode = max(i.lockMode, lockMode)
+	i.tableRewrite = i.tableRewrite || rewrite
+	i.tableScan = i.tableScan || scan
+	if reason != "" && !slices.Contains(i.reasons, reason) {
+		i.reasons = append(i.reasons, reason)
+	}
+}
+
+// analyzePGLockImpact classifies the lock level and the rewrite/scan behavior of the DDL statements.
+// Statements not taking table level locks worth reporting are skipped.
+func analyzePGLockImpact(nodes []ast.Node, metadata *storepb.DatabaseSchemaMetadata) []*pgLockImpact {
+	var impacts []*pgLockImpact
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *ast.AlterTableStmt:
+			if n.Table == nil {
+				continue
+			}
+			impact := newPGLockImpact(n, n.Table)
+			table := findPGTable(metadata, impact.schema, impact.table)
+			for _, item := range n.AlterItemList {
+				analyzePGAlterTableItem(impact, item, table)
+			}
+			if impact.lockMode == storepb.PlanCheckRunResult_Result_StatementLockImpact_LOCK_MODE_UNSPECIFIED {
+				impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+			}
+			impacts = append(impacts, impact)
+		case *ast.CreateIndexStmt:
+			if n.Index == nil || n.Index.Table == nil {
+				continue
+			}
+			impact := newPGLockImpact(n, n.Index.Table)
+			if n.Concurrently {
+				impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE_UPDATE_EXCLUSIVE, false, true, "CREATE INDEX CONCURRENTLY scans the table without blocking writes")
+			} else {
+				impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE, false, true, "CREATE INDEX blocks writes while scanning the table, consider CREATE INDEX CONCURRENTLY")
+			}
+			impacts = append(impacts, impact)
+		case *ast.DropTableStmt:
+			for _, table := range n.TableList {
+				impact := newPGLockImpact(n, table)
+				impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "DROP TABLE waits for all queries on the table to finish")
+				impacts = append(impacts, impact)
+			}
+		default:
+		}
+	}
+	return impacts
+}
+
+func newPGLockImpact(node ast.Node, table *ast.TableDef) *pgLockImpact {
+	schema := table.Schema
+	if schema == "" {
+		schema = "public"
+	}
+	return &pgLockImpact{
+		line:      node.LastLine(),
+		statement: node.Text(),
+		schema:    schema,
+		table:     table.Name,
+	}
+}
+
+func analyzePGAlterTableItem(impact *pgLockImpact, item ast.Node, table *storepb.TableMetadata) {
+	switch n := item.(type) {
+	case *ast.AddColumnListStmt:
+		for _, column := range n.ColumnList {
+			if reason := pgAddColumnRewriteReason(column); reason != "" {
+				impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, true, true, reason)
+				continue
+			}
+			impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+		}
+	case *ast.AlterColumnTypeStmt:
+		if isPGBinaryCoercible(findPGColumn(table, n.ColumnName), n.Type) {
+			impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+			return
+		}
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, true, true, fmt.Sprintf("ALTER COLUMN %q TYPE rewrites the table and its indexes", n.ColumnName))
+	case *ast.SetNotNullStmt:
+		if hasPGNotNullCheckConstraint(table, n.ColumnName) {
+			impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+			return
+		}
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, true, fmt.Sprintf("SET NOT NULL on column %q scans the table, add a CHECK (%s IS NOT NULL) NOT VALID constraint and validate it first", n.ColumnName, n.ColumnName))
+	case *ast.AddConstraintStmt:
+		if n.Constraint == nil {
+			return
+		}
+		analyzePGAddConstraint(impact, n.Constraint)
+	case *ast.ValidateConstraintStmt:
+		// VALIDATE CONSTRAINT scans the table without blocking writes.
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE_UPDATE_EXCLUSIVE, false, true, fmt.Sprintf("VALIDATE CONSTRAINT %q scans the table without blocking writes", n.ConstraintName))
+	case *ast.SetTablespaceStmt:
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, true, false, fmt.Sprintf("SET TABLESPACE %q copies the table and its data files while blocking all queries", n.Tablespace))
+	default:
+		// DROP COLUMN, RENAME, SET/DROP DEFAULT, DROP NOT NULL and DROP CONSTRAINT only change the catalog.
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+	}
+}
+
+func analyzePGAddConstraint(impact *pgLockImpact, constraint *ast.ConstraintDef) {
+	switch constraint.Type {
+	case ast.ConstraintTypeForeign:
+		if constraint.SkipValidation {
+			impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE_ROW_EXCLUSIVE, false, false, "")
+			return
+		}
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE_ROW_EXCLUSIVE, false, true, "adding FOREIGN KEY validates all existing rows, consider NOT VALID and VALIDATE CONSTRAINT later")
+	case ast.ConstraintTypeCheck:
+		if constraint.SkipValidation {
+			impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+			return
+		}
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, true, "adding CHECK constraint validates all existing rows, consider NOT VALID and VALIDATE CONSTRAINT later")
+	case ast.ConstraintTypePrimary, ast.ConstraintTypeUnique:
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, true, "adding PRIMARY KEY or UNIQUE constraint builds the index while holding the lock, consider building the index concurrently and USING INDEX")
+	case ast.ConstraintTypeExclusion:
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, true, "adding EXCLUDE constraint builds the index while holding the lock")
+	default:
+		impact.merge(storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE, false, false, "")
+	}
+}
+
+// pgAddColumnRewriteReason returns the reason if adding the column rewrites the table.
+func pgAddColumnRewriteReason(column *ast.ColumnDef) string {
+	if _, ok := column.Type.(*ast.Serial); ok {
+		return fmt.Sprintf("adding serial column %q rewrites the table", column.ColumnName)
+	}
+	for _, constraint := range column.ConstraintList {
+		switch constraint.Type {
+		case ast.ConstraintTypeGenerated:
+			return fmt.Sprintf("adding generated column %q rewrites the table", column.ColumnName)
+		case ast.ConstraintTypeDefault:
+			if constraint.Expression == nil {
+				continue
+			}
+			expression := strings.ToLower(strings.Join(strings.Fields(constraint.Expression.Text()), ""))
+			for _, prefix := range pgVolatileFunctionPrefixes {
+				if strings.Contains(expression, prefix) {
+					return fmt.Sprintf("adding column %q with volatile default rewrites the table", column.ColumnName)
+				}
+			}
+		default:
+		}
+	}
+	return ""
+}
+
+var pgVarcharRegexp = regexp.MustCompile(`^(?:character varying|varchar)(?:\((\d+)\))?$`)
+
+// isPGBinaryCoercible returns true if the column type change only updates the catalog,
+// i.e. varchar(n) to text, or increasing the length of varchar.
+func isPGBinaryCoercible(column *storepb.ColumnMetadata, newType ast.DataType) bool {
+	if column == nil {
+		return false
+	}
+	match := pgVarcharRegexp.FindStringSubmatch(strings.ToLower(column.Type))
+	if match == nil {
+		return false
+	}
+	switch t := newType.(type) {
+	case *ast.Text:
+		return true
+	case *ast.CharacterVarying:
+		if match[1] == "" {
+			// Unlimited varchar to varchar(n) needs to check the existing values.
+			return false
+		}
+		var oldSize int
+		if _, err := fmt.Sscanf(match[1], "%d", &oldSize); err != nil {
+			return false
+		}
+		return t.Size == 0 || t.Size >= oldSize
+	default:
+		return false
+	}
+}
+
+var pgNotNullCheckRegexp = regexp.MustCompile(`(?i)^\(*"?([a-z0-9_$]+)"?\s+IS\s+NOT\s+NULL\)*$`)
+
+// hasPGNotNullCheckConstraint returns true if there is a valid CHECK (column IS NOT NULL) constraint,
+// then PostgreSQL 12+ skips the full table scan for SET NOT NULL.
+func hasPGNotNullCheckConstraint(table *storepb.TableMetadata, columnName string) bool {
+	for _, check := range table.GetCheckConstraints() {
+		expression := strings.TrimSpace(check.Expression)
+		expression = strings.TrimSpace(strings.TrimPrefix(expression, "CHECK"))
+		match := pgNotNullCheckRegexp.FindStringSubmatch(expression)
+		if match != nil && strings.EqualFold(match[1], columnName) {
+			return true
+		}
+	}
+	return false
+}
+
+func findPGTable(metadata *storepb.DatabaseSchemaMetadata, schemaName, tableName string) *storepb.TableMetadata {
+	for _, schema := range metadata.GetSchemas() {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+func findPGColumn(table *storepb.TableMetadata, columnName string) *storepb.ColumnMetadata {
+	for _, column := range table.GetColumns() {
+		if column.Name == columnName {
+			return column
+		}
+	}
+	return nil
+}
+
+// estimatePGBlockingRisk estimates how long the statement blocks the concurrent queries on the table.
+func estimatePGBlockingRisk(impact *pgLockImpact, rows, size int64) storepb.PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk {
+	// Locks weaker than SHARE do not conflict with INSERT, UPDATE and DELETE.
+	if impact.lockMode < storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE {
+		return storepb.PlanCheckRunResult_Result_StatementLockImpact_LOW
+	}
+	large := rows >= largeTableRows || size >= largeTableSize
+	medium := rows >= mediumTableRows || size >= mediumTableSize
+	switch {
+	case impact.tableRewrite || impact.tableScan:
+		if large {
+			return storepb.PlanCheckRunResult_Result_StatementLockImpact_HIGH
+		}
+		if medium {
+			return storepb.PlanCheckRunResult_Result_StatementLockImpact_MEDIUM
+		}
+		return storepb.PlanCheckRunResult_Result_StatementLockImpact_LOW
+	case impact.lockMode == storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE && large:
+		// The lock itself is brief, but waiting for it queues every query on a busy table.
+		return storepb.PlanCheckRunResult_Result_StatementLockImpact_MEDIUM
+	default:
+		return storepb.PlanCheckRunResult_Result_StatementLockImpact_LOW
+	}
+}
@@ -0,0 +1,217 @@
+package plancheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+)
+
+const (
+	accessExclusive      = storepb.PlanCheckRunResult_Result_StatementLockImpact_ACCESS_EXCLUSIVE
+	shareRowExclusive    = storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE_ROW_EXCLUSIVE
+	share                = storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE
+	shareUpdateExclusive = storepb.PlanCheckRunResult_Result_StatementLockImpact_SHARE_UPDATE_EXCLUSIVE
+)
+
+func newPGLockImpactTestTable() *storepb.TableMetadata {
+	return &storepb.TableMetadata{
+		Name: "t",
+		Columns: []*storepb.ColumnMetadata{
+			{Name: "id", Type: "integer"},
+			{Name: "name", Type: "character varying(20)", Nullable: true},
+			{Name: "note", Type: "character varying", Nullable: true},
+			{Name: "email", Type: "text", Nullable: true},
+			{Name: "phone", Type: "text", Nullable: true},
+		},
+		CheckConstraints: []*storepb.CheckConstraintMetadata{
+			{Name: "email_not_null", Expression: "CHECK ((email IS NOT NULL))"},
+			{Name: "phone_not_null", Expression: "CHECK ((phone IS NOT NULL)) NOT VALID"},
+		},
+	}
+}
+
+func TestAnalyzePGLockImpact(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name:   "public",
+				Tables: []*storepb.TableMetadata{newPGLockImpactTestTable()},
+			},
+		},
+	}
+
+	type want struct {
+		lockMode     storepb.PlanCheckRunResult_Result_StatementLockImpact_LockMode
+		tableRewrite bool
+		tableScan    bool
+	}
+	tests := []struct {
+		statement string
+		want      want
+	}{
+		// varchar(n) to text and to a larger size only update the catalog.
+		{"ALTER TABLE t ALTER COLUMN name TYPE text", want{accessExclusive, false, false}},
+		{"ALTER TABLE t ALTER COLUMN name TYPE varchar(50)", want{accessExclusive, false, false}},
+		{"ALTER TABLE t ALTER COLUMN name TYPE varchar(10)", want{accessExclusive, true, true}},
+		{"ALTER TABLE t ALTER COLUMN note TYPE varchar(10)", want{accessExclusive, true, true}},
+		{"ALTER TABLE t ALTER COLUMN id TYPE bigint", want{accessExclusive, true, true}},
+		// SET NOT NULL skips the scan with a valid CHECK (col IS NOT NULL).
+		{"ALTER TABLE t ALTER COLUMN email SET NOT NULL", want{accessExclusive, false, false}},
+		{"ALTER TABLE t ALTER COLUMN phone SET NOT NULL", want{accessExclusive, false, true}},
+		{"ALTER TABLE t ALTER COLUMN name SET NOT NULL", want{accessExclusive, false, true}},
+		{"ALTER TABLE t ADD CONSTRAINT fk_id FOREIGN KEY (id) REFERENCES u (id)", want{shareRowExclusive, false, true}},
+		{"ALTER TABLE t ADD CONSTRAINT fk_id FOREIGN KEY (id) REFERENCES u (id) NOT VALID", want{shareRowExclusive, false, false}},
+		{"ALTER TABLE t ADD CONSTRAINT chk_id CHECK (id > 0)", want{accessExclusive, false, true}},
+		{"ALTER TABLE t ADD CONSTRAINT chk_id CHECK (id > 0) NOT VALID", want{accessExclusive, false, false}},
+		{"ALTER TABLE t VALIDATE CONSTRAINT chk_id", want{shareUpdateExclusive, false, true}},
+		{"ALTER TABLE t ADD CONSTRAINT uk_name UNIQUE (name)", want{accessExclusive, false, true}},
+		{"ALTER TABLE t SET TABLESPACE fast_ssd", want{accessExclusive, true, false}},
+		{"CREATE INDEX idx_name ON t (name)", want{share, false, true}},
+		{"CREATE INDEX CONCURRENTLY idx_name ON t (name)", want{shareUpdateExclusive, false, true}},
+		// A volatile default is evaluated for every existing row.
+		{"ALTER TABLE t ADD COLUMN c uuid DEFAULT gen_random_uuid()", want{accessExclusive, true, true}},
+		{"ALTER TABLE t ADD COLUMN c integer DEFAULT 0", want{accessExclusive, false, false}},
+		{"ALTER TABLE t ADD COLUMN c serial", want{accessExclusive, true, true}},
+		{"ALTER TABLE t DROP COLUMN note", want{accessExclusive, false, false}},
+		{"DROP TABLE t", want{accessExclusive, false, false}},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		nodes, err := pgrawparser.Parse(pgrawparser.ParseContext{}, test.statement)
+		a.NoError(err, test.statement)
+		impacts := analyzePGLockImpact(nodes, metadata)
+		a.Len(impacts, 1, test.statement)
+		got := want{
+			lockMode:     impacts[0].lockMode,
+			tableRewrite: impacts[0].tableRewrite,
+			tableScan:    impacts[0].tableScan,
+		}
+		a.Equal(test.want, got, "%s, reasons: %v", test.statement, impacts[0].reasons)
+		a.Equal("public", impacts[0].schema, test.statement)
+		a.Equal("t", impacts[0].table, test.statement)
+	}
+
+	// Statements without table level locks are skipped.
+	nodes, err := pgrawparser.Parse(pgrawparser.ParseContext{}, "SELECT 1; INSERT INTO t (id) VALUES (1);")
+	a.NoError(err)
+	a.Empty(analyzePGLockImpact(nodes, metadata))
+}
+
+func TestIsPGBinaryCoercible(t *testing.T) {
+	tests := []struct {
+		oldType string
+		newType ast.DataType
+		want    bool
+	}{
+		{"character varying(20)", &ast.Text{}, true},
+		{"varchar(20)", &ast.Text{}, true},
+		{"character varying(20)", &ast.CharacterVarying{Size: 20}, true},
+		{"character varying(20)", &ast.CharacterVarying{Size: 50}, true},
+		{"character varying(20)", &ast.CharacterVarying{Size: 10}, false},
+		{"character varying(20)", &ast.CharacterVarying{}, true},
+		{"character varying", &ast.CharacterVarying{Size: 10}, false},
+		{"character varying", &ast.Text{}, true},
+		{"text", &ast.CharacterVarying{Size: 10}, false},
+		{"integer", &ast.Text{}, false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		column := &storepb.ColumnMetadata{Name: "c", Type: test.oldType}
+		a.Equal(test.want, isPGBinaryCoercible(column, test.newType), "%s to %T", test.oldType, test.newType)
+	}
+	a.False(isPGBinaryCoercible(nil, &ast.Text{}))
+}
+
+func TestHasPGNotNullCheckConstraint(t *testing.T) {
+	tests := []struct {
+		expression string
+		column     string
+		want       bool
+	}{
+		{"CHECK ((email IS NOT NULL))", "email", true},
+		{"CHECK ((\"Email\" IS NOT NULL))", "email", true},
+		{"CHECK (email IS NOT NULL)", "email", true},
+		{"CHECK ((email IS NOT NULL)) NOT VALID", "email", false},
+		{"CHECK ((email IS NOT NULL))", "phone", false},
+		{"CHECK (((email IS NOT NULL) AND (phone IS NOT NULL)))", "email", false},
+		{"CHECK ((length(email) > 0))", "email", false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		table := &storepb.TableMetadata{
+			CheckConstraints: []*storepb.CheckConstraintMetadata{{Name: "chk", Expression: test.expression}},
+		}
+		a.Equal(test.want, hasPGNotNullCheckConstraint(table, test.column), "%s on %s", test.expression, test.column)
+	}
+	a.False(hasPGNotNullCheckConstraint(nil, "email"))
+}
+
+func TestPGAddColumnRewriteReason(t *testing.T) {
+	tests := []struct {
+		statement string
+		rewrite   bool
+	}{
+		{"ALTER TABLE t ADD COLUMN c integer", false},
+		{"ALTER TABLE t ADD COLUMN c integer NOT NULL DEFAULT 0", false},
+		{"ALTER TABLE t ADD COLUMN c timestamptz DEFAULT now()", false},
+		{"ALTER TABLE t ADD COLUMN c text DEFAULT 'a'", false},
+		{"ALTER TABLE t ADD COLUMN c double precision DEFAULT random()", true},
+		{"ALTER TABLE t ADD COLUMN c uuid DEFAULT gen_random_uuid()", true},
+		{"ALTER TABLE t ADD COLUMN c timestamptz DEFAULT clock_timestamp()", true},
+		{"ALTER TABLE t ADD COLUMN c bigint DEFAULT nextval('s')", true},
+		{"ALTER TABLE t ADD COLUMN c bigserial", true},
+		{"ALTER TABLE t ADD COLUMN c integer GENERATED ALWAYS AS (id + 1) STORED", true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		nodes, err := pgrawparser.Parse(pgrawparser.ParseContext{}, test.statement)
+		a.NoError(err, test.statement)
+		a.Len(nodes, 1, test.statement)
+		alterTable, ok := nodes[0].(*ast.AlterTableStmt)
+		a.True(ok, test.statement)
+		addColumn, ok := alterTable.AlterItemList[0].(*ast.AddColumnListStmt)
+		a.True(ok, test.statement)
+		reason := pgAddColumnRewriteReason(addColumn.ColumnList[0])
+		a.Equal(test.rewrite, reason != "", "%s, reason: %s", test.statement, reason)
+	}
+}
+
+func TestEstimatePGBlockingRisk(t *testing.T) {
+	low := storepb.PlanCheckRunResult_Result_StatementLockImpact_LOW
+	medium := storepb.PlanCheckRunResult_Result_StatementLockImpact_MEDIUM
+	high := storepb.PlanCheckRunResult_Result_StatementLockImpact_HIGH
+
+	tests := []struct {
+		description string
+		impact      *pgLockImpact
+		rows        int64
+		size        int64
+		want        storepb.PlanCheckRunResult_Result_StatementLockImpact_BlockingRisk
+	}{
+		{"rewrite on small table", &pgLockImpact{lockMode: accessExclusive, tableRewrite: true}, mediumTableRows - 1, mediumTableSize - 1, low},
+		{"rewrite on medium table by rows", &pgLockImpact{lockMode: accessExclusive, tableRewrite: true}, mediumTableRows, 0, medium},
+		{"rewrite on medium table by size", &pgLockImpact{lockMode: accessExclusive, tableRewrite: true}, 0, mediumTableSize, medium},
+		{"rewrite on large table by rows", &pgLockImpact{lockMode: accessExclusive, tableRewrite: true}, largeTableRows, 0, high},
+		{"rewrite on large table by size", &pgLockImpact{lockMode: accessExclusive, tableRewrite: true}, 0, largeTableSize, high},
+		{"scan below large table", &pgLockImpact{lockMode: share, tableScan: true}, largeTableRows - 1, largeTableSize - 1, medium},
+		{"scan on large table", &pgLockImpact{lockMode: share, tableScan: true}, largeTableRows, 0, high},
+		{"scan without blocking writes on large table", &pgLockImpact{lockMode: shareUpdateExclusive, tableScan: true}, largeTableRows, largeTableSize, low},
+		{"catalog only change on large table", &pgLockImpact{lockMode: accessExclusive}, largeTableRows, 0, medium},
+		{"catalog only change on medium table", &pgLockImpact{lockMode: accessExclusive}, mediumTableRows, mediumTableSize, low},
+		{"catalog only change with weaker lock on large table", &pgLockImpact{lockMode: shareRowExclusive}, largeTableRows, largeTableSize, low},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, estimatePGBlockingRisk(test.impact, test.rows, test.size), test.description)
+	}
+}
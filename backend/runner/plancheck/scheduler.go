@@ -12,6 +12,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/coordinator"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -23,11 +24,12 @@ const (
 )
 
 // NewScheduler creates a new plan check scheduler.
-func NewScheduler(s *store.Store, licenseService *enterprise.LicenseService, stateCfg *state.State) *Scheduler {
+func NewScheduler(s *store.Store, licenseService *enterprise.LicenseService, stateCfg *state.State, coordinator *coordinator.Coordinator) *Scheduler {
 	return &Scheduler{
 		store:          s,
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
+		coordinator:    coordinator,
 		executors:      make(map[store.PlanCheckRunType]Executor),
 	}
 }
@@ -37,6 +39,7 @@ type Scheduler struct {
 	store          *store.Store
 	licenseService *enterprise.LicenseService
 	stateCfg       *state.State
+	coordinator    *coordinator.Coordinator
 	executors      map[store.PlanCheckRunType]Executor
 }
 
@@ -80,6 +83,14 @@ func (s *Scheduler) runOnce(ctx context.Context) {
 		}
 	}()
 
+	if s.coordinator.Enabled() {
+		// The plan check runs may be canceled on another replica.
+		s.cancelCanceledPlanCheckRuns(ctx)
+	}
+	if !s.coordinator.IsActive() {
+		return
+	}
+
 	planCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
 		Status: &[]store.PlanCheckRunStatus{
 			store.PlanCheckRunStatusRunning,
@@ -95,6 +106,33 @@ func (s *Scheduler) runOnce(ctx context.Context) {
 	}
 }
 
+// cancelCanceledPlanCheckRuns cancels the plan check runs executing on this replica that have been canceled in the database.
+func (s *Scheduler) cancelCanceledPlanCheckRuns(ctx context.Context) {
+	var uids []int
+	s.stateCfg.RunningPlanCheckRunsCancelFunc.Range(func(key, _ any) bool {
+		if uid, ok := key.(int); ok {
+			uids = append(uids, uid)
+		}
+		return true
+	})
+	if len(uids) == 0 {
+		return
+	}
+	planCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		UIDs:   &uids,
+		Status: &[]store.PlanCheckRunStatus{store.PlanCheckRunStatusCanceled},
+	})
+	if err != nil {
+		slog.Error("failed to list canceled plan check runs", log.BBError(err))
+		return
+	}
+	for _, planCheckRun := range planCheckRuns {
+		if cancelFunc, ok := s.stateCfg.RunningPlanCheckRunsCancelFunc.Load(planCheckRun.UID); ok {
+			cancelFunc.(context.CancelFunc)()
+		}
+	}
+}
+
 func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.PlanCheckRunMessage) {
 	executor, ok := s.executors[planCheckRun.Type]
 	if !ok {
@@ -116,13 +154,38 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 	if maximumConnections <= 0 {
 		maximumConnections = common.DefaultInstanceMaximumConnections
 	}
+	// Claim the plan check run so that it is not executed by other replicas.
+	claimed, err := s.coordinator.TryLock(ctx, coordinator.LockKindPlanCheckRun, planCheckRun.UID)
+	if err != nil {
+		slog.Error("failed to claim plan check run", slog.Int("uid", planCheckRun.UID), log.BBError(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+	// The plan check run may have been executed by another replica, which released the claim after it was done.
+	latestPlanCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		UIDs:   &[]int{planCheckRun.UID},
+		Status: &[]store.PlanCheckRunStatus{store.PlanCheckRunStatusRunning},
+	})
+	if err != nil {
+		slog.Error("failed to get plan check run", slog.Int("uid", planCheckRun.UID), log.BBError(err))
+		s.coordinator.Unlock(ctx, coordinator.LockKindPlanCheckRun, planCheckRun.UID)
+		return
+	}
+	if len(latestPlanCheckRuns) == 0 {
+		s.coordinator.Unlock(ctx, coordinator.LockKindPlanCheckRun, planCheckRun.UID)
+		return
+	}
 	if s.stateCfg.InstanceOutstandingConnections.Increment(instance.ResourceID, maximumConnections) {
+		s.coordinator.Unlock(ctx, coordinator.LockKindPlanCheckRun, planCheckRun.UID)
 		return
 	}
 
 	s.stateCfg.RunningPlanChecks.Store(planCheckRun.UID, true)
 	go func() {
 		defer func() {
+			s.coordinator.Unlock(ctx, coordinator.LockKindPlanCheckRun, planCheckRun.UID)
 			s.stateCfg.RunningPlanChecks.Delete(planCheckRun.UID)
 			s.stateCfg.RunningPlanCheckRunsCancelFunc.Delete(planCheckRun.UID)
 			s.stateCfg.InstanceOutstandingConnections.Decrement(instance.ResourceID)
@@ -131,6 +194,7 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 		ctxWithCancel, cancel := context.WithCancel(ctx)
 		defer cancel()
 		s.stateCfg.RunningPlanCheckRunsCancelFunc.Store(planCheckRun.UID, cancel)
+		s.coordinator.SetCancel(coordinator.LockKindPlanCheckRun, planCheckRun.UID, cancel)
 
 		results, err := runExecutorOnce(ctxWithCancel, executor, planCheckRun.Config)
 		if err != nil {
@@ -83,12 +83,14 @@ func (e *StatementAdviseExecutor) Run(ctx context.Context, config *storepb.PlanC
 	if instance == nil {
 		return nil, errors.Errorf("instance %s not found", config.InstanceId)
 	}
-	if !common.EngineSupportStatementAdvise(instance.Metadata.GetEngine()) {
+	engine := instance.Metadata.GetEngine()
+	// The builtin prior backup check still runs for the engines supporting prior backup but not statement advise.
+	if !common.EngineSupportStatementAdvise(engine) && !(enablePriorBackup && common.EngineSupportPriorBackup(engine)) && !common.EngineSupportStatementAdviseWithRules(engine) {
 		return []*storepb.PlanCheckRunResult_Result{
 			{
 				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
 				Code:    common.Ok.Int32(),
-				Title:   fmt.Sprintf("Statement advise is not supported for %s", instance.Metadata.GetEngine()),
+				Title:   fmt.Sprintf("Statement advise is not supported for %s", engine),
 				Content: "",
 			},
 		}, nil
@@ -102,7 +104,27 @@ func (e *StatementAdviseExecutor) Run(ctx context.Context, config *storepb.PlanC
 		return nil, errors.Errorf("database not found %q", config.DatabaseName)
 	}
 
-	results, err := e.runReview(ctx, instance, database, changeType, statement, enablePriorBackup)
+	reviewConfig, err := e.store.GetReviewConfigForDatabase(ctx, database)
+	if err != nil {
+		if e, ok := err.(*common.Error); ok && e.Code == common.NotFound {
+			// Continue to check the builtin rules.
+			reviewConfig = &storepb.ReviewConfigPayload{}
+		} else {
+			return nil, common.Wrapf(err, common.Internal, "failed to get SQL review config")
+		}
+	}
+	if !common.EngineSupportStatementAdvise(engine) && common.EngineSupportStatementAdviseWithRules(engine) && !advisor.HasEnabledRules(reviewConfig.SqlReviewRules, engine) {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("No SQL review rules are configured for %s", engine),
+				Content: "",
+			},
+		}, nil
+	}
+
+	results, err := e.runReview(ctx, instance, database, reviewConfig, changeType, statement, enablePriorBackup)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +147,7 @@ func (e *StatementAdviseExecutor) runReview(
 	ctx context.Context,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
+	reviewConfig *storepb.ReviewConfigPayload,
 	changeType storepb.PlanCheckRunConfig_ChangeDatabaseType,
 	statement string,
 	enablePriorBackup bool,
@@ -140,16 +163,6 @@ func (e *StatementAdviseExecutor) runReview(
 		return nil, errors.Errorf("database schema metadata %s not found", database.String())
 	}
 
-	reviewConfig, err := e.store.GetReviewConfigForDatabase(ctx, database)
-	if err != nil {
-		if e, ok := err.(*common.Error); ok && e.Code == common.NotFound {
-			// Continue to check the builtin rules.
-			reviewConfig = &storepb.ReviewConfigPayload{}
-		} else {
-			return nil, common.Wrapf(err, common.Internal, "failed to get SQL review config")
-		}
-	}
-
 	catalog, err := catalog.NewCatalog(ctx, e.store, database.InstanceID, database.DatabaseName, instance.Metadata.GetEngine(), store.IsObjectCaseSensitive(instance), nil /* Override Metadata */)
 	if err != nil {
 		return nil, common.Wrapf(err, common.Internal, "failed to create a catalog")
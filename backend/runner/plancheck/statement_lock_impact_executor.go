@@ -0,0 +1,157 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// NewStatementLockImpactExecutor creates a statement lock impact executor.
+func NewStatementLockImpactExecutor(store *store.Store, sheetManager *sheet.Manager) Executor {
+	return &StatementLockImpactExecutor{
+		store:        store,
+		sheetManager: sheetManager,
+	}
+}
+
+// StatementLockImpactExecutor is the executor analyzing the table locks taken by the DDL statements,
+// and estimating how long they block the concurrent queries based on the table size.
+type StatementLockImpactExecutor struct {
+	store        *store.Store
+	sheetManager *sheet.Manager
+}
+
+// Run runs the statement lock impact executor.
+func (e *StatementLockImpactExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Lock impact analysis for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &config.InstanceId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %v", config.InstanceId)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %s not found", config.InstanceId)
+	}
+	if instance.Metadata.GetEngine() != storepb.Engine_POSTGRES {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Lock impact analysis is not supported for %s", instance.Metadata.GetEngine()),
+				Content: "",
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	asts, syntaxAdvices := e.sheetManager.GetASTsForChecks(instance.Metadata.GetEngine(), statement)
+	if len(syntaxAdvices) > 0 {
+		advice := syntaxAdvices[0]
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   advice.Title,
+				Content: advice.Content,
+				Code:    advice.Code,
+				Report: &storepb.PlanCheckRunResult_Result_SqlReviewReport_{
+					SqlReviewReport: &storepb.PlanCheckRunResult_Result_SqlReviewReport{
+						Line:          advice.GetStartPosition().GetLine(),
+						Column:        advice.GetStartPosition().GetColumn(),
+						StartPosition: advice.StartPosition,
+						EndPosition:   advice.EndPosition,
+					},
+				},
+			},
+		}, nil
+	}
+	nodes, ok := asts.([]ast.Node)
+	if !ok {
+		return nil, errors.Errorf("invalid ast type %T", asts)
+	}
+
+	databaseSchema, err := e.store.GetDBSchema(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database schema %q", database.DatabaseName)
+	}
+	if databaseSchema == nil {
+		return nil, errors.Errorf("database schema %s not found", database.String())
+	}
+	metadata := databaseSchema.GetMetadata()
+
+	report := &storepb.PlanCheckRunResult_Result_LockImpactReport{}
+	highRiskCount := 0
+	for _, impact := range analyzePGLockImpact(nodes, metadata) {
+		var rows, size int64
+		if table := findPGTable(metadata, impact.schema, impact.table); table != nil {
+			rows = table.RowCount
+			size = table.DataSize + table.IndexSize
+		}
+		risk := estimatePGBlockingRisk(impact, rows, size)
+		if risk == storepb.PlanCheckRunResult_Result_StatementLockImpact_HIGH {
+			highRiskCount++
+		}
+		report.Statements = append(report.Statements, &storepb.PlanCheckRunResult_Result_StatementLockImpact{
+			StartPosition: common.ConvertPGParserLineToPosition(impact.line),
+			Statement:     impact.statement,
+			Schema:        impact.schema,
+			Table:         impact.table,
+			LockMode:      impact.lockMode,
+			TableRewrite:  impact.tableRewrite,
+			TableScan:     impact.tableScan,
+			TableRows:     rows,
+			TableSize:     size,
+			BlockingRisk:  risk,
+			Reasons:       impact.reasons,
+		})
+	}
+
+	result := &storepb.PlanCheckRunResult_Result{
+		Status: storepb.PlanCheckRunResult_Result_SUCCESS,
+		Code:   common.Ok.Int32(),
+		Title:  "OK",
+		Report: &storepb.PlanCheckRunResult_Result_LockImpactReport_{
+			LockImpactReport: report,
+		},
+	}
+	if highRiskCount > 0 {
+		result.Status = storepb.PlanCheckRunResult_Result_WARNING
+		result.Title = "High blocking risk"
+		result.Content = fmt.Sprintf("%d statement(s) may block the queries on large tables for a long time", highRiskCount)
+	}
+	return []*storepb.PlanCheckRunResult_Result{result}, nil
+}
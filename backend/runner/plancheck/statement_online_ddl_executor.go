@@ -0,0 +1,173 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// NewStatementOnlineDDLExecutor creates a statement online DDL executor.
+func NewStatementOnlineDDLExecutor(store *store.Store, sheetManager *sheet.Manager) Executor {
+	return &StatementOnlineDDLExecutor{
+		store:        store,
+		sheetManager: sheetManager,
+	}
+}
+
+// StatementOnlineDDLExecutor is the executor predicting the online DDL algorithm of the MySQL ALTER TABLE statements,
+// and suggesting the gh-ost migration if the table is copied while blocking writes.
+type StatementOnlineDDLExecutor struct {
+	store        *store.Store
+	sheetManager *sheet.Manager
+}
+
+// Run runs the statement online DDL executor.
+func (e *StatementOnlineDDLExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Online DDL prediction for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &config.InstanceId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %v", config.InstanceId)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %s not found", config.InstanceId)
+	}
+	if instance.Metadata.GetEngine() != storepb.Engine_MYSQL {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Online DDL prediction is not supported for %s", instance.Metadata.GetEngine()),
+				Content: "",
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	asts, syntaxAdvices := e.sheetManager.GetASTsForChecks(instance.Metadata.GetEngine(), statement)
+	if len(syntaxAdvices) > 0 {
+		advice := syntaxAdvices[0]
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   advice.Title,
+				Content: advice.Content,
+				Code:    advice.Code,
+				Report: &storepb.PlanCheckRunResult_Result_SqlReviewReport_{
+					SqlReviewReport: &storepb.PlanCheckRunResult_Result_SqlReviewReport{
+						Line:          advice.GetStartPosition().GetLine(),
+						Column:        advice.GetStartPosition().GetColumn(),
+						StartPosition: advice.StartPosition,
+						EndPosition:   advice.EndPosition,
+					},
+				},
+			},
+		}, nil
+	}
+	parseResults, ok := asts.([]*mysqlparser.ParseResult)
+	if !ok {
+		return nil, errors.Errorf("invalid ast type %T", asts)
+	}
+
+	databaseSchema, err := e.store.GetDBSchema(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database schema %q", database.DatabaseName)
+	}
+	if databaseSchema == nil {
+		return nil, errors.Errorf("database schema %s not found", database.String())
+	}
+
+	report := &storepb.PlanCheckRunResult_Result_OnlineDdlReport{}
+	var ghostTables []string
+	for _, prediction := range mysqlparser.PredictOnlineDDL(parseResults, instance.Metadata.GetVersion(), database.DatabaseName, databaseSchema.GetMetadata()) {
+		var rows, size int64
+		if schema := databaseSchema.GetDatabaseMetadata().GetSchema(""); schema != nil && (prediction.Database == "" || prediction.Database == database.DatabaseName) {
+			if table := schema.GetTable(prediction.Table); table != nil {
+				rows = table.GetProto().GetRowCount()
+				size = table.GetProto().GetDataSize() + table.GetProto().GetIndexSize()
+			}
+		}
+		// gh-ost copies the table in the background without blocking writes,
+		// so it's preferred when MySQL copies a large table.
+		suggestGhost := prediction.Algorithm == mysqlparser.OnlineDDLAlgorithmCopy && (rows >= largeTableRows || size >= largeTableSize)
+		if suggestGhost {
+			ghostTables = append(ghostTables, prediction.Table)
+		}
+		report.Statements = append(report.Statements, &storepb.PlanCheckRunResult_Result_StatementOnlineDdl{
+			StartPosition: common.ConvertANTLRLineToPosition(prediction.Line),
+			Statement:     prediction.Statement,
+			Database:      prediction.Database,
+			Table:         prediction.Table,
+			Algorithm:     convertToOnlineDDLAlgorithm(prediction.Algorithm),
+			TableRebuild:  prediction.TableRebuild,
+			ConcurrentDml: prediction.ConcurrentDML,
+			TableRows:     rows,
+			TableSize:     size,
+			SuggestGhost:  suggestGhost,
+			Reasons:       prediction.Reasons,
+		})
+	}
+
+	result := &storepb.PlanCheckRunResult_Result{
+		Status: storepb.PlanCheckRunResult_Result_SUCCESS,
+		Code:   common.Ok.Int32(),
+		Title:  "OK",
+		Report: &storepb.PlanCheckRunResult_Result_OnlineDdlReport_{
+			OnlineDdlReport: report,
+		},
+	}
+	if len(ghostTables) > 0 {
+		result.Status = storepb.PlanCheckRunResult_Result_WARNING
+		result.Title = "Table copy on large tables"
+		result.Content = fmt.Sprintf("ALTER TABLE copies the large table(s) %q and blocks writes, consider switching to the gh-ost migration", ghostTables)
+	}
+	return []*storepb.PlanCheckRunResult_Result{result}, nil
+}
+
+func convertToOnlineDDLAlgorithm(algorithm mysqlparser.OnlineDDLAlgorithm) storepb.PlanCheckRunResult_Result_StatementOnlineDdl_Algorithm {
+	switch algorithm {
+	case mysqlparser.OnlineDDLAlgorithmInstant:
+		return storepb.PlanCheckRunResult_Result_StatementOnlineDdl_INSTANT
+	case mysqlparser.OnlineDDLAlgorithmInplace:
+		return storepb.PlanCheckRunResult_Result_StatementOnlineDdl_INPLACE
+	case mysqlparser.OnlineDDLAlgorithmCopy:
+		return storepb.PlanCheckRunResult_Result_StatementOnlineDdl_COPY
+	default:
+		return storepb.PlanCheckRunResult_Result_StatementOnlineDdl_ALGORITHM_UNSPECIFIED
+	}
+}
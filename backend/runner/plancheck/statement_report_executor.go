@@ -179,7 +179,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 
 	switch instance.Metadata.GetEngine() {
 	case storepb.Engine_POSTGRES:
-		pd, ok := driver.(*pgdriver.Driver)
+		pd, ok := db.Unwrap(driver).(*pgdriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid pg driver type")
 		}
@@ -191,7 +191,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 		}
 		defaultSchema = "public"
 	case storepb.Engine_REDSHIFT:
-		rd, ok := driver.(*redshiftdriver.Driver)
+		rd, ok := db.Unwrap(driver).(*redshiftdriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid redshift driver type")
 		}
@@ -204,7 +204,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 		}
 		defaultSchema = "public"
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
-		md, ok := driver.(*mysqldriver.Driver)
+		md, ok := db.Unwrap(driver).(*mysqldriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid mysql driver type")
 		}
@@ -218,7 +218,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 		}
 		defaultSchema = ""
 	case storepb.Engine_TIDB:
-		md, ok := driver.(*tidbdriver.Driver)
+		md, ok := db.Unwrap(driver).(*tidbdriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid tidb driver type")
 		}
@@ -230,7 +230,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 		}
 		defaultSchema = ""
 	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
-		od, ok := driver.(*oracledriver.Driver)
+		od, ok := db.Unwrap(driver).(*oracledriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid oracle driver type")
 		}
@@ -244,7 +244,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 		}
 		defaultSchema = database.DatabaseName
 	case storepb.Engine_MSSQL:
-		md, ok := driver.(*mssqldriver.Driver)
+		md, ok := db.Unwrap(driver).(*mssqldriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid mssql driver type")
 		}
@@ -0,0 +1,163 @@
+package schemasync
+
+import (
+	"fmt"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+// buildDriftObjects converts the metadata diff between the changelog schema and the synced schema to the drifted objects.
+func buildDriftObjects(diff *schema.MetadataDiff) []*storepb.DriftObject {
+	if diff == nil {
+		return nil
+	}
+	var objects []*storepb.DriftObject
+	for _, d := range diff.SchemaChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_SCHEMA, d.SchemaName, d.SchemaName))
+	}
+	for _, d := range diff.TableChanges {
+		object := newDriftObject(d.Action, storepb.DriftObject_TABLE, d.SchemaName, d.TableName)
+		if d.Action == schema.MetadataDiffActionAlter {
+			object.Children = buildTableDriftObjects(d)
+		}
+		objects = append(objects, object)
+	}
+	for _, d := range diff.ViewChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_VIEW, d.SchemaName, d.ViewName))
+	}
+	for _, d := range diff.MaterializedViewChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_MATERIALIZED_VIEW, d.SchemaName, d.MaterializedViewName))
+	}
+	for _, d := range diff.FunctionChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_FUNCTION, d.SchemaName, d.FunctionName))
+	}
+	for _, d := range diff.ProcedureChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_PROCEDURE, d.SchemaName, d.ProcedureName))
+	}
+	for _, d := range diff.SequenceChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_SEQUENCE, d.SchemaName, d.SequenceName))
+	}
+	for _, d := range diff.EnumTypeChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_ENUM_TYPE, d.SchemaName, d.EnumTypeName))
+	}
+	for _, d := range diff.EventChanges {
+		objects = append(objects, newDriftObject(d.Action, storepb.DriftObject_EVENT, "", d.EventName))
+	}
+	return mergeDriftObjects(objects)
+}
+
+func buildTableDriftObjects(d *schema.TableDiff) []*storepb.DriftObject {
+	var objects []*storepb.DriftObject
+	for _, c := range d.ColumnChanges {
+		objects = append(objects, newDriftObject(c.Action, storepb.DriftObject_COLUMN, d.SchemaName, getName(c.OldColumn.GetName(), c.NewColumn.GetName())))
+	}
+	for _, c := range d.IndexChanges {
+		objects = append(objects, newDriftObject(c.Action, storepb.DriftObject_INDEX, d.SchemaName, getName(c.OldIndex.GetName(), c.NewIndex.GetName())))
+	}
+	for _, c := range d.ForeignKeyChanges {
+		objects = append(objects, newDriftObject(c.Action, storepb.DriftObject_FOREIGN_KEY, d.SchemaName, getName(c.OldForeignKey.GetName(), c.NewForeignKey.GetName())))
+	}
+	for _, c := range d.CheckConstraintChanges {
+		objects = append(objects, newDriftObject(c.Action, storepb.DriftObject_CHECK_CONSTRAINT, d.SchemaName, getName(c.OldCheckConstraint.GetName(), c.NewCheckConstraint.GetName())))
+	}
+	for _, c := range d.PartitionChanges {
+		objects = append(objects, newDriftObject(c.Action, storepb.DriftObject_PARTITION, d.SchemaName, getName(c.OldPartition.GetName(), c.NewPartition.GetName())))
+	}
+	for _, c := range d.TriggerChanges {
+		objects = append(objects, newDriftObject(c.Action, storepb.DriftObject_TRIGGER, d.SchemaName, getName(c.OldTrigger.GetName(), c.NewTrigger.GetName())))
+	}
+	return mergeDriftObjects(objects)
+}
+
+func newDriftObject(action schema.MetadataDiffAction, tp storepb.DriftObject_Type, schemaName, name string) *storepb.DriftObject {
+	object := &storepb.DriftObject{
+		Type:   tp,
+		Schema: schemaName,
+		Name:   name,
+	}
+	switch action {
+	case schema.MetadataDiffActionCreate:
+		object.Action = storepb.DriftObject_ADD
+	case schema.MetadataDiffActionDrop:
+		object.Action = storepb.DriftObject_DROP
+	case schema.MetadataDiffActionAlter:
+		object.Action = storepb.DriftObject_ALTER
+	}
+	return object
+}
+
+// mergeDriftObjects merges the dropped and added objects with the same name into an altered object,
+// because the differ reports the changed functions, indexes and so on as drop and create.
+func mergeDriftObjects(objects []*storepb.DriftObject) []*storepb.DriftObject {
+	type key struct {
+		tp     storepb.DriftObject_Type
+		schema string
+		name   string
+	}
+	added := make(map[key]*storepb.DriftObject)
+	for _, object := range objects {
+		if object.Action == storepb.DriftObject_ADD {
+			added[key{tp: object.Type, schema: object.Schema, name: object.Name}] = object
+		}
+	}
+	merged := make(map[key]bool)
+	var result []*storepb.DriftObject
+	for _, object := range objects {
+		k := key{tp: object.Type, schema: object.Schema, name: object.Name}
+		switch object.Action {
+		case storepb.DriftObject_DROP:
+			if a, ok := added[k]; ok {
+				merged[k] = true
+				result = append(result, &storepb.DriftObject{
+					Action:   storepb.DriftObject_ALTER,
+					Type:     object.Type,
+					Schema:   object.Schema,
+					Name:     object.Name,
+					Children: a.Children,
+				})
+				continue
+			}
+		case storepb.DriftObject_ADD:
+			if merged[k] {
+				continue
+			}
+		default:
+		}
+		result = append(result, object)
+	}
+	return result
+}
+
+func getName(oldName, newName string) string {
+	if newName != "" {
+		return newName
+	}
+	return oldName
+}
+
+// formatDriftObjects formats the drifted objects for the webhook, e.g. "ALTER TABLE public.users (ADD COLUMN email)".
+func formatDriftObjects(objects []*storepb.DriftObject) []string {
+	var result []string
+	for _, object := range objects {
+		var buf strings.Builder
+		_, _ = fmt.Fprintf(&buf, "%s %s %s", object.Action.String(), strings.ReplaceAll(object.Type.String(), "_", " "), getDriftObjectName(object))
+		if len(object.Children) > 0 {
+			var children []string
+			for _, child := range object.Children {
+				children = append(children, fmt.Sprintf("%s %s %s", child.Action.String(), strings.ReplaceAll(child.Type.String(), "_", " "), child.Name))
+			}
+			_, _ = fmt.Fprintf(&buf, " (%s)", strings.Join(children, ", "))
+		}
+		result = append(result, buf.String())
+	}
+	return result
+}
+
+func getDriftObjectName(object *storepb.DriftObject) string {
+	if object.Schema == "" || object.Type == storepb.DriftObject_SCHEMA {
+		return object.Name
+	}
+	return fmt.Sprintf("%s.%s", object.Schema, object.Name)
+}
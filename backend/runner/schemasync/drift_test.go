@@ -0,0 +1,114 @@
+package schemasync
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestBuildDriftObjects(t *testing.T) {
+	a := require.New(t)
+
+	oldMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "name", Type: "text"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "idx_name", Expressions: []string{"name"}},
+						},
+					},
+					{
+						Name:    "logs",
+						Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "integer"}},
+					},
+				},
+				Functions: []*storepb.FunctionMetadata{
+					{Name: "add_one", Signature: "add_one(integer)", Definition: "SELECT $1 + 1"},
+				},
+			},
+		},
+	}
+	newMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "name", Type: "varchar(100)"},
+							{Name: "email", Type: "text"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "idx_name", Unique: true, Expressions: []string{"name"}},
+						},
+					},
+				},
+				Functions: []*storepb.FunctionMetadata{
+					{Name: "add_one", Signature: "add_one(integer)", Definition: "SELECT $1 + 2"},
+				},
+			},
+			{Name: "audit"},
+		},
+	}
+	oldSchema := model.NewDatabaseSchema(oldMetadata, nil, nil, storepb.Engine_POSTGRES, true)
+	newSchema := model.NewDatabaseSchema(newMetadata, nil, nil, storepb.Engine_POSTGRES, true)
+	diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_POSTGRES, oldSchema, newSchema)
+	a.NoError(err)
+
+	got := buildDriftObjects(diff)
+	want := []*storepb.DriftObject{
+		{Action: storepb.DriftObject_ADD, Type: storepb.DriftObject_SCHEMA, Schema: "audit", Name: "audit"},
+		{Action: storepb.DriftObject_DROP, Type: storepb.DriftObject_TABLE, Schema: "public", Name: "logs"},
+		{
+			Action: storepb.DriftObject_ALTER,
+			Type:   storepb.DriftObject_TABLE,
+			Schema: "public",
+			Name:   "users",
+			Children: []*storepb.DriftObject{
+				{Action: storepb.DriftObject_ALTER, Type: storepb.DriftObject_COLUMN, Schema: "public", Name: "name"},
+				{Action: storepb.DriftObject_ADD, Type: storepb.DriftObject_COLUMN, Schema: "public", Name: "email"},
+				{Action: storepb.DriftObject_ALTER, Type: storepb.DriftObject_INDEX, Schema: "public", Name: "idx_name"},
+			},
+		},
+		{Action: storepb.DriftObject_ALTER, Type: storepb.DriftObject_FUNCTION, Schema: "public", Name: "add_one"},
+	}
+	a.Empty(cmp.Diff(want, got, protocmp.Transform()))
+
+	a.Equal([]string{
+		"ADD SCHEMA audit",
+		"DROP TABLE public.logs",
+		"ALTER TABLE public.users (ALTER COLUMN name, ADD COLUMN email, ALTER INDEX idx_name)",
+		"ALTER FUNCTION public.add_one",
+	}, formatDriftObjects(got))
+}
+
+func TestDriftObjectsEqual(t *testing.T) {
+	a := require.New(t)
+	objects := []*storepb.DriftObject{
+		{Action: storepb.DriftObject_DROP, Type: storepb.DriftObject_TABLE, Schema: "public", Name: "logs"},
+	}
+	a.True(driftObjectsEqual(objects, []*storepb.DriftObject{
+		{Action: storepb.DriftObject_DROP, Type: storepb.DriftObject_TABLE, Schema: "public", Name: "logs"},
+	}))
+	a.False(driftObjectsEqual(objects, []*storepb.DriftObject{
+		{Action: storepb.DriftObject_ADD, Type: storepb.DriftObject_TABLE, Schema: "public", Name: "logs"},
+	}))
+	a.False(driftObjectsEqual(objects, nil))
+}
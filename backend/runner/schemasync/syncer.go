@@ -20,12 +20,15 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/coordinator"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
 )
@@ -40,13 +43,15 @@ const (
 )
 
 // NewSyncer creates a schema syncer.
-func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, profile *config.Profile, stateCfg *state.State, licenseService *enterprise.LicenseService) *Syncer {
+func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, profile *config.Profile, stateCfg *state.State, licenseService *enterprise.LicenseService, webhookManager *webhook.Manager, coordinator *coordinator.Coordinator) *Syncer {
 	return &Syncer{
 		store:          stores,
 		dbFactory:      dbFactory,
 		profile:        profile,
 		stateCfg:       stateCfg,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
+		coordinator:    coordinator,
 	}
 }
 
@@ -59,6 +64,8 @@ type Syncer struct {
 	profile         *config.Profile
 	stateCfg        *state.State
 	licenseService  *enterprise.LicenseService
+	webhookManager  *webhook.Manager
+	coordinator     *coordinator.Coordinator
 	databaseSyncMap sync.Map // map[string]*store.DatabaseMessage
 }
 
@@ -156,12 +163,35 @@ func (s *Syncer) trySyncAll(ctx context.Context) {
 		}
 	}()
 
+	if !s.coordinator.IsActive() {
+		return
+	}
+
 	wp := pool.New().WithMaxGoroutines(MaximumOutstanding)
 	instances, err := s.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
 	if err != nil {
 		slog.Error("Failed to retrieve instances", log.BBError(err))
 		return
 	}
+	// Claim the instances so that they are not synced by other replicas in the same round.
+	var claimedInstances []*store.InstanceMessage
+	for _, instance := range instances {
+		claimed, err := s.coordinator.TryLockKey(ctx, coordinator.LockKindInstanceSync, instance.ResourceID)
+		if err != nil {
+			slog.Error("Failed to claim instance sync", slog.String("instance", instance.ResourceID), log.BBError(err))
+			continue
+		}
+		if claimed {
+			claimedInstances = append(claimedInstances, instance)
+		}
+	}
+	defer func() {
+		for _, instance := range claimedInstances {
+			s.coordinator.UnlockKey(ctx, coordinator.LockKindInstanceSync, instance.ResourceID)
+		}
+	}()
+	instances = claimedInstances
+
 	now := time.Now()
 	for _, instance := range instances {
 		instance := instance
@@ -528,12 +558,21 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	metadata.LastSyncTime = timestamppb.New(time.Now())
 	metadata.BackupAvailable = s.databaseBackupAvailable(ctx, instance, databaseMetadata)
 	metadata.Datashare = databaseMetadata.Datashare
-	drifted, skipped, err := s.getSchemaDrifted(ctx, instance, database, string(rawDump))
+	driftReport, skipped, err := s.getSchemaDriftReport(ctx, instance, database, databaseMetadata)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get schema drifted for database %q", database.DatabaseName)
+		return errors.Wrapf(err, "failed to get schema drift report for database %q", database.DatabaseName)
 	}
+	notifyDrift := false
 	if !skipped {
-		metadata.Drifted = drifted
+		previousReport := metadata.GetDriftReport()
+		if driftReport != nil && previousReport.GetChangelogUid() == driftReport.GetChangelogUid() && driftObjectsEqual(previousReport.GetObjects(), driftReport.GetObjects()) {
+			// Keep the time when the drift is first detected.
+			driftReport.DetectTime = previousReport.GetDetectTime()
+		} else {
+			notifyDrift = driftReport != nil
+		}
+		metadata.Drifted = driftReport != nil
+		metadata.DriftReport = driftReport
 	}
 	if _, err := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
 		InstanceID:   database.InstanceID,
@@ -543,6 +582,19 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	}); err != nil {
 		return errors.Wrapf(err, "failed to update database %q for instance %q", database.DatabaseName, database.InstanceID)
 	}
+	if notifyDrift {
+		s.webhookManager.CreateEvent(ctx, &webhook.Event{
+			Actor:   s.store.GetSystemBotUser(ctx),
+			Type:    common.EventTypeSchemaDrift,
+			Comment: fmt.Sprintf("The schema of database %q is drifted from the latest changelog.", database.DatabaseName),
+			Project: webhook.NewProject(project),
+			SchemaDrift: &webhook.EventSchemaDrift{
+				InstanceID:   database.InstanceID,
+				DatabaseName: database.DatabaseName,
+				Objects:      formatDriftObjects(driftReport.GetObjects()),
+			},
+		})
+	}
 
 	todo := !common.EngineDBSchemaReadyToMigrate(instance.Metadata.GetEngine())
 	if err := s.store.UpsertDBSchema(ctx,
@@ -560,10 +612,12 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	return nil
 }
 
-func (s *Syncer) getSchemaDrifted(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, rawDump string) (drifted bool, skipped bool, err error) {
+// getSchemaDriftReport compares the schema recorded by the latest changelog with the synced schema.
+// It returns nil if the schema is not drifted.
+func (s *Syncer) getSchemaDriftReport(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, databaseMetadata *storepb.DatabaseSchemaMetadata) (report *storepb.DriftReport, skipped bool, err error) {
 	// Redis and MongoDB are schemaless.
 	if disableSchemaDriftCheck(instance.Metadata.GetEngine()) {
-		return false, false, nil
+		return nil, false, nil
 	}
 	limit := 1
 	list, err := s.store.ListChangelogs(ctx, &store.FindChangelogMessage{
@@ -572,24 +626,49 @@ func (s *Syncer) getSchemaDrifted(ctx context.Context, instance *store.InstanceM
 		TypeList:       []string{string(db.Migrate), string(db.Baseline)},
 		HasSyncHistory: true,
 		Limit:          &limit,
-		ShowFull:       true,
 	})
 	if err != nil {
-		return false, false, errors.Wrapf(err, "failed to list changelogs")
+		return nil, false, errors.Wrapf(err, "failed to list changelogs")
 	}
 	if len(list) == 0 {
-		return false, false, nil
+		return nil, false, nil
 	}
 
 	changelog := list[0]
 	if changelog.Payload.GetGitCommit() != s.profile.GitCommit {
-		return false, true, nil
+		return nil, true, nil
 	}
 	if changelog.SyncHistoryUID == nil {
-		return false, false, errors.Errorf("expect sync history but get nil")
+		return nil, false, errors.Errorf("expect sync history but get nil")
 	}
-	latestSchema := string(rawDump)
-	return changelog.Schema != latestSchema, false, nil
+	syncHistory, err := s.store.GetSyncHistoryByUID(ctx, *changelog.SyncHistoryUID)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to get sync history %d", *changelog.SyncHistoryUID)
+	}
+
+	engine := instance.Metadata.GetEngine()
+	isCaseSensitive := store.IsObjectCaseSensitive(instance)
+	oldSchema := model.NewDatabaseSchema(syncHistory.Metadata, nil, nil, engine, isCaseSensitive)
+	newSchema := model.NewDatabaseSchema(databaseMetadata, nil, nil, engine, isCaseSensitive)
+	diff, err := schema.GetDatabaseSchemaDiff(engine, oldSchema, newSchema)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to diff the schema with changelog %d", changelog.UID)
+	}
+	objects := buildDriftObjects(diff)
+	if len(objects) == 0 {
+		return nil, false, nil
+	}
+	return &storepb.DriftReport{
+		ChangelogUid: changelog.UID,
+		DetectTime:   timestamppb.Now(),
+		Objects:      objects,
+	}, false, nil
+}
+
+func driftObjectsEqual(a, b []*storepb.DriftObject) bool {
+	return slices.EqualFunc(a, b, func(x, y *storepb.DriftObject) bool {
+		return proto.Equal(x, y)
+	})
 }
 
 func (s *Syncer) databaseBackupAvailable(ctx context.Context, instance *store.InstanceMessage, dbSchema *storepb.DatabaseSchemaMetadata) bool {
@@ -606,7 +685,7 @@ func (s *Syncer) databaseBackupAvailable(ctx context.Context, instance *store.In
 				return true
 			}
 		}
-	case storepb.Engine_MYSQL, storepb.Engine_MSSQL, storepb.Engine_TIDB:
+	case storepb.Engine_MYSQL, storepb.Engine_MSSQL, storepb.Engine_TIDB, storepb.Engine_STARROCKS, storepb.Engine_DORIS, storepb.Engine_SNOWFLAKE:
 		dbName := common.BackupDatabaseNameOfEngine(instance.Metadata.GetEngine())
 		backupDB, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
 			InstanceID:   &instance.ResourceID,
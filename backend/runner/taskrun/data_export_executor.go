@@ -1,6 +1,7 @@
 package taskrun
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/pkg/errors"
@@ -74,13 +75,13 @@ func (exec *DataExportExecutor) RunOnce(ctx context.Context, _ context.Context,
 		Format:    v1pb.ExportFormat(task.Payload.GetFormat()),
 		Password:  "", /* do not pass the password, we will encrypt the files will password when users download them */
 	}
-	bytes, _, exportErr := apiv1.DoExport(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, issue.Creator /* user */, instance, database, nil, exec.schemaSyncer, dataSource)
-	if exportErr != nil {
+	var buf bytes.Buffer
+	if _, exportErr := apiv1.DoExport(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, issue.Creator /* user */, instance, database, nil, exec.schemaSyncer, dataSource, &buf); exportErr != nil {
 		return true, nil, errors.Wrap(exportErr, "failed to export data")
 	}
 
 	exportArchive, err := exec.store.CreateExportArchive(ctx, &store.ExportArchiveMessage{
-		Bytes: bytes,
+		Bytes: buf.Bytes(),
 		Payload: &storepb.ExportArchivePayload{
 			FileFormat: task.Payload.GetFormat(),
 		},
@@ -214,7 +214,7 @@ func (exec *DataUpdateExecutor) backupData(
 		DatabaseName:            database.DatabaseName,
 	}
 	if instance.Metadata.GetEngine() == storepb.Engine_ORACLE {
-		oracleDriver, ok := driver.(*oracle.Driver)
+		oracleDriver, ok := db.Unwrap(driver).(*oracle.Driver)
 		if ok {
 			if version, err := oracleDriver.GetVersion(); err == nil {
 				tc.Version = version
@@ -255,10 +255,14 @@ func (exec *DataUpdateExecutor) backupData(
 			if _, err := driver.Execute(driverCtx, fmt.Sprintf("ALTER TABLE `%s`.`%s` COMMENT = '%s, source table (%s, %s)'", backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
 				return nil, errors.Wrap(err, "failed to set table comment")
 			}
-		case storepb.Engine_MYSQL:
+		case storepb.Engine_MYSQL, storepb.Engine_STARROCKS:
 			if _, err := driver.Execute(driverCtx, fmt.Sprintf("ALTER TABLE `%s`.`%s` COMMENT = '%s, source table (%s, %s)'", backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
 				return nil, errors.Wrap(err, "failed to set table comment")
 			}
+		case storepb.Engine_DORIS:
+			if _, err := driver.Execute(driverCtx, fmt.Sprintf("ALTER TABLE `%s`.`%s` MODIFY COMMENT '%s, source table (%s, %s)'", backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
+				return nil, errors.Wrap(err, "failed to set table comment")
+			}
 		case storepb.Engine_MSSQL:
 			schemaName := statement.SourceSchema
 			if schemaName == "" {
@@ -279,6 +283,10 @@ func (exec *DataUpdateExecutor) backupData(
 			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."%s" IS '%s, source table (%s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
 				return nil, errors.Wrap(err, "failed to set table comment")
 			}
+		case storepb.Engine_SNOWFLAKE:
+			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."PUBLIC"."%s" IS '%s, source table (%s, %s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceSchema, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
+				return nil, errors.Wrap(err, "failed to set table comment")
+			}
 		}
 
 		item := &storepb.PriorBackupDetail_Item{
@@ -295,13 +303,17 @@ func (exec *DataUpdateExecutor) backupData(
 			StartPosition: statement.StartPosition,
 			EndPosition:   statement.EndPosition,
 		}
-		if instance.Metadata.GetEngine() == storepb.Engine_POSTGRES {
+		switch instance.Metadata.GetEngine() {
+		case storepb.Engine_POSTGRES:
 			item.TargetTable = &storepb.PriorBackupDetail_Item_Table{
 				Database: sourceDatabaseName,
 				// postgres uses schema as the backup database name currently.
 				Schema: backupDatabaseName,
 				Table:  statement.TargetTableName,
 			}
+		case storepb.Engine_SNOWFLAKE:
+			// snowflake backs up the tables to the PUBLIC schema of the backup database.
+			item.TargetTable.Schema = "PUBLIC"
 		}
 		priorBackupDetail.Items = append(priorBackupDetail.Items, item)
 
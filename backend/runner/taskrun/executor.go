@@ -343,6 +343,7 @@ func postMigration(ctx context.Context, stores *store.Store, mc *migrateContext,
 		return false, nil, errors.Errorf("failed to convert database metadata type")
 	}
 	metadata.Drifted = false
+	metadata.DriftReport = nil
 	if _, err := stores.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
 		InstanceID:   database.InstanceID,
 		DatabaseName: database.DatabaseName,
@@ -15,6 +15,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/coordinator"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
@@ -40,6 +41,7 @@ type SchedulerV2 struct {
 	executorMap    map[storepb.Task_Type]Executor
 	profile        *config.Profile
 	licenseService *enterprise.LicenseService
+	coordinator    *coordinator.Coordinator
 }
 
 // NewSchedulerV2 will create a new scheduler.
@@ -49,6 +51,7 @@ func NewSchedulerV2(
 	webhookManager *webhook.Manager,
 	profile *config.Profile,
 	licenseService *enterprise.LicenseService,
+	coordinator *coordinator.Coordinator,
 ) *SchedulerV2 {
 	return &SchedulerV2{
 		store:          store,
@@ -57,6 +60,7 @@ func NewSchedulerV2(
 		profile:        profile,
 		executorMap:    map[storepb.Task_Type]Executor{},
 		licenseService: licenseService,
+		coordinator:    coordinator,
 	}
 }
 
@@ -102,12 +106,25 @@ func (s *SchedulerV2) runOnce(ctx context.Context) {
 		}
 	}()
 
-	if err := s.scheduleAutoRolloutTasks(ctx); err != nil {
-		slog.Error("failed to schedule auto rollout tasks", log.BBError(err))
+	if s.coordinator.Enabled() {
+		// The task runs may be canceled on another replica.
+		if err := s.cancelCanceledTaskRuns(ctx); err != nil {
+			slog.Error("failed to cancel canceled task runs", log.BBError(err))
+		}
+	}
+	if !s.coordinator.IsActive() {
+		return
 	}
 
-	if err := s.schedulePendingTaskRuns(ctx); err != nil {
-		slog.Error("failed to schedule pending task runs", log.BBError(err))
+	// Only the leader creates and promotes the task runs, so that the replicas don't race with each other.
+	if s.coordinator.IsLeader() {
+		if err := s.scheduleAutoRolloutTasks(ctx); err != nil {
+			slog.Error("failed to schedule auto rollout tasks", log.BBError(err))
+		}
+
+		if err := s.schedulePendingTaskRuns(ctx); err != nil {
+			slog.Error("failed to schedule pending task runs", log.BBError(err))
+		}
 	}
 
 	if err := s.scheduleRunningTaskRuns(ctx); err != nil {
@@ -115,6 +132,33 @@ func (s *SchedulerV2) runOnce(ctx context.Context) {
 	}
 }
 
+// cancelCanceledTaskRuns cancels the task runs executing on this replica that have been canceled in the database.
+func (s *SchedulerV2) cancelCanceledTaskRuns(ctx context.Context) error {
+	var taskRunIDs []int
+	s.stateCfg.RunningTaskRunsCancelFunc.Range(func(key, _ any) bool {
+		if taskRunID, ok := key.(int); ok {
+			taskRunIDs = append(taskRunIDs, taskRunID)
+		}
+		return true
+	})
+	if len(taskRunIDs) == 0 {
+		return nil
+	}
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		UIDs:   &taskRunIDs,
+		Status: &[]storepb.TaskRun_Status{storepb.TaskRun_CANCELED},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list canceled task runs")
+	}
+	for _, taskRun := range taskRuns {
+		if cancelFunc, ok := s.stateCfg.RunningTaskRunsCancelFunc.Load(taskRun.ID); ok {
+			cancelFunc.(context.CancelFunc)()
+		}
+	}
+	return nil
+}
+
 func (s *SchedulerV2) scheduleAutoRolloutTasks(ctx context.Context) error {
 	environments, err := s.store.GetEnvironmentSetting(ctx)
 	if err != nil {
@@ -424,6 +468,30 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 		}
 	}
 
+	// Claim the task run so that it is not executed by other replicas.
+	claimed, err := s.coordinator.TryLock(ctx, coordinator.LockKindTaskRun, taskRun.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to claim task run")
+	}
+	if !claimed {
+		return nil
+	}
+	// release the claim if we return below.
+	revertClaim := true
+	defer func() {
+		if revertClaim {
+			s.coordinator.Unlock(ctx, coordinator.LockKindTaskRun, taskRun.ID)
+		}
+	}()
+	// The task run may have been executed by another replica, which released the claim after it was done.
+	latestTaskRun, err := s.store.GetTaskRun(ctx, taskRun.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task run")
+	}
+	if latestTaskRun.Status != storepb.TaskRun_RUNNING {
+		return nil
+	}
+
 	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &task.InstanceID})
 	if err != nil {
 		return errors.Wrapf(err, "failed to get instance")
@@ -525,6 +593,7 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	// The executor will decrement them.
 	revertInstanceConnectionsIncrement = false
 	revertRolloutConnectionsIncrement = false
+	revertClaim = false
 	go s.runTaskRunOnce(ctx, taskRun, task, executor)
 	return nil
 }
@@ -547,11 +616,13 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		}
 		s.stateCfg.InstanceOutstandingConnections.Decrement(task.InstanceID)
 		s.stateCfg.RolloutOutstandingTasks.Decrement(strconv.Itoa(task.PipelineID) + "/" + task.InstanceID)
+		s.coordinator.Unlock(ctx, coordinator.LockKindTaskRun, taskRun.ID)
 	}()
 
 	driverCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	s.stateCfg.RunningTaskRunsCancelFunc.Store(taskRun.ID, cancel)
+	s.coordinator.SetCancel(coordinator.LockKindTaskRun, taskRun.ID, cancel)
 
 	done, result, err := RunExecutorOnce(ctx, driverCtx, executor, task, taskRun.ID)
 
@@ -0,0 +1,113 @@
+// Package webhookdelivery is the runner for delivering the persisted webhook events.
+package webhookdelivery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	webhookDeliveryRunnerInterval = 5 * time.Second
+	// webhookDeliveryBatchSize is the maximum number of deliveries claimed in one round.
+	webhookDeliveryBatchSize = 100
+	// webhookDeliveryLease is how long a claimed delivery is hidden from other rounds while being sent.
+	webhookDeliveryLease = 1 * time.Minute
+	// webhookDeliveryCleanupInterval is how often the done and dead deliveries are cleaned up.
+	webhookDeliveryCleanupInterval = 1 * time.Hour
+	// webhookDeliveryRetentionPeriod is how long the done and dead deliveries are kept for the delivery history.
+	webhookDeliveryRetentionPeriod = 30 * 24 * time.Hour
+)
+
+// Runner is the runner for delivering webhook events from the outbox.
+type Runner struct {
+	store          *store.Store
+	stateCfg       *state.State
+	webhookManager *webhook.Manager
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, stateCfg *state.State, webhookManager *webhook.Manager) *Runner {
+	return &Runner{
+		store:          store,
+		stateCfg:       stateCfg,
+		webhookManager: webhookManager,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(webhookDeliveryRunnerInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(webhookDeliveryCleanupInterval)
+	defer cleanupTicker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", webhookDeliveryRunnerInterval))
+	r.cleanup(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			r.runOnce(ctx)
+		case <-r.stateCfg.WebhookDeliveryTickleChan:
+			r.runOnce(ctx)
+		case <-cleanupTicker.C:
+			r.cleanup(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	deliveries, err := r.store.ClaimDueWebhookDeliveries(ctx, webhookDeliveryBatchSize, webhookDeliveryLease)
+	if err != nil {
+		slog.Error("failed to claim webhook deliveries", log.BBError(err))
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *store.WebhookDeliveryMessage) {
+			defer wg.Done()
+			r.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+// cleanup deletes the done and dead deliveries older than the retention period.
+func (r *Runner) cleanup(ctx context.Context) {
+	rowsAffected, err := r.store.DeleteExpiredWebhookDeliveries(ctx, webhookDeliveryRetentionPeriod)
+	if err != nil {
+		slog.Error("failed to clean up webhook deliveries", log.BBError(err))
+		return
+	}
+	if rowsAffected > 0 {
+		slog.Info("Cleaned up expired webhook deliveries", slog.Int64("count", rowsAffected))
+	}
+}
+
+func (r *Runner) deliver(ctx context.Context, delivery *store.WebhookDeliveryMessage) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("Webhook delivery runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+	if err := r.webhookManager.Deliver(ctx, delivery); err != nil {
+		slog.Error("failed to deliver webhook event", slog.Int64("delivery", delivery.UID), log.BBError(err))
+	}
+}
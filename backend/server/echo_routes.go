@@ -13,6 +13,7 @@ import (
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/saml"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 )
@@ -21,6 +22,7 @@ func configureEchoRouters(
 	e *echo.Echo,
 	lspServer *lsp.Server,
 	directorySyncServer *directorysync.Service,
+	samlServer *saml.Service,
 	profile *config.Profile,
 ) {
 	e.Use(recoverMiddleware)
@@ -69,6 +71,8 @@ func configureEchoRouters(
 	hookGroup := e.Group(webhookAPIPrefix)
 	scimGroup := hookGroup.Group(scimAPIPrefix)
 	directorySyncServer.RegisterDirectorySyncRoutes(scimGroup)
+
+	samlServer.RegisterRoutes(e.Group(samlAPIPrefix))
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
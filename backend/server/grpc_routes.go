@@ -30,6 +30,7 @@ import (
 	"github.com/bytebase/bytebase/backend/enterprise"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/store"
@@ -48,6 +49,7 @@ func configureGrpcRouters(
 	schemaSyncer *schemasync.Syncer,
 	webhookManager *webhook.Manager,
 	iamManager *iam.Manager,
+	ldapSyncer *ldapsync.Syncer,
 	secret string,
 ) error {
 	// Note: the gateway response modifier takes the token duration on server startup. If the value is changed,
@@ -83,13 +85,13 @@ func configureGrpcRouters(
 	databaseGroupService := apiv1.NewDatabaseGroupService(stores, profile, iamManager, licenseService)
 	databaseService := apiv1.NewDatabaseService(stores, schemaSyncer, licenseService, profile, iamManager)
 	groupService := apiv1.NewGroupService(stores, iamManager, licenseService)
-	identityProviderService := apiv1.NewIdentityProviderService(stores, licenseService)
+	identityProviderService := apiv1.NewIdentityProviderService(stores, licenseService, ldapSyncer)
 	instanceRoleService := apiv1.NewInstanceRoleService(stores, dbFactory)
 	instanceService := apiv1.NewInstanceService(stores, licenseService, metricReporter, stateCfg, dbFactory, schemaSyncer, iamManager)
 	issueService := apiv1.NewIssueService(stores, webhookManager, stateCfg, licenseService, profile, iamManager, metricReporter)
 	orgPolicyService := apiv1.NewOrgPolicyService(stores, licenseService)
 	planService := apiv1.NewPlanService(stores, sheetManager, licenseService, dbFactory, stateCfg, profile, iamManager)
-	projectService := apiv1.NewProjectService(stores, profile, iamManager, licenseService)
+	projectService := apiv1.NewProjectService(stores, profile, iamManager, licenseService, webhookManager)
 	releaseService := apiv1.NewReleaseService(stores, sheetManager, schemaSyncer, dbFactory)
 	reviewConfigService := apiv1.NewReviewConfigService(stores, licenseService)
 	revisionService := apiv1.NewRevisionService(stores)
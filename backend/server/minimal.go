@@ -20,6 +20,7 @@ import (
 
 	// IM webhooks.
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/dingtalk"
+	_ "github.com/bytebase/bytebase/backend/plugin/webhook/eventsink"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/slack"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/wecom"
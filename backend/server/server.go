@@ -16,9 +16,11 @@ import (
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/saml"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/coordinator"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sheet"
@@ -30,12 +32,14 @@ import (
 	"github.com/bytebase/bytebase/backend/migrator"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	runnermigrator "github.com/bytebase/bytebase/backend/runner/migrator"
 	"github.com/bytebase/bytebase/backend/runner/monitor"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/runner/webhookdelivery"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -43,6 +47,8 @@ const (
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
 	scimAPIPrefix    = "/scim"
+	// samlAPIPrefix is the API prefix for Bytebase as the SAML service provider.
+	samlAPIPrefix = "/saml"
 	// lspAPI is the API for Bytebase Language Server Protocol.
 	lspAPI                 = "/lsp"
 	maxStacksize           = 1024 * 10240
@@ -59,6 +65,9 @@ type Server struct {
 	approvalRunner        *approval.Runner
 	columnDefaultMigrator *runnermigrator.ColumnDefaultMigrator
 	exportArchiveCleaner  *runnermigrator.ExportArchiveCleaner
+	webhookDeliveryRunner *webhookdelivery.Runner
+	ldapSyncRunner        *ldapsync.Runner
+	coordinator           *coordinator.Coordinator
 	runnerWG              sync.WaitGroup
 
 	webhookManager *webhook.Manager
@@ -146,6 +155,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		return nil, errors.Wrapf(err, "failed to new store")
 	}
 	s.store = stores
+	s.coordinator = coordinator.New(stores.GetDB(), profile)
 	sheetManager := sheet.NewManager(stores)
 
 	s.stateCfg, err = state.New()
@@ -178,24 +188,24 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create iam manager")
 	}
-	s.webhookManager = webhook.NewManager(stores, s.iamManager)
+	s.webhookManager = webhook.NewManager(stores, s.iamManager, s.stateCfg)
 	s.dbFactory = dbfactory.New(s.store, s.licenseService)
 
 	// Configure echo server.
 	s.echoServer = echo.New()
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile)
-	s.schemaSyncer = schemasync.NewSyncer(stores, s.dbFactory, s.profile, s.stateCfg, s.licenseService)
-	s.approvalRunner = approval.NewRunner(stores, sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.licenseService)
+	s.schemaSyncer = schemasync.NewSyncer(stores, s.dbFactory, s.profile, s.stateCfg, s.licenseService, s.webhookManager, s.coordinator)
+	s.approvalRunner = approval.NewRunner(stores, sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.licenseService, s.coordinator)
 
-	s.taskSchedulerV2 = taskrun.NewSchedulerV2(stores, s.stateCfg, s.webhookManager, profile, s.licenseService)
+	s.taskSchedulerV2 = taskrun.NewSchedulerV2(stores, s.stateCfg, s.webhookManager, profile, s.licenseService, s.coordinator)
 	s.taskSchedulerV2.Register(storepb.Task_DATABASE_CREATE, taskrun.NewDatabaseCreateExecutor(stores, s.dbFactory, s.schemaSyncer, s.stateCfg, profile))
 	s.taskSchedulerV2.Register(storepb.Task_DATABASE_SCHEMA_UPDATE, taskrun.NewSchemaUpdateExecutor(stores, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 	s.taskSchedulerV2.Register(storepb.Task_DATABASE_DATA_UPDATE, taskrun.NewDataUpdateExecutor(stores, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 	s.taskSchedulerV2.Register(storepb.Task_DATABASE_EXPORT, taskrun.NewDataExportExecutor(stores, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 	s.taskSchedulerV2.Register(storepb.Task_DATABASE_SCHEMA_UPDATE_GHOST, taskrun.NewSchemaUpdateGhostExecutor(stores, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, s.profile))
 
-	s.planCheckScheduler = plancheck.NewScheduler(stores, s.licenseService, s.stateCfg)
+	s.planCheckScheduler = plancheck.NewScheduler(stores, s.licenseService, s.stateCfg, s.coordinator)
 	databaseConnectExecutor := plancheck.NewDatabaseConnectExecutor(stores, s.dbFactory)
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseConnect, databaseConnectExecutor)
 	statementAdviseExecutor := plancheck.NewStatementAdviseExecutor(stores, sheetManager, s.dbFactory, s.licenseService)
@@ -204,6 +214,10 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
 	statementReportExecutor := plancheck.NewStatementReportExecutor(stores, sheetManager, s.dbFactory)
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
+	statementLockImpactExecutor := plancheck.NewStatementLockImpactExecutor(stores, sheetManager)
+	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementLockImpact, statementLockImpactExecutor)
+	statementOnlineDDLExecutor := plancheck.NewStatementOnlineDDLExecutor(stores, sheetManager)
+	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementOnlineDDL, statementOnlineDDLExecutor)
 
 	// Column default value migrator
 	s.columnDefaultMigrator = runnermigrator.NewColumnDefaultMigrator(stores, runnermigrator.EnginesNeedingMigration())
@@ -211,18 +225,26 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	// Export archive cleaner
 	s.exportArchiveCleaner = runnermigrator.NewExportArchiveCleaner(stores)
 
+	// Webhook delivery runner
+	s.webhookDeliveryRunner = webhookdelivery.NewRunner(stores, s.stateCfg, s.webhookManager)
+
+	// LDAP group sync runner
+	ldapSyncer := ldapsync.NewSyncer(stores, s.iamManager)
+	s.ldapSyncRunner = ldapsync.NewRunner(stores, ldapSyncer, s.coordinator)
+
 	// Metric reporter
 	s.initMetricReporter()
 
 	// LSP server.
-	s.lspServer = lsp.NewServer(s.store, profile)
+	s.lspServer = lsp.NewServer(s.store, sheetManager, profile)
 
 	directorySyncServer := directorysync.NewService(s.store, s.licenseService, s.iamManager)
+	samlServer := saml.NewService(s.store, secret)
 
-	if err := configureGrpcRouters(ctx, s.echoServer, s.store, sheetManager, s.dbFactory, s.licenseService, s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.webhookManager, s.iamManager, secret); err != nil {
+	if err := configureGrpcRouters(ctx, s.echoServer, s.store, sheetManager, s.dbFactory, s.licenseService, s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.webhookManager, s.iamManager, ldapSyncer, secret); err != nil {
 		return nil, errors.Wrapf(err, "failed to configure gRPC routers")
 	}
-	configureEchoRouters(s.echoServer, s.lspServer, directorySyncServer, profile)
+	configureEchoRouters(s.echoServer, s.lspServer, directorySyncServer, samlServer, profile)
 
 	serverStarted = true
 	return s, nil
@@ -234,6 +256,10 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.cancel = cancel
 	// runnerWG waits for all goroutines to complete.
 	s.runnerWG.Add(1)
+	go s.coordinator.Run(ctx, &s.runnerWG)
+	s.runnerWG.Add(1)
+	go s.dbFactory.Run(ctx, &s.runnerWG)
+	s.runnerWG.Add(1)
 	go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
 	s.runnerWG.Add(1)
 	go s.schemaSyncer.Run(ctx, &s.runnerWG)
@@ -252,6 +278,12 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.runnerWG.Add(1)
 	go s.exportArchiveCleaner.Run(ctx, &s.runnerWG)
 
+	s.runnerWG.Add(1)
+	go s.webhookDeliveryRunner.Run(ctx, &s.runnerWG)
+
+	s.runnerWG.Add(1)
+	go s.ldapSyncRunner.Run(ctx, &s.runnerWG)
+
 	s.runnerWG.Add(1)
 	mmm := monitor.NewMemoryMonitor(s.profile)
 	go mmm.Run(ctx, &s.runnerWG)
@@ -59,5 +59,6 @@ func embedFrontend(e *echo.Echo) {
 // defaultAPIRequestSkipper is echo skipper for api requests.
 func defaultAPIRequestSkipper(c echo.Context) bool {
 	path := c.Path()
-	return common.HasPrefixes(path, "/api", "/v1", webhookAPIPrefix)
+	// The SAML callback page is served by the frontend.
+	return common.HasPrefixes(path, "/api", "/v1", webhookAPIPrefix, samlAPIPrefix+"/sso", samlAPIPrefix+"/metadata", samlAPIPrefix+"/acs")
 }
@@ -44,12 +44,16 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 
 	// Advisors.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/bigquery"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/clickhouse"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/doris"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mysql"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oceanbase"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/tidb"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/trino"
 
 	// Schema designer.
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/clickhouse"
@@ -58,6 +62,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/redshift"
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/tidb"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/trino"
 
@@ -66,6 +71,7 @@ import (
 
 	// IM webhooks.
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/dingtalk"
+	_ "github.com/bytebase/bytebase/backend/plugin/webhook/eventsink"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/slack"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/wecom"
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// AccessTokenMessage is the message for a personal access token.
+type AccessTokenMessage struct {
+	PrincipalID int
+	// TokenHash is the SHA-256 hex digest of the token. The token itself is never stored.
+	TokenHash string
+	// ExpiresAt is nil if the token never expires.
+	ExpiresAt *time.Time
+	Payload   *storepb.AccessTokenPayload
+
+	// Output only fields.
+	UID       int64
+	CreatedAt time.Time
+	Revoked   bool
+}
+
+// FindAccessTokenMessage is the message for finding access tokens.
+type FindAccessTokenMessage struct {
+	UID         *int64
+	PrincipalID *int
+	TokenHash   *string
+	ShowRevoked bool
+}
+
+// CreateAccessToken creates a personal access token.
+func (s *Store) CreateAccessToken(ctx context.Context, create *AccessTokenMessage) (*AccessTokenMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+	row := s.db.QueryRowContext(ctx, `
+		INSERT INTO access_token (
+			principal_id,
+			expires_at,
+			token_hash,
+			payload
+		) VALUES ($1, $2, $3, $4)
+		RETURNING
+			id,
+			created_at,
+			principal_id,
+			expires_at,
+			revoked,
+			token_hash,
+			payload
+	`, create.PrincipalID, create.ExpiresAt, create.TokenHash, payload)
+	accessToken, err := scanAccessToken(row)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create access token")
+	}
+	return accessToken, nil
+}
+
+// GetAccessToken gets a personal access token.
+func (s *Store) GetAccessToken(ctx context.Context, find *FindAccessTokenMessage) (*AccessTokenMessage, error) {
+	accessTokens, err := s.ListAccessTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(accessTokens) == 0 {
+		return nil, nil
+	}
+	if len(accessTokens) > 1 {
+		return nil, errors.Errorf("expected 1 access token, got %d", len(accessTokens))
+	}
+	return accessTokens[0], nil
+}
+
+// ListAccessTokens lists personal access tokens, newest first.
+func (s *Store) ListAccessTokens(ctx context.Context, find *FindAccessTokenMessage) ([]*AccessTokenMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.TokenHash; v != nil {
+		where, args = append(where, fmt.Sprintf("token_hash = $%d", len(args)+1)), append(args, *v)
+	}
+	if !find.ShowRevoked {
+		where = append(where, "revoked = FALSE")
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			created_at,
+			principal_id,
+			expires_at,
+			revoked,
+			token_hash,
+			payload
+		FROM access_token
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accessTokens []*AccessTokenMessage
+	for rows.Next() {
+		accessToken, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		accessTokens = append(accessTokens, accessToken)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return accessTokens, nil
+}
+
+// RevokeAccessToken revokes a personal access token.
+func (s *Store) RevokeAccessToken(ctx context.Context, uid int64) (*AccessTokenMessage, error) {
+	row := s.db.QueryRowContext(ctx, `
+		UPDATE access_token
+		SET revoked = TRUE
+		WHERE id = $1
+		RETURNING
+			id,
+			created_at,
+			principal_id,
+			expires_at,
+			revoked,
+			token_hash,
+			payload
+	`, uid)
+	accessToken, err := scanAccessToken(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("access token %d not found", uid)}
+		}
+		return nil, err
+	}
+	return accessToken, nil
+}
+
+type accessTokenScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccessToken(scanner accessTokenScanner) (*AccessTokenMessage, error) {
+	accessToken := AccessTokenMessage{
+		Payload: &storepb.AccessTokenPayload{},
+	}
+	var expiresAt sql.NullTime
+	var payload []byte
+	if err := scanner.Scan(
+		&accessToken.UID,
+		&accessToken.CreatedAt,
+		&accessToken.PrincipalID,
+		&expiresAt,
+		&accessToken.Revoked,
+		&accessToken.TokenHash,
+		&payload,
+	); err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid {
+		accessToken.ExpiresAt = &expiresAt.Time
+	}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, accessToken.Payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	return &accessToken, nil
+}
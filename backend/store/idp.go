@@ -32,6 +32,9 @@ func getConfigBytes(config *storepb.IdentityProviderConfig) ([]byte, error) {
 	} else if v := config.GetLdapConfig(); v != nil {
 		configBytes, err := protojson.Marshal(v)
 		return configBytes, err
+	} else if v := config.GetSamlConfig(); v != nil {
+		configBytes, err := protojson.Marshal(v)
+		return configBytes, err
 	}
 	return nil, errors.Errorf("unexpected provider type")
 }
@@ -298,6 +301,8 @@ func convertIdentityProviderType(identityProviderType string) storepb.IdentityPr
 		return storepb.IdentityProviderType_OIDC
 	case "LDAP":
 		return storepb.IdentityProviderType_LDAP
+	case "SAML":
+		return storepb.IdentityProviderType_SAML
 	default:
 		return storepb.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED
 	}
@@ -330,6 +335,14 @@ func convertIdentityProviderConfigString(identityProviderType storepb.IdentityPr
 		identityProviderConfig.Config = &storepb.IdentityProviderConfig_LdapConfig{
 			LdapConfig: &formattedConfig,
 		}
+	case storepb.IdentityProviderType_SAML:
+		var formattedConfig storepb.SAMLIdentityProviderConfig
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(config), &formattedConfig); err != nil {
+			return nil
+		}
+		identityProviderConfig.Config = &storepb.IdentityProviderConfig_SamlConfig{
+			SamlConfig: &formattedConfig,
+		}
 	}
 	return identityProviderConfig
 }
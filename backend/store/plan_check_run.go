@@ -29,6 +29,10 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabaseStatementLockImpact is the plan check type for the lock impact analysis of DDL statements.
+	PlanCheckDatabaseStatementLockImpact PlanCheckRunType = "bb.plan-check.database.statement.lock-impact"
+	// PlanCheckDatabaseStatementOnlineDDL is the plan check type for the MySQL online DDL algorithm prediction.
+	PlanCheckDatabaseStatementOnlineDDL PlanCheckRunType = "bb.plan-check.database.statement.online-ddl"
 )
 
 // PlanCheckRunStatus is the status of a plan check run.
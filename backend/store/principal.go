@@ -447,3 +447,37 @@ func (s *Store) UpdateUser(ctx context.Context, currentUser *UserMessage, patch
 	s.userEmailCache.Add(user.Email, user)
 	return user, nil
 }
+
+// UpdateUserMFAConfigIfUnchanged updates the MFA config of the user only if the stored MFA config still equals the one of currentUser.
+// The WHERE condition ensures atomic check-and-update, so the concurrent ceremonies cannot overwrite or reuse each other's challenge.
+// It returns false if the MFA config has been changed since currentUser was read.
+func (s *Store) UpdateUserMFAConfigIfUnchanged(ctx context.Context, currentUser *UserMessage, mfaConfig *storepb.MFAConfig) (bool, error) {
+	if currentUser.ID == common.SystemBotID {
+		return false, errors.Errorf("cannot update system bot")
+	}
+	oldMFAConfigBytes, err := protojson.Marshal(currentUser.MFAConfig)
+	if err != nil {
+		return false, err
+	}
+	mfaConfigBytes, err := protojson.Marshal(mfaConfig)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE principal
+		SET mfa_config = $1
+		WHERE id = $2 AND mfa_config = $3::jsonb
+	`, mfaConfigBytes, currentUser.ID, oldMFAConfigBytes)
+	// The cached user may be stale whether the update succeeds or not.
+	s.userEmailCache.Remove(currentUser.Email)
+	s.userIDCache.Remove(currentUser.ID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bytebase/bytebase/backend/common"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -68,7 +69,11 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, projectID string, cr
 	`
 	payload := []byte("{}")
 	if create.Payload != nil {
-		p, err := protojson.Marshal(create.Payload)
+		redacted, err := s.obfuscateProjectWebhookPayload(ctx, create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		p, err := protojson.Marshal(redacted)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to marshal")
 		}
@@ -173,7 +178,11 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, projectResourceID st
 		set, args = append(set, fmt.Sprintf("event_list = $%d", len(args)+1)), append(args, v)
 	}
 	if v := update.Payload; v != nil {
-		p, err := protojson.Marshal(v)
+		redacted, err := s.obfuscateProjectWebhookPayload(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		p, err := protojson.Marshal(redacted)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to marshal payload")
 		}
@@ -215,6 +224,9 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, projectResourceID st
 	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, projectWebhook.Payload); err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal")
 	}
+	if err := s.unobfuscateProjectWebhookPayload(ctx, projectWebhook.Payload); err != nil {
+		return nil, err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrapf(err, "failed to commit transaction")
@@ -243,7 +255,7 @@ func (s *Store) DeleteProjectWebhookV2(ctx context.Context, projectResourceID st
 	return nil
 }
 
-func (*Store) findProjectWebhookImplV2(ctx context.Context, txn *sql.Tx, find *FindProjectWebhookMessage) ([]*ProjectWebhookMessage, error) {
+func (s *Store) findProjectWebhookImplV2(ctx context.Context, txn *sql.Tx, find *FindProjectWebhookMessage) ([]*ProjectWebhookMessage, error) {
 	// Build WHERE clause.
 	where, args := []string{"TRUE"}, []any{}
 	if v := find.ID; v != nil {
@@ -298,6 +310,9 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, txn *sql.Tx, find *F
 		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, projectWebhook.Payload); err != nil {
 			return nil, errors.Wrapf(err, "failed to unmarshal")
 		}
+		if err := s.unobfuscateProjectWebhookPayload(ctx, projectWebhook.Payload); err != nil {
+			return nil, err
+		}
 
 		if v := find.EventType; v != nil {
 			for _, activity := range projectWebhook.Events {
@@ -316,3 +331,42 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, txn *sql.Tx, find *F
 
 	return projectWebhooks, nil
 }
+
+// obfuscateProjectWebhookPayload returns a copy of the payload with the signing secrets obfuscated,
+// so that they're not persisted in plain text.
+func (s *Store) obfuscateProjectWebhookPayload(ctx context.Context, payload *storepb.ProjectWebhookPayload) (*storepb.ProjectWebhookPayload, error) {
+	secret, err := s.GetSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted, ok := proto.Clone(payload).(*storepb.ProjectWebhookPayload)
+	if !ok {
+		return nil, errors.Errorf("failed to clone project webhook payload")
+	}
+	redacted.ObfuscatedSigningSecret = common.Obfuscate(redacted.GetSigningSecret(), secret)
+	redacted.SigningSecret = ""
+	redacted.ObfuscatedPreviousSigningSecret = common.Obfuscate(redacted.GetPreviousSigningSecret(), secret)
+	redacted.PreviousSigningSecret = ""
+	return redacted, nil
+}
+
+func (s *Store) unobfuscateProjectWebhookPayload(ctx context.Context, payload *storepb.ProjectWebhookPayload) error {
+	secret, err := s.GetSecret(ctx)
+	if err != nil {
+		return err
+	}
+
+	signingSecret, err := common.Unobfuscate(payload.GetObfuscatedSigningSecret(), secret)
+	if err != nil {
+		return err
+	}
+	payload.SigningSecret = signingSecret
+
+	previousSigningSecret, err := common.Unobfuscate(payload.GetObfuscatedPreviousSigningSecret(), secret)
+	if err != nil {
+		return err
+	}
+	payload.PreviousSigningSecret = previousSigningSecret
+	return nil
+}
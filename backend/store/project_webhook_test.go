@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestObfuscateProjectWebhookPayload(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s := &Store{Secret: "auth-secret"}
+
+	payload := &storepb.ProjectWebhookPayload{
+		DirectMessage:                   true,
+		SigningSecret:                   "whsec_new",
+		PreviousSigningSecret:           "whsec_old",
+		PreviousSigningSecretExpireTime: timestamppb.Now(),
+	}
+	redacted, err := s.obfuscateProjectWebhookPayload(ctx, payload)
+	a.NoError(err)
+	// The payload itself is left untouched.
+	a.Equal("whsec_new", payload.SigningSecret)
+	a.Empty(payload.ObfuscatedSigningSecret)
+
+	// The persisted payload has no secret in plain text.
+	persisted, err := protojson.Marshal(redacted)
+	a.NoError(err)
+	a.NotContains(string(persisted), "whsec_")
+	a.Empty(redacted.SigningSecret)
+	a.Empty(redacted.PreviousSigningSecret)
+	a.True(redacted.DirectMessage)
+
+	loaded := &storepb.ProjectWebhookPayload{}
+	a.NoError(common.ProtojsonUnmarshaler.Unmarshal(persisted, loaded))
+	a.NoError(s.unobfuscateProjectWebhookPayload(ctx, loaded))
+	a.Equal("whsec_new", loaded.SigningSecret)
+	a.Equal("whsec_old", loaded.PreviousSigningSecret)
+	a.True(loaded.DirectMessage)
+
+	// A webhook without signing secrets stays unsigned.
+	redacted, err = s.obfuscateProjectWebhookPayload(ctx, &storepb.ProjectWebhookPayload{})
+	a.NoError(err)
+	a.NoError(s.unobfuscateProjectWebhookPayload(ctx, redacted))
+	a.Empty(redacted.SigningSecret)
+	a.Empty(redacted.PreviousSigningSecret)
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ConsumeSAMLAssertion marks the SAML assertion as consumed until it expires,
+// and returns false if it has been consumed and not expired yet.
+func (s *Store) ConsumeSAMLAssertion(ctx context.Context, issuer, assertionID string, expiresAt time.Time) (bool, error) {
+	// Purge the expired assertions so that the table doesn't grow over time.
+	if _, err := s.db.ExecContext(ctx, `
+		DELETE FROM saml_assertion
+		WHERE expires_at < now()
+	`); err != nil {
+		return false, errors.Wrapf(err, "failed to delete expired saml assertions")
+	}
+
+	// No row is affected if the assertion has been consumed and not expired yet.
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO saml_assertion (
+			issuer,
+			assertion_id,
+			expires_at
+		) VALUES ($1, $2, $3)
+		ON CONFLICT (issuer, assertion_id) DO UPDATE SET
+			expires_at = EXCLUDED.expires_at
+		WHERE saml_assertion.expires_at <= now()
+	`, issuer, assertionID, expiresAt)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to consume saml assertion")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get affected rows")
+	}
+	return rows == 1, nil
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common/testcontainer"
+)
+
+const samlAssertionTestSchema = `
+CREATE TABLE saml_assertion (
+    issuer text NOT NULL,
+    assertion_id text NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (issuer, assertion_id)
+);
+`
+
+func TestConsumeSAMLAssertionWithTestcontainer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping PostgreSQL testcontainer test in short mode")
+	}
+	a := require.New(t)
+	ctx := context.Background()
+
+	pgContainer := testcontainer.GetTestPgContainer(ctx, t)
+	defer pgContainer.Close(ctx)
+	_, err := pgContainer.GetDB().ExecContext(ctx, samlAssertionTestSchema)
+	a.NoError(err)
+	// The stores share the database as the replicas do.
+	s1 := &Store{db: pgContainer.GetDB()}
+	s2 := &Store{db: pgContainer.GetDB()}
+
+	expiresAt := time.Now().Add(time.Hour)
+	consumed, err := s1.ConsumeSAMLAssertion(ctx, "idp", "a1", expiresAt)
+	a.NoError(err)
+	a.True(consumed)
+	// The assertion cannot be replayed on another replica.
+	consumed, err = s2.ConsumeSAMLAssertion(ctx, "idp", "a1", expiresAt)
+	a.NoError(err)
+	a.False(consumed)
+	// The same ID from another issuer is a different assertion.
+	consumed, err = s2.ConsumeSAMLAssertion(ctx, "other", "a1", expiresAt)
+	a.NoError(err)
+	a.True(consumed)
+
+	// The expired assertions are purged.
+	consumed, err = s1.ConsumeSAMLAssertion(ctx, "idp", "a2", time.Now().Add(-time.Minute))
+	a.NoError(err)
+	a.True(consumed)
+	consumed, err = s1.ConsumeSAMLAssertion(ctx, "idp", "a3", expiresAt)
+	a.NoError(err)
+	a.True(consumed)
+	var count int
+	a.NoError(pgContainer.GetDB().QueryRowContext(ctx, `SELECT count(*) FROM saml_assertion WHERE assertion_id = 'a2'`).Scan(&count))
+	a.Equal(0, count)
+}
@@ -0,0 +1,317 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending is the status for deliveries waiting to be sent or retried.
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliveryStatusDone is the status for deliveries sent successfully.
+	WebhookDeliveryStatusDone WebhookDeliveryStatus = "DONE"
+	// WebhookDeliveryStatusDead is the status for deliveries that have exhausted their retries.
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDeliveryMessage is the message for a webhook delivery in the outbox.
+type WebhookDeliveryMessage struct {
+	ProjectID string
+	WebhookID int
+	EventType common.EventType
+	Payload   *storepb.WebhookDeliveryPayload
+
+	// Output only fields.
+	UID           int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Status        WebhookDeliveryStatus
+	RetryCount    int
+	NextAttemptAt time.Time
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	UID       *int64
+	ProjectID *string
+	WebhookID *int
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery.
+type UpdateWebhookDeliveryMessage struct {
+	Status        *WebhookDeliveryStatus
+	RetryCount    *int
+	NextAttemptAt *time.Time
+	Payload       *storepb.WebhookDeliveryPayload
+}
+
+// CreateWebhookDeliveries creates pending webhook deliveries.
+func (s *Store) CreateWebhookDeliveries(ctx context.Context, creates ...*WebhookDeliveryMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	var values []any
+	if _, err := query.WriteString(`INSERT INTO webhook_delivery (
+		project,
+		webhook_id,
+		event_type,
+		status,
+		payload
+	) VALUES
+	`); err != nil {
+		return err
+	}
+	for i, create := range creates {
+		payload, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		values = append(values,
+			create.ProjectID,
+			create.WebhookID,
+			create.EventType,
+			WebhookDeliveryStatusPending,
+			payload,
+		)
+		if i != 0 {
+			if _, err := query.WriteString(","); err != nil {
+				return err
+			}
+		}
+		count := 5
+		if _, err := query.WriteString(getPlaceholders(i*count+1, count)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.db.ExecContext(ctx, query.String(), values...); err != nil {
+		return errors.Wrapf(err, "failed to create webhook deliveries")
+	}
+	return nil
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, errors.Errorf("expected 1 webhook delivery, got %d", len(deliveries))
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists webhook deliveries, newest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("project = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.WebhookID; v != nil {
+		where, args = append(where, fmt.Sprintf("webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			created_at,
+			updated_at,
+			project,
+			webhook_id,
+			event_type,
+			status,
+			retry_count,
+			next_attempt_at,
+			payload
+		FROM webhook_delivery
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// ClaimDueWebhookDeliveries claims at most limit pending deliveries whose next attempt is due.
+// The claimed deliveries are leased by pushing their next attempt time forward,
+// so that they are not picked up again while they are being sent.
+func (s *Store) ClaimDueWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDeliveryMessage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		UPDATE webhook_delivery
+		SET next_attempt_at = now() + make_interval(secs => $1), updated_at = now()
+		WHERE id IN (
+			SELECT id FROM webhook_delivery
+			WHERE status = $2 AND next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			project,
+			webhook_id,
+			event_type,
+			status,
+			retry_count,
+			next_attempt_at,
+			payload
+	`, lease.Seconds(), WebhookDeliveryStatusPending, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim webhook deliveries")
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates a webhook delivery.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, uid int64, update *UpdateWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	set, args := []string{"updated_at = now()"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.RetryCount; v != nil {
+		set, args = append(set, fmt.Sprintf("retry_count = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptAt; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_at = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	args = append(args, uid)
+
+	row := s.db.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE webhook_delivery
+		SET %s
+		WHERE id = $%d
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			project,
+			webhook_id,
+			event_type,
+			status,
+			retry_count,
+			next_attempt_at,
+			payload
+	`, strings.Join(set, ", "), len(args)), args...)
+	delivery, err := scanWebhookDelivery(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("webhook delivery %d not found", uid)}
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// DeleteExpiredWebhookDeliveries deletes done and dead deliveries last updated before the retention period.
+// Returns the number of deliveries deleted.
+func (s *Store) DeleteExpiredWebhookDeliveries(ctx context.Context, retentionPeriod time.Duration) (int64, error) {
+	cutoffTime := time.Now().Add(-retentionPeriod)
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM webhook_delivery
+		WHERE status IN ($1, $2) AND updated_at < $3
+	`, WebhookDeliveryStatusDone, WebhookDeliveryStatusDead, cutoffTime)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete expired webhook deliveries")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
+}
+
+type webhookDeliveryScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebhookDelivery(scanner webhookDeliveryScanner) (*WebhookDeliveryMessage, error) {
+	delivery := WebhookDeliveryMessage{
+		Payload: &storepb.WebhookDeliveryPayload{},
+	}
+	var payload []byte
+	if err := scanner.Scan(
+		&delivery.UID,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+		&delivery.ProjectID,
+		&delivery.WebhookID,
+		&delivery.EventType,
+		&delivery.Status,
+		&delivery.RetryCount,
+		&delivery.NextAttemptAt,
+		&payload,
+	); err != nil {
+		return nil, err
+	}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, delivery.Payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	return &delivery, nil
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/testcontainer"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// webhookDeliveryTestSchema is the webhook_delivery table without the foreign keys to the project and project_webhook tables.
+const webhookDeliveryTestSchema = `
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    project text NOT NULL,
+    webhook_id integer NOT NULL,
+    event_type text NOT NULL,
+    status text NOT NULL CHECK (status IN ('PENDING', 'DONE', 'DEAD')),
+    retry_count integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+`
+
+func TestWebhookDeliveryWithTestcontainer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping PostgreSQL testcontainer test in short mode")
+	}
+	a := require.New(t)
+	ctx := context.Background()
+
+	pgContainer := testcontainer.GetTestPgContainer(ctx, t)
+	defer pgContainer.Close(ctx)
+	_, err := pgContainer.GetDB().ExecContext(ctx, webhookDeliveryTestSchema)
+	a.NoError(err)
+	s := &Store{db: pgContainer.GetDB()}
+
+	newDelivery := func(webhookID int) *WebhookDeliveryMessage {
+		return &WebhookDeliveryMessage{
+			ProjectID: "p1",
+			WebhookID: webhookID,
+			EventType: common.EventType("bb.issue.created"),
+			Payload: &storepb.WebhookDeliveryPayload{
+				Event: &storepb.WebhookEvent{Title: "issue created"},
+			},
+		}
+	}
+	a.NoError(s.CreateWebhookDeliveries(ctx, newDelivery(1), newDelivery(2), newDelivery(3)))
+
+	// Claim leases the due pending deliveries.
+	claimed, err := s.ClaimDueWebhookDeliveries(ctx, 2, time.Minute)
+	a.NoError(err)
+	a.Len(claimed, 2)
+	for _, delivery := range claimed {
+		a.Equal(WebhookDeliveryStatusPending, delivery.Status)
+		a.True(delivery.NextAttemptAt.After(time.Now()))
+	}
+	// The leased deliveries are not claimed again.
+	claimedAgain, err := s.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+	a.NoError(err)
+	a.Len(claimedAgain, 1)
+	a.NotContains([]int64{claimed[0].UID, claimed[1].UID}, claimedAgain[0].UID)
+	claimedAgain, err = s.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+	a.NoError(err)
+	a.Empty(claimedAgain)
+
+	// A retried delivery is claimed once its next attempt is due.
+	retryCount := 1
+	nextAttemptAt := time.Now().Add(-time.Second)
+	retried, err := s.UpdateWebhookDelivery(ctx, claimed[0].UID, &UpdateWebhookDeliveryMessage{
+		RetryCount:    &retryCount,
+		NextAttemptAt: &nextAttemptAt,
+		Payload: &storepb.WebhookDeliveryPayload{
+			Event:    claimed[0].Payload.Event,
+			Attempts: []*storepb.WebhookDeliveryAttempt{{StatusCode: 503, Error: "unavailable"}},
+		},
+	})
+	a.NoError(err)
+	a.Equal(1, retried.RetryCount)
+	claimedAgain, err = s.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+	a.NoError(err)
+	a.Len(claimedAgain, 1)
+	a.Equal(claimed[0].UID, claimedAgain[0].UID)
+	a.Len(claimedAgain[0].Payload.Attempts, 1)
+
+	// Done and dead deliveries are never claimed.
+	done, dead := WebhookDeliveryStatusDone, WebhookDeliveryStatusDead
+	_, err = s.UpdateWebhookDelivery(ctx, claimed[0].UID, &UpdateWebhookDeliveryMessage{Status: &done, NextAttemptAt: &nextAttemptAt})
+	a.NoError(err)
+	_, err = s.UpdateWebhookDelivery(ctx, claimed[1].UID, &UpdateWebhookDeliveryMessage{Status: &dead, NextAttemptAt: &nextAttemptAt})
+	a.NoError(err)
+	claimedAgain, err = s.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+	a.NoError(err)
+	a.Empty(claimedAgain)
+
+	// Only the done and dead deliveries past the retention period are deleted.
+	count, err := s.DeleteExpiredWebhookDeliveries(ctx, time.Hour)
+	a.NoError(err)
+	a.Zero(count)
+	count, err = s.DeleteExpiredWebhookDeliveries(ctx, -time.Hour)
+	a.NoError(err)
+	a.Equal(int64(2), count)
+	remaining, err := s.ListWebhookDeliveries(ctx, &FindWebhookDeliveryMessage{})
+	a.NoError(err)
+	a.Len(remaining, 1)
+	a.Equal(WebhookDeliveryStatusPending, remaining[0].Status)
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+)
+
+func TestAccessToken(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	ctl := &controller{}
+	ctx, err := ctl.StartServerWithExternalPg(ctx)
+	a.NoError(err)
+	defer ctl.Close(ctx)
+
+	var projects []*v1pb.Project
+	for _, projectID := range []string{"scoped", "unscoped"} {
+		projectResp, err := ctl.projectServiceClient.CreateProject(ctx, connect.NewRequest(&v1pb.CreateProjectRequest{
+			Project: &v1pb.Project{
+				Name:  fmt.Sprintf("projects/%s", projectID),
+				Title: projectID,
+			},
+			ProjectId: projectID,
+		}))
+		a.NoError(err)
+		projects = append(projects, projectResp.Msg)
+	}
+
+	_, err = ctl.userServiceClient.CreateAccessToken(ctx, connect.NewRequest(&v1pb.CreateAccessTokenRequest{
+		Parent: ctl.principalName,
+		AccessToken: &v1pb.AccessToken{
+			Title:      "expired",
+			ExpireTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+	}))
+	a.ErrorContains(err, "expire_time must be in the future")
+
+	tokenResp, err := ctl.userServiceClient.CreateAccessToken(ctx, connect.NewRequest(&v1pb.CreateAccessTokenRequest{
+		Parent: ctl.principalName,
+		AccessToken: &v1pb.AccessToken{
+			Title:       "CI",
+			Projects:    []string{projects[0].Name},
+			Permissions: []string{"bb.projects.get"},
+			ExpireTime:  timestamppb.New(time.Now().Add(time.Hour)),
+		},
+	}))
+	a.NoError(err)
+	accessToken := tokenResp.Msg
+	a.NotEmpty(accessToken.Token)
+	a.Equal([]string{projects[0].Name}, accessToken.Projects)
+
+	listResp, err := ctl.userServiceClient.ListAccessTokens(ctx, connect.NewRequest(&v1pb.ListAccessTokensRequest{
+		Parent: ctl.principalName,
+	}))
+	a.NoError(err)
+	a.Len(listResp.Msg.AccessTokens, 1)
+	a.Equal(accessToken.Name, listResp.Msg.AccessTokens[0].Name)
+	// The token is only returned once.
+	a.Empty(listResp.Msg.AccessTokens[0].Token)
+
+	interceptors := connect.WithInterceptors(&authInterceptor{token: accessToken.Token})
+	projectServiceClient := v1connect.NewProjectServiceClient(ctl.client, ctl.rootURL, interceptors)
+	userServiceClient := v1connect.NewUserServiceClient(ctl.client, ctl.rootURL, interceptors)
+
+	// The token can only get the scoped project.
+	_, err = projectServiceClient.GetProject(ctx, connect.NewRequest(&v1pb.GetProjectRequest{Name: projects[0].Name}))
+	a.NoError(err)
+	_, err = projectServiceClient.GetProject(ctx, connect.NewRequest(&v1pb.GetProjectRequest{Name: projects[1].Name}))
+	a.Equal(connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = projectServiceClient.UpdateProject(ctx, connect.NewRequest(&v1pb.UpdateProjectRequest{
+		Project:    &v1pb.Project{Name: projects[0].Name, Title: "updated"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	}))
+	a.Equal(connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// The token cannot create another token.
+	_, err = userServiceClient.CreateAccessToken(ctx, connect.NewRequest(&v1pb.CreateAccessTokenRequest{
+		Parent:      ctl.principalName,
+		AccessToken: &v1pb.AccessToken{Title: "escalated"},
+	}))
+	a.Equal(connect.CodePermissionDenied, connect.CodeOf(err))
+
+	revokeResp, err := ctl.userServiceClient.RevokeAccessToken(ctx, connect.NewRequest(&v1pb.RevokeAccessTokenRequest{
+		Name: accessToken.Name,
+	}))
+	a.NoError(err)
+	a.True(revokeResp.Msg.Revoked)
+	_, err = projectServiceClient.GetProject(ctx, connect.NewRequest(&v1pb.GetProjectRequest{Name: projects[0].Name}))
+	a.Equal(connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	listResp, err = ctl.userServiceClient.ListAccessTokens(ctx, connect.NewRequest(&v1pb.ListAccessTokensRequest{
+		Parent: ctl.principalName,
+	}))
+	a.NoError(err)
+	a.Empty(listResp.Msg.AccessTokens)
+}
+
+func TestAccessTokenQueryScope(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	ctl := &controller{}
+	ctx, err := ctl.StartServerWithExternalPg(ctx)
+	a.NoError(err)
+	defer ctl.Close(ctx)
+
+	instanceDir, err := ctl.provisionSQLiteInstance(t.TempDir(), "testInstance1")
+	a.NoError(err)
+	instanceResp, err := ctl.instanceServiceClient.CreateInstance(ctx, connect.NewRequest(&v1pb.CreateInstanceRequest{
+		InstanceId: generateRandomString("instance"),
+		Instance: &v1pb.Instance{
+			Title:       "testInstance1",
+			Engine:      v1pb.Engine_SQLITE,
+			Environment: "environments/prod",
+			Activation:  true,
+			DataSources: []*v1pb.DataSource{{Type: v1pb.DataSourceType_ADMIN, Host: instanceDir, Id: "admin"}},
+		},
+	}))
+	a.NoError(err)
+	instance := instanceResp.Msg
+	databaseName := "testAccessTokenQuery"
+	err = ctl.createDatabaseV2(ctx, ctl.project, instance, nil /* environment */, databaseName, "")
+	a.NoError(err)
+	queryRequest := &v1pb.QueryRequest{
+		Name:         fmt.Sprintf("%s/databases/%s", instance.Name, databaseName),
+		Statement:    "SELECT 1;",
+		DataSourceId: "admin",
+	}
+
+	newSQLServiceClient := func(permissions []string) v1connect.SQLServiceClient {
+		tokenResp, err := ctl.userServiceClient.CreateAccessToken(ctx, connect.NewRequest(&v1pb.CreateAccessTokenRequest{
+			Parent: ctl.principalName,
+			AccessToken: &v1pb.AccessToken{
+				Title:       "query",
+				Permissions: permissions,
+				ExpireTime:  timestamppb.New(time.Now().Add(time.Hour)),
+			},
+		}))
+		a.NoError(err)
+		return v1connect.NewSQLServiceClient(ctl.client, ctl.rootURL, connect.WithInterceptors(&authInterceptor{token: tokenResp.Msg.Token}))
+	}
+
+	// The token without bb.sql.select cannot query even though the user can.
+	_, err = newSQLServiceClient([]string{"bb.databases.get"}).Query(ctx, connect.NewRequest(queryRequest))
+	a.Equal(connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = newSQLServiceClient([]string{"bb.databases.get", "bb.sql.select"}).Query(ctx, connect.NewRequest(queryRequest))
+	a.NoError(err)
+}
@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// scimClient is a minimal SCIM 2.0 client acting like an identity provider.
+type scimClient struct {
+	baseURL string
+	token   string
+}
+
+func (c *scimClient) do(ctx context.Context, method, path string, body any) (int, map[string]any, error) {
+	var reader io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return 0, nil, err
+		}
+		reader = bytes.NewReader(bs)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/scim+json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	var result map[string]any
+	if len(bs) > 0 {
+		if err := json.Unmarshal(bs, &result); err != nil {
+			return 0, nil, err
+		}
+	}
+	return resp.StatusCode, result, nil
+}
+
+func newSCIMClient(ctx context.Context, ctl *controller) (*scimClient, error) {
+	settingResp, err := ctl.settingServiceClient.UpdateSetting(ctx, connect.NewRequest(&v1pb.UpdateSettingRequest{
+		AllowMissing: true,
+		Setting: &v1pb.Setting{
+			Name: "settings/" + v1pb.Setting_SCIM.String(),
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ScimSetting{
+					ScimSetting: &v1pb.SCIMSetting{},
+				},
+			},
+		},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	infoResp, err := ctl.actuatorServiceClient.GetActuatorInfo(ctx, connect.NewRequest(&v1pb.GetActuatorInfoRequest{}))
+	if err != nil {
+		return nil, err
+	}
+	return &scimClient{
+		baseURL: fmt.Sprintf("%s/hook/scim/workspaces/%s", ctl.rootURL, infoResp.Msg.WorkspaceId),
+		token:   settingResp.Msg.Value.GetScimSetting().Token,
+	}, nil
+}
+
+func TestSCIM(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	ctl := &controller{}
+	ctx, err := ctl.StartServerWithExternalPg(ctx)
+	a.NoError(err)
+	defer ctl.Close(ctx)
+
+	client, err := newSCIMClient(ctx, ctl)
+	a.NoError(err)
+
+	// Authentication.
+	status, _, err := (&scimClient{baseURL: client.baseURL, token: "invalid"}).do(ctx, http.MethodGet, "/Users", nil)
+	a.NoError(err)
+	a.Equal(http.StatusUnauthorized, status)
+
+	// Discovery endpoints.
+	status, config, err := client.do(ctx, http.MethodGet, "/ServiceProviderConfig", nil)
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.Equal(true, config["patch"].(map[string]any)["supported"])
+	a.Equal(true, config["filter"].(map[string]any)["supported"])
+	status, schemas, err := client.do(ctx, http.MethodGet, "/Schemas", nil)
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.EqualValues(2, schemas["totalResults"])
+	status, resourceType, err := client.do(ctx, http.MethodGet, "/ResourceTypes/User", nil)
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.Equal("/Users", resourceType["endpoint"])
+
+	// Users.
+	userIDs := map[string]string{}
+	for _, name := range []string{"alice", "bob", "carol"} {
+		status, user, err := client.do(ctx, http.MethodPost, "/Users", map[string]any{
+			"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+			"userName":    name + "@example.com",
+			"displayName": name,
+			"active":      true,
+			"emails":      []map[string]any{{"value": name + "@example.com", "type": "work", "primary": true}},
+		})
+		a.NoError(err)
+		a.Equal(http.StatusCreated, status)
+		a.Equal(name+"@example.com", user["userName"])
+		userIDs[name] = user["id"].(string)
+	}
+
+	status, list, err := client.do(ctx, http.MethodGet, "/Users?filter="+url.QueryEscape(`userName eq "bob@example.com"`), nil)
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.EqualValues(1, list["totalResults"])
+
+	status, list, err = client.do(ctx, http.MethodGet, "/Users?filter="+url.QueryEscape(`userName sw "a" or displayName co "aro"`)+"&startIndex=2&count=1", nil)
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.EqualValues(2, list["totalResults"])
+	a.EqualValues(2, list["startIndex"])
+	a.EqualValues(1, list["itemsPerPage"])
+	a.Len(list["Resources"], 1)
+
+	status, scimErr, err := client.do(ctx, http.MethodGet, "/Users?filter="+url.QueryEscape(`userName eq`), nil)
+	a.NoError(err)
+	a.Equal(http.StatusBadRequest, status)
+	a.Equal("invalidFilter", scimErr["scimType"])
+
+	status, _, err = client.do(ctx, http.MethodPost, "/Users", map[string]any{
+		"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		"userName": "carol@example.com",
+	})
+	a.NoError(err)
+	a.Equal(http.StatusCreated, status)
+
+	status, user, err := client.do(ctx, http.MethodPatch, "/Users/"+userIDs["alice"], map[string]any{
+		"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []map[string]any{
+			{"op": "Replace", "path": "displayName", "value": "Alice Smith"},
+			{"op": "Replace", "path": "phoneNumbers[type eq \"work\"].value", "value": "+1 555 0100"},
+		},
+	})
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.Equal("Alice Smith", user["displayName"])
+
+	// Groups.
+	status, group, err := client.do(ctx, http.MethodPost, "/Groups", map[string]any{
+		"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+		"displayName": "dev@example.com",
+		"members":     []map[string]any{{"value": userIDs["alice"]}},
+	})
+	a.NoError(err)
+	a.Equal(http.StatusCreated, status)
+	groupID := group["id"].(string)
+	a.Len(group["members"], 1)
+
+	status, group, err = client.do(ctx, http.MethodPatch, "/Groups/"+url.PathEscape(groupID), map[string]any{
+		"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []map[string]any{
+			{"op": "add", "path": "members", "value": []map[string]any{{"value": userIDs["bob"]}, {"value": userIDs["carol"]}}},
+			{"op": "remove", "path": fmt.Sprintf("members[value eq %q]", userIDs["alice"])},
+		},
+	})
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.Len(group["members"], 2)
+
+	status, list, err = client.do(ctx, http.MethodGet, "/Groups?attributes=displayName", nil)
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.EqualValues(1, list["totalResults"])
+	projected := list["Resources"].([]any)[0].(map[string]any)
+	a.NotContains(projected, "members")
+	a.Equal(groupID, projected["id"])
+
+	status, _, err = client.do(ctx, http.MethodDelete, "/Groups/"+url.PathEscape(groupID), nil)
+	a.NoError(err)
+	a.Equal(http.StatusNoContent, status)
+	status, _, err = client.do(ctx, http.MethodGet, "/Groups/"+url.PathEscape(groupID), nil)
+	a.NoError(err)
+	a.Equal(http.StatusNotFound, status)
+
+	// Deprovisioning.
+	status, user, err = client.do(ctx, http.MethodPatch, "/Users/"+userIDs["bob"], map[string]any{
+		"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []map[string]any{{"op": "Replace", "value": map[string]any{"active": "False"}}},
+	})
+	a.NoError(err)
+	a.Equal(http.StatusOK, status)
+	a.Equal(false, user["active"])
+
+	status, _, err = client.do(ctx, http.MethodDelete, "/Users/"+userIDs["carol"], nil)
+	a.NoError(err)
+	a.Equal(http.StatusNoContent, status)
+	status, _, err = client.do(ctx, http.MethodGet, "/Users/"+userIDs["carol"], nil)
+	a.NoError(err)
+	a.Equal(http.StatusNotFound, status)
+}
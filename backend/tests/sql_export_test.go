@@ -207,9 +207,13 @@ func TestSQLExport(t *testing.T) {
 			Password:     tt.password,
 			DataSourceId: dataSource.Id,
 		}
-		exportResp, err := ctl.sqlServiceClient.Export(ctx, connect.NewRequest(request))
+		exportStream, err := ctl.sqlServiceClient.Export(ctx, connect.NewRequest(request))
 		a.NoError(err)
-		export := exportResp.Msg
+		var exportContent []byte
+		for exportStream.Receive() {
+			exportContent = append(exportContent, exportStream.Msg().Content...)
+		}
+		a.NoError(exportStream.Err())
 
 		statement = tt.reset
 		results, err = ctl.adminQuery(ctx, database, statement)
@@ -217,8 +221,8 @@ func TestSQLExport(t *testing.T) {
 		checkResults(a, tt.databaseName, statement, tt.resetResult, results)
 
 		if tt.password != "" {
-			reader := bytes.NewReader(export.Content)
-			zipReader, err := zip.NewReader(reader, int64(len(export.Content)))
+			reader := bytes.NewReader(exportContent)
+			zipReader, err := zip.NewReader(reader, int64(len(exportContent)))
 			a.NoError(err)
 			a.Equal(1, len(zipReader.File))
 
@@ -231,7 +235,7 @@ func TestSQLExport(t *testing.T) {
 			a.NoError(err)
 			statement = string(content)
 		} else {
-			statement = string(export.Content)
+			statement = string(exportContent)
 		}
 
 		results, err = ctl.adminQuery(ctx, database, statement)
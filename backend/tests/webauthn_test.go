@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/webauthn/webauthntest"
+)
+
+func TestWebAuthn(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	ctl := &controller{}
+	ctx, err := ctl.StartServerWithExternalPg(ctx)
+	a.NoError(err)
+	defer ctl.Close(ctx)
+
+	authenticator, err := webauthntest.NewAuthenticator(ctl.rootURL)
+	a.NoError(err)
+
+	// Register a credential.
+	optionsResp, err := ctl.userServiceClient.GenerateWebAuthnCreationOptions(ctx, connect.NewRequest(&v1pb.GenerateWebAuthnCreationOptionsRequest{
+		Parent: ctl.principalName,
+	}))
+	a.NoError(err)
+	registration, err := authenticator.Create([]byte(optionsResp.Msg.CreationOptions))
+	a.NoError(err)
+	credentialResp, err := ctl.userServiceClient.CreateWebAuthnCredential(ctx, connect.NewRequest(&v1pb.CreateWebAuthnCredentialRequest{
+		Parent:               ctl.principalName,
+		WebauthnCredential:   &v1pb.WebAuthnCredential{Title: "YubiKey"},
+		RegistrationResponse: string(registration),
+	}))
+	a.NoError(err)
+	credential := credentialResp.Msg
+	a.Equal("YubiKey", credential.Title)
+	a.Equal([]string{"usb"}, credential.Transports)
+
+	// The challenge cannot be reused.
+	_, err = ctl.userServiceClient.CreateWebAuthnCredential(ctx, connect.NewRequest(&v1pb.CreateWebAuthnCredentialRequest{
+		Parent:               ctl.principalName,
+		WebauthnCredential:   &v1pb.WebAuthnCredential{Title: "YubiKey"},
+		RegistrationResponse: string(registration),
+	}))
+	a.ErrorContains(err, "challenge")
+
+	updatedResp, err := ctl.userServiceClient.UpdateWebAuthnCredential(ctx, connect.NewRequest(&v1pb.UpdateWebAuthnCredentialRequest{
+		WebauthnCredential: &v1pb.WebAuthnCredential{Name: credential.Name, Title: "Backup key"},
+		UpdateMask:         &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	}))
+	a.NoError(err)
+	a.Equal("Backup key", updatedResp.Msg.Title)
+
+	// Login with the credential as the second factor.
+	loginResp, err := ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		Email:    "demo@example.com",
+		Password: "1024bytebase",
+	}))
+	a.NoError(err)
+	a.Empty(loginResp.Msg.Token)
+	a.NotNil(loginResp.Msg.MfaTempToken)
+	a.NotNil(loginResp.Msg.WebauthnRequestOptions)
+	assertion, err := authenticator.Get([]byte(loginResp.Msg.GetWebauthnRequestOptions()))
+	a.NoError(err)
+	loginResp, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		MfaTempToken:       loginResp.Msg.MfaTempToken,
+		WebauthnCredential: stringPointer(string(assertion)),
+	}))
+	a.NoError(err)
+	a.NotEmpty(loginResp.Msg.Token)
+
+	listResp, err := ctl.userServiceClient.ListWebAuthnCredentials(ctx, connect.NewRequest(&v1pb.ListWebAuthnCredentialsRequest{
+		Parent: ctl.principalName,
+	}))
+	a.NoError(err)
+	a.Len(listResp.Msg.WebauthnCredentials, 1)
+	a.NotNil(listResp.Msg.WebauthnCredentials[0].LastUseTime)
+
+	// The OTP code is not accepted if the user has not enabled TOTP.
+	loginResp, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		Email:    "demo@example.com",
+		Password: "1024bytebase",
+	}))
+	a.NoError(err)
+	code, err := totp.GenerateCode("", time.Now())
+	a.NoError(err)
+	_, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		MfaTempToken: loginResp.Msg.MfaTempToken,
+		OtpCode:      &code,
+	}))
+	a.ErrorContains(err, "WebAuthn is required")
+
+	// Require WebAuthn for the database admins.
+	err = setWebAuthnRequiredPermissions(ctx, ctl, []string{"bb.databases.update"})
+	a.NoError(err)
+
+	// The recovery codes are not accepted.
+	loginResp, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		Email:    "demo@example.com",
+		Password: "1024bytebase",
+	}))
+	a.NoError(err)
+	_, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		MfaTempToken: loginResp.Msg.MfaTempToken,
+		RecoveryCode: stringPointer("invalid"),
+	}))
+	a.ErrorContains(err, "WebAuthn is required")
+
+	// The last credential cannot be deleted while WebAuthn is required.
+	_, err = ctl.userServiceClient.DeleteWebAuthnCredential(ctx, connect.NewRequest(&v1pb.DeleteWebAuthnCredentialRequest{
+		Name: credential.Name,
+	}))
+	a.Equal(connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// The user registers a new credential at login after the lost one is deleted.
+	err = setWebAuthnRequiredPermissions(ctx, ctl, nil)
+	a.NoError(err)
+	_, err = ctl.userServiceClient.DeleteWebAuthnCredential(ctx, connect.NewRequest(&v1pb.DeleteWebAuthnCredentialRequest{
+		Name: credential.Name,
+	}))
+	a.NoError(err)
+	err = setWebAuthnRequiredPermissions(ctx, ctl, []string{"bb.databases.update"})
+	a.NoError(err)
+	loginResp, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		Email:    "demo@example.com",
+		Password: "1024bytebase",
+	}))
+	a.NoError(err)
+	a.NotNil(loginResp.Msg.WebauthnCreationOptions)
+	newAuthenticator, err := webauthntest.NewAuthenticator(ctl.rootURL)
+	a.NoError(err)
+	registration, err = newAuthenticator.Create([]byte(loginResp.Msg.GetWebauthnCreationOptions()))
+	a.NoError(err)
+	loginResp, err = ctl.authServiceClient.Login(ctx, connect.NewRequest(&v1pb.LoginRequest{
+		MfaTempToken:       loginResp.Msg.MfaTempToken,
+		WebauthnCredential: stringPointer(string(registration)),
+	}))
+	a.NoError(err)
+	a.NotEmpty(loginResp.Msg.Token)
+
+	listResp, err = ctl.userServiceClient.ListWebAuthnCredentials(ctx, connect.NewRequest(&v1pb.ListWebAuthnCredentialsRequest{
+		Parent: ctl.principalName,
+	}))
+	a.NoError(err)
+	a.Len(listResp.Msg.WebauthnCredentials, 1)
+	a.NotEqual(credential.Name, listResp.Msg.WebauthnCredentials[0].Name)
+}
+
+func setWebAuthnRequiredPermissions(ctx context.Context, ctl *controller, permissions []string) error {
+	_, err := ctl.settingServiceClient.UpdateSetting(ctx, connect.NewRequest(&v1pb.UpdateSettingRequest{
+		Setting: &v1pb.Setting{
+			Name: "settings/" + v1pb.Setting_WORKSPACE_PROFILE.String(),
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_WorkspaceProfileSettingValue{
+					WorkspaceProfileSettingValue: &v1pb.WorkspaceProfileSetting{
+						WebauthnRequiredPermissions: permissions,
+					},
+				},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"value.workspace_profile_setting_value.webauthn_required_permissions"}},
+	}))
+	return err
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
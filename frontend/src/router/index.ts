@@ -23,6 +23,7 @@ import authRoutes, {
   AUTH_OIDC_CALLBACK_MODULE,
   AUTH_PASSWORD_FORGOT_MODULE,
   AUTH_PASSWORD_RESET_MODULE,
+  AUTH_SAML_CALLBACK_MODULE,
   AUTH_SIGNIN_ADMIN_MODULE,
   AUTH_SIGNIN_MODULE,
   AUTH_SIGNUP_MODULE,
@@ -105,10 +106,11 @@ router.beforeEach((to, from, next) => {
 
   // SSO callback routes are relayes to handle the IdP callback and dispatch the subsequent events.
   // They are called in the following scenarios:
-  // - Login via OAuth / OIDC
+  // - Login via OAuth / OIDC / SAML
   if (
     to.name === AUTH_OAUTH_CALLBACK_MODULE ||
-    to.name === AUTH_OIDC_CALLBACK_MODULE
+    to.name === AUTH_OIDC_CALLBACK_MODULE ||
+    to.name === AUTH_SAML_CALLBACK_MODULE
   ) {
     next();
     return;
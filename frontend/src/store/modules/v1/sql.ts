@@ -74,13 +74,26 @@ export const useSQLStore = defineStore("sql", () => {
   };
 
   const exportData = async (params: ExportRequest) => {
-    const newResponse = await sqlServiceClientConnect.export(params, {
+    const stream = sqlServiceClientConnect.export(params, {
       // Won't jump to 403 page when permission denied.
       contextValues: createContextValues().set(ignoredCodesContextKey, [
         Code.PermissionDenied,
       ]),
     });
-    return newResponse.content;
+    // The export file is streamed in chunks.
+    const chunks: Uint8Array[] = [];
+    let size = 0;
+    for await (const response of stream) {
+      chunks.push(response.content);
+      size += response.content.length;
+    }
+    const content = new Uint8Array(size);
+    let offset = 0;
+    for (const chunk of chunks) {
+      content.set(chunk, offset);
+      offset += chunk.length;
+    }
+    return content;
   };
 
   return {
@@ -640,7 +640,7 @@ export declare type TestWebhookRequest = Message<"bytebase.v1.TestWebhookRequest
   project: string;
 
   /**
-   * The webhook to test. Identified by its url.
+   * The webhook to test. If its name is set, the test payload is signed with the signing secrets of the webhook.
    *
    * @generated from field: bytebase.v1.Webhook webhook = 2;
    */
@@ -872,7 +872,8 @@ export declare const ExportRequestSchema: GenMessage<ExportRequest>;
  */
 export declare type ExportResponse = Message<"bytebase.v1.ExportResponse"> & {
   /**
-   * The export file content.
+   * A chunk of the export file content.
+   * The export file is the concatenation of the chunks in order.
    *
    * @generated from field: bytes content = 1;
    */
@@ -1439,7 +1440,7 @@ export declare const SQLService: GenService<{
    * @generated from rpc bytebase.v1.SQLService.Export
    */
   export: {
-    methodKind: "unary";
+    methodKind: "server_streaming";
     input: typeof ExportRequestSchema;
     output: typeof ExportResponseSchema;
   },
@@ -16,7 +16,7 @@ import { file_v1_database_service } from "./database_service_pb";
  * Describes the file v1/sql_service.proto.
  */
 export const file_v1_sql_service = /*@__PURE__*/
-  fileDesc("ChR2MS9zcWxfc2VydmljZS5wcm90bxILYnl0ZWJhc2UudjEisAEKE0FkbWluRXhlY3V0ZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJc3RhdGVtZW50GAMgASgJEg0KBWxpbWl0GAQgASgFEhMKBnNjaGVtYRgGIAEoCUgAiAEBEhYKCWNvbnRhaW5lchgHIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJBChRBZG1pbkV4ZWN1dGVSZXNwb25zZRIpCgdyZXN1bHRzGAEgAygLMhguYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQihwIKDFF1ZXJ5UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYAyABKAkSDQoFbGltaXQYBCABKAUSGwoOZGF0YV9zb3VyY2VfaWQYBiABKAlCA+BBAhIPCgdleHBsYWluGAcgASgIEhMKBnNjaGVtYRgIIAEoCUgAiAEBEi4KDHF1ZXJ5X29wdGlvbhgJIAEoCzIYLmJ5dGViYXNlLnYxLlF1ZXJ5T3B0aW9uEhYKCWNvbnRhaW5lchgKIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJACg1RdWVyeVJlc3BvbnNlEikKB3Jlc3VsdHMYASADKAsyGC5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdEoECAIQAyK2AQoLUXVlcnlPcHRpb24SSgoVcmVkaXNfcnVuX2NvbW1hbmRzX29uGAEgASgOMisuYnl0ZWJhc2UudjEuUXVlcnlPcHRpb24uUmVkaXNSdW5Db21tYW5kc09uIlsKElJlZGlzUnVuQ29tbWFuZHNPbhIlCiFSRURJU19SVU5fQ09NTUFORFNfT05fVU5TUEVDSUZJRUQQABIPCgtTSU5HTEVfTk9ERRABEg0KCUFMTF9OT0RFUxACIpoHCgtRdWVyeVJlc3VsdBIUCgxjb2x1bW5fbmFtZXMYASADKAkSGQoRY29sdW1uX3R5cGVfbmFtZXMYAiADKAkSIwoEcm93cxgDIAMoCzIVLmJ5dGViYXNlLnYxLlF1ZXJ5Um93EhIKCnJvd3NfY291bnQYCiABKAMSDQoFZXJyb3IYBiABKAkSKgoHbGF0ZW5jeRgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIRCglzdGF0ZW1lbnQYCCABKAkSQAoOcG9zdGdyZXNfZXJyb3IYCSABKAsyJi5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdC5Qb3N0Z3Jlc0Vycm9ySAASFAoMYWxsb3dfZXhwb3J0GAsgASgIEjIKCG1lc3NhZ2VzGAwgAygLMiAuYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQuTWVzc2FnZRIqCgZtYXNrZWQYBCADKAsyGi5ieXRlYmFzZS52MS5NYXNraW5nUmVhc29uGs4CCg1Qb3N0Z3Jlc0Vycm9yEhAKCHNldmVyaXR5GAEgASgJEgwKBGNvZGUYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIOCgZkZXRhaWwYBCABKAkSDAoEaGludBgFIAEoCRIQCghwb3NpdGlvbhgGIAEoBRIZChFpbnRlcm5hbF9wb3NpdGlvbhgHIAEoBRIWCg5pbnRlcm5hbF9xdWVyeRgIIAEoCRINCgV3aGVyZRgJIAEoCRITCgtzY2hlbWFfbmFtZRgKIAEoCRISCgp0YWJsZV9uYW1lGAsgASgJEhMKC2NvbHVtbl9uYW1lGAwgASgJEhYKDmRhdGFfdHlwZV9uYW1lGA0gASgJEhcKD2NvbnN0cmFpbnRfbmFtZRgOIAEoCRIMCgRmaWxlGA8gASgJEgwKBGxpbmUYECABKAUSDwoHcm91dGluZRgRIAEoCRq3AQoHTWVzc2FnZRI1CgVsZXZlbBgBIAEoDjImLmJ5dGViYXNlLnYxLlF1ZXJ5UmVzdWx0Lk1lc3NhZ2UuTGV2ZWwSDwoHY29udGVudBgCIAEoCSJkCgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARILCgdXQVJOSU5HEAISCQoFREVCVUcQAxIHCgNMT0cQBBIKCgZOT1RJQ0UQBRINCglFWENFUFRJT04QBkIQCg5kZXRhaWxlZF9lcnJvciK9AQoNTWFza2luZ1JlYXNvbhIYChBzZW1hbnRpY190eXBlX2lkGAEgASgJEhsKE3NlbWFudGljX3R5cGVfdGl0bGUYAiABKAkSFwoPbWFza2luZ19ydWxlX2lkGAMgASgJEhEKCWFsZ29yaXRobRgEIAEoCRIPCgdjb250ZXh0GAUgASgJEhwKFGNsYXNzaWZpY2F0aW9uX2xldmVsGAYgASgJEhoKEnNlbWFudGljX3R5cGVfaWNvbhgHIAEoCSIxCghRdWVyeVJvdxIlCgZ2YWx1ZXMYASADKAsyFS5ieXRlYmFzZS52MS5Sb3dWYWx1ZSKMBQoIUm93VmFsdWUSMAoKbnVsbF92YWx1ZRgBIAEoDjIaLmdvb2dsZS5wcm90b2J1Zi5OdWxsVmFsdWVIABIUCgpib29sX3ZhbHVlGAIgASgISAASFQoLYnl0ZXNfdmFsdWUYAyABKAxIABIWCgxkb3VibGVfdmFsdWUYBCABKAFIABIVCgtmbG9hdF92YWx1ZRgFIAEoAkgAEhUKC2ludDMyX3ZhbHVlGAYgASgFSAASFQoLaW50NjRfdmFsdWUYByABKANIABIWCgxzdHJpbmdfdmFsdWUYCCABKAlIABIWCgx1aW50MzJfdmFsdWUYCSABKA1IABIWCgx1aW50NjRfdmFsdWUYCiABKARIABItCgt2YWx1ZV92YWx1ZRgLIAEoCzIWLmdvb2dsZS5wcm90b2J1Zi5WYWx1ZUgAEjoKD3RpbWVzdGFtcF92YWx1ZRgMIAEoCzIfLmJ5dGViYXNlLnYxLlJvd1ZhbHVlLlRpbWVzdGFtcEgAEj8KEnRpbWVzdGFtcF90el92YWx1ZRgNIAEoCzIhLmJ5dGViYXNlLnYxLlJvd1ZhbHVlLlRpbWVzdGFtcFRaSAAaUwoJVGltZXN0YW1wEjQKEGdvb2dsZV90aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGFjY3VyYWN5GAIgASgFGnMKC1RpbWVzdGFtcFRaEjQKEGdvb2dsZV90aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBHpvbmUYAiABKAkSDgoGb2Zmc2V0GAMgASgFEhAKCGFjY3VyYWN5GAQgASgFQgYKBGtpbmQilwIKBkFkdmljZRIqCgZzdGF0dXMYASABKA4yGi5ieXRlYmFzZS52MS5BZHZpY2UuU3RhdHVzEgwKBGNvZGUYAiABKAUSDQoFdGl0bGUYAyABKAkSDwoHY29udGVudBgEIAEoCRItCg5zdGFydF9wb3NpdGlvbhgIIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgJIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uIkUKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdTVUNDRVNTEAESCwoHV0FSTklORxACEgkKBUVSUk9SEANKBAgHEAhKBAgFEAZKBAgGEAciyAEKDUV4cG9ydFJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJc3RhdGVtZW50GAMgASgJEg0KBWxpbWl0GAQgASgFEikKBmZvcm1hdBgFIAEoDjIZLmJ5dGViYXNlLnYxLkV4cG9ydEZvcm1hdBINCgVhZG1pbhgGIAEoCBIQCghwYXNzd29yZBgHIAEoCRIWCg5kYXRhX3NvdXJjZV9pZBgIIAEoCUoECAIQAyIhCg5FeHBvcnRSZXNwb25zZRIPCgdjb250ZW50GAEgASgMImUKDVByZXR0eVJlcXVlc3QSIwoGZW5naW5lGAEgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhYKDmN1cnJlbnRfc2NoZW1hGAIgASgJEhcKD2V4cGVjdGVkX3NjaGVtYRgDIAEoCSJBCg5QcmV0dHlSZXNwb25zZRIWCg5jdXJyZW50X3NjaGVtYRgBIAEoCRIXCg9leHBlY3RlZF9zY2hlbWEYAiABKAki5QEKDENoZWNrUmVxdWVzdBIrCgRuYW1lGAIgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYASABKAkSOQoLY2hhbmdlX3R5cGUYBCABKA4yJC5ieXRlYmFzZS52MS5DaGVja1JlcXVlc3QuQ2hhbmdlVHlwZSJaCgpDaGFuZ2VUeXBlEhsKF0NIQU5HRV9UWVBFX1VOU1BFQ0lGSUVEEAASBwoDRERMEAESDQoJRERMX0dIT1NUEAISBwoDRE1MEAMSDgoKU1FMX0VESVRPUhAEIkwKDUNoZWNrUmVzcG9uc2USJAoHYWR2aWNlcxgBIAMoCzITLmJ5dGViYXNlLnYxLkFkdmljZRIVCg1hZmZlY3RlZF9yb3dzGAIgASgFIiwKGVBhcnNlTXlCYXRpc01hcHBlclJlcXVlc3QSDwoHY29udGVudBgBIAEoDCIwChpQYXJzZU15QmF0aXNNYXBwZXJSZXNwb25zZRISCgpzdGF0ZW1lbnRzGAEgAygJIsQCChNEaWZmTWV0YWRhdGFSZXF1ZXN0EjsKD3NvdXJjZV9tZXRhZGF0YRgBIAEoCzIdLmJ5dGViYXNlLnYxLkRhdGFiYXNlTWV0YWRhdGFCA+BBAhI7Cg90YXJnZXRfbWV0YWRhdGEYAiABKAsyHS5ieXRlYmFzZS52MS5EYXRhYmFzZU1ldGFkYXRhQgPgQQISNAoOc291cmNlX2NhdGFsb2cYBSABKAsyHC5ieXRlYmFzZS52MS5EYXRhYmFzZUNhdGFsb2cSNAoOdGFyZ2V0X2NhdGFsb2cYBiABKAsyHC5ieXRlYmFzZS52MS5EYXRhYmFzZUNhdGFsb2cSIwoGZW5naW5lGAMgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEiIKGmNsYXNzaWZpY2F0aW9uX2Zyb21fY29uZmlnGAQgASgIIiQKFERpZmZNZXRhZGF0YVJlc3BvbnNlEgwKBGRpZmYYASABKAkiVAobU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJEg4KBmZpbHRlchgDIAEoCSJwChxTZWFyY2hRdWVyeUhpc3Rvcmllc1Jlc3BvbnNlEjcKD3F1ZXJ5X2hpc3RvcmllcxgBIAMoCzIZLmJ5dGViYXNlLnYxLlF1ZXJ5SGlzdG9yeUID4EEDEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSLUAgoMUXVlcnlIaXN0b3J5EhEKBG5hbWUYASABKAlCA+BBAxIVCghkYXRhYmFzZRgCIAEoCUID4EEDEhQKB2NyZWF0b3IYAyABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIWCglzdGF0ZW1lbnQYBSABKAlCA+BBAxIXCgVlcnJvchgGIAEoCUID4EEDSACIAQESMAoIZHVyYXRpb24YByABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBAxIsCgR0eXBlGAggASgOMh4uYnl0ZWJhc2UudjEuUXVlcnlIaXN0b3J5LlR5cGUiMwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCQoFUVVFUlkQARIKCgZFWFBPUlQQAkIICgZfZXJyb3IiewoTQUlDb21wbGV0aW9uUmVxdWVzdBI6CghtZXNzYWdlcxgBIAMoCzIoLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlcXVlc3QuTWVzc2FnZRooCgdNZXNzYWdlEgwKBHJvbGUYASABKAkSDwoHY29udGVudBgCIAEoCSKVAgoUQUlDb21wbGV0aW9uUmVzcG9uc2USPwoKY2FuZGlkYXRlcxgBIAMoCzIrLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlc3BvbnNlLkNhbmRpZGF0ZRq7AQoJQ2FuZGlkYXRlEkQKB2NvbnRlbnQYASABKAsyMy5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXNwb25zZS5DYW5kaWRhdGUuQ29udGVudBpoCgdDb250ZW50EkcKBXBhcnRzGAEgAygLMjguYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uUmVzcG9uc2UuQ2FuZGlkYXRlLkNvbnRlbnQuUGFydBoUCgRQYXJ0EgwKBHRleHQYASABKAky2gkKClNRTFNlcnZpY2USsgEKBVF1ZXJ5EhkuYnl0ZWJhc2UudjEuUXVlcnlSZXF1ZXN0GhouYnl0ZWJhc2UudjEuUXVlcnlSZXNwb25zZSJyiuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABmOowAYLT5JMCUDoBKlohOgEqIhwvdjEve25hbWU9aW5zdGFuY2VzLyp9OnF1ZXJ5IigvdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9OnF1ZXJ5EokBCgxBZG1pbkV4ZWN1dGUSIC5ieXRlYmFzZS52MS5BZG1pbkV4ZWN1dGVSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuQWRtaW5FeGVjdXRlUmVzcG9uc2UiMIrqMAxiYi5zcWwuYWRtaW6Q6jABmOowAYLT5JMCEhIQL3YxOmFkbWluRXhlY3V0ZSgBMAESlQEKFFNlYXJjaFF1ZXJ5SGlzdG9yaWVzEiguYnl0ZWJhc2UudjEuU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXF1ZXN0GikuYnl0ZWJhc2UudjEuU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXNwb25zZSIokOowAoLT5JMCHjoBKiIZL3YxL3F1ZXJ5SGlzdG9yaWVzOnNlYXJjaBKeAgoGRXhwb3J0EhouYnl0ZWJhc2UudjEuRXhwb3J0UmVxdWVzdBobLmJ5dGViYXNlLnYxLkV4cG9ydFJlc3BvbnNlItoBiuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABmOowAYLT5JMCtwE6ASpaIjoBKiIdL3YxL3tuYW1lPWluc3RhbmNlcy8qfTpleHBvcnRaLDoBKiInL3YxL3tuYW1lPXByb2plY3RzLyovcm9sbG91dHMvKn06ZXhwb3J0WjU6ASoiMC92MS97bmFtZT1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyp9OmV4cG9ydCIpL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfTpleHBvcnQScgoFQ2hlY2sSGS5ieXRlYmFzZS52MS5DaGVja1JlcXVlc3QaGi5ieXRlYmFzZS52MS5DaGVja1Jlc3BvbnNlIjKK6jASYmIuZGF0YWJhc2VzLmNoZWNrkOowAYLT5JMCEjoBKiINL3YxL3NxbC9jaGVjaxJgCgZQcmV0dHkSGi5ieXRlYmFzZS52MS5QcmV0dHlSZXF1ZXN0GhsuYnl0ZWJhc2UudjEuUHJldHR5UmVzcG9uc2UiHYDqMAGC0+STAhM6ASoiDi92MS9zcWwvcHJldHR5EoEBCgxEaWZmTWV0YWRhdGESIC5ieXRlYmFzZS52MS5EaWZmTWV0YWRhdGFSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuRGlmZk1ldGFkYXRhUmVzcG9uc2UiLIDqMAGC0+STAiI6ASoiHS92MS9zY2hlbWFEZXNpZ246ZGlmZk1ldGFkYXRhEngKDEFJQ29tcGxldGlvbhIgLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlcXVlc3QaIS5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXNwb25zZSIjkOowAoLT5JMCGToBKiIUL3YxL3NxbC9haUNvbXBsZXRpb25CNlo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MWIGcHJvdG8z", [file_google_api_annotations, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_struct, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service]);
+  fileDesc("ChR2MS9zcWxfc2VydmljZS5wcm90bxILYnl0ZWJhc2UudjEisAEKE0FkbWluRXhlY3V0ZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJc3RhdGVtZW50GAMgASgJEg0KBWxpbWl0GAQgASgFEhMKBnNjaGVtYRgGIAEoCUgAiAEBEhYKCWNvbnRhaW5lchgHIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJBChRBZG1pbkV4ZWN1dGVSZXNwb25zZRIpCgdyZXN1bHRzGAEgAygLMhguYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQihwIKDFF1ZXJ5UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYAyABKAkSDQoFbGltaXQYBCABKAUSGwoOZGF0YV9zb3VyY2VfaWQYBiABKAlCA+BBAhIPCgdleHBsYWluGAcgASgIEhMKBnNjaGVtYRgIIAEoCUgAiAEBEi4KDHF1ZXJ5X29wdGlvbhgJIAEoCzIYLmJ5dGViYXNlLnYxLlF1ZXJ5T3B0aW9uEhYKCWNvbnRhaW5lchgKIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJACg1RdWVyeVJlc3BvbnNlEikKB3Jlc3VsdHMYASADKAsyGC5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdEoECAIQAyK2AQoLUXVlcnlPcHRpb24SSgoVcmVkaXNfcnVuX2NvbW1hbmRzX29uGAEgASgOMisuYnl0ZWJhc2UudjEuUXVlcnlPcHRpb24uUmVkaXNSdW5Db21tYW5kc09uIlsKElJlZGlzUnVuQ29tbWFuZHNPbhIlCiFSRURJU19SVU5fQ09NTUFORFNfT05fVU5TUEVDSUZJRUQQABIPCgtTSU5HTEVfTk9ERRABEg0KCUFMTF9OT0RFUxACIpoHCgtRdWVyeVJlc3VsdBIUCgxjb2x1bW5fbmFtZXMYASADKAkSGQoRY29sdW1uX3R5cGVfbmFtZXMYAiADKAkSIwoEcm93cxgDIAMoCzIVLmJ5dGViYXNlLnYxLlF1ZXJ5Um93EhIKCnJvd3NfY291bnQYCiABKAMSDQoFZXJyb3IYBiABKAkSKgoHbGF0ZW5jeRgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIRCglzdGF0ZW1lbnQYCCABKAkSQAoOcG9zdGdyZXNfZXJyb3IYCSABKAsyJi5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdC5Qb3N0Z3Jlc0Vycm9ySAASFAoMYWxsb3dfZXhwb3J0GAsgASgIEjIKCG1lc3NhZ2VzGAwgAygLMiAuYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQuTWVzc2FnZRIqCgZtYXNrZWQYBCADKAsyGi5ieXRlYmFzZS52MS5NYXNraW5nUmVhc29uGs4CCg1Qb3N0Z3Jlc0Vycm9yEhAKCHNldmVyaXR5GAEgASgJEgwKBGNvZGUYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIOCgZkZXRhaWwYBCABKAkSDAoEaGludBgFIAEoCRIQCghwb3NpdGlvbhgGIAEoBRIZChFpbnRlcm5hbF9wb3NpdGlvbhgHIAEoBRIWCg5pbnRlcm5hbF9xdWVyeRgIIAEoCRINCgV3aGVyZRgJIAEoCRITCgtzY2hlbWFfbmFtZRgKIAEoCRISCgp0YWJsZV9uYW1lGAsgASgJEhMKC2NvbHVtbl9uYW1lGAwgASgJEhYKDmRhdGFfdHlwZV9uYW1lGA0gASgJEhcKD2NvbnN0cmFpbnRfbmFtZRgOIAEoCRIMCgRmaWxlGA8gASgJEgwKBGxpbmUYECABKAUSDwoHcm91dGluZRgRIAEoCRq3AQoHTWVzc2FnZRI1CgVsZXZlbBgBIAEoDjImLmJ5dGViYXNlLnYxLlF1ZXJ5UmVzdWx0Lk1lc3NhZ2UuTGV2ZWwSDwoHY29udGVudBgCIAEoCSJkCgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARILCgdXQVJOSU5HEAISCQoFREVCVUcQAxIHCgNMT0cQBBIKCgZOT1RJQ0UQBRINCglFWENFUFRJT04QBkIQCg5kZXRhaWxlZF9lcnJvciK9AQoNTWFza2luZ1JlYXNvbhIYChBzZW1hbnRpY190eXBlX2lkGAEgASgJEhsKE3NlbWFudGljX3R5cGVfdGl0bGUYAiABKAkSFwoPbWFza2luZ19ydWxlX2lkGAMgASgJEhEKCWFsZ29yaXRobRgEIAEoCRIPCgdjb250ZXh0GAUgASgJEhwKFGNsYXNzaWZpY2F0aW9uX2xldmVsGAYgASgJEhoKEnNlbWFudGljX3R5cGVfaWNvbhgHIAEoCSIxCghRdWVyeVJvdxIlCgZ2YWx1ZXMYASADKAsyFS5ieXRlYmFzZS52MS5Sb3dWYWx1ZSKMBQoIUm93VmFsdWUSMAoKbnVsbF92YWx1ZRgBIAEoDjIaLmdvb2dsZS5wcm90b2J1Zi5OdWxsVmFsdWVIABIUCgpib29sX3ZhbHVlGAIgASgISAASFQoLYnl0ZXNfdmFsdWUYAyABKAxIABIWCgxkb3VibGVfdmFsdWUYBCABKAFIABIVCgtmbG9hdF92YWx1ZRgFIAEoAkgAEhUKC2ludDMyX3ZhbHVlGAYgASgFSAASFQoLaW50NjRfdmFsdWUYByABKANIABIWCgxzdHJpbmdfdmFsdWUYCCABKAlIABIWCgx1aW50MzJfdmFsdWUYCSABKA1IABIWCgx1aW50NjRfdmFsdWUYCiABKARIABItCgt2YWx1ZV92YWx1ZRgLIAEoCzIWLmdvb2dsZS5wcm90b2J1Zi5WYWx1ZUgAEjoKD3RpbWVzdGFtcF92YWx1ZRgMIAEoCzIfLmJ5dGViYXNlLnYxLlJvd1ZhbHVlLlRpbWVzdGFtcEgAEj8KEnRpbWVzdGFtcF90el92YWx1ZRgNIAEoCzIhLmJ5dGViYXNlLnYxLlJvd1ZhbHVlLlRpbWVzdGFtcFRaSAAaUwoJVGltZXN0YW1wEjQKEGdvb2dsZV90aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGFjY3VyYWN5GAIgASgFGnMKC1RpbWVzdGFtcFRaEjQKEGdvb2dsZV90aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBHpvbmUYAiABKAkSDgoGb2Zmc2V0GAMgASgFEhAKCGFjY3VyYWN5GAQgASgFQgYKBGtpbmQilwIKBkFkdmljZRIqCgZzdGF0dXMYASABKA4yGi5ieXRlYmFzZS52MS5BZHZpY2UuU3RhdHVzEgwKBGNvZGUYAiABKAUSDQoFdGl0bGUYAyABKAkSDwoHY29udGVudBgEIAEoCRItCg5zdGFydF9wb3NpdGlvbhgIIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgJIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uIkUKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdTVUNDRVNTEAESCwoHV0FSTklORxACEgkKBUVSUk9SEANKBAgHEAhKBAgFEAZKBAgGEAciyAEKDUV4cG9ydFJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJc3RhdGVtZW50GAMgASgJEg0KBWxpbWl0GAQgASgFEikKBmZvcm1hdBgFIAEoDjIZLmJ5dGViYXNlLnYxLkV4cG9ydEZvcm1hdBINCgVhZG1pbhgGIAEoCBIQCghwYXNzd29yZBgHIAEoCRIWCg5kYXRhX3NvdXJjZV9pZBgIIAEoCUoECAIQAyIhCg5FeHBvcnRSZXNwb25zZRIPCgdjb250ZW50GAEgASgMImUKDVByZXR0eVJlcXVlc3QSIwoGZW5naW5lGAEgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhYKDmN1cnJlbnRfc2NoZW1hGAIgASgJEhcKD2V4cGVjdGVkX3NjaGVtYRgDIAEoCSJBCg5QcmV0dHlSZXNwb25zZRIWCg5jdXJyZW50X3NjaGVtYRgBIAEoCRIXCg9leHBlY3RlZF9zY2hlbWEYAiABKAki5QEKDENoZWNrUmVxdWVzdBIrCgRuYW1lGAIgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYASABKAkSOQoLY2hhbmdlX3R5cGUYBCABKA4yJC5ieXRlYmFzZS52MS5DaGVja1JlcXVlc3QuQ2hhbmdlVHlwZSJaCgpDaGFuZ2VUeXBlEhsKF0NIQU5HRV9UWVBFX1VOU1BFQ0lGSUVEEAASBwoDRERMEAESDQoJRERMX0dIT1NUEAISBwoDRE1MEAMSDgoKU1FMX0VESVRPUhAEIkwKDUNoZWNrUmVzcG9uc2USJAoHYWR2aWNlcxgBIAMoCzITLmJ5dGViYXNlLnYxLkFkdmljZRIVCg1hZmZlY3RlZF9yb3dzGAIgASgFIiwKGVBhcnNlTXlCYXRpc01hcHBlclJlcXVlc3QSDwoHY29udGVudBgBIAEoDCIwChpQYXJzZU15QmF0aXNNYXBwZXJSZXNwb25zZRISCgpzdGF0ZW1lbnRzGAEgAygJIsQCChNEaWZmTWV0YWRhdGFSZXF1ZXN0EjsKD3NvdXJjZV9tZXRhZGF0YRgBIAEoCzIdLmJ5dGViYXNlLnYxLkRhdGFiYXNlTWV0YWRhdGFCA+BBAhI7Cg90YXJnZXRfbWV0YWRhdGEYAiABKAsyHS5ieXRlYmFzZS52MS5EYXRhYmFzZU1ldGFkYXRhQgPgQQISNAoOc291cmNlX2NhdGFsb2cYBSABKAsyHC5ieXRlYmFzZS52MS5EYXRhYmFzZUNhdGFsb2cSNAoOdGFyZ2V0X2NhdGFsb2cYBiABKAsyHC5ieXRlYmFzZS52MS5EYXRhYmFzZUNhdGFsb2cSIwoGZW5naW5lGAMgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEiIKGmNsYXNzaWZpY2F0aW9uX2Zyb21fY29uZmlnGAQgASgIIiQKFERpZmZNZXRhZGF0YVJlc3BvbnNlEgwKBGRpZmYYASABKAkiVAobU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJEg4KBmZpbHRlchgDIAEoCSJwChxTZWFyY2hRdWVyeUhpc3Rvcmllc1Jlc3BvbnNlEjcKD3F1ZXJ5X2hpc3RvcmllcxgBIAMoCzIZLmJ5dGViYXNlLnYxLlF1ZXJ5SGlzdG9yeUID4EEDEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSLUAgoMUXVlcnlIaXN0b3J5EhEKBG5hbWUYASABKAlCA+BBAxIVCghkYXRhYmFzZRgCIAEoCUID4EEDEhQKB2NyZWF0b3IYAyABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIWCglzdGF0ZW1lbnQYBSABKAlCA+BBAxIXCgVlcnJvchgGIAEoCUID4EEDSACIAQESMAoIZHVyYXRpb24YByABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBAxIsCgR0eXBlGAggASgOMh4uYnl0ZWJhc2UudjEuUXVlcnlIaXN0b3J5LlR5cGUiMwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCQoFUVVFUlkQARIKCgZFWFBPUlQQAkIICgZfZXJyb3IiewoTQUlDb21wbGV0aW9uUmVxdWVzdBI6CghtZXNzYWdlcxgBIAMoCzIoLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlcXVlc3QuTWVzc2FnZRooCgdNZXNzYWdlEgwKBHJvbGUYASABKAkSDwoHY29udGVudBgCIAEoCSKVAgoUQUlDb21wbGV0aW9uUmVzcG9uc2USPwoKY2FuZGlkYXRlcxgBIAMoCzIrLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlc3BvbnNlLkNhbmRpZGF0ZRq7AQoJQ2FuZGlkYXRlEkQKB2NvbnRlbnQYASABKAsyMy5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXNwb25zZS5DYW5kaWRhdGUuQ29udGVudBpoCgdDb250ZW50EkcKBXBhcnRzGAEgAygLMjguYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uUmVzcG9uc2UuQ2FuZGlkYXRlLkNvbnRlbnQuUGFydBoUCgRQYXJ0EgwKBHRleHQYASABKAky3AkKClNRTFNlcnZpY2USsgEKBVF1ZXJ5EhkuYnl0ZWJhc2UudjEuUXVlcnlSZXF1ZXN0GhouYnl0ZWJhc2UudjEuUXVlcnlSZXNwb25zZSJyiuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABmOowAYLT5JMCUDoBKlohOgEqIhwvdjEve25hbWU9aW5zdGFuY2VzLyp9OnF1ZXJ5IigvdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9OnF1ZXJ5EokBCgxBZG1pbkV4ZWN1dGUSIC5ieXRlYmFzZS52MS5BZG1pbkV4ZWN1dGVSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuQWRtaW5FeGVjdXRlUmVzcG9uc2UiMIrqMAxiYi5zcWwuYWRtaW6Q6jABmOowAYLT5JMCEhIQL3YxOmFkbWluRXhlY3V0ZSgBMAESlQEKFFNlYXJjaFF1ZXJ5SGlzdG9yaWVzEiguYnl0ZWJhc2UudjEuU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXF1ZXN0GikuYnl0ZWJhc2UudjEuU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXNwb25zZSIokOowAoLT5JMCHjoBKiIZL3YxL3F1ZXJ5SGlzdG9yaWVzOnNlYXJjaBKgAgoGRXhwb3J0EhouYnl0ZWJhc2UudjEuRXhwb3J0UmVxdWVzdBobLmJ5dGViYXNlLnYxLkV4cG9ydFJlc3BvbnNlItoBiuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABmOowAYLT5JMCtwE6ASpaIjoBKiIdL3YxL3tuYW1lPWluc3RhbmNlcy8qfTpleHBvcnRaLDoBKiInL3YxL3tuYW1lPXByb2plY3RzLyovcm9sbG91dHMvKn06ZXhwb3J0WjU6ASoiMC92MS97bmFtZT1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyp9OmV4cG9ydCIpL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfTpleHBvcnQwARJyCgVDaGVjaxIZLmJ5dGViYXNlLnYxLkNoZWNrUmVxdWVzdBoaLmJ5dGViYXNlLnYxLkNoZWNrUmVzcG9uc2UiMorqMBJiYi5kYXRhYmFzZXMuY2hlY2uQ6jABgtPkkwISOgEqIg0vdjEvc3FsL2NoZWNrEmAKBlByZXR0eRIaLmJ5dGViYXNlLnYxLlByZXR0eVJlcXVlc3QaGy5ieXRlYmFzZS52MS5QcmV0dHlSZXNwb25zZSIdgOowAYLT5JMCEzoBKiIOL3YxL3NxbC9wcmV0dHkSgQEKDERpZmZNZXRhZGF0YRIgLmJ5dGViYXNlLnYxLkRpZmZNZXRhZGF0YVJlcXVlc3QaIS5ieXRlYmFzZS52MS5EaWZmTWV0YWRhdGFSZXNwb25zZSIsgOowAYLT5JMCIjoBKiIdL3YxL3NjaGVtYURlc2lnbjpkaWZmTWV0YWRhdGESeAoMQUlDb21wbGV0aW9uEiAuYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uUmVxdWVzdBohLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlc3BvbnNlIiOQ6jACgtPkkwIZOgEqIhQvdjEvc3FsL2FpQ29tcGxldGlvbkI2WjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxYgZwcm90bzM=", [file_google_api_annotations, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_struct, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service]);
 
 /**
  * Describes the message bytebase.v1.AdminExecuteRequest.
@@ -4,6 +4,7 @@ import {
   AUTH_OIDC_CALLBACK_MODULE,
   AUTH_PASSWORD_FORGOT_MODULE,
   AUTH_PASSWORD_RESET_MODULE,
+  AUTH_SAML_CALLBACK_MODULE,
   AUTH_SIGNIN_ADMIN_MODULE,
   AUTH_SIGNIN_MODULE,
   AUTH_SIGNUP_MODULE,
@@ -19,5 +20,6 @@ export const isAuthRelatedRoute = (routeName: string) => {
     AUTH_PASSWORD_FORGOT_MODULE,
     AUTH_OAUTH_CALLBACK_MODULE,
     AUTH_OIDC_CALLBACK_MODULE,
+    AUTH_SAML_CALLBACK_MODULE,
   ].includes(routeName);
 };
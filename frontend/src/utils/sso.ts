@@ -4,6 +4,8 @@ import type { IdentityProvider } from "@/types/proto-es/v1/idp_service_pb";
 import { IdentityProviderType } from "@/types/proto-es/v1/idp_service_pb";
 
 export const SSOConfigSessionKey = "sso-config";
+// The redirect after the SAML login, which cannot be carried through the identity provider.
+export const SAMLRedirectSessionKey = "saml-redirect";
 
 export async function openWindowForSSO(
   identityProvider: IdentityProvider,
@@ -52,6 +54,16 @@ export async function openWindowForSSO(
       scope: oidcConfig.scopes.join(" "),
       redirect_uri: `${window.location.origin}/oidc/callback`,
     });
+  } else if (identityProvider.type === IdentityProviderType.SAML) {
+    if (popup) {
+      throw new Error("SAML identity providers can only be used to sign in");
+    }
+    // The server starts the login with the AuthnRequest bound to this browser,
+    // and the identity provider posts the response back to /saml/callback.
+    sessionStorage.setItem(SAMLRedirectSessionKey, redirect);
+    const resourceId = identityProvider.name.split("/").pop();
+    window.location.href = `/saml/sso/${resourceId}`;
+    return;
   } else {
     throw new Error(
       `identity provider type ${identityProvider.type.toString()} is not supported`